@@ -0,0 +1,229 @@
+// Package airplane provides semantic access to common aircraft systems, such as fuel, electrical
+// switches, lights, and flight controls, so that applications scripting a cockpit need not know
+// the underlying dataref names.
+//
+//	plane := airplane.New(client)
+//	if err := plane.SetBattery(ctx, 0, true); err != nil {
+//		return err
+//	}
+//	if err := plane.SetLight(ctx, airplane.LightBeacon, true); err != nil {
+//		return err
+//	}
+//
+// Values are read and written using the [xpweb.Client] transport-agnostic methods, except for
+// writes to individual elements of array datarefs, which are performed via REST.  The client's
+// cache must be loaded, or LazyCache enabled.
+package airplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/dataref/drefaircraft"
+	"github.com/janeprather/xpweb/names/dataref/drefcockpit2"
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+)
+
+// Airplane provides semantic getters and setters for the user's aircraft.
+type Airplane struct {
+	client *xpweb.Client
+}
+
+// New instantiates and returns a pointer to a new [Airplane] object which uses the specified
+// client.
+func New(client *xpweb.Client) *Airplane {
+	return &Airplane{client: client}
+}
+
+// Light is an exterior light of the aircraft.
+type Light int
+
+const (
+	LightBeacon Light = iota
+	LightLanding
+	LightNav
+	LightStrobe
+	LightTaxi
+)
+
+// lightDatarefs maps each Light to the dataref for its switch.
+var lightDatarefs = map[Light]string{
+	LightBeacon:  drefcockpit2.SimCockpit2Switches_beacon_on,
+	LightLanding: drefcockpit2.SimCockpit2Switches_landing_lights_on,
+	LightNav:     drefcockpit2.SimCockpit2Switches_navigation_lights_on,
+	LightStrobe:  drefcockpit2.SimCockpit2Switches_strobe_lights_on,
+	LightTaxi:    drefcockpit2.SimCockpit2Switches_taxi_light_on,
+}
+
+// String returns the name of the light.
+func (l Light) String() string {
+	switch l {
+	case LightBeacon:
+		return "beacon"
+	case LightLanding:
+		return "landing"
+	case LightNav:
+		return "nav"
+	case LightStrobe:
+		return "strobe"
+	case LightTaxi:
+		return "taxi"
+	}
+	return fmt.Sprintf("Light(%d)", int(l))
+}
+
+// NumTanks returns the number of fuel tanks the aircraft has.
+func (a *Airplane) NumTanks(ctx context.Context) (int, error) {
+	val, err := a.client.GetValue(ctx, drefaircraft.SimAircraftOverflow_acf_num_tanks)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetIntValue(), nil
+}
+
+// Fuel returns the quantity of fuel in each of the aircraft's tanks, in kilograms.
+func (a *Airplane) Fuel(ctx context.Context) ([]float64, error) {
+	numTanks, err := a.NumTanks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	val, err := a.client.GetValue(ctx, drefflightmodel.SimFlightmodelWeight_m_fuel)
+	if err != nil {
+		return nil, err
+	}
+	fuel := val.GetFloatArrayValue()
+	return fuel[:min(numTanks, len(fuel))], nil
+}
+
+// TotalFuel returns the total quantity of fuel in all of the aircraft's tanks, in kilograms.
+func (a *Airplane) TotalFuel(ctx context.Context) (float64, error) {
+	fuel, err := a.Fuel(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var total float64
+	for _, tankFuel := range fuel {
+		total += tankFuel
+	}
+	return total, nil
+}
+
+// SetTankFuel sets the quantity of fuel in the specified tank, in kilograms.
+func (a *Airplane) SetTankFuel(ctx context.Context, tank int, kg float64) error {
+	numTanks, err := a.NumTanks(ctx)
+	if err != nil {
+		return err
+	}
+	if tank < 0 || tank >= numTanks {
+		return fmt.Errorf("invalid tank %d: aircraft has %d tank(s)", tank, numTanks)
+	}
+	return a.client.REST.SetDatarefElementValue(ctx, drefflightmodel.SimFlightmodelWeight_m_fuel,
+		tank, kg)
+}
+
+// Battery returns whether the specified battery, numbered from zero, is switched on.
+func (a *Airplane) Battery(ctx context.Context, battery int) (bool, error) {
+	val, err := a.client.GetValue(ctx, drefcockpit2.SimCockpit2Electrical_battery_on)
+	if err != nil {
+		return false, err
+	}
+	batteries := val.GetIntArrayValue()
+	if battery < 0 || battery >= len(batteries) {
+		return false, fmt.Errorf("invalid battery %d", battery)
+	}
+	return batteries[battery] != 0, nil
+}
+
+// SetBattery switches the specified battery, numbered from zero, on or off.
+func (a *Airplane) SetBattery(ctx context.Context, battery int, on bool) error {
+	return a.client.REST.SetDatarefElementValue(ctx, drefcockpit2.SimCockpit2Electrical_battery_on,
+		battery, boolToInt(on))
+}
+
+// Light returns whether the specified exterior light is switched on.
+func (a *Airplane) Light(ctx context.Context, light Light) (bool, error) {
+	name, ok := lightDatarefs[light]
+	if !ok {
+		return false, fmt.Errorf("unknown light: %s", light)
+	}
+	val, err := a.client.GetValue(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return val.GetIntValue() != 0, nil
+}
+
+// SetLight switches the specified exterior light on or off.
+func (a *Airplane) SetLight(ctx context.Context, light Light, on bool) error {
+	name, ok := lightDatarefs[light]
+	if !ok {
+		return fmt.Errorf("unknown light: %s", light)
+	}
+	return a.client.SetValue(ctx, name, boolToInt(on))
+}
+
+// ParkingBrake returns the parking brake ratio, from 0 (released) to 1 (fully set).
+func (a *Airplane) ParkingBrake(ctx context.Context) (float64, error) {
+	val, err := a.client.GetValue(ctx, drefcockpit2.SimCockpit2Controls_parking_brake_ratio)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetFloatValue(), nil
+}
+
+// SetParkingBrake sets the parking brake ratio, from 0 (released) to 1 (fully set).
+func (a *Airplane) SetParkingBrake(ctx context.Context, ratio float64) error {
+	if err := checkRatio(ratio); err != nil {
+		return err
+	}
+	return a.client.SetValue(ctx, drefcockpit2.SimCockpit2Controls_parking_brake_ratio, ratio)
+}
+
+// Throttle returns the throttle ratio for all engines, from 0 (idle) to 1 (full).
+func (a *Airplane) Throttle(ctx context.Context) (float64, error) {
+	val, err := a.client.GetValue(ctx, drefcockpit2.SimCockpit2EngineActuators_throttle_ratio_all)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetFloatValue(), nil
+}
+
+// SetThrottle sets the throttle ratio for all engines, from 0 (idle) to 1 (full).
+func (a *Airplane) SetThrottle(ctx context.Context, ratio float64) error {
+	if err := checkRatio(ratio); err != nil {
+		return err
+	}
+	return a.client.SetValue(ctx, drefcockpit2.SimCockpit2EngineActuators_throttle_ratio_all, ratio)
+}
+
+// Flaps returns the flap handle ratio, from 0 (retracted) to 1 (fully extended).
+func (a *Airplane) Flaps(ctx context.Context) (float64, error) {
+	val, err := a.client.GetValue(ctx, drefcockpit2.SimCockpit2Controls_flap_ratio)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetFloatValue(), nil
+}
+
+// SetFlaps sets the flap handle ratio, from 0 (retracted) to 1 (fully extended).
+func (a *Airplane) SetFlaps(ctx context.Context, ratio float64) error {
+	if err := checkRatio(ratio); err != nil {
+		return err
+	}
+	return a.client.SetValue(ctx, drefcockpit2.SimCockpit2Controls_flap_ratio, ratio)
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func checkRatio(ratio float64) error {
+	if ratio < 0 || ratio > 1 {
+		return fmt.Errorf("invalid ratio %v: must be between 0 and 1", ratio)
+	}
+	return nil
+}
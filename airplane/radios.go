@@ -0,0 +1,170 @@
+package airplane
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/janeprather/xpweb/names/dataref/drefcockpit2"
+)
+
+// Radio is a COM or NAV radio of the aircraft.
+type Radio int
+
+const (
+	RadioCOM1 Radio = iota
+	RadioCOM2
+	RadioNAV1
+	RadioNAV2
+)
+
+// radioDatarefs holds the active and standby frequency datarefs of a radio, and the number of
+// dataref units per MHz.  COM radios use the 8.33 kHz datarefs, which are in kHz (118.805 MHz is
+// 118805), while NAV radios use 10 kHz units (110.80 MHz is 11080).
+type radioDatarefs struct {
+	active  string
+	standby string
+	perMHz  float64
+}
+
+var radios = map[Radio]radioDatarefs{
+	RadioCOM1: {
+		active:  drefcockpit2.SimCockpit2RadiosActuators_com1_frequency_hz_833,
+		standby: drefcockpit2.SimCockpit2RadiosActuators_com1_standby_frequency_hz_833,
+		perMHz:  1000,
+	},
+	RadioCOM2: {
+		active:  drefcockpit2.SimCockpit2RadiosActuators_com2_frequency_hz_833,
+		standby: drefcockpit2.SimCockpit2RadiosActuators_com2_standby_frequency_hz_833,
+		perMHz:  1000,
+	},
+	RadioNAV1: {
+		active:  drefcockpit2.SimCockpit2RadiosActuators_nav1_frequency_hz,
+		standby: drefcockpit2.SimCockpit2RadiosActuators_nav1_standby_frequency_hz,
+		perMHz:  100,
+	},
+	RadioNAV2: {
+		active:  drefcockpit2.SimCockpit2RadiosActuators_nav2_frequency_hz,
+		standby: drefcockpit2.SimCockpit2RadiosActuators_nav2_standby_frequency_hz,
+		perMHz:  100,
+	},
+}
+
+// String returns the name of the radio.
+func (r Radio) String() string {
+	switch r {
+	case RadioCOM1:
+		return "COM1"
+	case RadioCOM2:
+		return "COM2"
+	case RadioNAV1:
+		return "NAV1"
+	case RadioNAV2:
+		return "NAV2"
+	}
+	return fmt.Sprintf("Radio(%d)", int(r))
+}
+
+// TransponderMode is the operating mode of the transponder.
+type TransponderMode int
+
+const (
+	TransponderOff TransponderMode = iota
+	TransponderStandby
+	TransponderOn
+	TransponderAlt
+	TransponderTest
+	TransponderGround
+)
+
+// Frequency returns the active frequency of the specified radio in MHz, e.g. 118.8.
+func (a *Airplane) Frequency(ctx context.Context, radio Radio) (float64, error) {
+	return a.frequency(ctx, radio, false)
+}
+
+// StandbyFrequency returns the standby frequency of the specified radio in MHz, e.g. 118.8.
+func (a *Airplane) StandbyFrequency(ctx context.Context, radio Radio) (float64, error) {
+	return a.frequency(ctx, radio, true)
+}
+
+// SetFrequency sets the active frequency of the specified radio in MHz, e.g. 118.8.
+func (a *Airplane) SetFrequency(ctx context.Context, radio Radio, mhz float64) error {
+	return a.setFrequency(ctx, radio, false, mhz)
+}
+
+// SetStandbyFrequency sets the standby frequency of the specified radio in MHz, e.g. 118.8.
+func (a *Airplane) SetStandbyFrequency(ctx context.Context, radio Radio, mhz float64) error {
+	return a.setFrequency(ctx, radio, true, mhz)
+}
+
+func (a *Airplane) frequency(ctx context.Context, radio Radio, standby bool) (float64, error) {
+	drefs, ok := radios[radio]
+	if !ok {
+		return 0, fmt.Errorf("unknown radio: %s", radio)
+	}
+	name := drefs.active
+	if standby {
+		name = drefs.standby
+	}
+	val, err := a.client.GetValue(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return float64(val.GetIntValue()) / drefs.perMHz, nil
+}
+
+func (a *Airplane) setFrequency(ctx context.Context, radio Radio, standby bool, mhz float64) error {
+	drefs, ok := radios[radio]
+	if !ok {
+		return fmt.Errorf("unknown radio: %s", radio)
+	}
+	if mhz < 100 || mhz >= 140 {
+		return fmt.Errorf("invalid %s frequency %v: must be in MHz", radio, mhz)
+	}
+	name := drefs.active
+	if standby {
+		name = drefs.standby
+	}
+	// round rather than truncate, since e.g. 118.8 * 1000 is 118799.99999999999
+	return a.client.SetValue(ctx, name, int(math.Round(mhz*drefs.perMHz)))
+}
+
+// TransponderCode returns the transponder code, e.g. 1200.
+func (a *Airplane) TransponderCode(ctx context.Context) (int, error) {
+	val, err := a.client.GetValue(ctx, drefcockpit2.SimCockpit2RadiosActuators_transponder_code)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetIntValue(), nil
+}
+
+// SetTransponderCode sets the transponder code, e.g. 1200.  Each of the four digits must be
+// between 0 and 7.
+func (a *Airplane) SetTransponderCode(ctx context.Context, code int) error {
+	if code < 0 || code > 7777 {
+		return fmt.Errorf("invalid transponder code %04d", code)
+	}
+	for digits := code; digits > 0; digits /= 10 {
+		if digits%10 > 7 {
+			return fmt.Errorf("invalid transponder code %04d: digits must be 0-7", code)
+		}
+	}
+	return a.client.SetValue(ctx, drefcockpit2.SimCockpit2RadiosActuators_transponder_code, code)
+}
+
+// TransponderMode returns the transponder mode.
+func (a *Airplane) TransponderMode(ctx context.Context) (TransponderMode, error) {
+	val, err := a.client.GetValue(ctx, drefcockpit2.SimCockpit2RadiosActuators_transponder_mode)
+	if err != nil {
+		return 0, err
+	}
+	return TransponderMode(val.GetIntValue()), nil
+}
+
+// SetTransponderMode sets the transponder mode.
+func (a *Airplane) SetTransponderMode(ctx context.Context, mode TransponderMode) error {
+	if mode < TransponderOff || mode > TransponderGround {
+		return fmt.Errorf("invalid transponder mode %d", mode)
+	}
+	return a.client.SetValue(ctx, drefcockpit2.SimCockpit2RadiosActuators_transponder_mode, int(mode))
+}
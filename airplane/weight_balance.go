@@ -0,0 +1,145 @@
+package airplane
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/janeprather/xpweb/names/dataref/drefaircraft"
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+)
+
+// WeightBalance provides access to the fuel and payload of the aircraft.  Its attributes describe
+// the loaded aircraft at the time it was obtained with [Airplane.WeightBalance], and it should be
+// obtained again after a different aircraft is loaded.
+type WeightBalance struct {
+	airplane *Airplane
+
+	// The number of fuel tanks.
+	NumTanks int
+	// The fraction of the total fuel capacity held by each tank.
+	TankRatios []float64
+	// The total fuel capacity of all tanks, in kilograms.
+	MaxFuel float64
+	// The empty weight of the aircraft, in kilograms.
+	EmptyWeight float64
+	// The maximum takeoff weight of the aircraft, in kilograms.
+	MaxWeight float64
+	// The maximum weight of each payload station, in kilograms.
+	StationMax []float64
+}
+
+// WeightBalance reads the fuel tank and payload configuration of the loaded aircraft.
+func (a *Airplane) WeightBalance(ctx context.Context) (*WeightBalance, error) {
+	wb := &WeightBalance{airplane: a}
+
+	numTanks, err := a.NumTanks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	wb.NumTanks = numTanks
+
+	ratiosVal, err := a.client.GetValue(ctx, drefaircraft.SimAircraftOverflow_acf_tank_rat)
+	if err != nil {
+		return nil, err
+	}
+	ratios := ratiosVal.GetFloatArrayValue()
+	wb.TankRatios = ratios[:min(numTanks, len(ratios))]
+
+	for name, target := range map[string]*float64{
+		drefaircraft.SimAircraftWeight_acf_m_fuel_tot: &wb.MaxFuel,
+		drefaircraft.SimAircraftWeight_acf_m_empty:    &wb.EmptyWeight,
+		drefaircraft.SimAircraftWeight_acf_m_max:      &wb.MaxWeight,
+	} {
+		val, err := a.client.GetValue(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		*target = val.GetFloatValue()
+	}
+
+	stationsVal, err := a.client.GetValue(ctx, drefaircraft.SimAircraftWeight_acf_m_station_max)
+	if err != nil {
+		return nil, err
+	}
+	wb.StationMax = stationsVal.GetFloatArrayValue()
+
+	return wb, nil
+}
+
+// TankCapacities returns the fuel capacity of each tank, in kilograms.
+func (wb *WeightBalance) TankCapacities() []float64 {
+	capacities := make([]float64, len(wb.TankRatios))
+	for idx, ratio := range wb.TankRatios {
+		capacities[idx] = wb.MaxFuel * ratio
+	}
+	return capacities
+}
+
+// SetFuelFraction fills every tank to the specified fraction of its capacity, from 0 (empty) to 1
+// (full).  All tanks are written at once.
+func (wb *WeightBalance) SetFuelFraction(ctx context.Context, fraction float64) error {
+	if err := checkRatio(fraction); err != nil {
+		return err
+	}
+
+	fuelVal, err := wb.airplane.client.GetValue(ctx, drefflightmodel.SimFlightmodelWeight_m_fuel)
+	if err != nil {
+		return err
+	}
+	fuel := fuelVal.GetFloatArrayValue()
+	for idx, capacity := range wb.TankCapacities() {
+		if idx < len(fuel) {
+			fuel[idx] = capacity * fraction
+		}
+	}
+
+	return wb.airplane.client.SetValue(ctx, drefflightmodel.SimFlightmodelWeight_m_fuel, fuel)
+}
+
+// FillTanks fills every tank to its capacity.
+func (wb *WeightBalance) FillTanks(ctx context.Context) error {
+	return wb.SetFuelFraction(ctx, 1)
+}
+
+// Payload returns the payload weight, in kilograms.
+func (wb *WeightBalance) Payload(ctx context.Context) (float64, error) {
+	val, err := wb.airplane.client.GetValue(ctx, drefflightmodel.SimFlightmodelWeight_m_fixed)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetFloatValue(), nil
+}
+
+// SetPayload sets the payload weight, in kilograms.
+func (wb *WeightBalance) SetPayload(ctx context.Context, kg float64) error {
+	if kg < 0 {
+		return fmt.Errorf("invalid payload %v: must not be negative", kg)
+	}
+	if wb.MaxWeight > 0 && wb.EmptyWeight+kg > wb.MaxWeight {
+		return fmt.Errorf("invalid payload %v: exceeds maximum weight of %v", kg, wb.MaxWeight)
+	}
+	return wb.airplane.client.SetValue(ctx, drefflightmodel.SimFlightmodelWeight_m_fixed, kg)
+}
+
+// SetStationPayload sets the weight of the specified payload station, in kilograms.
+func (wb *WeightBalance) SetStationPayload(ctx context.Context, station int, kg float64) error {
+	if station < 0 || station >= len(wb.StationMax) {
+		return fmt.Errorf("invalid station %d: aircraft has %d station(s)", station,
+			len(wb.StationMax))
+	}
+	if kg < 0 || (wb.StationMax[station] > 0 && kg > wb.StationMax[station]) {
+		return fmt.Errorf("invalid payload %v for station %d: must be between 0 and %v", kg,
+			station, wb.StationMax[station])
+	}
+	return wb.airplane.client.REST.SetDatarefElementValue(ctx,
+		drefflightmodel.SimFlightmodelWeight_m_stations, station, kg)
+}
+
+// TotalWeight returns the current total weight of the aircraft, in kilograms.
+func (wb *WeightBalance) TotalWeight(ctx context.Context) (float64, error) {
+	val, err := wb.airplane.client.GetValue(ctx, drefflightmodel.SimFlightmodelWeight_m_total)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetFloatValue(), nil
+}
@@ -0,0 +1,40 @@
+package xpweb
+
+import "maps"
+
+// RegisterAlias registers a short friendly name which may be used in place of a full dataref or
+// command name with any method which accepts a name.  Registering an alias which already exists
+// replaces its target.
+//
+//	client.RegisterAlias("ALT", "sim/cockpit2/autopilot/altitude_dial_ft")
+//	altVal, err := client.REST.GetDatarefValue(ctx, "ALT")
+func (c *Client) RegisterAlias(alias string, name string) {
+	c.aliasesLock.Lock()
+	defer c.aliasesLock.Unlock()
+	c.aliases[alias] = name
+}
+
+// UnregisterAlias removes a previously registered alias.
+func (c *Client) UnregisterAlias(alias string) {
+	c.aliasesLock.Lock()
+	defer c.aliasesLock.Unlock()
+	delete(c.aliases, alias)
+}
+
+// Aliases returns a copy of the registered aliases, keyed by alias.
+func (c *Client) Aliases() map[string]string {
+	c.aliasesLock.RLock()
+	defer c.aliasesLock.RUnlock()
+	return maps.Clone(c.aliases)
+}
+
+// resolveAlias returns the name which the specified alias refers to.  If the specified name is
+// not a registered alias, it is returned unchanged.
+func (c *Client) resolveAlias(name string) string {
+	c.aliasesLock.RLock()
+	defer c.aliasesLock.RUnlock()
+	if target, exists := c.aliases[name]; exists {
+		return target
+	}
+	return name
+}
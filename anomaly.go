@@ -0,0 +1,143 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// AnomalyDetector examines a stream of numeric samples and reports those which are anomalous.  An
+// AnomalyDetector holds state and must not be shared between streams.
+type AnomalyDetector interface {
+	// Observe examines the next sample in the stream.  If the sample is anomalous, a non-empty
+	// description of the anomaly is returned.
+	Observe(sample Sample) string
+}
+
+// AnomalyEvent describes an anomalous dataref value.
+type AnomalyEvent struct {
+	// The dataref whose value was anomalous.
+	Dataref *Dataref
+	// The index of the anomalous element for array datarefs, otherwise zero.
+	Index int
+	// The anomalous sample.
+	Sample Sample
+	// A description of the anomaly.
+	Reason string
+}
+
+// AnomalyHandler is a function which performs some action for an [AnomalyEvent].
+type AnomalyHandler func(*AnomalyEvent)
+
+// thresholdDetector reports values outside of a fixed range.
+type thresholdDetector struct {
+	min float64
+	max float64
+}
+
+// NewThresholdDetector returns an [AnomalyDetector] which reports values below min or above max.
+func NewThresholdDetector(min, max float64) AnomalyDetector {
+	return &thresholdDetector{min: min, max: max}
+}
+
+func (d *thresholdDetector) Observe(sample Sample) string {
+	switch {
+	case sample.Value < d.min:
+		return fmt.Sprintf("value %v below minimum %v", sample.Value, d.min)
+	case sample.Value > d.max:
+		return fmt.Sprintf("value %v above maximum %v", sample.Value, d.max)
+	}
+	return ""
+}
+
+// zScoreDetector reports values which deviate from the recent mean by too many standard
+// deviations.
+type zScoreDetector struct {
+	series    *TimeSeries
+	threshold float64
+}
+
+// NewZScoreDetector returns an [AnomalyDetector] which reports values whose z-score, relative to
+// the mean and standard deviation of the preceding window samples, exceeds the threshold.  No
+// values are reported until the window is full.
+func NewZScoreDetector(window int, threshold float64) AnomalyDetector {
+	return &zScoreDetector{series: NewTimeSeries(window), threshold: threshold}
+}
+
+func (d *zScoreDetector) Observe(sample Sample) string {
+	defer d.series.Add(sample.Time, sample.Value)
+
+	samples := d.series.Samples()
+	if len(samples) < cap(d.series.samples) {
+		return ""
+	}
+
+	var sum, sumSq float64
+	for _, s := range samples {
+		sum += s.Value
+		sumSq += s.Value * s.Value
+	}
+	n := float64(len(samples))
+	mean := sum / n
+	stdDev := math.Sqrt(max(sumSq/n-mean*mean, 0))
+	if stdDev == 0 {
+		if sample.Value != mean {
+			return fmt.Sprintf("value %v deviates from constant %v", sample.Value, mean)
+		}
+		return ""
+	}
+
+	if z := (sample.Value - mean) / stdDev; math.Abs(z) > d.threshold {
+		return fmt.Sprintf("value %v has z-score %.2f (mean %v, std dev %v)", sample.Value, z,
+			mean, stdDev)
+	}
+	return ""
+}
+
+// DetectAnomalies watches the dataref with the specified name, passing each value to an
+// [AnomalyDetector], and calls the specified handler for each anomalous value.  The specified
+// function is called to create a separate detector for each element of an array dataref.  Any
+// [WatchOption] values, e.g. [WithSmoothing], are applied before detection.  Detection stops when
+// the context is done.
+//
+//	err := client.DetectAnomalies(ctx, dataref.SimFlightmodelForces_g_nrml,
+//		func() xpweb.AnomalyDetector { return xpweb.NewThresholdDetector(-1, 4) },
+//		func(event *xpweb.AnomalyEvent) {
+//			log.Printf("%s: %s", event.Dataref.Name, event.Reason)
+//		})
+func (c *Client) DetectAnomalies(
+	ctx context.Context,
+	name string,
+	newDetector func() AnomalyDetector,
+	handler AnomalyHandler,
+	opts ...WatchOption,
+) error {
+	var detectors []AnomalyDetector
+	observe := func(dref *Dataref, idx int, sample Sample) {
+		for len(detectors) <= idx {
+			detectors = append(detectors, newDetector())
+		}
+		if reason := detectors[idx].Observe(sample); reason != "" {
+			handler(&AnomalyEvent{Dataref: dref, Index: idx, Sample: sample, Reason: reason})
+		}
+	}
+
+	return c.Watch(ctx, name, func(val *DatarefValue) {
+		now := time.Now()
+		switch raw := val.Value.(type) {
+		case float64:
+			observe(val.Dataref, 0, Sample{Time: now, Value: raw})
+		case []float64:
+			for idx, num := range raw {
+				observe(val.Dataref, idx, Sample{Time: now, Value: num})
+			}
+		case []any:
+			for idx, elem := range raw {
+				if num, ok := elem.(float64); ok {
+					observe(val.Dataref, idx, Sample{Time: now, Value: num})
+				}
+			}
+		}
+	}, opts...)
+}
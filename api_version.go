@@ -0,0 +1,164 @@
+package xpweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// API versions which this package is able to use.
+const (
+	// APIVersion1 is the initial web API provided by X-Plane 12.1.1, which supports datarefs but
+	// not commands.
+	APIVersion1 string = "v1"
+	// APIVersion2 is the web API provided by X-Plane 12.1.4 and later, which adds commands.
+	APIVersion2 string = "v2"
+	// APIVersion3 is the web API provided by newer X-Plane 12 releases.  The REST endpoints and
+	// websocket messages used by this package behave as they do in v2, and fields added to their
+	// responses are ignored, so the existing methods are used unchanged.
+	APIVersion3 string = "v3"
+)
+
+// supportedAPIVersions lists the API versions which this package is able to use, in order of
+// preference.
+var supportedAPIVersions = []string{APIVersion3, APIVersion2, APIVersion1}
+
+// defaultAPIVersion is the API version used until a version is negotiated.
+const defaultAPIVersion = APIVersion2
+
+// APIVersion returns the web API version, such as [APIVersion2], through which requests are
+// routed.  Until a version has been negotiated, either explicitly via [Client.NegotiateAPIVersion]
+// or implicitly by LoadCache or the first API request, [APIVersion2] is returned.
+func (c *Client) APIVersion() string {
+	c.apiVersionLock.RLock()
+	defer c.apiVersionLock.RUnlock()
+	if c.apiVersion == "" {
+		return defaultAPIVersion
+	}
+	return c.apiVersion
+}
+
+// NegotiateAPIVersion fetches the capabilities of the simulator, and selects the highest API
+// version which is supported by both the simulator and this package.  The selected version is
+// returned, and is used for all subsequent requests.
+func (c *Client) NegotiateAPIVersion(ctx context.Context) (string, error) {
+	capabilities, err := c.REST.GetCapabilities(ctx)
+	if err != nil {
+		return "", fmt.Errorf("negotiating API version: %w", err)
+	}
+
+	version := selectAPIVersion(capabilities.API.Versions)
+	if version == "" {
+		return "", fmt.Errorf("%w: simulator provides API versions %v, none of which are supported",
+			errors.ErrUnsupported, capabilities.API.Versions)
+	}
+
+	c.apiVersionLock.Lock()
+	c.apiVersion = version
+	c.apiVersionLock.Unlock()
+	return version, nil
+}
+
+// ensureAPIVersion returns the negotiated API version, negotiating it first if that has not yet
+// been done.
+func (c *Client) ensureAPIVersion(ctx context.Context) (string, error) {
+	c.apiVersionLock.RLock()
+	version := c.apiVersion
+	c.apiVersionLock.RUnlock()
+	if version != "" {
+		return version, nil
+	}
+
+	c.negotiateLock.Lock()
+	defer c.negotiateLock.Unlock()
+
+	// another caller may have negotiated while this one waited
+	c.apiVersionLock.RLock()
+	version = c.apiVersion
+	c.apiVersionLock.RUnlock()
+	if version != "" {
+		return version, nil
+	}
+	return c.NegotiateAPIVersion(ctx)
+}
+
+// renegotiateAPIVersion negotiates the API version again, unless it was pinned by the
+// configuration, so that a simulator which has been upgraded or replaced is used through the
+// highest version it supports.
+func (c *Client) renegotiateAPIVersion(ctx context.Context) error {
+	if c.pinnedAPIVersion {
+		return nil
+	}
+	c.negotiateLock.Lock()
+	defer c.negotiateLock.Unlock()
+	_, err := c.NegotiateAPIVersion(ctx)
+	return err
+}
+
+// resetAPIVersion discards the negotiated API version, unless it was pinned by the configuration,
+// so that it is negotiated again by the next request.  This is done when the simulator version
+// changes.
+func (c *Client) resetAPIVersion() {
+	if c.pinnedAPIVersion {
+		return
+	}
+	c.apiVersionLock.Lock()
+	c.apiVersion = ""
+	c.apiVersionLock.Unlock()
+}
+
+// endpointMinVersions maps endpoint path prefixes to the oldest API version which provides them.
+var endpointMinVersions = map[string]string{
+	"/command": APIVersion2,
+}
+
+// supportsCommands returns whether the negotiated API version provides commands.
+func (c *Client) supportsCommands(ctx context.Context) (bool, error) {
+	version, err := c.ensureAPIVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+	return apiVersionNumber(version) >= apiVersionNumber(APIVersion2), nil
+}
+
+// apiPath returns the path of the specified endpoint beneath the root of the negotiated API
+// version, such as /api/v2/datarefs for /datarefs.  An error matching errors.ErrUnsupported is
+// returned if the endpoint is not provided by the negotiated version.
+func (xpc *RESTClient) apiPath(ctx context.Context, endpoint string) (string, error) {
+	version, err := xpc.client.ensureAPIVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+	for prefix, minVersion := range endpointMinVersions {
+		if strings.HasPrefix(endpoint, prefix) &&
+			apiVersionNumber(version) < apiVersionNumber(minVersion) {
+			return "", fmt.Errorf("%w: %s requires API %s, but API %s is in use",
+				errors.ErrUnsupported, endpoint, minVersion, version)
+		}
+	}
+	return "/api/" + version + endpoint, nil
+}
+
+// selectAPIVersion returns the most preferred supported version among those offered, or an empty
+// string if none are supported.
+func selectAPIVersion(offered []string) string {
+	for _, version := range supportedAPIVersions {
+		if slices.Contains(offered, version) {
+			return version
+		}
+	}
+	return ""
+}
+
+// apiVersionNumber returns the numeric portion of an API version such as v2, or 0 if the version
+// is malformed.
+func apiVersionNumber(version string) int {
+	num, err := strconv.Atoi(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return 0
+	}
+	return num
+}
@@ -0,0 +1,56 @@
+package xpweb
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// CredentialsProvider supplies the value of the Authorization header which is attached to REST
+// requests and to the websocket handshake, for use when the API is exposed behind an
+// authenticating proxy.  It is called for each request, so that tokens may be refreshed.
+type CredentialsProvider interface {
+	Authorization(ctx context.Context) (string, error)
+}
+
+// CredentialsFunc is an adapter which allows an ordinary function to be used as a
+// [CredentialsProvider].
+type CredentialsFunc func(ctx context.Context) (string, error)
+
+// Authorization calls f(ctx).
+func (f CredentialsFunc) Authorization(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// BasicAuth returns a [CredentialsProvider] which authenticates with the specified username and
+// password using HTTP basic authentication.
+func BasicAuth(username, password string) CredentialsProvider {
+	encoded := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+	return staticCredentials("Basic " + encoded)
+}
+
+// BearerToken returns a [CredentialsProvider] which authenticates with the specified bearer
+// token.
+func BearerToken(token string) CredentialsProvider {
+	return staticCredentials("Bearer " + token)
+}
+
+// staticCredentials is a CredentialsProvider with a fixed Authorization header value.
+type staticCredentials string
+
+func (s staticCredentials) Authorization(context.Context) (string, error) {
+	return string(s), nil
+}
+
+// authorization returns the Authorization header value from the configured CredentialsProvider,
+// or an empty string if there is none.
+func (c *Client) authorization(ctx context.Context) (string, error) {
+	if c.credentials == nil {
+		return "", nil
+	}
+	value, err := c.credentials.Authorization(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain credentials: %w", err)
+	}
+	return value, nil
+}
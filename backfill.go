@@ -0,0 +1,138 @@
+package xpweb
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// backfillTimeout limits how long resolving unknown dataref IDs may take.
+	backfillTimeout time.Duration = 30 * time.Second
+	// backfillRetryFreq is how long an ID which could not be resolved is delivered without a
+	// Dataref before another attempt is made to resolve it.
+	backfillRetryFreq time.Duration = time.Minute
+)
+
+// datarefBackfill holds dataref update messages whose delivery is deferred while IDs which are not
+// in the cache are resolved, so that handlers receive them with their Dataref values populated,
+// and in the order in which they were received.
+type datarefBackfill struct {
+	queue   []*WSMessageDatarefUpdate
+	running bool
+	// when each ID which could not be resolved was last attempted
+	failed map[uint64]time.Time
+	lock   sync.Mutex
+}
+
+// needsBackfill returns whether the message has a value for a dataref which is not cached, and
+// which has not recently failed to resolve.  The lock must be held.
+func (bf *datarefBackfill) needsBackfill(msg *WSMessageDatarefUpdate) bool {
+	for id, val := range msg.Data {
+		if val.Dataref == nil && time.Since(bf.failed[id]) >= backfillRetryFreq {
+			return true
+		}
+	}
+	return false
+}
+
+// deliverOrBackfill delivers a dataref update message whose Dataref values have been populated.
+// If the client was configured with BackfillDatarefs and the message has values for datarefs
+// which are not cached, or a backfill is already in progress, the message is instead queued to be
+// delivered once the unknown IDs have been resolved.
+func (wsc *WSClient) deliverOrBackfill(msg *WSMessageDatarefUpdate) {
+	bf := &wsc.backfill
+	bf.lock.Lock()
+	if !bf.running {
+		if !wsc.backfillDatarefs || !bf.needsBackfill(msg) {
+			bf.lock.Unlock()
+			wsc.deliverDatarefUpdate(msg)
+			return
+		}
+		bf.running = true
+		go wsc.runBackfill()
+	}
+	bf.queue = append(bf.queue, msg)
+	bf.lock.Unlock()
+}
+
+// runBackfill resolves the unknown IDs of queued messages, and delivers them, until the queue is
+// empty.
+func (wsc *WSClient) runBackfill() {
+	bf := &wsc.backfill
+	for {
+		bf.lock.Lock()
+		queue := bf.queue
+		bf.queue = nil
+		if len(queue) == 0 {
+			bf.running = false
+			bf.lock.Unlock()
+			return
+		}
+		unknown := make(map[uint64]bool)
+		for _, msg := range queue {
+			// IDs may have been resolved since the message was queued
+			msg.populateDatarefs(wsc)
+			for id, val := range msg.Data {
+				if val.Dataref == nil && time.Since(bf.failed[id]) >= backfillRetryFreq {
+					unknown[id] = true
+				}
+			}
+		}
+		bf.lock.Unlock()
+
+		if len(unknown) > 0 {
+			wsc.resolveDatarefIDs(unknown)
+		}
+		for _, msg := range queue {
+			msg.populateDatarefs(wsc)
+			wsc.deliverDatarefUpdate(msg)
+		}
+	}
+}
+
+// resolveDatarefIDs fetches the dataref listing via the REST API, and adds any datarefs which are
+// not cached.  IDs which are still not found are recorded as having failed to resolve.
+func (wsc *WSClient) resolveDatarefIDs(ids map[uint64]bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), backfillTimeout)
+	defer cancel()
+
+	datarefs, err := wsc.client.REST.GetDatarefs(ctx)
+	if err != nil {
+		wsc.client.logger.Warn("failed to resolve unknown dataref IDs", "error", err)
+	} else {
+		wsc.client.addDatarefs(datarefs)
+	}
+
+	bf := &wsc.backfill
+	bf.lock.Lock()
+	defer bf.lock.Unlock()
+	for id := range ids {
+		if wsc.client.GetDatarefByID(id) != nil {
+			delete(bf.failed, id)
+			continue
+		}
+		if bf.failed == nil {
+			bf.failed = make(map[uint64]time.Time)
+		}
+		bf.failed[id] = time.Now()
+		if err == nil {
+			wsc.client.logger.Warn("dataref ID not found in listing", "id", id)
+		}
+	}
+}
+
+// addDatarefs adds the datarefs which are not already cached to the cache.  Names are mapped to
+// the added datarefs, as they are newer than any cached dataref of the same name.
+func (xpc *Client) addDatarefs(datarefs []*Dataref) {
+	xpc.datarefsLock.Lock()
+	defer xpc.datarefsLock.Unlock()
+
+	for _, dataref := range datarefs {
+		if _, exists := xpc.datarefsByID[dataref.ID]; exists {
+			continue
+		}
+		xpc.datarefsByID[dataref.ID] = dataref
+		xpc.datarefsByName[dataref.Name] = dataref
+	}
+}
@@ -0,0 +1,177 @@
+// Package bindings connects home cockpit hardware, such as Arduino-based switch panels and
+// annunciators, to the simulator.  A declarative [Config] maps the named inputs of a [Device] to
+// commands and datarefs, and maps datarefs to the device's named outputs, such as LEDs or gauges.
+//
+//	inputs:
+//	  - key: gear
+//	    command: sim/flight_controls/landing_gear_toggle
+//	    debounce: 0.05
+//	  - key: starter
+//	    command: sim/engines/engage_starters
+//	    hold: true
+//	  - key: flaps
+//	    dataref: sim/cockpit2/controls/flap_ratio
+//	    scale: {in_min: 0, in_max: 1023, out_min: 0, out_max: 1}
+//	outputs:
+//	  - key: gear_led
+//	    dataref: sim/flightmodel2/gear/deploy_ratio
+//	    index: 0
+//	    threshold: 1
+//
+// The simplest devices exchange key=value lines over a serial port, which [LineDevice] handles:
+//
+//	cfg, err := bindings.Load("panel.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	port, err := os.OpenFile("/dev/ttyACM0", os.O_RDWR, 0)
+//	if err != nil {
+//		return err
+//	}
+//	err = bindings.New(client, cfg, bindings.NewLineDevice(port)).Run(ctx)
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/janeprather/xpweb/internal/yaml"
+)
+
+// Config maps device inputs and outputs to commands and datarefs.
+type Config struct {
+	Inputs  []*Input  `json:"inputs"`
+	Outputs []*Output `json:"outputs"`
+}
+
+// Input maps a device input to a command or a dataref.  Exactly one of Command and Dataref must
+// be specified.
+type Input struct {
+	// The name by which the device identifies the input.
+	Key string `json:"key"`
+
+	// The name of a command, which is activated when the input changes from zero to non-zero,
+	// according to its [xpweb.CommandKind].  If Hold is set, the command is instead held for as
+	// long as the input is non-zero.
+	Command string `json:"command,omitempty"`
+	Hold    bool   `json:"hold,omitempty"`
+
+	// The name of a dataref to which the input value is written, after scaling, or to whose Index
+	// element it is written if Index is specified.
+	Dataref string `json:"dataref,omitempty"`
+	Index   *int   `json:"index,omitempty"`
+	Scale   *Scale `json:"scale,omitempty"`
+
+	// A number of seconds after a change of the input during which further changes are ignored,
+	// to suppress switch bounce.
+	Debounce float64 `json:"debounce,omitempty"`
+}
+
+// Output maps a dataref to a device output.  The dataref value, or the value of its Index element,
+// is scaled and written to the device each time it changes.
+type Output struct {
+	// The name by which the device identifies the output.
+	Key string `json:"key"`
+
+	Dataref string `json:"dataref"`
+	Index   *int   `json:"index,omitempty"`
+	Scale   *Scale `json:"scale,omitempty"`
+
+	// If specified, the output is written as 1 when the scaled value is at least the threshold,
+	// and 0 otherwise, as for an LED.
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// Scale maps values linearly from an input range to an output range.  Values outside the input
+// range are clamped to the output range.
+type Scale struct {
+	InMin  float64 `json:"in_min"`
+	InMax  float64 `json:"in_max"`
+	OutMin float64 `json:"out_min"`
+	OutMax float64 `json:"out_max"`
+}
+
+// Apply returns the scaled value.  A nil Scale returns the value unchanged.
+func (s *Scale) Apply(value float64) float64 {
+	if s == nil {
+		return value
+	}
+	fraction := (value - s.InMin) / (s.InMax - s.InMin)
+	fraction = max(0, min(1, fraction))
+	return s.OutMin + fraction*(s.OutMax-s.OutMin)
+}
+
+// Load reads a [Config] from the specified file, which is decoded as JSON if its name ends in
+// .json, or as YAML otherwise.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		cfg := &Config{}
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal bindings: %w", err)
+		}
+		return cfg, cfg.Validate()
+	}
+	return Parse(data)
+}
+
+// Parse decodes a [Config] from YAML.
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal bindings: %w", err)
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that each input and output of the configuration is well formed.
+func (c *Config) Validate() error {
+	for idx, input := range c.Inputs {
+		if err := input.validate(); err != nil {
+			return fmt.Errorf("input %d (%s): %w", idx+1, input.Key, err)
+		}
+	}
+	for idx, output := range c.Outputs {
+		if err := output.validate(); err != nil {
+			return fmt.Errorf("output %d (%s): %w", idx+1, output.Key, err)
+		}
+	}
+	return nil
+}
+
+func (i *Input) validate() error {
+	switch {
+	case i.Key == "":
+		return fmt.Errorf("key is required")
+	case (i.Command == "") == (i.Dataref == ""):
+		return fmt.Errorf("exactly one of command and dataref is required")
+	case i.Hold && i.Command == "":
+		return fmt.Errorf("hold requires a command")
+	case i.Debounce < 0:
+		return fmt.Errorf("debounce must not be negative")
+	}
+	return i.Scale.validate()
+}
+
+func (o *Output) validate() error {
+	switch {
+	case o.Key == "":
+		return fmt.Errorf("key is required")
+	case o.Dataref == "":
+		return fmt.Errorf("dataref is required")
+	}
+	return o.Scale.validate()
+}
+
+func (s *Scale) validate() error {
+	if s != nil && s.InMin == s.InMax {
+		return fmt.Errorf("scale input range must not be empty")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package bindings
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrMalformedInput is matched by errors returned by a [Device] for input which could not be
+// decoded.  The [Engine] logs such errors and continues reading.
+var ErrMalformedInput = errors.New("malformed input")
+
+// Device is a source of named inputs and a sink for named outputs, such as a microcontroller
+// attached to switches and LEDs.
+type Device interface {
+	// Read blocks until the device reports an input value, and returns it.
+	Read() (key string, value float64, err error)
+	// Write sends an output value to the device.
+	Write(key string, value float64) error
+}
+
+// LineDevice is a [Device] which exchanges key=value lines, such as "gear=1", over a stream such
+// as a serial port.  Blank lines and lines beginning with # are ignored.  Writes are serialized,
+// so LineDevice is safe for concurrent use by one reader and many writers.
+type LineDevice struct {
+	scanner *bufio.Scanner
+	w       io.Writer
+	lock    sync.Mutex
+}
+
+// NewLineDevice returns a [LineDevice] which reads and writes lines on the specified stream.
+func NewLineDevice(rw io.ReadWriter) *LineDevice {
+	return &LineDevice{scanner: bufio.NewScanner(rw), w: rw}
+}
+
+// Read allows LineDevice to implement the [Device] interface.  It returns io.EOF when the stream
+// ends.
+func (d *LineDevice) Read() (string, float64, error) {
+	for d.scanner.Scan() {
+		line := strings.TrimSpace(d.scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, text, ok := strings.Cut(line, "=")
+		if !ok {
+			return "", 0, fmt.Errorf("%w: %q", ErrMalformedInput, line)
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return "", 0, fmt.Errorf("%w: %q: %w", ErrMalformedInput, line, err)
+		}
+		return strings.TrimSpace(key), value, nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return "", 0, err
+	}
+	return "", 0, io.EOF
+}
+
+// Write allows LineDevice to implement the [Device] interface.
+func (d *LineDevice) Write(key string, value float64) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, err := fmt.Fprintf(d.w, "%s=%s\n", key, strconv.FormatFloat(value, 'f', -1, 64))
+	return err
+}
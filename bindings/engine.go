@@ -0,0 +1,212 @@
+package bindings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// releaseTimeout is the time allowed for releasing held commands when the engine stops.
+const releaseTimeout = 5 * time.Second
+
+// Engine applies a [Config] between a [Device] and the simulator.
+type Engine struct {
+	client *xpweb.Client
+	config *Config
+	device Device
+	// An optional logger for errors handling inputs and outputs.  If nil, slog.Default() is used.
+	Logger *slog.Logger
+
+	inputLock sync.Mutex
+	inputs    map[string]*inputState
+
+	outputLock sync.Mutex
+	// the last value written to each output key
+	written map[string]float64
+}
+
+// inputState is the latest accepted value of an input.
+type inputState struct {
+	input   *Input
+	value   float64
+	changed time.Time
+	held    bool
+}
+
+// New instantiates and returns a pointer to a new [Engine] which applies the specified
+// configuration between the device and the simulator of the specified client.
+func New(client *xpweb.Client, config *Config, device Device) *Engine {
+	inputs := make(map[string]*inputState, len(config.Inputs))
+	for _, input := range config.Inputs {
+		inputs[input.Key] = &inputState{input: input}
+	}
+	return &Engine{
+		client:  client,
+		config:  config,
+		device:  device,
+		inputs:  inputs,
+		written: make(map[string]float64),
+	}
+}
+
+func (e *Engine) logger() *slog.Logger {
+	if e.Logger != nil {
+		return e.Logger
+	}
+	return slog.Default()
+}
+
+// Run subscribes to the output datarefs, and handles device inputs, until the context is done or
+// the device fails.  Commands held by inputs are released when it returns.  Errors performing an
+// individual input or output are logged rather than stopping the engine.
+func (e *Engine) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer e.releaseHeld()
+
+	for _, output := range e.config.Outputs {
+		handler := func(val *xpweb.DatarefValue) { e.handleOutput(output, val) }
+		var err error
+		if output.Index != nil {
+			err = e.client.SubscribeDatarefElement(ctx, output.Dataref, *output.Index, handler)
+		} else {
+			err = e.client.Watch(ctx, output.Dataref, handler)
+		}
+		if err != nil {
+			return fmt.Errorf("output %s: %w", output.Key, err)
+		}
+	}
+
+	type reading struct {
+		key   string
+		value float64
+		err   error
+	}
+	readings := make(chan reading)
+	go func() {
+		for {
+			key, value, err := e.device.Read()
+			select {
+			case readings <- reading{key, value, err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil && !errors.Is(err, ErrMalformedInput) {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r := <-readings:
+			switch {
+			case errors.Is(r.err, ErrMalformedInput):
+				e.logger().Warn("ignoring device input", "error", r.err)
+			case r.err != nil:
+				return fmt.Errorf("reading device: %w", r.err)
+			default:
+				if err := e.HandleInput(ctx, r.key, r.value); err != nil {
+					e.logger().Error("failed to handle device input", "key", r.key, "error", err)
+				}
+			}
+		}
+	}
+}
+
+// HandleInput applies an input value as though it were read from the device.  Inputs which are
+// not configured are ignored, as are changes within the debounce period of the previous change.
+func (e *Engine) HandleInput(ctx context.Context, key string, value float64) error {
+	e.inputLock.Lock()
+	defer e.inputLock.Unlock()
+
+	state, ok := e.inputs[key]
+	if !ok {
+		return nil
+	}
+	input := state.input
+	now := time.Now()
+	if input.Debounce > 0 && now.Sub(state.changed) < secondsToDuration(input.Debounce) {
+		return nil
+	}
+	previous := state.value
+	if value != previous {
+		state.changed = now
+	}
+	state.value = value
+
+	switch {
+	case input.Command != "" && input.Hold:
+		if value != 0 && !state.held {
+			state.held = true
+			return e.client.HoldCommand(ctx, input.Command)
+		}
+		if value == 0 && state.held {
+			state.held = false
+			return e.client.ReleaseCommand(ctx, input.Command)
+		}
+	case input.Command != "":
+		if value != 0 && previous == 0 {
+			return e.client.TriggerCommand(ctx, input.Command)
+		}
+	case input.Index != nil:
+		return e.client.REST.SetDatarefElementValue(ctx, input.Dataref, *input.Index,
+			input.Scale.Apply(value))
+	default:
+		return e.client.SetValue(ctx, input.Dataref, input.Scale.Apply(value))
+	}
+	return nil
+}
+
+// handleOutput writes a dataref value to its output, if the value written has changed.
+func (e *Engine) handleOutput(output *Output, val *xpweb.DatarefValue) {
+	value := output.Scale.Apply(val.GetFloatValue())
+	if output.Threshold != nil {
+		if value >= *output.Threshold {
+			value = 1
+		} else {
+			value = 0
+		}
+	}
+
+	e.outputLock.Lock()
+	defer e.outputLock.Unlock()
+	if last, ok := e.written[output.Key]; ok && last == value {
+		return
+	}
+	if err := e.device.Write(output.Key, value); err != nil {
+		e.logger().Error("failed to write device output", "key", output.Key, "error", err)
+		return
+	}
+	e.written[output.Key] = value
+}
+
+// releaseHeld releases the commands held by inputs.
+func (e *Engine) releaseHeld() {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+
+	e.inputLock.Lock()
+	defer e.inputLock.Unlock()
+	for _, state := range e.inputs {
+		if !state.held {
+			continue
+		}
+		state.held = false
+		if err := e.client.ReleaseCommand(ctx, state.input.Command); err != nil {
+			e.logger().Error("failed to release command", "command", state.input.Command,
+				"error", err)
+		}
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
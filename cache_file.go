@@ -0,0 +1,125 @@
+package xpweb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"os"
+	"slices"
+	"time"
+)
+
+// cacheValidationSamples is the number of datarefs and commands checked by ValidateCache.
+const cacheValidationSamples = 5
+
+// ErrStaleCache is returned by [Client.ValidateCache] when cached IDs do not match those of the
+// running simulator session.
+var ErrStaleCache = errors.New("cache does not match the running simulator session")
+
+// cacheFile is the structure of a cache file written by SaveCache.
+type cacheFile struct {
+	SavedAt  time.Time  `json:"saved_at"`
+	Commands []*Command `json:"commands"`
+	Datarefs []*Dataref `json:"datarefs"`
+}
+
+// SaveCache writes the currently cached commands and datarefs to the specified file, so that they
+// may be restored in a later run with [Client.LoadCacheFromFile].
+func (c *Client) SaveCache(path string) error {
+	data := &cacheFile{SavedAt: time.Now()}
+
+	c.commandsLock.RLock()
+	data.Commands = slices.Collect(maps.Values(c.commandsByID))
+	c.commandsLock.RUnlock()
+
+	c.datarefsLock.RLock()
+	data.Datarefs = slices.Collect(maps.Values(c.datarefsByID))
+	c.datarefsLock.RUnlock()
+
+	fileData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cache: %w", err)
+	}
+
+	return os.WriteFile(path, fileData, 0o644)
+}
+
+// LoadCacheFromFile replaces the cached commands and datarefs with those in a file written by
+// [Client.SaveCache].  Because IDs may change between simulator sessions, [Client.ValidateCache]
+// should be called afterward, and [Client.LoadCache] used instead if it fails.
+//
+//	if err := client.LoadCacheFromFile(cachePath); err == nil {
+//		err = client.ValidateCache(ctx)
+//	}
+//	if err != nil {
+//		if err := client.LoadCache(ctx); err != nil {
+//			return err
+//		}
+//		client.SaveCache(cachePath)
+//	}
+func (c *Client) LoadCacheFromFile(path string) error {
+	fileData, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	data := &cacheFile{}
+	if err := json.Unmarshal(fileData, data); err != nil {
+		return fmt.Errorf("failed to unmarshal cache: %w", err)
+	}
+
+	c.setCommands(data.Commands)
+	c.setDatarefs(data.Datarefs)
+	return nil
+}
+
+// ValidateCache checks a random sample of cached commands and datarefs against the running
+// simulator session.  If any of them have a different ID, or no longer exist, [ErrStaleCache] is
+// returned.
+func (c *Client) ValidateCache(ctx context.Context) error {
+	c.datarefsLock.RLock()
+	datarefs := sampleValues(c.datarefsByName, cacheValidationSamples)
+	c.datarefsLock.RUnlock()
+
+	for _, cached := range datarefs {
+		found, err := c.REST.FindDatarefs(ctx, cached.Name)
+		if err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(found, func(d *Dataref) bool {
+			return d.Name == cached.Name && d.ID == cached.ID
+		}) {
+			return fmt.Errorf("%w: dataref %s", ErrStaleCache, cached.Name)
+		}
+	}
+
+	c.commandsLock.RLock()
+	commands := sampleValues(c.commandsByName, cacheValidationSamples)
+	c.commandsLock.RUnlock()
+
+	for _, cached := range commands {
+		found, err := c.REST.FindCommands(ctx, cached.Name)
+		if err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(found, func(cmd *Command) bool {
+			return cmd.Name == cached.Name && cmd.ID == cached.ID
+		}) {
+			return fmt.Errorf("%w: command %s", ErrStaleCache, cached.Name)
+		}
+	}
+
+	return nil
+}
+
+// sampleValues returns up to n randomly selected values from the specified map.
+func sampleValues[K comparable, V any](m map[K]V, n int) []V {
+	values := slices.Collect(maps.Values(m))
+	rand.Shuffle(len(values), func(i, j int) {
+		values[i], values[j] = values[j], values[i]
+	})
+	return values[:min(n, len(values))]
+}
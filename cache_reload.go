@@ -0,0 +1,69 @@
+package xpweb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// cacheReloadTimeout limits how long an automatic cache reload may take.
+const cacheReloadTimeout time.Duration = 60 * time.Second
+
+// CacheReloadHandler is a function which is called after the cache has been automatically
+// reloaded due to a detected simulator restart.  The error value is the result of the reload.
+type CacheReloadHandler func(err error)
+
+// reloadCacheAsync starts an automatic cache reload in the background, if the client was
+// configured with AutoReloadCache and a reload is not already in progress.
+func (c *Client) reloadCacheAsync() {
+	if !c.autoReloadCache || !c.cacheReloading.CompareAndSwap(false, true) {
+		return
+	}
+
+	go c.reloadCache(context.Background())
+}
+
+// reloadCacheNow performs an automatic cache reload, if the client was configured with
+// AutoReloadCache and a reload is not already in progress, and returns once it is complete.
+func (c *Client) reloadCacheNow(ctx context.Context) {
+	if !c.autoReloadCache || !c.cacheReloading.CompareAndSwap(false, true) {
+		return
+	}
+	c.reloadCache(ctx)
+}
+
+// reloadCache reloads the cache and calls the configured CacheReloadHandler.  The caller must have
+// set cacheReloading.
+func (c *Client) reloadCache(ctx context.Context) {
+	defer c.cacheReloading.Store(false)
+
+	ctx, cancel := context.WithTimeout(ctx, cacheReloadTimeout)
+	defer cancel()
+
+	err := c.LoadCache(ctx)
+	if c.onCacheReload != nil {
+		c.onCacheReload(err)
+	}
+}
+
+// checkStaleID inspects an error returned from a request which referenced a cached ID.  If the
+// API reports that the ID does not exist, the simulator has likely been restarted and the cache
+// is reloaded.
+func (c *Client) checkStaleID(err error) {
+	var errorResp *ErrorResponse
+	if errors.As(err, &errorResp) && errorResp.StatusCode == http.StatusNotFound {
+		c.reloadCacheAsync()
+	}
+}
+
+// checkSimVersion records the simulator version reported by the capabilities endpoint.  If it
+// differs from the previously recorded version, the simulator has been restarted and the cache is
+// reloaded.
+func (c *Client) checkSimVersion(version string) {
+	prev := c.simVersion.Swap(&version)
+	if prev != nil && *prev != version {
+		c.resetAPIVersion()
+		c.reloadCacheAsync()
+	}
+}
@@ -20,5 +20,6 @@ func (c *RESTClient) GetCapabilities(ctx context.Context) (*Capabilities, error)
 	if err != nil {
 		return nil, err
 	}
+	c.client.checkSimVersion(capabilities.XPlane.Version)
 	return capabilities, nil
 }
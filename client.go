@@ -63,6 +63,10 @@
 //		return err
 //	}
 //
+// Lightweight tools which only use a handful of names may instead set LazyCache in the
+// [ClientConfig], in which case names are resolved individually via the REST API the first time
+// they are used.
+//
 // A significant portion of this package is broken up into REST-specific and websocket-specific
 // functionality.  While some of the more agnostic methods are available via the [Client] object,
 // much of the API functions used by calling applications will be done using either the [WSClient]
@@ -154,6 +158,11 @@
 //
 //	err := client.REST.ActivateCommand(ctx, command.SimElectrical_battery_1_on, 0)
 //
+// Applications which do not need to care whether REST or the websocket service is used may instead
+// use the transport-agnostic [Client.GetValue], [Client.SetValue], [Client.ActivateCommand],
+// [Client.Subscribe], and [Client.Unsubscribe] methods.  The [Transport] performing these
+// operations may be selected with [Client.SetTransport], e.g. a [MockTransport] for testing.
+//
 // To start using the websocket service, establish a connection.
 //
 //	if err := client.WS.Connect(); err != nil {
@@ -186,24 +195,83 @@ package xpweb
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"net/http"
 	"net/url"
 	"reflect"
 	"regexp"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultURLBase          string = "http://localhost:8086"
+	defaultBatchConcurrency int    = 4
 )
 
-const defaultURLBase string = "http://localhost:8086"
+// listingPageSize is the number of items requested per page when iterating over the datarefs or
+// commands listings.
+const listingPageSize int = 1000
+
+// lazyLookupTimeout limits how long an on-demand name lookup may take when LazyCache is enabled.
+const lazyLookupTimeout time.Duration = 5 * time.Second
+
+// lazyMissTTL is how long a name which was not found by an on-demand lookup is reported as not
+// found without looking it up again.
+const lazyMissTTL time.Duration = 30 * time.Second
 
 // Client is an X-Plane Web API client.
 type Client struct {
 	REST *RESTClient
 	WS   *WSClient
 
-	transport http.RoundTripper
+	batchConcurrency int
+	lazyCache        bool
+	datarefMisses    lazyMisses
+	commandMisses    lazyMisses
+	nameNormalizer   NameNormalizer
+
+	aliases     map[string]string
+	aliasesLock sync.RWMutex
+
+	commandKinds        commandKinds
+	commandHoldDuration float64
+
+	onRequest RequestHandler
+	tracer    Tracer
+	logger    *slog.Logger
+	retry     *RetryPolicy
+	limiter   *rateLimiter
+
+	credentials CredentialsProvider
+
+	election     *WriterElection
+	electionLock sync.Mutex
+
+	autoReloadCache bool
+	cacheReloading  atomic.Bool
+	onCacheReload   CacheReloadHandler
+	simVersion      atomic.Pointer[string]
+
+	apiVersion       string
+	apiVersionLock   sync.RWMutex
+	negotiateLock    sync.Mutex
+	pinnedAPIVersion bool
+
+	dataTransport     Transport
+	dataTransportLock sync.RWMutex
+
+	store      *Store
+	groups     map[string]*datarefGroup
+	groupsLock sync.Mutex
 
 	commandsByID   commandsIDMap
 	commandsByName commandsNameMap
@@ -216,8 +284,9 @@ type Client struct {
 
 // RestClient provides functions and attributes related to REST API operations.
 type RESTClient struct {
-	client *Client
-	url    *url.URL
+	client     *Client
+	httpClient *http.Client
+	url        *url.URL
 }
 
 // ClientConfig is a structure which may optionall be passed to NewClient().
@@ -233,6 +302,98 @@ type ClientConfig struct {
 	DatarefUpdateHandler DatarefUpdateHandler
 	// The handler function for result messages received from the websocket service.
 	ResultHandler ResultHandler
+	// An optional handler for messages of types which are not otherwise handled, such as those
+	// introduced by newer versions of X-Plane.  If unspecified, such messages are logged and
+	// dropped.
+	RawMessageHandler RawMessageHandler
+	// An optional Codec for websocket message payloads.  If unspecified, [JSONCodec] will be used.
+	Codec Codec
+	// An optional limit on the number of concurrent REST requests performed by batch operations
+	// such as [RESTClient.SetDatarefValues].  If unspecified, a default of 4 will be used.
+	BatchConcurrency int
+	// If true, names which are not found in the cache will be resolved on demand via the REST
+	// API, and the result added to the cache.  This allows lightweight tools to skip LoadCache.
+	// Names which are not found are not looked up again for 30 seconds, or until the cache is
+	// reloaded.
+	LazyCache bool
+	// An optional NameNormalizer, such as [NormalizeCase].  If specified, errors for names which
+	// cannot be found will suggest cached names which are near-miss matches.
+	NameNormalizer NameNormalizer
+	// Optional aliases to register, keyed by alias.  See [Client.RegisterAlias].
+	Aliases map[string]string
+	// If true, the cache will be automatically reloaded when a simulator restart is detected,
+	// either by the websocket reconnecting, the simulator version changing, or a cached ID no
+	// longer being recognized by the API.
+	AutoReloadCache bool
+	// An optional handler which is called after each automatic cache reload.
+	OnCacheReload CacheReloadHandler
+	// Optional command classifications to register, keyed by name or pattern.  See
+	// [Client.SetCommandKind].
+	CommandKinds map[string]CommandKind
+	// An optional duration, in seconds, for which [Client.TriggerCommand] holds commands
+	// classified as [CommandHeld].  If unspecified, a default of 1 second will be used.
+	CommandHoldDuration float64
+	// An optional handler which is called after each REST API call with its sizes and timing.
+	OnRequest RequestHandler
+	// An optional Tracer which creates spans for REST API calls and websocket requests.
+	Tracer Tracer
+	// An optional logger for messages from background activity such as the websocket read loop.
+	// Outbound and inbound websocket messages are logged at the debug level.  If unspecified,
+	// slog.Default() will be used.
+	Logger *slog.Logger
+	// An optional policy for retrying failed REST API requests, such as [DefaultRetryPolicy].  If
+	// unspecified, requests are not retried.
+	Retry *RetryPolicy
+	// An optional limit on the rate of REST API requests.  If unspecified, requests are not
+	// limited.
+	RateLimit *RateLimit
+	// An optional bound on how long [WSClient.Close] waits for results of in-flight websocket
+	// requests before closing the connection.  If unspecified, a default of 2 seconds will be used.
+	CloseTimeout time.Duration
+	// An optional time after which a websocket request which has not received a result is
+	// considered to have been dropped by the simulator, and is no longer tracked.  If unspecified,
+	// a default of 1 minute will be used.  See [WSClient.RequestStats].
+	ResultTimeout time.Duration
+	// An optional handler which is called for each websocket request which is discarded without
+	// receiving a result, either because the ResultTimeout elapsed or because too many newer
+	// requests are awaiting results.
+	OnOrphanedRequest OrphanedRequestHandler
+	// If true, websocket requests are sent without first being checked by [WSReq.Validate], such
+	// as to send requests which this package does not know are valid.
+	SkipRequestValidation bool
+	// If true, dataref update messages having values for datarefs which are not cached, such as
+	// those registered by a plugin after the cache was loaded, are held while the dataref listing
+	// is fetched via the REST API, so that handlers receive them with their Dataref values
+	// populated.  Later updates are held behind them, so that they are delivered in order.  If the
+	// listing cannot be fetched, or the IDs are not found, the updates are delivered with nil
+	// Dataref values, as when this is false.
+	BackfillDatarefs bool
+	// An optional API version, such as [APIVersion1], to use for all requests.  If unspecified,
+	// the version is negotiated with the simulator before the first API request.  See
+	// [Client.NegotiateAPIVersion].
+	APIVersion string
+	// An optional overall timeout for each REST API request attempt, including reading the
+	// response body.  If unspecified, requests are bounded only by their context.
+	HTTPTimeout time.Duration
+	// An optional TLS configuration for https and wss URLs, such as when the API is served
+	// behind an HTTPS reverse proxy.  It may specify custom RootCAs, client Certificates, or
+	// InsecureSkipVerify.  It applies to websocket dialing, and to REST requests unless a custom
+	// Transport is specified, in which case that transport's own TLS configuration is used.
+	TLSConfig *tls.Config
+	// An optional CredentialsProvider, such as [BasicAuth] or [BearerToken], which supplies an
+	// Authorization header for REST requests and the websocket handshake.
+	Credentials CredentialsProvider
+	// Optional headers, such as cookies, to send with the websocket handshake.  An Origin header,
+	// if specified, replaces the default origin of the REST API URL.
+	WSHeaders http.Header
+	// An optional proxy URL, with an http or https scheme, through which REST requests and the
+	// websocket connection are made.  If unspecified, the HTTP_PROXY, HTTPS_PROXY and NO_PROXY
+	// environment variables are honored.  It does not apply to REST requests if a custom
+	// Transport is specified.
+	ProxyURL string
+	// If true and no URL is specified, NewClient listens for the beacons of X-Plane instances on
+	// the local network, and targets the first master instance discovered.  See [Discover].
+	Discover bool
 }
 
 type commandsIDMap map[uint64]*Command
@@ -244,6 +405,8 @@ type datarefsNameMap map[string]*Dataref
 type ErrorResponse struct {
 	ErrorCode    string `json:"error_code"`
 	ErrorMessage string `json:"error_message"`
+	// The HTTP status code of the response.
+	StatusCode int `json:"-"`
 }
 
 // Error allows ErrorResponse to implement the error interface.
@@ -253,19 +416,85 @@ func (e ErrorResponse) Error() string {
 
 // NewClient instantiates and returns a pointer to a new [Client] object.
 func NewClient(config *ClientConfig) (client *Client, err error) {
+	if config == nil {
+		config = &ClientConfig{}
+	}
+
 	// defaults
 	apiURL := defaultURLBase
 	transport := http.DefaultTransport
+	batchConcurrency := defaultBatchConcurrency
+	lazyCache := false
+	var nameNormalizer NameNormalizer
+	commandHoldDuration := defaultCommandHoldDuration
+	logger := slog.Default()
+	closeTimeout := defaultCloseTimeout
+	resultTimeout := defaultResultTimeout
+	var onOrphanedRequest OrphanedRequestHandler
+	var codec Codec = JSONCodec{}
+	var tlsConfig *tls.Config
+	var wsHeaders http.Header
+	proxy := proxyFunc(http.ProxyFromEnvironment)
 
 	// config-specified values
-	if config != nil {
-		if config.URL != "" {
-			apiURL = config.URL
+	if config.URL != "" {
+		apiURL = config.URL
+	} else if config.Discover {
+		instance, err := discoverMaster(defaultDiscoverTimeout)
+		if err != nil {
+			return nil, err
+		}
+		apiURL = instance.URL()
+	}
+	if config.ProxyURL != "" {
+		proxy, err = newProxyFunc(config.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if config.TLSConfig != nil || config.ProxyURL != "" {
+		tlsConfig = config.TLSConfig
+		httpTransport, ok := http.DefaultTransport.(*http.Transport)
+		if ok {
+			httpTransport = httpTransport.Clone()
+		} else {
+			httpTransport = &http.Transport{}
 		}
-		if config.Transport != nil {
-			transport = config.Transport
+		httpTransport.Proxy = proxy
+		httpTransport.TLSClientConfig = tlsConfig
+		transport = httpTransport
+	}
+	if config.Transport != nil {
+		transport = config.Transport
+	}
+	// copy the headers via Add, so that their names are canonicalized
+	wsHeaders = make(http.Header)
+	for name, values := range config.WSHeaders {
+		for _, value := range values {
+			wsHeaders.Add(name, value)
 		}
 	}
+	if config.BatchConcurrency > 0 {
+		batchConcurrency = config.BatchConcurrency
+	}
+	lazyCache = config.LazyCache
+	nameNormalizer = config.NameNormalizer
+	if config.CommandHoldDuration > 0 {
+		commandHoldDuration = config.CommandHoldDuration
+	}
+	if config.Logger != nil {
+		logger = config.Logger
+	}
+	if config.CloseTimeout > 0 {
+		closeTimeout = config.CloseTimeout
+	}
+	if config.ResultTimeout > 0 {
+		resultTimeout = config.ResultTimeout
+	}
+	onOrphanedRequest = config.OnOrphanedRequest
+	if config.Codec != nil {
+		codec = config.Codec
+	}
 
 	// trim any trailing / off the URL
 	trailingSlashes := regexp.MustCompile("/+$")
@@ -282,23 +511,68 @@ func NewClient(config *ClientConfig) (client *Client, err error) {
 	}
 
 	client = &Client{
-		transport: transport,
+		batchConcurrency:    batchConcurrency,
+		lazyCache:           lazyCache,
+		nameNormalizer:      nameNormalizer,
+		aliases:             make(map[string]string),
+		commandKinds:        commandKinds{exact: make(map[string]CommandKind)},
+		commandHoldDuration: commandHoldDuration,
+		logger:              logger,
+		commandsByID:        make(commandsIDMap),
+		commandsByName:      make(commandsNameMap),
+		datarefsByID:        make(datarefsIDMap),
+		datarefsByName:      make(datarefsNameMap),
+	}
+
+	maps.Copy(client.aliases, config.Aliases)
+	client.autoReloadCache = config.AutoReloadCache
+	client.onCacheReload = config.OnCacheReload
+	client.onRequest = config.OnRequest
+	client.tracer = config.Tracer
+	client.retry = config.Retry
+	client.limiter = newRateLimiter(config.RateLimit)
+	client.credentials = config.Credentials
+	if config.APIVersion != "" {
+		client.apiVersion = config.APIVersion
+		client.pinnedAPIVersion = true
+	}
+	for name, kind := range config.CommandKinds {
+		client.SetCommandKind(name, kind)
 	}
 
 	client.REST = &RESTClient{
-		client: client,
-		url:    restURL,
+		client:     client,
+		httpClient: &http.Client{Transport: transport, Timeout: config.HTTPTimeout},
+		url:        restURL,
 	}
 
 	client.WS = &WSClient{
+		backfillDatarefs:     config.BackfillDatarefs,
 		commandUpdateHandler: config.CommandUpdateHandler,
 		datarefUpdateHandler: config.DatarefUpdateHandler,
 		client:               client,
-		reqHistory:           newReqHistory(),
+		closeTimeout:         closeTimeout,
+		codec:                codec,
+		reqHistory:           newReqHistory(resultTimeout, onOrphanedRequest),
+		tlsConfig:            tlsConfig,
+		headers:              wsHeaders,
+		proxy:                proxy,
+		rawMessageHandler:    config.RawMessageHandler,
+		skipValidation:       config.SkipRequestValidation,
 		resultHandler:        config.ResultHandler,
 		url:                  wsURL,
 	}
 
+	client.store = newStore(client)
+	client.groups = make(map[string]*datarefGroup)
+
+	restTransport := client.NewRESTTransport(0)
+	client.dataTransport = &SplitTransport{
+		Values:        restTransport,
+		Commands:      restTransport,
+		Subscriptions: client.NewWSTransport(),
+	}
+
 	return client, nil
 }
 
@@ -316,6 +590,27 @@ func getWebsocketURL(restURL *url.URL) (*url.URL, error) {
 	return &wsURL, nil
 }
 
+// rawResponse is a successful response received from the REST API.  Body is nil if the response
+// was decoded into a target as it was read.
+type rawResponse struct {
+	StatusCode int
+	Body       []byte
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += n
+	return n, err
+}
+
+// makeRequest performs a request against the REST API, and decodes the response body into the
+// target, if specified.
 func (xpc *RESTClient) makeRequest(
 	ctx context.Context,
 	method string,
@@ -323,78 +618,206 @@ func (xpc *RESTClient) makeRequest(
 	bodyObj any,
 	target any,
 ) error {
+	_, err := xpc.doRequest(ctx, method, path, bodyObj, target)
+	return err
+}
+
+// URL returns the base URL of the web API, such as http://localhost:8086.
+func (xpc *RESTClient) URL() string {
+	return xpc.url.String()
+}
+
+// DoRaw performs a request against an arbitrary path of the web server, such as an endpoint which
+// is new or provided by a plugin and is not otherwise modeled by this package.  The body, if not
+// nil, is marshaled as the JSON request body, and a successful response body is unmarshaled into
+// the target, if not nil.  An unsuccessful response is returned as an [ErrorResponse].  The path is
+// used as given; [Client.APIVersion] may be used to construct a path beneath the negotiated API
+// version.
+//
+//	var flight map[string]any
+//	err := client.REST.DoRaw(ctx, http.MethodGet, "/api/"+client.APIVersion()+"/flight", nil, &flight)
+func (xpc *RESTClient) DoRaw(
+	ctx context.Context,
+	method string,
+	path string,
+	body any,
+	target any,
+) error {
+	return xpc.makeRequest(ctx, method, path, body, target)
+}
+
+// makeAPIRequest performs a request against the specified endpoint beneath the root of the
+// negotiated API version, as described by makeRequest.
+func (xpc *RESTClient) makeAPIRequest(
+	ctx context.Context,
+	method string,
+	endpoint string,
+	bodyObj any,
+	target any,
+) error {
+	path, err := xpc.apiPath(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	return xpc.makeRequest(ctx, method, path, bodyObj, target)
+}
+
+// doRequest performs a request against the REST API, retrying according to the client's
+// [RetryPolicy], and returns the response if it was successful.  An unsuccessful response is
+// returned as an [ErrorResponse] if possible.  If a target is specified, a successful response
+// body is decoded into it as it is read, rather than being buffered in the returned response, so
+// that large listings are not held in memory twice.
+func (xpc *RESTClient) doRequest(
+	ctx context.Context,
+	method string,
+	path string,
+	bodyObj any,
+	target any,
+) (*rawResponse, error) {
+	return withRetry(ctx, xpc.client.retry, method, func() (*rawResponse, error) {
+		return xpc.doRequestOnce(ctx, method, path, bodyObj, target)
+	})
+}
+
+// doRequestOnce performs a single attempt of a request against the REST API.
+func (xpc *RESTClient) doRequestOnce(
+	ctx context.Context,
+	method string,
+	path string,
+	bodyObj any,
+	target any,
+) (raw *rawResponse, err error) {
+	info := &RequestInfo{Method: method, Path: path}
+
+	ctx, span := xpc.client.startRESTSpan(ctx, method, path)
+	if span != nil {
+		defer func() {
+			span.SetAttributes(Attribute{"http.response.status_code", info.StatusCode})
+			if err != nil {
+				span.RecordError(err)
+			}
+			span.End()
+		}()
+	}
+
+	if xpc.client.onRequest != nil {
+		start := time.Now()
+		defer func() {
+			info.Latency = time.Since(start)
+			info.Err = err
+			xpc.client.onRequest(info)
+		}()
+	}
+
+	if err := xpc.client.limiter.wait(ctx); err != nil {
+		return nil, err
+	}
+
 	// prepare body payload
 	var body io.Reader
 	if bodyObj != nil {
 		bodyData, err := json.Marshal(bodyObj)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+		info.BytesSent = len(bodyData)
 		body = bytes.NewBuffer(bodyData)
 	}
 
-	apiURL := xpc.url
-	apiURL.Path = path
+	// copy the base URL, so that concurrent requests do not race on its fields
+	apiURL := *xpc.url
+	apiURL.Path, apiURL.RawQuery, _ = strings.Cut(path, "?")
 
 	// perform request
 	request, err := http.NewRequestWithContext(ctx, method, apiURL.String(), body)
 	if err != nil {
-		return fmt.Errorf("failed to create new request: %w", err)
+		return nil, fmt.Errorf("failed to create new request: %w", err)
 	}
 
 	request.Header.Add("Accept", "application/json")
+	auth, err := xpc.client.authorization(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if auth != "" {
+		request.Header.Set("Authorization", auth)
+	}
 	if body != nil {
 		request.Header.Add("Content-Type", "application/json")
 	}
 
-	client := &http.Client{Transport: xpc.client.transport}
-
-	resp, err := client.Do(request)
+	resp, err := xpc.httpClient.Do(request)
 	if err != nil {
-		return fmt.Errorf("failed to perform request: %w", err)
+		return nil, fmt.Errorf("failed to perform request: %w", err)
 	}
 	defer resp.Body.Close()
+	info.StatusCode = resp.StatusCode
+	info.ServerTime = parseServerTiming(resp.Header)
 
 	if resp.StatusCode != 200 {
 		// attempt to unmarshal an error response body
 		errorData, err := io.ReadAll(resp.Body)
+		info.BytesReceived = len(errorData)
 		if err != nil {
-			return fmt.Errorf("response from API: %s (unable to read response body)",
+			return nil, fmt.Errorf("response from API: %s (unable to read response body)",
 				resp.Status)
 		}
-		errorResp := &ErrorResponse{}
+		errorResp := &ErrorResponse{StatusCode: resp.StatusCode}
 		err = json.Unmarshal(errorData, errorResp)
 		if err != nil {
-			return fmt.Errorf("response from API: %s (unable to unmarshal response body)",
+			return nil, fmt.Errorf("response from API: %s (unable to unmarshal response body)",
 				resp.Status)
 		}
 
 		// we were able to get a proper error object from the API, return it
-		return errorResp
+		return nil, errorResp
 	}
 
 	if target != nil {
-		bodyData, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return fmt.Errorf("unable to read response body: %w", err)
-		}
-
-		err = json.Unmarshal(bodyData, &target)
+		body := &countingReader{r: resp.Body}
+		err := json.NewDecoder(body).Decode(target)
+		// consume any trailing data so that the connection may be reused
+		io.Copy(io.Discard, body)
+		info.BytesReceived = body.n
 		if err != nil {
-			return fmt.Errorf("unable to unmarshal response into %s: %w",
+			return nil, fmt.Errorf("unable to unmarshal response into %s: %w",
 				reflect.TypeOf(target).String(), err)
 		}
+		return &rawResponse{StatusCode: resp.StatusCode}, nil
+	}
+
+	bodyData, err := io.ReadAll(resp.Body)
+	info.BytesReceived = len(bodyData)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response body: %w", err)
 	}
 
-	return nil
+	return &rawResponse{StatusCode: resp.StatusCode, Body: bodyData}, nil
 }
 
+// LoadCache negotiates the API version, then fetches the available commands and datarefs from the
+// simulator into the cache.  The commands and datarefs are fetched concurrently.  If the
+// negotiated API version does not provide commands, only datarefs are loaded.
 func (c *Client) LoadCache(ctx context.Context) error {
-	if err := c.loadCommands(ctx); err != nil {
+	if err := c.renegotiateAPIVersion(ctx); err != nil {
 		return err
 	}
-	if err := c.loadDatarefs(ctx); err != nil {
+	withCommands, err := c.supportsCommands(ctx)
+	if err != nil {
 		return err
 	}
-	return nil
+
+	var commandsErr error
+	var wg sync.WaitGroup
+	if withCommands {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			commandsErr = c.loadCommands(ctx)
+		}()
+	}
+	datarefsErr := c.loadDatarefs(ctx)
+	wg.Wait()
+
+	return errors.Join(commandsErr, datarefsErr)
 }
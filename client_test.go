@@ -0,0 +1,69 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestNewClientNilConfig(t *testing.T) {
+	client, err := NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.REST.url.String() != defaultURLBase {
+		t.Errorf("unexpected URL %s", client.REST.url)
+	}
+}
+
+// TestConcurrentGetDatarefValue checks that concurrent requests do not share request state, such
+// as the URL, and should be run with -race.
+func TestConcurrentGetDatarefValue(t *testing.T) {
+	const readers = 50
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// respond with the ID from the path, so that the caller can check it requested its own
+		var id uint64
+		if _, err := fmt.Sscanf(r.URL.Path, "/api/v2/datarefs/%d/value", &id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"data":%d}`, id)
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{URL: server.URL, APIVersion: APIVersion2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	datarefs := make([]*Dataref, readers)
+	for idx := range datarefs {
+		datarefs[idx] = &Dataref{
+			ID:        uint64(1000 + idx),
+			Name:      "sim/test/value_" + strconv.Itoa(idx),
+			ValueType: ValueTypeInt,
+		}
+	}
+	client.setDatarefs(datarefs)
+
+	var wg sync.WaitGroup
+	for _, dref := range datarefs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			val, err := client.REST.GetDatarefValue(context.Background(), dref.Name)
+			if err != nil {
+				t.Errorf("%s: %v", dref.Name, err)
+				return
+			}
+			if got := val.GetIntValue(); uint64(got) != dref.ID {
+				t.Errorf("%s: requested the path of ID %d, expected %d", dref.Name, got, dref.ID)
+			}
+		}()
+	}
+	wg.Wait()
+}
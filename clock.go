@@ -0,0 +1,122 @@
+package xpweb
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// simElapsedDataref is the dataref from which a [SimClock] reads simulator time.  It does not
+// advance while the simulator is paused.
+const simElapsedDataref = "sim/time/total_running_time_sec"
+
+// Clock measures elapsed time for timed operations such as scripted waits and recordings.
+type Clock interface {
+	// Elapsed returns the time elapsed since an arbitrary fixed point.
+	Elapsed() time.Duration
+	// Sleep waits until the specified duration has elapsed according to the clock, or the context
+	// is done.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// WallClock is a [Clock] which measures real time.
+var WallClock Clock = wallClock{start: time.Now()}
+
+type wallClock struct {
+	start time.Time
+}
+
+func (c wallClock) Elapsed() time.Duration {
+	return time.Since(c.start)
+}
+
+func (c wallClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SimClock is a [Clock] which measures simulator time, so that it stops while the simulator is
+// paused.  This keeps timed operations synchronized with the simulation rather than desynchronizing
+// when the user pauses.  Its resolution is limited by the rate of dataref updates.
+type SimClock struct {
+	elapsed time.Duration
+	changed chan struct{}
+	lock    sync.RWMutex
+}
+
+// NewSimClock subscribes to the simulator's running time using the selected [Transport], and
+// returns a [SimClock] once the first value has been received.  The clock stops advancing when the
+// context is done.
+//
+//	clock, err := client.NewSimClock(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	clock.Sleep(ctx, 30*time.Second) // 30 seconds of unpaused simulation
+func (c *Client) NewSimClock(ctx context.Context) (*SimClock, error) {
+	clock := &SimClock{changed: make(chan struct{})}
+	first := make(chan struct{})
+	var firstOnce sync.Once
+
+	err := c.Watch(ctx, simElapsedDataref, func(val *DatarefValue) {
+		clock.update(time.Duration(val.GetFloatValue() * float64(time.Second)))
+		firstOnce.Do(func() { close(first) })
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-first:
+		return clock, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// update records a new simulator time and wakes any sleepers.
+func (c *SimClock) update(elapsed time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.elapsed = elapsed
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// Elapsed returns the simulator's running time.
+func (c *SimClock) Elapsed() time.Duration {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.elapsed
+}
+
+// Sleep waits until the simulator's running time has advanced by the specified duration, or the
+// context is done.
+func (c *SimClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.lock.RLock()
+	target := c.elapsed + d
+	c.lock.RUnlock()
+
+	for {
+		c.lock.RLock()
+		elapsed, changed := c.elapsed, c.changed
+		c.lock.RUnlock()
+
+		// the running time resets if the simulator reloads, so treat a decrease as expiry
+		if elapsed >= target || elapsed < target-d {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,302 @@
+// Command instructor-station is an example instructor operating station, serving a simple web UI
+// for monitoring and repositioning the user's aircraft, injecting and clearing failures, and
+// changing the weather in a running X-Plane simulator.
+//
+// Usage:
+//
+//	instructor-station [-url URL] [-listen ADDR]
+//	instructor-station [-url URL] -check
+//
+// The UI is served at the listen address, default localhost:8080, along with the [gateway] API
+// under /sim/.  With -check, the station instead exercises each of the facades it uses once,
+// without modifying the simulator, and exits with a non-zero status if any of them fails, which
+// makes it usable as an integration test against a running simulator.
+package main
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/failures"
+	"github.com/janeprather/xpweb/gateway"
+	"github.com/janeprather/xpweb/names/command"
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+	"github.com/janeprather/xpweb/names/dataref/drefweather"
+)
+
+//go:embed index.html
+var indexHTML []byte
+
+// station holds the state shared by the HTTP handlers.
+type station struct {
+	client   *xpweb.Client
+	failures *failures.Failures
+}
+
+func main() {
+	apiURL := flag.String("url", "", "the URL to target, if not the default")
+	listen := flag.String("listen", "localhost:8080", "the address on which to serve the UI")
+	check := flag.Bool("check", false, "exercise each facade once and exit")
+	flag.Parse()
+
+	client, err := xpweb.NewClient(&xpweb.ClientConfig{URL: *apiURL, LazyCache: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	s := &station{client: client, failures: failures.New(client)}
+	if *check {
+		if err := s.check(ctx); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if err := client.WS.Connect(); err != nil {
+		log.Fatal(err)
+	}
+	defer client.WS.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle("/sim/", http.StripPrefix("/sim", gateway.New(client)))
+	mux.HandleFunc("GET /{$}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(indexHTML)
+	})
+	mux.HandleFunc("GET /api/position", s.getPosition)
+	mux.HandleFunc("POST /api/position", s.movePosition)
+	mux.HandleFunc("POST /api/pause", s.togglePause)
+	mux.HandleFunc("GET /api/failures", s.getFailures)
+	mux.HandleFunc("POST /api/failures", s.setFailure)
+	mux.HandleFunc("POST /api/failures/clear", s.clearFailures)
+	mux.HandleFunc("POST /api/weather", s.setWeather)
+
+	server := &http.Server{Addr: *listen, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("serving instructor station at http://%s/", *listen)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err)
+	}
+}
+
+// check reads from each facade used by the station, reporting each result.
+func (s *station) check(ctx context.Context) error {
+	var errs []error
+	report := func(name string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			fmt.Printf("FAIL %s: %s\n", name, err)
+			return
+		}
+		fmt.Printf("ok   %s\n", name)
+	}
+
+	_, err := s.client.Position(ctx)
+	report("position", err)
+	_, err = s.failures.List(ctx)
+	report("failures", err)
+	_, err = s.weather(ctx)
+	report("weather", err)
+	_, err = s.client.LookupCommand(command.SimOperation_pause_toggle)
+	report("pause command", err)
+	return errors.Join(errs...)
+}
+
+func (s *station) getPosition(w http.ResponseWriter, r *http.Request) {
+	pos, err := s.client.Position(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, pos)
+}
+
+// moveRequest is the body of a request to move the aircraft.
+type moveRequest struct {
+	// The distance to move the aircraft up, in meters, or down if negative.
+	ClimbMeters float64 `json:"climb_m"`
+}
+
+func (s *station) movePosition(w http.ResponseWriter, r *http.Request) {
+	req := &moveRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	val, err := s.client.GetValue(r.Context(), drefflightmodel.SimFlightmodelPosition_local_y)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	localY := val.GetFloat64Value() + req.ClimbMeters
+	err = s.client.SetValue(r.Context(), drefflightmodel.SimFlightmodelPosition_local_y, localY)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *station) togglePause(w http.ResponseWriter, r *http.Request) {
+	err := s.client.ActivateCommand(r.Context(), command.SimOperation_pause_toggle, 0)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// failureJSON is the JSON representation of a failure.
+type failureJSON struct {
+	Name   string `json:"name"`
+	System string `json:"system"`
+	Mode   int    `json:"mode"`
+	State  string `json:"state"`
+}
+
+func (s *station) getFailures(w http.ResponseWriter, r *http.Request) {
+	list, err := s.failures.List(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	body := make([]failureJSON, len(list))
+	for idx, failure := range list {
+		body[idx] = failureJSON{
+			Name:   failure.Name,
+			System: failure.System(),
+			Mode:   int(failure.Mode),
+			State:  failure.Mode.String(),
+		}
+	}
+	writeJSON(w, body)
+}
+
+func (s *station) setFailure(w http.ResponseWriter, r *http.Request) {
+	req := &failureJSON{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	err := s.failures.SetMode(r.Context(), req.Name, failures.Mode(req.Mode))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *station) clearFailures(w http.ResponseWriter, r *http.Request) {
+	if err := s.failures.ClearAll(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// weatherJSON is the JSON representation of the regional weather which the station controls.
+type weatherJSON struct {
+	VisibilitySM  float64 `json:"visibility_sm"`
+	PressureInHg  float64 `json:"pressure_inhg"`
+	RainPercent   float64 `json:"rain_percent"`
+	WindDirection float64 `json:"wind_direction"`
+	WindSpeedKts  float64 `json:"wind_speed_kts"`
+}
+
+const (
+	pascalsPerInHg = 3386.389
+	msPerKnot      = 0.514444
+)
+
+// weather reads the current regional weather.
+func (s *station) weather(ctx context.Context) (*weatherJSON, error) {
+	names := []string{
+		drefweather.SimWeatherRegion_visibility_reported_sm,
+		drefweather.SimWeatherRegion_sealevel_pressure_pas,
+		drefweather.SimWeatherRegion_rain_percent,
+		drefweather.SimWeatherRegion_wind_direction_degt,
+		drefweather.SimWeatherRegion_wind_speed_msc,
+	}
+	values := make(map[string]*xpweb.DatarefValue, len(names))
+	for _, name := range names {
+		val, err := s.client.GetValue(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		values[name] = val
+	}
+	first := func(name string) float64 {
+		if elems := values[name].GetFloatArrayValue(); len(elems) > 0 {
+			return elems[0]
+		}
+		return 0
+	}
+	return &weatherJSON{
+		VisibilitySM: values[drefweather.SimWeatherRegion_visibility_reported_sm].GetFloatValue(),
+		PressureInHg: values[drefweather.SimWeatherRegion_sealevel_pressure_pas].GetFloatValue() /
+			pascalsPerInHg,
+		RainPercent:   values[drefweather.SimWeatherRegion_rain_percent].GetFloatValue(),
+		WindDirection: first(drefweather.SimWeatherRegion_wind_direction_degt),
+		WindSpeedKts:  first(drefweather.SimWeatherRegion_wind_speed_msc) / msPerKnot,
+	}, nil
+}
+
+func (s *station) setWeather(w http.ResponseWriter, r *http.Request) {
+	req := &weatherJSON{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	err := s.client.REST.SetDatarefValues(ctx, map[string]any{
+		drefweather.SimWeatherRegion_visibility_reported_sm: req.VisibilitySM,
+		drefweather.SimWeatherRegion_sealevel_pressure_pas:  req.PressureInHg * pascalsPerInHg,
+		drefweather.SimWeatherRegion_rain_percent:           req.RainPercent,
+	})
+	if err == nil {
+		// the lowest wind layer is the surface wind
+		err = errors.Join(
+			s.client.REST.SetDatarefElementValue(ctx, drefweather.SimWeatherRegion_wind_direction_degt,
+				0, req.WindDirection),
+			s.client.REST.SetDatarefElementValue(ctx, drefweather.SimWeatherRegion_wind_speed_msc,
+				0, req.WindSpeedKts*msPerKnot),
+		)
+	}
+	if err == nil {
+		err = s.client.SetValue(ctx, drefweather.SimWeatherRegion_update_immediately, 1)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeJSON(w http.ResponseWriter, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	http.Error(w, err.Error(), http.StatusBadGateway)
+}
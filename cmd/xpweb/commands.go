@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"strconv"
+	"strings"
+)
+
+func runGet(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return usageError("get")
+	}
+	for _, name := range args {
+		val, err := a.client.REST.GetDatarefValue(ctx, name)
+		if err != nil {
+			return err
+		}
+		a.out.value(val)
+	}
+	return a.out.flush()
+}
+
+func runSet(ctx context.Context, a *app, args []string) error {
+	if len(args) != 2 {
+		return usageError("set")
+	}
+	value, err := a.client.ParseDatarefValue(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	return a.client.REST.SetDatarefValue(ctx, args[0], value)
+}
+
+func runCmd(ctx context.Context, a *app, args []string) error {
+	switch len(args) {
+	case 1:
+		return a.client.TriggerCommand(ctx, args[0])
+	case 2:
+		duration, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return usageError("cmd")
+		}
+		return a.client.REST.ActivateCommand(ctx, args[0], duration)
+	}
+	return usageError("cmd")
+}
+
+func runWatch(ctx context.Context, a *app, args []string) error {
+	if len(args) == 0 {
+		return usageError("watch")
+	}
+	a.client.ConnectWithFallback(0)
+	defer a.client.WS.Close()
+
+	updates, err := a.client.SubscribeFor(ctx, args...)
+	if err != nil {
+		return err
+	}
+	for msg := range updates {
+		for _, val := range msg.Data {
+			a.out.value(val)
+		}
+		if err := a.out.flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func runList(ctx context.Context, a *app, args []string) error {
+	flags := flag.NewFlagSet("list", flag.ContinueOnError)
+	filter := flags.String("filter", "", "list only names containing this substring")
+	if len(args) == 0 {
+		return usageError("list")
+	}
+	kind := args[0]
+	if err := flags.Parse(args[1:]); err != nil {
+		return usageError("list")
+	}
+	matches := func(name string) bool {
+		return strings.Contains(strings.ToLower(name), strings.ToLower(*filter))
+	}
+
+	switch kind {
+	case "datarefs":
+		for dref, err := range a.client.REST.DatarefsIter(ctx) {
+			if err != nil {
+				return err
+			}
+			if matches(dref.Name) {
+				a.out.dataref(dref)
+			}
+		}
+	case "commands":
+		for cmd, err := range a.client.REST.CommandsIter(ctx) {
+			if err != nil {
+				return err
+			}
+			if matches(cmd.Name) {
+				a.out.command(cmd)
+			}
+		}
+	default:
+		return usageError("list")
+	}
+	return a.out.flush()
+}
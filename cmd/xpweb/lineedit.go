@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// maxCompletionsShown limits the number of candidates listed when a completion is ambiguous.
+const maxCompletionsShown = 40
+
+// lineEditor reads lines from a terminal with history and tab-completion.  When standard input is
+// not a terminal, it falls back to reading plain lines.
+type lineEditor struct {
+	in       *bufio.Reader
+	out      io.Writer
+	prompt   string
+	complete func(word string) []string
+
+	history []string
+	raw     bool
+	saved   string
+
+	// the line being edited, guarded by lock so that asynchronous output can redraw it
+	buf  []rune
+	lock sync.Mutex
+}
+
+// newLineEditor puts the terminal into raw mode if possible and returns a lineEditor.  The
+// terminal must be restored by calling close.
+func newLineEditor(prompt string, complete func(word string) []string) *lineEditor {
+	e := &lineEditor{
+		in:       bufio.NewReader(os.Stdin),
+		out:      os.Stdout,
+		prompt:   prompt,
+		complete: complete,
+	}
+	if saved, err := stty("-g"); err == nil {
+		if _, err := stty("raw", "-echo"); err == nil {
+			e.raw, e.saved = true, strings.TrimSpace(saved)
+		}
+	}
+	return e
+}
+
+// stty runs the stty utility against the terminal attached to standard input.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
+
+// close restores the terminal to its original mode.
+func (e *lineEditor) close() {
+	if e.raw {
+		stty(e.saved)
+		e.raw = false
+	}
+}
+
+// Write writes output above the line being edited, so that asynchronous output such as watched
+// values does not corrupt the prompt.
+func (e *lineEditor) Write(p []byte) (int, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if !e.raw {
+		return e.out.Write(p)
+	}
+	text := strings.ReplaceAll(string(p), "\n", "\r\n")
+	fmt.Fprintf(e.out, "\r\x1b[K%s%s%s", text, e.prompt, string(e.buf))
+	return len(p), nil
+}
+
+// readLine reads a line, returning io.EOF when input ends or the user presses Ctrl-D on an empty
+// line.
+func (e *lineEditor) readLine() (string, error) {
+	if !e.raw {
+		fmt.Fprint(e.out, e.prompt)
+		line, err := e.in.ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	e.lock.Lock()
+	e.buf = e.buf[:0]
+	fmt.Fprint(e.out, e.prompt)
+	e.lock.Unlock()
+
+	historyPos := len(e.history)
+	for {
+		r, _, err := e.in.ReadRune()
+		if err != nil {
+			return "", err
+		}
+
+		e.lock.Lock()
+		switch r {
+		case '\r', '\n':
+			line := string(e.buf)
+			e.buf = e.buf[:0]
+			fmt.Fprint(e.out, "\r\n")
+			e.lock.Unlock()
+			if strings.TrimSpace(line) != "" {
+				e.history = append(e.history, line)
+			}
+			return line, nil
+		case 3: // Ctrl-C
+			e.buf = e.buf[:0]
+			fmt.Fprintf(e.out, "^C\r\n%s", e.prompt)
+		case 4: // Ctrl-D
+			if len(e.buf) == 0 {
+				fmt.Fprint(e.out, "\r\n")
+				e.lock.Unlock()
+				return "", io.EOF
+			}
+		case 127, 8: // backspace
+			if len(e.buf) > 0 {
+				e.buf = e.buf[:len(e.buf)-1]
+				fmt.Fprint(e.out, "\b \b")
+			}
+		case '\t':
+			e.completeWord()
+		case 27: // escape sequence, for the history arrows
+			seq := make([]byte, 2)
+			if _, err := io.ReadFull(e.in, seq); err == nil && seq[0] == '[' {
+				switch seq[1] {
+				case 'A':
+					historyPos = max(historyPos-1, 0)
+				case 'B':
+					historyPos = min(historyPos+1, len(e.history))
+				}
+				if seq[1] == 'A' || seq[1] == 'B' {
+					e.buf = e.buf[:0]
+					if historyPos < len(e.history) {
+						e.buf = append(e.buf, []rune(e.history[historyPos])...)
+					}
+					e.redraw()
+				}
+			}
+		default:
+			if r >= ' ' && r != utf8.RuneError {
+				e.buf = append(e.buf, r)
+				fmt.Fprint(e.out, string(r))
+			}
+		}
+		e.lock.Unlock()
+	}
+}
+
+// redraw rewrites the prompt and the line being edited.
+func (e *lineEditor) redraw() {
+	fmt.Fprintf(e.out, "\r\x1b[K%s%s", e.prompt, string(e.buf))
+}
+
+// completeWord completes the last word of the line being edited.  If there is a single candidate,
+// it is inserted; if there are several, their common prefix is inserted, or the candidates are
+// listed if there is no common prefix to add.
+func (e *lineEditor) completeWord() {
+	if e.complete == nil {
+		return
+	}
+	line := string(e.buf)
+	word := line[strings.LastIndex(line, " ")+1:]
+	candidates := e.complete(word)
+
+	switch len(candidates) {
+	case 0:
+		return
+	case 1:
+		e.buf = append(e.buf, []rune(candidates[0][len(word):]+" ")...)
+		e.redraw()
+		return
+	}
+
+	prefix := candidates[0]
+	for _, candidate := range candidates[1:] {
+		for !strings.HasPrefix(candidate, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	if len(prefix) > len(word) {
+		e.buf = append(e.buf, []rune(prefix[len(word):])...)
+		e.redraw()
+		return
+	}
+
+	shown := candidates[:min(len(candidates), maxCompletionsShown)]
+	fmt.Fprintf(e.out, "\r\n%s\r\n", strings.Join(shown, "\r\n"))
+	if len(candidates) > len(shown) {
+		fmt.Fprintf(e.out, "... and %d more\r\n", len(candidates)-len(shown))
+	}
+	e.redraw()
+}
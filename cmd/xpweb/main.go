@@ -0,0 +1,119 @@
+// Command xpweb is a command-line utility for reading and writing datarefs, activating commands,
+// and exploring the datarefs and commands available in a running X-Plane simulator.
+//
+// Usage:
+//
+//	xpweb [-url URL] [-json] <command> [arguments]
+//
+// The commands are:
+//
+//	get <dataref>...                   print the values of datarefs
+//	set <dataref> <value>              write the value of a dataref
+//	cmd <command> [duration]           activate a command
+//	watch <dataref>...                 print dataref values as they change
+//	list datarefs|commands [-filter S] list datarefs or commands
+//	repl                               start an interactive shell
+//
+// Output is printed as an aligned table, or as JSON if -json is specified.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+
+	"github.com/janeprather/xpweb"
+)
+
+// subcommand is a command which may be invoked from the command line.
+type subcommand struct {
+	usage string
+	run   func(ctx context.Context, app *app, args []string) error
+}
+
+var subcommands map[string]subcommand
+
+func init() {
+	subcommands = map[string]subcommand{
+		"get":   {"get <dataref>...", runGet},
+		"set":   {"set <dataref> <value>", runSet},
+		"cmd":   {"cmd <command> [duration]", runCmd},
+		"watch": {"watch <dataref>...", runWatch},
+		"list":  {"list datarefs|commands [-filter substring]", runList},
+		"repl":  {"repl", runREPL},
+	}
+}
+
+// app holds the state shared by all subcommands.
+type app struct {
+	client *xpweb.Client
+	out    *output
+	json   bool
+}
+
+func main() {
+	apiURL := flag.String("url", "", "the URL to target, if not the default")
+	jsonOutput := flag.Bool("json", false, "print output as JSON")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		usage()
+		os.Exit(2)
+	}
+	sub, exists := subcommands[flag.Arg(0)]
+	if !exists {
+		fmt.Fprintf(os.Stderr, "xpweb: unknown command %q\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+
+	client, err := xpweb.NewClient(&xpweb.ClientConfig{
+		URL:            *apiURL,
+		LazyCache:      true,
+		NameNormalizer: xpweb.NormalizeCase,
+	})
+	if err != nil {
+		fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	a := &app{client: client, out: newOutput(os.Stdout, *jsonOutput), json: *jsonOutput}
+	if err := sub.run(ctx, a, flag.Args()[1:]); err != nil && ctx.Err() == nil {
+		fatal(err)
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: xpweb [flags] <command> [arguments]\n\ncommands:\n")
+	for _, name := range slices.Sorted(maps.Keys(subcommands)) {
+		fmt.Fprintf(os.Stderr, "  %s\n", subcommands[name].usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nflags:\n")
+	flag.PrintDefaults()
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "xpweb: %s\n", err.Error())
+	os.Exit(1)
+}
+
+// usageError returns an error describing the correct usage of a subcommand.
+func usageError(name string) error {
+	return fmt.Errorf("usage: xpweb %s", subcommands[name].usage)
+}
+
+// formatValue returns a human-readable representation of a dataref value.
+func formatValue(val *xpweb.DatarefValue) string {
+	if val.Dataref != nil && val.Dataref.ValueType == xpweb.ValueTypeData {
+		return strings.TrimRight(val.GetStringValue(), "\x00")
+	}
+	return fmt.Sprint(val.Value)
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/janeprather/xpweb"
+)
+
+// output prints results either as an aligned table or as JSON, one object per line.
+type output struct {
+	json    *json.Encoder
+	table   *tabwriter.Writer
+	jsonErr error
+}
+
+func newOutput(w io.Writer, asJSON bool) *output {
+	if asJSON {
+		return &output{json: json.NewEncoder(w)}
+	}
+	return &output{table: tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)}
+}
+
+func (o *output) value(val *xpweb.DatarefValue) {
+	if o.json != nil {
+		o.encode(map[string]any{"name": val.Dataref.Name, "value": val.Value})
+		return
+	}
+	fmt.Fprintf(o.table, "%s\t%s\n", val.Dataref.Name, formatValue(val))
+}
+
+func (o *output) dataref(dref *xpweb.Dataref) {
+	if o.json != nil {
+		o.encode(dref)
+		return
+	}
+	writable := ""
+	if dref.IsWritable {
+		writable = "writable"
+	}
+	fmt.Fprintf(o.table, "%s\t%s\t%s\n", dref.Name, dref.ValueType, writable)
+}
+
+func (o *output) command(cmd *xpweb.Command) {
+	if o.json != nil {
+		o.encode(cmd)
+		return
+	}
+	fmt.Fprintf(o.table, "%s\t%s\n", cmd.Name, cmd.Description)
+}
+
+func (o *output) encode(v any) {
+	if o.jsonErr == nil {
+		o.jsonErr = o.json.Encode(v)
+	}
+}
+
+// flush writes any buffered table rows, and returns any error encountered while writing.
+func (o *output) flush() error {
+	if o.json != nil {
+		err := o.jsonErr
+		o.jsonErr = nil
+		return err
+	}
+	return o.table.Flush()
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/janeprather/xpweb"
+)
+
+// replCommands are the commands available in the REPL in addition to the subcommands.
+var replCommands = []string{"help", "quit", "unwatch"}
+
+// errQuit is returned by a REPL command to end the session.
+var errQuit = errors.New("quit")
+
+// repl holds the state of an interactive session.
+type repl struct {
+	app    *app
+	editor *lineEditor
+	names  []string
+
+	watches     map[string]context.CancelFunc
+	watchesLock sync.Mutex
+}
+
+// runREPL starts an interactive shell in which subcommands may be entered repeatedly, with
+// tab-completion of command, dataref, and command names, and history.  Values watched with watch
+// are printed as they change until they are stopped with unwatch.
+func runREPL(ctx context.Context, a *app, args []string) error {
+	fmt.Println("loading dataref and command names...")
+	if err := a.client.LoadCache(ctx); err != nil {
+		return err
+	}
+	a.client.ConnectWithFallback(0)
+	defer a.client.WS.Close()
+
+	r := &repl{app: a, watches: make(map[string]context.CancelFunc)}
+	r.loadNames(ctx)
+	r.editor = newLineEditor("xpweb> ", r.complete)
+	defer r.editor.close()
+	defer r.unwatch(nil)
+
+	a.out = newOutput(r.editor, a.json)
+	fmt.Fprintln(r.editor, "type help for a list of commands")
+
+	for {
+		line, err := r.editor.readLine()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if err := r.run(ctx, fields[0], fields[1:]); err != nil {
+			if errors.Is(err, errQuit) {
+				return nil
+			}
+			fmt.Fprintf(r.editor, "error: %s\n", err.Error())
+		}
+	}
+}
+
+// loadNames collects the names offered for tab-completion.
+func (r *repl) loadNames(ctx context.Context) {
+	names := append(slices.Collect(maps.Keys(subcommands)), replCommands...)
+	names = append(names, "datarefs", "commands")
+	for dref, err := range r.app.client.REST.DatarefsIter(ctx) {
+		if err != nil {
+			break
+		}
+		names = append(names, dref.Name)
+	}
+	for cmd, err := range r.app.client.REST.CommandsIter(ctx) {
+		if err != nil {
+			break
+		}
+		names = append(names, cmd.Name)
+	}
+	slices.Sort(names)
+	r.names = slices.Compact(names)
+}
+
+// complete returns the known names beginning with the specified prefix.
+func (r *repl) complete(prefix string) []string {
+	start, _ := slices.BinarySearch(r.names, prefix)
+	var matches []string
+	for _, name := range r.names[start:] {
+		if !strings.HasPrefix(name, prefix) {
+			break
+		}
+		matches = append(matches, name)
+	}
+	return matches
+}
+
+// run performs a single command entered in the REPL.
+func (r *repl) run(ctx context.Context, name string, args []string) error {
+	switch name {
+	case "help":
+		for _, sub := range slices.Sorted(maps.Keys(subcommands)) {
+			if sub != "repl" {
+				fmt.Fprintf(r.editor, "  %s\n", subcommands[sub].usage)
+			}
+		}
+		fmt.Fprintf(r.editor, "  unwatch [dataref]...\n  quit\n")
+		return nil
+	case "quit", "exit":
+		return errQuit
+	case "watch":
+		return r.watch(ctx, args)
+	case "unwatch":
+		r.unwatch(args)
+		return nil
+	case "repl":
+		return errors.New("already in the REPL")
+	}
+
+	sub, exists := subcommands[name]
+	if !exists {
+		return fmt.Errorf("unknown command %q", name)
+	}
+	return sub.run(ctx, r.app, args)
+}
+
+// watch starts printing the values of the specified datarefs in the background.
+func (r *repl) watch(ctx context.Context, names []string) error {
+	if len(names) == 0 {
+		return usageError("watch")
+	}
+	r.watchesLock.Lock()
+	defer r.watchesLock.Unlock()
+
+	for _, name := range names {
+		if _, exists := r.watches[name]; exists {
+			continue
+		}
+		watchCtx, cancel := context.WithCancel(ctx)
+		err := r.app.client.Watch(watchCtx, name, func(val *xpweb.DatarefValue) {
+			fmt.Fprintf(r.editor, "%s = %s\n", val.Dataref.Name, formatValue(val))
+		})
+		if err != nil {
+			cancel()
+			return err
+		}
+		r.watches[name] = cancel
+	}
+	return nil
+}
+
+// unwatch stops watching the specified datarefs, or all datarefs if none are specified.
+func (r *repl) unwatch(names []string) {
+	r.watchesLock.Lock()
+	defer r.watchesLock.Unlock()
+
+	if len(names) == 0 {
+		names = slices.Collect(maps.Keys(r.watches))
+	}
+	for _, name := range names {
+		if cancel, exists := r.watches[name]; exists {
+			cancel()
+			delete(r.watches, name)
+		}
+	}
+}
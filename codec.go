@@ -0,0 +1,62 @@
+package xpweb
+
+import (
+	"encoding/json"
+
+	"golang.org/x/net/websocket"
+)
+
+// Codec marshals and unmarshals the payloads of websocket messages.  The default codec uses
+// encoding/json, but a faster JSON library such as jsoniter or sonic may be substituted by setting
+// Codec in the [ClientConfig]:
+//
+//	type sonicCodec struct{}
+//
+//	func (sonicCodec) Marshal(v any) ([]byte, error)      { return sonic.Marshal(v) }
+//	func (sonicCodec) Unmarshal(data []byte, v any) error { return sonic.Unmarshal(data, v) }
+//
+// Values are marshalled and unmarshalled according to their json struct tags.  Message types do
+// not depend on custom UnmarshalJSON methods when decoded through a Codec, so those methods are
+// not a bottleneck for libraries which do not call them.
+//
+// A Codec may also transform the encoded payload, such as to compress messages exchanged with a
+// proxy which decompresses them.  Such codecs should implement [BinaryCodec], so that requests are
+// sent in binary rather than text frames.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// BinaryCodec is implemented by a [Codec] whose output is not UTF-8 text.
+type BinaryCodec interface {
+	Codec
+	// Binary returns whether payloads must be sent in binary frames.
+	Binary() bool
+}
+
+// JSONCodec is the default [Codec], which uses encoding/json.
+type JSONCodec struct{}
+
+// Marshal returns the JSON encoding of v.
+func (JSONCodec) Marshal(v any) ([]byte, error) { return json.Marshal(v) }
+
+// Unmarshal decodes JSON data into v.
+func (JSONCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+
+// websocketCodec returns a websocket.Codec which sends and receives frames with the specified
+// codec.
+func websocketCodec(codec Codec) websocket.Codec {
+	frameType := byte(websocket.TextFrame)
+	if binary, ok := codec.(BinaryCodec); ok && binary.Binary() {
+		frameType = websocket.BinaryFrame
+	}
+	return websocket.Codec{
+		Marshal: func(v any) ([]byte, byte, error) {
+			data, err := codec.Marshal(v)
+			return data, frameType, err
+		},
+		Unmarshal: func(data []byte, _ byte, v any) error {
+			return codec.Unmarshal(data, v)
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package xpweb
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// defaultCommandHoldDuration is the duration, in seconds, for which [Client.TriggerCommand]
+// activates a command classified as [CommandHeld].
+const defaultCommandHoldDuration float64 = 1
+
+// CommandKind classifies how a command must be activated to take effect.  Sending the wrong
+// pattern silently does nothing on many commands, such as a starter which is only engaged
+// momentarily.
+type CommandKind int
+
+const (
+	// CommandMomentary is a command which takes effect from a single instantaneous activation.
+	CommandMomentary CommandKind = iota
+	// CommandToggle is a command which flips some state with each instantaneous activation.
+	CommandToggle
+	// CommandHeld is a command which takes effect only for as long as it is held active, such as
+	// a starter or trim wheel.
+	CommandHeld
+)
+
+// String returns the name of the command kind.
+func (k CommandKind) String() string {
+	switch k {
+	case CommandMomentary:
+		return "momentary"
+	case CommandToggle:
+		return "toggle"
+	case CommandHeld:
+		return "held"
+	}
+	return "unknown"
+}
+
+// builtinCommandKinds classifies common X-Plane commands by name pattern.  They are consulted
+// after any classifications registered by the application, in order.
+var builtinCommandKinds = []struct {
+	pattern string
+	kind    CommandKind
+}{
+	{"*_toggle*", CommandToggle},
+	{"*engage_starter*", CommandHeld},
+	{"sim/starters/*", CommandHeld},
+	{"sim/flight_controls/*trim*", CommandHeld},
+	{"sim/flight_controls/brakes_*", CommandHeld},
+	{"sim/flight_controls/*_up", CommandHeld},
+	{"sim/flight_controls/*_down", CommandHeld},
+	{"sim/flight_controls/*_left", CommandHeld},
+	{"sim/flight_controls/*_right", CommandHeld},
+	{"sim/engines/throttle_up*", CommandHeld},
+	{"sim/engines/throttle_down*", CommandHeld},
+	{"*push_to_talk*", CommandHeld},
+}
+
+// commandKindRule matches command names against a pattern.
+type commandKindRule struct {
+	pattern *regexp.Regexp
+	kind    CommandKind
+}
+
+// commandKinds holds the command classifications registered by the application.
+type commandKinds struct {
+	exact map[string]CommandKind
+	rules []commandKindRule
+	lock  sync.RWMutex
+}
+
+var builtinCommandKindRules = func() []commandKindRule {
+	rules := make([]commandKindRule, 0, len(builtinCommandKinds))
+	for _, builtin := range builtinCommandKinds {
+		rules = append(rules, commandKindRule{compileCommandPattern(builtin.pattern), builtin.kind})
+	}
+	return rules
+}()
+
+// compileCommandPattern converts a pattern, in which * matches any sequence of characters
+// including slashes, to a regular expression.
+func compileCommandPattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for idx, part := range parts {
+		parts[idx] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// SetCommandKind classifies the commands matching the specified name, which may contain *
+// wildcards matching any sequence of characters, as the specified kind.  Exact names take
+// precedence over patterns, and patterns registered later take precedence over those registered
+// earlier and over the built-in classifications.
+//
+//	client.SetCommandKind("laminar/B738/push_button/*", xpweb.CommandHeld)
+//	client.SetCommandKind("laminar/B738/toggle_switch/beacon", xpweb.CommandToggle)
+func (c *Client) SetCommandKind(name string, kind CommandKind) {
+	c.commandKinds.lock.Lock()
+	defer c.commandKinds.lock.Unlock()
+
+	if !strings.Contains(name, "*") {
+		c.commandKinds.exact[name] = kind
+		return
+	}
+	rule := commandKindRule{compileCommandPattern(name), kind}
+	c.commandKinds.rules = append([]commandKindRule{rule}, c.commandKinds.rules...)
+}
+
+// CommandKind returns the classification of the command with the specified name.  Commands which
+// match no classification are considered [CommandMomentary].
+func (c *Client) CommandKind(name string) CommandKind {
+	name = c.resolveAlias(name)
+
+	c.commandKinds.lock.RLock()
+	defer c.commandKinds.lock.RUnlock()
+
+	if kind, ok := c.commandKinds.exact[name]; ok {
+		return kind
+	}
+	for _, rules := range [][]commandKindRule{c.commandKinds.rules, builtinCommandKindRules} {
+		for _, rule := range rules {
+			if rule.pattern.MatchString(name) {
+				return rule.kind
+			}
+		}
+	}
+	return CommandMomentary
+}
+
+// TriggerCommand activates the command with the specified name using the semantics of its
+// [CommandKind]: momentary and toggle commands are activated instantaneously, and held commands
+// are held for the CommandHoldDuration configured in the [ClientConfig].
+func (c *Client) TriggerCommand(ctx context.Context, name string) error {
+	var duration float64
+	if c.CommandKind(name) == CommandHeld {
+		duration = c.commandHoldDuration
+	}
+	return c.ActivateCommand(ctx, name, duration)
+}
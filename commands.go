@@ -1,9 +1,14 @@
 package xpweb
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 type commandsResponse struct {
@@ -32,7 +37,50 @@ type Command struct {
 // GetCommands fetches and returns a list of available commands from the simulator.
 func (c *RESTClient) GetCommands(ctx context.Context) ([]*Command, error) {
 	commandsResp := &commandsResponse{}
-	err := c.makeRequest(ctx, http.MethodGet, "/api/v2/commands", nil, commandsResp)
+	err := c.makeAPIRequest(ctx, http.MethodGet, "/commands", nil, commandsResp)
+	if err != nil {
+		return nil, err
+	}
+	return commandsResp.Data, nil
+}
+
+// CommandsIter returns an iterator over the available commands, which are fetched from the
+// simulator a page at a time rather than as a single listing.  If an error occurs, it is yielded
+// with a nil [Command] and iteration stops.
+func (c *RESTClient) CommandsIter(ctx context.Context) iter.Seq2[*Command, error] {
+	return func(yield func(*Command, error) bool) {
+		for start := 0; ; start += listingPageSize {
+			query := url.Values{
+				"start": {strconv.Itoa(start)},
+				"limit": {strconv.Itoa(listingPageSize)},
+			}
+			commandsResp := &commandsResponse{}
+			err := c.makeAPIRequest(ctx, http.MethodGet, "/commands?"+query.Encode(), nil,
+				commandsResp)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, cmd := range commandsResp.Data {
+				if !yield(cmd, nil) {
+					return
+				}
+			}
+			if len(commandsResp.Data) < listingPageSize {
+				return
+			}
+		}
+	}
+}
+
+// FindCommands fetches the commands matching the specified name filter from the simulator.  This
+// allows individual commands to be resolved without fetching the full listing via GetCommands.
+// The cache is not modified.
+func (c *RESTClient) FindCommands(ctx context.Context, nameFilter string) ([]*Command, error) {
+	query := url.Values{"filter[name]": {nameFilter}}
+	commandsResp := &commandsResponse{}
+	err := c.makeAPIRequest(ctx, http.MethodGet, "/commands?"+query.Encode(), nil,
+		commandsResp)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +90,7 @@ func (c *RESTClient) GetCommands(ctx context.Context) ([]*Command, error) {
 // GetCommandsCount returns the number of total commands available.
 func (c *RESTClient) GetCommandsCount(ctx context.Context) (int, error) {
 	commandsCountResp := &commandsCountResponse{}
-	err := c.makeRequest(ctx, http.MethodGet, "/api/v2/commands/count", nil, commandsCountResp)
+	err := c.makeAPIRequest(ctx, http.MethodGet, "/commands/count", nil, commandsCountResp)
 	if err != nil {
 		return 0, err
 	}
@@ -62,19 +110,55 @@ func (c *Client) GetCommandByID(id uint64) (cmd *Command) {
 }
 
 // GetCommandByName returns the [Command] object with the specified name.  If no such command
-// is cached, a value of nil will be returned.
+// is cached, a value of nil will be returned, unless the client was configured with LazyCache, in
+// which case the command will be looked up via the REST API and cached.  The name may be an alias
+// registered with [Client.RegisterAlias].
 func (c *Client) GetCommandByName(name string) (cmd *Command) {
+	name = c.resolveAlias(name)
+
 	c.commandsLock.RLock()
-	defer c.commandsLock.RUnlock()
+	cmd = c.commandsByName[name]
+	c.commandsLock.RUnlock()
 
-	if command, exists := c.commandsByName[name]; exists {
-		cmd = command
+	if cmd == nil && c.lazyCache {
+		cmd = c.resolveCommand(name)
 	}
 	return
 }
 
+// resolveCommand looks up a single command by name via the REST API and adds it to the cache.
+// Names which are not found are remembered for a while, and not looked up again meanwhile.
+func (c *Client) resolveCommand(name string) *Command {
+	if c.commandMisses.missed(name) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), lazyLookupTimeout)
+	defer cancel()
+
+	commands, err := c.REST.FindCommands(ctx, name)
+	if err != nil {
+		return nil
+	}
+
+	c.commandsLock.Lock()
+	defer c.commandsLock.Unlock()
+
+	for _, command := range commands {
+		if command.Name == name {
+			c.commandsByID[command.ID] = command
+			c.commandsByName[command.Name] = command
+			return command
+		}
+	}
+	c.commandMisses.add(name)
+	return nil
+}
+
 // GetCommandID returns the ID of the [Command] with the specified name.  If no such command
 // is found, a value of zero is returned.
+//
+// Deprecated: A zero ID is silently sent to the simulator if the name is misspelled.  Use
+// [Client.LookupCommand] or [Client.Command], which return errors.
 func (c *Client) GetCommandID(name string) (id uint64) {
 	if cmd := c.GetCommandByName(name); cmd != nil {
 		id = cmd.ID
@@ -94,40 +178,180 @@ func (c *Client) GetCommandName(id uint64) (name string) {
 // loadCommands should be called after the client is instantiated, to populate a cache of command
 // ID mappings.
 func (c *Client) loadCommands(ctx context.Context) error {
-	c.commandsLock.Lock()
-	defer c.commandsLock.Unlock()
-
 	commands, err := c.REST.GetCommands(ctx)
 	if err != nil {
 		return err
 	}
 
-	c.commandsByID = make(commandsIDMap)
-	c.commandsByName = make(commandsNameMap)
+	c.setCommands(commands)
+	return nil
+}
+
+// setCommands replaces the cache of command ID and name mappings with the specified commands.
+func (c *Client) setCommands(commands []*Command) {
+	c.commandsLock.Lock()
+	defer c.commandsLock.Unlock()
+
+	c.commandsByID = make(commandsIDMap, len(commands))
+	c.commandsByName = make(commandsNameMap, len(commands))
 
 	for _, command := range commands {
 		c.commandsByID[command.ID] = command
 		c.commandsByName[command.Name] = command
 	}
+	c.commandMisses.reset()
+}
 
+// MaxCommandDuration is the longest duration, in seconds, for which the simulator will activate a
+// command.  Use [Client.HoldCommand] to hold a command active for longer.
+const MaxCommandDuration = 10
+
+// validateDuration returns an error matching [ErrInvalidDuration] if the specified command
+// activation duration is outside the range accepted by the simulator.
+func validateDuration(duration float64) error {
+	if !(duration >= 0 && duration <= MaxCommandDuration) {
+		return fmt.Errorf("%w: %v must be between 0 and %d seconds", ErrInvalidDuration, duration,
+			MaxCommandDuration)
+	}
 	return nil
 }
 
+// HoldCommand begins holding the command with the specified name active indefinitely, until
+// [Client.ReleaseCommand] is called.  Indefinite holds cannot be expressed via the REST API, so
+// this is performed with a command_set_is_active request over the websocket, which must be
+// connected.
+func (c *Client) HoldCommand(ctx context.Context, name string) error {
+	return c.WS.setCommandActive(ctx, name, true, nil)
+}
+
+// ReleaseCommand stops holding the command with the specified name active.
+func (c *Client) ReleaseCommand(ctx context.Context, name string) error {
+	return c.WS.setCommandActive(ctx, name, false, nil)
+}
+
+// PressCommand triggers the command with the specified name on and off immediately, as with a
+// momentary button press, using a command_set_is_active request with a zero duration.
+func (wsc *WSClient) PressCommand(name string) error {
+	return wsc.setCommandActive(context.Background(), name, true, ptr(0.0))
+}
+
+// BeginCommand begins holding the command with the specified name active, using a
+// command_set_is_active request without a duration.  The command remains active until
+// [WSClient.EndCommand] is called.
+func (wsc *WSClient) BeginCommand(name string) error {
+	return wsc.setCommandActive(context.Background(), name, true, nil)
+}
+
+// EndCommand stops holding the command with the specified name active.
+func (wsc *WSClient) EndCommand(name string) error {
+	return wsc.setCommandActive(context.Background(), name, false, nil)
+}
+
+// setCommandActive sends a command_set_is_active request for the command with the specified name,
+// with the specified duration if it is not nil.  It returns early if the context is done before
+// the request has been written.
+func (wsc *WSClient) setCommandActive(
+	ctx context.Context,
+	name string,
+	isActive bool,
+	duration *float64,
+) error {
+	if err := wsc.client.checkWriter(); err != nil {
+		return err
+	}
+	cmd, err := wsc.client.LookupCommand(name)
+	if err != nil {
+		return err
+	}
+	wsCmd := &WSCommand{ID: cmd.ID, IsActive: isActive, Duration: duration}
+	return wsc.NewReq().CommandSetIsActive(wsCmd).SendContext(ctx)
+}
+
 // ActivateCommand runs a command for a fixed duration. A zero duration will cause the command to
-// be triggered on and off immediately but not be held down.  The maximum duration is 10 seconds.
+// be triggered on and off immediately but not be held down.  The maximum duration is
+// [MaxCommandDuration], and an error matching [ErrInvalidDuration] is returned for a duration
+// outside that range.
 func (c *RESTClient) ActivateCommand(ctx context.Context, name string, duration float64) error {
-	command := c.client.GetCommandByName(name)
-	if command == nil {
-		return fmt.Errorf("no such command: %s", name)
+	_, err := c.ActivateCommandResult(ctx, name, duration)
+	return err
+}
+
+// CommandResult describes the outcome of a command activation performed via the REST API.
+type CommandResult struct {
+	// The command which was activated.
+	Command *Command
+	// The HTTP status code of the response.
+	StatusCode int
+	// The duration, in seconds, which was requested.
+	RequestedDuration float64
+	// The duration, in seconds, which the simulator reported applying.  If the response did not
+	// include a duration, this is the requested duration.
+	Duration float64
+	// Whether the simulator applied a different duration than was requested.
+	Clamped bool
+	// The raw response body, if any.
+	Body json.RawMessage
+}
+
+// commandActivateResponse is the acknowledgment which may be returned by the command activation
+// endpoint.  The duration is accepted either at the top level or within data.
+type commandActivateResponse struct {
+	Duration *float64 `json:"duration"`
+	Data     *struct {
+		Duration *float64 `json:"duration"`
+	} `json:"data"`
+}
+
+// ActivateCommandResult activates the specified command for the specified duration like
+// [RESTClient.ActivateCommand], and returns a [CommandResult] describing the response, so that
+// callers can distinguish accepted activations from those whose duration was clamped.
+func (c *RESTClient) ActivateCommandResult(
+	ctx context.Context,
+	name string,
+	duration float64,
+) (*CommandResult, error) {
+	if err := c.client.checkWriter(); err != nil {
+		return nil, err
+	}
+	if err := validateDuration(duration); err != nil {
+		return nil, err
+	}
+	command, err := c.client.LookupCommand(name)
+	if err != nil {
+		return nil, err
 	}
 
-	path := fmt.Sprintf("/api/v2/command/%d/activate", command.ID)
+	path, err := c.apiPath(ctx, fmt.Sprintf("/command/%d/activate", command.ID))
+	if err != nil {
+		return nil, err
+	}
 	payload := &commandPost{Duration: duration}
 
-	err := c.makeRequest(ctx, http.MethodPost, path, payload, nil)
+	resp, err := c.doRequest(ctx, http.MethodPost, path, payload, nil)
 	if err != nil {
-		return err
+		c.client.checkStaleID(err)
+		return nil, err
 	}
 
-	return nil
+	result := &CommandResult{
+		Command:           command,
+		StatusCode:        resp.StatusCode,
+		RequestedDuration: duration,
+		Duration:          duration,
+	}
+	if len(bytes.TrimSpace(resp.Body)) > 0 {
+		result.Body = resp.Body
+		ack := &commandActivateResponse{}
+		if json.Unmarshal(resp.Body, ack) == nil {
+			switch {
+			case ack.Duration != nil:
+				result.Duration = *ack.Duration
+			case ack.Data != nil && ack.Data.Duration != nil:
+				result.Duration = *ack.Data.Duration
+			}
+		}
+	}
+	result.Clamped = result.Duration != duration
+
+	return result, nil
 }
@@ -0,0 +1,24 @@
+package xpweb
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestHoldCommandCancelled(t *testing.T) {
+	client, err := NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.setCommands([]*Command{{ID: 1, Name: "sim/test/command"}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := client.HoldCommand(ctx, "sim/test/command"); !errors.Is(err, context.Canceled) {
+		t.Errorf("HoldCommand: unexpected error %v", err)
+	}
+	if err := client.ReleaseCommand(ctx, "sim/test/command"); !errors.Is(err, context.Canceled) {
+		t.Errorf("ReleaseCommand: unexpected error %v", err)
+	}
+}
@@ -0,0 +1,129 @@
+package xpweb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+const (
+	// confirmTimeout is how long SetDatarefValueConfirmed waits for a written value to be read
+	// back, if the context has no earlier deadline.
+	confirmTimeout = time.Second
+	// confirmInterval is the interval between read backs while confirming a written value.
+	confirmInterval = 50 * time.Millisecond
+)
+
+// SetDatarefValueConfirmed writes the specified value to the dataref with the specified name using
+// the selected [Transport], and then reads the value back until it matches, to confirm that the
+// simulator accepted it.  Numeric values match if each element is within the specified tolerance
+// of the written value; values of float datarefs are first rounded to 32-bit precision, so a
+// tolerance of zero confirms an exact write.  Data values must match exactly.
+//
+// Some datarefs accept writes but do not retain them, because they are read-only in practice or
+// overridden by the simulator or a plugin.  If the value does not match within one second, or
+// before the context is done, an error matching [ErrWriteNotConfirmed] is returned.
+func (c *Client) SetDatarefValueConfirmed(
+	ctx context.Context,
+	name string,
+	value any,
+	tolerance float64,
+) error {
+	dref, err := c.LookupDataref(name)
+	if err != nil {
+		return err
+	}
+	if err := c.SetValue(ctx, name, value); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, confirmTimeout)
+	defer cancel()
+	ticker := time.NewTicker(confirmInterval)
+	defer ticker.Stop()
+
+	var last *DatarefValue
+	for {
+		got, err := c.GetValue(ctx, name)
+		if err == nil {
+			if valueMatches(dref.ValueType, value, got, tolerance) {
+				return nil
+			}
+			last = got
+		}
+
+		select {
+		case <-ctx.Done():
+			if last == nil {
+				return fmt.Errorf("%w: %s: %w", ErrWriteNotConfirmed, dref.Name, err)
+			}
+			return fmt.Errorf("%w: %s: wrote %v, read back %v", ErrWriteNotConfirmed, dref.Name,
+				value, last.Value)
+		case <-ticker.C:
+		}
+	}
+}
+
+// valueMatches returns whether a value read from a dataref of the specified type matches the
+// written value, within the specified tolerance for numeric values.  The read value may be decoded
+// JSON, or a Go value provided by a [MockTransport].
+func valueMatches(valueType ValueType, want any, got *DatarefValue, tolerance float64) bool {
+	switch want := want.(type) {
+	case string:
+		return bytes.Equal([]byte(want), got.GetByteArrayValue())
+	case []byte:
+		return bytes.Equal(want, got.GetByteArrayValue())
+	}
+
+	narrow := valueType == ValueTypeFloat || valueType == ValueTypeFloatArray
+	numMatches := func(want, got reflect.Value) bool {
+		wantNum, wantOK := numericValue(want)
+		gotNum, gotOK := numericValue(got)
+		if !wantOK || !gotOK {
+			return false
+		}
+		if narrow {
+			wantNum, gotNum = float64(float32(wantNum)), float64(float32(gotNum))
+		}
+		return math.Abs(wantNum-gotNum) <= tolerance
+	}
+
+	wantRV, gotRV := reflect.ValueOf(want), reflect.ValueOf(got.Value)
+	if !isList(wantRV) {
+		return numMatches(wantRV, gotRV)
+	}
+	if !isList(gotRV) || gotRV.Len() != wantRV.Len() {
+		return false
+	}
+	for idx := range wantRV.Len() {
+		if !numMatches(wantRV.Index(idx), gotRV.Index(idx)) {
+			return false
+		}
+	}
+	return true
+}
+
+// isList returns whether the specified value is a slice or array.
+func isList(rv reflect.Value) bool {
+	return rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array
+}
+
+// numericValue returns the value of the specified integer or float, which may be wrapped in an
+// interface, as a float64.
+func numericValue(rv reflect.Value) (float64, bool) {
+	if rv.Kind() == reflect.Interface {
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,99 @@
+package xpweb
+
+import (
+	"context"
+	"slices"
+)
+
+// ConnState describes the state of a websocket connection.
+type ConnState int32
+
+const (
+	// ConnDisconnected indicates that there is no websocket connection, and none is being attempted.
+	ConnDisconnected ConnState = iota
+	// ConnConnecting indicates that an initial connection attempt is in progress.
+	ConnConnecting
+	// ConnConnected indicates that the websocket connection is established.
+	ConnConnected
+	// ConnReconnecting indicates that an established connection was lost and is being
+	// re-established in the background.
+	ConnReconnecting
+)
+
+// stateChangesBuffer is the channel buffer size for channels returned by WSClient.StateChanges.
+const stateChangesBuffer = 16
+
+// String returns a lowercase name for the connection state.
+func (s ConnState) String() string {
+	switch s {
+	case ConnDisconnected:
+		return "disconnected"
+	case ConnConnecting:
+		return "connecting"
+	case ConnConnected:
+		return "connected"
+	case ConnReconnecting:
+		return "reconnecting"
+	default:
+		return "unknown"
+	}
+}
+
+// State returns the current state of the websocket connection.
+func (wsc *WSClient) State() ConnState {
+	return ConnState(wsc.state.Load())
+}
+
+// stateWatcher is a channel returned by WSClient.StateChanges, and a function which stops its
+// removal once the context is done.
+type stateWatcher struct {
+	ch   chan ConnState
+	stop func() bool
+}
+
+// StateChanges returns a channel which receives each subsequent connection state transition, until
+// the context is done or [WSClient.Close] is called, after which the channel is closed.  The
+// channel is buffered; if a consumer falls behind, transitions are dropped rather than blocking the
+// connection, so State should be consulted when an accurate current value is required.
+func (wsc *WSClient) StateChanges(ctx context.Context) <-chan ConnState {
+	watcher := &stateWatcher{ch: make(chan ConnState, stateChangesBuffer)}
+	wsc.stateLock.Lock()
+	defer wsc.stateLock.Unlock()
+	wsc.stateWatchers = append(wsc.stateWatchers, watcher)
+	watcher.stop = context.AfterFunc(ctx, func() {
+		wsc.stateLock.Lock()
+		defer wsc.stateLock.Unlock()
+		if idx := slices.Index(wsc.stateWatchers, watcher); idx >= 0 {
+			wsc.stateWatchers = slices.Delete(wsc.stateWatchers, idx, idx+1)
+			close(watcher.ch)
+		}
+	})
+	return watcher.ch
+}
+
+// closeStateWatchers closes the channels returned by StateChanges.
+func (wsc *WSClient) closeStateWatchers() {
+	wsc.stateLock.Lock()
+	defer wsc.stateLock.Unlock()
+	for _, watcher := range wsc.stateWatchers {
+		watcher.stop()
+		close(watcher.ch)
+	}
+	wsc.stateWatchers = nil
+}
+
+// setState records a connection state transition and notifies any StateChanges consumers.
+func (wsc *WSClient) setState(state ConnState) {
+	wsc.stateLock.Lock()
+	defer wsc.stateLock.Unlock()
+	if ConnState(wsc.state.Swap(int32(state))) == state {
+		return
+	}
+	wsc.client.logger.Debug("websocket connection state changed", "state", state.String())
+	for _, watcher := range wsc.stateWatchers {
+		select {
+		case watcher.ch <- state:
+		default:
+		}
+	}
+}
@@ -0,0 +1,267 @@
+// Package controls drives the aircraft's primary controls, such as the yoke, rudder, throttle, and
+// toe brakes, from an application rather than the user's hardware.
+//
+// The simulator continually writes control positions from its joystick input, so an application
+// must first take control of an axis by setting the corresponding override dataref.  While an
+// override is set, the simulator ignores the hardware for that axis, so a program which exits
+// without clearing it leaves the control frozen.  A [Controls] object therefore tracks the
+// overrides it sets, and clears them when the context passed to Take is done, or when Close is
+// called.
+//
+//	ctl := controls.New(client)
+//	defer ctl.Close()
+//	if err := ctl.Take(ctx, controls.AxisPitch, controls.AxisRoll); err != nil {
+//		return err
+//	}
+//	err := ctl.Set(ctx, controls.AxisPitch, -0.2)
+//
+// The client's cache must be loaded, or LazyCache enabled.
+package controls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/dataref/drefcockpit2"
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+	"github.com/janeprather/xpweb/names/dataref/drefjoystick"
+	"github.com/janeprather/xpweb/names/dataref/drefoperation"
+)
+
+// releaseTimeout is the time allowed for clearing overrides on Close or context cancellation.
+const releaseTimeout = 5 * time.Second
+
+// ErrNotTaken is returned when an axis is set without first taking control of it.
+var ErrNotTaken = errors.New("control axis not taken")
+
+// Axis is a control axis which may be driven by a [Controls] object.
+type Axis int
+
+const (
+	// The yoke or stick pitch, from -1 (full nose down) to 1 (full nose up).
+	AxisPitch Axis = iota
+	// The yoke or stick roll, from -1 (full left) to 1 (full right).
+	AxisRoll
+	// The rudder, from -1 (full left) to 1 (full right).
+	AxisYaw
+	// The throttle of all engines, from 0 (idle) to 1 (full).
+	AxisThrottle
+	// The left toe brake, from 0 (released) to 1 (full).
+	AxisLeftBrake
+	// The right toe brake, from 0 (released) to 1 (full).
+	AxisRightBrake
+)
+
+// axisInfo describes the datarefs and range of an axis.
+type axisInfo struct {
+	value    string
+	override string
+	min      float64
+}
+
+var axisInfos = map[Axis]axisInfo{
+	AxisPitch: {
+		drefjoystick.SimJoystick_yoke_pitch_ratio,
+		drefoperation.SimOperationOverride_override_joystick_pitch, -1,
+	},
+	AxisRoll: {
+		drefjoystick.SimJoystick_yoke_roll_ratio,
+		drefoperation.SimOperationOverride_override_joystick_roll, -1,
+	},
+	AxisYaw: {
+		drefjoystick.SimJoystick_yoke_heading_ratio,
+		drefoperation.SimOperationOverride_override_joystick_heading, -1,
+	},
+	AxisThrottle: {
+		drefflightmodel.SimFlightmodelEngine_ENGN_thro_use,
+		drefoperation.SimOperationOverride_override_throttles, 0,
+	},
+	AxisLeftBrake: {
+		drefcockpit2.SimCockpit2Controls_left_brake_ratio,
+		drefoperation.SimOperationOverride_override_toe_brakes, 0,
+	},
+	AxisRightBrake: {
+		drefcockpit2.SimCockpit2Controls_right_brake_ratio,
+		drefoperation.SimOperationOverride_override_toe_brakes, 0,
+	},
+}
+
+// String returns the name of the axis.
+func (a Axis) String() string {
+	switch a {
+	case AxisPitch:
+		return "pitch"
+	case AxisRoll:
+		return "roll"
+	case AxisYaw:
+		return "yaw"
+	case AxisThrottle:
+		return "throttle"
+	case AxisLeftBrake:
+		return "left brake"
+	case AxisRightBrake:
+		return "right brake"
+	}
+	return fmt.Sprintf("Axis(%d)", int(a))
+}
+
+// Controls drives control axes of the user's aircraft, and clears the overrides it sets when it
+// is done.
+type Controls struct {
+	client *xpweb.Client
+
+	lock sync.Mutex
+	// the axes which have been taken
+	taken map[Axis]bool
+	// the number of taken axes using each override dataref
+	overrides map[string]int
+	// the number of engines, read when the throttle is taken
+	numEngines int
+}
+
+// New instantiates and returns a pointer to a new [Controls] object which uses the specified
+// client.
+func New(client *xpweb.Client) *Controls {
+	return &Controls{
+		client:    client,
+		taken:     make(map[Axis]bool),
+		overrides: make(map[string]int),
+	}
+}
+
+// Take sets the override datarefs for the specified axes, so that their values may be set with
+// [Controls.Set].  Control is held until [Controls.Release] or [Controls.Close] is called, or the
+// context is done, at which point the overrides are cleared.
+func (c *Controls) Take(ctx context.Context, axes ...Axis) error {
+	for _, axis := range axes {
+		if err := c.take(ctx, axis); err != nil {
+			return err
+		}
+		context.AfterFunc(ctx, func() {
+			releaseCtx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+			defer cancel()
+			c.Release(releaseCtx, axis)
+		})
+	}
+	return nil
+}
+
+func (c *Controls) take(ctx context.Context, axis Axis) error {
+	info, ok := axisInfos[axis]
+	if !ok {
+		return fmt.Errorf("unknown axis: %s", axis)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.taken[axis] {
+		return nil
+	}
+
+	if axis == AxisThrottle {
+		val, err := c.client.GetValue(ctx, info.value)
+		if err != nil {
+			return err
+		}
+		c.numEngines = len(val.GetFloatArrayValue())
+	}
+	if c.overrides[info.override] == 0 {
+		if err := c.client.SetValue(ctx, info.override, 1); err != nil {
+			return fmt.Errorf("taking %s: %w", axis, err)
+		}
+	}
+	c.overrides[info.override]++
+	c.taken[axis] = true
+	return nil
+}
+
+// Set sets the value of the specified axis, which must have been taken.  The value must be within
+// the range of the axis.
+func (c *Controls) Set(ctx context.Context, axis Axis, value float64) error {
+	info, ok := axisInfos[axis]
+	if !ok {
+		return fmt.Errorf("unknown axis: %s", axis)
+	}
+	if value < info.min || value > 1 {
+		return fmt.Errorf("invalid %s value %v: must be between %v and 1", axis, value, info.min)
+	}
+
+	c.lock.Lock()
+	taken, numEngines := c.taken[axis], c.numEngines
+	c.lock.Unlock()
+	if !taken {
+		return fmt.Errorf("%w: %s", ErrNotTaken, axis)
+	}
+
+	if axis == AxisThrottle {
+		throttles := make([]float64, numEngines)
+		for idx := range throttles {
+			throttles[idx] = value
+		}
+		return c.client.SetValue(ctx, info.value, throttles)
+	}
+	return c.client.SetValue(ctx, info.value, value)
+}
+
+// Get returns the current value of the specified axis.  For the throttle, the value of the first
+// engine is returned.
+func (c *Controls) Get(ctx context.Context, axis Axis) (float64, error) {
+	info, ok := axisInfos[axis]
+	if !ok {
+		return 0, fmt.Errorf("unknown axis: %s", axis)
+	}
+	val, err := c.client.GetValue(ctx, info.value)
+	if err != nil {
+		return 0, err
+	}
+	if axis == AxisThrottle {
+		throttles := val.GetFloatArrayValue()
+		if len(throttles) == 0 {
+			return 0, nil
+		}
+		return throttles[0], nil
+	}
+	return val.GetFloatValue(), nil
+}
+
+// Release clears the override datarefs for the specified axes, returning them to the user's
+// hardware.  Axes which have not been taken are ignored.
+func (c *Controls) Release(ctx context.Context, axes ...Axis) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	var errs []error
+	for _, axis := range axes {
+		if !c.taken[axis] {
+			continue
+		}
+		override := axisInfos[axis].override
+		if c.overrides[override] == 1 {
+			if err := c.client.SetValue(ctx, override, 0); err != nil {
+				errs = append(errs, fmt.Errorf("releasing %s: %w", axis, err))
+				continue
+			}
+		}
+		c.overrides[override]--
+		delete(c.taken, axis)
+	}
+	return errors.Join(errs...)
+}
+
+// Close releases all taken axes.
+func (c *Controls) Close() error {
+	c.lock.Lock()
+	taken := make([]Axis, 0, len(c.taken))
+	for axis := range c.taken {
+		taken = append(taken, axis)
+	}
+	c.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	return c.Release(ctx, taken...)
+}
@@ -4,7 +4,15 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"iter"
+	"maps"
+	"math"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 type datarefsResponse struct {
@@ -20,6 +28,8 @@ type Dataref struct {
 	Name string `json:"name"`
 	// The type of the dataref value(s).
 	ValueType ValueType `json:"value_type"`
+	// Whether the value of the dataref may be set.
+	IsWritable bool `json:"is_writable"`
 }
 
 type datarefsCountResponse struct {
@@ -48,19 +58,45 @@ const (
 
 // DatarefValue is a type-agnostic object containing a dataref value.  The ValueType attribute may
 // be checked if necessary, and an appropriate method may be called to return the typed value.
-//   - float - DatarefValue.GetFloatValue
-//   - double - DatarefValue.GetFloatValue
+//   - float - DatarefValue.GetFloat32Value
+//   - double - DatarefValue.GetFloat64Value
 //   - int - DatarefValue.GetIntValue
 //   - int_array - DatarefValue.GetIntArrayValue
 //   - float_array - DatarefValue.GetFloatArrayValue
 //   - data - DatarefValue.GetByteArrayValue or DatarefValue.GetStringValue
+//
+// Value holds the decoded JSON of the value, except that numeric arrays received in websocket
+// dataref updates are a []float64 rather than a []any, to avoid boxing each element.  The methods
+// above accept either.
+//
+// Values delivered in dataref updates also carry a Typed value, decoded according to the cached
+// ValueType of the dataref, so that handlers may use a type switch rather than inspecting the raw
+// decoded JSON:
+//   - float, double - float64
+//   - int - int
+//   - int_array - []int
+//   - float_array - []float64
+//   - data - []byte
+//
+// Typed is nil if the dataref is not in the cache, if the raw value does not match its ValueType,
+// or if the value was computed by a [WatchOption] such as [WithSmoothing] or [WithDerivative].
 type DatarefValue struct {
 	Dataref *Dataref
 	Value   any
+	Typed   any
 }
 
-// GetFloatValue returns a float32 dataref value.
+// GetFloatValue returns a float or double dataref value as a float64.  It is equivalent to
+// GetFloat64Value.
 func (v *DatarefValue) GetFloatValue() float64 {
+	return v.GetFloat64Value()
+}
+
+// GetFloat64Value returns a float or double dataref value as a float64.  Values of float datarefs
+// are 32-bit in the simulator, so the result is exactly representable as a float32, but may print
+// with spurious digits (a stored 0.1 reads back as 0.10000000149011612).  Use GetFloat32Value for
+// float datarefs when comparing against literals.
+func (v *DatarefValue) GetFloat64Value() float64 {
 	if v != nil {
 		if x, ok := v.Value.(float64); ok {
 			return x
@@ -69,6 +105,13 @@ func (v *DatarefValue) GetFloatValue() float64 {
 	return 0
 }
 
+// GetFloat32Value returns a float dataref value as a float32, which is the simulator's native
+// precision for float datarefs.  The conversion is exact for values read from float datarefs, and
+// rounds to the nearest float32 for values read from double datarefs.
+func (v *DatarefValue) GetFloat32Value() float32 {
+	return float32(v.GetFloat64Value())
+}
+
 // GetIntValue returns an int dataref value.
 func (v *DatarefValue) GetIntValue() int {
 	if v != nil {
@@ -82,8 +125,15 @@ func (v *DatarefValue) GetIntValue() int {
 // GetIntArrayValue returns an int slice dataref value.
 func (v *DatarefValue) GetIntArrayValue() []int {
 	if v != nil {
-		if x, ok := v.Value.([]any); ok {
-			var val []int
+		if x, ok := v.Value.([]float64); ok && len(x) > 0 {
+			val := make([]int, len(x))
+			for idx, item := range x {
+				val[idx] = int(item)
+			}
+			return val
+		}
+		if x, ok := v.Value.([]any); ok && len(x) > 0 {
+			val := make([]int, 0, len(x))
 			for _, itemV := range x {
 				if item, ok := itemV.(float64); ok {
 					val = append(val, int(item))
@@ -98,11 +148,15 @@ func (v *DatarefValue) GetIntArrayValue() []int {
 	return nil
 }
 
-// GetFloatArrayValue returns a float slice dataref value.
+// GetFloatArrayValue returns a float slice dataref value.  A value received in a websocket
+// dataref update is returned without copying, so must not be modified.
 func (v *DatarefValue) GetFloatArrayValue() []float64 {
 	if v != nil {
-		if x, ok := v.Value.([]any); ok {
-			var val []float64
+		if x, ok := v.Value.([]float64); ok && len(x) > 0 {
+			return x
+		}
+		if x, ok := v.Value.([]any); ok && len(x) > 0 {
+			val := make([]float64, 0, len(x))
 			for _, itemV := range x {
 				if item, ok := itemV.(float64); ok {
 					val = append(val, item)
@@ -136,10 +190,89 @@ func (v *DatarefValue) GetStringValue() string {
 	return string(v.GetByteArrayValue())
 }
 
+// decodeTyped sets the Typed value according to the ValueType of the dataref.
+func (v *DatarefValue) decodeTyped() {
+	if v.Dataref == nil {
+		return
+	}
+	switch v.Dataref.ValueType {
+	case ValueTypeFloat, ValueTypeDouble:
+		if x, ok := v.Value.(float64); ok {
+			v.Typed = x
+		}
+	case ValueTypeInt:
+		if x, ok := v.Value.(float64); ok {
+			v.Typed = int(x)
+		}
+	case ValueTypeIntArray:
+		if x := v.GetIntArrayValue(); x != nil {
+			v.Typed = x
+		}
+	case ValueTypeFloatArray:
+		if x := v.GetFloatArrayValue(); x != nil {
+			v.Typed = x
+		}
+	case ValueTypeData:
+		if x := v.GetByteArrayValue(); x != nil {
+			v.Typed = x
+		}
+	}
+}
+
 // GetDatarefs fetches and returns a list of available datarefs from the simulator.
 func (c *RESTClient) GetDatarefs(ctx context.Context) ([]*Dataref, error) {
 	datarefsResp := &datarefsResponse{}
-	err := c.makeRequest(ctx, http.MethodGet, "/api/v2/datarefs", nil, datarefsResp)
+	err := c.makeAPIRequest(ctx, http.MethodGet, "/datarefs", nil, datarefsResp)
+	if err != nil {
+		return nil, err
+	}
+	return datarefsResp.Data, nil
+}
+
+// DatarefsIter returns an iterator over the available datarefs, which are fetched from the
+// simulator a page at a time rather than as a single listing.  If an error occurs, it is yielded
+// with a nil [Dataref] and iteration stops.
+//
+//	for dref, err := range client.REST.DatarefsIter(ctx) {
+//		if err != nil {
+//			return err
+//		}
+//		fmt.Println(dref.Name)
+//	}
+func (c *RESTClient) DatarefsIter(ctx context.Context) iter.Seq2[*Dataref, error] {
+	return func(yield func(*Dataref, error) bool) {
+		for start := 0; ; start += listingPageSize {
+			query := url.Values{
+				"start": {strconv.Itoa(start)},
+				"limit": {strconv.Itoa(listingPageSize)},
+			}
+			datarefsResp := &datarefsResponse{}
+			err := c.makeAPIRequest(ctx, http.MethodGet, "/datarefs?"+query.Encode(), nil,
+				datarefsResp)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for _, dref := range datarefsResp.Data {
+				if !yield(dref, nil) {
+					return
+				}
+			}
+			if len(datarefsResp.Data) < listingPageSize {
+				return
+			}
+		}
+	}
+}
+
+// FindDatarefs fetches the datarefs matching the specified name filter from the simulator.  This
+// allows individual datarefs to be resolved without fetching the full listing via GetDatarefs.
+// The cache is not modified.
+func (c *RESTClient) FindDatarefs(ctx context.Context, nameFilter string) ([]*Dataref, error) {
+	query := url.Values{"filter[name]": {nameFilter}}
+	datarefsResp := &datarefsResponse{}
+	err := c.makeAPIRequest(ctx, http.MethodGet, "/datarefs?"+query.Encode(), nil,
+		datarefsResp)
 	if err != nil {
 		return nil, err
 	}
@@ -149,7 +282,7 @@ func (c *RESTClient) GetDatarefs(ctx context.Context) ([]*Dataref, error) {
 // GetDatarefsCount returns the number of total datarefs available.
 func (c *RESTClient) GetDatarefsCount(ctx context.Context) (int, error) {
 	datarefsCountResp := &datarefsCountResponse{}
-	err := c.makeRequest(ctx, http.MethodGet, "/api/v2/datarefs/count", nil, datarefsCountResp)
+	err := c.makeAPIRequest(ctx, http.MethodGet, "/datarefs/count", nil, datarefsCountResp)
 	if err != nil {
 		return 0, err
 	}
@@ -169,19 +302,55 @@ func (c *Client) GetDatarefByID(id uint64) (dref *Dataref) {
 }
 
 // GetDatarefByName returns the [Dataref] object with the specified name.  If no such dataref is
-// cached, a value of nil will be returned.
+// cached, a value of nil will be returned, unless the client was configured with LazyCache, in
+// which case the dataref will be looked up via the REST API and cached.  The name may be an alias
+// registered with [Client.RegisterAlias].
 func (c *Client) GetDatarefByName(name string) (dref *Dataref) {
+	name = c.resolveAlias(name)
+
 	c.datarefsLock.RLock()
-	defer c.datarefsLock.RUnlock()
+	dref = c.datarefsByName[name]
+	c.datarefsLock.RUnlock()
 
-	if dataref, exists := c.datarefsByName[name]; exists {
-		dref = dataref
+	if dref == nil && c.lazyCache {
+		dref = c.resolveDataref(name)
 	}
 	return
 }
 
+// resolveDataref looks up a single dataref by name via the REST API and adds it to the cache.
+// Names which are not found are remembered for a while, and not looked up again meanwhile.
+func (c *Client) resolveDataref(name string) *Dataref {
+	if c.datarefMisses.missed(name) {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), lazyLookupTimeout)
+	defer cancel()
+
+	datarefs, err := c.REST.FindDatarefs(ctx, name)
+	if err != nil {
+		return nil
+	}
+
+	c.datarefsLock.Lock()
+	defer c.datarefsLock.Unlock()
+
+	for _, dataref := range datarefs {
+		if dataref.Name == name {
+			c.datarefsByID[dataref.ID] = dataref
+			c.datarefsByName[dataref.Name] = dataref
+			return dataref
+		}
+	}
+	c.datarefMisses.add(name)
+	return nil
+}
+
 // GetDatarefID returns the ID of the [Dataref] with the specified name.  If no such dataref
 // is found, an value of zero is returned.
+//
+// Deprecated: A zero ID is silently sent to the simulator if the name is misspelled.  Use
+// [Client.LookupDataref], or [Client.Dataref] or the typed API in package xp, which return errors.
 func (c *Client) GetDatarefID(name string) (id uint64) {
 	if dref := c.GetDatarefByName(name); dref != nil {
 		id = dref.ID
@@ -198,40 +367,55 @@ func (c *Client) GetDatarefName(id uint64) (name string) {
 	return
 }
 
+// IsDatarefWritable returns whether the [Dataref] with the specified name may have its value set.
+// If no such dataref is found, a value of false is returned.
+func (c *Client) IsDatarefWritable(name string) bool {
+	if dref := c.GetDatarefByName(name); dref != nil {
+		return dref.IsWritable
+	}
+	return false
+}
+
 // loadDatarefs should be called after the client is instantiated, to populate a cache of dataref
 // ID and name mappings.
 func (xpc *Client) loadDatarefs(ctx context.Context) error {
-	xpc.datarefsLock.Lock()
-	defer xpc.datarefsLock.Unlock()
-
 	datarefs, err := xpc.REST.GetDatarefs(ctx)
 	if err != nil {
 		return err
 	}
 
-	xpc.datarefsByID = make(datarefsIDMap)
-	xpc.datarefsByName = make(datarefsNameMap)
+	xpc.setDatarefs(datarefs)
+	return nil
+}
+
+// setDatarefs replaces the cache of dataref ID and name mappings with the specified datarefs.
+func (xpc *Client) setDatarefs(datarefs []*Dataref) {
+	xpc.datarefsLock.Lock()
+	defer xpc.datarefsLock.Unlock()
+
+	xpc.datarefsByID = make(datarefsIDMap, len(datarefs))
+	xpc.datarefsByName = make(datarefsNameMap, len(datarefs))
 
 	for _, dataref := range datarefs {
 		xpc.datarefsByID[dataref.ID] = dataref
 		xpc.datarefsByName[dataref.Name] = dataref
 	}
-
-	return nil
+	xpc.datarefMisses.reset()
 }
 
 // GetDatarefValue returns a type-agnostic DatarefValue object containing the value of the dataref
 // with the specified name.
 func (c *RESTClient) GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error) {
-	dref := c.client.GetDatarefByName(name)
-	if dref == nil {
-		return nil, fmt.Errorf("no such dataref: %s", name)
+	dref, err := c.client.LookupDataref(name)
+	if err != nil {
+		return nil, err
 	}
 
-	path := fmt.Sprintf("/api/v2/datarefs/%d/value", dref.ID)
+	endpoint := fmt.Sprintf("/datarefs/%d/value", dref.ID)
 	datarefValueResp := &datarefValueResponse{}
-	err := c.makeRequest(ctx, http.MethodGet, path, nil, datarefValueResp)
+	err = c.makeAPIRequest(ctx, http.MethodGet, endpoint, nil, datarefValueResp)
 	if err != nil {
+		c.client.checkStaleID(err)
 		return nil, err
 	}
 
@@ -241,50 +425,132 @@ func (c *RESTClient) GetDatarefValue(ctx context.Context, name string) (*Dataref
 	}, nil
 }
 
-// SetDatarefValue applies the specified value to the specified dataref.
+// SetDatarefValue applies the specified value to the specified dataref.  The value is checked
+// against the cached value type of the dataref with [ValidateValue] before it is sent.
 func (c *RESTClient) SetDatarefValue(ctx context.Context, name string, value any) error {
-	drefID := c.client.GetDatarefID(name)
-	if drefID == 0 {
-		return fmt.Errorf("no such dataref: %s", name)
+	if err := c.client.checkWriter(); err != nil {
+		return err
+	}
+	dref, err := c.client.LookupDataref(name)
+	if err != nil {
+		return err
 	}
 
-	path := fmt.Sprintf("/api/v2/datarefs/%d/value", drefID)
-	payload := genSetDatarefValuePayload(value)
+	if err := validateDatarefValue(dref, value); err != nil {
+		return err
+	}
 
-	err := c.makeRequest(ctx, http.MethodPatch, path, payload, nil)
+	endpoint := fmt.Sprintf("/datarefs/%d/value", dref.ID)
+	payload := genSetDatarefValuePayload(dref.ValueType, value)
+
+	err = c.makeAPIRequest(ctx, http.MethodPatch, endpoint, payload, nil)
 	if err != nil {
-		return err
+		c.client.checkStaleID(err)
+		return fmt.Errorf("%w: %s: %w", ErrWriteFailed, dref.Name, err)
 	}
 
 	return nil
 }
 
 // SetDatarefElementValue applies the specified value to the specified element index of the
-// specified array type dataref.
+// specified array type dataref.  The value is checked with [ValidateElementValue] before it is
+// sent.
 func (c *RESTClient) SetDatarefElementValue(
 	ctx context.Context,
 	name string,
 	index int,
 	value any,
 ) error {
-	drefID := c.client.GetDatarefID(name)
-	if drefID == 0 {
-		return fmt.Errorf("no such dataref: %s", name)
+	if err := c.client.checkWriter(); err != nil {
+		return err
+	}
+	dref, err := c.client.LookupDataref(name)
+	if err != nil {
+		return err
 	}
 
-	path := fmt.Sprintf("/api/v2/datarefs/%d/value?index=%d", drefID, index)
-	payload := genSetDatarefValuePayload(value)
+	if err := validateDatarefElementValue(dref, index, value); err != nil {
+		return err
+	}
 
-	err := c.makeRequest(ctx, http.MethodPatch, path, payload, nil)
+	endpoint := fmt.Sprintf("/datarefs/%d/value?index=%d", dref.ID, index)
+	payload := genSetDatarefValuePayload(dref.ValueType, value)
+
+	err = c.makeAPIRequest(ctx, http.MethodPatch, endpoint, payload, nil)
 	if err != nil {
-		return err
+		c.client.checkStaleID(err)
+		return fmt.Errorf("%w: %s: %w", ErrWriteFailed, dref.Name, err)
 	}
 
 	return nil
 }
 
-// genSetDatarefValuePayload generates a datarefValuePatch object for a given value.
-func genSetDatarefValuePayload(value any) *datarefValuePatch {
+// DatarefErrors is an aggregated error returned by batch dataref operations.  It maps the name of
+// each dataref for which the operation failed to the error encountered.
+type DatarefErrors map[string]error
+
+// Error allows DatarefErrors to implement the error interface.
+func (e DatarefErrors) Error() string {
+	names := slices.Sorted(maps.Keys(e))
+	failures := make([]string, 0, len(names))
+	for _, name := range names {
+		failures = append(failures, fmt.Sprintf("%s: %s", name, e[name].Error()))
+	}
+	return fmt.Sprintf("%d dataref operation(s) failed: %s", len(e), strings.Join(failures, "; "))
+}
+
+// Unwrap returns the individual errors contained in the DatarefErrors object, so that errors.Is
+// and errors.As may match any of them.
+func (e DatarefErrors) Unwrap() []error {
+	return slices.Collect(maps.Values(e))
+}
+
+// SetDatarefValues applies the specified values, keyed by dataref name, to their respective
+// datarefs.  The writes are performed concurrently, limited by the BatchConcurrency value of the
+// [ClientConfig].  All writes are attempted, and if any of them fail a [DatarefErrors] value is
+// returned which identifies the failed datarefs.
+func (c *RESTClient) SetDatarefValues(ctx context.Context, values map[string]any) error {
+	return c.forEachDataref(maps.Keys(values), func(name string) error {
+		return c.SetDatarefValue(ctx, name, values[name])
+	})
+}
+
+// forEachDataref calls the specified function for each of the specified dataref names, running up
+// to the configured BatchConcurrency calls at once.  If any calls fail, a [DatarefErrors] value is
+// returned.
+func (c *RESTClient) forEachDataref(names iter.Seq[string], fn func(name string) error) error {
+	var (
+		errs     = make(DatarefErrors)
+		errsLock sync.Mutex
+		wg       sync.WaitGroup
+	)
+	sem := make(chan struct{}, c.client.batchConcurrency)
+
+	for name := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := fn(name); err != nil {
+				errsLock.Lock()
+				errs[name] = err
+				errsLock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// genSetDatarefValuePayload generates a datarefValuePatch object for a given value of a dataref
+// with the specified value type.
+func genSetDatarefValuePayload(valueType ValueType, value any) *datarefValuePatch {
 	payload := &datarefValuePatch{}
 
 	// data types must be base64 encoded
@@ -294,8 +560,40 @@ func genSetDatarefValuePayload(value any) *datarefValuePatch {
 	case []byte:
 		payload.Data = base64.StdEncoding.EncodeToString(realValue)
 	default:
-		// numbers and arrays of numbers are sent verbatim
+		// numbers and arrays of numbers are sent verbatim, except as narrowed for float datarefs
 		payload.Data = realValue
+		if valueType == ValueTypeFloat || valueType == ValueTypeFloatArray {
+			payload.Data = narrowFloats(realValue)
+		}
 	}
 	return payload
 }
+
+// narrowFloats converts float64 values destined for 32-bit float datarefs to float32, so that they
+// are encoded with float32 precision.  A value read from a float dataref is thereby written back
+// exactly as read (0.10000000149011612 is sent as 0.1, which the simulator stores as the same
+// float32), and a float64 value is rounded to the nearest float32 by the client rather than parsed
+// with unspecified rounding by the simulator.  Values outside the float32 range, and values of
+// other types, are returned unchanged.
+func narrowFloats(value any) any {
+	switch realValue := value.(type) {
+	case float64:
+		if math.Abs(realValue) > math.MaxFloat32 {
+			return realValue
+		}
+		return float32(realValue)
+	case []float64:
+		narrowed := make([]any, len(realValue))
+		for idx, elem := range realValue {
+			narrowed[idx] = narrowFloats(elem)
+		}
+		return narrowed
+	case []any:
+		narrowed := make([]any, len(realValue))
+		for idx, elem := range realValue {
+			narrowed[idx] = narrowFloats(elem)
+		}
+		return narrowed
+	}
+	return value
+}
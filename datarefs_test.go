@@ -0,0 +1,79 @@
+package xpweb
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The sizes of the benchmark cache, which is comparable to that of X-Plane 12 with a few add-on
+// aircraft loaded.
+const (
+	benchDatarefs = 20000
+	benchCommands = 5000
+)
+
+// benchDatarefName returns the name of the benchmark dataref with the specified index.
+func benchDatarefName(idx int) string {
+	return fmt.Sprintf("sim/group_%d/subgroup_%d/dataref_%d", idx%100, idx%7, idx)
+}
+
+// newBenchClient returns a client whose cache holds generated datarefs and commands.
+func newBenchClient(b *testing.B) *Client {
+	b.Helper()
+	client, err := NewClient(&ClientConfig{NameNormalizer: NormalizeCase})
+	if err != nil {
+		b.Fatal(err)
+	}
+	datarefs := make([]*Dataref, 0, benchDatarefs)
+	for idx := range benchDatarefs {
+		datarefs = append(datarefs, &Dataref{
+			ID:        uint64(idx + 1),
+			Name:      benchDatarefName(idx),
+			ValueType: ValueTypeFloatArray,
+		})
+	}
+	commands := make([]*Command, 0, benchCommands)
+	for idx := range benchCommands {
+		commands = append(commands, &Command{
+			ID:   uint64(benchDatarefs + idx + 1),
+			Name: fmt.Sprintf("sim/group_%d/command_%d", idx%50, idx),
+		})
+	}
+	client.setDatarefs(datarefs)
+	client.setCommands(commands)
+	return client
+}
+
+func BenchmarkLookupName(b *testing.B) {
+	client := newBenchClient(b)
+	names := make([]string, 1000)
+	for idx := range names {
+		names[idx] = benchDatarefName(idx * benchDatarefs / len(names))
+	}
+
+	b.Run("Hit", func(b *testing.B) {
+		b.ReportAllocs()
+		idx := 0
+		for b.Loop() {
+			if client.GetDatarefByName(names[idx%len(names)]) == nil {
+				b.Fatal("dataref not found")
+			}
+			idx++
+		}
+	})
+	b.Run("Miss", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			client.GetDatarefByName("sim/no/such/dataref")
+		}
+	})
+	b.Run("NearMiss", func(b *testing.B) {
+		// a not found error with suggestions, as produced for a typo
+		b.ReportAllocs()
+		for b.Loop() {
+			if _, err := client.LookupDataref("sim/group_1/subgroup_1/dataref_1X"); err == nil {
+				b.Fatal("dataref unexpectedly found")
+			}
+		}
+	})
+}
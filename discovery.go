@@ -0,0 +1,167 @@
+package xpweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Beacon parameters used by X-Plane to announce running instances on the local network.
+const (
+	beaconGroup   = "239.255.1.1"
+	beaconPort    = 49707
+	beaconPrefix  = "BECN\x00"
+	beaconMaxSize = 1500
+
+	// defaultWebAPIPort is the port on which the web API is served.  It is not announced by the
+	// beacon, so is assumed.
+	defaultWebAPIPort = 8086
+	// defaultDiscoverTimeout is how long NewClient listens for beacons when ClientConfig.Discover
+	// is set.  X-Plane sends a beacon about once per second.
+	defaultDiscoverTimeout = 3 * time.Second
+)
+
+// Roles announced by X-Plane instances.
+const (
+	RoleMaster         uint32 = 1
+	RoleExternalVisual uint32 = 2
+	RoleIOS            uint32 = 3
+)
+
+// Instance describes a running X-Plane instance discovered on the local network.
+type Instance struct {
+	// The address from which the beacon was received.
+	Host string
+	// The UDP port on which the instance accepts the legacy UDP protocol.
+	UDPPort int
+	// The name of the computer running the instance.
+	ComputerName string
+	// The X-Plane version number, such as 121400 for 12.1.4.
+	Version int32
+	// The role of the instance, such as [RoleMaster].
+	Role uint32
+	// Whether the beacon was sent by X-Plane, rather than Plane Maker.
+	IsXPlane bool
+}
+
+// URL returns the assumed web API URL of the instance.
+func (i Instance) URL() string {
+	return "http://" + net.JoinHostPort(i.Host, strconv.Itoa(defaultWebAPIPort))
+}
+
+// beaconData is the fixed-size portion of a beacon, following the prefix.
+type beaconData struct {
+	MajorVersion uint8
+	MinorVersion uint8
+	HostID       int32
+	Version      int32
+	Role         uint32
+	Port         uint16
+}
+
+// Discover listens for the beacons of X-Plane instances on the local network until the context is
+// done, and returns the distinct instances found.  The context should carry a timeout of a few
+// seconds, as instances send a beacon about once per second.
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+//	defer cancel()
+//	instances, err := xpweb.Discover(ctx)
+func Discover(ctx context.Context) ([]Instance, error) {
+	var instances []Instance
+	err := listenBeacons(ctx, func(instance Instance) bool {
+		for _, found := range instances {
+			if found.Host == instance.Host && found.UDPPort == instance.UDPPort {
+				return true
+			}
+		}
+		instances = append(instances, instance)
+		return true
+	})
+	return instances, err
+}
+
+// discoverMaster returns the first master X-Plane instance discovered within the timeout.
+func discoverMaster(timeout time.Duration) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	var master *Instance
+	err := listenBeacons(ctx, func(instance Instance) bool {
+		if instance.IsXPlane && instance.Role == RoleMaster {
+			master = &instance
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if master == nil {
+		return nil, errors.New("no X-Plane instance discovered")
+	}
+	return master, nil
+}
+
+// listenBeacons calls the handler for each beacon received until the context is done or the
+// handler returns false.
+func listenBeacons(ctx context.Context, handler func(Instance) bool) error {
+	group := &net.UDPAddr{IP: net.ParseIP(beaconGroup), Port: beaconPort}
+	conn, err := net.ListenMulticastUDP("udp4", nil, group)
+	if err != nil {
+		return fmt.Errorf("failed to listen for beacons: %w", err)
+	}
+	defer conn.Close()
+
+	stop := context.AfterFunc(ctx, func() { conn.SetReadDeadline(time.Now()) })
+	defer stop()
+
+	buf := make([]byte, beaconMaxSize)
+	for {
+		n, addr, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read beacon: %w", err)
+		}
+		instance, err := parseBeacon(buf[:n], addr)
+		if err != nil {
+			continue
+		}
+		if !handler(*instance) {
+			return nil
+		}
+	}
+}
+
+// parseBeacon decodes a beacon packet received from the specified address.
+func parseBeacon(packet []byte, addr *net.UDPAddr) (*Instance, error) {
+	if !bytes.HasPrefix(packet, []byte(beaconPrefix)) {
+		return nil, errors.New("not a beacon")
+	}
+	reader := bytes.NewReader(packet[len(beaconPrefix):])
+	data := &beaconData{}
+	if err := binary.Read(reader, binary.LittleEndian, data); err != nil {
+		return nil, fmt.Errorf("malformed beacon: %w", err)
+	}
+
+	// the computer name is a null-terminated string following the fixed-size fields
+	name := packet[len(packet)-reader.Len():]
+	if end := bytes.IndexByte(name, 0); end >= 0 {
+		name = name[:end]
+	}
+
+	return &Instance{
+		Host:         addr.IP.String(),
+		UDPPort:      int(data.Port),
+		ComputerName: string(name),
+		Version:      data.Version,
+		Role:         data.Role,
+		IsXPlane:     data.HostID == 1,
+	}, nil
+}
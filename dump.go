@@ -0,0 +1,44 @@
+package xpweb
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DumpValues reads the current value of every cached dataref whose name begins with the specified
+// prefix, and returns the values keyed by dataref name.  The returned map may be marshalled
+// directly to JSON to capture the state of an aircraft or plugin.
+//
+//	values, err := client.DumpValues(ctx, "laminar/c172/")
+//
+// The reads are performed concurrently, limited by the BatchConcurrency value of the
+// [ClientConfig].  If any reads fail, the values which were read successfully are returned along
+// with a [DatarefErrors] value identifying the failed datarefs.
+func (c *Client) DumpValues(ctx context.Context, prefix string) (map[string]any, error) {
+	var names []string
+	c.datarefsLock.RLock()
+	for name := range c.datarefsByName {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	c.datarefsLock.RUnlock()
+
+	var valuesLock sync.Mutex
+	values := make(map[string]any, len(names))
+
+	err := c.REST.forEachDataref(slices.Values(names), func(name string) error {
+		val, err := c.REST.GetDatarefValue(ctx, name)
+		if err != nil {
+			return err
+		}
+		valuesLock.Lock()
+		values[name] = val.Value
+		valuesLock.Unlock()
+		return nil
+	})
+
+	return values, err
+}
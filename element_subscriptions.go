@@ -0,0 +1,275 @@
+package xpweb
+
+import (
+	"maps"
+	"slices"
+	"sync"
+)
+
+// elementSubscriptions tracks subscriptions to individual elements of array datarefs, so that only
+// the subscribed elements are sent by the simulator rather than whole arrays.
+//
+// The simulator merges the indexes of repeated subscriptions to a dataref, and sends the values of
+// the subscribed indexes as a single array.  So that the order of that array is known, the indexes
+// of a dataref are unsubscribed before a changed set of indexes is subscribed.  While the whole
+// dataref is subscribed, its elements are not subscribed individually, and are instead taken from
+// the whole values.
+type elementSubscriptions struct {
+	lock sync.Mutex
+	// the number of subscribers of each element, by dataref name and then index
+	counts map[string]map[int]int
+	// the indexes subscribed upstream for each dataref name, in the order of their values
+	sent      map[string][]int
+	listeners map[uint64]*elementListener
+}
+
+// elementListener receives the values of an element of an array dataref.
+type elementListener struct {
+	name    string
+	index   int
+	handler DatarefValueHandler
+}
+
+// release decrements the count of subscribers of the specified element.  The lock must be held.
+func (e *elementSubscriptions) release(name string, index int) {
+	counts := e.counts[name]
+	if counts[index] > 1 {
+		counts[index]--
+		return
+	}
+	delete(counts, index)
+	if len(counts) == 0 {
+		delete(e.counts, name)
+	}
+}
+
+// subscribeDatarefElement subscribes to the element at the specified index of the array dataref
+// with the specified name.  Values of the element are delivered to element listeners.
+func (wsc *WSClient) subscribeDatarefElement(name string, index int) error {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.counts == nil {
+		e.counts = make(map[string]map[int]int)
+		e.sent = make(map[string][]int)
+	}
+	if e.counts[name] == nil {
+		e.counts[name] = make(map[int]int)
+	}
+	e.counts[name][index]++
+	if err := wsc.syncElements(name); err != nil {
+		e.release(name, index)
+		return err
+	}
+	return nil
+}
+
+// unsubscribeDatarefElement releases a subscription made with subscribeDatarefElement,
+// unsubscribing from the element once it has no subscribers.
+func (wsc *WSClient) unsubscribeDatarefElement(name string, index int) error {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.release(name, index)
+	return wsc.syncElements(name)
+}
+
+// syncElements subscribes upstream to the indexes of the named dataref which have subscribers, if
+// they differ from those already subscribed.  The lock must be held.
+func (wsc *WSClient) syncElements(name string) error {
+	e := &wsc.elements
+	dref, err := wsc.client.LookupDataref(name)
+	if err != nil {
+		return err
+	}
+	var indexes []int
+	if !wsc.subscriptions.isHeld(dref.ID) {
+		indexes = slices.Sorted(maps.Keys(e.counts[name]))
+	}
+	sent := e.sent[name]
+	if slices.Equal(indexes, sent) {
+		return nil
+	}
+
+	if len(sent) > 0 {
+		err := wsc.NewReq().DatarefUnsubscribe(newIndexedWSDataref(dref.ID, sent)).Send()
+		if err != nil {
+			return err
+		}
+		delete(e.sent, name)
+	}
+	if len(indexes) > 0 {
+		err := wsc.NewReq().DatarefSubscribe(newIndexedWSDataref(dref.ID, indexes)).Send()
+		if err != nil {
+			return err
+		}
+		e.sent[name] = indexes
+	}
+	return nil
+}
+
+// newIndexedWSDataref returns a WSDataref with the specified indexes, using a single index rather
+// than an array where possible.
+func newIndexedWSDataref(id uint64, indexes []int) *WSDataref {
+	if len(indexes) == 1 {
+		return NewWSDataref(id).WithIndex(indexes[0])
+	}
+	return NewWSDataref(id).WithIndexArray(indexes)
+}
+
+// syncElementsByID updates the upstream element subscriptions of the datarefs with the specified
+// IDs, after their whole subscriptions have changed.  Elements are unsubscribed before the whole
+// dataref is subscribed, and subscribed again once the whole dataref is unsubscribed.
+func (wsc *WSClient) syncElementsByID(ids []uint64) {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	for _, id := range ids {
+		dref := wsc.client.GetDatarefByID(id)
+		if dref == nil || e.counts[dref.Name] == nil {
+			continue
+		}
+		if err := wsc.syncElements(dref.Name); err != nil {
+			wsc.client.logger.Warn("failed to update dataref element subscriptions",
+				"name", dref.Name, "error", err)
+		}
+	}
+}
+
+// resubscribeElements subscribes to the subscribed elements over a re-established connection.
+func (wsc *WSClient) resubscribeElements() {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	clear(e.sent)
+	for name := range e.counts {
+		if err := wsc.syncElements(name); err != nil {
+			wsc.client.logger.Warn("failed to resubscribe to dataref elements", "name", name,
+				"error", err)
+		}
+	}
+}
+
+// resetElements discards all element subscriptions, once all subscriptions have been removed.
+func (wsc *WSClient) resetElements() {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	clear(e.counts)
+	clear(e.sent)
+}
+
+// addElementListener registers a handler which receives the values of the element at the
+// specified index of the named dataref.  It returns an ID which may be passed to
+// removeElementListener.
+func (wsc *WSClient) addElementListener(
+	name string,
+	index int,
+	handler DatarefValueHandler,
+) uint64 {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.listeners == nil {
+		e.listeners = make(map[uint64]*elementListener)
+	}
+	id := wsc.listenerID.Add(1)
+	e.listeners[id] = &elementListener{name: name, index: index, handler: handler}
+	return id
+}
+
+// removeElementListener removes a handler registered with addElementListener.
+func (wsc *WSClient) removeElementListener(id uint64) {
+	e := &wsc.elements
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	delete(e.listeners, id)
+}
+
+// dispatchElements delivers the values of subscribed elements in an update to the element
+// listeners.  Values holding only individually subscribed elements are removed from the update, as
+// they are not values of the whole datarefs.
+func (wsc *WSClient) dispatchElements(msg *WSMessageDatarefUpdate) {
+	e := &wsc.elements
+	e.lock.Lock()
+	if len(e.listeners) == 0 && len(e.sent) == 0 {
+		e.lock.Unlock()
+		return
+	}
+
+	type delivery struct {
+		handler DatarefValueHandler
+		value   *DatarefValue
+	}
+	var deliveries []delivery
+	for id, val := range msg.Data {
+		if val.Dataref == nil {
+			continue
+		}
+		sent := e.sent[val.Dataref.Name]
+		if len(sent) > 0 {
+			delete(msg.Data, id)
+		}
+		for _, listener := range e.listeners {
+			if listener.name != val.Dataref.Name {
+				continue
+			}
+			position := listener.index
+			if len(sent) > 0 {
+				if position = slices.Index(sent, listener.index); position < 0 {
+					continue
+				}
+			}
+			if elem, ok := arrayElement(val.Value, position, len(sent) == 1); ok {
+				deliveries = append(deliveries,
+					delivery{listener.handler, newElementValue(val.Dataref, elem)})
+			}
+		}
+	}
+	e.lock.Unlock()
+
+	for _, d := range deliveries {
+		d.handler(d.value)
+	}
+}
+
+// arrayElement returns the element at the specified position of an array value.  A scalar value
+// is accepted as the only element if single is set, as the simulator sends for a subscription to a
+// single index.
+func arrayElement(value any, position int, single bool) (any, bool) {
+	switch elems := value.(type) {
+	case []float64:
+		if position < len(elems) {
+			return elems[position], true
+		}
+	case []any:
+		if position < len(elems) {
+			return elems[position], true
+		}
+	case float64:
+		if single && position == 0 {
+			return elems, true
+		}
+	}
+	return nil, false
+}
+
+// newElementValue returns the value of an element of the specified array dataref, with its Typed
+// value decoded according to the type of the dataref's elements.
+func newElementValue(dref *Dataref, elem any) *DatarefValue {
+	val := &DatarefValue{Dataref: dref, Value: elem}
+	if num, ok := elem.(float64); ok {
+		switch dref.ValueType {
+		case ValueTypeIntArray:
+			val.Typed = int(num)
+		case ValueTypeFloatArray:
+			val.Typed = num
+		}
+	}
+	return val
+}
@@ -0,0 +1,159 @@
+package xpweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// elementSim is a minimal simulator web API which serves an int array dataref with ID 3, whose
+// element at each index has the value 10 times the index.  The subscription requests it receives
+// are reported on the requests channel, and answered with the values of the subscribed elements.
+type elementSim struct {
+	requests chan string
+}
+
+func (s *elementSim) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/datarefs", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"id":3,"name":"sim/test/array","value_type":"int_array"}]}`))
+	})
+	mux.HandleFunc("/api/v2/commands", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	})
+	mux.Handle("/api/v2", websocket.Handler(func(conn *websocket.Conn) {
+		for {
+			req := &struct {
+				ReqID  uint64 `json:"req_id"`
+				Type   string `json:"type"`
+				Params struct {
+					Datarefs json.RawMessage `json:"datarefs"`
+				} `json:"params"`
+			}{}
+			if err := websocket.JSON.Receive(conn, req); err != nil {
+				return
+			}
+			websocket.JSON.Send(conn, map[string]any{
+				"type": MessageTypeResult, "req_id": req.ReqID, "success": true,
+			})
+			if req.Type != MessageTypeDatarefSub && req.Type != MessageTypeDatarefUnsub {
+				continue
+			}
+			s.requests <- req.Type + " " + string(req.Params.Datarefs)
+			if req.Type != MessageTypeDatarefSub {
+				continue
+			}
+			var datarefs []*WSDataref
+			json.Unmarshal(req.Params.Datarefs, &datarefs)
+			for _, dref := range datarefs {
+				var value string
+				switch index := dref.Index.(type) {
+				case float64:
+					value = fmt.Sprint(index * 10)
+				case []any:
+					values := make([]string, len(index))
+					for idx, elem := range index {
+						values[idx] = fmt.Sprint(elem.(float64) * 10)
+					}
+					value = "[" + strings.Join(values, ",") + "]"
+				default:
+					value = "[0,10,20,30]"
+				}
+				websocket.Message.Send(conn,
+					fmt.Sprintf(`{"type":"dataref_update_values","data":{"3":%s}}`, value))
+			}
+		}
+	}))
+	return mux
+}
+
+// expectRequest waits for the next subscription request, and fails the test unless it matches.
+func (s *elementSim) expectRequest(t *testing.T, want string) {
+	t.Helper()
+	select {
+	case got := <-s.requests:
+		if got != want {
+			t.Fatalf("received %s, expected %s", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no request received, expected %s", want)
+	}
+}
+
+// expectElement waits for the next element value, and fails the test unless it matches.
+func expectElement(t *testing.T, values <-chan *DatarefValue, want int) {
+	t.Helper()
+	select {
+	case val := <-values:
+		if val.Value != float64(want) || val.Typed != want {
+			t.Fatalf("received element %v (%v), expected %d", val.Value, val.Typed, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no element received, expected %d", want)
+	}
+}
+
+func TestSubscribeDatarefElementByIndex(t *testing.T) {
+	sim := &elementSim{requests: make(chan string, 16)}
+	server := httptest.NewServer(sim.handler())
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{URL: server.URL, APIVersion: APIVersion2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := client.LoadCache(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.WS.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.WS.Close()
+	const sub, unsub = MessageTypeDatarefSub + " ", MessageTypeDatarefUnsub + " "
+
+	// only the element is subscribed, and delivered as a scalar
+	values := make(chan *DatarefValue, 16)
+	handler := func(val *DatarefValue) { values <- val }
+	if err := client.SubscribeDatarefElement(ctx, "sim/test/array", 2, handler); err != nil {
+		t.Fatal(err)
+	}
+	sim.expectRequest(t, sub+`[{"id":3,"index":2}]`)
+	expectElement(t, values, 20)
+
+	// the indexes of further elements are subscribed together
+	if err := client.SubscribeDatarefElement(ctx, "sim/test/array", 1, handler); err != nil {
+		t.Fatal(err)
+	}
+	sim.expectRequest(t, unsub+`[{"id":3,"index":2}]`)
+	sim.expectRequest(t, sub+`[{"id":3,"index":[1,2]}]`)
+	first, second := <-values, <-values
+	if first.Typed.(int)+second.Typed.(int) != 30 {
+		t.Fatalf("received elements %v and %v, expected 10 and 20", first.Typed, second.Typed)
+	}
+
+	// while the whole array is subscribed, elements are taken from it
+	wholeCtx, cancelWhole := context.WithCancel(ctx)
+	if _, err := client.SubscribeFor(wholeCtx, "sim/test/array"); err != nil {
+		t.Fatal(err)
+	}
+	sim.expectRequest(t, unsub+`[{"id":3,"index":[1,2]}]`)
+	sim.expectRequest(t, sub+`[{"id":3}]`)
+	first, second = <-values, <-values
+	if first.Typed.(int)+second.Typed.(int) != 30 {
+		t.Fatalf("received elements %v and %v, expected 10 and 20", first.Typed, second.Typed)
+	}
+
+	// and subscribed again once it is unsubscribed
+	cancelWhole()
+	sim.expectRequest(t, unsub+`[{"id":3}]`)
+	sim.expectRequest(t, sub+`[{"id":3,"index":[1,2]}]`)
+}
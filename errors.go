@@ -0,0 +1,77 @@
+package xpweb
+
+import "errors"
+
+var (
+	// ErrDatarefNotFound is matched by errors returned when a dataref name cannot be found.  The
+	// error may be inspected further as a [NotFoundError].
+	ErrDatarefNotFound = errors.New("dataref not found")
+	// ErrCommandNotFound is matched by errors returned when a command name cannot be found.  The
+	// error may be inspected further as a [NotFoundError].
+	ErrCommandNotFound = errors.New("command not found")
+	// ErrNotConnected is returned when a websocket operation is attempted without an established
+	// connection.
+	ErrNotConnected = errors.New("websocket is not connected")
+	// ErrWriteFailed is matched by errors returned when the API rejects a dataref write.  The
+	// underlying cause, such as an [ErrorResponse], is also matched.
+	ErrWriteFailed = errors.New("dataref write failed")
+	// ErrClosed is returned when a websocket connection is established concurrently with a call to
+	// [WSClient.Close].
+	ErrClosed = errors.New("websocket client closed")
+	// ErrInvalidValue is matched by errors returned when a value to be written does not match the
+	// cached value type of the dataref, before any request is sent.
+	ErrInvalidValue = errors.New("invalid dataref value")
+	// ErrInvalidDuration is matched by errors returned when a command activation duration is
+	// outside the range accepted by the simulator, before any request is sent.
+	ErrInvalidDuration = errors.New("invalid command duration")
+	// ErrInvalidInterval is matched by errors returned when a polling interval is not positive.
+	ErrInvalidInterval = errors.New("invalid polling interval")
+	// ErrWriteNotConfirmed is matched by errors returned when a written dataref value is not read
+	// back from the simulator.
+	ErrWriteNotConfirmed = errors.New("dataref write not confirmed")
+	// ErrInvalidRequest is matched by errors returned by [WSClient.Send] when a request fails
+	// validation, before it is sent.  See [WSReq.Validate].
+	ErrInvalidRequest = errors.New("invalid websocket request")
+)
+
+// Is reports whether the NotFoundError matches the specified target, which allows it to be
+// matched against [ErrDatarefNotFound] or [ErrCommandNotFound] with errors.Is.
+func (e *NotFoundError) Is(target error) bool {
+	switch target {
+	case ErrDatarefNotFound:
+		return e.Kind == "dataref"
+	case ErrCommandNotFound:
+		return e.Kind == "command"
+	}
+	return false
+}
+
+// Code returns the error_code value reported by the API.
+func (e ErrorResponse) Code() string {
+	return e.ErrorCode
+}
+
+// Is reports whether the ErrorResponse matches the specified target, which must be an
+// [ErrorResponse] or a pointer to one.  The target's non-zero fields must each equal those of the
+// ErrorResponse, so a target may match on the HTTP status, the error code, or both.
+//
+//	if errors.Is(err, &xpweb.ErrorResponse{StatusCode: http.StatusNotFound}) {
+//		...
+//	}
+func (e ErrorResponse) Is(target error) bool {
+	var t ErrorResponse
+	switch typed := target.(type) {
+	case ErrorResponse:
+		t = typed
+	case *ErrorResponse:
+		if typed == nil {
+			return false
+		}
+		t = *typed
+	default:
+		return false
+	}
+	return (t.StatusCode == 0 || t.StatusCode == e.StatusCode) &&
+		(t.ErrorCode == "" || t.ErrorCode == e.ErrorCode) &&
+		(t.ErrorMessage == "" || t.ErrorMessage == e.ErrorMessage)
+}
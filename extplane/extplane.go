@@ -0,0 +1,457 @@
+// Package extplane provides a server which speaks the ExtPlane plugin's TCP protocol, and performs
+// the dataref subscriptions, writes and commands requested by its clients through an
+// [xpweb.Client].  This allows hardware panels and applications written for ExtPlane to use the
+// X-Plane 12 web API without modification.
+//
+//	server := extplane.NewServer(client)
+//	err := server.ListenAndServe(ctx, extplane.DefaultAddr)
+//
+// The following client commands are supported:
+//
+//	sub <dataref> [accuracy]
+//	unsub <dataref>
+//	set <dataref> <value>
+//	cmd once|begin|end <command>
+//	extplane-set update_interval <seconds>
+//	disconnect
+//
+// The key, but and rel commands, which press X-Plane keys and joystick buttons, have no web API
+// equivalent and are ignored.
+package extplane
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// DefaultAddr is the address on which the ExtPlane plugin listens.
+const DefaultAddr = ":51000"
+
+// greeting is sent to each client when it connects.
+const greeting = "EXTPLANE 1\n"
+
+// Server accepts ExtPlane protocol clients and proxies their requests through an xpweb client.
+type Server struct {
+	client *xpweb.Client
+	// An optional logger for connection and request errors.  If nil, slog.Default() is used.
+	Logger *slog.Logger
+}
+
+// NewServer instantiates and returns a pointer to a new [Server] which uses the specified client.
+// The client's websocket should be connected if its selected [xpweb.Transport] subscribes via
+// the websocket service, and its cache loaded unless it resolves names lazily.
+func NewServer(client *xpweb.Client) *Server {
+	return &Server{client: client}
+}
+
+// ListenAndServe listens on the specified TCP address, such as [DefaultAddr], and serves clients
+// until the context is done.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, listener)
+}
+
+// Serve accepts clients from the listener and serves them until the context is done, at which
+// point the listener and all client connections are closed.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(ctx, func() { listener.Close() })
+	defer stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveConn(ctx, conn)
+		}()
+	}
+}
+
+// logger returns the configured logger, or the default logger.
+func (s *Server) logger() *slog.Logger {
+	if s.Logger != nil {
+		return s.Logger
+	}
+	return slog.Default()
+}
+
+// serveConn handles a single client connection until it disconnects or the context is done.
+func (s *Server) serveConn(ctx context.Context, conn net.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	sess := &session{
+		server:  s,
+		conn:    conn,
+		subs:    make(map[string]*subscription),
+		changed: make(chan struct{}, 1),
+	}
+	defer sess.close()
+
+	if _, err := io.WriteString(conn, greeting); err != nil {
+		return
+	}
+	go sess.flushLoop(ctx)
+
+	scanner := bufio.NewScanner(conn)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if line == "disconnect" {
+			return
+		}
+		if err := sess.handle(ctx, line); err != nil {
+			s.logger().Warn("extplane request failed", "remote", conn.RemoteAddr().String(),
+				"request", line, "error", err)
+		}
+	}
+}
+
+// session is the state of a single client connection.
+type session struct {
+	server *Server
+	conn   net.Conn
+
+	lock     sync.Mutex
+	subs     map[string]*subscription
+	interval time.Duration
+	changed  chan struct{}
+}
+
+// subscription is a dataref subscribed by a client.
+type subscription struct {
+	cancel   context.CancelFunc
+	accuracy float64
+	// the value most recently sent to the client, and a newer value awaiting the next flush
+	sent    *xpweb.DatarefValue
+	pending *xpweb.DatarefValue
+}
+
+// handle performs a single client request.
+func (sess *session) handle(ctx context.Context, line string) error {
+	fields := strings.Fields(line)
+	client := sess.server.client
+
+	switch fields[0] {
+	case "sub":
+		if len(fields) < 2 {
+			return errors.New("missing dataref name")
+		}
+		var accuracy float64
+		if len(fields) > 2 {
+			var err error
+			if accuracy, err = strconv.ParseFloat(fields[2], 64); err != nil {
+				return fmt.Errorf("invalid accuracy: %w", err)
+			}
+		}
+		return sess.subscribe(ctx, fields[1], accuracy)
+	case "unsub":
+		if len(fields) < 2 {
+			return errors.New("missing dataref name")
+		}
+		sess.unsubscribe(fields[1])
+		return nil
+	case "set":
+		if len(fields) < 3 {
+			return errors.New("missing dataref name or value")
+		}
+		value, err := parseValue(strings.Join(fields[2:], ""))
+		if err != nil {
+			return err
+		}
+		return client.SetValue(ctx, fields[1], value)
+	case "cmd":
+		if len(fields) < 3 {
+			return errors.New("missing command mode or name")
+		}
+		switch fields[1] {
+		case "once":
+			return client.ActivateCommand(ctx, fields[2], 0)
+		case "begin":
+			return client.Command(fields[2]).Hold(ctx)
+		case "end":
+			return client.Command(fields[2]).Release(ctx)
+		default:
+			return fmt.Errorf("unknown command mode: %s", fields[1])
+		}
+	case "extplane-set":
+		if len(fields) < 3 || fields[1] != "update_interval" {
+			return fmt.Errorf("unsupported setting: %s", line)
+		}
+		seconds, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return fmt.Errorf("invalid update interval: %w", err)
+		}
+		sess.setInterval(time.Duration(seconds * float64(time.Second)))
+		return nil
+	case "key", "but", "rel":
+		return fmt.Errorf("%w: %s requests have no web API equivalent", errors.ErrUnsupported,
+			fields[0])
+	default:
+		return fmt.Errorf("unknown request: %s", fields[0])
+	}
+}
+
+// subscribe begins sending updates of the dataref to the client.
+func (sess *session) subscribe(ctx context.Context, name string, accuracy float64) error {
+	sess.lock.Lock()
+	if sub, exists := sess.subs[name]; exists {
+		sub.accuracy = accuracy
+		sess.lock.Unlock()
+		return nil
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &subscription{cancel: cancel, accuracy: accuracy}
+	sess.subs[name] = sub
+	sess.lock.Unlock()
+
+	err := sess.server.client.Watch(subCtx, name, func(val *xpweb.DatarefValue) {
+		sess.update(name, sub, val)
+	})
+	if err != nil {
+		sess.unsubscribe(name)
+		return err
+	}
+	return nil
+}
+
+// unsubscribe stops sending updates of the dataref to the client.
+func (sess *session) unsubscribe(name string) {
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+	if sub, exists := sess.subs[name]; exists {
+		sub.cancel()
+		delete(sess.subs, name)
+	}
+}
+
+// close removes all of the session's subscriptions.
+func (sess *session) close() {
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+	for name, sub := range sess.subs {
+		sub.cancel()
+		delete(sess.subs, name)
+	}
+}
+
+// update handles a new value of a subscribed dataref, sending it to the client immediately unless
+// an update interval has been set, in which case it is sent by the next flush.
+func (sess *session) update(name string, sub *subscription, val *xpweb.DatarefValue) {
+	sess.lock.Lock()
+	if sess.subs[name] != sub || !changed(sub.sent, val, sub.accuracy) {
+		sess.lock.Unlock()
+		return
+	}
+	if sess.interval > 0 {
+		sub.pending = val
+		sess.lock.Unlock()
+		return
+	}
+	sub.sent = val
+	sess.lock.Unlock()
+
+	sess.send(name, val)
+}
+
+// setInterval sets the minimum interval between updates sent to the client.
+func (sess *session) setInterval(interval time.Duration) {
+	sess.lock.Lock()
+	sess.interval = interval
+	sess.lock.Unlock()
+
+	select {
+	case sess.changed <- struct{}{}:
+	default:
+	}
+}
+
+// flushLoop sends pending values at the configured update interval until the context is done.
+func (sess *session) flushLoop(ctx context.Context) {
+	for {
+		sess.lock.Lock()
+		interval := sess.interval
+		sess.lock.Unlock()
+
+		// without an interval, values are sent as they arrive, so wait only for a change
+		var tick <-chan time.Time
+		var ticker *time.Ticker
+		if interval > 0 {
+			ticker = time.NewTicker(interval)
+			tick = ticker.C
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-sess.changed:
+		case <-tick:
+		}
+		if ticker != nil {
+			ticker.Stop()
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		sess.flush()
+	}
+}
+
+// flush sends all pending values to the client.
+func (sess *session) flush() {
+	pending := make(map[string]*xpweb.DatarefValue)
+	sess.lock.Lock()
+	for name, sub := range sess.subs {
+		if sub.pending != nil {
+			pending[name] = sub.pending
+			sub.sent = sub.pending
+			sub.pending = nil
+		}
+	}
+	sess.lock.Unlock()
+
+	for name, val := range pending {
+		sess.send(name, val)
+	}
+}
+
+// send writes an update line for the value to the client.
+func (sess *session) send(name string, val *xpweb.DatarefValue) {
+	line, err := formatUpdate(name, val)
+	if err != nil {
+		sess.server.logger().Warn("failed to format extplane update", "dataref", name,
+			"error", err)
+		return
+	}
+	// net.Conn writes are safe for concurrent use, and each line is written in a single call
+	if _, err := io.WriteString(sess.conn, line); err != nil {
+		sess.conn.Close()
+	}
+}
+
+// formatUpdate returns the update line for the value, such as "uf sim/flightmodel/position/theta
+// 2.5".
+func formatUpdate(name string, val *xpweb.DatarefValue) (string, error) {
+	var prefix, text string
+	switch val.Dataref.ValueType {
+	case xpweb.ValueTypeInt:
+		prefix, text = "ui", strconv.Itoa(val.GetIntValue())
+	case xpweb.ValueTypeFloat:
+		prefix, text = "uf", strconv.FormatFloat(val.GetFloatValue(), 'f', -1, 32)
+	case xpweb.ValueTypeDouble:
+		prefix, text = "ud", strconv.FormatFloat(val.GetFloatValue(), 'f', -1, 64)
+	case xpweb.ValueTypeIntArray:
+		data, err := json.Marshal(val.GetIntArrayValue())
+		if err != nil {
+			return "", err
+		}
+		prefix, text = "uia", string(data)
+	case xpweb.ValueTypeFloatArray:
+		data, err := json.Marshal(val.GetFloatArrayValue())
+		if err != nil {
+			return "", err
+		}
+		prefix, text = "ufa", string(data)
+	case xpweb.ValueTypeData:
+		prefix, text = "ub", base64.StdEncoding.EncodeToString(val.GetByteArrayValue())
+	default:
+		return "", fmt.Errorf("unsupported value type: %s", val.Dataref.ValueType)
+	}
+	return prefix + " " + name + " " + text + "\n", nil
+}
+
+// changed returns whether the new value differs from the previously sent value by at least the
+// accuracy, in any element.  Values which are not numeric are compared for any difference.
+func changed(prev, next *xpweb.DatarefValue, accuracy float64) bool {
+	if prev == nil {
+		return true
+	}
+	switch next.Dataref.ValueType {
+	case xpweb.ValueTypeInt, xpweb.ValueTypeFloat, xpweb.ValueTypeDouble:
+		return exceeds(prev.GetFloatValue(), next.GetFloatValue(), accuracy)
+	case xpweb.ValueTypeIntArray, xpweb.ValueTypeFloatArray:
+		prevValues, nextValues := prev.GetFloatArrayValue(), next.GetFloatArrayValue()
+		if next.Dataref.ValueType == xpweb.ValueTypeIntArray {
+			prevValues = toFloats(prev.GetIntArrayValue())
+			nextValues = toFloats(next.GetIntArrayValue())
+		}
+		if len(prevValues) != len(nextValues) {
+			return true
+		}
+		for i := range nextValues {
+			if exceeds(prevValues[i], nextValues[i], accuracy) {
+				return true
+			}
+		}
+		return false
+	default:
+		return fmt.Sprint(prev.Value) != fmt.Sprint(next.Value)
+	}
+}
+
+// exceeds returns whether two values differ, by at least the accuracy if it is positive.
+func exceeds(prev, next, accuracy float64) bool {
+	if accuracy <= 0 {
+		return prev != next
+	}
+	return math.Abs(next-prev) >= accuracy
+}
+
+func toFloats(values []int) []float64 {
+	floats := make([]float64, len(values))
+	for i, v := range values {
+		floats[i] = float64(v)
+	}
+	return floats
+}
+
+// parseValue parses the value of a set request, which is either a number or a bracketed,
+// comma-separated array of numbers.
+func parseValue(text string) (any, error) {
+	if strings.HasPrefix(text, "[") {
+		var values []float64
+		if err := json.Unmarshal([]byte(text), &values); err != nil {
+			return nil, fmt.Errorf("invalid array value: %w", err)
+		}
+		return values, nil
+	}
+	if i, err := strconv.Atoi(text); err == nil {
+		return i, nil
+	}
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %w", err)
+	}
+	return f, nil
+}
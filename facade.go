@@ -0,0 +1,122 @@
+package xpweb
+
+import "context"
+
+// DatarefRef is a handle to a single dataref, offering the common operations without regard to
+// whether they are performed via the REST API or the websocket service.  The selected [Transport]
+// determines how each operation is performed.
+//
+//	altitude := client.Dataref(dataref.SimFlightmodelPosition_elevation)
+//	val, err := altitude.Get(ctx)
+type DatarefRef struct {
+	client *Client
+	name   string
+}
+
+// Dataref returns a [DatarefRef] for the dataref with the specified name.  The name is not
+// resolved until an operation is performed.
+func (c *Client) Dataref(name string) *DatarefRef {
+	return &DatarefRef{client: c, name: name}
+}
+
+// Name returns the name of the dataref.
+func (r *DatarefRef) Name() string {
+	return r.name
+}
+
+// Get reads the current value of the dataref.
+func (r *DatarefRef) Get(ctx context.Context) (*DatarefValue, error) {
+	return r.client.GetValue(ctx, r.name)
+}
+
+// Set writes the value of the dataref.
+func (r *DatarefRef) Set(ctx context.Context, value any) error {
+	return r.client.SetValue(ctx, r.name, value)
+}
+
+// Subscribe calls the specified handler with each updated value of the dataref until the context
+// is done, as with [Client.Watch].
+func (r *DatarefRef) Subscribe(
+	ctx context.Context,
+	handler DatarefValueHandler,
+	opts ...WatchOption,
+) error {
+	return r.client.Watch(ctx, r.name, handler, opts...)
+}
+
+// CommandRef is a handle to a single command, offering the common operations without regard to
+// whether they are performed via the REST API or the websocket service.
+//
+//	starter := client.Command("sim/engines/engage_starters")
+//	err := starter.Activate(ctx, 2)
+type CommandRef struct {
+	client *Client
+	name   string
+}
+
+// Command returns a [CommandRef] for the command with the specified name.  The name is not
+// resolved until an operation is performed.
+func (c *Client) Command(name string) *CommandRef {
+	return &CommandRef{client: c, name: name}
+}
+
+// Name returns the name of the command.
+func (r *CommandRef) Name() string {
+	return r.name
+}
+
+// Activate activates the command for the specified duration, in seconds, using the selected
+// [Transport].
+func (r *CommandRef) Activate(ctx context.Context, duration float64) error {
+	return r.client.ActivateCommand(ctx, r.name, duration)
+}
+
+// Trigger activates the command according to its [CommandKind], as with [Client.TriggerCommand].
+func (r *CommandRef) Trigger(ctx context.Context) error {
+	return r.client.TriggerCommand(ctx, r.name)
+}
+
+// Hold begins holding the command active indefinitely, until [CommandRef.Release] is called, as
+// with [Client.HoldCommand].
+func (r *CommandRef) Hold(ctx context.Context) error {
+	return r.client.HoldCommand(ctx, r.name)
+}
+
+// Release stops holding the command active, as with [Client.ReleaseCommand].
+func (r *CommandRef) Release(ctx context.Context) error {
+	return r.client.ReleaseCommand(ctx, r.name)
+}
+
+// Subscribe calls the specified handler whenever the active status of the command changes, until
+// the context is done.  The websocket must be connected.
+func (r *CommandRef) Subscribe(ctx context.Context, handler func(isActive bool)) error {
+	cmd, err := r.client.LookupCommand(r.name)
+	if err != nil {
+		return err
+	}
+	wsc := r.client.WS
+
+	// updates are matched, and the subscription released, by name rather than ID, as IDs may
+	// change if the simulator is restarted
+	name := cmd.Name
+	listenerID := wsc.addCommandListener(func(msg *WSMessageCommandUpdate) {
+		for _, status := range msg.Data {
+			if status.Command != nil && status.Command.Name == name {
+				handler(status.IsActive)
+			}
+		}
+	})
+	if err := wsc.subscribeCommandIDs(cmd.ID); err != nil {
+		wsc.removeCommandListener(listenerID)
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		wsc.removeCommandListener(listenerID)
+		if cmd, err := r.client.LookupCommand(name); err == nil {
+			wsc.unsubscribeCommandIDs(cmd.ID)
+		}
+	}()
+	return nil
+}
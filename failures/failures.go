@@ -0,0 +1,199 @@
+// Package failures injects and clears system failures, for instructor station style tools.  Each
+// failable system has a dataref in the sim/operation/failures/rel_ family, whose value is the
+// [Mode] which determines when the system fails.
+//
+//	fail := failures.New(client)
+//	if err := fail.Fail(ctx, drefoperation.SimOperationFailures_rel_engfai0); err != nil {
+//		return err
+//	}
+//	...
+//	err := fail.ClearAll(ctx)
+//
+// Systems are identified by the full name of their failure dataref.  The client's cache must be
+// loaded, or LazyCache enabled.
+package failures
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/dataref/drefoperation"
+)
+
+// Prefix is the common prefix of the names of failure datarefs.
+const Prefix = "sim/operation/failures/rel_"
+
+// Mode determines when a system fails.
+type Mode int
+
+const (
+	// The system always works.
+	ModeWorking Mode = 0
+	// The system fails randomly, according to the mean time between failures set with
+	// [Failures.SetMTBF].
+	ModeMTBF Mode = 1
+	// The system fails at an exact time.
+	ModeAtTime Mode = 2
+	// The system fails at an exact indicated airspeed.
+	ModeAtSpeed Mode = 3
+	// The system fails at an exact altitude above ground level.
+	ModeAtAltitude Mode = 4
+	// The system fails when the failure command or key is activated.
+	ModeOnCommand Mode = 5
+	// The system is failed.
+	ModeInoperative Mode = 6
+)
+
+// String returns a description of the mode.
+func (m Mode) String() string {
+	switch m {
+	case ModeWorking:
+		return "working"
+	case ModeMTBF:
+		return "mean time between failures"
+	case ModeAtTime:
+		return "fail at time"
+	case ModeAtSpeed:
+		return "fail at speed"
+	case ModeAtAltitude:
+		return "fail at altitude"
+	case ModeOnCommand:
+		return "fail on command"
+	case ModeInoperative:
+		return "inoperative"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// Failure is the failure state of a single system.
+type Failure struct {
+	// The name of the failure dataref.
+	Name string
+	Mode Mode
+}
+
+// System returns the name of the system, which is the dataref name without the common prefix.
+func (f Failure) System() string {
+	return strings.TrimPrefix(f.Name, Prefix)
+}
+
+// Failures injects and clears system failures.
+type Failures struct {
+	client *xpweb.Client
+}
+
+// New instantiates and returns a pointer to a new [Failures] object which uses the specified
+// client.
+func New(client *xpweb.Client) *Failures {
+	return &Failures{client: client}
+}
+
+// List returns the failure state of every failable system, sorted by name.  The values are read
+// via REST, concurrently as configured by the BatchConcurrency value of the [xpweb.ClientConfig].
+func (f *Failures) List(ctx context.Context) ([]Failure, error) {
+	values, err := f.client.DumpValues(ctx, Prefix)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]Failure, 0, len(values))
+	for name, value := range values {
+		mode, ok := value.(float64)
+		if !ok {
+			continue
+		}
+		list = append(list, Failure{Name: name, Mode: Mode(mode)})
+	}
+	slices.SortFunc(list, func(a, b Failure) int { return strings.Compare(a.Name, b.Name) })
+	return list, nil
+}
+
+// Active returns the failure state of each system which is not in [ModeWorking], sorted by name.
+func (f *Failures) Active(ctx context.Context) ([]Failure, error) {
+	list, err := f.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(list, func(failure Failure) bool {
+		return failure.Mode == ModeWorking
+	}), nil
+}
+
+// Mode returns the failure mode of the system with the specified failure dataref.
+func (f *Failures) Mode(ctx context.Context, name string) (Mode, error) {
+	if err := checkName(name); err != nil {
+		return 0, err
+	}
+	val, err := f.client.GetValue(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return Mode(val.GetIntValue()), nil
+}
+
+// SetMode sets the failure mode of the system with the specified failure dataref.  The thresholds
+// for [ModeAtTime], [ModeAtSpeed], and [ModeAtAltitude] are not available as datarefs, so they
+// must be configured in the simulator's failures window.
+func (f *Failures) SetMode(ctx context.Context, name string, mode Mode) error {
+	if err := checkName(name); err != nil {
+		return err
+	}
+	if mode < ModeWorking || mode > ModeInoperative {
+		return fmt.Errorf("invalid failure mode: %s", mode)
+	}
+	return f.client.SetValue(ctx, name, int(mode))
+}
+
+// Fail fails the system with the specified failure dataref immediately.
+func (f *Failures) Fail(ctx context.Context, name string) error {
+	return f.SetMode(ctx, name, ModeInoperative)
+}
+
+// Repair returns the system with the specified failure dataref to working order.
+func (f *Failures) Repair(ctx context.Context, name string) error {
+	return f.SetMode(ctx, name, ModeWorking)
+}
+
+// ClearAll returns every system which is not in [ModeWorking] to working order, and disables
+// random failures.
+func (f *Failures) ClearAll(ctx context.Context) error {
+	active, err := f.Active(ctx)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]any, len(active)+1)
+	for _, failure := range active {
+		values[failure.Name] = int(ModeWorking)
+	}
+	values[drefoperation.SimOperationFailures_enable_random_failures] = 0
+	return f.client.REST.SetDatarefValues(ctx, values)
+}
+
+// SetMTBF sets the mean time between failures, in hours, which applies to systems in [ModeMTBF].
+func (f *Failures) SetMTBF(ctx context.Context, hours float64) error {
+	if hours <= 0 {
+		return fmt.Errorf("invalid mean time between failures %v: must be positive", hours)
+	}
+	return f.client.SetValue(ctx, drefoperation.SimOperationFailures_mean_time_between_failure_hrs,
+		hours)
+}
+
+// SetRandomFailures enables or disables random failures of all systems, according to the mean time
+// between failures.
+func (f *Failures) SetRandomFailures(ctx context.Context, enabled bool) error {
+	value := 0
+	if enabled {
+		value = 1
+	}
+	return f.client.SetValue(ctx, drefoperation.SimOperationFailures_enable_random_failures, value)
+}
+
+// checkName returns an error if the specified name is not a failure dataref.
+func checkName(name string) error {
+	if !strings.HasPrefix(name, Prefix) {
+		return fmt.Errorf("not a failure dataref: %s", name)
+	}
+	return nil
+}
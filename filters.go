@@ -0,0 +1,137 @@
+package xpweb
+
+import "slices"
+
+// Filter smooths a stream of numeric values.  Each call to Apply supplies the next raw value in
+// the stream, and returns the smoothed value.  A Filter holds state and must not be shared between
+// streams.
+type Filter interface {
+	Apply(value float64) float64
+}
+
+// emaFilter is an exponential moving average Filter.
+type emaFilter struct {
+	alpha   float64
+	value   float64
+	started bool
+}
+
+// NewEMAFilter returns a [Filter] which computes an exponential moving average.  The alpha value,
+// between 0 and 1, is the weight given to each new value; smaller values smooth more heavily.
+func NewEMAFilter(alpha float64) Filter {
+	return &emaFilter{alpha: min(max(alpha, 0), 1)}
+}
+
+func (f *emaFilter) Apply(value float64) float64 {
+	if !f.started {
+		f.value, f.started = value, true
+	} else {
+		f.value += f.alpha * (value - f.value)
+	}
+	return f.value
+}
+
+// windowFilter retains the most recent values in a fixed size window.
+type windowFilter struct {
+	window []float64
+	next   int
+	full   bool
+}
+
+func (f *windowFilter) add(value float64) []float64 {
+	f.window[f.next] = value
+	f.next = (f.next + 1) % len(f.window)
+	if f.next == 0 {
+		f.full = true
+	}
+	if f.full {
+		return f.window
+	}
+	return f.window[:f.next]
+}
+
+// movingAverageFilter is a simple moving average Filter.
+type movingAverageFilter struct {
+	windowFilter
+}
+
+// NewMovingAverageFilter returns a [Filter] which computes the average of the most recent size
+// values.
+func NewMovingAverageFilter(size int) Filter {
+	return &movingAverageFilter{windowFilter{window: make([]float64, max(size, 1))}}
+}
+
+func (f *movingAverageFilter) Apply(value float64) float64 {
+	values := f.add(value)
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// medianFilter is a moving median Filter.
+type medianFilter struct {
+	windowFilter
+	sorted []float64
+}
+
+// NewMedianFilter returns a [Filter] which computes the median of the most recent size values.
+// This is effective at rejecting occasional spikes.
+func NewMedianFilter(size int) Filter {
+	size = max(size, 1)
+	return &medianFilter{
+		windowFilter: windowFilter{window: make([]float64, size)},
+		sorted:       make([]float64, 0, size),
+	}
+}
+
+func (f *medianFilter) Apply(value float64) float64 {
+	values := f.add(value)
+	f.sorted = append(f.sorted[:0], values...)
+	slices.Sort(f.sorted)
+	mid := len(f.sorted) / 2
+	if len(f.sorted)%2 == 0 {
+		return (f.sorted[mid-1] + f.sorted[mid]) / 2
+	}
+	return f.sorted[mid]
+}
+
+// filterState applies a separate Filter to each element of a dataref value.
+type filterState struct {
+	newFilter func() Filter
+	filters   []Filter
+}
+
+// apply returns a copy of the specified value with its numeric value(s) filtered.  Values which
+// are not numeric are returned unchanged.
+func (s *filterState) apply(val *DatarefValue) *DatarefValue {
+	switch raw := val.Value.(type) {
+	case float64:
+		return &DatarefValue{Dataref: val.Dataref, Value: s.filter(0, raw)}
+	case []float64:
+		filtered := make([]float64, len(raw))
+		for idx, num := range raw {
+			filtered[idx] = s.filter(idx, num)
+		}
+		return &DatarefValue{Dataref: val.Dataref, Value: filtered}
+	case []any:
+		filtered := make([]any, len(raw))
+		for idx, elem := range raw {
+			if num, ok := elem.(float64); ok {
+				filtered[idx] = s.filter(idx, num)
+			} else {
+				filtered[idx] = elem
+			}
+		}
+		return &DatarefValue{Dataref: val.Dataref, Value: filtered}
+	}
+	return val
+}
+
+func (s *filterState) filter(idx int, value float64) float64 {
+	for len(s.filters) <= idx {
+		s.filters = append(s.filters, s.newFilter())
+	}
+	return s.filters[idx].Apply(value)
+}
@@ -0,0 +1,261 @@
+// Package flightplan reads and writes flight plans in the X-Plane .fms format, which is loaded by
+// the simulator's FMS and GPS units from the Output/FMS plans folder, so that routes may be shared
+// with electronic flight bag applications.
+//
+//	plan, err := flightplan.Load("KSEAKPDX.fms")
+//	if err != nil {
+//		return err
+//	}
+//	for _, wpt := range plan.Waypoints {
+//		fmt.Println(wpt.Ident, wpt.Lat, wpt.Lon)
+//	}
+//
+// The web API does not expose the FMS flight plan, so plans cannot be read from or sent to a
+// running simulator directly.  Write the plan to the Output/FMS plans folder of the simulator
+// installation, and load it from the FMS.
+//
+// Version 11 files are read and written.  Version 3 files, from X-Plane 10 and earlier, are also
+// read, although they lack the procedure and airway information of version 11.
+package flightplan
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// WaypointType is the kind of navaid or fix identified by a [Waypoint].
+type WaypointType int
+
+const (
+	WaypointAirport WaypointType = 1
+	WaypointNDB     WaypointType = 2
+	WaypointVOR     WaypointType = 3
+	WaypointFix     WaypointType = 11
+	WaypointLatLon  WaypointType = 28
+)
+
+// Via values with special meaning.  Any other value is the name of an airway, SID, or STAR by
+// which the waypoint is reached.
+const (
+	ViaDeparture   = "ADEP"
+	ViaDestination = "ADES"
+	ViaDirect      = "DRCT"
+)
+
+// Waypoint is a single entry of the en route portion of a [FlightPlan].
+type Waypoint struct {
+	Type  WaypointType
+	Ident string
+	// How the waypoint is reached, such as ViaDirect or an airway name.  This is empty for
+	// waypoints read from version 3 files.
+	Via string
+	// The altitude in feet, or 0 if none is specified.
+	Altitude float64
+	Lat      float64
+	Lon      float64
+}
+
+// FlightPlan is a route which may be loaded by the simulator's FMS.  The procedure fields are
+// optional, and are identified as in the simulator's navigation data.
+type FlightPlan struct {
+	// The AIRAC cycle of the navigation data used to build the plan, such as "2301".
+	Cycle string
+
+	Departure       string
+	DepartureRunway string
+	SID             string
+	SIDTransition   string
+
+	Destination        string
+	DestinationRunway  string
+	STAR               string
+	STARTransition     string
+	Approach           string
+	ApproachTransition string
+
+	Waypoints []Waypoint
+}
+
+// ErrFormat is matched by errors returned when a flight plan file is malformed.
+var ErrFormat = errors.New("malformed flight plan")
+
+// Load reads a [FlightPlan] from the .fms file at the specified path.
+func Load(path string) (*FlightPlan, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Parse(file)
+}
+
+// Parse reads a [FlightPlan] in the .fms format.
+func Parse(r io.Reader) (*FlightPlan, error) {
+	plan := &FlightPlan{}
+	headers := map[string]*string{
+		"CYCLE":     &plan.Cycle,
+		"ADEP":      &plan.Departure,
+		"DEP":       &plan.Departure,
+		"DEPRWY":    &plan.DepartureRunway,
+		"SID":       &plan.SID,
+		"SIDTRANS":  &plan.SIDTransition,
+		"ADES":      &plan.Destination,
+		"DES":       &plan.Destination,
+		"DESRWY":    &plan.DestinationRunway,
+		"STAR":      &plan.STAR,
+		"STARTRANS": &plan.STARTransition,
+		"APP":       &plan.Approach,
+		"APPTRANS":  &plan.ApproachTransition,
+	}
+
+	scanner := bufio.NewScanner(r)
+	version := 0
+	num := 0
+	for scanner.Scan() {
+		num++
+		fields := strings.Fields(scanner.Text())
+		switch {
+		case len(fields) == 0:
+			continue
+		case num == 1:
+			// line ending convention: I (unix) or A (mac)
+			continue
+		case version == 0:
+			if len(fields) != 2 || !strings.EqualFold(fields[1], "version") {
+				return nil, fmt.Errorf("%w: line %d: expected version", ErrFormat, num)
+			}
+			var err error
+			if version, err = strconv.Atoi(fields[0]); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %w", ErrFormat, num, err)
+			}
+			if version != 3 && version != 1100 {
+				return nil, fmt.Errorf("%w: unsupported version %d", ErrFormat, version)
+			}
+			continue
+		}
+
+		if field, ok := headers[fields[0]]; ok && len(fields) == 2 {
+			*field = fields[1]
+			continue
+		}
+		if fields[0] == "NUMENR" || len(fields) == 1 {
+			// the waypoint count, or in version 3 files, the count and an unused field
+			continue
+		}
+
+		wpt, err := parseWaypoint(fields, version)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: %w", ErrFormat, num, err)
+		}
+		plan.Waypoints = append(plan.Waypoints, wpt)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		return nil, fmt.Errorf("%w: missing version", ErrFormat)
+	}
+
+	if version == 3 && len(plan.Waypoints) > 0 {
+		first, last := plan.Waypoints[0], plan.Waypoints[len(plan.Waypoints)-1]
+		if first.Type == WaypointAirport {
+			plan.Departure = first.Ident
+		}
+		if last.Type == WaypointAirport {
+			plan.Destination = last.Ident
+		}
+	}
+	return plan, nil
+}
+
+// parseWaypoint parses the fields of a waypoint line: type, ident, via (version 11 only),
+// altitude, latitude, and longitude.
+func parseWaypoint(fields []string, version int) (Waypoint, error) {
+	want := 5
+	if version != 3 {
+		want = 6
+	}
+	if len(fields) != want {
+		return Waypoint{}, fmt.Errorf("expected %d waypoint fields, got %d", want, len(fields))
+	}
+
+	wpt := Waypoint{Ident: fields[1]}
+	wptType, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return Waypoint{}, fmt.Errorf("waypoint type: %w", err)
+	}
+	wpt.Type = WaypointType(wptType)
+	if version != 3 {
+		wpt.Via = fields[2]
+		fields = fields[1:]
+	}
+
+	for idx, dest := range []*float64{&wpt.Altitude, &wpt.Lat, &wpt.Lon} {
+		if *dest, err = strconv.ParseFloat(fields[2+idx], 64); err != nil {
+			return Waypoint{}, fmt.Errorf("waypoint %s: %w", wpt.Ident, err)
+		}
+	}
+	return wpt, nil
+}
+
+// Save writes the flight plan to the .fms file at the specified path.
+func (p *FlightPlan) Save(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := p.WriteTo(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// WriteTo writes the flight plan in the version 11 .fms format.  Waypoints without a Via value
+// are written as reached directly, except that an airport as the first or last waypoint is
+// written as the departure or destination.
+func (p *FlightPlan) WriteTo(w io.Writer) (int64, error) {
+	var sb strings.Builder
+	sb.WriteString("I\n1100 Version\n")
+
+	header := func(key string, value string) {
+		if value != "" {
+			fmt.Fprintf(&sb, "%s %s\n", key, value)
+		}
+	}
+	header("CYCLE", p.Cycle)
+	header("ADEP", p.Departure)
+	header("DEPRWY", p.DepartureRunway)
+	header("SID", p.SID)
+	header("SIDTRANS", p.SIDTransition)
+	header("ADES", p.Destination)
+	header("DESRWY", p.DestinationRunway)
+	header("STAR", p.STAR)
+	header("STARTRANS", p.STARTransition)
+	header("APP", p.Approach)
+	header("APPTRANS", p.ApproachTransition)
+
+	fmt.Fprintf(&sb, "NUMENR %d\n", len(p.Waypoints))
+	for idx, wpt := range p.Waypoints {
+		via := wpt.Via
+		switch {
+		case via != "":
+		case idx == 0 && wpt.Type == WaypointAirport:
+			via = ViaDeparture
+		case idx == len(p.Waypoints)-1 && wpt.Type == WaypointAirport:
+			via = ViaDestination
+		default:
+			via = ViaDirect
+		}
+		fmt.Fprintf(&sb, "%d %s %s %.6f %.6f %.6f\n", wpt.Type, wpt.Ident, via, wpt.Altitude,
+			wpt.Lat, wpt.Lon)
+	}
+
+	written, err := io.WriteString(w, sb.String())
+	return int64(written), err
+}
@@ -0,0 +1,159 @@
+// Package gateway provides an http.Handler which exposes a simplified, name-based JSON API for
+// reading and writing datarefs and activating commands, along with a server-sent events stream of
+// dataref values.  Requests are performed through an [xpweb.Client], so that web dashboards need
+// not communicate with the simulator directly.
+//
+//	http.Handle("/sim/", http.StripPrefix("/sim", gateway.New(client)))
+//
+// The following endpoints are served:
+//
+//	GET  /dataref/{name}             returns {"name": ..., "value": ...}
+//	PUT  /dataref/{name}             writes the value of a {"value": ...} body
+//	POST /command/{name}?duration=N  activates the command for N seconds, default 0
+//	GET  /events?dataref={name}&...  streams {"name": ..., "value": ...} events as values change
+package gateway
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/janeprather/xpweb"
+)
+
+// Handler serves the gateway API.
+type Handler struct {
+	client *xpweb.Client
+	mux    *http.ServeMux
+}
+
+// value is the JSON representation of a dataref value.
+type value struct {
+	Name  string `json:"name"`
+	Value any    `json:"value"`
+}
+
+// errorBody is the JSON representation of a failed request.
+type errorBody struct {
+	Error string `json:"error"`
+}
+
+// New instantiates and returns a pointer to a new [Handler] which performs requests through the
+// specified client, using its selected [xpweb.Transport].
+func New(client *xpweb.Client) *Handler {
+	h := &Handler{client: client, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /dataref/{name...}", h.getDataref)
+	h.mux.HandleFunc("PUT /dataref/{name...}", h.setDataref)
+	h.mux.HandleFunc("POST /command/{name...}", h.activateCommand)
+	h.mux.HandleFunc("GET /events", h.events)
+	return h
+}
+
+// ServeHTTP allows Handler to implement the http.Handler interface.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *Handler) getDataref(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	val, err := h.client.GetValue(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &value{Name: name, Value: val.Value})
+}
+
+func (h *Handler) setDataref(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	body := &value{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		writeJSON(w, http.StatusBadRequest, &errorBody{Error: "invalid body: " + err.Error()})
+		return
+	}
+	if err := h.client.SetValue(r.Context(), name, body.Value); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) activateCommand(w http.ResponseWriter, r *http.Request) {
+	var duration float64
+	if text := r.URL.Query().Get("duration"); text != "" {
+		var err error
+		if duration, err = strconv.ParseFloat(text, 64); err != nil {
+			writeJSON(w, http.StatusBadRequest, &errorBody{Error: "invalid duration"})
+			return
+		}
+	}
+	if err := h.client.ActivateCommand(r.Context(), r.PathValue("name"), duration); err != nil {
+		writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// events streams the values of the requested datarefs as server-sent events until the client
+// disconnects.
+func (h *Handler) events(w http.ResponseWriter, r *http.Request) {
+	names := r.URL.Query()["dataref"]
+	if len(names) == 0 {
+		writeJSON(w, http.StatusBadRequest, &errorBody{Error: "no dataref specified"})
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSON(w, http.StatusInternalServerError, &errorBody{Error: "streaming unsupported"})
+		return
+	}
+
+	updates, err := h.client.SubscribeFor(r.Context(), names...)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for msg := range updates {
+		for _, val := range msg.Data {
+			data, err := json.Marshal(&value{Name: val.Dataref.Name, Value: val.Value})
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: dataref\ndata: %s\n\n", data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// writeError writes an error response with a status code appropriate to the error.
+func writeError(w http.ResponseWriter, err error) {
+	status := http.StatusBadGateway
+	switch {
+	case errors.Is(err, xpweb.ErrDatarefNotFound), errors.Is(err, xpweb.ErrCommandNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, xpweb.ErrInvalidValue), errors.Is(err, xpweb.ErrInvalidDuration):
+		status = http.StatusBadRequest
+	case errors.Is(err, xpweb.ErrNotWriter):
+		status = http.StatusConflict
+	case errors.Is(err, xpweb.ErrNotConnected):
+		status = http.StatusServiceUnavailable
+	}
+	writeJSON(w, status, &errorBody{Error: err.Error()})
+}
+
+// writeJSON writes the JSON encoding of the body with the specified status code.
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
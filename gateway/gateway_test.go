@@ -0,0 +1,143 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// newMockServer returns a gateway server for a client whose selected transport is a
+// MockTransport, with a float dataref and a command in its cache.
+func newMockServer(t *testing.T) (*httptest.Server, *xpweb.MockTransport) {
+	t.Helper()
+	client, err := xpweb.NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cachePath := filepath.Join(t.TempDir(), "cache.json")
+	cache := `{"datarefs":[{"id":1,"name":"sim/test/float","value_type":"float",` +
+		`"is_writable":true}],"commands":[{"id":2,"name":"sim/test/command"}]}`
+	if err := os.WriteFile(cachePath, []byte(cache), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.LoadCacheFromFile(cachePath); err != nil {
+		t.Fatal(err)
+	}
+	transport := client.NewMockTransport(map[string]any{"sim/test/float": 1.5})
+	client.SetTransport(transport)
+
+	server := httptest.NewServer(New(client))
+	t.Cleanup(server.Close)
+	return server, transport
+}
+
+// request performs a request of the gateway, and fails the test unless the response has the
+// expected status code.  It returns the response body.
+func request(t *testing.T, method, url, body string, status int) string {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	data := &strings.Builder{}
+	bufio.NewReader(res.Body).WriteTo(data)
+	if res.StatusCode != status {
+		t.Fatalf("%s %s returned %d %s, expected %d", method, url, res.StatusCode, data, status)
+	}
+	return strings.TrimSpace(data.String())
+}
+
+func TestDatarefHandlers(t *testing.T) {
+	server, transport := newMockServer(t)
+	url := server.URL + "/dataref/sim/test/float"
+
+	if got := request(t, http.MethodGet, url, "", http.StatusOK); got !=
+		`{"name":"sim/test/float","value":1.5}` {
+		t.Errorf("GET returned %s", got)
+	}
+	request(t, http.MethodPut, url, `{"value":2.5}`, http.StatusNoContent)
+	val, err := transport.GetDatarefValue(context.Background(), "sim/test/float")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if val.Value != 2.5 {
+		t.Errorf("PUT wrote %v, expected 2.5", val.Value)
+	}
+
+	request(t, http.MethodPut, url, `{"value":`, http.StatusBadRequest)
+	request(t, http.MethodGet, server.URL+"/dataref/sim/test/missing", "", http.StatusNotFound)
+}
+
+func TestCommandHandler(t *testing.T) {
+	server, transport := newMockServer(t)
+	url := server.URL + "/command/sim/test/command"
+
+	request(t, http.MethodPost, url, "", http.StatusNoContent)
+	request(t, http.MethodPost, url+"?duration=0.5", "", http.StatusNoContent)
+	request(t, http.MethodPost, url+"?duration=soon", "", http.StatusBadRequest)
+	request(t, http.MethodPost, url+"?duration=-1", "", http.StatusBadRequest)
+	request(t, http.MethodPost, server.URL+"/command/sim/test/missing", "", http.StatusNotFound)
+
+	want := []xpweb.MockCommand{
+		{Name: "sim/test/command", Duration: 0},
+		{Name: "sim/test/command", Duration: 0.5},
+	}
+	got := transport.Commands()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("activated %+v, expected %+v", got, want)
+	}
+}
+
+func TestEventsHandler(t *testing.T) {
+	server, transport := newMockServer(t)
+	request(t, http.MethodGet, server.URL+"/events", "", http.StatusBadRequest)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		server.URL+"/events?dataref=sim/test/float", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	if ct := res.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("events served as %s", ct)
+	}
+
+	// the current value is streamed on subscription, and each published value after it
+	lines := bufio.NewScanner(res.Body)
+	expectEvent := func(want string) {
+		t.Helper()
+		for lines.Scan() {
+			if data, ok := strings.CutPrefix(lines.Text(), "data: "); ok {
+				if data != want {
+					t.Fatalf("received event %s, expected %s", data, want)
+				}
+				return
+			}
+		}
+		t.Fatalf("stream ended, expected event %s: %v", want, lines.Err())
+	}
+	expectEvent(`{"name":"sim/test/float","value":1.5}`)
+	if err := transport.Publish("sim/test/float", 3.0); err != nil {
+		t.Fatal(err)
+	}
+	expectEvent(`{"name":"sim/test/float","value":3}`)
+}
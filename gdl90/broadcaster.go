@@ -0,0 +1,159 @@
+package gdl90
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+	"github.com/janeprather/xpweb/traffic"
+)
+
+// DefaultAddr is the default destination of broadcast messages.
+const DefaultAddr = "255.255.255.255:4000"
+
+// Broadcaster periodically sends the state of the user's aircraft and its traffic as GDL90
+// messages.
+type Broadcaster struct {
+	client *xpweb.Client
+	// The UDP address to which messages are sent.  This may be a broadcast address, or the
+	// address of a single tablet.
+	Addr string
+	// The time between updates.  If not positive, one second is used.
+	Interval time.Duration
+	// The ICAO address, call sign, and emitter category of the user's aircraft.
+	Address  uint32
+	Callsign string
+	Emitter  byte
+	// The device name reported to EFBs, of up to 8 characters.
+	DeviceName string
+	// An optional logger for errors reading from the simulator.  If nil, slog.Default() is used.
+	Logger *slog.Logger
+}
+
+// New instantiates and returns a pointer to a new [Broadcaster] which reads from the specified
+// client, and sends to DefaultAddr once per second.
+func New(client *xpweb.Client) *Broadcaster {
+	return &Broadcaster{
+		client:     client,
+		Addr:       DefaultAddr,
+		Interval:   time.Second,
+		Address:    0xF00000,
+		Callsign:   "XPLANE",
+		Emitter:    EmitterLight,
+		DeviceName: "X-Plane",
+	}
+}
+
+func (b *Broadcaster) logger() *slog.Logger {
+	if b.Logger != nil {
+		return b.Logger
+	}
+	return slog.Default()
+}
+
+func (b *Broadcaster) interval() time.Duration {
+	if b.Interval > 0 {
+		return b.Interval
+	}
+	return time.Second
+}
+
+// Run sends messages at the configured interval until the context is done.  Errors reading from
+// the simulator are logged, and the update skipped, so that the stream resumes when the
+// simulator is available again.  An error sending messages stops the broadcaster.
+func (b *Broadcaster) Run(ctx context.Context) error {
+	conn, err := net.Dial("udp", b.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ticker := time.NewTicker(b.interval())
+	defer ticker.Stop()
+	for {
+		msgs, err := b.Messages(ctx)
+		if err != nil && ctx.Err() == nil {
+			b.logger().Error("failed to read GDL90 update", "error", err)
+		}
+		for _, msg := range msgs {
+			if _, err := conn.Write(Frame(msg)); err != nil {
+				return fmt.Errorf("sending GDL90 message: %w", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Messages reads the current state from the simulator and returns the unframed messages of a
+// single update.
+func (b *Broadcaster) Messages(ctx context.Context) ([][]byte, error) {
+	pos, err := b.client.Position(ctx)
+	if err != nil {
+		return nil, err
+	}
+	onGround, err := b.client.GetValue(ctx, drefflightmodel.SimFlightmodelFailures_onground_any)
+	if err != nil {
+		return nil, err
+	}
+	reports, err := b.readTraffic(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	altitude := pos.Elevation * feetPerM
+	msgs := [][]byte{
+		Heartbeat(pos.Time),
+		ForeFlightID(b.DeviceName, b.DeviceName),
+		OwnshipReport(Report{
+			Address:       b.Address,
+			Callsign:      b.Callsign,
+			Emitter:       b.Emitter,
+			Latitude:      pos.Latitude,
+			Longitude:     pos.Longitude,
+			Altitude:      altitude,
+			Track:         pos.Track,
+			GroundSpeed:   pos.GroundSpeed * knotsPerMS,
+			VerticalSpeed: pos.VerticalSpeed,
+			Airborne:      onGround.GetIntValue() == 0,
+		}),
+		OwnshipGeoAltitude(altitude),
+		ForeFlightAHRS(pos.Pitch, pos.Roll, pos.Heading),
+	}
+	for _, report := range reports {
+		msgs = append(msgs, TrafficReport(report))
+	}
+	return msgs, nil
+}
+
+// readTraffic returns reports for the simulator's traffic.
+func (b *Broadcaster) readTraffic(ctx context.Context) ([]Report, error) {
+	targets, err := traffic.Read(ctx, b.client)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]Report, len(targets))
+	for idx, target := range targets {
+		reports[idx] = Report{
+			Address:       target.ModeS,
+			Callsign:      target.Callsign,
+			Emitter:       EmitterUnknown,
+			Latitude:      target.Latitude,
+			Longitude:     target.Longitude,
+			Altitude:      target.Elevation * feetPerM,
+			Track:         target.Track,
+			GroundSpeed:   target.GroundSpeed * knotsPerMS,
+			VerticalSpeed: target.VerticalSpeed,
+			Airborne:      !target.OnGround,
+		}
+	}
+	return reports, nil
+}
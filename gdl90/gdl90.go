@@ -0,0 +1,261 @@
+// Package gdl90 broadcasts the user's aircraft and the simulator's traffic as GDL90 messages over
+// UDP, so that electronic flight bag applications on tablets receive the simulator as though it
+// were a portable ADS-B receiver.
+//
+//	b := gdl90.New(client)
+//	b.Callsign = "N172SP"
+//	err := b.Run(ctx)
+//
+// Each second, the broadcaster sends a heartbeat, an ownship report, the ownship geometric
+// altitude, a report for each TCAS target, and the ForeFlight extension's device identification
+// and AHRS messages, which carry the attitude of the aircraft.  EFBs generally listen on UDP port
+// 4000, which is the default destination.
+//
+// The message encoders are exported, for applications which send GDL90 by other means.
+package gdl90
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+)
+
+// Message IDs.
+const (
+	MessageHeartbeat          = 0
+	MessageOwnship            = 10
+	MessageOwnshipGeoAltitude = 11
+	MessageTraffic            = 20
+	MessageForeFlight         = 0x65
+)
+
+// ForeFlight extension sub-IDs.
+const (
+	foreFlightID   = 0
+	foreFlightAHRS = 1
+)
+
+const (
+	// flagByte delimits frames.
+	flagByte = 0x7E
+	// escapeByte precedes a flag or escape byte within a frame, which is then XORed with 0x20.
+	escapeByte = 0x7D
+)
+
+// Emitter categories, which determine the symbol shown for a target.
+const (
+	EmitterUnknown    = 0
+	EmitterLight      = 1
+	EmitterSmall      = 2
+	EmitterLarge      = 3
+	EmitterHeavy      = 5
+	EmitterRotorcraft = 7
+)
+
+const (
+	feetPerM   = 3.28084
+	knotsPerMS = 1.943844
+	// unavailable16 marks a signed 16 bit field as having no data.
+	unavailable16 = 0x7FFF
+)
+
+// Report is the state of an aircraft, as sent in an ownship or traffic report.
+type Report struct {
+	// The 24 bit ICAO address of the aircraft.
+	Address uint32
+	// Up to 8 characters of call sign, or the registration.
+	Callsign string
+	// The emitter category, such as EmitterLight.
+	Emitter byte
+	// Latitude and longitude in degrees.
+	Latitude  float64
+	Longitude float64
+	// Altitude in feet.
+	Altitude float64
+	// True track over the ground in degrees.
+	Track float64
+	// Ground speed in knots.
+	GroundSpeed float64
+	// Vertical speed in feet per minute.
+	VerticalSpeed float64
+	// Whether the aircraft is in the air, rather than on the ground.
+	Airborne bool
+}
+
+// crcTable is the CRC-16-CCITT lookup table used for frame check sequences.
+var crcTable = func() (table [256]uint16) {
+	for idx := range table {
+		crc := uint16(idx) << 8
+		for range 8 {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+		table[idx] = crc
+	}
+	return table
+}()
+
+// Frame returns the message, which begins with its message ID, with its frame check sequence
+// appended, escaped, and delimited with flag bytes for transmission.
+func Frame(msg []byte) []byte {
+	var crc uint16
+	for _, b := range msg {
+		crc = crcTable[crc>>8] ^ crc<<8 ^ uint16(b)
+	}
+	body := binary.LittleEndian.AppendUint16(append([]byte{}, msg...), crc)
+
+	frame := make([]byte, 0, len(body)+4)
+	frame = append(frame, flagByte)
+	for _, b := range body {
+		if b == flagByte || b == escapeByte {
+			frame = append(frame, escapeByte, b^0x20)
+			continue
+		}
+		frame = append(frame, b)
+	}
+	return append(frame, flagByte)
+}
+
+// Heartbeat returns a heartbeat message reporting a valid GPS position at the specified time.
+func Heartbeat(t time.Time) []byte {
+	t = t.UTC()
+	seconds := t.Hour()*3600 + t.Minute()*60 + t.Second()
+	// GPS position valid, UAT initialized
+	status1 := byte(0x81)
+	// UTC timing valid, with the 17th bit of the timestamp
+	status2 := byte(0x01) | byte(seconds>>16&1)<<7
+	return []byte{MessageHeartbeat, status1, status2, byte(seconds), byte(seconds >> 8), 0, 0}
+}
+
+// OwnshipReport returns an ownship report message for the user's aircraft.
+func OwnshipReport(r Report) []byte {
+	return encodeReport(MessageOwnship, r)
+}
+
+// TrafficReport returns a traffic report message for another aircraft.
+func TrafficReport(r Report) []byte {
+	return encodeReport(MessageTraffic, r)
+}
+
+// encodeReport returns an ownship or traffic report, which share the same format.
+func encodeReport(id byte, r Report) []byte {
+	msg := make([]byte, 28)
+	msg[0] = id
+	// no alert, ADS-B with ICAO address
+	msg[1] = 0x00
+	putUint24(msg[2:], r.Address)
+	putUint24(msg[5:], uint32(encodeAngle(r.Latitude)))
+	putUint24(msg[8:], uint32(encodeAngle(r.Longitude)))
+
+	altitude := uint16(clamp(math.Round((r.Altitude+1000)/25), 0, 0xFFE))
+	// true track, updated rather than extrapolated
+	misc := byte(0x01)
+	if r.Airborne {
+		misc |= 0x08
+	}
+	msg[11] = byte(altitude >> 4)
+	msg[12] = byte(altitude<<4) | misc
+	// navigation integrity and accuracy categories of a GPS fix
+	msg[13] = 0xA9
+
+	speed := uint16(clamp(math.Round(r.GroundSpeed), 0, 0xFFE))
+	vertical := uint16(int16(clamp(math.Round(r.VerticalSpeed/64), -0x1FE, 0x1FE))) & 0xFFF
+	msg[14] = byte(speed >> 4)
+	msg[15] = byte(speed<<4) | byte(vertical>>8)
+	msg[16] = byte(vertical)
+	msg[17] = byte(math.Round(math.Mod(r.Track+360, 360) / 360 * 256))
+	msg[18] = r.Emitter
+	copy(msg[19:27], encodeCallsign(r.Callsign))
+	return msg
+}
+
+// OwnshipGeoAltitude returns an ownship geometric altitude message for the specified altitude in
+// feet.
+func OwnshipGeoAltitude(feet float64) []byte {
+	msg := []byte{MessageOwnshipGeoAltitude, 0, 0, 0, 0}
+	binary.BigEndian.PutUint16(msg[1:], uint16(int16(clamp(math.Round(feet/5), -32768, 32767))))
+	// no vertical warning, 10 meter vertical figure of merit
+	binary.BigEndian.PutUint16(msg[3:], 10)
+	return msg
+}
+
+// ForeFlightID returns the ForeFlight extension's device identification message, which names the
+// device to EFBs and declares that geometric altitudes are above mean sea level.
+func ForeFlightID(name string, longName string) []byte {
+	msg := make([]byte, 39)
+	msg[0] = MessageForeFlight
+	msg[1] = foreFlightID
+	// message version
+	msg[2] = 1
+	// serial number unavailable
+	for idx := 3; idx < 11; idx++ {
+		msg[idx] = 0xFF
+	}
+	copy(msg[11:19], padText(name, 8))
+	copy(msg[19:35], padText(longName, 16))
+	// geometric altitude datum is mean sea level
+	binary.BigEndian.PutUint32(msg[35:], 1)
+	return msg
+}
+
+// ForeFlightAHRS returns the ForeFlight extension's attitude message, with angles in degrees and
+// the true heading.  Airspeeds are reported as unavailable.
+func ForeFlightAHRS(pitch, roll, heading float64) []byte {
+	msg := make([]byte, 12)
+	msg[0] = MessageForeFlight
+	msg[1] = foreFlightAHRS
+	tenths := func(degrees float64) uint16 {
+		return uint16(int16(clamp(math.Round(degrees*10), -3600, 3600)))
+	}
+	binary.BigEndian.PutUint16(msg[2:], tenths(roll))
+	binary.BigEndian.PutUint16(msg[4:], tenths(pitch))
+	binary.BigEndian.PutUint16(msg[6:], tenths(math.Mod(heading+360, 360)))
+	binary.BigEndian.PutUint16(msg[8:], unavailable16)
+	binary.BigEndian.PutUint16(msg[10:], unavailable16)
+	return msg
+}
+
+// encodeAngle returns a latitude or longitude as a 24 bit signed binary fraction of 180 degrees.
+func encodeAngle(degrees float64) int32 {
+	return int32(math.Round(clamp(degrees, -180, 180)*(1<<23)/180)) & 0xFFFFFF
+}
+
+// encodeCallsign returns the call sign as 8 upper case characters, padded with spaces, replacing
+// characters which may not be sent.
+func encodeCallsign(callsign string) []byte {
+	return padText(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		}
+		return -1
+	}, callsign), 8)
+}
+
+// padText returns the text truncated or padded with spaces to the specified length.
+func padText(text string, length int) []byte {
+	padded := []byte(text)
+	if len(padded) > length {
+		return padded[:length]
+	}
+	for len(padded) < length {
+		padded = append(padded, ' ')
+	}
+	return padded
+}
+
+func putUint24(dest []byte, value uint32) {
+	dest[0] = byte(value >> 16)
+	dest[1] = byte(value >> 8)
+	dest[2] = byte(value)
+}
+
+func clamp(value, low, high float64) float64 {
+	return max(low, min(high, value))
+}
@@ -3,11 +3,14 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"go/format"
 	"html/template"
 	"os"
 	"path"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"unicode"
@@ -20,9 +23,12 @@ func init() {
 	wordSepRe = regexp.MustCompile(`[-/ \[\]]+`)
 }
 
-// Item struct is either a dataref or command item with a name attribute.
+// Item struct is either a dataref or command item with a name attribute.  Datarefs also have a
+// value type and writability.
 type Item struct {
-	Name string `json:"name"`
+	Name       string `json:"name"`
+	ValueType  string `json:"value_type"`
+	IsWritable bool   `json:"is_writable"`
 }
 
 // ItemData is the way the data comes wrapped from /api/v2/datarefs or /api/v2/commands
@@ -34,20 +40,85 @@ const namesTemplate string = `//
 // This file is generated, and changes made directly to this file will be overwritten.  To update
 // this file, modify either {{ .JSONFile }} or gen_names.go and then execute 'go generate'.
 
+{{ if .Domain -}}
+// Package {{ .Package }} provides the known dataref names under {{ .Domain }}/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+{{- else -}}
 // Package {{ .Package }} provides known names as string constants to limit repetition of string
 // literals and the risk of typos that can't be caught during lint/compile.
+{{- end }}
 package {{ .Package }}
 
 const ({{ range .Items }}
-	{{ .Name | toIdentifier }} string = "{{ .Name }}"{{ end }}
+	{{ .Name | toIdentifier }} string = "{{ .Name }}"{{ if $.Domain }} // {{ .ValueType }}{{ if .IsWritable }}, writable{{ end }}{{ end }}{{ end }}
 )
 `
 
+// aliasTemplate is the template of a package which repeats the constants of the subpackage of
+// each domain, for consumers which use names from many domains.
+const aliasTemplate string = `//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either {{ .JSONFile }} or gen_names.go and then execute 'go generate'.
+
+// Package {{ .Package }} provides every known name as a string constant to limit repetition of string
+// literals and the risk of typos that can't be caught during lint/compile.
+//
+// The constants are defined by a subpackage for each domain, such as {{ .Example.Package }} for the names
+// under {{ .Example.Domain }}/, and are repeated here for convenience.  Importing this package compiles
+// every name, so libraries should import the domain packages instead.
+{{- if .Descriptors }}
+//
+// The value type and writability of each dataref is available at run time via [Lookup].
+{{- end }}
+package {{ .Package }}
+
+import (
+{{- if .Descriptors }}
+	"github.com/janeprather/xpweb"
+{{ end }}{{ range .Domains }}
+	"{{ .ImportPath }}"{{ end }}
+)
+
+const ({{ range .Items }}
+	{{ .Name | toIdentifier }} = {{ .Name | toPackage }}.{{ .Name | toIdentifier }}{{ end }}
+)
+{{ if .Descriptors }}
+// descriptors holds the descriptor of each known dataref, keyed by name.
+var descriptors = map[string]Descriptor{ {{ range .Items }}
+	{{ .Name | toIdentifier }}: { {{ .Name | toIdentifier }}, xpweb.{{ .ValueType | toValueType }}, {{ .IsWritable }} },{{ end }}
+}
+{{ end }}`
+
+// modulePath is the import path of the module into which names are generated.
+const modulePath string = "github.com/janeprather/xpweb"
+
+// domainPrefix is prepended to the name of each domain subpackage, so that packages such as time
+// and test do not shadow the standard library or common identifiers.
+const domainPrefix string = "dref"
+
+// domain is a subpackage holding the names within a domain.
+type domain struct {
+	// the domain, such as sim/cockpit2
+	Domain string
+	// the package name, such as drefcockpit2
+	Package    string
+	ImportPath string
+	items      []*Item
+}
+
 type genCfg struct {
 	items    []*Item
 	goFile   string
 	jsonFile string
 	pkg      string
+	// whether to generate a Descriptor for each item, which the package must define
+	descriptors bool
+	// whether to define the items in a subpackage for each domain, which this package repeats
+	split bool
 }
 
 type namesGenerator struct {
@@ -59,10 +130,13 @@ func (g *namesGenerator) run() error {
 		if err := g.loadData(gen); err != nil {
 			return err
 		}
-		if err := g.generateFile(gen); err != nil {
-			return err
+		if gen.split {
+			if err := g.generateSplit(gen); err != nil {
+				return err
+			}
+			continue
 		}
-		if err := g.formatFile(gen); err != nil {
+		if err := g.generateFile(gen.goFile, namesTemplate, gen.context(nil)); err != nil {
 			return err
 		}
 	}
@@ -70,6 +144,106 @@ func (g *namesGenerator) run() error {
 	return nil
 }
 
+// toDomain returns the domain of a name, which is the first two segments of names under sim/,
+// such as sim/cockpit2, or else the first segment, such as laminar.
+func toDomain(name string) string {
+	segments := strings.SplitN(name, "/", 3)
+	if segments[0] == "sim" && len(segments) == 3 {
+		return segments[0] + "/" + segments[1]
+	}
+	return segments[0]
+}
+
+// toPackage returns the name of the subpackage of the domain of a name, such as drefcockpit2.
+func toPackage(name string) string {
+	return domainPrefix + strings.ToLower(path.Base(toDomain(name)))
+}
+
+// valueTypes maps the value types listed by the simulator to the xpweb.ValueType constants.
+var valueTypes = map[string]string{
+	"float":       "ValueTypeFloat",
+	"double":      "ValueTypeDouble",
+	"int":         "ValueTypeInt",
+	"int_array":   "ValueTypeIntArray",
+	"float_array": "ValueTypeFloatArray",
+	"data":        "ValueTypeData",
+}
+
+// toValueType returns the name of the xpweb.ValueType constant of a listed value type.
+func toValueType(valueType string) (string, error) {
+	ident, ok := valueTypes[valueType]
+	if !ok {
+		return "", fmt.Errorf("unknown value type %q", valueType)
+	}
+	return ident, nil
+}
+
+// context returns the template context for the items of the configuration, or of a domain.
+func (gen *genCfg) context(dom *domain) map[string]any {
+	context := map[string]any{
+		"Package":     gen.pkg,
+		"JSONFile":    gen.jsonFile,
+		"Items":       gen.items,
+		"Descriptors": gen.descriptors,
+	}
+	if dom != nil {
+		context["Package"] = dom.Package
+		context["Domain"] = dom.Domain
+		context["Items"] = dom.items
+	}
+	return context
+}
+
+// generateSplit generates a subpackage for each domain of the items, such as
+// names/dataref/drefcockpit2 for the sim/cockpit2 datarefs, and a package repeating all of them.
+// Subpackages generated previously are removed first, so that domains which are no longer known do
+// not linger.
+func (g *namesGenerator) generateSplit(gen *genCfg) error {
+	dir := filepath.Dir(gen.goFile)
+	base := filepath.Base(gen.goFile)
+	stale, err := filepath.Glob(filepath.Join(dir, "*", base))
+	if err != nil {
+		return err
+	}
+	for _, file := range stale {
+		if err := os.RemoveAll(filepath.Dir(file)); err != nil {
+			return err
+		}
+	}
+
+	var domains []*domain
+	byPackage := make(map[string]*domain)
+	for _, item := range gen.items {
+		pkg := toPackage(item.Name)
+		dom, exists := byPackage[pkg]
+		if !exists {
+			dom = &domain{
+				Domain:     toDomain(item.Name),
+				Package:    pkg,
+				ImportPath: path.Join(modulePath, dir, pkg),
+			}
+			byPackage[pkg] = dom
+			domains = append(domains, dom)
+		}
+		dom.items = append(dom.items, item)
+	}
+
+	for _, dom := range domains {
+		goFile := filepath.Join(dir, dom.Package, base)
+		if err := os.MkdirAll(filepath.Dir(goFile), 0o755); err != nil {
+			return err
+		}
+		if err := g.generateFile(goFile, namesTemplate, gen.context(dom)); err != nil {
+			return err
+		}
+	}
+
+	context := gen.context(nil)
+	context["Domains"] = domains
+	context["Example"] = byPackage[toPackage("sim/cockpit2/")]
+	return g.generateFile(gen.goFile, aliasTemplate, context)
+}
+
 // converttoIdentifier preps a command or dataref name as an identifier.  We camelcase the path but
 // for the trailing portion we just clean up the whitespace.  We cannot camelcase the end of the
 // identifier because dataref names are case sensitive, and camelcase can cause conflicts.
@@ -124,48 +298,28 @@ func toCamelCase(s string) string {
 	return strings.ReplaceAll(string(runes), "_", "")
 }
 
-func (g *namesGenerator) generateFile(gen *genCfg) error {
+// generateFile executes the template with the context, and writes the formatted result to the
+// file.
+func (g *namesGenerator) generateFile(goFile string, text string, context map[string]any) error {
 	templates := template.New("")
 	templates.Funcs(template.FuncMap{
 		"toIdentifier": convertToIdentifier,
+		"toPackage":    toPackage,
+		"toValueType":  toValueType,
 	})
-
-	templates.Parse(namesTemplate)
-
-	fileHandle, err := os.Create(gen.goFile)
-	if err != nil {
-		return err
-	}
-	defer fileHandle.Close()
-
-	context := map[string]any{
-		"Package":  gen.pkg,
-		"JSONFile": gen.jsonFile,
-		"Items":    gen.items,
-	}
-
-	return templates.Execute(fileHandle, context)
-}
-
-func (g *namesGenerator) formatFile(gen *genCfg) error {
-	data, err := os.ReadFile(gen.goFile)
-	if err != nil {
+	if _, err := templates.Parse(text); err != nil {
 		return err
 	}
 
-	formattedData, err := format.Source(data)
-	if err != nil {
+	var buf bytes.Buffer
+	if err := templates.Execute(&buf, context); err != nil {
 		return err
 	}
-
-	fileHandle, err := os.Create(gen.goFile)
+	formattedData, err := format.Source(buf.Bytes())
 	if err != nil {
 		return err
 	}
-	defer fileHandle.Close()
-
-	_, err = fileHandle.Write(formattedData)
-	return err
+	return os.WriteFile(goFile, formattedData, 0o644)
 }
 
 func (g *namesGenerator) loadData(gen *genCfg) error {
@@ -192,9 +346,11 @@ func newNamesGenerator() namesGenerator {
 				pkg:      "command",
 			},
 			{
-				goFile:   "names/dataref/datarefs_gen.go",
-				jsonFile: "data/datarefs.json",
-				pkg:      "dataref",
+				goFile:      "names/dataref/datarefs_gen.go",
+				jsonFile:    "data/datarefs.json",
+				pkg:         "dataref",
+				descriptors: true,
+				split:       true,
 			},
 		},
 	}
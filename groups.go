@@ -0,0 +1,104 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+)
+
+// GroupUpdate is delivered to a [GroupHandler] when any member of a dataref group is updated.
+type GroupUpdate struct {
+	// The name of the group.
+	Group string
+	// The latest value of each member of the group, keyed by dataref name.  Members which were
+	// not included in the triggering update are filled in from the [Store].  Members for which no
+	// value has yet been received are omitted.
+	Values map[string]*DatarefValue
+}
+
+// GroupHandler is a function which performs some action for a [GroupUpdate].
+type GroupHandler func(*GroupUpdate)
+
+// datarefGroup is a named set of datarefs whose updates are delivered together.
+type datarefGroup struct {
+	names []string
+	// the resolved names of the members, by which updates are matched as IDs may change if the
+	// simulator is restarted
+	resolved   map[string]bool
+	handler    GroupHandler
+	listenerID uint64
+}
+
+// DefineGroup subscribes to the datarefs with the specified names using the selected [Transport],
+// and calls the specified handler once per update message which includes any of them.  The
+// handler receives the latest values of all members, so that consumers such as instrument renders
+// always see a consistent set rather than piecemeal updates.  Defining a group with the name of an
+// existing group replaces it.
+//
+//	err := client.DefineGroup(ctx, "attitude", []string{
+//		"sim/flightmodel/position/theta",
+//		"sim/flightmodel/position/phi",
+//		"sim/flightmodel/position/psi",
+//	}, func(update *xpweb.GroupUpdate) {
+//		renderAttitude(update.Values)
+//	})
+func (c *Client) DefineGroup(
+	ctx context.Context,
+	name string,
+	datarefs []string,
+	handler GroupHandler,
+) error {
+	if len(datarefs) == 0 {
+		return fmt.Errorf("group %s has no datarefs", name)
+	}
+
+	group := &datarefGroup{
+		names:    datarefs,
+		resolved: make(map[string]bool, len(datarefs)),
+		handler:  handler,
+	}
+	for _, drefName := range datarefs {
+		dref, err := c.LookupDataref(drefName)
+		if err != nil {
+			return err
+		}
+		group.resolved[dref.Name] = true
+	}
+
+	if err := c.RemoveGroup(ctx, name); err != nil {
+		return err
+	}
+
+	group.listenerID = c.WS.addDatarefListener(func(msg *WSMessageDatarefUpdate) {
+		for _, val := range msg.Data {
+			if val.Dataref != nil && group.resolved[val.Dataref.Name] {
+				group.handler(&GroupUpdate{Group: name, Values: c.store.Snapshot(group.names...)})
+				return
+			}
+		}
+	})
+
+	if err := c.Transport().SubscribeDatarefs(ctx, datarefs...); err != nil {
+		c.WS.removeDatarefListener(group.listenerID)
+		return err
+	}
+
+	c.groupsLock.Lock()
+	c.groups[name] = group
+	c.groupsLock.Unlock()
+	return nil
+}
+
+// RemoveGroup removes a group defined with [Client.DefineGroup], unsubscribing from its datarefs.
+// Removing a group which does not exist is not an error.
+func (c *Client) RemoveGroup(ctx context.Context, name string) error {
+	c.groupsLock.Lock()
+	group, exists := c.groups[name]
+	delete(c.groups, name)
+	c.groupsLock.Unlock()
+
+	if !exists {
+		return nil
+	}
+	c.WS.removeDatarefListener(group.listenerID)
+	return c.Transport().UnsubscribeDatarefs(ctx, group.names...)
+}
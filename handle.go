@@ -0,0 +1,153 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+)
+
+// DatarefType is the set of Go types in which dataref values may be handled by a
+// [DatarefHandle].  Data datarefs are handled as string or []byte.
+type DatarefType interface {
+	float64 | float32 | int | []float64 | []int | string | []byte
+}
+
+// DatarefHandle is a handle to a single dataref whose values are of type T, so that application
+// code reads and writes values of the correct type without type assertions or any-valued calls.
+//
+//	altitude, err := xpweb.Handle[float64](client, dataref.SimFlightmodelPosition_elevation)
+//	if err != nil {
+//		return err
+//	}
+//	meters, err := altitude.Get(ctx)
+//
+// The name is resolved, and checked against the type, when the handle is created.  Operations are
+// performed with the selected [Transport], as with [DatarefRef].
+type DatarefHandle[T DatarefType] struct {
+	client  *Client
+	dataref *Dataref
+}
+
+// Handle returns a [DatarefHandle] for the dataref with the specified name, which may be an alias.
+// An error matching [ErrDatarefNotFound] is returned if the name cannot be found, or one matching
+// [ErrInvalidValue] if the cached value type of the dataref cannot be represented as T.
+//
+//   - float64 and float32 handle float, double, and int datarefs
+//   - int handles int datarefs
+//   - []float64 handles float_array and int_array datarefs
+//   - []int handles int_array datarefs
+//   - string and []byte handle data datarefs
+func Handle[T DatarefType](client *Client, name string) (*DatarefHandle[T], error) {
+	dref, err := client.LookupDataref(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckHandle[T](dref.Name, dref.ValueType); err != nil {
+		return nil, err
+	}
+	return &DatarefHandle[T]{client: client, dataref: dref}, nil
+}
+
+// CheckHandle checks whether a [DatarefHandle] of type T may be created for the dataref with the
+// specified name and value type, and returns an error matching [ErrInvalidValue] if not.  The
+// value types of known datarefs are described by package names/dataref, whose CheckHandle
+// function checks a handle by name alone.
+func CheckHandle[T DatarefType](name string, valueType ValueType) error {
+	var zero T
+	if !handlesValueType(zero, valueType) {
+		return fmt.Errorf("%w: %s dataref %s cannot be handled as %T", ErrInvalidValue,
+			valueType, name, zero)
+	}
+	return nil
+}
+
+// handlesValueType returns whether values of the dataref type may be represented by the Go value's
+// type.  An unrecognized dataref type is accepted.
+func handlesValueType(value any, valueType ValueType) bool {
+	switch valueType {
+	case ValueTypeFloat, ValueTypeDouble:
+		switch value.(type) {
+		case float64, float32:
+			return true
+		}
+	case ValueTypeInt:
+		switch value.(type) {
+		case float64, float32, int:
+			return true
+		}
+	case ValueTypeFloatArray:
+		_, ok := value.([]float64)
+		return ok
+	case ValueTypeIntArray:
+		switch value.(type) {
+		case []float64, []int:
+			return true
+		}
+	case ValueTypeData:
+		switch value.(type) {
+		case string, []byte:
+			return true
+		}
+	default:
+		return true
+	}
+	return false
+}
+
+// decodeAs returns the dataref value as a T.
+func decodeAs[T DatarefType](val *DatarefValue) T {
+	var out T
+	switch ptr := any(&out).(type) {
+	case *float64:
+		*ptr = val.GetFloat64Value()
+	case *float32:
+		*ptr = val.GetFloat32Value()
+	case *int:
+		*ptr = val.GetIntValue()
+	case *[]float64:
+		*ptr = val.GetFloatArrayValue()
+	case *[]int:
+		*ptr = val.GetIntArrayValue()
+	case *string:
+		*ptr = val.GetStringValue()
+	case *[]byte:
+		*ptr = val.GetByteArrayValue()
+	}
+	return out
+}
+
+// Name returns the name of the dataref.
+func (h *DatarefHandle[T]) Name() string {
+	return h.dataref.Name
+}
+
+// Dataref returns the cached [Dataref] to which the handle was resolved.
+func (h *DatarefHandle[T]) Dataref() *Dataref {
+	return h.dataref
+}
+
+// Get reads the current value of the dataref.
+func (h *DatarefHandle[T]) Get(ctx context.Context) (T, error) {
+	val, err := h.client.GetValue(ctx, h.dataref.Name)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return decodeAs[T](val), nil
+}
+
+// Set writes the value of the dataref.
+func (h *DatarefHandle[T]) Set(ctx context.Context, value T) error {
+	return h.client.SetValue(ctx, h.dataref.Name, value)
+}
+
+// Subscribe calls the specified handler with each updated value of the dataref until the context
+// is done, as with [Client.Watch].
+func (h *DatarefHandle[T]) Subscribe(
+	ctx context.Context,
+	handler func(T),
+	opts ...WatchOption,
+) error {
+	return h.client.Watch(ctx, h.dataref.Name, func(val *DatarefValue) {
+		handler(decodeAs[T](val))
+	}, opts...)
+}
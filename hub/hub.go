@@ -0,0 +1,468 @@
+// Package hub multiplexes a single upstream connection to the simulator among many downstream
+// consumers.  A [Hub] is an http.Handler which serves the simulator's websocket protocol to
+// downstream clients, such as other applications using this package, and passes REST requests
+// through to the simulator.  Dataref and command subscriptions made by downstream clients are
+// performed through the hub's [xpweb.Client], whose subscriptions are counted, so that ten gauges
+// watching the same dataref generate one upstream subscription.
+//
+//	h, err := hub.New(client)
+//	if err != nil {
+//		return err
+//	}
+//	err = http.ListenAndServe(":8087", h)
+//
+// Downstream clients then connect to the hub as though it were the simulator:
+//
+//	gauge, err := xpweb.NewClient(&xpweb.ClientConfig{URL: "http://hub-host:8087"})
+//
+// Within a single process, [xpweb.Client.SubscribeFor] and [xpweb.Client.Watch] already share
+// upstream subscriptions in the same way, so the hub is only needed to share a connection between
+// processes.  Element indexes in downstream subscriptions are not supported; the whole value of
+// the dataref is delivered.
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"golang.org/x/net/websocket"
+)
+
+// releaseTimeout limits the time spent releasing the commands held by a downstream client once
+// it has disconnected.
+const releaseTimeout = 5 * time.Second
+
+// websocketPath matches the paths at which the simulator serves its websocket protocol.
+var websocketPath = regexp.MustCompile(`^/api/v\d+/?$`)
+
+// Hub serves the simulator's websocket protocol to downstream clients, and passes REST requests
+// through to the simulator.
+type Hub struct {
+	client *xpweb.Client
+	proxy  *httputil.ReverseProxy
+	ws     websocket.Server
+	// the number of sessions holding each command active, by command name
+	holds     map[string]int
+	holdsLock sync.Mutex
+	// An optional logger for downstream connection errors.  If nil, slog.Default() is used.
+	Logger *slog.Logger
+}
+
+// New instantiates and returns a pointer to a new [Hub] which performs downstream requests
+// through the specified client.  The client's websocket should be connected, and its cache
+// loaded, so that downstream dataref and command IDs can be resolved.
+func New(client *xpweb.Client) (*Hub, error) {
+	upstream, err := url.Parse(client.REST.URL())
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream URL: %w", err)
+	}
+	h := &Hub{
+		client: client,
+		proxy:  httputil.NewSingleHostReverseProxy(upstream),
+		holds:  make(map[string]int),
+	}
+	h.ws = websocket.Server{
+		Handler: h.serveConn,
+		// downstream clients may be served from any origin
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+	}
+	return h, nil
+}
+
+// ServeHTTP allows Hub to implement the http.Handler interface.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocketPath.MatchString(r.URL.Path) && r.Header.Get("Upgrade") != "" {
+		h.ws.ServeHTTP(w, r)
+		return
+	}
+	h.proxy.ServeHTTP(w, r)
+}
+
+// logger returns the configured logger, or the default logger.
+func (h *Hub) logger() *slog.Logger {
+	if h.Logger != nil {
+		return h.Logger
+	}
+	return slog.Default()
+}
+
+// request is an inbound downstream websocket request.
+type request struct {
+	ReqID  uint64          `json:"req_id"`
+	Type   string          `json:"type"`
+	Params json.RawMessage `json:"params"`
+}
+
+// result is the response to a downstream websocket request.
+type result struct {
+	ReqID        uint64 `json:"req_id"`
+	Type         string `json:"type"`
+	Success      bool   `json:"success"`
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// update is a dataref or command update sent to a downstream client.
+type update struct {
+	Type string         `json:"type"`
+	Data map[string]any `json:"data"`
+}
+
+// item is an element of the datarefs or commands array of a request's params.
+type item struct {
+	ID       uint64   `json:"id"`
+	Index    *int     `json:"index"`
+	Value    any      `json:"value"`
+	IsActive bool     `json:"is_active"`
+	Duration *float64 `json:"duration"`
+}
+
+// params holds the params of any supported request.  A value of "all" for datarefs or commands
+// is represented by the corresponding all field.
+type params struct {
+	Datarefs    []item
+	Commands    []item
+	AllDatarefs bool
+	AllCommands bool
+}
+
+func (p *params) UnmarshalJSON(data []byte) error {
+	raw := struct {
+		Datarefs json.RawMessage `json:"datarefs"`
+		Commands json.RawMessage `json:"commands"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var err error
+	if p.AllDatarefs, err = unmarshalItems(raw.Datarefs, &p.Datarefs); err != nil {
+		return err
+	}
+	p.AllCommands, err = unmarshalItems(raw.Commands, &p.Commands)
+	return err
+}
+
+// unmarshalItems unmarshals an array of items, or returns true if the value is "all".
+func unmarshalItems(data json.RawMessage, items *[]item) (bool, error) {
+	if len(data) == 0 {
+		return false, nil
+	}
+	var all string
+	if json.Unmarshal(data, &all) == nil {
+		return all == "all", nil
+	}
+	return false, json.Unmarshal(data, items)
+}
+
+// session is the state of a single downstream connection.
+type session struct {
+	hub      *Hub
+	conn     *websocket.Conn
+	lock     sync.Mutex
+	datarefs map[uint64]context.CancelFunc
+	commands map[uint64]context.CancelFunc
+	// the names of the commands which the session holds active
+	held map[string]bool
+}
+
+// serveConn handles a single downstream websocket connection until it is closed.
+func (h *Hub) serveConn(conn *websocket.Conn) {
+	ctx, cancel := context.WithCancel(conn.Request().Context())
+	defer cancel()
+
+	sess := &session{
+		hub:      h,
+		conn:     conn,
+		datarefs: make(map[uint64]context.CancelFunc),
+		commands: make(map[uint64]context.CancelFunc),
+		held:     make(map[string]bool),
+	}
+	defer sess.releaseHeld()
+
+	for {
+		req := &request{}
+		if err := websocket.JSON.Receive(conn, req); err != nil {
+			return
+		}
+		res := &result{ReqID: req.ReqID, Type: xpweb.MessageTypeResult, Success: true}
+		if err := sess.handle(ctx, req); err != nil {
+			res.Success = false
+			res.ErrorCode, res.ErrorMessage = errorCode(err), err.Error()
+		}
+		sess.send(res)
+	}
+}
+
+// send writes a message to the downstream client.
+func (sess *session) send(msg any) {
+	if err := websocket.JSON.Send(sess.conn, msg); err != nil {
+		sess.hub.logger().Debug("failed to send to downstream client", "error", err)
+	}
+}
+
+// handle performs a single downstream request.
+func (sess *session) handle(ctx context.Context, req *request) error {
+	p := &params{}
+	if len(req.Params) > 0 {
+		if err := json.Unmarshal(req.Params, p); err != nil {
+			return &requestError{code: xpweb.ResultErrorInvalidParams, err: err}
+		}
+	}
+
+	client := sess.hub.client
+	switch req.Type {
+	case xpweb.MessageTypeDatarefSub:
+		for _, it := range p.Datarefs {
+			if err := sess.subscribeDataref(ctx, it.ID); err != nil {
+				return err
+			}
+		}
+	case xpweb.MessageTypeDatarefUnsub:
+		sess.unsubscribe(sess.datarefs, ids(p.Datarefs), p.AllDatarefs)
+	case xpweb.MessageTypeDatarefSet:
+		for _, it := range p.Datarefs {
+			dref, err := lookupDataref(client, it.ID)
+			if err != nil {
+				return err
+			}
+			if it.Index != nil {
+				err = client.REST.SetDatarefElementValue(ctx, dref.Name, *it.Index, it.Value)
+			} else {
+				err = client.SetValue(ctx, dref.Name, it.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	case xpweb.MessageTypeCommandSub:
+		for _, it := range p.Commands {
+			if err := sess.subscribeCommand(ctx, it.ID); err != nil {
+				return err
+			}
+		}
+	case xpweb.MessageTypeCommandUnsub:
+		sess.unsubscribe(sess.commands, ids(p.Commands), p.AllCommands)
+	case xpweb.MessageTypeCommandSetIsActive:
+		for _, it := range p.Commands {
+			if err := sess.setCommandActive(ctx, it); err != nil {
+				return err
+			}
+		}
+	default:
+		return &requestError{
+			code: xpweb.ResultErrorInvalidRequest,
+			err:  fmt.Errorf("unsupported request type: %s", req.Type),
+		}
+	}
+	return nil
+}
+
+// subscribeDataref forwards updates of the dataref with the specified ID to the downstream client.
+func (sess *session) subscribeDataref(ctx context.Context, id uint64) error {
+	dref, err := lookupDataref(sess.hub.client, id)
+	if err != nil {
+		return err
+	}
+
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+	if _, exists := sess.datarefs[id]; exists {
+		return nil
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	updates, err := sess.hub.client.SubscribeFor(subCtx, dref.Name)
+	if err != nil {
+		cancel()
+		return err
+	}
+	sess.datarefs[id] = cancel
+
+	go func() {
+		key := strconv.FormatUint(id, 10)
+		for msg := range updates {
+			for _, val := range msg.Data {
+				sess.send(&update{
+					Type: xpweb.MessageTypeDatarefUpdate,
+					Data: map[string]any{key: val.Value},
+				})
+			}
+		}
+	}()
+	return nil
+}
+
+// subscribeCommand forwards changes to the active status of the command with the specified ID to
+// the downstream client.
+func (sess *session) subscribeCommand(ctx context.Context, id uint64) error {
+	cmd := sess.hub.client.GetCommandByID(id)
+	if cmd == nil {
+		return &requestError{
+			code: xpweb.ResultErrorInvalidCommandID,
+			err:  fmt.Errorf("no such command ID: %d", id),
+		}
+	}
+
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+	if _, exists := sess.commands[id]; exists {
+		return nil
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	key := strconv.FormatUint(id, 10)
+	err := sess.hub.client.Command(cmd.Name).Subscribe(subCtx, func(isActive bool) {
+		sess.send(&update{
+			Type: xpweb.MessageTypeCommandUpdate,
+			Data: map[string]any{key: isActive},
+		})
+	})
+	if err != nil {
+		cancel()
+		return err
+	}
+	sess.commands[id] = cancel
+	return nil
+}
+
+// setCommandActive activates a command for a duration, or begins or ends holding it.  Holds are
+// counted across sessions, so that a command is released upstream once no session holds it.
+func (sess *session) setCommandActive(ctx context.Context, it item) error {
+	client := sess.hub.client
+	cmd := client.GetCommandByID(it.ID)
+	if cmd == nil {
+		return &requestError{
+			code: xpweb.ResultErrorInvalidCommandID,
+			err:  fmt.Errorf("no such command ID: %d", it.ID),
+		}
+	}
+	switch {
+	case !it.IsActive:
+		sess.lock.Lock()
+		defer sess.lock.Unlock()
+		held := sess.held[cmd.Name]
+		delete(sess.held, cmd.Name)
+		return sess.hub.releaseHold(ctx, cmd.Name, held)
+	case it.Duration != nil:
+		return client.ActivateCommand(ctx, cmd.Name, *it.Duration)
+	default:
+		sess.lock.Lock()
+		defer sess.lock.Unlock()
+		if sess.held[cmd.Name] {
+			return nil
+		}
+		if err := sess.hub.acquireHold(ctx, cmd.Name); err != nil {
+			return err
+		}
+		sess.held[cmd.Name] = true
+		return nil
+	}
+}
+
+// releaseHeld releases the commands which the session still holds active once its connection has
+// closed, so that they are not left active upstream.
+func (sess *session) releaseHeld() {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+	for name := range sess.held {
+		if err := sess.hub.releaseHold(ctx, name, true); err != nil {
+			sess.hub.logger().Warn("failed to release held command", "command", name,
+				"error", err)
+		}
+	}
+	clear(sess.held)
+}
+
+// acquireHold begins holding the named command active upstream, unless another session already
+// holds it.
+func (h *Hub) acquireHold(ctx context.Context, name string) error {
+	h.holdsLock.Lock()
+	defer h.holdsLock.Unlock()
+	if h.holds[name] == 0 {
+		if err := h.client.Command(name).Hold(ctx); err != nil {
+			return err
+		}
+	}
+	h.holds[name]++
+	return nil
+}
+
+// releaseHold stops holding the named command active upstream, unless another session still holds
+// it.  A session which did not hold the command may release it only if no session holds it.
+func (h *Hub) releaseHold(ctx context.Context, name string, held bool) error {
+	h.holdsLock.Lock()
+	defer h.holdsLock.Unlock()
+	if held {
+		h.holds[name]--
+	}
+	if h.holds[name] > 0 {
+		return nil
+	}
+	delete(h.holds, name)
+	return h.client.Command(name).Release(ctx)
+}
+
+// unsubscribe cancels the specified subscriptions, or all of them.
+func (sess *session) unsubscribe(subs map[uint64]context.CancelFunc, ids []uint64, all bool) {
+	sess.lock.Lock()
+	defer sess.lock.Unlock()
+	for id, cancel := range subs {
+		if all || slices.Contains(ids, id) {
+			cancel()
+			delete(subs, id)
+		}
+	}
+}
+
+// lookupDataref returns the cached dataref with the specified ID.
+func lookupDataref(client *xpweb.Client, id uint64) (*xpweb.Dataref, error) {
+	dref := client.GetDatarefByID(id)
+	if dref == nil {
+		return nil, &requestError{
+			code: xpweb.ResultErrorInvalidDatarefID,
+			err:  fmt.Errorf("no such dataref ID: %d", id),
+		}
+	}
+	return dref, nil
+}
+
+func ids(items []item) []uint64 {
+	ids := make([]uint64, len(items))
+	for i, it := range items {
+		ids[i] = it.ID
+	}
+	return ids
+}
+
+// requestError is an error with a websocket result error code.
+type requestError struct {
+	code string
+	err  error
+}
+
+func (e *requestError) Error() string { return e.err.Error() }
+func (e *requestError) Unwrap() error { return e.err }
+
+// errorCode returns the websocket result error code for an error.
+func errorCode(err error) string {
+	var reqErr *requestError
+	if errors.As(err, &reqErr) {
+		return reqErr.code
+	}
+	return xpweb.ResultErrorInternal
+}
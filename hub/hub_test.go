@@ -0,0 +1,147 @@
+package hub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"golang.org/x/net/websocket"
+)
+
+// upstreamSim is a minimal simulator web API which lists a single command, and records the
+// is_active values of the command_set_is_active requests it receives.
+type upstreamSim struct {
+	lock   sync.Mutex
+	active []bool
+}
+
+func (s *upstreamSim) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/datarefs", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	})
+	mux.HandleFunc("/api/v2/commands", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[{"id":5,"name":"sim/test/hold","description":"test"}]}`))
+	})
+	mux.Handle("/api/v2", websocket.Handler(func(conn *websocket.Conn) {
+		for {
+			req := &struct {
+				ReqID  uint64 `json:"req_id"`
+				Type   string `json:"type"`
+				Params params `json:"params"`
+			}{}
+			if err := websocket.JSON.Receive(conn, req); err != nil {
+				return
+			}
+			if req.Type == xpweb.MessageTypeCommandSetIsActive {
+				s.lock.Lock()
+				for _, it := range req.Params.Commands {
+					s.active = append(s.active, it.IsActive)
+				}
+				s.lock.Unlock()
+			}
+			websocket.JSON.Send(conn, &result{
+				ReqID: req.ReqID, Type: xpweb.MessageTypeResult, Success: true,
+			})
+		}
+	}))
+	return mux
+}
+
+// requests returns the is_active values received so far.
+func (s *upstreamSim) requests() []bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]bool{}, s.active...)
+}
+
+// holdCommand connects a downstream client to the hub and holds the command active through it.
+func holdCommand(t *testing.T, hubURL string) *websocket.Conn {
+	t.Helper()
+	wsURL := "ws" + strings.TrimPrefix(hubURL, "http") + "/api/v2"
+	conn, err := websocket.Dial(wsURL, "", hubURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := `{"req_id":1,"type":"command_set_is_active","params":{"commands":[` +
+		`{"id":5,"is_active":true}]}}`
+	if err := websocket.Message.Send(conn, req); err != nil {
+		t.Fatal(err)
+	}
+	res := &result{}
+	if err := websocket.JSON.Receive(conn, res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Success {
+		t.Fatalf("hold failed: %s", res.ErrorMessage)
+	}
+	return conn
+}
+
+// waitForRequests waits for the upstream simulator to have received the expected requests.
+func waitForRequests(t *testing.T, sim *upstreamSim, want []bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := sim.requests()
+		if len(got) >= len(want) {
+			data, _ := json.Marshal(got)
+			if expected, _ := json.Marshal(want); string(data) != string(expected) {
+				t.Fatalf("upstream received is_active %s, expected %s", data, expected)
+			}
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("upstream received is_active %v, expected %v", got, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestHeldCommandsReleasedOnDisconnect(t *testing.T) {
+	sim := &upstreamSim{}
+	upstream := httptest.NewServer(sim.handler())
+	defer upstream.Close()
+
+	client, err := xpweb.NewClient(&xpweb.ClientConfig{
+		URL:        upstream.URL,
+		APIVersion: xpweb.APIVersion2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := client.LoadCache(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.WS.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.WS.Close()
+
+	h, err := New(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(h)
+	defer server.Close()
+
+	// the command is held upstream once, however many sessions hold it
+	first := holdCommand(t, server.URL)
+	second := holdCommand(t, server.URL)
+	waitForRequests(t, sim, []bool{true})
+
+	// and released once the last session holding it disconnects
+	first.Close()
+	time.Sleep(100 * time.Millisecond)
+	waitForRequests(t, sim, []bool{true})
+	second.Close()
+	waitForRequests(t, sim, []bool{true, false})
+}
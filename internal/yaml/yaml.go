@@ -0,0 +1,380 @@
+// Package yaml decodes the subset of YAML used by the declarative file formats in this module:
+// block mappings and sequences, flow mappings and sequences, plain and quoted scalars, and
+// comments.  Anchors, tags, multi-document streams, and block scalars are not supported.
+//
+// Documents are decoded into generic values and then into the target through encoding/json, so
+// targets use json struct tags.
+package yaml
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Unmarshal decodes the YAML document in data into the value pointed to by v.
+func Unmarshal(data []byte, v any) error {
+	node, err := Parse(data)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(encoded, v)
+}
+
+// Parse decodes the YAML document in data into generic values: map[string]any, []any, string,
+// float64, bool, and nil.
+func Parse(data []byte) (any, error) {
+	p := &parser{}
+	for num, text := range strings.Split(string(data), "\n") {
+		text = strings.TrimRight(stripComment(text), " \t\r")
+		content := strings.TrimLeft(text, " ")
+		if content == "" || content == "---" {
+			continue
+		}
+		if strings.HasPrefix(content, "\t") {
+			return nil, fmt.Errorf("line %d: tabs may not be used for indentation", num+1)
+		}
+		p.lines = append(p.lines, line{num: num + 1, indent: len(text) - len(content), content: content})
+	}
+	if len(p.lines) == 0 {
+		return nil, nil
+	}
+	node, err := p.parseNode(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", p.lines[p.pos].num)
+	}
+	return node, nil
+}
+
+type line struct {
+	num     int
+	indent  int
+	content string
+}
+
+type parser struct {
+	lines []line
+	pos   int
+}
+
+func isSeqItem(content string) bool {
+	return content == "-" || strings.HasPrefix(content, "- ")
+}
+
+func (p *parser) parseNode(indent int) (any, error) {
+	if isSeqItem(p.lines[p.pos].content) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *parser) parseSequence(indent int) (any, error) {
+	items := []any{}
+	for p.pos < len(p.lines) {
+		ln := p.lines[p.pos]
+		if ln.indent != indent || !isSeqItem(ln.content) {
+			break
+		}
+		rest := strings.TrimLeft(strings.TrimPrefix(ln.content, "-"), " ")
+		if rest == "" {
+			p.pos++
+			item, err := p.parseChild(indent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+			continue
+		}
+		if _, _, isPair := splitKey(rest); isPair || isSeqItem(rest) {
+			// the item is a nested block starting on the same line as the dash
+			childIndent := ln.indent + len(ln.content) - len(rest)
+			p.lines[p.pos] = line{num: ln.num, indent: childIndent, content: rest}
+			item, err := p.parseNode(childIndent)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+			continue
+		}
+		item, err := parseScalar(rest)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", ln.num, err)
+		}
+		items = append(items, item)
+		p.pos++
+	}
+	return items, nil
+}
+
+func (p *parser) parseMapping(indent int) (any, error) {
+	mapping := map[string]any{}
+	for p.pos < len(p.lines) {
+		ln := p.lines[p.pos]
+		if ln.indent < indent {
+			break
+		}
+		if ln.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", ln.num)
+		}
+		if isSeqItem(ln.content) {
+			break
+		}
+		key, value, isPair := splitKey(ln.content)
+		if !isPair {
+			return nil, fmt.Errorf("line %d: expected key: value", ln.num)
+		}
+		if _, exists := mapping[key]; exists {
+			return nil, fmt.Errorf("line %d: duplicate key %q", ln.num, key)
+		}
+		p.pos++
+		if value != "" {
+			scalar, err := parseScalar(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", ln.num, err)
+			}
+			mapping[key] = scalar
+			continue
+		}
+		// a sequence may be indented at the same level as its key
+		if p.pos < len(p.lines) && p.lines[p.pos].indent == indent &&
+			isSeqItem(p.lines[p.pos].content) {
+			child, err := p.parseSequence(indent)
+			if err != nil {
+				return nil, err
+			}
+			mapping[key] = child
+			continue
+		}
+		child, err := p.parseChild(indent)
+		if err != nil {
+			return nil, err
+		}
+		mapping[key] = child
+	}
+	return mapping, nil
+}
+
+// parseChild parses the node nested beneath a line at the specified indentation, or returns nil
+// if there is none.
+func (p *parser) parseChild(indent int) (any, error) {
+	if p.pos >= len(p.lines) || p.lines[p.pos].indent <= indent {
+		return nil, nil
+	}
+	return p.parseNode(p.lines[p.pos].indent)
+}
+
+// splitKey splits a "key: value" line, returning false if the line is not a mapping pair.
+func splitKey(content string) (key string, value string, ok bool) {
+	if strings.HasPrefix(content, "[") || strings.HasPrefix(content, "{") {
+		return "", "", false
+	}
+	end := scanPlain(content, 0, func(s string, idx int) bool {
+		return s[idx] == ':' && (idx+1 == len(s) || s[idx+1] == ' ')
+	})
+	if end == len(content) {
+		return "", "", false
+	}
+	key = strings.TrimSpace(content[:end])
+	if len(key) > 0 && (key[0] == '"' || key[0] == '\'') {
+		unquoted, err := parseScalar(key)
+		if err != nil {
+			return "", "", false
+		}
+		key = fmt.Sprint(unquoted)
+	}
+	return key, strings.TrimSpace(content[end+1:]), true
+}
+
+// scanPlain returns the index of the first byte at or after start, outside of quotes, for which
+// stop returns true, or the length of s.
+func scanPlain(s string, start int, stop func(string, int) bool) int {
+	var quote byte
+	for idx := start; idx < len(s); idx++ {
+		switch {
+		case quote != 0:
+			if s[idx] == '\\' && quote == '"' {
+				idx++
+			} else if s[idx] == quote {
+				quote = 0
+			}
+		case (s[idx] == '"' || s[idx] == '\'') && (idx == start || s[idx-1] == ' '):
+			quote = s[idx]
+		case stop(s, idx):
+			return idx
+		}
+	}
+	return len(s)
+}
+
+// stripComment removes a trailing comment from a line.
+func stripComment(text string) string {
+	end := scanPlain(text, 0, func(s string, idx int) bool {
+		return s[idx] == '#' && (idx == 0 || s[idx-1] == ' ' || s[idx-1] == '\t')
+	})
+	return text[:end]
+}
+
+func parseScalar(text string) (any, error) {
+	if text == "" {
+		return nil, fmt.Errorf("empty scalar")
+	}
+	if strings.HasPrefix(text, "[") || strings.HasPrefix(text, "{") {
+		value, end, err := parseFlow(text, 0)
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(text[end:]) != "" {
+			return nil, fmt.Errorf("unexpected text after %q", text[:end])
+		}
+		return value, nil
+	}
+	switch text[0] {
+	case '"':
+		return strconv.Unquote(text)
+	case '\'':
+		if len(text) < 2 || text[len(text)-1] != '\'' {
+			return nil, fmt.Errorf("unterminated string %s", text)
+		}
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), nil
+	}
+	switch text {
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	case "null", "Null", "NULL", "~":
+		return nil, nil
+	}
+	if num, ok := parseNumber(text); ok {
+		return num, nil
+	}
+	if strings.HasPrefix(text, "|") || strings.HasPrefix(text, ">") {
+		return nil, fmt.Errorf("block scalars are not supported")
+	}
+	return text, nil
+}
+
+// Numbers as resolved by the YAML 1.2 core schema.  Other plain scalars, such as inf or 1_000,
+// are strings.
+var (
+	decimalRe = regexp.MustCompile(`^[-+]?(\.[0-9]+|[0-9]+(\.[0-9]*)?)([eE][-+]?[0-9]+)?$`)
+	octalRe   = regexp.MustCompile(`^0o[0-7]+$`)
+	hexRe     = regexp.MustCompile(`^0x[0-9a-fA-F]+$`)
+)
+
+// parseNumber parses a plain scalar which the YAML 1.2 core schema resolves to a number, returning
+// false for any other scalar.
+func parseNumber(text string) (float64, bool) {
+	switch text {
+	case ".inf", ".Inf", ".INF", "+.inf", "+.Inf", "+.INF":
+		return math.Inf(1), true
+	case "-.inf", "-.Inf", "-.INF":
+		return math.Inf(-1), true
+	case ".nan", ".NaN", ".NAN":
+		return math.NaN(), true
+	}
+	switch {
+	case decimalRe.MatchString(text):
+		num, err := strconv.ParseFloat(text, 64)
+		return num, err == nil
+	case octalRe.MatchString(text):
+		num, err := strconv.ParseUint(text[2:], 8, 64)
+		return float64(num), err == nil
+	case hexRe.MatchString(text):
+		num, err := strconv.ParseUint(text[2:], 16, 64)
+		return float64(num), err == nil
+	}
+	return 0, false
+}
+
+// parseFlow parses a flow sequence or mapping beginning at s[start], returning the value and the
+// index following it.
+func parseFlow(s string, start int) (any, int, error) {
+	isMapping := s[start] == '{'
+	closer := byte(']')
+	if isMapping {
+		closer = '}'
+	}
+	items := []any{}
+	mapping := map[string]any{}
+	idx := start + 1
+	for {
+		idx = skipSpaces(s, idx)
+		if idx >= len(s) {
+			return nil, 0, fmt.Errorf("unterminated flow collection %s", s[start:])
+		}
+		if s[idx] == closer {
+			idx++
+			break
+		}
+
+		var key string
+		if isMapping {
+			end := scanPlain(s, idx, func(s string, i int) bool {
+				return s[i] == ':' || s[i] == ',' || s[i] == '}'
+			})
+			if end >= len(s) || s[end] != ':' {
+				return nil, 0, fmt.Errorf("expected key: value in %s", s[start:])
+			}
+			unquoted, err := parseScalar(strings.TrimSpace(s[idx:end]))
+			if err != nil {
+				return nil, 0, err
+			}
+			key = fmt.Sprint(unquoted)
+			idx = skipSpaces(s, end+1)
+		}
+
+		var item any
+		if idx < len(s) && (s[idx] == '[' || s[idx] == '{') {
+			value, end, err := parseFlow(s, idx)
+			if err != nil {
+				return nil, 0, err
+			}
+			item, idx = value, end
+		} else {
+			end := scanPlain(s, idx, func(s string, i int) bool {
+				return s[i] == ',' || s[i] == closer
+			})
+			if text := strings.TrimSpace(s[idx:end]); text != "" {
+				scalar, err := parseScalar(text)
+				if err != nil {
+					return nil, 0, err
+				}
+				item = scalar
+			}
+			idx = end
+		}
+
+		if isMapping {
+			mapping[key] = item
+		} else {
+			items = append(items, item)
+		}
+
+		idx = skipSpaces(s, idx)
+		if idx < len(s) && s[idx] == ',' {
+			idx++
+		}
+	}
+	if isMapping {
+		return mapping, idx, nil
+	}
+	return items, idx, nil
+}
+
+func skipSpaces(s string, idx int) int {
+	for idx < len(s) && s[idx] == ' ' {
+		idx++
+	}
+	return idx
+}
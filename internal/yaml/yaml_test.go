@@ -0,0 +1,63 @@
+package yaml
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestParseScalars(t *testing.T) {
+	for _, tc := range []struct {
+		text string
+		want any
+	}{
+		{"1", 1.0},
+		{"-2.5e3", -2500.0},
+		{".5", 0.5},
+		{"0x1F", 31.0},
+		{"0o17", 15.0},
+		{"inf", "inf"},
+		{"nan", "nan"},
+		{"Infinity", "Infinity"},
+		{"1_000", "1_000"},
+		{"0x1_F", "0x1_F"},
+		{"+", "+"},
+	} {
+		got, err := Parse([]byte("a: " + tc.text))
+		if err != nil {
+			t.Errorf("%s: %v", tc.text, err)
+			continue
+		}
+		if value := got.(map[string]any)["a"]; !reflect.DeepEqual(value, tc.want) {
+			t.Errorf("%s: got %#v, expected %#v", tc.text, value, tc.want)
+		}
+	}
+}
+
+func TestParseSpecialFloats(t *testing.T) {
+	got, err := Parse([]byte("a: [.inf, -.Inf, .NaN]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := got.(map[string]any)["a"].([]any)
+	if !math.IsInf(values[0].(float64), 1) || !math.IsInf(values[1].(float64), -1) ||
+		!math.IsNaN(values[2].(float64)) {
+		t.Errorf("unexpected values %v", values)
+	}
+}
+
+func TestUnmarshalPlainSpecialWords(t *testing.T) {
+	var out map[string]string
+	if err := Unmarshal([]byte("a: inf\nb: nan\n"), &out); err != nil {
+		t.Fatal(err)
+	}
+	if out["a"] != "inf" || out["b"] != "nan" {
+		t.Errorf("unexpected values %v", out)
+	}
+}
+
+func TestParseEmptyFlowKey(t *testing.T) {
+	if _, err := Parse([]byte("a: {: 1}")); err == nil {
+		t.Error("expected an error for an empty flow mapping key")
+	}
+}
@@ -0,0 +1,49 @@
+package xpweb
+
+import (
+	"sync"
+	"time"
+)
+
+// lazyMisses remembers names which LazyCache lookups did not find, so that repeated uses of a
+// misspelled or unregistered name do not each send a request to the REST API.  Names are
+// forgotten after lazyMissTTL, so that datarefs and commands registered later by plugins are
+// eventually found, and whenever the cache is reloaded.
+type lazyMisses struct {
+	lock    sync.Mutex
+	expires map[string]time.Time
+}
+
+// missed returns whether the name was not found by a lookup within the last lazyMissTTL.
+func (m *lazyMisses) missed(name string) bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	expires, ok := m.expires[name]
+	if !ok {
+		return false
+	}
+	if time.Now().After(expires) {
+		delete(m.expires, name)
+		return false
+	}
+	return true
+}
+
+// add records that the name was not found.
+func (m *lazyMisses) add(name string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.expires == nil {
+		m.expires = make(map[string]time.Time)
+	}
+	m.expires[name] = time.Now().Add(lazyMissTTL)
+}
+
+// reset forgets all names, such as once the cache has been reloaded.
+func (m *lazyMisses) reset() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	clear(m.expires)
+}
@@ -0,0 +1,63 @@
+package xpweb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLazyCacheRemembersMisses(t *testing.T) {
+	var lookups atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lookups.Add(1)
+		w.Write([]byte(`{"data":[]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{
+		URL:        server.URL,
+		APIVersion: APIVersion2,
+		LazyCache:  true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.setDatarefs(nil)
+	client.setCommands(nil)
+	expectLookups := func(want int32) {
+		t.Helper()
+		if got := lookups.Load(); got != want {
+			t.Fatalf("%d lookups sent, expected %d", got, want)
+		}
+	}
+
+	// a name which is not found is looked up once
+	for range 3 {
+		if _, err := client.LookupDataref("sim/test/missing"); err == nil {
+			t.Fatal("missing dataref found")
+		}
+	}
+	expectLookups(1)
+
+	// misses are remembered separately for datarefs and commands
+	for range 3 {
+		if _, err := client.LookupCommand("sim/test/missing"); err == nil {
+			t.Fatal("missing command found")
+		}
+	}
+	expectLookups(2)
+
+	// and looked up again once expired
+	client.datarefMisses.lock.Lock()
+	client.datarefMisses.expires["sim/test/missing"] = time.Now().Add(-time.Second)
+	client.datarefMisses.lock.Unlock()
+	client.GetDatarefByName("sim/test/missing")
+	expectLookups(3)
+
+	// or once the cache is reloaded
+	client.setCommands(nil)
+	client.GetCommandByName("sim/test/missing")
+	expectLookups(4)
+}
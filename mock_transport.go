@@ -0,0 +1,131 @@
+package xpweb
+
+import (
+	"context"
+	"sync"
+)
+
+// MockCommand is a record of a command activated via a [MockTransport].
+type MockCommand struct {
+	Name     string
+	Duration float64
+}
+
+// MockTransport is an in-memory [Transport] which does not communicate with the simulator.  It is
+// intended for testing application code which uses the [Client] transport-agnostic methods.
+// Values written with SetDatarefValue are delivered to subscribers, and [MockTransport.Publish]
+// may be used to simulate values changing within the simulator.
+//
+// Datarefs and commands must exist in the client's cache, which may be populated with
+// [Client.LoadCacheFromFile].
+type MockTransport struct {
+	client     *Client
+	values     map[string]any
+	commands   []MockCommand
+	subscribed refCounts[string]
+	lock       sync.Mutex
+}
+
+// NewMockTransport returns a [MockTransport] having the specified initial dataref values, keyed
+// by dataref name.
+func (c *Client) NewMockTransport(values map[string]any) *MockTransport {
+	t := &MockTransport{
+		client: c,
+		values: make(map[string]any),
+	}
+	for name, value := range values {
+		t.values[name] = value
+	}
+	return t
+}
+
+// GetDatarefValue returns the stored value of the specified dataref.
+func (t *MockTransport) GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error) {
+	dref, err := t.client.LookupDataref(name)
+	if err != nil {
+		return nil, err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return &DatarefValue{Dataref: dref, Value: t.values[dref.Name]}, nil
+}
+
+// SetDatarefValue stores the specified value, delivering an update if the dataref is subscribed.
+func (t *MockTransport) SetDatarefValue(ctx context.Context, name string, value any) error {
+	return t.Publish(name, value)
+}
+
+// ActivateCommand records the command activation, which may be inspected with
+// [MockTransport.Commands].  The duration is validated as by the other transports.
+func (t *MockTransport) ActivateCommand(ctx context.Context, name string, duration float64) error {
+	if err := validateDuration(duration); err != nil {
+		return err
+	}
+	cmd, err := t.client.LookupCommand(name)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.commands = append(t.commands, MockCommand{Name: cmd.Name, Duration: duration})
+	return nil
+}
+
+// SubscribeDatarefs marks the specified datarefs as subscribed, and delivers their current values.
+func (t *MockTransport) SubscribeDatarefs(ctx context.Context, names ...string) error {
+	msg := &WSMessageDatarefUpdate{Type: MessageTypeDatarefUpdate, Data: make(WSDatarefValuesMap)}
+
+	t.lock.Lock()
+	for _, name := range names {
+		dref, err := t.client.LookupDataref(name)
+		if err != nil {
+			t.lock.Unlock()
+			return err
+		}
+		t.subscribed.acquire(dref.Name)
+		msg.Data[dref.ID] = &DatarefValue{Dataref: dref, Value: t.values[dref.Name]}
+	}
+	t.lock.Unlock()
+
+	t.client.WS.deliverDatarefUpdate(msg)
+	return nil
+}
+
+// UnsubscribeDatarefs releases subscriptions made with SubscribeDatarefs.
+func (t *MockTransport) UnsubscribeDatarefs(ctx context.Context, names ...string) error {
+	for _, name := range names {
+		t.subscribed.release(t.client.resolveAlias(name))
+	}
+	return nil
+}
+
+// Publish stores the specified dataref value as though it had changed within the simulator, and
+// delivers an update if the dataref is subscribed.
+func (t *MockTransport) Publish(name string, value any) error {
+	dref, err := t.client.LookupDataref(name)
+	if err != nil {
+		return err
+	}
+
+	t.lock.Lock()
+	t.values[dref.Name] = value
+	t.lock.Unlock()
+	subscribed := t.subscribed.isHeld(dref.Name)
+
+	if subscribed {
+		t.client.WS.deliverDatarefUpdate(&WSMessageDatarefUpdate{
+			Type: MessageTypeDatarefUpdate,
+			Data: WSDatarefValuesMap{dref.ID: &DatarefValue{Dataref: dref, Value: value}},
+		})
+	}
+	return nil
+}
+
+// Commands returns the commands activated via the transport, in order.
+func (t *MockTransport) Commands() []MockCommand {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return append([]MockCommand(nil), t.commands...)
+}
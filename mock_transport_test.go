@@ -0,0 +1,135 @@
+package xpweb
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newMockClient returns a client whose selected transport is a MockTransport, with a float
+// dataref, a float array dataref and a command in its cache.
+func newMockClient(t *testing.T) (*Client, *MockTransport) {
+	t.Helper()
+	client, err := NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.setDatarefs([]*Dataref{
+		{ID: 1, Name: "sim/test/float", ValueType: ValueTypeFloat, IsWritable: true},
+		{ID: 2, Name: "sim/test/array", ValueType: ValueTypeFloatArray, IsWritable: true},
+	})
+	client.setCommands([]*Command{{ID: 3, Name: "sim/test/command"}})
+	transport := client.NewMockTransport(map[string]any{
+		"sim/test/float": 0.0,
+		"sim/test/array": []float64{1, 2, 3},
+	})
+	client.SetTransport(transport)
+	return client, transport
+}
+
+// expectValues waits for the specified values to be received, and fails the test if they differ
+// or any further value is received within a short time.
+func expectValues(t *testing.T, values <-chan *DatarefValue, want ...float64) {
+	t.Helper()
+	for _, expected := range want {
+		select {
+		case val := <-values:
+			if val.Value != expected {
+				t.Fatalf("received %v, expected %v", val.Value, expected)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("no value received, expected %v", expected)
+		}
+	}
+	select {
+	case val := <-values:
+		t.Fatalf("unexpected value %v", val.Value)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatchMockTransport(t *testing.T) {
+	client, transport := newMockClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the current value is delivered on subscription, and later values after applying options
+	values := make(chan *DatarefValue, 16)
+	err := client.Watch(ctx, "sim/test/float", func(val *DatarefValue) { values <- val },
+		WithDeadband(0.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []float64{0.2, 1, 1.3, 2} {
+		if err := transport.Publish("sim/test/float", value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expectValues(t, values, 0, 1, 2)
+
+	// elements of arrays are delivered from the whole values
+	elements := make(chan *DatarefValue, 16)
+	err = client.SubscribeDatarefElement(ctx, "sim/test/array", 1,
+		func(val *DatarefValue) { elements <- val })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.Publish("sim/test/array", []float64{4, 5, 6}); err != nil {
+		t.Fatal(err)
+	}
+	expectValues(t, elements, 2, 5)
+
+	// the subscriptions are released once the context is done
+	cancel()
+	for deadline := time.Now().Add(5 * time.Second); transport.subscribed.isHeld("sim/test/float") ||
+		transport.subscribed.isHeld("sim/test/array"); time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("subscriptions not released")
+		}
+	}
+	transport.Publish("sim/test/float", 3.0)
+	expectValues(t, values)
+}
+
+// discardingTransport is a MockTransport which accepts writes without retaining them.
+type discardingTransport struct {
+	*MockTransport
+}
+
+func (t *discardingTransport) SetDatarefValue(ctx context.Context, name string, value any) error {
+	_, err := t.client.LookupDataref(name)
+	return err
+}
+
+func TestSetDatarefValueConfirmedMockTransport(t *testing.T) {
+	client, transport := newMockClient(t)
+	ctx := context.Background()
+
+	if err := client.SetDatarefValueConfirmed(ctx, "sim/test/float", 1.5, 0); err != nil {
+		t.Fatal(err)
+	}
+	err := client.SetDatarefValueConfirmed(ctx, "sim/test/array", []float64{1, 2.05, 3}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a write which is not retained is reported with the value read back
+	client.SetTransport(&discardingTransport{transport})
+	ctx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+	err = client.SetDatarefValueConfirmed(ctx, "sim/test/float", 2.5, 0)
+	if !errors.Is(err, ErrWriteNotConfirmed) {
+		t.Fatalf("unconfirmed write returned %v", err)
+	}
+	if want := "wrote 2.5, read back 1.5"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not report %q", err, want)
+	}
+
+	// as is a dataref which is not found
+	err = client.SetDatarefValueConfirmed(ctx, "sim/test/missing", 1.0, 0)
+	if !errors.Is(err, ErrDatarefNotFound) {
+		t.Errorf("missing dataref returned %v", err)
+	}
+}
@@ -0,0 +1,211 @@
+// Package movingmap streams the live position of the user's aircraft as GeoJSON or NMEA-0183, so
+// that moving map software such as electronic flight bags or OpenCPN can follow the simulator
+// without custom glue code.
+//
+// A [Writer] writes the stream to any io.Writer, such as a file or a serial port:
+//
+//	w := movingmap.NewWriter(os.Stdout, movingmap.NMEA)
+//	if err := w.Attach(ctx, client); err != nil {
+//		return err
+//	}
+//
+// A [Server] serves the stream to every client connected to a TCP listener, which is how most
+// mapping applications accept an external GPS:
+//
+//	srv := movingmap.NewServer(movingmap.NMEA)
+//	if err := srv.Attach(ctx, client); err != nil {
+//		return err
+//	}
+//	listener, err := net.Listen("tcp", ":10110")
+//	if err != nil {
+//		return err
+//	}
+//	err = srv.Serve(ctx, listener)
+//
+// Timestamps are taken from the local clock at the time of each update, not the simulator's clock.
+package movingmap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// DefaultInterval is the minimum time between positions written by a new [Writer].  Most
+// receivers expect NMEA sentences once per second.
+const DefaultInterval = time.Second
+
+// knotsPerMS converts meters per second to knots.
+const knotsPerMS = 1.943844
+
+// Format is the encoding in which positions are written.
+type Format int
+
+const (
+	// GeoJSON writes one GeoJSON Point feature per position, one per line.
+	GeoJSON Format = iota
+	// NMEA writes RMC and GGA sentences per position, as from a GPS receiver.
+	NMEA
+)
+
+// Writer writes the position of the user's aircraft as it changes.  It is safe for concurrent
+// use.
+type Writer struct {
+	format Format
+	w      io.Writer
+	// The minimum time between written positions, or zero to write one for every update.
+	Interval time.Duration
+
+	latest  map[string]*xpweb.DatarefValue
+	written time.Time
+	err     error
+	lock    sync.Mutex
+}
+
+// NewWriter returns a [Writer] which writes positions in the specified format to w.
+func NewWriter(w io.Writer, format Format) *Writer {
+	return &Writer{
+		format:   format,
+		w:        w,
+		Interval: DefaultInterval,
+		latest:   make(map[string]*xpweb.DatarefValue),
+	}
+}
+
+// Attach subscribes to [xpweb.PositionDatarefs] and writes positions as they are updated until
+// the context is done.
+func (w *Writer) Attach(ctx context.Context, client *xpweb.Client) error {
+	updates, err := client.SubscribeFor(ctx, xpweb.PositionDatarefs...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for msg := range updates {
+			w.HandleUpdate(msg)
+		}
+	}()
+	return nil
+}
+
+// HandleUpdate records the position values in an update message, and writes the position if the
+// interval has elapsed.  It has the signature of an [xpweb.DatarefUpdateHandler].  No position is
+// written until a value has been received for every position dataref.
+func (w *Writer) HandleUpdate(msg *xpweb.WSMessageDatarefUpdate) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for _, val := range msg.Data {
+		if val.Dataref != nil {
+			w.latest[val.Dataref.Name] = val
+		}
+	}
+	for _, name := range xpweb.PositionDatarefs {
+		if w.latest[name] == nil {
+			return
+		}
+	}
+	if w.err != nil || time.Since(w.written) < w.Interval {
+		return
+	}
+	w.written = time.Now()
+	w.err = Encode(w.w, w.format, xpweb.PositionFromValues(w.latest))
+}
+
+// Err returns the first error encountered while writing, if any.  Once an error has occurred, no
+// further positions are written.
+func (w *Writer) Err() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.err
+}
+
+// Encode writes a single position to w in the specified format.
+func Encode(w io.Writer, format Format, pos *xpweb.Position) error {
+	switch format {
+	case GeoJSON:
+		data, err := json.Marshal(Feature(pos))
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	case NMEA:
+		var data []byte
+		for _, sentence := range Sentences(pos) {
+			data = append(data, sentence...)
+			data = append(data, '\r', '\n')
+		}
+		_, err := w.Write(data)
+		return err
+	}
+	return fmt.Errorf("unknown format: %d", format)
+}
+
+// Feature returns a GeoJSON Point feature for the position.  The coordinates are longitude,
+// latitude, and elevation in meters, and the remaining values are included as properties.
+func Feature(pos *xpweb.Position) map[string]any {
+	return map[string]any{
+		"type": "Feature",
+		"geometry": map[string]any{
+			"type":        "Point",
+			"coordinates": []float64{pos.Longitude, pos.Latitude, pos.Elevation},
+		},
+		"properties": map[string]any{
+			"time":               pos.Time.UTC().Format(time.RFC3339Nano),
+			"agl_m":              pos.AGL,
+			"heading":            pos.Heading,
+			"track":              pos.Track,
+			"pitch":              pos.Pitch,
+			"roll":               pos.Roll,
+			"ground_speed_kts":   pos.GroundSpeed * knotsPerMS,
+			"vertical_speed_fpm": pos.VerticalSpeed,
+		},
+	}
+}
+
+// Sentences returns the NMEA-0183 RMC (recommended minimum) and GGA (fix data) sentences for the
+// position, with checksums and without line endings.
+func Sentences(pos *xpweb.Position) []string {
+	t := pos.Time.UTC()
+	utc := t.Format("150405.00")
+	lat := formatCoordinate(pos.Latitude, 2, "N", "S")
+	lon := formatCoordinate(pos.Longitude, 3, "E", "W")
+	track := math.Mod(pos.Track+360, 360)
+
+	rmc := fmt.Sprintf("GPRMC,%s,A,%s,%s,%.1f,%.1f,%s,,,A", utc, lat, lon,
+		pos.GroundSpeed*knotsPerMS, track, t.Format("020106"))
+	gga := fmt.Sprintf("GPGGA,%s,%s,%s,1,12,1.0,%.1f,M,0.0,M,,", utc, lat, lon, pos.Elevation)
+	return []string{sentence(rmc), sentence(gga)}
+}
+
+// formatCoordinate formats a coordinate in degrees as NMEA degrees and decimal minutes, followed
+// by its hemisphere, with the specified number of digits of degrees.
+func formatCoordinate(degrees float64, digits int, positive, negative string) string {
+	hemisphere := positive
+	if degrees < 0 {
+		hemisphere = negative
+		degrees = -degrees
+	}
+	whole := math.Floor(degrees)
+	minutes := math.Round((degrees-whole)*60*1e4) / 1e4
+	if minutes >= 60 {
+		whole++
+		minutes -= 60
+	}
+	return fmt.Sprintf("%0*d%07.4f,%s", digits, int(whole), minutes, hemisphere)
+}
+
+// sentence wraps the body of an NMEA sentence with the leading $ and trailing checksum.
+func sentence(body string) string {
+	var checksum byte
+	for idx := range len(body) {
+		checksum ^= body[idx]
+	}
+	return fmt.Sprintf("$%s*%02X", body, checksum)
+}
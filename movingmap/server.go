@@ -0,0 +1,85 @@
+package movingmap
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+)
+
+// Server writes the position of the user's aircraft to every client connected to its listeners.
+// Clients only receive data; anything they send is ignored.
+type Server struct {
+	*Writer
+	conns *broadcast
+}
+
+// NewServer returns a [Server] which writes positions in the specified format.
+func NewServer(format Format) *Server {
+	conns := &broadcast{conns: make(map[net.Conn]bool)}
+	return &Server{Writer: NewWriter(conns, format), conns: conns}
+}
+
+// Serve accepts connections from the listener until the context is done or the listener fails,
+// and then closes the listener and every connection accepted from it.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	var accepted sync.Map
+	stop := context.AfterFunc(ctx, func() { listener.Close() })
+	defer stop()
+	defer func() {
+		listener.Close()
+		accepted.Range(func(conn, _ any) bool {
+			s.conns.remove(conn.(net.Conn))
+			return true
+		})
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		accepted.Store(conn, true)
+		s.conns.add(conn)
+	}
+}
+
+// broadcast is an io.Writer which writes to every connection it holds.  Connections which fail
+// are closed and removed, and never cause an error to be returned, so that one departing client
+// does not stop the stream for the others.
+type broadcast struct {
+	conns map[net.Conn]bool
+	lock  sync.Mutex
+}
+
+func (b *broadcast) add(conn net.Conn) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.conns[conn] = true
+}
+
+func (b *broadcast) remove(conn net.Conn) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.conns, conn)
+	conn.Close()
+}
+
+// Write allows broadcast to implement the io.Writer interface.
+func (b *broadcast) Write(data []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for conn := range b.conns {
+		if _, err := conn.Write(data); err != nil {
+			delete(b.conns, conn)
+			conn.Close()
+		}
+	}
+	return len(data), nil
+}
@@ -0,0 +1,148 @@
+package xpweb
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// maxNameSuggestions limits the number of candidate names included in a [NotFoundError].
+const maxNameSuggestions = 5
+
+// maxNameDistance is the largest edit distance at which a cached name is considered a near-miss
+// of a name which was not found.
+const maxNameDistance = 2
+
+// NameNormalizer is a function which maps a dataref or command name to a normalized form.  Two
+// names having the same normalized form are considered likely to be confused with one another.
+type NameNormalizer func(string) string
+
+// NormalizeCase is a [NameNormalizer] which treats names as case-insensitive.
+var NormalizeCase NameNormalizer = strings.ToLower
+
+// NotFoundError is returned when a dataref or command name cannot be found.  If the client was
+// configured with a NameNormalizer, Candidates will contain any cached names which are
+// case-insensitive or near-miss matches of the requested name.
+type NotFoundError struct {
+	// The kind of item which was not found, either "dataref" or "command".
+	Kind string
+	// The name which was not found.
+	Name string
+	// Cached names which closely match Name.
+	Candidates []string
+}
+
+// Error allows NotFoundError to implement the error interface.
+func (e *NotFoundError) Error() string {
+	msg := fmt.Sprintf("no such %s: %s", e.Kind, e.Name)
+	if len(e.Candidates) > 0 {
+		msg += fmt.Sprintf(" (did you mean %s?)", strings.Join(e.Candidates, ", "))
+	}
+	return msg
+}
+
+// LookupDataref returns the [Dataref] object with the specified name.  If no such dataref is
+// found, a [NotFoundError] is returned.
+func (c *Client) LookupDataref(name string) (*Dataref, error) {
+	if dref := c.GetDatarefByName(name); dref != nil {
+		return dref, nil
+	}
+
+	err := &NotFoundError{Kind: "dataref", Name: name}
+	if c.nameNormalizer != nil {
+		c.datarefsLock.RLock()
+		err.Candidates = c.suggestNames(name, func(yield func(string) bool) {
+			for cachedName := range c.datarefsByName {
+				if !yield(cachedName) {
+					return
+				}
+			}
+		})
+		c.datarefsLock.RUnlock()
+	}
+	return nil, err
+}
+
+// LookupCommand returns the [Command] object with the specified name.  If no such command is
+// found, a [NotFoundError] is returned.
+func (c *Client) LookupCommand(name string) (*Command, error) {
+	if cmd := c.GetCommandByName(name); cmd != nil {
+		return cmd, nil
+	}
+
+	err := &NotFoundError{Kind: "command", Name: name}
+	if c.nameNormalizer != nil {
+		c.commandsLock.RLock()
+		err.Candidates = c.suggestNames(name, func(yield func(string) bool) {
+			for cachedName := range c.commandsByName {
+				if !yield(cachedName) {
+					return
+				}
+			}
+		})
+		c.commandsLock.RUnlock()
+	}
+	return nil, err
+}
+
+// suggestNames returns the names which normalize to the same value as the specified name, followed
+// by the names which are within a small edit distance of it once normalized.
+func (c *Client) suggestNames(name string, names func(func(string) bool)) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	normName := c.nameNormalizer(name)
+	var candidates []candidate
+	for cachedName := range names {
+		normCached := c.nameNormalizer(cachedName)
+		if abs(len(normCached)-len(normName)) > maxNameDistance {
+			continue
+		}
+		if distance := editDistance(normName, normCached); distance <= maxNameDistance {
+			candidates = append(candidates, candidate{name: cachedName, distance: distance})
+		}
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	var suggestions []string
+	for _, cand := range candidates[:min(len(candidates), maxNameSuggestions)] {
+		suggestions = append(suggestions, cand.name)
+	}
+	return suggestions
+}
+
+// editDistance returns the Levenshtein distance between two strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
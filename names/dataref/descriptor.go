@@ -0,0 +1,34 @@
+package dataref
+
+import "github.com/janeprather/xpweb"
+
+// Descriptor describes a known dataref, as listed by the simulator when the constants of this
+// package were generated.  Datarefs registered by plugins or added in later simulator versions
+// are not described.
+type Descriptor struct {
+	// The fully qualified name of the dataref.
+	Name string
+	// The type of the dataref value(s).
+	ValueType xpweb.ValueType
+	// Whether the value of the dataref may be set.
+	IsWritable bool
+}
+
+// Lookup returns the descriptor of the known dataref with the specified name, and whether it is
+// known.
+func Lookup(name string) (Descriptor, bool) {
+	desc, ok := descriptors[name]
+	return desc, ok
+}
+
+// CheckHandle checks whether an [xpweb.DatarefHandle] of type T may be created for the dataref
+// with the specified name, according to its descriptor, and returns an error matching
+// [xpweb.ErrInvalidValue] if not.  No simulator is required, so handles may be checked at startup
+// or in tests.  Datarefs which are not described, such as those of plugins, are accepted.
+func CheckHandle[T xpweb.DatarefType](name string) error {
+	desc, ok := Lookup(name)
+	if !ok {
+		return nil
+	}
+	return xpweb.CheckHandle[T](name, desc.ValueType)
+}
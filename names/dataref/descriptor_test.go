@@ -0,0 +1,35 @@
+package dataref
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+)
+
+func TestLookup(t *testing.T) {
+	desc, ok := Lookup(drefflightmodel.SimFlightmodelPosition_elevation)
+	if !ok {
+		t.Fatal("dataref not described")
+	}
+	if desc.ValueType != xpweb.ValueTypeDouble || desc.IsWritable {
+		t.Errorf("unexpected descriptor %+v", desc)
+	}
+	if _, ok := Lookup("plugin/unknown"); ok {
+		t.Error("unknown dataref described")
+	}
+}
+
+func TestCheckHandle(t *testing.T) {
+	if err := CheckHandle[float64](SimFlightmodelPosition_elevation); err != nil {
+		t.Error(err)
+	}
+	err := CheckHandle[[]int](SimFlightmodelPosition_elevation)
+	if !errors.Is(err, xpweb.ErrInvalidValue) {
+		t.Errorf("unexpected error %v", err)
+	}
+	if err := CheckHandle[string]("plugin/unknown"); err != nil {
+		t.Error(err)
+	}
+}
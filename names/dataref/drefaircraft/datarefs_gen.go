@@ -0,0 +1,569 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefaircraft provides the known dataref names under sim/aircraft/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefaircraft
+
+const (
+	SimAircraftAutopilot_vvi_step_ft                             string = "sim/aircraft/autopilot/vvi_step_ft"                             // float, writable
+	SimAircraftAutopilot_alt_step_ft                             string = "sim/aircraft/autopilot/alt_step_ft"                             // float, writable
+	SimAircraftAutopilot_radio_altimeter_step_ft                 string = "sim/aircraft/autopilot/radio_altimeter_step_ft"                 // float, writable
+	SimAircraftAutopilot_preconfigured_ap_type                   string = "sim/aircraft/autopilot/preconfigured_ap_type"                   // int
+	SimAircraftAutopilot_single_axis_autopilot                   string = "sim/aircraft/autopilot/single_axis_autopilot"                   // int
+	SimAircraftAutopilot_ah_source                               string = "sim/aircraft/autopilot/ah_source"                               // int
+	SimAircraftAutopilot_dg_source                               string = "sim/aircraft/autopilot/dg_source"                               // int
+	SimAircraftView_acf_tailnum                                  string = "sim/aircraft/view/acf_tailnum"                                  // data, writable
+	SimAircraftView_acf_modeS_id                                 string = "sim/aircraft/view/acf_modeS_id"                                 // int, writable
+	SimAircraftView_acf_author                                   string = "sim/aircraft/view/acf_author"                                   // data, writable
+	SimAircraftView_acf_descrip                                  string = "sim/aircraft/view/acf_descrip"                                  // data, writable
+	SimAircraftView_acf_notes                                    string = "sim/aircraft/view/acf_notes"                                    // data, writable
+	SimAircraftView_acf_ui_name                                  string = "sim/aircraft/view/acf_ui_name"                                  // data
+	SimAircraftView_acf_size_x                                   string = "sim/aircraft/view/acf_size_x"                                   // float, writable
+	SimAircraftView_acf_size_z                                   string = "sim/aircraft/view/acf_size_z"                                   // float, writable
+	SimAircraftView_acf_asi_kts                                  string = "sim/aircraft/view/acf_asi_kts"                                  // int, writable
+	SimAircraftView_acf_cockpit_type                             string = "sim/aircraft/view/acf_cockpit_type"                             // int, writable
+	SimAircraftView_acf_has_SC_fd                                string = "sim/aircraft/view/acf_has_SC_fd"                                // int, writable
+	SimAircraftView_acf_has_stallwarn                            string = "sim/aircraft/view/acf_has_stallwarn"                            // int, writable
+	SimAircraftView_acf_peX                                      string = "sim/aircraft/view/acf_peX"                                      // float, writable
+	SimAircraftView_acf_peY                                      string = "sim/aircraft/view/acf_peY"                                      // float, writable
+	SimAircraftView_acf_peZ                                      string = "sim/aircraft/view/acf_peZ"                                      // float, writable
+	SimAircraftView_acf_Vso                                      string = "sim/aircraft/view/acf_Vso"                                      // float, writable
+	SimAircraftView_acf_Vs                                       string = "sim/aircraft/view/acf_Vs"                                       // float, writable
+	SimAircraftView_acf_Vfe                                      string = "sim/aircraft/view/acf_Vfe"                                      // float, writable
+	SimAircraftView_acf_Vno                                      string = "sim/aircraft/view/acf_Vno"                                      // float, writable
+	SimAircraftView_acf_Vne                                      string = "sim/aircraft/view/acf_Vne"                                      // float, writable
+	SimAircraftView_acf_Mmo                                      string = "sim/aircraft/view/acf_Mmo"                                      // float, writable
+	SimAircraftView_acf_Gneg                                     string = "sim/aircraft/view/acf_Gneg"                                     // float, writable
+	SimAircraftView_acf_Gpos                                     string = "sim/aircraft/view/acf_Gpos"                                     // float, writable
+	SimAircraftView_acf_yawstringx                               string = "sim/aircraft/view/acf_yawstringx"                               // float, writable
+	SimAircraftView_acf_yawstringy                               string = "sim/aircraft/view/acf_yawstringy"                               // float, writable
+	SimAircraftView_acf_HUD_cntry                                string = "sim/aircraft/view/acf_HUD_cntry"                                // float, writable
+	SimAircraftView_acf_HUD_delx                                 string = "sim/aircraft/view/acf_HUD_delx"                                 // float, writable
+	SimAircraftView_acf_HUD_dely                                 string = "sim/aircraft/view/acf_HUD_dely"                                 // float, writable
+	SimAircraftView_acf_ICAO                                     string = "sim/aircraft/view/acf_ICAO"                                     // data, writable
+	SimAircraftView_acf_door_x                                   string = "sim/aircraft/view/acf_door_x"                                   // float
+	SimAircraftView_acf_door_y                                   string = "sim/aircraft/view/acf_door_y"                                   // float
+	SimAircraftView_acf_door_z                                   string = "sim/aircraft/view/acf_door_z"                                   // float
+	SimAircraftView_acf_livery_index                             string = "sim/aircraft/view/acf_livery_index"                             // int
+	SimAircraftView_acf_relative_path                            string = "sim/aircraft/view/acf_relative_path"                            // data
+	SimAircraftView_acf_livery_path                              string = "sim/aircraft/view/acf_livery_path"                              // data
+	SimAircraftForcefeedback_acf_ff_hydraulic                    string = "sim/aircraft/forcefeedback/acf_ff_hydraulic"                    // int, writable
+	SimAircraftForcefeedback_acf_ff_stickshaker                  string = "sim/aircraft/forcefeedback/acf_ff_stickshaker"                  // int, writable
+	SimAircraftElectrical_num_batteries                          string = "sim/aircraft/electrical/num_batteries"                          // int
+	SimAircraftElectrical_num_generators                         string = "sim/aircraft/electrical/num_generators"                         // int
+	SimAircraftElectrical_num_inverters                          string = "sim/aircraft/electrical/num_inverters"                          // int
+	SimAircraftElectrical_num_buses                              string = "sim/aircraft/electrical/num_buses"                              // int
+	SimAircraftElectrical_bus_apu_is_on                          string = "sim/aircraft/electrical/bus_apu_is_on"                          // int
+	SimAircraftElectrical_bus_essentials_are_on                  string = "sim/aircraft/electrical/bus_essentials_are_on"                  // int
+	SimAircraftElectrical_acf_nom_gen_volt                       string = "sim/aircraft/electrical/acf_nom_gen_volt"                       // float
+	SimAircraftElectrical_acf_nom_bat_volt                       string = "sim/aircraft/electrical/acf_nom_bat_volt"                       // float
+	SimAircraftElectrical_essential_ties                         string = "sim/aircraft/electrical/essential_ties"                         // int, writable
+	SimAircraftElectrical_bus_tie_selective                      string = "sim/aircraft/electrical/bus_tie_selective"                      // int, writable
+	SimAircraftElectrical_battery_watt_hr_max                    string = "sim/aircraft/electrical/battery_watt_hr_max"                    // float
+	SimAircraftEngine_acf_num_engines                            string = "sim/aircraft/engine/acf_num_engines"                            // int, writable
+	SimAircraftEngine_acf_auto_featherEQ                         string = "sim/aircraft/engine/acf_auto_featherEQ"                         // int, writable
+	SimAircraftEngine_acf_prop_fail_mode                         string = "sim/aircraft/engine/acf_prop_fail_mode"                         // int, writable
+	SimAircraftEngine_acf_throtmax_FWD                           string = "sim/aircraft/engine/acf_throtmax_FWD"                           // float, writable
+	SimAircraftEngine_acf_throtmax_REV                           string = "sim/aircraft/engine/acf_throtmax_REV"                           // float, writable
+	SimAircraftEngine_acf_RSC_mingov_eng                         string = "sim/aircraft/engine/acf_RSC_mingov_eng"                         // float, writable
+	SimAircraftEngine_acf_RSC_idlespeed_eng                      string = "sim/aircraft/engine/acf_RSC_idlespeed_eng"                      // float, writable
+	SimAircraftEngine_acf_RSC_redline_eng                        string = "sim/aircraft/engine/acf_RSC_redline_eng"                        // float, writable
+	SimAircraftEngine_acf_RSC_redline_eng_per_engine             string = "sim/aircraft/engine/acf_RSC_redline_eng_per_engine"             // float_array, writable
+	SimAircraftEngine_min_N1_turboprop_FCU                       string = "sim/aircraft/engine/min_N1_turboprop_FCU"                       // float, writable
+	SimAircraftEngine_min_N2_jet_governor                        string = "sim/aircraft/engine/min_N2_jet_governor"                        // float, writable
+	SimAircraftEngine_acf_RSC_mingreen_eng                       string = "sim/aircraft/engine/acf_RSC_mingreen_eng"                       // float, writable
+	SimAircraftEngine_acf_RSC_maxgreen_eng                       string = "sim/aircraft/engine/acf_RSC_maxgreen_eng"                       // float, writable
+	SimAircraftEngine_acf_pmax                                   string = "sim/aircraft/engine/acf_pmax"                                   // float, writable
+	SimAircraftEngine_acf_pmax_per_engine                        string = "sim/aircraft/engine/acf_pmax_per_engine"                        // float_array, writable
+	SimAircraftEngine_acf_tmax                                   string = "sim/aircraft/engine/acf_tmax"                                   // float, writable
+	SimAircraftEngine_acf_tmax_per_engine                        string = "sim/aircraft/engine/acf_tmax_per_engine"                        // float_array, writable
+	SimAircraftEngine_acf_burnerinc                              string = "sim/aircraft/engine/acf_burnerinc"                              // float, writable
+	SimAircraftEngine_acf_burnerinc_per_engine                   string = "sim/aircraft/engine/acf_burnerinc_per_engine"                   // float_array, writable
+	SimAircraftEngine_acf_critalt                                string = "sim/aircraft/engine/acf_critalt"                                // float, writable
+	SimAircraftEngine_acf_mpmax                                  string = "sim/aircraft/engine/acf_mpmax"                                  // float, writable
+	SimAircraftEngine_acf_gear_rat                               string = "sim/aircraft/engine/acf_gear_rat"                               // float, writable
+	SimAircraftEngine_acf_face_jet                               string = "sim/aircraft/engine/acf_face_jet"                               // float, writable
+	SimAircraftEngine_acf_face_rocket                            string = "sim/aircraft/engine/acf_face_rocket"                            // float, writable
+	SimAircraftEngine_acf_spooltime_jet                          string = "sim/aircraft/engine/acf_spooltime_jet"                          // float, writable
+	SimAircraftEngine_acf_spooltime_prop                         string = "sim/aircraft/engine/acf_spooltime_prop"                         // float, writable
+	SimAircraftEngine_acf_spooltime_turbine                      string = "sim/aircraft/engine/acf_spooltime_turbine"                      // float, writable
+	SimAircraftEngine_acf_fuel_intro_time_prop                   string = "sim/aircraft/engine/acf_fuel_intro_time_prop"                   // float, writable
+	SimAircraftEngine_fuel_intro_time_jet                        string = "sim/aircraft/engine/fuel_intro_time_jet"                        // float, writable
+	SimAircraftEngine_acf_max_mach_eff                           string = "sim/aircraft/engine/acf_max_mach_eff"                           // float, writable
+	SimAircraftEngine_acf_fmax_sl                                string = "sim/aircraft/engine/acf_fmax_sl"                                // float, writable
+	SimAircraftEngine_acf_fmax_opt                               string = "sim/aircraft/engine/acf_fmax_opt"                               // float, writable
+	SimAircraftEngine_acf_fmax_vac                               string = "sim/aircraft/engine/acf_fmax_vac"                               // float, writable
+	SimAircraftEngine_acf_h_opt                                  string = "sim/aircraft/engine/acf_h_opt"                                  // float, writable
+	SimAircraftEngine_aacf_tip_mach_des_50                       string = "sim/aircraft/engine/aacf_tip_mach_des_50"                       // float, writable
+	SimAircraftEngine_aacf_tip_mach_des_100                      string = "sim/aircraft/engine/aacf_tip_mach_des_100"                      // float, writable
+	SimAircraftEngine_aacf_rotor_mi_rat                          string = "sim/aircraft/engine/aacf_rotor_mi_rat"                          // float, writable
+	SimAircraftEngine_aacf_tip_weight                            string = "sim/aircraft/engine/aacf_tip_weight"                            // float, writable
+	SimAircraftEngine_acf_max_ITT                                string = "sim/aircraft/engine/acf_max_ITT"                                // float, writable
+	SimAircraftEngine_acf_max_EGT                                string = "sim/aircraft/engine/acf_max_EGT"                                // float, writable
+	SimAircraftEngine_acf_max_CHT                                string = "sim/aircraft/engine/acf_max_CHT"                                // float, writable
+	SimAircraftEngine_acf_max_OILP                               string = "sim/aircraft/engine/acf_max_OILP"                               // float, writable
+	SimAircraftEngine_acf_max_OILT                               string = "sim/aircraft/engine/acf_max_OILT"                               // float, writable
+	SimAircraftEngine_acf_oilT_is_C                              string = "sim/aircraft/engine/acf_oilT_is_C"                              // int
+	SimAircraftEngine_acf_ITT_is_C                               string = "sim/aircraft/engine/acf_ITT_is_C"                               // int
+	SimAircraftEngine_acf_EGT_is_C                               string = "sim/aircraft/engine/acf_EGT_is_C"                               // int
+	SimAircraftEngine_acf_CHT_is_C                               string = "sim/aircraft/engine/acf_CHT_is_C"                               // int
+	SimAircraftEngine_acf_max_FUELP                              string = "sim/aircraft/engine/acf_max_FUELP"                              // float, writable
+	SimAircraftEngine_acf_starter_torque_ratio                   string = "sim/aircraft/engine/acf_starter_torque_ratio"                   // float, writable
+	SimAircraftEngine_acf_starter_max_rpm_ratio                  string = "sim/aircraft/engine/acf_starter_max_rpm_ratio"                  // float, writable
+	SimAircraftEngine_boost_ratio                                string = "sim/aircraft/engine/boost_ratio"                                // float, writable
+	SimAircraftEngine_boost_max_seconds                          string = "sim/aircraft/engine/boost_max_seconds"                          // float, writable
+	SimAircraftEngine_acf_APU_door_time                          string = "sim/aircraft/engine/acf_APU_door_time"                          // float, writable
+	SimAircraftEngine_acf_APU_cooldown_time                      string = "sim/aircraft/engine/acf_APU_cooldown_time"                      // float, writable
+	SimAircraftEngine_acf_APU_spoolup_time                       string = "sim/aircraft/engine/acf_APU_spoolup_time"                       // float, writable
+	SimAircraftEngine_acf_APU_spooldn_time                       string = "sim/aircraft/engine/acf_APU_spooldn_time"                       // float, writable
+	SimAircraftEngine_acf_APU_fuelflow_kgh                       string = "sim/aircraft/engine/acf_APU_fuelflow_kgh"                       // float, writable
+	SimAircraftEngine_acf_APU_fuel_src                           string = "sim/aircraft/engine/acf_APU_fuel_src"                           // int, writable
+	SimAircraftEngine_bleed_n2_min_deice_wing                    string = "sim/aircraft/engine/bleed_n2_min_deice_wing"                    // float, writable
+	SimAircraftLimits_green_lo_MP                                string = "sim/aircraft/limits/green_lo_MP"                                // float
+	SimAircraftLimits_green_hi_MP                                string = "sim/aircraft/limits/green_hi_MP"                                // float
+	SimAircraftLimits_yellow_lo_MP                               string = "sim/aircraft/limits/yellow_lo_MP"                               // float
+	SimAircraftLimits_yellow_hi_MP                               string = "sim/aircraft/limits/yellow_hi_MP"                               // float
+	SimAircraftLimits_red_lo_MP                                  string = "sim/aircraft/limits/red_lo_MP"                                  // float
+	SimAircraftLimits_red_hi_MP                                  string = "sim/aircraft/limits/red_hi_MP"                                  // float
+	SimAircraftLimits_green_lo_EPR                               string = "sim/aircraft/limits/green_lo_EPR"                               // float
+	SimAircraftLimits_green_hi_EPR                               string = "sim/aircraft/limits/green_hi_EPR"                               // float
+	SimAircraftLimits_yellow_lo_EPR                              string = "sim/aircraft/limits/yellow_lo_EPR"                              // float
+	SimAircraftLimits_yellow_hi_EPR                              string = "sim/aircraft/limits/yellow_hi_EPR"                              // float
+	SimAircraftLimits_red_lo_EPR                                 string = "sim/aircraft/limits/red_lo_EPR"                                 // float
+	SimAircraftLimits_red_hi_EPR                                 string = "sim/aircraft/limits/red_hi_EPR"                                 // float
+	SimAircraftLimits_green_lo_TRQ                               string = "sim/aircraft/limits/green_lo_TRQ"                               // float
+	SimAircraftLimits_green_hi_TRQ                               string = "sim/aircraft/limits/green_hi_TRQ"                               // float
+	SimAircraftLimits_yellow_lo_TRQ                              string = "sim/aircraft/limits/yellow_lo_TRQ"                              // float
+	SimAircraftLimits_yellow_hi_TRQ                              string = "sim/aircraft/limits/yellow_hi_TRQ"                              // float
+	SimAircraftLimits_red_lo_TRQ                                 string = "sim/aircraft/limits/red_lo_TRQ"                                 // float
+	SimAircraftLimits_red_hi_TRQ                                 string = "sim/aircraft/limits/red_hi_TRQ"                                 // float
+	SimAircraftLimits_green_lo_FF                                string = "sim/aircraft/limits/green_lo_FF"                                // float
+	SimAircraftLimits_green_hi_FF                                string = "sim/aircraft/limits/green_hi_FF"                                // float
+	SimAircraftLimits_yellow_lo_FF                               string = "sim/aircraft/limits/yellow_lo_FF"                               // float
+	SimAircraftLimits_yellow_hi_FF                               string = "sim/aircraft/limits/yellow_hi_FF"                               // float
+	SimAircraftLimits_red_lo_FF                                  string = "sim/aircraft/limits/red_lo_FF"                                  // float
+	SimAircraftLimits_red_hi_FF                                  string = "sim/aircraft/limits/red_hi_FF"                                  // float
+	SimAircraftLimits_green_lo_ITT                               string = "sim/aircraft/limits/green_lo_ITT"                               // float
+	SimAircraftLimits_green_hi_ITT                               string = "sim/aircraft/limits/green_hi_ITT"                               // float
+	SimAircraftLimits_yellow_lo_ITT                              string = "sim/aircraft/limits/yellow_lo_ITT"                              // float
+	SimAircraftLimits_yellow_hi_ITT                              string = "sim/aircraft/limits/yellow_hi_ITT"                              // float
+	SimAircraftLimits_red_lo_ITT                                 string = "sim/aircraft/limits/red_lo_ITT"                                 // float
+	SimAircraftLimits_red_hi_ITT                                 string = "sim/aircraft/limits/red_hi_ITT"                                 // float
+	SimAircraftLimits_green_lo_EGT                               string = "sim/aircraft/limits/green_lo_EGT"                               // float
+	SimAircraftLimits_green_hi_EGT                               string = "sim/aircraft/limits/green_hi_EGT"                               // float
+	SimAircraftLimits_yellow_lo_EGT                              string = "sim/aircraft/limits/yellow_lo_EGT"                              // float
+	SimAircraftLimits_yellow_hi_EGT                              string = "sim/aircraft/limits/yellow_hi_EGT"                              // float
+	SimAircraftLimits_red_lo_EGT                                 string = "sim/aircraft/limits/red_lo_EGT"                                 // float
+	SimAircraftLimits_red_hi_EGT                                 string = "sim/aircraft/limits/red_hi_EGT"                                 // float
+	SimAircraftLimits_green_lo_CHT                               string = "sim/aircraft/limits/green_lo_CHT"                               // float
+	SimAircraftLimits_green_hi_CHT                               string = "sim/aircraft/limits/green_hi_CHT"                               // float
+	SimAircraftLimits_yellow_lo_CHT                              string = "sim/aircraft/limits/yellow_lo_CHT"                              // float
+	SimAircraftLimits_yellow_hi_CHT                              string = "sim/aircraft/limits/yellow_hi_CHT"                              // float
+	SimAircraftLimits_red_lo_CHT                                 string = "sim/aircraft/limits/red_lo_CHT"                                 // float
+	SimAircraftLimits_red_hi_CHT                                 string = "sim/aircraft/limits/red_hi_CHT"                                 // float
+	SimAircraftLimits_green_lo_oilT                              string = "sim/aircraft/limits/green_lo_oilT"                              // float
+	SimAircraftLimits_green_hi_oilT                              string = "sim/aircraft/limits/green_hi_oilT"                              // float
+	SimAircraftLimits_yellow_lo_oilT                             string = "sim/aircraft/limits/yellow_lo_oilT"                             // float
+	SimAircraftLimits_yellow_hi_oilT                             string = "sim/aircraft/limits/yellow_hi_oilT"                             // float
+	SimAircraftLimits_red_lo_oilT                                string = "sim/aircraft/limits/red_lo_oilT"                                // float
+	SimAircraftLimits_red_hi_oilT                                string = "sim/aircraft/limits/red_hi_oilT"                                // float
+	SimAircraftLimits_green_lo_oilP                              string = "sim/aircraft/limits/green_lo_oilP"                              // float
+	SimAircraftLimits_green_hi_oilP                              string = "sim/aircraft/limits/green_hi_oilP"                              // float
+	SimAircraftLimits_yellow_lo_oilP                             string = "sim/aircraft/limits/yellow_lo_oilP"                             // float
+	SimAircraftLimits_yellow_hi_oilP                             string = "sim/aircraft/limits/yellow_hi_oilP"                             // float
+	SimAircraftLimits_red_lo_oilP                                string = "sim/aircraft/limits/red_lo_oilP"                                // float
+	SimAircraftLimits_red_hi_oilP                                string = "sim/aircraft/limits/red_hi_oilP"                                // float
+	SimAircraftLimits_green_lo_fuelP                             string = "sim/aircraft/limits/green_lo_fuelP"                             // float
+	SimAircraftLimits_green_hi_fuelP                             string = "sim/aircraft/limits/green_hi_fuelP"                             // float
+	SimAircraftLimits_yellow_lo_fuelP                            string = "sim/aircraft/limits/yellow_lo_fuelP"                            // float
+	SimAircraftLimits_yellow_hi_fuelP                            string = "sim/aircraft/limits/yellow_hi_fuelP"                            // float
+	SimAircraftLimits_red_lo_fuelP                               string = "sim/aircraft/limits/red_lo_fuelP"                               // float
+	SimAircraftLimits_red_hi_fuelP                               string = "sim/aircraft/limits/red_hi_fuelP"                               // float
+	SimAircraftLimits_green_lo_gen_amp                           string = "sim/aircraft/limits/green_lo_gen_amp"                           // float
+	SimAircraftLimits_green_hi_gen_amp                           string = "sim/aircraft/limits/green_hi_gen_amp"                           // float
+	SimAircraftLimits_yellow_lo_gen_amp                          string = "sim/aircraft/limits/yellow_lo_gen_amp"                          // float
+	SimAircraftLimits_yellow_hi_gen_amp                          string = "sim/aircraft/limits/yellow_hi_gen_amp"                          // float
+	SimAircraftLimits_red_lo_gen_amp                             string = "sim/aircraft/limits/red_lo_gen_amp"                             // float
+	SimAircraftLimits_red_hi_gen_amp                             string = "sim/aircraft/limits/red_hi_gen_amp"                             // float
+	SimAircraftLimits_green_lo_bat_amp                           string = "sim/aircraft/limits/green_lo_bat_amp"                           // float
+	SimAircraftLimits_green_hi_bat_amp                           string = "sim/aircraft/limits/green_hi_bat_amp"                           // float
+	SimAircraftLimits_yellow_lo_bat_amp                          string = "sim/aircraft/limits/yellow_lo_bat_amp"                          // float
+	SimAircraftLimits_yellow_hi_bat_amp                          string = "sim/aircraft/limits/yellow_hi_bat_amp"                          // float
+	SimAircraftLimits_red_lo_bat_amp                             string = "sim/aircraft/limits/red_lo_bat_amp"                             // float
+	SimAircraftLimits_red_hi_bat_amp                             string = "sim/aircraft/limits/red_hi_bat_amp"                             // float
+	SimAircraftLimits_max_bat_amp                                string = "sim/aircraft/limits/max_bat_amp"                                // float
+	SimAircraftLimits_green_lo_bat_volt                          string = "sim/aircraft/limits/green_lo_bat_volt"                          // float
+	SimAircraftLimits_green_hi_bat_volt                          string = "sim/aircraft/limits/green_hi_bat_volt"                          // float
+	SimAircraftLimits_yellow_lo_bat_volt                         string = "sim/aircraft/limits/yellow_lo_bat_volt"                         // float
+	SimAircraftLimits_yellow_hi_bat_volt                         string = "sim/aircraft/limits/yellow_hi_bat_volt"                         // float
+	SimAircraftLimits_red_lo_bat_volt                            string = "sim/aircraft/limits/red_lo_bat_volt"                            // float
+	SimAircraftLimits_red_hi_bat_volt                            string = "sim/aircraft/limits/red_hi_bat_volt"                            // float
+	SimAircraftLimits_max_bat_volt_standard                      string = "sim/aircraft/limits/max_bat_volt_standard"                      // float
+	SimAircraftLimits_green_lo_vac                               string = "sim/aircraft/limits/green_lo_vac"                               // float
+	SimAircraftLimits_green_hi_vac                               string = "sim/aircraft/limits/green_hi_vac"                               // float
+	SimAircraftLimits_yellow_lo_vac                              string = "sim/aircraft/limits/yellow_lo_vac"                              // float
+	SimAircraftLimits_yellow_hi_vac                              string = "sim/aircraft/limits/yellow_hi_vac"                              // float
+	SimAircraftLimits_red_lo_vac                                 string = "sim/aircraft/limits/red_lo_vac"                                 // float
+	SimAircraftLimits_red_hi_vac                                 string = "sim/aircraft/limits/red_hi_vac"                                 // float
+	SimAircraftLimits_max_vac                                    string = "sim/aircraft/limits/max_vac"                                    // float
+	SimAircraftLimits_green_lo_N1                                string = "sim/aircraft/limits/green_lo_N1"                                // float
+	SimAircraftLimits_green_hi_N1                                string = "sim/aircraft/limits/green_hi_N1"                                // float
+	SimAircraftLimits_yellow_lo_N1                               string = "sim/aircraft/limits/yellow_lo_N1"                               // float
+	SimAircraftLimits_yellow_hi_N1                               string = "sim/aircraft/limits/yellow_hi_N1"                               // float
+	SimAircraftLimits_red_lo_N1                                  string = "sim/aircraft/limits/red_lo_N1"                                  // float
+	SimAircraftLimits_red_hi_N1                                  string = "sim/aircraft/limits/red_hi_N1"                                  // float
+	SimAircraftLimits_green_lo_N2                                string = "sim/aircraft/limits/green_lo_N2"                                // float
+	SimAircraftLimits_green_hi_N2                                string = "sim/aircraft/limits/green_hi_N2"                                // float
+	SimAircraftLimits_yellow_lo_N2                               string = "sim/aircraft/limits/yellow_lo_N2"                               // float
+	SimAircraftLimits_yellow_hi_N2                               string = "sim/aircraft/limits/yellow_hi_N2"                               // float
+	SimAircraftLimits_red_lo_N2                                  string = "sim/aircraft/limits/red_lo_N2"                                  // float
+	SimAircraftLimits_red_hi_N2                                  string = "sim/aircraft/limits/red_hi_N2"                                  // float
+	SimAircraftProp_acf_en_type                                  string = "sim/aircraft/prop/acf_en_type"                                  // int_array
+	SimAircraftProp_acf_revthrust_eq                             string = "sim/aircraft/prop/acf_revthrust_eq"                             // int, writable
+	SimAircraftProp_acf_prop_type                                string = "sim/aircraft/prop/acf_prop_type"                                // int_array, writable
+	SimAircraftProp_acf_prop_gear_rat                            string = "sim/aircraft/prop/acf_prop_gear_rat"                            // float_array, writable
+	SimAircraftProp_acf_prop_dir                                 string = "sim/aircraft/prop/acf_prop_dir"                                 // float_array
+	SimAircraftProp_acf_num_blades                               string = "sim/aircraft/prop/acf_num_blades"                               // float_array, writable
+	SimAircraftProp_acf_min_pitch                                string = "sim/aircraft/prop/acf_min_pitch"                                // float_array, writable
+	SimAircraftProp_acf_max_pitch                                string = "sim/aircraft/prop/acf_max_pitch"                                // float_array, writable
+	SimAircraftProp_acf_reversed_pitch                           string = "sim/aircraft/prop/acf_reversed_pitch"                           // float, writable
+	SimAircraftProp_acf_sidecant                                 string = "sim/aircraft/prop/acf_sidecant"                                 // float_array, writable
+	SimAircraftProp_acf_vertcant                                 string = "sim/aircraft/prop/acf_vertcant"                                 // float_array, writable
+	SimAircraftProp_prop_sidecant                                string = "sim/aircraft/prop/prop_sidecant"                                // float_array, writable
+	SimAircraftProp_prop_vertcant                                string = "sim/aircraft/prop/prop_vertcant"                                // float_array, writable
+	SimAircraftProp_acf_prop_mass                                string = "sim/aircraft/prop/acf_prop_mass"                                // float_array, writable
+	SimAircraftProp_acf_miprop_rpm                               string = "sim/aircraft/prop/acf_miprop_rpm"                               // float_array, writable
+	SimAircraftProp_acf_discarea                                 string = "sim/aircraft/prop/acf_discarea"                                 // float_array, writable
+	SimAircraftProp_acf_ringarea                                 string = "sim/aircraft/prop/acf_ringarea"                                 // float_array, writable
+	SimAircraftProp_acf_des_rpm_prp                              string = "sim/aircraft/prop/acf_des_rpm_prp"                              // float_array, writable
+	SimAircraftProp_acf_des_kts_acf                              string = "sim/aircraft/prop/acf_des_kts_acf"                              // float_array, writable
+	SimAircraftParts_acf_els                                     string = "sim/aircraft/parts/acf_els"                                     // int_array, writable
+	SimAircraftParts_acf_Xarm                                    string = "sim/aircraft/parts/acf_Xarm"                                    // float_array, writable
+	SimAircraftParts_acf_Yarm                                    string = "sim/aircraft/parts/acf_Yarm"                                    // float_array, writable
+	SimAircraftParts_acf_Zarm                                    string = "sim/aircraft/parts/acf_Zarm"                                    // float_array, writable
+	SimAircraftParts_acf_Croot                                   string = "sim/aircraft/parts/acf_Croot"                                   // float_array, writable
+	SimAircraftParts_acf_Ctip                                    string = "sim/aircraft/parts/acf_Ctip"                                    // float_array, writable
+	SimAircraftParts_acf_dihed1                                  string = "sim/aircraft/parts/acf_dihed1"                                  // float_array, writable
+	SimAircraftParts_acf_sweep1                                  string = "sim/aircraft/parts/acf_sweep1"                                  // float_array, writable
+	SimAircraftParts_acf_sweep2                                  string = "sim/aircraft/parts/acf_sweep2"                                  // float_array, writable
+	SimAircraftParts_acf_semilen_SEG                             string = "sim/aircraft/parts/acf_semilen_SEG"                             // float_array, writable
+	SimAircraftParts_acf_semilen_JND                             string = "sim/aircraft/parts/acf_semilen_JND"                             // float_array, writable
+	SimAircraftParts_acf_e                                       string = "sim/aircraft/parts/acf_e"                                       // float_array, writable
+	SimAircraftParts_acf_AR                                      string = "sim/aircraft/parts/acf_AR"                                      // float_array, writable
+	SimAircraftParts_acf_anginc                                  string = "sim/aircraft/parts/acf_anginc"                                  // float_array, writable
+	SimAircraftParts_acf_flapEQ                                  string = "sim/aircraft/parts/acf_flapEQ"                                  // int, writable
+	SimAircraftParts_acf_slatEQ                                  string = "sim/aircraft/parts/acf_slatEQ"                                  // int, writable
+	SimAircraftParts_acf_sbrkEQ                                  string = "sim/aircraft/parts/acf_sbrkEQ"                                  // int, writable
+	SimAircraftParts_acf_ail1                                    string = "sim/aircraft/parts/acf_ail1"                                    // int_array, writable
+	SimAircraftParts_acf_ail2                                    string = "sim/aircraft/parts/acf_ail2"                                    // int_array, writable
+	SimAircraftParts_acf_splr                                    string = "sim/aircraft/parts/acf_splr"                                    // int_array, writable
+	SimAircraftParts_acf_flap                                    string = "sim/aircraft/parts/acf_flap"                                    // int_array, writable
+	SimAircraftParts_acf_flap2                                   string = "sim/aircraft/parts/acf_flap2"                                   // int_array, writable
+	SimAircraftParts_acf_slat                                    string = "sim/aircraft/parts/acf_slat"                                    // int_array, writable
+	SimAircraftParts_acf_sbrk                                    string = "sim/aircraft/parts/acf_sbrk"                                    // int_array, writable
+	SimAircraftParts_acf_drud                                    string = "sim/aircraft/parts/acf_drud"                                    // int_array, writable
+	SimAircraftParts_acf_yawb                                    string = "sim/aircraft/parts/acf_yawb"                                    // int_array, writable
+	SimAircraftParts_acf_elev                                    string = "sim/aircraft/parts/acf_elev"                                    // int_array, writable
+	SimAircraftParts_acf_rudd                                    string = "sim/aircraft/parts/acf_rudd"                                    // int_array, writable
+	SimAircraftParts_acf_rudd2                                   string = "sim/aircraft/parts/acf_rudd2"                                   // int_array, writable
+	SimAircraftParts_acf_gear_type                               string = "sim/aircraft/parts/acf_gear_type"                               // int_array, writable
+	SimAircraftParts_acf_gear_latE                               string = "sim/aircraft/parts/acf_gear_latE"                               // float_array, writable
+	SimAircraftParts_acf_gear_lonE                               string = "sim/aircraft/parts/acf_gear_lonE"                               // float_array, writable
+	SimAircraftParts_acf_gear_axiE                               string = "sim/aircraft/parts/acf_gear_axiE"                               // float_array, writable
+	SimAircraftParts_acf_gear_latR                               string = "sim/aircraft/parts/acf_gear_latR"                               // float_array, writable
+	SimAircraftParts_acf_gear_lonR                               string = "sim/aircraft/parts/acf_gear_lonR"                               // float_array, writable
+	SimAircraftParts_acf_gear_axiR                               string = "sim/aircraft/parts/acf_gear_axiR"                               // float_array, writable
+	SimAircraftParts_acf_gear_latN                               string = "sim/aircraft/parts/acf_gear_latN"                               // float_array, writable
+	SimAircraftParts_acf_gear_lonN                               string = "sim/aircraft/parts/acf_gear_lonN"                               // float_array, writable
+	SimAircraftParts_acf_gear_axiN                               string = "sim/aircraft/parts/acf_gear_axiN"                               // float_array, writable
+	SimAircraftParts_acf_gear_leglen                             string = "sim/aircraft/parts/acf_gear_leglen"                             // float_array, writable
+	SimAircraftParts_acf_gear_tirrad                             string = "sim/aircraft/parts/acf_gear_tirrad"                             // float_array, writable
+	SimAircraftParts_acf_gearcon                                 string = "sim/aircraft/parts/acf_gearcon"                                 // float_array
+	SimAircraftParts_acf_geardmp                                 string = "sim/aircraft/parts/acf_geardmp"                                 // float_array, writable
+	SimAircraftParts_acf_gear_deploy                             string = "sim/aircraft/parts/acf_gear_deploy"                             // float_array, writable
+	SimAircraftParts_acf_gear_xnodef                             string = "sim/aircraft/parts/acf_gear_xnodef"                             // float_array, writable
+	SimAircraftParts_acf_gear_ynodef                             string = "sim/aircraft/parts/acf_gear_ynodef"                             // float_array, writable
+	SimAircraftParts_acf_gear_znodef                             string = "sim/aircraft/parts/acf_gear_znodef"                             // float_array, writable
+	SimAircraftBodies_acf_fuse_cd                                string = "sim/aircraft/bodies/acf_fuse_cd"                                // float, writable
+	SimAircraftBodies_acf_fuse_cd_array                          string = "sim/aircraft/bodies/acf_fuse_cd_array"                          // float_array, writable
+	SimAircraftControls_acf_ail1_crat                            string = "sim/aircraft/controls/acf_ail1_crat"                            // float, writable
+	SimAircraftControls_acf_ail1_up                              string = "sim/aircraft/controls/acf_ail1_up"                              // float, writable
+	SimAircraftControls_acf_ail1_dn                              string = "sim/aircraft/controls/acf_ail1_dn"                              // float, writable
+	SimAircraftControls_acf_RSC_mingov_prp                       string = "sim/aircraft/controls/acf_RSC_mingov_prp"                       // float
+	SimAircraftControls_acf_RSC_idlespeed_prp                    string = "sim/aircraft/controls/acf_RSC_idlespeed_prp"                    // float
+	SimAircraftControls_acf_RSC_redline_prp                      string = "sim/aircraft/controls/acf_RSC_redline_prp"                      // float
+	SimAircraftControls_acf_ail2_crat                            string = "sim/aircraft/controls/acf_ail2_crat"                            // float, writable
+	SimAircraftControls_acf_ail2_up                              string = "sim/aircraft/controls/acf_ail2_up"                              // float, writable
+	SimAircraftControls_acf_ail2_dn                              string = "sim/aircraft/controls/acf_ail2_dn"                              // float, writable
+	SimAircraftControls_acf_RSC_mingreen_prp                     string = "sim/aircraft/controls/acf_RSC_mingreen_prp"                     // float
+	SimAircraftControls_acf_RSC_maxgreen_prp                     string = "sim/aircraft/controls/acf_RSC_maxgreen_prp"                     // float
+	SimAircraftControls_acf_elev_crat                            string = "sim/aircraft/controls/acf_elev_crat"                            // float, writable
+	SimAircraftControls_acf_elev_up                              string = "sim/aircraft/controls/acf_elev_up"                              // float, writable
+	SimAircraftControls_acf_elev_dn                              string = "sim/aircraft/controls/acf_elev_dn"                              // float, writable
+	SimAircraftControls_acf_trq_max_eng                          string = "sim/aircraft/controls/acf_trq_max_eng"                          // float, writable
+	SimAircraftControls_acf_trq_max_prp                          string = "sim/aircraft/controls/acf_trq_max_prp"                          // float, writable
+	SimAircraftControls_acf_rudd_crat                            string = "sim/aircraft/controls/acf_rudd_crat"                            // float, writable
+	SimAircraftControls_acf_rudd_lr                              string = "sim/aircraft/controls/acf_rudd_lr"                              // float, writable
+	SimAircraftControls_acf_rudd_rr                              string = "sim/aircraft/controls/acf_rudd_rr"                              // float, writable
+	SimAircraftControls_acf_rud2_crat                            string = "sim/aircraft/controls/acf_rud2_crat"                            // float, writable
+	SimAircraftControls_acf_rud2_lr                              string = "sim/aircraft/controls/acf_rud2_lr"                              // float, writable
+	SimAircraftControls_acf_rud2_rr                              string = "sim/aircraft/controls/acf_rud2_rr"                              // float, writable
+	SimAircraftControls_acf_splr_crat                            string = "sim/aircraft/controls/acf_splr_crat"                            // float, writable
+	SimAircraftControls_acf_splr_up                              string = "sim/aircraft/controls/acf_splr_up"                              // float, writable
+	SimAircraftControls_acf_sbrk_crat                            string = "sim/aircraft/controls/acf_sbrk_crat"                            // float, writable
+	SimAircraftControls_acf_sbrk2_crat                           string = "sim/aircraft/controls/acf_sbrk2_crat"                           // float, writable
+	SimAircraftControls_acf_sbrk_up                              string = "sim/aircraft/controls/acf_sbrk_up"                              // float, writable
+	SimAircraftControls_acf_sbrk2_up                             string = "sim/aircraft/controls/acf_sbrk2_up"                             // float, writable
+	SimAircraftControls_acf_flap_crat                            string = "sim/aircraft/controls/acf_flap_crat"                            // float, writable
+	SimAircraftControls_acf_flap2_crat                           string = "sim/aircraft/controls/acf_flap2_crat"                           // float, writable
+	SimAircraftControls_acf_flap_dn                              string = "sim/aircraft/controls/acf_flap_dn"                              // float_array, writable
+	SimAircraftControls_acf_flap2_dn                             string = "sim/aircraft/controls/acf_flap2_dn"                             // float_array, writable
+	SimAircraftControls_acf_flap_dial_a_flap_notch               string = "sim/aircraft/controls/acf_flap_dial_a_flap_notch"               // int, writable
+	SimAircraftControls_acf_hstb_trim_up                         string = "sim/aircraft/controls/acf_hstb_trim_up"                         // float, writable
+	SimAircraftControls_acf_hstb_trim_dn                         string = "sim/aircraft/controls/acf_hstb_trim_dn"                         // float, writable
+	SimAircraftControls_acf_flap_type                            string = "sim/aircraft/controls/acf_flap_type"                            // float, writable
+	SimAircraftControls_acf_flap2_type                           string = "sim/aircraft/controls/acf_flap2_type"                           // float, writable
+	SimAircraftControls_acf_flap_cl                              string = "sim/aircraft/controls/acf_flap_cl"                              // float, writable
+	SimAircraftControls_acf_flap_cd                              string = "sim/aircraft/controls/acf_flap_cd"                              // float, writable
+	SimAircraftControls_acf_flap_cm                              string = "sim/aircraft/controls/acf_flap_cm"                              // float, writable
+	SimAircraftControls_acf_flap2_cl                             string = "sim/aircraft/controls/acf_flap2_cl"                             // float, writable
+	SimAircraftControls_acf_flap2_cd                             string = "sim/aircraft/controls/acf_flap2_cd"                             // float, writable
+	SimAircraftControls_acf_flap2_cm                             string = "sim/aircraft/controls/acf_flap2_cm"                             // float, writable
+	SimAircraftControls_acf_slat_cd                              string = "sim/aircraft/controls/acf_slat_cd"                              // float, writable
+	SimAircraftControls_acf_slat2_cd                             string = "sim/aircraft/controls/acf_slat2_cd"                             // float, writable
+	SimAircraftControls_acf_flap_detents                         string = "sim/aircraft/controls/acf_flap_detents"                         // int, writable
+	SimAircraftControls_acf_flap_deftime                         string = "sim/aircraft/controls/acf_flap_deftime"                         // float, writable
+	SimAircraftControls_acf_slat_inc                             string = "sim/aircraft/controls/acf_slat_inc"                             // float, writable
+	SimAircraftControls_acf_blown_flap_min_engag                 string = "sim/aircraft/controls/acf_blown_flap_min_engag"                 // float, writable
+	SimAircraftControls_acf_takeoff_trim                         string = "sim/aircraft/controls/acf_takeoff_trim"                         // float, writable
+	SimAircraftControls_acf_min_trim_elev                        string = "sim/aircraft/controls/acf_min_trim_elev"                        // float, writable
+	SimAircraftControls_acf_max_trim_elev                        string = "sim/aircraft/controls/acf_max_trim_elev"                        // float, writable
+	SimAircraftControls_acf_elev_trim_speedrat                   string = "sim/aircraft/controls/acf_elev_trim_speedrat"                   // float, writable
+	SimAircraftControls_acf_elev_tab                             string = "sim/aircraft/controls/acf_elev_tab"                             // float, writable
+	SimAircraftControls_acf_min_trim_ailn                        string = "sim/aircraft/controls/acf_min_trim_ailn"                        // float, writable
+	SimAircraftControls_acf_max_trim_ailn                        string = "sim/aircraft/controls/acf_max_trim_ailn"                        // float, writable
+	SimAircraftControls_acf_ailn_trim_speedrat                   string = "sim/aircraft/controls/acf_ailn_trim_speedrat"                   // float, writable
+	SimAircraftControls_acf_ailn_tab                             string = "sim/aircraft/controls/acf_ailn_tab"                             // float, writable
+	SimAircraftControls_acf_min_trim_rudd                        string = "sim/aircraft/controls/acf_min_trim_rudd"                        // float, writable
+	SimAircraftControls_acf_max_trim_rudd                        string = "sim/aircraft/controls/acf_max_trim_rudd"                        // float, writable
+	SimAircraftControls_acf_rudd_trim_speedrat                   string = "sim/aircraft/controls/acf_rudd_trim_speedrat"                   // float, writable
+	SimAircraftControls_acf_rudd_tab                             string = "sim/aircraft/controls/acf_rudd_tab"                             // float, writable
+	SimAircraftControls_acf_elev_def_time                        string = "sim/aircraft/controls/acf_elev_def_time"                        // float, writable
+	SimAircraftControls_acf_ailn_def_time                        string = "sim/aircraft/controls/acf_ailn_def_time"                        // float, writable
+	SimAircraftControls_acf_rudd_def_time                        string = "sim/aircraft/controls/acf_rudd_def_time"                        // float, writable
+	SimAircraftControls_acf_elev_trim_time                       string = "sim/aircraft/controls/acf_elev_trim_time"                       // float, writable
+	SimAircraftControls_acf_ailn_trim_time                       string = "sim/aircraft/controls/acf_ailn_trim_time"                       // float, writable
+	SimAircraftControls_acf_rudd_trim_time                       string = "sim/aircraft/controls/acf_rudd_trim_time"                       // float, writable
+	SimAircraftControls_acf_speedbrake_ext_time                  string = "sim/aircraft/controls/acf_speedbrake_ext_time"                  // float, writable
+	SimAircraftControls_acf_speedbrake_ret_time                  string = "sim/aircraft/controls/acf_speedbrake_ret_time"                  // float, writable
+	SimAircraftControls_acf_hyd_PTU_type                         string = "sim/aircraft/controls/acf_hyd_PTU_type"                         // int, writable
+	SimAircraftGear_acf_gear_retract                             string = "sim/aircraft/gear/acf_gear_retract"                             // int, writable
+	SimAircraftGear_acf_gear_is_skid                             string = "sim/aircraft/gear/acf_gear_is_skid"                             // int, writable
+	SimAircraftGear_acf_nw_steerdeg1                             string = "sim/aircraft/gear/acf_nw_steerdeg1"                             // float, writable
+	SimAircraftGear_acf_nw_steerdeg2                             string = "sim/aircraft/gear/acf_nw_steerdeg2"                             // float, writable
+	SimAircraftGear_acf_water_rud_longarm                        string = "sim/aircraft/gear/acf_water_rud_longarm"                        // float, writable
+	SimAircraftGear_acf_water_rud_area                           string = "sim/aircraft/gear/acf_water_rud_area"                           // float, writable
+	SimAircraftGear_acf_water_rud_maxdef                         string = "sim/aircraft/gear/acf_water_rud_maxdef"                         // float, writable
+	SimAircraftGear_acf_h_eqlbm                                  string = "sim/aircraft/gear/acf_h_eqlbm"                                  // float, writable
+	SimAircraftGear_acf_the_eqlbm                                string = "sim/aircraft/gear/acf_the_eqlbm"                                // float, writable
+	SimAircraftGear_acf_has_abs                                  string = "sim/aircraft/gear/acf_has_abs"                                  // int, writable
+	SimAircraftGear_acf_park_brake_trap                          string = "sim/aircraft/gear/acf_park_brake_trap"                          // int, writable
+	SimAircraftGear_acf_park_brake_toe                           string = "sim/aircraft/gear/acf_park_brake_toe"                           // int, writable
+	SimAircraftWeight_acf_cgY_original                           string = "sim/aircraft/weight/acf_cgY_original"                           // float
+	SimAircraftWeight_acf_cgZ_original                           string = "sim/aircraft/weight/acf_cgZ_original"                           // float
+	SimAircraftWeight_acf_Jxx_unitmass                           string = "sim/aircraft/weight/acf_Jxx_unitmass"                           // float, writable
+	SimAircraftWeight_acf_Jyy_unitmass                           string = "sim/aircraft/weight/acf_Jyy_unitmass"                           // float, writable
+	SimAircraftWeight_acf_Jzz_unitmass                           string = "sim/aircraft/weight/acf_Jzz_unitmass"                           // float, writable
+	SimAircraftWeight_acf_m_empty                                string = "sim/aircraft/weight/acf_m_empty"                                // float, writable
+	SimAircraftWeight_acf_m_displaced                            string = "sim/aircraft/weight/acf_m_displaced"                            // float, writable
+	SimAircraftWeight_acf_m_max                                  string = "sim/aircraft/weight/acf_m_max"                                  // float, writable
+	SimAircraftWeight_acf_stations_ref_x                         string = "sim/aircraft/weight/acf_stations_ref_x"                         // float_array
+	SimAircraftWeight_acf_stations_ref_y                         string = "sim/aircraft/weight/acf_stations_ref_y"                         // float_array
+	SimAircraftWeight_acf_stations_ref_z                         string = "sim/aircraft/weight/acf_stations_ref_z"                         // float_array
+	SimAircraftWeight_acf_m_station_max                          string = "sim/aircraft/weight/acf_m_station_max"                          // float_array
+	SimAircraftWeight_acf_m_fuel_tot                             string = "sim/aircraft/weight/acf_m_fuel_tot"                             // float, writable
+	SimAircraftWeight_acf_m_jettison                             string = "sim/aircraft/weight/acf_m_jettison"                             // float, writable
+	SimAircraftWeight_acf_m_displaced_y                          string = "sim/aircraft/weight/acf_m_displaced_y"                          // float, writable
+	SimAircraftSpecialcontrols_acf_jato_theta                    string = "sim/aircraft/specialcontrols/acf_jato_theta"                    // float, writable
+	SimAircraftSpecialcontrols_acf_jato_thrust                   string = "sim/aircraft/specialcontrols/acf_jato_thrust"                   // float, writable
+	SimAircraftSpecialcontrols_acf_jato_dur                      string = "sim/aircraft/specialcontrols/acf_jato_dur"                      // float, writable
+	SimAircraftSpecialcontrols_acf_jato_sfc                      string = "sim/aircraft/specialcontrols/acf_jato_sfc"                      // float, writable
+	SimAircraftSpecialcontrols_acf_jato_Y                        string = "sim/aircraft/specialcontrols/acf_jato_Y"                        // float, writable
+	SimAircraftSpecialcontrols_acf_jato_Z                        string = "sim/aircraft/specialcontrols/acf_jato_Z"                        // float, writable
+	SimAircraftSpecialcontrols_acf_chute_area                    string = "sim/aircraft/specialcontrols/acf_chute_area"                    // float, writable
+	SimAircraftSpecialcontrols_acf_chute_Y                       string = "sim/aircraft/specialcontrols/acf_chute_Y"                       // float, writable
+	SimAircraftSpecialcontrols_acf_chute_Z                       string = "sim/aircraft/specialcontrols/acf_chute_Z"                       // float, writable
+	SimAircraftSpecialcontrols_acf_ail1pitch                     string = "sim/aircraft/specialcontrols/acf_ail1pitch"                     // float, writable
+	SimAircraftSpecialcontrols_acf_ail1flaps                     string = "sim/aircraft/specialcontrols/acf_ail1flaps"                     // float, writable
+	SimAircraftSpecialcontrols_acf_ail2pitch                     string = "sim/aircraft/specialcontrols/acf_ail2pitch"                     // float, writable
+	SimAircraftSpecialcontrols_acf_ail2flaps                     string = "sim/aircraft/specialcontrols/acf_ail2flaps"                     // float, writable
+	SimAircraftSpecialcontrols_acf_stabroll                      string = "sim/aircraft/specialcontrols/acf_stabroll"                      // float, writable
+	SimAircraftSpecialcontrols_acf_stabhdng                      string = "sim/aircraft/specialcontrols/acf_stabhdng"                      // float, writable
+	SimAircraftSpecialcontrols_acf_tvec_ptch                     string = "sim/aircraft/specialcontrols/acf_tvec_ptch"                     // float, writable
+	SimAircraftSpecialcontrols_acf_tvec_roll                     string = "sim/aircraft/specialcontrols/acf_tvec_roll"                     // float, writable
+	SimAircraftSpecialcontrols_acf_tvec_hdng                     string = "sim/aircraft/specialcontrols/acf_tvec_hdng"                     // float, writable
+	SimAircraftSpecialcontrols_acf_diff_thro_with_hdng           string = "sim/aircraft/specialcontrols/acf_diff_thro_with_hdng"           // float, writable
+	SimAircraftSpecialcontrols_acf_tks_cap_liter                 string = "sim/aircraft/specialcontrols/acf_tks_cap_liter"                 // float, writable
+	SimAircraftSpecialcontrols_acf_winshield_deice_effectiveness string = "sim/aircraft/specialcontrols/acf_winshield_deice_effectiveness" // float, writable
+	SimAircraftSpecialcontrols_acf_warn1EQ                       string = "sim/aircraft/specialcontrols/acf_warn1EQ"                       // int, writable
+	SimAircraftSpecialcontrols_acf_gearhornEQ                    string = "sim/aircraft/specialcontrols/acf_gearhornEQ"                    // int, writable
+	SimAircraftSpecialcontrols_acf_autosbrkEQ                    string = "sim/aircraft/specialcontrols/acf_autosbrkEQ"                    // int, writable
+	SimAircraftSpecialcontrols_acf_autofbrkEQ                    string = "sim/aircraft/specialcontrols/acf_autofbrkEQ"                    // int, writable
+	SimAircraftSpecialcontrols_acf_autosweepEQ                   string = "sim/aircraft/specialcontrols/acf_autosweepEQ"                   // int, writable
+	SimAircraftSpecialcontrols_acf_autoslatEQ                    string = "sim/aircraft/specialcontrols/acf_autoslatEQ"                    // int, writable
+	SimAircraftSpecialcontrols_acf_autofbrk_decels               string = "sim/aircraft/specialcontrols/acf_autofbrk_decels"               // float_array, writable
+	SimAircraftVtolcontrols_acf_vectEQ                           string = "sim/aircraft/vtolcontrols/acf_vectEQ"                           // int, writable
+	SimAircraftVtolcontrols_acf_vectarmZ                         string = "sim/aircraft/vtolcontrols/acf_vectarmZ"                         // float, writable
+	SimAircraftVtolcontrols_acf_cyclic_elev                      string = "sim/aircraft/vtolcontrols/acf_cyclic_elev"                      // float, writable
+	SimAircraftVtolcontrols_acf_cyclic_ailn                      string = "sim/aircraft/vtolcontrols/acf_cyclic_ailn"                      // float, writable
+	SimAircraftVtolcontrols_acf_delta3                           string = "sim/aircraft/vtolcontrols/acf_delta3"                           // float, writable
+	SimAircraftVtolcontrols_acf_puffL                            string = "sim/aircraft/vtolcontrols/acf_puffL"                            // float, writable
+	SimAircraftVtolcontrols_acf_puffM                            string = "sim/aircraft/vtolcontrols/acf_puffM"                            // float, writable
+	SimAircraftVtolcontrols_acf_puffN                            string = "sim/aircraft/vtolcontrols/acf_puffN"                            // float, writable
+	SimAircraftVtolcontrols_acf_tail_with_coll                   string = "sim/aircraft/vtolcontrols/acf_tail_with_coll"                   // float, writable
+	SimAircraftVtolcontrols_acf_diff_coll_with_roll              string = "sim/aircraft/vtolcontrols/acf_diff_coll_with_roll"              // float, writable
+	SimAircraftVtolcontrols_acf_diff_coll_with_hdng              string = "sim/aircraft/vtolcontrols/acf_diff_coll_with_hdng"              // float, writable
+	SimAircraftVtolcontrols_acf_diff_cycl_with_hdng_lon          string = "sim/aircraft/vtolcontrols/acf_diff_cycl_with_hdng_lon"          // float, writable
+	SimAircraftVtolcontrols_acf_auto_rpm_with_tvec               string = "sim/aircraft/vtolcontrols/acf_auto_rpm_with_tvec"               // int, writable
+	SimAircraftVtolcontrols_acf_cyclic_elev_fwd                  string = "sim/aircraft/vtolcontrols/acf_cyclic_elev_fwd"                  // float, writable
+	SimAircraftVtolcontrols_acf_cyclic_elev_aft                  string = "sim/aircraft/vtolcontrols/acf_cyclic_elev_aft"                  // float, writable
+	SimAircraftVtolcontrols_acf_cyclic_ailn_lft                  string = "sim/aircraft/vtolcontrols/acf_cyclic_ailn_lft"                  // float, writable
+	SimAircraftVtolcontrols_acf_cyclic_ailn_rgt                  string = "sim/aircraft/vtolcontrols/acf_cyclic_ailn_rgt"                  // float, writable
+	SimAircraftArtstability_acf_AShiV                            string = "sim/aircraft/artstability/acf_AShiV"                            // float, writable
+	SimAircraftArtstability_acf_ASloV                            string = "sim/aircraft/artstability/acf_ASloV"                            // float, writable
+	SimAircraftArtstability_acf_ASmaxp_lo                        string = "sim/aircraft/artstability/acf_ASmaxp_lo"                        // float, writable
+	SimAircraftArtstability_acf_ASp_lo_rate                      string = "sim/aircraft/artstability/acf_ASp_lo_rate"                      // float, writable
+	SimAircraftArtstability_acf_ASmaxp_hi                        string = "sim/aircraft/artstability/acf_ASmaxp_hi"                        // float, writable
+	SimAircraftArtstability_acf_ASp_hi_pos                       string = "sim/aircraft/artstability/acf_ASp_hi_pos"                       // float, writable
+	SimAircraftArtstability_acf_ASmaxh_lo                        string = "sim/aircraft/artstability/acf_ASmaxh_lo"                        // float, writable
+	SimAircraftArtstability_acf_ASh_lo_rate                      string = "sim/aircraft/artstability/acf_ASh_lo_rate"                      // float, writable
+	SimAircraftArtstability_acf_ASmaxh_hi                        string = "sim/aircraft/artstability/acf_ASmaxh_hi"                        // float, writable
+	SimAircraftArtstability_acf_ASh_hi_pos                       string = "sim/aircraft/artstability/acf_ASh_hi_pos"                       // float, writable
+	SimAircraftArtstability_acf_ASmaxr_lo                        string = "sim/aircraft/artstability/acf_ASmaxr_lo"                        // float, writable
+	SimAircraftArtstability_acf_ASr_lo_rate                      string = "sim/aircraft/artstability/acf_ASr_lo_rate"                      // float, writable
+	SimAircraftArtstability_acf_ASmaxr_hi                        string = "sim/aircraft/artstability/acf_ASmaxr_hi"                        // float, writable
+	SimAircraftArtstability_acf_ASr_hi_rate                      string = "sim/aircraft/artstability/acf_ASr_hi_rate"                      // float, writable
+	SimAircraftArtstability_acf_has_clutch                       string = "sim/aircraft/artstability/acf_has_clutch"                       // int, writable
+	SimAircraftOverflow_acf_stab_delinc_to_Vne                   string = "sim/aircraft/overflow/acf_stab_delinc_to_Vne"                   // float, writable
+	SimAircraftOverflow_acf_Vmca                                 string = "sim/aircraft/overflow/acf_Vmca"                                 // float, writable
+	SimAircraftOverflow_acf_Vyse                                 string = "sim/aircraft/overflow/acf_Vyse"                                 // float, writable
+	SimAircraftOverflow_acf_flap_arm                             string = "sim/aircraft/overflow/acf_flap_arm"                             // float, writable
+	SimAircraftOverflow_acf_cgZ_fwd                              string = "sim/aircraft/overflow/acf_cgZ_fwd"                              // float, writable
+	SimAircraftOverflow_acf_cgZ_aft                              string = "sim/aircraft/overflow/acf_cgZ_aft"                              // float, writable
+	SimAircraftOverflow_acf_cgX_lft                              string = "sim/aircraft/overflow/acf_cgX_lft"                              // float, writable
+	SimAircraftOverflow_acf_cgX_rgt                              string = "sim/aircraft/overflow/acf_cgX_rgt"                              // float, writable
+	SimAircraftOverflow_acf_gear_cyc_time                        string = "sim/aircraft/overflow/acf_gear_cyc_time"                        // float_array, writable
+	SimAircraftOverflow_acf_refuel_X                             string = "sim/aircraft/overflow/acf_refuel_X"                             // float, writable
+	SimAircraftOverflow_acf_refuel_Y                             string = "sim/aircraft/overflow/acf_refuel_Y"                             // float, writable
+	SimAircraftOverflow_acf_refuel_Z                             string = "sim/aircraft/overflow/acf_refuel_Z"                             // float, writable
+	SimAircraftOverflow_acf_gear_steers                          string = "sim/aircraft/overflow/acf_gear_steers"                          // int_array
+	SimAircraftOverflow_acf_dihed2                               string = "sim/aircraft/overflow/acf_dihed2"                               // float_array, writable
+	SimAircraftOverflow_jett_X                                   string = "sim/aircraft/overflow/jett_X"                                   // float, writable
+	SimAircraftOverflow_jett_Y                                   string = "sim/aircraft/overflow/jett_Y"                                   // float, writable
+	SimAircraftOverflow_jett_Z                                   string = "sim/aircraft/overflow/jett_Z"                                   // float, writable
+	SimAircraftOverflow_acf_puffX                                string = "sim/aircraft/overflow/acf_puffX"                                // float, writable
+	SimAircraftOverflow_acf_puffY                                string = "sim/aircraft/overflow/acf_puffY"                                // float, writable
+	SimAircraftOverflow_acf_puffZ                                string = "sim/aircraft/overflow/acf_puffZ"                                // float, writable
+	SimAircraftOverflow_acf_Vle                                  string = "sim/aircraft/overflow/acf_Vle"                                  // float, writable
+	SimAircraftOverflow_acf_elevflaps                            string = "sim/aircraft/overflow/acf_elevflaps"                            // float, writable
+	SimAircraftOverflow_acf_tank_X                               string = "sim/aircraft/overflow/acf_tank_X"                               // float_array, writable
+	SimAircraftOverflow_acf_tank_Y                               string = "sim/aircraft/overflow/acf_tank_Y"                               // float_array, writable
+	SimAircraftOverflow_acf_tank_Z                               string = "sim/aircraft/overflow/acf_tank_Z"                               // float_array, writable
+	SimAircraftOverflow_acf_tank_X_full                          string = "sim/aircraft/overflow/acf_tank_X_full"                          // float_array, writable
+	SimAircraftOverflow_acf_tank_Y_full                          string = "sim/aircraft/overflow/acf_tank_Y_full"                          // float_array, writable
+	SimAircraftOverflow_acf_tank_Z_full                          string = "sim/aircraft/overflow/acf_tank_Z_full"                          // float_array, writable
+	SimAircraftOverflow_acf_tank_rat                             string = "sim/aircraft/overflow/acf_tank_rat"                             // float_array, writable
+	SimAircraftOverflow_acf_stall_warn_alpha                     string = "sim/aircraft/overflow/acf_stall_warn_alpha"                     // float, writable
+	SimAircraftOverflow_acf_mass_shift                           string = "sim/aircraft/overflow/acf_mass_shift"                           // float, writable
+	SimAircraftOverflow_acf_mass_shift_dx                        string = "sim/aircraft/overflow/acf_mass_shift_dx"                        // float, writable
+	SimAircraftOverflow_acf_mass_shift_dz                        string = "sim/aircraft/overflow/acf_mass_shift_dz"                        // float, writable
+	SimAircraftOverflow_acf_feathered_pitch                      string = "sim/aircraft/overflow/acf_feathered_pitch"                      // float, writable
+	SimAircraftOverflow_acf_wing_tilt_ptch                       string = "sim/aircraft/overflow/acf_wing_tilt_ptch"                       // float, writable
+	SimAircraftOverflow_acf_wing_tilt_roll                       string = "sim/aircraft/overflow/acf_wing_tilt_roll"                       // float, writable
+	SimAircraftOverflow_acf_max_press_diff                       string = "sim/aircraft/overflow/acf_max_press_diff"                       // float, writable
+	SimAircraftOverflow_acf_o2_bottle_cap_liters                 string = "sim/aircraft/overflow/acf_o2_bottle_cap_liters"                 // float, writable
+	SimAircraftOverflow_acf_diff_coll_with_ptch                  string = "sim/aircraft/overflow/acf_diff_coll_with_ptch"                  // float, writable
+	SimAircraftOverflow_acf_flap_roll                            string = "sim/aircraft/overflow/acf_flap_roll"                            // float, writable
+	SimAircraftOverflow_acf_flap_ptch                            string = "sim/aircraft/overflow/acf_flap_ptch"                            // float, writable
+	SimAircraftOverflow_acf_diff_cycl_with_hdng_lat              string = "sim/aircraft/overflow/acf_diff_cycl_with_hdng_lat"              // float, writable
+	SimAircraftOverflow_acf_phase_tvect_out_at_90                string = "sim/aircraft/overflow/acf_phase_tvect_out_at_90"                // int, writable
+	SimAircraftOverflow_acf_roll_co                              string = "sim/aircraft/overflow/acf_roll_co"                              // float, writable
+	SimAircraftOverflow_acf_brake_co                             string = "sim/aircraft/overflow/acf_brake_co"                             // float, writable
+	SimAircraftOverflow_acf_drive_by_wire                        string = "sim/aircraft/overflow/acf_drive_by_wire"                        // int, writable
+	SimAircraftOverflow_acf_is_glossy                            string = "sim/aircraft/overflow/acf_is_glossy"                            // int, writable
+	SimAircraftOverflow_acf_num_tanks                            string = "sim/aircraft/overflow/acf_num_tanks"                            // int
+	SimAircraftOverflow_acf_has_refuel                           string = "sim/aircraft/overflow/acf_has_refuel"                           // int, writable
+	SimAircraftOverflow_acf_jett_is_slung                        string = "sim/aircraft/overflow/acf_jett_is_slung"                        // int, writable
+	SimAircraftOverflow_acf_eng_mass                             string = "sim/aircraft/overflow/acf_eng_mass"                             // float_array, writable
+	SimAircraftOverflow_acf_phase_tvect_out_at_00                string = "sim/aircraft/overflow/acf_phase_tvect_out_at_00"                // int, writable
+	SimAircraftOverflow_acf_auto_trimEQ                          string = "sim/aircraft/overflow/acf_auto_trimEQ"                          // int, writable
+	SimAircraftOverflow_acf_has_DC_fd                            string = "sim/aircraft/overflow/acf_has_DC_fd"                            // int, writable
+	SimAircraftOverflow_acf_flaps_with_gearEQ                    string = "sim/aircraft/overflow/acf_flaps_with_gearEQ"                    // int, writable
+	SimAircraftOverflow_acf_rev_on_touchdown                     string = "sim/aircraft/overflow/acf_rev_on_touchdown"                     // int, writable
+	SimAircraftOverflow_acf_flaps_with_vecEQ                     string = "sim/aircraft/overflow/acf_flaps_with_vecEQ"                     // int, writable
+	SimAircraftOverflow_acf_warn2EQ                              string = "sim/aircraft/overflow/acf_warn2EQ"                              // int, writable
+	SimAircraftOverflow_acf_num_thrustpoints                     string = "sim/aircraft/overflow/acf_num_thrustpoints"                     // int
+	SimAircraftOverflow_acf_cus_rnd_use                          string = "sim/aircraft/overflow/acf_cus_rnd_use"                          // int_array, writable
+	SimAircraftOverflow_acf_cus_rnd_lo_val                       string = "sim/aircraft/overflow/acf_cus_rnd_lo_val"                       // float_array, writable
+	SimAircraftOverflow_acf_cus_rnd_hi_val                       string = "sim/aircraft/overflow/acf_cus_rnd_hi_val"                       // float_array, writable
+	SimAircraftOverflow_acf_cus_rnd_lo_ang                       string = "sim/aircraft/overflow/acf_cus_rnd_lo_ang"                       // float_array, writable
+	SimAircraftOverflow_acf_cus_rnd_hi_ang                       string = "sim/aircraft/overflow/acf_cus_rnd_hi_ang"                       // float_array, writable
+	SimAircraftOverflow_acf_has_beta                             string = "sim/aircraft/overflow/acf_has_beta"                             // int, writable
+	SimAircraftOverflow_acf_cus_rnd_mirror                       string = "sim/aircraft/overflow/acf_cus_rnd_mirror"                       // int_array, writable
+	SimAircraftOverflow_acf_cus_rnd_label                        string = "sim/aircraft/overflow/acf_cus_rnd_label"                        // int_array, writable
+	SimAircraftOverflow_acf_cus_dig_use                          string = "sim/aircraft/overflow/acf_cus_dig_use"                          // int_array, writable
+	SimAircraftOverflow_acf_cus_dig_offset                       string = "sim/aircraft/overflow/acf_cus_dig_offset"                       // float_array, writable
+	SimAircraftOverflow_acf_cus_dig_scale                        string = "sim/aircraft/overflow/acf_cus_dig_scale"                        // float_array, writable
+	SimAircraftOverflow_acf_cus_dig_dig                          string = "sim/aircraft/overflow/acf_cus_dig_dig"                          // int_array, writable
+	SimAircraftOverflow_acf_cus_dig_dec                          string = "sim/aircraft/overflow/acf_cus_dig_dec"                          // int_array, writable
+	SimAircraftOverflow_acf_inc_ail                              string = "sim/aircraft/overflow/acf_inc_ail"                              // int_array, writable
+	SimAircraftOverflow_acf_inc_ail2                             string = "sim/aircraft/overflow/acf_inc_ail2"                             // int_array, writable
+	SimAircraftOverflow_acf_inc_vec                              string = "sim/aircraft/overflow/acf_inc_vec"                              // int_array, writable
+	SimAircraftOverflow_acf_tow_hook_Y                           string = "sim/aircraft/overflow/acf_tow_hook_Y"                           // float, writable
+	SimAircraftOverflow_acf_tow_hook_Z                           string = "sim/aircraft/overflow/acf_tow_hook_Z"                           // float, writable
+	SimAircraftOverflow_acf_win_hook_Y                           string = "sim/aircraft/overflow/acf_win_hook_Y"                           // float, writable
+	SimAircraftOverflow_acf_win_hook_Z                           string = "sim/aircraft/overflow/acf_win_hook_Z"                           // float, writable
+	SimAircraftOverflow_acf_vectarmY                             string = "sim/aircraft/overflow/acf_vectarmY"                             // float, writable
+	SimAircraftOverflow_acf_hide_prop_at_90_vect                 string = "sim/aircraft/overflow/acf_hide_prop_at_90_vect"                 // int, writable
+	SimAircraftOverflow_acf_has_fuel_all                         string = "sim/aircraft/overflow/acf_has_fuel_all"                         // int, writable
+	SimAircraftOverflow_acf_has_fuel_any                         string = "sim/aircraft/overflow/acf_has_fuel_any"                         // int, writable
+	SimAircraftOverflow_has_hsi                                  string = "sim/aircraft/overflow/has_hsi"                                  // int, writable
+	SimAircraftOverflow_has_yawdamp_but                          string = "sim/aircraft/overflow/has_yawdamp_but"                          // int, writable
+	SimAircraftOverflow_has_transonic_audio                      string = "sim/aircraft/overflow/has_transonic_audio"                      // int, writable
+	SimAircraftOverflow_has_pre_rotate                           string = "sim/aircraft/overflow/has_pre_rotate"                           // int, writable
+	SimAircraftOverflow_SFC_alt_lo_PRP                           string = "sim/aircraft/overflow/SFC_alt_lo_PRP"                           // float, writable
+	SimAircraftOverflow_SFC_best_eco_lo_recip                    string = "sim/aircraft/overflow/SFC_best_eco_lo_recip"                    // float, writable
+	SimAircraftOverflow_SFC_best_pwr_lo_recip                    string = "sim/aircraft/overflow/SFC_best_pwr_lo_recip"                    // float, writable
+	SimAircraftOverflow_SFC_alt_hi_PRP                           string = "sim/aircraft/overflow/SFC_alt_hi_PRP"                           // float, writable
+	SimAircraftOverflow_SFC_best_eco_hi_recip                    string = "sim/aircraft/overflow/SFC_best_eco_hi_recip"                    // float, writable
+	SimAircraftOverflow_SFC_best_pwr_hi_recip                    string = "sim/aircraft/overflow/SFC_best_pwr_hi_recip"                    // float, writable
+	SimAircraftOverflow_ff_rat_idle_PRP                          string = "sim/aircraft/overflow/ff_rat_idle_PRP"                          // float, writable
+	SimAircraftOverflow_hi_alt_for_SFC_turbo                     string = "sim/aircraft/overflow/hi_alt_for_SFC_turbo"                     // float, writable
+	SimAircraftOverflow_lo_alt_for_SFC_turbo                     string = "sim/aircraft/overflow/lo_alt_for_SFC_turbo"                     // float, writable
+	SimAircraftOverflow_SFC_Ng_95_hi_turbo                       string = "sim/aircraft/overflow/SFC_Ng_95_hi_turbo"                       // float, writable
+	SimAircraftOverflow_SFC_Ng_95_lo_turbo                       string = "sim/aircraft/overflow/SFC_Ng_95_lo_turbo"                       // float, writable
+	SimAircraftOverflow_SFC_Ng_80_hi_turbo                       string = "sim/aircraft/overflow/SFC_Ng_80_hi_turbo"                       // float, writable
+	SimAircraftOverflow_SFC_Ng_80_lo_turbo                       string = "sim/aircraft/overflow/SFC_Ng_80_lo_turbo"                       // float, writable
+	SimAircraftOverflow_ff_rat_idle_turbo                        string = "sim/aircraft/overflow/ff_rat_idle_turbo"                        // float, writable
+	SimAircraftOverflow_jet_N1_locrz                             string = "sim/aircraft/overflow/jet_N1_locrz"                             // float, writable
+	SimAircraftOverflow_jet_Mach_locrz                           string = "sim/aircraft/overflow/jet_Mach_locrz"                           // float, writable
+	SimAircraftOverflow_jet_SFC_locrz                            string = "sim/aircraft/overflow/jet_SFC_locrz"                            // float, writable
+	SimAircraftOverflow_jet_N1_hicrz                             string = "sim/aircraft/overflow/jet_N1_hicrz"                             // float, writable
+	SimAircraftOverflow_jet_Mach_hicrz                           string = "sim/aircraft/overflow/jet_Mach_hicrz"                           // float, writable
+	SimAircraftOverflow_jet_SFC_hicrz                            string = "sim/aircraft/overflow/jet_SFC_hicrz"                            // float, writable
+	SimAircraftOverflow_jet_N1_climb                             string = "sim/aircraft/overflow/jet_N1_climb"                             // float, writable
+	SimAircraftOverflow_jet_Mach_climb                           string = "sim/aircraft/overflow/jet_Mach_climb"                           // float, writable
+	SimAircraftOverflow_jet_SFC_climb                            string = "sim/aircraft/overflow/jet_SFC_climb"                            // float, writable
+	SimAircraftOverflow_jet_N1_takeoff                           string = "sim/aircraft/overflow/jet_N1_takeoff"                           // float, writable
+	SimAircraftOverflow_jet_Mach_takeoff                         string = "sim/aircraft/overflow/jet_Mach_takeoff"                         // float, writable
+	SimAircraftOverflow_jet_SFC_takeoff                          string = "sim/aircraft/overflow/jet_SFC_takeoff"                          // float, writable
+	SimAircraftOverflow_ff_rat_idle_JET                          string = "sim/aircraft/overflow/ff_rat_idle_JET"                          // float, writable
+	SimAircraftOverflow_pushback_attached                        string = "sim/aircraft/overflow/pushback_attached"                        // int
+	SimAircraftSystems_fdir_needed_to_engage_servos              string = "sim/aircraft/systems/fdir_needed_to_engage_servos"              // int, writable
+)
@@ -0,0 +1,33 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefaircraft2 provides the known dataref names under sim/aircraft2/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefaircraft2
+
+const (
+	SimAircraft2Metadata_is_ultralight          string = "sim/aircraft2/metadata/is_ultralight"          // int
+	SimAircraft2Metadata_is_experimental        string = "sim/aircraft2/metadata/is_experimental"        // int
+	SimAircraft2Metadata_is_general_aviation    string = "sim/aircraft2/metadata/is_general_aviation"    // int
+	SimAircraft2Metadata_is_airliner            string = "sim/aircraft2/metadata/is_airliner"            // int
+	SimAircraft2Metadata_is_military            string = "sim/aircraft2/metadata/is_military"            // int
+	SimAircraft2Metadata_is_cargo               string = "sim/aircraft2/metadata/is_cargo"               // int
+	SimAircraft2Metadata_is_glider              string = "sim/aircraft2/metadata/is_glider"              // int
+	SimAircraft2Metadata_is_seaplane            string = "sim/aircraft2/metadata/is_seaplane"            // int
+	SimAircraft2Metadata_is_helicopter          string = "sim/aircraft2/metadata/is_helicopter"          // int
+	SimAircraft2Metadata_is_vtol                string = "sim/aircraft2/metadata/is_vtol"                // int
+	SimAircraft2Metadata_is_sci_fi              string = "sim/aircraft2/metadata/is_sci_fi"              // int
+	SimAircraft2Body_kill_body                  string = "sim/aircraft2/body/kill_body"                  // int_array, writable
+	SimAircraft2Engine_low_idle_ratio           string = "sim/aircraft2/engine/low_idle_ratio"           // float, writable
+	SimAircraft2Engine_high_idle_ratio          string = "sim/aircraft2/engine/high_idle_ratio"          // float, writable
+	SimAircraft2Engine_engine_friction_ratio    string = "sim/aircraft2/engine/engine_friction_ratio"    // float, writable
+	SimAircraft2Engine_max_power_limited_watts  string = "sim/aircraft2/engine/max_power_limited_watts"  // float, writable
+	SimAircraft2Engine_flap_extension_time_sec  string = "sim/aircraft2/engine/flap_extension_time_sec"  // float, writable
+	SimAircraft2Engine_flap_retraction_time_sec string = "sim/aircraft2/engine/flap_retraction_time_sec" // float, writable
+	SimAircraft2Engine_exhaust_dirtiness_ratio  string = "sim/aircraft2/engine/exhaust_dirtiness_ratio"  // float
+)
@@ -0,0 +1,24 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefairfoils provides the known dataref names under sim/airfoils/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefairfoils
+
+const (
+	SimAirfoils_afl_clB         string = "sim/airfoils/afl_clB"         // float_array, writable
+	SimAirfoils_afl_almin_array string = "sim/airfoils/afl_almin_array" // float_array, writable
+	SimAirfoils_afl_almax_array string = "sim/airfoils/afl_almax_array" // float_array, writable
+	SimAirfoils_afl_re_num      string = "sim/airfoils/afl_re_num"      // float_array, writable
+	SimAirfoils_afl_t_rat       string = "sim/airfoils/afl_t_rat"       // float_array, writable
+	SimAirfoils_afl_mach_div    string = "sim/airfoils/afl_mach_div"    // float_array, writable
+	SimAirfoils_afl_clM         string = "sim/airfoils/afl_clM"         // float_array, writable
+	SimAirfoils_afl_cl          string = "sim/airfoils/afl_cl"          // float_array, writable
+	SimAirfoils_afl_cd          string = "sim/airfoils/afl_cd"          // float_array, writable
+	SimAirfoils_afl_cm          string = "sim/airfoils/afl_cm"          // float_array, writable
+)
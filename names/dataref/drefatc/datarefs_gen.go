@@ -0,0 +1,28 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefatc provides the known dataref names under sim/atc/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefatc
+
+const (
+	SimAtc_user_aircraft_transmitting string = "sim/atc/user_aircraft_transmitting" // int
+	SimAtc_com1_tuned_facility        string = "sim/atc/com1_tuned_facility"        // int
+	SimAtc_com2_tuned_facility        string = "sim/atc/com2_tuned_facility"        // int
+	SimAtc_com1_active                string = "sim/atc/com1_active"                // int
+	SimAtc_com2_active                string = "sim/atc/com2_active"                // int
+	SimAtc_atis_enabled               string = "sim/atc/atis_enabled"               // int, writable
+	SimAtc_com1_rx                    string = "sim/atc/com1_rx"                    // int
+	SimAtc_com2_rx                    string = "sim/atc/com2_rx"                    // int
+	SimAtc_com1_tx                    string = "sim/atc/com1_tx"                    // int
+	SimAtc_com2_tx                    string = "sim/atc/com2_tx"                    // int
+	SimAtc_com1_rx_override           string = "sim/atc/com1_rx_override"           // int, writable
+	SimAtc_com2_rx_override           string = "sim/atc/com2_rx_override"           // int, writable
+	SimAtc_com1_tx_override           string = "sim/atc/com1_tx_override"           // int, writable
+	SimAtc_com2_tx_override           string = "sim/atc/com2_tx_override"           // int, writable
+)
@@ -0,0 +1,447 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefcockpit provides the known dataref names under sim/cockpit/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefcockpit
+
+const (
+	SimCockpitAutopilot_autopilot_mode                  string = "sim/cockpit/autopilot/autopilot_mode"                   // int, writable
+	SimCockpitAutopilot_airspeed_mode                   string = "sim/cockpit/autopilot/airspeed_mode"                    // int
+	SimCockpitAutopilot_heading_mode                    string = "sim/cockpit/autopilot/heading_mode"                     // int
+	SimCockpitAutopilot_altitude_mode                   string = "sim/cockpit/autopilot/altitude_mode"                    // int
+	SimCockpitAutopilot_backcourse_on                   string = "sim/cockpit/autopilot/backcourse_on"                    // int, writable
+	SimCockpitAutopilot_altitude                        string = "sim/cockpit/autopilot/altitude"                         // float, writable
+	SimCockpitAutopilot_current_altitude                string = "sim/cockpit/autopilot/current_altitude"                 // float, writable
+	SimCockpitAutopilot_vertical_velocity               string = "sim/cockpit/autopilot/vertical_velocity"                // float, writable
+	SimCockpitAutopilot_airspeed                        string = "sim/cockpit/autopilot/airspeed"                         // float, writable
+	SimCockpitAutopilot_heading                         string = "sim/cockpit/autopilot/heading"                          // float, writable
+	SimCockpitAutopilot_heading_mag                     string = "sim/cockpit/autopilot/heading_mag"                      // float, writable
+	SimCockpitAutopilot_heading_mag2                    string = "sim/cockpit/autopilot/heading_mag2"                     // float, writable
+	SimCockpitAutopilot_airspeed_is_mach                string = "sim/cockpit/autopilot/airspeed_is_mach"                 // int, writable
+	SimCockpitAutopilot_flight_director_pitch           string = "sim/cockpit/autopilot/flight_director_pitch"            // float, writable
+	SimCockpitAutopilot_flight_director_roll            string = "sim/cockpit/autopilot/flight_director_roll"             // float, writable
+	SimCockpitAutopilot_autopilot_state                 string = "sim/cockpit/autopilot/autopilot_state"                  // int, writable
+	SimCockpitAutopilot_heading_roll_mode               string = "sim/cockpit/autopilot/heading_roll_mode"                // int, writable
+	SimCockpitAutopilot_mode_hnav                       string = "sim/cockpit/autopilot/mode_hnav"                        // int
+	SimCockpitAutopilot_mode_gls                        string = "sim/cockpit/autopilot/mode_gls"                         // int
+	SimCockpitAutopilot_syn_hold_deg                    string = "sim/cockpit/autopilot/syn_hold_deg"                     // float, writable
+	SimCockpitAutopilot_nav_steer_deg_mag               string = "sim/cockpit/autopilot/nav_steer_deg_mag"                // float, writable
+	SimCockpitAvidyne_lft_hil                           string = "sim/cockpit/avidyne/lft_hil"                            // int, writable
+	SimCockpitAvidyne_rgt_hil                           string = "sim/cockpit/avidyne/rgt_hil"                            // int, writable
+	SimCockpitAvidyne_alt_hil                           string = "sim/cockpit/avidyne/alt_hil"                            // int, writable
+	SimCockpitAvidyne_src                               string = "sim/cockpit/avidyne/src"                                // int_array, writable
+	SimCockpitAvidyne_hsi_mode                          string = "sim/cockpit/avidyne/hsi_mode"                           // int, writable
+	SimCockpitAvidyne_map_range_sel                     string = "sim/cockpit/avidyne/map_range_sel"                      // int, writable
+	SimCockpitElectrical_battery_on                     string = "sim/cockpit/electrical/battery_on"                      // int, writable
+	SimCockpitElectrical_battery_array_on               string = "sim/cockpit/electrical/battery_array_on"                // int_array, writable
+	SimCockpitElectrical_battery_EQ                     string = "sim/cockpit/electrical/battery_EQ"                      // int
+	SimCockpitElectrical_avionics_on                    string = "sim/cockpit/electrical/avionics_on"                     // int, writable
+	SimCockpitElectrical_avionics_EQ                    string = "sim/cockpit/electrical/avionics_EQ"                     // int, writable
+	SimCockpitElectrical_generator_on                   string = "sim/cockpit/electrical/generator_on"                    // int_array, writable
+	SimCockpitElectrical_generator_EQ                   string = "sim/cockpit/electrical/generator_EQ"                    // int, writable
+	SimCockpitElectrical_generator_apu_on               string = "sim/cockpit/electrical/generator_apu_on"                // int, writable
+	SimCockpitElectrical_gpu_on                         string = "sim/cockpit/electrical/gpu_on"                          // int, writable
+	SimCockpitElectrical_generator_apu_amps             string = "sim/cockpit/electrical/generator_apu_amps"              // float, writable
+	SimCockpitElectrical_gpu_amps                       string = "sim/cockpit/electrical/gpu_amps"                        // float, writable
+	SimCockpitElectrical_HUD_on                         string = "sim/cockpit/electrical/HUD_on"                          // int, writable
+	SimCockpitElectrical_HUD_brightness                 string = "sim/cockpit/electrical/HUD_brightness"                  // float, writable
+	SimCockpitElectrical_beacon_lights_on               string = "sim/cockpit/electrical/beacon_lights_on"                // int, writable
+	SimCockpitElectrical_landing_lights_on              string = "sim/cockpit/electrical/landing_lights_on"               // int, writable
+	SimCockpitElectrical_nav_lights_on                  string = "sim/cockpit/electrical/nav_lights_on"                   // int, writable
+	SimCockpitElectrical_strobe_lights_on               string = "sim/cockpit/electrical/strobe_lights_on"                // int, writable
+	SimCockpitElectrical_taxi_light_on                  string = "sim/cockpit/electrical/taxi_light_on"                   // int, writable
+	SimCockpitElectrical_cockpit_lights_on              string = "sim/cockpit/electrical/cockpit_lights_on"               // int
+	SimCockpitElectrical_cockpit_lights                 string = "sim/cockpit/electrical/cockpit_lights"                  // float, writable
+	SimCockpitElectrical_instrument_brightness          string = "sim/cockpit/electrical/instrument_brightness"           // float, writable
+	SimCockpitElectrical_sunglasses_on                  string = "sim/cockpit/electrical/sunglasses_on"                   // int, writable
+	SimCockpitElectrical_night_vision_on                string = "sim/cockpit/electrical/night_vision_on"                 // int, writable
+	SimCockpitElectrical_ah_bar                         string = "sim/cockpit/electrical/ah_bar"                          // float, writable
+	SimCockpitElectrical_battery_charge_watt_hr         string = "sim/cockpit/electrical/battery_charge_watt_hr"          // float_array, writable
+	SimCockpitEngine_inverter_on                        string = "sim/cockpit/engine/inverter_on"                         // int_array, writable
+	SimCockpitEngine_inverter_eq                        string = "sim/cockpit/engine/inverter_eq"                         // int
+	SimCockpitEngine_fuel_pump_on                       string = "sim/cockpit/engine/fuel_pump_on"                        // int_array, writable
+	SimCockpitEngine_fadec_on                           string = "sim/cockpit/engine/fadec_on"                            // int_array, writable
+	SimCockpitEngine_idle_speed                         string = "sim/cockpit/engine/idle_speed"                          // int_array, writable
+	SimCockpitEngine_fuel_tank_selector                 string = "sim/cockpit/engine/fuel_tank_selector"                  // int, writable
+	SimCockpitEngine_fuel_tank_transfer                 string = "sim/cockpit/engine/fuel_tank_transfer"                  // int, writable
+	SimCockpitEngine_fuel_tank_transfer_from            string = "sim/cockpit/engine/fuel_tank_transfer_from"             // int, writable
+	SimCockpitEngine_ignition_on                        string = "sim/cockpit/engine/ignition_on"                         // int_array, writable
+	SimCockpitEngine_igniters_on                        string = "sim/cockpit/engine/igniters_on"                         // int_array, writable
+	SimCockpitEngine_starter_duration                   string = "sim/cockpit/engine/starter_duration"                    // float_array, writable
+	SimCockpitEngine_clutch_engage                      string = "sim/cockpit/engine/clutch_engage"                       // int, writable
+	SimCockpitEngine_APU_switch                         string = "sim/cockpit/engine/APU_switch"                          // int, writable
+	SimCockpitEngine_APU_running                        string = "sim/cockpit/engine/APU_running"                         // int, writable
+	SimCockpitEngine_APU_N1                             string = "sim/cockpit/engine/APU_N1"                              // float, writable
+	SimCockpitG430_g430_nav_com_sel                     string = "sim/cockpit/g430/g430_nav_com_sel"                      // int_array, writable
+	SimCockpitG1000_gcu478_input_sel                    string = "sim/cockpit/g1000/gcu478_input_sel"                     // int, writable
+	SimCockpitG1000_g1000_n1_page                       string = "sim/cockpit/g1000/g1000_n1_page"                        // data
+	SimCockpitG1000_g1000_n2_page                       string = "sim/cockpit/g1000/g1000_n2_page"                        // data
+	SimCockpitG1000_g1000_n1_overlay                    string = "sim/cockpit/g1000/g1000_n1_overlay"                     // int
+	SimCockpitG1000_g1000_n2_overlay                    string = "sim/cockpit/g1000/g1000_n2_overlay"                     // int
+	SimCockpitG1000_g1000_n2_eis                        string = "sim/cockpit/g1000/g1000_n2_eis"                         // int
+	SimCockpitG1000_g1000_startup_time                  string = "sim/cockpit/g1000/g1000_startup_time"                   // float_array, writable
+	SimCockpitGps_course                                string = "sim/cockpit/gps/course"                                 // float
+	SimCockpitGps_destination_type                      string = "sim/cockpit/gps/destination_type"                       // int
+	SimCockpitGps_destination_index                     string = "sim/cockpit/gps/destination_index"                      // int
+	SimCockpitGyros_the_vac_ind_deg                     string = "sim/cockpit/gyros/the_vac_ind_deg"                      // float, writable
+	SimCockpitGyros_the_ele_ind_deg                     string = "sim/cockpit/gyros/the_ele_ind_deg"                      // float, writable
+	SimCockpitGyros_the_ind_deg3                        string = "sim/cockpit/gyros/the_ind_deg3"                         // float, writable
+	SimCockpitGyros_the_ind_deg4                        string = "sim/cockpit/gyros/the_ind_deg4"                         // float, writable
+	SimCockpitGyros_the_ind_vac_pilot_deg               string = "sim/cockpit/gyros/the_ind_vac_pilot_deg"                // float, writable
+	SimCockpitGyros_the_ind_vac_copilot_deg             string = "sim/cockpit/gyros/the_ind_vac_copilot_deg"              // float, writable
+	SimCockpitGyros_the_ind_elec_pilot_deg              string = "sim/cockpit/gyros/the_ind_elec_pilot_deg"               // float, writable
+	SimCockpitGyros_the_ind_elec_copilot_deg            string = "sim/cockpit/gyros/the_ind_elec_copilot_deg"             // float, writable
+	SimCockpitGyros_the_ind_ahars_pilot_deg             string = "sim/cockpit/gyros/the_ind_ahars_pilot_deg"              // float, writable
+	SimCockpitGyros_the_ind_ahars_copilot_deg           string = "sim/cockpit/gyros/the_ind_ahars_copilot_deg"            // float, writable
+	SimCockpitGyros_psi_vac_ind_degm                    string = "sim/cockpit/gyros/psi_vac_ind_degm"                     // float, writable
+	SimCockpitGyros_psi_ele_ind_degm                    string = "sim/cockpit/gyros/psi_ele_ind_degm"                     // float, writable
+	SimCockpitGyros_psi_ind_degm3                       string = "sim/cockpit/gyros/psi_ind_degm3"                        // float, writable
+	SimCockpitGyros_psi_ind_degm4                       string = "sim/cockpit/gyros/psi_ind_degm4"                        // float, writable
+	SimCockpitGyros_psi_ind_vac_pilot_degm              string = "sim/cockpit/gyros/psi_ind_vac_pilot_degm"               // float, writable
+	SimCockpitGyros_psi_ind_vac_copilot_degm            string = "sim/cockpit/gyros/psi_ind_vac_copilot_degm"             // float, writable
+	SimCockpitGyros_psi_ind_elec_pilot_degm             string = "sim/cockpit/gyros/psi_ind_elec_pilot_degm"              // float, writable
+	SimCockpitGyros_psi_ind_elec_copilot_degm           string = "sim/cockpit/gyros/psi_ind_elec_copilot_degm"            // float, writable
+	SimCockpitGyros_psi_ind_ahars_pilot_degm            string = "sim/cockpit/gyros/psi_ind_ahars_pilot_degm"             // float, writable
+	SimCockpitGyros_psi_ind_ahars_copilot_degm          string = "sim/cockpit/gyros/psi_ind_ahars_copilot_degm"           // float, writable
+	SimCockpitGyros_phi_vac_ind_deg                     string = "sim/cockpit/gyros/phi_vac_ind_deg"                      // float, writable
+	SimCockpitGyros_phi_ele_ind_deg                     string = "sim/cockpit/gyros/phi_ele_ind_deg"                      // float, writable
+	SimCockpitGyros_phi_ind_deg3                        string = "sim/cockpit/gyros/phi_ind_deg3"                         // float, writable
+	SimCockpitGyros_phi_ind_deg4                        string = "sim/cockpit/gyros/phi_ind_deg4"                         // float, writable
+	SimCockpitGyros_phi_ind_vac_pilot_deg               string = "sim/cockpit/gyros/phi_ind_vac_pilot_deg"                // float, writable
+	SimCockpitGyros_phi_ind_vac_copilot_deg             string = "sim/cockpit/gyros/phi_ind_vac_copilot_deg"              // float, writable
+	SimCockpitGyros_phi_ind_elec_pilot_deg              string = "sim/cockpit/gyros/phi_ind_elec_pilot_deg"               // float, writable
+	SimCockpitGyros_phi_ind_elec_copilot_deg            string = "sim/cockpit/gyros/phi_ind_elec_copilot_deg"             // float, writable
+	SimCockpitGyros_phi_ind_ahars_pilot_deg             string = "sim/cockpit/gyros/phi_ind_ahars_pilot_deg"              // float, writable
+	SimCockpitGyros_phi_ind_ahars_copilot_deg           string = "sim/cockpit/gyros/phi_ind_ahars_copilot_deg"            // float, writable
+	SimCockpitGyros_dg_drift_vac_deg                    string = "sim/cockpit/gyros/dg_drift_vac_deg"                     // float, writable
+	SimCockpitGyros_dg_drift_vac2_deg                   string = "sim/cockpit/gyros/dg_drift_vac2_deg"                    // float, writable
+	SimCockpitGyros_dg_drift_ele_deg                    string = "sim/cockpit/gyros/dg_drift_ele_deg"                     // float, writable
+	SimCockpitGyros_dg_drift_ele2_deg                   string = "sim/cockpit/gyros/dg_drift_ele2_deg"                    // float, writable
+	SimCockpitGyros_dg_drift_ahars_deg                  string = "sim/cockpit/gyros/dg_drift_ahars_deg"                   // float, writable
+	SimCockpitGyros_dg_drift_ahars2_deg                 string = "sim/cockpit/gyros/dg_drift_ahars2_deg"                  // float, writable
+	SimCockpitGyros_gyr_force                           string = "sim/cockpit/gyros/gyr_force"                            // float_array, writable
+	SimCockpitGyros_gyr_spin                            string = "sim/cockpit/gyros/gyr_spin"                             // float_array, writable
+	SimCockpitGyros_gyr_free_slaved                     string = "sim/cockpit/gyros/gyr_free_slaved"                      // int_array, writable
+	SimCockpitGyros_gyr_flag                            string = "sim/cockpit/gyros/gyr_flag"                             // int_array
+	SimCockpitGyros_gyr_cage_ratio                      string = "sim/cockpit/gyros/gyr_cage_ratio"                       // float_array, writable
+	SimCockpitGyros_gyr_latitude_nut                    string = "sim/cockpit/gyros/gyr_latitude_nut"                     // float_array, writable
+	SimCockpitGyros_gyr_total_error                     string = "sim/cockpit/gyros/gyr_total_error"                      // float_array
+	SimCockpitGyros_gyr_magnetometer_diff               string = "sim/cockpit/gyros/gyr_magnetometer_diff"                // float_array
+	SimCockpitMisc_outer_marker_lit                     string = "sim/cockpit/misc/outer_marker_lit"                      // int
+	SimCockpitMisc_middle_marker_lit                    string = "sim/cockpit/misc/middle_marker_lit"                     // int
+	SimCockpitMisc_inner_marker_lit                     string = "sim/cockpit/misc/inner_marker_lit"                      // int
+	SimCockpitMisc_over_outer_marker                    string = "sim/cockpit/misc/over_outer_marker"                     // int, writable
+	SimCockpitMisc_over_middle_marker                   string = "sim/cockpit/misc/over_middle_marker"                    // int, writable
+	SimCockpitMisc_over_inner_marker                    string = "sim/cockpit/misc/over_inner_marker"                     // int, writable
+	SimCockpitMisc_barometer_setting                    string = "sim/cockpit/misc/barometer_setting"                     // float, writable
+	SimCockpitMisc_barometer_setting2                   string = "sim/cockpit/misc/barometer_setting2"                    // float, writable
+	SimCockpitMisc_radio_altimeter_minimum              string = "sim/cockpit/misc/radio_altimeter_minimum"               // float, writable
+	SimCockpitMisc_show_path                            string = "sim/cockpit/misc/show_path"                             // float, writable
+	SimCockpitMisc_vacuum                               string = "sim/cockpit/misc/vacuum"                                // float, writable
+	SimCockpitMisc_vacuum2                              string = "sim/cockpit/misc/vacuum2"                               // float, writable
+	SimCockpitMisc_ah_adjust                            string = "sim/cockpit/misc/ah_adjust"                             // float, writable
+	SimCockpitMisc_ah_adjust2                           string = "sim/cockpit/misc/ah_adjust2"                            // float, writable
+	SimCockpitMisc_compass_indicated                    string = "sim/cockpit/misc/compass_indicated"                     // float, writable
+	SimCockpitMisc_hydraulic_quantity                   string = "sim/cockpit/misc/hydraulic_quantity"                    // float, writable
+	SimCockpitMisc_hydraulic_quantity2                  string = "sim/cockpit/misc/hydraulic_quantity2"                   // float, writable
+	SimCockpitMisc_hydraulic_quantity3                  string = "sim/cockpit/misc/hydraulic_quantity3"                   // float, writable
+	SimCockpitPressure_bleed_air_on                     string = "sim/cockpit/pressure/bleed_air_on"                      // int, writable
+	SimCockpitPressure_bleed_air_mode                   string = "sim/cockpit/pressure/bleed_air_mode"                    // int, writable
+	SimCockpitPressure_cabin_altitude_set_m_msl         string = "sim/cockpit/pressure/cabin_altitude_set_m_msl"          // float, writable
+	SimCockpitPressure_cabin_altitude_set_ft            string = "sim/cockpit/pressure/cabin_altitude_set_ft"             // float, writable
+	SimCockpitPressure_cabin_vvi_set_m_msec             string = "sim/cockpit/pressure/cabin_vvi_set_m_msec"              // float, writable
+	SimCockpitPressure_cabin_vvi_set_m_fpm              string = "sim/cockpit/pressure/cabin_vvi_set_m_fpm"               // float, writable
+	SimCockpitPressure_cabin_pressure_differential_psi  string = "sim/cockpit/pressure/cabin_pressure_differential_psi"   // float, writable
+	SimCockpitPressure_cabin_altitude_actual_m_msl      string = "sim/cockpit/pressure/cabin_altitude_actual_m_msl"       // float, writable
+	SimCockpitPressure_cabin_altitude_actual_ft         string = "sim/cockpit/pressure/cabin_altitude_actual_ft"          // float, writable
+	SimCockpitPressure_cabin_vvi_actual_m_msec          string = "sim/cockpit/pressure/cabin_vvi_actual_m_msec"           // float, writable
+	SimCockpitPressure_cabin_vvi_actual_fpm             string = "sim/cockpit/pressure/cabin_vvi_actual_fpm"              // float, writable
+	SimCockpitPressure_pressure_test_timeout            string = "sim/cockpit/pressure/pressure_test_timeout"             // float, writable
+	SimCockpitPressure_max_allowable_altitude           string = "sim/cockpit/pressure/max_allowable_altitude"            // float, writable
+	SimCockpitPressure_dump_all                         string = "sim/cockpit/pressure/dump_all"                          // int, writable
+	SimCockpitPressure_dump_to_alt                      string = "sim/cockpit/pressure/dump_to_alt"                       // int, writable
+	SimCockpitPressure_outflow_valve                    string = "sim/cockpit/pressure/outflow_valve"                     // float, writable
+	SimCockpitRadios_nav1_freq_hz                       string = "sim/cockpit/radios/nav1_freq_hz"                        // int, writable
+	SimCockpitRadios_nav2_freq_hz                       string = "sim/cockpit/radios/nav2_freq_hz"                        // int, writable
+	SimCockpitRadios_com1_freq_hz                       string = "sim/cockpit/radios/com1_freq_hz"                        // int, writable
+	SimCockpitRadios_com2_freq_hz                       string = "sim/cockpit/radios/com2_freq_hz"                        // int, writable
+	SimCockpitRadios_adf1_freq_hz                       string = "sim/cockpit/radios/adf1_freq_hz"                        // int, writable
+	SimCockpitRadios_adf2_freq_hz                       string = "sim/cockpit/radios/adf2_freq_hz"                        // int, writable
+	SimCockpitRadios_dme_freq_hz                        string = "sim/cockpit/radios/dme_freq_hz"                         // int, writable
+	SimCockpitRadios_nav1_stdby_freq_hz                 string = "sim/cockpit/radios/nav1_stdby_freq_hz"                  // int, writable
+	SimCockpitRadios_nav2_stdby_freq_hz                 string = "sim/cockpit/radios/nav2_stdby_freq_hz"                  // int, writable
+	SimCockpitRadios_com1_stdby_freq_hz                 string = "sim/cockpit/radios/com1_stdby_freq_hz"                  // int, writable
+	SimCockpitRadios_com2_stdby_freq_hz                 string = "sim/cockpit/radios/com2_stdby_freq_hz"                  // int, writable
+	SimCockpitRadios_adf1_stdby_freq_hz                 string = "sim/cockpit/radios/adf1_stdby_freq_hz"                  // int, writable
+	SimCockpitRadios_adf2_stdby_freq_hz                 string = "sim/cockpit/radios/adf2_stdby_freq_hz"                  // int, writable
+	SimCockpitRadios_dme_stdby_freq_hz                  string = "sim/cockpit/radios/dme_stdby_freq_hz"                   // int, writable
+	SimCockpitRadios_nav1_obs_degt                      string = "sim/cockpit/radios/nav1_obs_degt"                       // float, writable
+	SimCockpitRadios_nav2_obs_degt                      string = "sim/cockpit/radios/nav2_obs_degt"                       // float, writable
+	SimCockpitRadios_nav1_obs_degm                      string = "sim/cockpit/radios/nav1_obs_degm"                       // float, writable
+	SimCockpitRadios_nav1_obs_degm2                     string = "sim/cockpit/radios/nav1_obs_degm2"                      // float, writable
+	SimCockpitRadios_nav2_obs_degm                      string = "sim/cockpit/radios/nav2_obs_degm"                       // float, writable
+	SimCockpitRadios_nav2_obs_degm2                     string = "sim/cockpit/radios/nav2_obs_degm2"                      // float, writable
+	SimCockpitRadios_nav1_dir_degt                      string = "sim/cockpit/radios/nav1_dir_degt"                       // float, writable
+	SimCockpitRadios_nav2_dir_degt                      string = "sim/cockpit/radios/nav2_dir_degt"                       // float, writable
+	SimCockpitRadios_adf1_dir_degt                      string = "sim/cockpit/radios/adf1_dir_degt"                       // float, writable
+	SimCockpitRadios_adf2_dir_degt                      string = "sim/cockpit/radios/adf2_dir_degt"                       // float, writable
+	SimCockpitRadios_gps_dir_degt                       string = "sim/cockpit/radios/gps_dir_degt"                        // float, writable
+	SimCockpitRadios_gps2_dir_degt                      string = "sim/cockpit/radios/gps2_dir_degt"                       // float, writable
+	SimCockpitRadios_dme_dir_degt                       string = "sim/cockpit/radios/dme_dir_degt"                        // float
+	SimCockpitRadios_nav1_hdef_dot                      string = "sim/cockpit/radios/nav1_hdef_dot"                       // float, writable
+	SimCockpitRadios_nav1_hdef_dot2                     string = "sim/cockpit/radios/nav1_hdef_dot2"                      // float, writable
+	SimCockpitRadios_nav2_hdef_dot                      string = "sim/cockpit/radios/nav2_hdef_dot"                       // float, writable
+	SimCockpitRadios_nav2_hdef_dot2                     string = "sim/cockpit/radios/nav2_hdef_dot2"                      // float, writable
+	SimCockpitRadios_gps_hdef_dot                       string = "sim/cockpit/radios/gps_hdef_dot"                        // float, writable
+	SimCockpitRadios_gps_hdef_dot2                      string = "sim/cockpit/radios/gps_hdef_dot2"                       // float, writable
+	SimCockpitRadios_gps2_hdef_dot                      string = "sim/cockpit/radios/gps2_hdef_dot"                       // float, writable
+	SimCockpitRadios_gps2_hdef_dot2                     string = "sim/cockpit/radios/gps2_hdef_dot2"                      // float, writable
+	SimCockpitRadios_nav1_vdef_dot                      string = "sim/cockpit/radios/nav1_vdef_dot"                       // float, writable
+	SimCockpitRadios_nav1_vdef_dot2                     string = "sim/cockpit/radios/nav1_vdef_dot2"                      // float, writable
+	SimCockpitRadios_nav2_vdef_dot                      string = "sim/cockpit/radios/nav2_vdef_dot"                       // float, writable
+	SimCockpitRadios_nav2_vdef_dot2                     string = "sim/cockpit/radios/nav2_vdef_dot2"                      // float, writable
+	SimCockpitRadios_gps_vdef_dot                       string = "sim/cockpit/radios/gps_vdef_dot"                        // float, writable
+	SimCockpitRadios_gps_vdef_dot2                      string = "sim/cockpit/radios/gps_vdef_dot2"                       // float, writable
+	SimCockpitRadios_gps2_vdef_dot                      string = "sim/cockpit/radios/gps2_vdef_dot"                       // float, writable
+	SimCockpitRadios_gps2_vdef_dot2                     string = "sim/cockpit/radios/gps2_vdef_dot2"                      // float, writable
+	SimCockpitRadios_nav1_fromto                        string = "sim/cockpit/radios/nav1_fromto"                         // int, writable
+	SimCockpitRadios_nav1_fromto2                       string = "sim/cockpit/radios/nav1_fromto2"                        // int, writable
+	SimCockpitRadios_nav2_fromto                        string = "sim/cockpit/radios/nav2_fromto"                         // int, writable
+	SimCockpitRadios_nav2_fromto2                       string = "sim/cockpit/radios/nav2_fromto2"                        // int, writable
+	SimCockpitRadios_gps_fromto                         string = "sim/cockpit/radios/gps_fromto"                          // int, writable
+	SimCockpitRadios_gps_fromto2                        string = "sim/cockpit/radios/gps_fromto2"                         // int, writable
+	SimCockpitRadios_gps2_fromto                        string = "sim/cockpit/radios/gps2_fromto"                         // int, writable
+	SimCockpitRadios_gps2_fromto2                       string = "sim/cockpit/radios/gps2_fromto2"                        // int, writable
+	SimCockpitRadios_nav1_CDI                           string = "sim/cockpit/radios/nav1_CDI"                            // int
+	SimCockpitRadios_nav2_CDI                           string = "sim/cockpit/radios/nav2_CDI"                            // int
+	SimCockpitRadios_nav1_dme_dist_m                    string = "sim/cockpit/radios/nav1_dme_dist_m"                     // float, writable
+	SimCockpitRadios_nav2_dme_dist_m                    string = "sim/cockpit/radios/nav2_dme_dist_m"                     // float, writable
+	SimCockpitRadios_adf1_dme_dist_m                    string = "sim/cockpit/radios/adf1_dme_dist_m"                     // float, writable
+	SimCockpitRadios_adf2_dme_dist_m                    string = "sim/cockpit/radios/adf2_dme_dist_m"                     // float, writable
+	SimCockpitRadios_gps_dme_dist_m                     string = "sim/cockpit/radios/gps_dme_dist_m"                      // float, writable
+	SimCockpitRadios_gps2_dme_dist_m                    string = "sim/cockpit/radios/gps2_dme_dist_m"                     // float, writable
+	SimCockpitRadios_standalone_dme_dist_m              string = "sim/cockpit/radios/standalone_dme_dist_m"               // float, writable
+	SimCockpitRadios_nav1_dme_speed_kts                 string = "sim/cockpit/radios/nav1_dme_speed_kts"                  // float, writable
+	SimCockpitRadios_nav2_dme_speed_kts                 string = "sim/cockpit/radios/nav2_dme_speed_kts"                  // float, writable
+	SimCockpitRadios_adf1_dme_speed_kts                 string = "sim/cockpit/radios/adf1_dme_speed_kts"                  // float, writable
+	SimCockpitRadios_adf2_dme_speed_kts                 string = "sim/cockpit/radios/adf2_dme_speed_kts"                  // float, writable
+	SimCockpitRadios_gps_dme_speed_kts                  string = "sim/cockpit/radios/gps_dme_speed_kts"                   // float, writable
+	SimCockpitRadios_gps2_dme_speed_kts                 string = "sim/cockpit/radios/gps2_dme_speed_kts"                  // float, writable
+	SimCockpitRadios_standalone_dme_speed_kts           string = "sim/cockpit/radios/standalone_dme_speed_kts"            // float, writable
+	SimCockpitRadios_nav1_dme_time_secs                 string = "sim/cockpit/radios/nav1_dme_time_secs"                  // float, writable
+	SimCockpitRadios_nav2_dme_time_secs                 string = "sim/cockpit/radios/nav2_dme_time_secs"                  // float, writable
+	SimCockpitRadios_adf1_dme_time_secs                 string = "sim/cockpit/radios/adf1_dme_time_secs"                  // float, writable
+	SimCockpitRadios_adf2_dme_time_secs                 string = "sim/cockpit/radios/adf2_dme_time_secs"                  // float, writable
+	SimCockpitRadios_gps_dme_time_secs                  string = "sim/cockpit/radios/gps_dme_time_secs"                   // float, writable
+	SimCockpitRadios_gps2_dme_time_secs                 string = "sim/cockpit/radios/gps2_dme_time_secs"                  // float, writable
+	SimCockpitRadios_standalone_dme_time_secs           string = "sim/cockpit/radios/standalone_dme_time_secs"            // float, writable
+	SimCockpitRadios_nav1_course_degm                   string = "sim/cockpit/radios/nav1_course_degm"                    // float, writable
+	SimCockpitRadios_nav1_course_degm2                  string = "sim/cockpit/radios/nav1_course_degm2"                   // float, writable
+	SimCockpitRadios_nav2_course_degm                   string = "sim/cockpit/radios/nav2_course_degm"                    // float, writable
+	SimCockpitRadios_nav2_course_degm2                  string = "sim/cockpit/radios/nav2_course_degm2"                   // float, writable
+	SimCockpitRadios_gps_course_degtm                   string = "sim/cockpit/radios/gps_course_degtm"                    // float, writable
+	SimCockpitRadios_gps_course_degtm2                  string = "sim/cockpit/radios/gps_course_degtm2"                   // float, writable
+	SimCockpitRadios_gps2_course_degtm                  string = "sim/cockpit/radios/gps2_course_degtm"                   // float, writable
+	SimCockpitRadios_gps2_course_degtm2                 string = "sim/cockpit/radios/gps2_course_degtm2"                  // float, writable
+	SimCockpitRadios_nav1_slope_degt                    string = "sim/cockpit/radios/nav1_slope_degt"                     // float, writable
+	SimCockpitRadios_nav2_slope_degt                    string = "sim/cockpit/radios/nav2_slope_degt"                     // float, writable
+	SimCockpitRadios_gps_slope_degt                     string = "sim/cockpit/radios/gps_slope_degt"                      // float, writable
+	SimCockpitRadios_gps2_slope_degt                    string = "sim/cockpit/radios/gps2_slope_degt"                     // float, writable
+	SimCockpitRadios_gps_gp_mtr_per_dot                 string = "sim/cockpit/radios/gps_gp_mtr_per_dot"                  // float, writable
+	SimCockpitRadios_gps2_gp_mtr_per_dot                string = "sim/cockpit/radios/gps2_gp_mtr_per_dot"                 // float, writable
+	SimCockpitRadios_gps_hdef_nm_per_dot                string = "sim/cockpit/radios/gps_hdef_nm_per_dot"                 // float, writable
+	SimCockpitRadios_gps2_hdef_nm_per_dot               string = "sim/cockpit/radios/gps2_hdef_nm_per_dot"                // float, writable
+	SimCockpitRadios_gps_cdi_sensitivity                string = "sim/cockpit/radios/gps_cdi_sensitivity"                 // int
+	SimCockpitRadios_gps2_cdi_sensitivity               string = "sim/cockpit/radios/gps2_cdi_sensitivity"                // int
+	SimCockpitRadios_gps_sequencing                     string = "sim/cockpit/radios/gps_sequencing"                      // int
+	SimCockpitRadios_gps2_sequencing                    string = "sim/cockpit/radios/gps2_sequencing"                     // int
+	SimCockpitRadios_transponder_code                   string = "sim/cockpit/radios/transponder_code"                    // int, writable
+	SimCockpitRadios_transponder_id                     string = "sim/cockpit/radios/transponder_id"                      // int
+	SimCockpitRadios_transponder_light                  string = "sim/cockpit/radios/transponder_light"                   // int
+	SimCockpitRadios_transponder_brightness             string = "sim/cockpit/radios/transponder_brightness"              // float, writable
+	SimCockpitRadios_transponder_mode                   string = "sim/cockpit/radios/transponder_mode"                    // int, writable
+	SimCockpitRadios_nav1_cardinal_dir                  string = "sim/cockpit/radios/nav1_cardinal_dir"                   // float, writable
+	SimCockpitRadios_nav1_cardinal_dir2                 string = "sim/cockpit/radios/nav1_cardinal_dir2"                  // float, writable
+	SimCockpitRadios_nav2_cardinal_dir                  string = "sim/cockpit/radios/nav2_cardinal_dir"                   // float, writable
+	SimCockpitRadios_nav2_cardinal_dir2                 string = "sim/cockpit/radios/nav2_cardinal_dir2"                  // float, writable
+	SimCockpitRadios_adf1_cardinal_dir                  string = "sim/cockpit/radios/adf1_cardinal_dir"                   // float, writable
+	SimCockpitRadios_adf1_cardinal_dir2                 string = "sim/cockpit/radios/adf1_cardinal_dir2"                  // float, writable
+	SimCockpitRadios_adf2_cardinal_dir                  string = "sim/cockpit/radios/adf2_cardinal_dir"                   // float, writable
+	SimCockpitRadios_adf2_cardinal_dir2                 string = "sim/cockpit/radios/adf2_cardinal_dir2"                  // float, writable
+	SimCockpitRadios_nav1_has_dme                       string = "sim/cockpit/radios/nav1_has_dme"                        // int, writable
+	SimCockpitRadios_nav2_has_dme                       string = "sim/cockpit/radios/nav2_has_dme"                        // int, writable
+	SimCockpitRadios_adf1_has_dme                       string = "sim/cockpit/radios/adf1_has_dme"                        // int, writable
+	SimCockpitRadios_adf2_has_dme                       string = "sim/cockpit/radios/adf2_has_dme"                        // int, writable
+	SimCockpitRadios_dme5_has_dme                       string = "sim/cockpit/radios/dme5_has_dme"                        // int, writable
+	SimCockpitRadios_obs_mag                            string = "sim/cockpit/radios/obs_mag"                             // float, writable
+	SimCockpitRadios_gear_audio_working                 string = "sim/cockpit/radios/gear_audio_working"                  // int, writable
+	SimCockpitRadios_marker_audio_working               string = "sim/cockpit/radios/marker_audio_working"                // int, writable
+	SimCockpitRadios_nav_type                           string = "sim/cockpit/radios/nav_type"                            // int_array
+	SimCockpitRadios_ap_src                             string = "sim/cockpit/radios/ap_src"                              // int, writable
+	SimCockpitRadios_nav_com_adf_mode                   string = "sim/cockpit/radios/nav_com_adf_mode"                    // int, writable
+	SimCockpitRadios_gps_has_glideslope                 string = "sim/cockpit/radios/gps_has_glideslope"                  // int, writable
+	SimCockpitRadios_gps2_has_glideslope                string = "sim/cockpit/radios/gps2_has_glideslope"                 // int, writable
+	SimCockpitRadios_glideslope_signal_valid            string = "sim/cockpit/radios/glideslope_signal_valid"             // int_array, writable
+	SimCockpitRadios_gps_obs_degm                       string = "sim/cockpit/radios/gps_obs_degm"                        // float, writable
+	SimCockpitRadios_gps_obs_degm2                      string = "sim/cockpit/radios/gps_obs_degm2"                       // float, writable
+	SimCockpitRadios_gps2_obs_degm                      string = "sim/cockpit/radios/gps2_obs_degm"                       // float, writable
+	SimCockpitRadios_gps2_obs_degm2                     string = "sim/cockpit/radios/gps2_obs_degm2"                      // float, writable
+	SimCockpitRadios_gps_has_dme                        string = "sim/cockpit/radios/gps_has_dme"                         // int, writable
+	SimCockpitRadios_gps2_has_dme                       string = "sim/cockpit/radios/gps2_has_dme"                        // int, writable
+	SimCockpitSwitches_DME_radio_selector               string = "sim/cockpit/switches/DME_radio_selector"                // int, writable
+	SimCockpitSwitches_DME_distance_or_time             string = "sim/cockpit/switches/DME_distance_or_time"              // int, writable
+	SimCockpitSwitches_HSI_selector                     string = "sim/cockpit/switches/HSI_selector"                      // int, writable
+	SimCockpitSwitches_HSI_selector2                    string = "sim/cockpit/switches/HSI_selector2"                     // int, writable
+	SimCockpitSwitches_RMI_selector                     string = "sim/cockpit/switches/RMI_selector"                      // int, writable
+	SimCockpitSwitches_RMI_selector2                    string = "sim/cockpit/switches/RMI_selector2"                     // int, writable
+	SimCockpitSwitches_RMI_l_vor_adf_selector           string = "sim/cockpit/switches/RMI_l_vor_adf_selector"            // int, writable
+	SimCockpitSwitches_RMI_l_vor_adf_selector2          string = "sim/cockpit/switches/RMI_l_vor_adf_selector2"           // int, writable
+	SimCockpitSwitches_RMI_r_vor_adf_selector           string = "sim/cockpit/switches/RMI_r_vor_adf_selector"            // int, writable
+	SimCockpitSwitches_RMI_r_vor_adf_selector2          string = "sim/cockpit/switches/RMI_r_vor_adf_selector2"           // int, writable
+	SimCockpitSwitches_EFIS_dme_1_selector              string = "sim/cockpit/switches/EFIS_dme_1_selector"               // int, writable
+	SimCockpitSwitches_EFIS_dme_2_selector              string = "sim/cockpit/switches/EFIS_dme_2_selector"               // int, writable
+	SimCockpitSwitches_marker_panel_out                 string = "sim/cockpit/switches/marker_panel_out"                  // int, writable
+	SimCockpitSwitches_audio_panel_out                  string = "sim/cockpit/switches/audio_panel_out"                   // int, writable
+	SimCockpitSwitches_anti_ice_on                      string = "sim/cockpit/switches/anti_ice_on"                       // int, writable
+	SimCockpitSwitches_anti_ice_inlet_heat              string = "sim/cockpit/switches/anti_ice_inlet_heat"               // int, writable
+	SimCockpitSwitches_anti_ice_inlet_heat_per_enigne   string = "sim/cockpit/switches/anti_ice_inlet_heat_per_enigne"    // int_array, writable
+	SimCockpitSwitches_anti_ice_inlet_heat_per_engine   string = "sim/cockpit/switches/anti_ice_inlet_heat_per_engine"    // int_array, writable
+	SimCockpitSwitches_anti_ice_prop_heat               string = "sim/cockpit/switches/anti_ice_prop_heat"                // int, writable
+	SimCockpitSwitches_anti_ice_prop_heat_per_engine    string = "sim/cockpit/switches/anti_ice_prop_heat_per_engine"     // int_array, writable
+	SimCockpitSwitches_anti_ice_window_heat             string = "sim/cockpit/switches/anti_ice_window_heat"              // int, writable
+	SimCockpitSwitches_pitot_heat_on                    string = "sim/cockpit/switches/pitot_heat_on"                     // int, writable
+	SimCockpitSwitches_pitot_heat_on2                   string = "sim/cockpit/switches/pitot_heat_on2"                    // int, writable
+	SimCockpitSwitches_static_heat_on                   string = "sim/cockpit/switches/static_heat_on"                    // int, writable
+	SimCockpitSwitches_static_heat_on2                  string = "sim/cockpit/switches/static_heat_on2"                   // int, writable
+	SimCockpitSwitches_anti_ice_AOA_heat                string = "sim/cockpit/switches/anti_ice_AOA_heat"                 // int, writable
+	SimCockpitSwitches_anti_ice_AOA_heat2               string = "sim/cockpit/switches/anti_ice_AOA_heat2"                // int, writable
+	SimCockpitSwitches_anti_ice_surf_heat               string = "sim/cockpit/switches/anti_ice_surf_heat"                // int, writable
+	SimCockpitSwitches_anti_ice_surf_heat_left          string = "sim/cockpit/switches/anti_ice_surf_heat_left"           // int, writable
+	SimCockpitSwitches_anti_ice_surf_heat_right         string = "sim/cockpit/switches/anti_ice_surf_heat_right"          // int, writable
+	SimCockpitSwitches_anti_ice_surf_boot               string = "sim/cockpit/switches/anti_ice_surf_boot"                // int, writable
+	SimCockpitSwitches_anti_ice_engine_air              string = "sim/cockpit/switches/anti_ice_engine_air"               // float_array, writable
+	SimCockpitSwitches_anti_ice_auto_ignite             string = "sim/cockpit/switches/anti_ice_auto_ignite"              // int, writable
+	SimCockpitSwitches_ice_detect                       string = "sim/cockpit/switches/ice_detect"                        // int, writable
+	SimCockpitSwitches_auto_brake_settings              string = "sim/cockpit/switches/auto_brake_settings"               // int, writable
+	SimCockpitSwitches_auto_feather_mode                string = "sim/cockpit/switches/auto_feather_mode"                 // int, writable
+	SimCockpitSwitches_yaw_damper_on                    string = "sim/cockpit/switches/yaw_damper_on"                     // int, writable
+	SimCockpitSwitches_art_stab_on                      string = "sim/cockpit/switches/art_stab_on"                       // int, writable
+	SimCockpitSwitches_pre_rotate_level                 string = "sim/cockpit/switches/pre_rotate_level"                  // int, writable
+	SimCockpitSwitches_parachute_on                     string = "sim/cockpit/switches/parachute_on"                      // int, writable
+	SimCockpitSwitches_jato_on                          string = "sim/cockpit/switches/jato_on"                           // int, writable
+	SimCockpitSwitches_prop_sync_on                     string = "sim/cockpit/switches/prop_sync_on"                      // int, writable
+	SimCockpitSwitches_puffers_on                       string = "sim/cockpit/switches/puffers_on"                        // int, writable
+	SimCockpitSwitches_water_scoop                      string = "sim/cockpit/switches/water_scoop"                       // int, writable
+	SimCockpitSwitches_arresting_gear                   string = "sim/cockpit/switches/arresting_gear"                    // int, writable
+	SimCockpitSwitches_canopy_req                       string = "sim/cockpit/switches/canopy_req"                        // int, writable
+	SimCockpitSwitches_dumping_fuel                     string = "sim/cockpit/switches/dumping_fuel"                      // int, writable
+	SimCockpitSwitches_tot_ener_audio                   string = "sim/cockpit/switches/tot_ener_audio"                    // int, writable
+	SimCockpitSwitches_EFIS_map_mode                    string = "sim/cockpit/switches/EFIS_map_mode"                     // int, writable
+	SimCockpitSwitches_EFIS_map_submode                 string = "sim/cockpit/switches/EFIS_map_submode"                  // int, writable
+	SimCockpitSwitches_EFIS_map_range_selector          string = "sim/cockpit/switches/EFIS_map_range_selector"           // int, writable
+	SimCockpitSwitches_ECAM_mode                        string = "sim/cockpit/switches/ECAM_mode"                         // int, writable
+	SimCockpitSwitches_gear_handle_status               string = "sim/cockpit/switches/gear_handle_status"                // int, writable
+	SimCockpitSwitches_EFIFS_shows_weather              string = "sim/cockpit/switches/EFIFS_shows_weather"               // int, writable
+	SimCockpitSwitches_EFIS_shows_weather               string = "sim/cockpit/switches/EFIS_shows_weather"                // int, writable
+	SimCockpitSwitches_EFIS_weather_alpha               string = "sim/cockpit/switches/EFIS_weather_alpha"                // float, writable
+	SimCockpitSwitches_EFIS_shows_tcas                  string = "sim/cockpit/switches/EFIS_shows_tcas"                   // int, writable
+	SimCockpitSwitches_EFIS_shows_airports              string = "sim/cockpit/switches/EFIS_shows_airports"               // int, writable
+	SimCockpitSwitches_EFIS_shows_waypoints             string = "sim/cockpit/switches/EFIS_shows_waypoints"              // int, writable
+	SimCockpitSwitches_EFIS_shows_VORs                  string = "sim/cockpit/switches/EFIS_shows_VORs"                   // int, writable
+	SimCockpitSwitches_EFIS_shows_NDBs                  string = "sim/cockpit/switches/EFIS_shows_NDBs"                   // int, writable
+	SimCockpitSwitches_argus_mode                       string = "sim/cockpit/switches/argus_mode"                        // int, writable
+	SimCockpitSwitches_no_smoking                       string = "sim/cockpit/switches/no_smoking"                        // int, writable
+	SimCockpitSwitches_fasten_seat_belts                string = "sim/cockpit/switches/fasten_seat_belts"                 // int, writable
+	SimCockpitWarnings_master_caution_timeout           string = "sim/cockpit/warnings/master_caution_timeout"            // float, writable
+	SimCockpitWarnings_master_caution_on                string = "sim/cockpit/warnings/master_caution_on"                 // float, writable
+	SimCockpitWarnings_master_warning_on                string = "sim/cockpit/warnings/master_warning_on"                 // float, writable
+	SimCockpitWarnings_master_accept_on                 string = "sim/cockpit/warnings/master_accept_on"                  // float, writable
+	SimCockpitWarnings_annunciator_test_timeout         string = "sim/cockpit/warnings/annunciator_test_timeout"          // float, writable
+	SimCockpitWarnings_annunciator_test_pressed         string = "sim/cockpit/warnings/annunciator_test_pressed"          // int
+	SimCockpitWarnings_autopilot_test_beeping           string = "sim/cockpit/warnings/autopilot_test_beeping"            // int
+	SimCockpitWarnings_autopilot_test_modes_lit         string = "sim/cockpit/warnings/autopilot_test_modes_lit"          // int
+	SimCockpitWarnings_autopilot_test_trim_lit          string = "sim/cockpit/warnings/autopilot_test_trim_lit"           // int
+	SimCockpitWarnings_autopilot_test_ap_lit            string = "sim/cockpit/warnings/autopilot_test_ap_lit"             // int
+	SimCockpitWarningsAnnunciators_master_caution       string = "sim/cockpit/warnings/annunciators/master_caution"       // int, writable
+	SimCockpitWarningsAnnunciators_master_warning       string = "sim/cockpit/warnings/annunciators/master_warning"       // int, writable
+	SimCockpitWarningsAnnunciators_master_accept        string = "sim/cockpit/warnings/annunciators/master_accept"        // int, writable
+	SimCockpitWarningsAnnunciators_autopilot_disconnect string = "sim/cockpit/warnings/annunciators/autopilot_disconnect" // int, writable
+	SimCockpitWarningsAnnunciators_low_vacuum           string = "sim/cockpit/warnings/annunciators/low_vacuum"           // int, writable
+	SimCockpitWarningsAnnunciators_low_voltage          string = "sim/cockpit/warnings/annunciators/low_voltage"          // int, writable
+	SimCockpitWarningsAnnunciators_fuel_quantity        string = "sim/cockpit/warnings/annunciators/fuel_quantity"        // int, writable
+	SimCockpitWarningsAnnunciators_hydraulic_pressure   string = "sim/cockpit/warnings/annunciators/hydraulic_pressure"   // int, writable
+	SimCockpitWarningsAnnunciators_speedbrake           string = "sim/cockpit/warnings/annunciators/speedbrake"           // int, writable
+	SimCockpitWarningsAnnunciators_GPWS                 string = "sim/cockpit/warnings/annunciators/GPWS"                 // int, writable
+	SimCockpitWarningsAnnunciators_ice                  string = "sim/cockpit/warnings/annunciators/ice"                  // int, writable
+	SimCockpitWarningsAnnunciators_lo_rotor             string = "sim/cockpit/warnings/annunciators/lo_rotor"             // int, writable
+	SimCockpitWarningsAnnunciators_hi_rotor             string = "sim/cockpit/warnings/annunciators/hi_rotor"             // int, writable
+	SimCockpitWarningsAnnunciators_pitot_heat_off       string = "sim/cockpit/warnings/annunciators/pitot_heat_off"       // int, writable
+	SimCockpitWarningsAnnunciators_transonic            string = "sim/cockpit/warnings/annunciators/transonic"            // int, writable
+	SimCockpitWarningsAnnunciators_slats                string = "sim/cockpit/warnings/annunciators/slats"                // int, writable
+	SimCockpitWarningsAnnunciators_flight_director      string = "sim/cockpit/warnings/annunciators/flight_director"      // int, writable
+	SimCockpitWarningsAnnunciators_autopilot            string = "sim/cockpit/warnings/annunciators/autopilot"            // int, writable
+	SimCockpitWarningsAnnunciators_yaw_damper           string = "sim/cockpit/warnings/annunciators/yaw_damper"           // int, writable
+	SimCockpitWarningsAnnunciators_fuel_pressure_low    string = "sim/cockpit/warnings/annunciators/fuel_pressure_low"    // int_array, writable
+	SimCockpitWarningsAnnunciators_oil_pressure_low     string = "sim/cockpit/warnings/annunciators/oil_pressure_low"     // int_array, writable
+	SimCockpitWarningsAnnunciators_oil_temperature_high string = "sim/cockpit/warnings/annunciators/oil_temperature_high" // int_array, writable
+	SimCockpitWarningsAnnunciators_generator_off        string = "sim/cockpit/warnings/annunciators/generator_off"        // int_array, writable
+	SimCockpitWarningsAnnunciators_chip_detected        string = "sim/cockpit/warnings/annunciators/chip_detected"        // int_array, writable
+	SimCockpitWarningsAnnunciators_engine_fires         string = "sim/cockpit/warnings/annunciators/engine_fires"         // int_array, writable
+	SimCockpitWarningsAnnunciators_igniter_on           string = "sim/cockpit/warnings/annunciators/igniter_on"           // int_array, writable
+	SimCockpitWarningsAnnunciators_reverser_on          string = "sim/cockpit/warnings/annunciators/reverser_on"          // int_array, writable
+	SimCockpitWarningsAnnunciators_burner_on            string = "sim/cockpit/warnings/annunciators/burner_on"            // int_array, writable
+	SimCockpitWarningsAnnunciators_inverter_off         string = "sim/cockpit/warnings/annunciators/inverter_off"         // int_array, writable
+	SimCockpitWarningsAnnunciators_N1_low               string = "sim/cockpit/warnings/annunciators/N1_low"               // int_array, writable
+	SimCockpitWarningsAnnunciators_N1_high              string = "sim/cockpit/warnings/annunciators/N1_high"              // int_array, writable
+	SimCockpitWarningsAnnunciators_reverser_not_ready   string = "sim/cockpit/warnings/annunciators/reverser_not_ready"   // int_array, writable
+	SimCockpitWarningsAnnunciators_ice_vane_extend      string = "sim/cockpit/warnings/annunciators/ice_vane_extend"      // int_array, writable
+	SimCockpitWarningsAnnunciators_ice_vane_fail        string = "sim/cockpit/warnings/annunciators/ice_vane_fail"        // int_array, writable
+	SimCockpitWarningsAnnunciators_bleed_air_off        string = "sim/cockpit/warnings/annunciators/bleed_air_off"        // int_array, writable
+	SimCockpitWarningsAnnunciators_bleed_air_fail       string = "sim/cockpit/warnings/annunciators/bleed_air_fail"       // int_array, writable
+	SimCockpitWarningsAnnunciators_auto_feather_arm     string = "sim/cockpit/warnings/annunciators/auto_feather_arm"     // int_array, writable
+	SimCockpitWarningsAnnunciators_fuel_transfer        string = "sim/cockpit/warnings/annunciators/fuel_transfer"        // int_array, writable
+	SimCockpitWarningsAnnunciators_hvac                 string = "sim/cockpit/warnings/annunciators/hvac"                 // int, writable
+	SimCockpitWarningsAnnunciators_battery_charge_hi    string = "sim/cockpit/warnings/annunciators/battery_charge_hi"    // int, writable
+	SimCockpitWarningsAnnunciators_cabin_altitude_12500 string = "sim/cockpit/warnings/annunciators/cabin_altitude_12500" // int, writable
+	SimCockpitWarningsAnnunciators_autopilot_trim_fail  string = "sim/cockpit/warnings/annunciators/autopilot_trim_fail"  // int, writable
+	SimCockpitWarningsAnnunciators_electric_trim_off    string = "sim/cockpit/warnings/annunciators/electric_trim_off"    // int, writable
+	SimCockpitWarningsAnnunciators_crossfeed_on         string = "sim/cockpit/warnings/annunciators/crossfeed_on"         // int, writable
+	SimCockpitWarningsAnnunciators_landing_taxi_lite    string = "sim/cockpit/warnings/annunciators/landing_taxi_lite"    // int, writable
+	SimCockpitWarningsAnnunciators_cabin_door_open      string = "sim/cockpit/warnings/annunciators/cabin_door_open"      // int, writable
+	SimCockpitWarningsAnnunciators_external_power_on    string = "sim/cockpit/warnings/annunciators/external_power_on"    // int, writable
+	SimCockpitWarningsAnnunciators_passenger_oxy_on     string = "sim/cockpit/warnings/annunciators/passenger_oxy_on"     // int, writable
+	SimCockpitWarningsAnnunciators_gear_unsafe          string = "sim/cockpit/warnings/annunciators/gear_unsafe"          // int, writable
+	SimCockpitWarningsAnnunciators_autopilot_trim_down  string = "sim/cockpit/warnings/annunciators/autopilot_trim_down"  // int, writable
+	SimCockpitWarningsAnnunciators_autopilot_trim_up    string = "sim/cockpit/warnings/annunciators/autopilot_trim_up"    // int, writable
+	SimCockpitWarningsAnnunciators_autopilot_bank_limit string = "sim/cockpit/warnings/annunciators/autopilot_bank_limit" // int, writable
+	SimCockpitWarningsAnnunciators_autopilot_soft_ride  string = "sim/cockpit/warnings/annunciators/autopilot_soft_ride"  // int, writable
+	SimCockpitWarningsAnnunciators_no_inverters         string = "sim/cockpit/warnings/annunciators/no_inverters"         // int, writable
+	SimCockpitWarningsAnnunciators_glideslope           string = "sim/cockpit/warnings/annunciators/glideslope"           // int
+	SimCockpitWarningsAnnunciators_fuel_pressure        string = "sim/cockpit/warnings/annunciators/fuel_pressure"        // int, writable
+	SimCockpitWarningsAnnunciators_oil_pressure         string = "sim/cockpit/warnings/annunciators/oil_pressure"         // int, writable
+	SimCockpitWarningsAnnunciators_oil_temperature      string = "sim/cockpit/warnings/annunciators/oil_temperature"      // int, writable
+	SimCockpitWarningsAnnunciators_generator            string = "sim/cockpit/warnings/annunciators/generator"            // int, writable
+	SimCockpitWarningsAnnunciators_chip_detect          string = "sim/cockpit/warnings/annunciators/chip_detect"          // int, writable
+	SimCockpitWarningsAnnunciators_engine_fire          string = "sim/cockpit/warnings/annunciators/engine_fire"          // int, writable
+	SimCockpitWarningsAnnunciators_auto_ignition        string = "sim/cockpit/warnings/annunciators/auto_ignition"        // int, writable
+	SimCockpitWarningsAnnunciators_reverse              string = "sim/cockpit/warnings/annunciators/reverse"              // int, writable
+	SimCockpitWarningsAnnunciators_afterburners_on      string = "sim/cockpit/warnings/annunciators/afterburners_on"      // int, writable
+	SimCockpitWarningsAnnunciators_inverter             string = "sim/cockpit/warnings/annunciators/inverter"             // int, writable
+	SimCockpitWeapons_guns_armed                        string = "sim/cockpit/weapons/guns_armed"                         // int, writable
+	SimCockpitWeapons_rockets_armed                     string = "sim/cockpit/weapons/rockets_armed"                      // int, writable
+	SimCockpitWeapons_missiles_armed                    string = "sim/cockpit/weapons/missiles_armed"                     // int, writable
+	SimCockpitWeapons_bombs_armed                       string = "sim/cockpit/weapons/bombs_armed"                        // int, writable
+	SimCockpitWeapons_firing_mode                       string = "sim/cockpit/weapons/firing_mode"                        // int, writable
+	SimCockpitWeapons_firing_rate                       string = "sim/cockpit/weapons/firing_rate"                        // int, writable
+	SimCockpitWeapons_plane_target_index                string = "sim/cockpit/weapons/plane_target_index"                 // int, writable
+	SimCockpitWeapons_chaff_now                         string = "sim/cockpit/weapons/chaff_now"                          // int
+	SimCockpitWeapons_flare_now                         string = "sim/cockpit/weapons/flare_now"                          // int
+	SimCockpitWeapons_wpn_sel_console                   string = "sim/cockpit/weapons/wpn_sel_console"                    // int, writable
+	SimCockpitWeapons_incoming_missile_lock             string = "sim/cockpit/weapons/incoming_missile_lock"              // int, writable
+)
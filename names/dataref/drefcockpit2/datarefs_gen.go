@@ -0,0 +1,1462 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefcockpit2 provides the known dataref names under sim/cockpit2/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefcockpit2
+
+const (
+	SimCockpit2Annunciators_master_caution                                 string = "sim/cockpit2/annunciators/master_caution"                                  // int
+	SimCockpit2Annunciators_master_warning                                 string = "sim/cockpit2/annunciators/master_warning"                                  // int
+	SimCockpit2Annunciators_master_accept                                  string = "sim/cockpit2/annunciators/master_accept"                                   // int
+	SimCockpit2Annunciators_autopilot_disconnect                           string = "sim/cockpit2/annunciators/autopilot_disconnect"                            // int
+	SimCockpit2Annunciators_low_vacuum                                     string = "sim/cockpit2/annunciators/low_vacuum"                                      // int
+	SimCockpit2Annunciators_low_voltage                                    string = "sim/cockpit2/annunciators/low_voltage"                                     // int
+	SimCockpit2Annunciators_fuel_quantity                                  string = "sim/cockpit2/annunciators/fuel_quantity"                                   // int
+	SimCockpit2Annunciators_hydraulic_pressure                             string = "sim/cockpit2/annunciators/hydraulic_pressure"                              // int
+	SimCockpit2Annunciators_speedbrake                                     string = "sim/cockpit2/annunciators/speedbrake"                                      // int
+	SimCockpit2Annunciators_GPWS                                           string = "sim/cockpit2/annunciators/GPWS"                                            // int
+	SimCockpit2Annunciators_ice                                            string = "sim/cockpit2/annunciators/ice"                                             // int
+	SimCockpit2Annunciators_low_rotor                                      string = "sim/cockpit2/annunciators/low_rotor"                                       // int
+	SimCockpit2Annunciators_high_rotor                                     string = "sim/cockpit2/annunciators/high_rotor"                                      // int
+	SimCockpit2Annunciators_pitot_heat                                     string = "sim/cockpit2/annunciators/pitot_heat"                                      // int
+	SimCockpit2Annunciators_transonic                                      string = "sim/cockpit2/annunciators/transonic"                                       // int
+	SimCockpit2Annunciators_slats                                          string = "sim/cockpit2/annunciators/slats"                                           // int
+	SimCockpit2Annunciators_flight_director                                string = "sim/cockpit2/annunciators/flight_director"                                 // int
+	SimCockpit2Annunciators_autopilot                                      string = "sim/cockpit2/annunciators/autopilot"                                       // int
+	SimCockpit2Annunciators_yaw_damper                                     string = "sim/cockpit2/annunciators/yaw_damper"                                      // int
+	SimCockpit2Annunciators_fuel_pressure_low                              string = "sim/cockpit2/annunciators/fuel_pressure_low"                               // int_array
+	SimCockpit2Annunciators_oil_pressure_low                               string = "sim/cockpit2/annunciators/oil_pressure_low"                                // int_array
+	SimCockpit2Annunciators_oil_temperature_high                           string = "sim/cockpit2/annunciators/oil_temperature_high"                            // int_array
+	SimCockpit2Annunciators_generator_off                                  string = "sim/cockpit2/annunciators/generator_off"                                   // int_array
+	SimCockpit2Annunciators_chip_detected                                  string = "sim/cockpit2/annunciators/chip_detected"                                   // int_array
+	SimCockpit2Annunciators_engine_fires                                   string = "sim/cockpit2/annunciators/engine_fires"                                    // int_array
+	SimCockpit2Annunciators_igniter_on                                     string = "sim/cockpit2/annunciators/igniter_on"                                      // int_array
+	SimCockpit2Annunciators_reverser_on                                    string = "sim/cockpit2/annunciators/reverser_on"                                     // int_array
+	SimCockpit2Annunciators_beta                                           string = "sim/cockpit2/annunciators/beta"                                            // int_array
+	SimCockpit2Annunciators_burner_on                                      string = "sim/cockpit2/annunciators/burner_on"                                       // int_array
+	SimCockpit2Annunciators_inverter_off                                   string = "sim/cockpit2/annunciators/inverter_off"                                    // int_array
+	SimCockpit2Annunciators_N1_low                                         string = "sim/cockpit2/annunciators/N1_low"                                          // int_array
+	SimCockpit2Annunciators_N1_high                                        string = "sim/cockpit2/annunciators/N1_high"                                         // int_array
+	SimCockpit2Annunciators_reverser_not_ready                             string = "sim/cockpit2/annunciators/reverser_not_ready"                              // int_array
+	SimCockpit2Annunciators_ice_vane_extend                                string = "sim/cockpit2/annunciators/ice_vane_extend"                                 // int_array
+	SimCockpit2Annunciators_ice_vane_fail                                  string = "sim/cockpit2/annunciators/ice_vane_fail"                                   // int_array
+	SimCockpit2Annunciators_bleed_air_off                                  string = "sim/cockpit2/annunciators/bleed_air_off"                                   // int_array
+	SimCockpit2Annunciators_bleed_air_fail                                 string = "sim/cockpit2/annunciators/bleed_air_fail"                                  // int_array
+	SimCockpit2Annunciators_auto_feather_arm                               string = "sim/cockpit2/annunciators/auto_feather_arm"                                // int_array
+	SimCockpit2Annunciators_fuel_transfer                                  string = "sim/cockpit2/annunciators/fuel_transfer"                                   // int_array
+	SimCockpit2Annunciators_hvac                                           string = "sim/cockpit2/annunciators/hvac"                                            // int
+	SimCockpit2Annunciators_battery_charge_hi                              string = "sim/cockpit2/annunciators/battery_charge_hi"                               // int
+	SimCockpit2Annunciators_cabin_altitude_12500                           string = "sim/cockpit2/annunciators/cabin_altitude_12500"                            // int
+	SimCockpit2Annunciators_autopilot_trim_fail                            string = "sim/cockpit2/annunciators/autopilot_trim_fail"                             // int
+	SimCockpit2Annunciators_electric_trim_off                              string = "sim/cockpit2/annunciators/electric_trim_off"                               // int
+	SimCockpit2Annunciators_crossfeed_on                                   string = "sim/cockpit2/annunciators/crossfeed_on"                                    // int
+	SimCockpit2Annunciators_landing_taxi_lite                              string = "sim/cockpit2/annunciators/landing_taxi_lite"                               // int
+	SimCockpit2Annunciators_cabin_door_open                                string = "sim/cockpit2/annunciators/cabin_door_open"                                 // int
+	SimCockpit2Annunciators_external_power_on                              string = "sim/cockpit2/annunciators/external_power_on"                               // int
+	SimCockpit2Annunciators_passenger_oxy_on                               string = "sim/cockpit2/annunciators/passenger_oxy_on"                                // int
+	SimCockpit2Annunciators_gear_unsafe                                    string = "sim/cockpit2/annunciators/gear_unsafe"                                     // int
+	SimCockpit2Annunciators_autopilot_trim_down                            string = "sim/cockpit2/annunciators/autopilot_trim_down"                             // int
+	SimCockpit2Annunciators_autopilot_trim_up                              string = "sim/cockpit2/annunciators/autopilot_trim_up"                               // int
+	SimCockpit2Annunciators_autopilot_bank_limit                           string = "sim/cockpit2/annunciators/autopilot_bank_limit"                            // int
+	SimCockpit2Annunciators_autopilot_soft_ride                            string = "sim/cockpit2/annunciators/autopilot_soft_ride"                             // int
+	SimCockpit2Annunciators_no_inverters                                   string = "sim/cockpit2/annunciators/no_inverters"                                    // int
+	SimCockpit2Annunciators_fuel_pressure                                  string = "sim/cockpit2/annunciators/fuel_pressure"                                   // int
+	SimCockpit2Annunciators_oil_pressure                                   string = "sim/cockpit2/annunciators/oil_pressure"                                    // int
+	SimCockpit2Annunciators_oil_temperature                                string = "sim/cockpit2/annunciators/oil_temperature"                                 // int
+	SimCockpit2Annunciators_generator                                      string = "sim/cockpit2/annunciators/generator"                                       // int
+	SimCockpit2Annunciators_chip_detect                                    string = "sim/cockpit2/annunciators/chip_detect"                                     // int
+	SimCockpit2Annunciators_engine_fire                                    string = "sim/cockpit2/annunciators/engine_fire"                                     // int
+	SimCockpit2Annunciators_reverser_deployed                              string = "sim/cockpit2/annunciators/reverser_deployed"                               // int
+	SimCockpit2Annunciators_prop_beta                                      string = "sim/cockpit2/annunciators/prop_beta"                                       // int
+	SimCockpit2Annunciators_afterburner                                    string = "sim/cockpit2/annunciators/afterburner"                                     // int
+	SimCockpit2Annunciators_inverter                                       string = "sim/cockpit2/annunciators/inverter"                                        // int
+	SimCockpit2Annunciators_stall_warning                                  string = "sim/cockpit2/annunciators/stall_warning"                                   // int
+	SimCockpit2Annunciators_stall_warning_ratio                            string = "sim/cockpit2/annunciators/stall_warning_ratio"                             // float, writable
+	SimCockpit2Annunciators_gear_warning                                   string = "sim/cockpit2/annunciators/gear_warning"                                    // int
+	SimCockpit2Annunciators_gear_warning_aural                             string = "sim/cockpit2/annunciators/gear_warning_aural"                              // int
+	SimCockpit2Annunciators_no_smoking                                     string = "sim/cockpit2/annunciators/no_smoking"                                      // int
+	SimCockpit2Annunciators_fasten_seatbelt                                string = "sim/cockpit2/annunciators/fasten_seatbelt"                                 // int
+	SimCockpit2Annunciators_windshear_warning                              string = "sim/cockpit2/annunciators/windshear_warning"                               // int
+	SimCockpit2Annunciators_plugin_master_warning                          string = "sim/cockpit2/annunciators/plugin_master_warning"                           // int, writable
+	SimCockpit2Annunciators_plugin_master_caution                          string = "sim/cockpit2/annunciators/plugin_master_caution"                           // int, writable
+	SimCockpit2Autopilot_autopilot2_avail                                  string = "sim/cockpit2/autopilot/autopilot2_avail"                                   // int
+	SimCockpit2Autopilot_master_flight_director                            string = "sim/cockpit2/autopilot/master_flight_director"                             // int, writable
+	SimCockpit2Autopilot_flight_director_command_bars_pilot                string = "sim/cockpit2/autopilot/flight_director_command_bars_pilot"                 // int, writable
+	SimCockpit2Autopilot_flight_director_command_bars_copilot              string = "sim/cockpit2/autopilot/flight_director_command_bars_copilot"               // int, writable
+	SimCockpit2Autopilot_flight_director_master_pilot                      string = "sim/cockpit2/autopilot/flight_director_master_pilot"                       // int
+	SimCockpit2Autopilot_flight_director_master_copilot                    string = "sim/cockpit2/autopilot/flight_director_master_copilot"                     // int
+	SimCockpit2Autopilot_autopilot_electric_master                         string = "sim/cockpit2/autopilot/autopilot_electric_master"                          // int, writable
+	SimCockpit2Autopilot_autopilot_source                                  string = "sim/cockpit2/autopilot/autopilot_source"                                   // int, writable
+	SimCockpit2Autopilot_autothrottle_enabled                              string = "sim/cockpit2/autopilot/autothrottle_enabled"                               // int, writable
+	SimCockpit2Autopilot_autothrottle_on                                   string = "sim/cockpit2/autopilot/autothrottle_on"                                    // int
+	SimCockpit2Autopilot_autothrottle_arm                                  string = "sim/cockpit2/autopilot/autothrottle_arm"                                   // int
+	SimCockpit2Autopilot_electric_trim_on                                  string = "sim/cockpit2/autopilot/electric_trim_on"                                   // int, writable
+	SimCockpit2Autopilot_pitch_mistrim                                     string = "sim/cockpit2/autopilot/pitch_mistrim"                                      // int, writable
+	SimCockpit2Autopilot_otto_fail_warn                                    string = "sim/cockpit2/autopilot/otto_fail_warn"                                     // int, writable
+	SimCockpit2Autopilot_otto_ready                                        string = "sim/cockpit2/autopilot/otto_ready"                                         // int
+	SimCockpit2Autopilot_downgrade                                         string = "sim/cockpit2/autopilot/downgrade"                                          // int
+	SimCockpit2Autopilot_autopilot_disconnect                              string = "sim/cockpit2/autopilot/autopilot_disconnect"                               // int
+	SimCockpit2Autopilot_autopilot_trim_fail                               string = "sim/cockpit2/autopilot/autopilot_trim_fail"                                // int
+	SimCockpit2Autopilot_heading_mode                                      string = "sim/cockpit2/autopilot/heading_mode"                                       // int, writable
+	SimCockpit2Autopilot_altitude_mode                                     string = "sim/cockpit2/autopilot/altitude_mode"                                      // int, writable
+	SimCockpit2Autopilot_bank_angle_mode                                   string = "sim/cockpit2/autopilot/bank_angle_mode"                                    // int, writable
+	SimCockpit2Autopilot_bank_angles_values                                string = "sim/cockpit2/autopilot/bank_angles_values"                                 // float_array, writable
+	SimCockpit2Autopilot_flight_director_mode                              string = "sim/cockpit2/autopilot/flight_director_mode"                               // int, writable
+	SimCockpit2Autopilot_flight_director2_mode                             string = "sim/cockpit2/autopilot/flight_director2_mode"                              // int, writable
+	SimCockpit2Autopilot_flight_director3_mode                             string = "sim/cockpit2/autopilot/flight_director3_mode"                              // int, writable
+	SimCockpit2Autopilot_autopilot_has_power                               string = "sim/cockpit2/autopilot/autopilot_has_power"                                // int
+	SimCockpit2Autopilot_autopilot_on                                      string = "sim/cockpit2/autopilot/autopilot_on"                                       // int
+	SimCockpit2Autopilot_autopilot2_on                                     string = "sim/cockpit2/autopilot/autopilot2_on"                                      // int
+	SimCockpit2Autopilot_autopilot3_on                                     string = "sim/cockpit2/autopilot/autopilot3_on"                                      // int
+	SimCockpit2Autopilot_autopilot_on_or_cws                               string = "sim/cockpit2/autopilot/autopilot_on_or_cws"                                // int
+	SimCockpit2Autopilot_autopilot2_on_or_cws                              string = "sim/cockpit2/autopilot/autopilot2_on_or_cws"                               // int
+	SimCockpit2Autopilot_autopilot3_on_or_cws                              string = "sim/cockpit2/autopilot/autopilot3_on_or_cws"                               // int
+	SimCockpit2Autopilot_servos_on                                         string = "sim/cockpit2/autopilot/servos_on"                                          // int
+	SimCockpit2Autopilot_servos2_on                                        string = "sim/cockpit2/autopilot/servos2_on"                                         // int
+	SimCockpit2Autopilot_servos3_on                                        string = "sim/cockpit2/autopilot/servos3_on"                                         // int
+	SimCockpit2Autopilot_airspeed_is_mach                                  string = "sim/cockpit2/autopilot/airspeed_is_mach"                                   // int, writable
+	SimCockpit2Autopilot_alt_vvi_is_showing_vvi                            string = "sim/cockpit2/autopilot/alt_vvi_is_showing_vvi"                             // int, writable
+	SimCockpit2Autopilot_vnav_armed                                        string = "sim/cockpit2/autopilot/vnav_armed"                                         // int
+	SimCockpit2Autopilot_vnav_spd_armed                                    string = "sim/cockpit2/autopilot/vnav_spd_armed"                                     // int
+	SimCockpit2Autopilot_altitude_hold_armed                               string = "sim/cockpit2/autopilot/altitude_hold_armed"                                // int
+	SimCockpit2Autopilot_hnav_armed                                        string = "sim/cockpit2/autopilot/hnav_armed"                                         // int
+	SimCockpit2Autopilot_glideslope_armed                                  string = "sim/cockpit2/autopilot/glideslope_armed"                                   // int
+	SimCockpit2Autopilot_backcourse_on                                     string = "sim/cockpit2/autopilot/backcourse_on"                                      // int
+	SimCockpit2Autopilot_airspeed_dial_kts_mach                            string = "sim/cockpit2/autopilot/airspeed_dial_kts_mach"                             // float, writable
+	SimCockpit2Autopilot_airspeed_dial_kts                                 string = "sim/cockpit2/autopilot/airspeed_dial_kts"                                  // float, writable
+	SimCockpit2Autopilot_heading_dial_deg_mag_pilot                        string = "sim/cockpit2/autopilot/heading_dial_deg_mag_pilot"                         // float, writable
+	SimCockpit2Autopilot_heading_dial_deg_mag_copilot                      string = "sim/cockpit2/autopilot/heading_dial_deg_mag_copilot"                       // float, writable
+	SimCockpit2Autopilot_heading_is_gpss                                   string = "sim/cockpit2/autopilot/heading_is_gpss"                                    // int, writable
+	SimCockpit2Autopilot_trk_fpa                                           string = "sim/cockpit2/autopilot/trk_fpa"                                            // int, writable
+	SimCockpit2Autopilot_vvi_dial_fpm                                      string = "sim/cockpit2/autopilot/vvi_dial_fpm"                                       // float, writable
+	SimCockpit2Autopilot_fpa                                               string = "sim/cockpit2/autopilot/fpa"                                                // float, writable
+	SimCockpit2Autopilot_altitude_dial_ft                                  string = "sim/cockpit2/autopilot/altitude_dial_ft"                                   // float, writable
+	SimCockpit2Autopilot_altitude_hold_ft                                  string = "sim/cockpit2/autopilot/altitude_hold_ft"                                   // float
+	SimCockpit2Autopilot_altitude_vnav_ft                                  string = "sim/cockpit2/autopilot/altitude_vnav_ft"                                   // float
+	SimCockpit2Autopilot_barometer_setting_in_hg_alt_preselector           string = "sim/cockpit2/autopilot/barometer_setting_in_hg_alt_preselector"            // float, writable
+	SimCockpit2Autopilot_altitude_readout_preselector                      string = "sim/cockpit2/autopilot/altitude_readout_preselector"                       // float, writable
+	SimCockpit2Autopilot_climb_adjust                                      string = "sim/cockpit2/autopilot/climb_adjust"                                       // float, writable
+	SimCockpit2Autopilot_des_adjust                                        string = "sim/cockpit2/autopilot/des_adjust"                                         // float, writable
+	SimCockpit2Autopilot_sync_hold_pitch_deg                               string = "sim/cockpit2/autopilot/sync_hold_pitch_deg"                                // float, writable
+	SimCockpit2Autopilot_sync_hold_roll_deg                                string = "sim/cockpit2/autopilot/sync_hold_roll_deg"                                 // float, writable
+	SimCockpit2Autopilot_set_roll_deg                                      string = "sim/cockpit2/autopilot/set_roll_deg"                                       // float, writable
+	SimCockpit2Autopilot_turn_rate_deg_sec                                 string = "sim/cockpit2/autopilot/turn_rate_deg_sec"                                  // float, writable
+	SimCockpit2Autopilot_flight_director_pitch_deg                         string = "sim/cockpit2/autopilot/flight_director_pitch_deg"                          // float
+	SimCockpit2Autopilot_flight_director_roll_deg                          string = "sim/cockpit2/autopilot/flight_director_roll_deg"                           // float
+	SimCockpit2Autopilot_flight_director2_pitch_deg                        string = "sim/cockpit2/autopilot/flight_director2_pitch_deg"                         // float
+	SimCockpit2Autopilot_flight_director2_roll_deg                         string = "sim/cockpit2/autopilot/flight_director2_roll_deg"                          // float
+	SimCockpit2Autopilot_TOGA_pitch_deg                                    string = "sim/cockpit2/autopilot/TOGA_pitch_deg"                                     // float, writable
+	SimCockpit2Autopilot_roll_status                                       string = "sim/cockpit2/autopilot/roll_status"                                        // int
+	SimCockpit2Autopilot_attitude_status                                   string = "sim/cockpit2/autopilot/attitude_status"                                    // int
+	SimCockpit2Autopilot_rate_status                                       string = "sim/cockpit2/autopilot/rate_status"                                        // int
+	SimCockpit2Autopilot_heading_status                                    string = "sim/cockpit2/autopilot/heading_status"                                     // int
+	SimCockpit2Autopilot_heading_hold_status                               string = "sim/cockpit2/autopilot/heading_hold_status"                                // int
+	SimCockpit2Autopilot_track_status                                      string = "sim/cockpit2/autopilot/track_status"                                       // int
+	SimCockpit2Autopilot_runway_status                                     string = "sim/cockpit2/autopilot/runway_status"                                      // int
+	SimCockpit2Autopilot_runway_track_status                               string = "sim/cockpit2/autopilot/runway_track_status"                                // int
+	SimCockpit2Autopilot_nav_status                                        string = "sim/cockpit2/autopilot/nav_status"                                         // int
+	SimCockpit2Autopilot_gpss_status                                       string = "sim/cockpit2/autopilot/gpss_status"                                        // int
+	SimCockpit2Autopilot_rollout_status                                    string = "sim/cockpit2/autopilot/rollout_status"                                     // int
+	SimCockpit2Autopilot_flare_status                                      string = "sim/cockpit2/autopilot/flare_status"                                       // int
+	SimCockpit2Autopilot_backcourse_status                                 string = "sim/cockpit2/autopilot/backcourse_status"                                  // int
+	SimCockpit2Autopilot_TOGA_lateral_status                               string = "sim/cockpit2/autopilot/TOGA_lateral_status"                                // int
+	SimCockpit2Autopilot_pitch_status                                      string = "sim/cockpit2/autopilot/pitch_status"                                       // int
+	SimCockpit2Autopilot_vvi_status                                        string = "sim/cockpit2/autopilot/vvi_status"                                         // int
+	SimCockpit2Autopilot_fpa_status                                        string = "sim/cockpit2/autopilot/fpa_status"                                         // int
+	SimCockpit2Autopilot_speed_status                                      string = "sim/cockpit2/autopilot/speed_status"                                       // int
+	SimCockpit2Autopilot_altitude_hold_status                              string = "sim/cockpit2/autopilot/altitude_hold_status"                               // int
+	SimCockpit2Autopilot_glideslope_status                                 string = "sim/cockpit2/autopilot/glideslope_status"                                  // int
+	SimCockpit2Autopilot_vnav_status                                       string = "sim/cockpit2/autopilot/vnav_status"                                        // int
+	SimCockpit2Autopilot_vnav_speed_status                                 string = "sim/cockpit2/autopilot/vnav_speed_status"                                  // int
+	SimCockpit2Autopilot_fms_vnav                                          string = "sim/cockpit2/autopilot/fms_vnav"                                           // int, writable
+	SimCockpit2Autopilot_TOGA_status                                       string = "sim/cockpit2/autopilot/TOGA_status"                                        // int
+	SimCockpit2Autopilot_approach_status                                   string = "sim/cockpit2/autopilot/approach_status"                                    // int
+	SimCockpit2Autopilot_dead_reckoning                                    string = "sim/cockpit2/autopilot/dead_reckoning"                                     // int
+	SimCockpit2Autopilot_alt_hold_is_alt_sel_any                           string = "sim/cockpit2/autopilot/alt_hold_is_alt_sel_any"                            // int
+	SimCockpit2Autopilot_alts_armed                                        string = "sim/cockpit2/autopilot/alts_armed"                                         // int
+	SimCockpit2Autopilot_altv_armed                                        string = "sim/cockpit2/autopilot/altv_armed"                                         // int
+	SimCockpit2Autopilot_alts_captured                                     string = "sim/cockpit2/autopilot/alts_captured"                                      // int
+	SimCockpit2Autopilot_altv_captured                                     string = "sim/cockpit2/autopilot/altv_captured"                                      // int
+	SimCockpit2Autopilot_vnav_speed_window_open                            string = "sim/cockpit2/autopilot/vnav_speed_window_open"                             // int
+	SimCockpit2Autopilot_vnav_speed_armed                                  string = "sim/cockpit2/autopilot/vnav_speed_armed"                                   // int
+	SimCockpit2Autopilot_ap_ref_waiting                                    string = "sim/cockpit2/autopilot/ap_ref_waiting"                                     // int
+	SimCockpit2Autopilot_vnav_descent_speed_range                          string = "sim/cockpit2/autopilot/vnav_descent_speed_range"                           // int
+	SimCockpit2Autopilot_st55_hdg                                          string = "sim/cockpit2/autopilot/st55_hdg"                                           // int
+	SimCockpit2Autopilot_st55_rdy                                          string = "sim/cockpit2/autopilot/st55_rdy"                                           // int
+	SimCockpit2Autopilot_st55_nav                                          string = "sim/cockpit2/autopilot/st55_nav"                                           // int
+	SimCockpit2Autopilot_st55_cws                                          string = "sim/cockpit2/autopilot/st55_cws"                                           // int
+	SimCockpit2Autopilot_st55_apr                                          string = "sim/cockpit2/autopilot/st55_apr"                                           // int
+	SimCockpit2Autopilot_st55_fail                                         string = "sim/cockpit2/autopilot/st55_fail"                                          // int
+	SimCockpit2Autopilot_st55_gpss                                         string = "sim/cockpit2/autopilot/st55_gpss"                                          // int
+	SimCockpit2Autopilot_st55_rev                                          string = "sim/cockpit2/autopilot/st55_rev"                                           // int
+	SimCockpit2Autopilot_st55_trim                                         string = "sim/cockpit2/autopilot/st55_trim"                                          // int
+	SimCockpit2Autopilot_st55_alt                                          string = "sim/cockpit2/autopilot/st55_alt"                                           // int
+	SimCockpit2Autopilot_st55_gs                                           string = "sim/cockpit2/autopilot/st55_gs"                                            // int
+	SimCockpit2Autopilot_st55_vs                                           string = "sim/cockpit2/autopilot/st55_vs"                                            // int
+	SimCockpit2Autopilot_st360_display                                     string = "sim/cockpit2/autopilot/st360_display"                                      // data
+	SimCockpit2Autopilot_st360_tenths                                      string = "sim/cockpit2/autopilot/st360_tenths"                                       // int
+	SimCockpit2Autopilot_st360_ent                                         string = "sim/cockpit2/autopilot/st360_ent"                                          // int
+	SimCockpit2Autopilot_st360_alt                                         string = "sim/cockpit2/autopilot/st360_alt"                                          // int
+	SimCockpit2Autopilot_st360_sel                                         string = "sim/cockpit2/autopilot/st360_sel"                                          // int
+	SimCockpit2Autopilot_st360_alr                                         string = "sim/cockpit2/autopilot/st360_alr"                                          // int
+	SimCockpit2Autopilot_st360_dh                                          string = "sim/cockpit2/autopilot/st360_dh"                                           // int
+	SimCockpit2Autopilot_st360_vs                                          string = "sim/cockpit2/autopilot/st360_vs"                                           // int
+	SimCockpit2Autopilot_st360_baro                                        string = "sim/cockpit2/autopilot/st360_baro"                                         // int
+	SimCockpit2Camera_camera_offset_pitch                                  string = "sim/cockpit2/camera/camera_offset_pitch"                                   // float, writable
+	SimCockpit2Camera_camera_offset_heading                                string = "sim/cockpit2/camera/camera_offset_heading"                                 // float, writable
+	SimCockpit2Camera_camera_offset_roll                                   string = "sim/cockpit2/camera/camera_offset_roll"                                    // float, writable
+	SimCockpit2Camera_camera_offset_X                                      string = "sim/cockpit2/camera/camera_offset_X"                                       // float, writable
+	SimCockpit2Camera_camera_offset_Y                                      string = "sim/cockpit2/camera/camera_offset_Y"                                       // float, writable
+	SimCockpit2Camera_camera_offset_Z                                      string = "sim/cockpit2/camera/camera_offset_Z"                                       // float, writable
+	SimCockpit2Camera_camera_field_of_view                                 string = "sim/cockpit2/camera/camera_field_of_view"                                  // float, writable
+	SimCockpit2ClockTimer_elapsed_time_hours                               string = "sim/cockpit2/clock_timer/elapsed_time_hours"                               // int
+	SimCockpit2ClockTimer_elapsed_time_minutes                             string = "sim/cockpit2/clock_timer/elapsed_time_minutes"                             // int
+	SimCockpit2ClockTimer_elapsed_time_seconds                             string = "sim/cockpit2/clock_timer/elapsed_time_seconds"                             // int
+	SimCockpit2ClockTimer_zulu_time_hours                                  string = "sim/cockpit2/clock_timer/zulu_time_hours"                                  // int
+	SimCockpit2ClockTimer_zulu_time_minutes                                string = "sim/cockpit2/clock_timer/zulu_time_minutes"                                // int
+	SimCockpit2ClockTimer_zulu_time_seconds                                string = "sim/cockpit2/clock_timer/zulu_time_seconds"                                // int
+	SimCockpit2ClockTimer_local_time_hours                                 string = "sim/cockpit2/clock_timer/local_time_hours"                                 // int
+	SimCockpit2ClockTimer_local_time_minutes                               string = "sim/cockpit2/clock_timer/local_time_minutes"                               // int
+	SimCockpit2ClockTimer_local_time_seconds                               string = "sim/cockpit2/clock_timer/local_time_seconds"                               // int
+	SimCockpit2ClockTimer_hobbs_time_hours                                 string = "sim/cockpit2/clock_timer/hobbs_time_hours"                                 // int
+	SimCockpit2ClockTimer_hobbs_time_minutes                               string = "sim/cockpit2/clock_timer/hobbs_time_minutes"                               // int
+	SimCockpit2ClockTimer_hobbs_time_seconds                               string = "sim/cockpit2/clock_timer/hobbs_time_seconds"                               // int
+	SimCockpit2ClockTimer_timer_running                                    string = "sim/cockpit2/clock_timer/timer_running"                                    // int
+	SimCockpit2ClockTimer_timer_is_GMT                                     string = "sim/cockpit2/clock_timer/timer_is_GMT"                                     // int
+	SimCockpit2ClockTimer_date_is_showing                                  string = "sim/cockpit2/clock_timer/date_is_showing"                                  // int
+	SimCockpit2ClockTimer_current_day                                      string = "sim/cockpit2/clock_timer/current_day"                                      // int
+	SimCockpit2ClockTimer_current_month                                    string = "sim/cockpit2/clock_timer/current_month"                                    // int
+	SimCockpit2ClockTimer_timer_mode                                       string = "sim/cockpit2/clock_timer/timer_mode"                                       // int, writable
+	SimCockpit2ClockTimer_chrono_time                                      string = "sim/cockpit2/clock_timer/chrono_time"                                      // float_array, writable
+	SimCockpit2ClockTimer_elapsed_time                                     string = "sim/cockpit2/clock_timer/elapsed_time"                                     // float_array, writable
+	SimCockpit2ClockTimer_chrono_running                                   string = "sim/cockpit2/clock_timer/chrono_running"                                   // int_array, writable
+	SimCockpit2ClockTimer_elapsed_running                                  string = "sim/cockpit2/clock_timer/elapsed_running"                                  // int_array, writable
+	SimCockpit2ClockTimer_chrono_timer_minutes                             string = "sim/cockpit2/clock_timer/chrono_timer_minutes"                             // int_array
+	SimCockpit2ClockTimer_chrono_timer_seconds                             string = "sim/cockpit2/clock_timer/chrono_timer_seconds"                             // int_array
+	SimCockpit2ClockTimer_elapsed_timer_hours                              string = "sim/cockpit2/clock_timer/elapsed_timer_hours"                              // int_array
+	SimCockpit2ClockTimer_elapsed_timer_minutes                            string = "sim/cockpit2/clock_timer/elapsed_timer_minutes"                            // int_array
+	SimCockpit2ClockTimer_elapsed_timer_seconds                            string = "sim/cockpit2/clock_timer/elapsed_timer_seconds"                            // int_array
+	SimCockpit2Controls_yoke_pitch_ratio                                   string = "sim/cockpit2/controls/yoke_pitch_ratio"                                    // float, writable
+	SimCockpit2Controls_yoke_roll_ratio                                    string = "sim/cockpit2/controls/yoke_roll_ratio"                                     // float, writable
+	SimCockpit2Controls_yoke_heading_ratio                                 string = "sim/cockpit2/controls/yoke_heading_ratio"                                  // float, writable
+	SimCockpit2Controls_yoke_pitch_ratio_copilot                           string = "sim/cockpit2/controls/yoke_pitch_ratio_copilot"                            // float, writable
+	SimCockpit2Controls_yoke_roll_ratio_copilot                            string = "sim/cockpit2/controls/yoke_roll_ratio_copilot"                             // float, writable
+	SimCockpit2Controls_yoke_heading_ratio_copilot                         string = "sim/cockpit2/controls/yoke_heading_ratio_copilot"                          // float, writable
+	SimCockpit2Controls_total_heading_ratio                                string = "sim/cockpit2/controls/total_heading_ratio"                                 // float, writable
+	SimCockpit2Controls_total_pitch_ratio                                  string = "sim/cockpit2/controls/total_pitch_ratio"                                   // float, writable
+	SimCockpit2Controls_total_roll_ratio                                   string = "sim/cockpit2/controls/total_roll_ratio"                                    // float, writable
+	SimCockpit2Controls_total_pitch_ratio_copilot                          string = "sim/cockpit2/controls/total_pitch_ratio_copilot"                           // float, writable
+	SimCockpit2Controls_total_roll_ratio_copilot                           string = "sim/cockpit2/controls/total_roll_ratio_copilot"                            // float, writable
+	SimCockpit2Controls_torque_tube_split_roll                             string = "sim/cockpit2/controls/torque_tube_split_roll"                              // int, writable
+	SimCockpit2Controls_torque_tube_split_pitch                            string = "sim/cockpit2/controls/torque_tube_split_pitch"                             // int, writable
+	SimCockpit2Controls_roll_spoiler_actuation                             string = "sim/cockpit2/controls/roll_spoiler_actuation"                              // int, writable
+	SimCockpit2Controls_speedbrake_ratio                                   string = "sim/cockpit2/controls/speedbrake_ratio"                                    // float, writable
+	SimCockpit2Controls_wingsweep_ratio                                    string = "sim/cockpit2/controls/wingsweep_ratio"                                     // float, writable
+	SimCockpit2Controls_thrust_vector_ratio                                string = "sim/cockpit2/controls/thrust_vector_ratio"                                 // float, writable
+	SimCockpit2Controls_dihedral_ratio                                     string = "sim/cockpit2/controls/dihedral_ratio"                                      // float, writable
+	SimCockpit2Controls_incidence_ratio                                    string = "sim/cockpit2/controls/incidence_ratio"                                     // float, writable
+	SimCockpit2Controls_wing_retraction_ratio                              string = "sim/cockpit2/controls/wing_retraction_ratio"                               // float, writable
+	SimCockpit2Controls_flap_ratio                                         string = "sim/cockpit2/controls/flap_ratio"                                          // float, writable
+	SimCockpit2Controls_flap_system_deploy_ratio                           string = "sim/cockpit2/controls/flap_system_deploy_ratio"                            // float, writable
+	SimCockpit2Controls_flap_handle_request_ratio                          string = "sim/cockpit2/controls/flap_handle_request_ratio"                           // float, writable
+	SimCockpit2Controls_flap_dial_a_flap                                   string = "sim/cockpit2/controls/flap_dial_a_flap"                                    // float, writable
+	SimCockpit2Controls_flap_config                                        string = "sim/cockpit2/controls/flap_config"                                         // int
+	SimCockpit2Controls_slat_lock_active                                   string = "sim/cockpit2/controls/slat_lock_active"                                    // int
+	SimCockpit2Controls_flap_disagree                                      string = "sim/cockpit2/controls/flap_disagree"                                       // int
+	SimCockpit2Controls_slat_disagree                                      string = "sim/cockpit2/controls/slat_disagree"                                       // int
+	SimCockpit2Controls_parking_brake_ratio                                string = "sim/cockpit2/controls/parking_brake_ratio"                                 // float, writable
+	SimCockpit2Controls_left_brake_ratio                                   string = "sim/cockpit2/controls/left_brake_ratio"                                    // float, writable
+	SimCockpit2Controls_right_brake_ratio                                  string = "sim/cockpit2/controls/right_brake_ratio"                                   // float, writable
+	SimCockpit2Controls_gear_handle_down                                   string = "sim/cockpit2/controls/gear_handle_down"                                    // int, writable
+	SimCockpit2Controls_gear_handle_animation                              string = "sim/cockpit2/controls/gear_handle_animation"                               // float, writable
+	SimCockpit2Controls_gear_handle_request                                string = "sim/cockpit2/controls/gear_handle_request"                                 // int, writable
+	SimCockpit2Controls_gear_handle_downlock_release                       string = "sim/cockpit2/controls/gear_handle_downlock_release"                        // int, writable
+	SimCockpit2Controls_nosewheel_steer_on                                 string = "sim/cockpit2/controls/nosewheel_steer_on"                                  // int, writable
+	SimCockpit2Controls_brake_fan_on                                       string = "sim/cockpit2/controls/brake_fan_on"                                        // int, writable
+	SimCockpit2Controls_tailwheel_lock_ratio                               string = "sim/cockpit2/controls/tailwheel_lock_ratio"                                // float, writable
+	SimCockpit2Controls_aileron_trim                                       string = "sim/cockpit2/controls/aileron_trim"                                        // float, writable
+	SimCockpit2Controls_elevator_trim                                      string = "sim/cockpit2/controls/elevator_trim"                                       // float, writable
+	SimCockpit2Controls_rudder_trim                                        string = "sim/cockpit2/controls/rudder_trim"                                         // float, writable
+	SimCockpit2Controls_water_rudder_handle_ratio                          string = "sim/cockpit2/controls/water_rudder_handle_ratio"                           // float, writable
+	SimCockpit2Controls_flap_handle_deploy_ratio                           string = "sim/cockpit2/controls/flap_handle_deploy_ratio"                            // float
+	SimCockpit2Controls_wheel_brake_ratio                                  string = "sim/cockpit2/controls/wheel_brake_ratio"                                   // float, writable
+	SimCockpit2Controls_park_brake_valve                                   string = "sim/cockpit2/controls/park_brake_valve"                                    // int, writable
+	SimCockpit2EFIS_map_mode                                               string = "sim/cockpit2/EFIS/map_mode"                                                // int, writable
+	SimCockpit2EFIS_map_mode_is_HSI                                        string = "sim/cockpit2/EFIS/map_mode_is_HSI"                                         // int, writable
+	SimCockpit2EFIS_map_range                                              string = "sim/cockpit2/EFIS/map_range"                                               // int, writable
+	SimCockpit2EFIS_map_range_nm                                           string = "sim/cockpit2/EFIS/map_range_nm"                                            // float
+	SimCockpit2EFIS_argus_mode                                             string = "sim/cockpit2/EFIS/argus_mode"                                              // int, writable
+	SimCockpit2EFIS_ecam_mode                                              string = "sim/cockpit2/EFIS/ecam_mode"                                               // int, writable
+	SimCockpit2EFIS_EFIS_weather_on                                        string = "sim/cockpit2/EFIS/EFIS_weather_on"                                         // int, writable
+	SimCockpit2EFIS_EFIS_terrain_on                                        string = "sim/cockpit2/EFIS/EFIS_terrain_on"                                         // int, writable
+	SimCockpit2EFIS_EFIS_tcas_on                                           string = "sim/cockpit2/EFIS/EFIS_tcas_on"                                            // int, writable
+	SimCockpit2EFIS_EFIS_airport_on                                        string = "sim/cockpit2/EFIS/EFIS_airport_on"                                         // int, writable
+	SimCockpit2EFIS_EFIS_fix_on                                            string = "sim/cockpit2/EFIS/EFIS_fix_on"                                             // int, writable
+	SimCockpit2EFIS_EFIS_vor_on                                            string = "sim/cockpit2/EFIS/EFIS_vor_on"                                             // int, writable
+	SimCockpit2EFIS_EFIS_ndb_on                                            string = "sim/cockpit2/EFIS/EFIS_ndb_on"                                             // int, writable
+	SimCockpit2EFIS_EFIS_data_on                                           string = "sim/cockpit2/EFIS/EFIS_data_on"                                            // int, writable
+	SimCockpit2EFIS_map_mode_copilot                                       string = "sim/cockpit2/EFIS/map_mode_copilot"                                        // int, writable
+	SimCockpit2EFIS_map_mode_is_HSI_copilot                                string = "sim/cockpit2/EFIS/map_mode_is_HSI_copilot"                                 // int, writable
+	SimCockpit2EFIS_map_range_copilot                                      string = "sim/cockpit2/EFIS/map_range_copilot"                                       // int, writable
+	SimCockpit2EFIS_map_range_nm_copilot                                   string = "sim/cockpit2/EFIS/map_range_nm_copilot"                                    // float
+	SimCockpit2EFIS_EFIS_weather_on_copilot                                string = "sim/cockpit2/EFIS/EFIS_weather_on_copilot"                                 // int, writable
+	SimCockpit2EFIS_EFIS_terrain_on_copilot                                string = "sim/cockpit2/EFIS/EFIS_terrain_on_copilot"                                 // int, writable
+	SimCockpit2EFIS_EFIS_tcas_on_copilot                                   string = "sim/cockpit2/EFIS/EFIS_tcas_on_copilot"                                    // int, writable
+	SimCockpit2EFIS_EFIS_airport_on_copilot                                string = "sim/cockpit2/EFIS/EFIS_airport_on_copilot"                                 // int, writable
+	SimCockpit2EFIS_EFIS_fix_on_copilot                                    string = "sim/cockpit2/EFIS/EFIS_fix_on_copilot"                                     // int, writable
+	SimCockpit2EFIS_EFIS_vor_on_copilot                                    string = "sim/cockpit2/EFIS/EFIS_vor_on_copilot"                                     // int, writable
+	SimCockpit2EFIS_EFIS_ndb_on_copilot                                    string = "sim/cockpit2/EFIS/EFIS_ndb_on_copilot"                                     // int, writable
+	SimCockpit2EFIS_EFIS_data_on_copilot                                   string = "sim/cockpit2/EFIS/EFIS_data_on_copilot"                                    // int, writable
+	SimCockpit2EFIS_EFIS_weather_alpha                                     string = "sim/cockpit2/EFIS/EFIS_weather_alpha"                                      // float, writable
+	SimCockpit2EFIS_EFIS_weather_alpha_copilot                             string = "sim/cockpit2/EFIS/EFIS_weather_alpha_copilot"                              // float, writable
+	SimCockpit2EFIS_EFIS_terrain_altitudes                                 string = "sim/cockpit2/EFIS/EFIS_terrain_altitudes"                                  // float_array
+	SimCockpit2EFIS_EFIS_1_selection_pilot                                 string = "sim/cockpit2/EFIS/EFIS_1_selection_pilot"                                  // int, writable
+	SimCockpit2EFIS_EFIS_1_selection_copilot                               string = "sim/cockpit2/EFIS/EFIS_1_selection_copilot"                                // int, writable
+	SimCockpit2EFIS_EFIS_2_selection_pilot                                 string = "sim/cockpit2/EFIS/EFIS_2_selection_pilot"                                  // int, writable
+	SimCockpit2EFIS_EFIS_2_selection_copilot                               string = "sim/cockpit2/EFIS/EFIS_2_selection_copilot"                                // int, writable
+	SimCockpit2EFIS_EFIS_page                                              string = "sim/cockpit2/EFIS/EFIS_page"                                               // int_array, writable
+	SimCockpit2EFIS_G1000_reversionary_mode                                string = "sim/cockpit2/EFIS/G1000_reversionary_mode"                                 // int_array, writable
+	SimCockpit2EFIS_map_range_steps                                        string = "sim/cockpit2/EFIS/map_range_steps"                                         // int_array, writable
+	SimCockpit2EFIS_true_north                                             string = "sim/cockpit2/EFIS/true_north"                                              // int, writable
+	SimCockpit2EFIS_true_north_copilot                                     string = "sim/cockpit2/EFIS/true_north_copilot"                                      // int, writable
+	SimCockpit2Electrical_inverter_on                                      string = "sim/cockpit2/electrical/inverter_on"                                       // int_array, writable
+	SimCockpit2Electrical_battery_on                                       string = "sim/cockpit2/electrical/battery_on"                                        // int_array, writable
+	SimCockpit2Electrical_battery_amps                                     string = "sim/cockpit2/electrical/battery_amps"                                      // float_array, writable
+	SimCockpit2Electrical_battery_voltage_actual_volts                     string = "sim/cockpit2/electrical/battery_voltage_actual_volts"                      // float_array, writable
+	SimCockpit2Electrical_battery_voltage_indicated_volts                  string = "sim/cockpit2/electrical/battery_voltage_indicated_volts"                   // float_array, writable
+	SimCockpit2Electrical_generator_on                                     string = "sim/cockpit2/electrical/generator_on"                                      // int_array, writable
+	SimCockpit2Electrical_generator_amps                                   string = "sim/cockpit2/electrical/generator_amps"                                    // float_array
+	SimCockpit2Electrical_generator_volts                                  string = "sim/cockpit2/electrical/generator_volts"                                   // float_array
+	SimCockpit2Electrical_air_driven_generator_on                          string = "sim/cockpit2/electrical/air_driven_generator_on"                           // int, writable
+	SimCockpit2Electrical_APU_generator_on                                 string = "sim/cockpit2/electrical/APU_generator_on"                                  // int, writable
+	SimCockpit2Electrical_APU_generator_amps                               string = "sim/cockpit2/electrical/APU_generator_amps"                                // float, writable
+	SimCockpit2Electrical_APU_generator_volts                              string = "sim/cockpit2/electrical/APU_generator_volts"                               // float
+	SimCockpit2Electrical_APU_starter_switch                               string = "sim/cockpit2/electrical/APU_starter_switch"                                // int, writable
+	SimCockpit2Electrical_APU_N1_percent                                   string = "sim/cockpit2/electrical/APU_N1_percent"                                    // float
+	SimCockpit2Electrical_APU_EGT_c                                        string = "sim/cockpit2/electrical/APU_EGT_c"                                         // float
+	SimCockpit2Electrical_APU_door                                         string = "sim/cockpit2/electrical/APU_door"                                          // float
+	SimCockpit2Electrical_APU_running                                      string = "sim/cockpit2/electrical/APU_running"                                       // int
+	SimCockpit2Electrical_GPU_generator_on                                 string = "sim/cockpit2/electrical/GPU_generator_on"                                  // int, writable
+	SimCockpit2Electrical_GPU_generator_amps                               string = "sim/cockpit2/electrical/GPU_generator_amps"                                // float, writable
+	SimCockpit2Electrical_GPU_generator_volts                              string = "sim/cockpit2/electrical/GPU_generator_volts"                               // float, writable
+	SimCockpit2Electrical_GPU_can_be_called_for                            string = "sim/cockpit2/electrical/GPU_can_be_called_for"                             // int
+	SimCockpit2Electrical_RAT_generator_on                                 string = "sim/cockpit2/electrical/RAT_generator_on"                                  // int, writable
+	SimCockpit2Electrical_RAT_generator_amps                               string = "sim/cockpit2/electrical/RAT_generator_amps"                                // float, writable
+	SimCockpit2Electrical_RAT_generator_volts                              string = "sim/cockpit2/electrical/RAT_generator_volts"                               // float
+	SimCockpit2Electrical_cross_tie                                        string = "sim/cockpit2/electrical/cross_tie"                                         // int, writable
+	SimCockpit2Electrical_dc_voltmeter_selection                           string = "sim/cockpit2/electrical/dc_voltmeter_selection"                            // int
+	SimCockpit2Electrical_dc_voltmeter_value                               string = "sim/cockpit2/electrical/dc_voltmeter_value"                                // float
+	SimCockpit2Electrical_bus_volts                                        string = "sim/cockpit2/electrical/bus_volts"                                         // float_array, writable
+	SimCockpit2Electrical_bus_load_amps                                    string = "sim/cockpit2/electrical/bus_load_amps"                                     // float_array, writable
+	SimCockpit2Electrical_plugin_bus_load_amps                             string = "sim/cockpit2/electrical/plugin_bus_load_amps"                              // float_array, writable
+	SimCockpit2Electrical_panel_brightness_ratio                           string = "sim/cockpit2/electrical/panel_brightness_ratio"                            // float_array
+	SimCockpit2Electrical_panel_brightness_ratio_auto                      string = "sim/cockpit2/electrical/panel_brightness_ratio_auto"                       // float_array
+	SimCockpit2Electrical_panel_brightness_ratio_manual                    string = "sim/cockpit2/electrical/panel_brightness_ratio_manual"                     // float_array
+	SimCockpit2Electrical_instrument_brightness_ratio                      string = "sim/cockpit2/electrical/instrument_brightness_ratio"                       // float_array
+	SimCockpit2Electrical_instrument_brightness_ratio_auto                 string = "sim/cockpit2/electrical/instrument_brightness_ratio_auto"                  // float_array
+	SimCockpit2Electrical_instrument_brightness_ratio_manual               string = "sim/cockpit2/electrical/instrument_brightness_ratio_manual"                // float_array
+	SimCockpit2Electrical_instrument_brightness_attenuation_ref_nits       string = "sim/cockpit2/electrical/instrument_brightness_attenuation_ref_nits"        // float_array, writable
+	SimCockpit2Electrical_HUD_brightness_ratio                             string = "sim/cockpit2/electrical/HUD_brightness_ratio"                              // float
+	SimCockpit2Electrical_HUD_brightness_ratio_auto                        string = "sim/cockpit2/electrical/HUD_brightness_ratio_auto"                         // float
+	SimCockpit2Electrical_HUD_brightness_ratio_manual                      string = "sim/cockpit2/electrical/HUD_brightness_ratio_manual"                       // float
+	SimCockpit2Electrical_HUD_brightness_attenuation_ref_nits              string = "sim/cockpit2/electrical/HUD_brightness_attenuation_ref_nits"               // float, writable
+	SimCockpit2Electrical_display_screen_brightness                        string = "sim/cockpit2/electrical/display_screen_brightness"                         // float_array
+	SimCockpit2EngineActuators_cyclic_elevator_deg                         string = "sim/cockpit2/engine/actuators/cyclic_elevator_deg"                         // float_array, writable
+	SimCockpit2EngineActuators_cyclic_aileron_deg                          string = "sim/cockpit2/engine/actuators/cyclic_aileron_deg"                          // float_array, writable
+	SimCockpit2EngineActuators_throttle_ratio                              string = "sim/cockpit2/engine/actuators/throttle_ratio"                              // float_array, writable
+	SimCockpit2EngineActuators_throttle_beta_rev_ratio                     string = "sim/cockpit2/engine/actuators/throttle_beta_rev_ratio"                     // float_array, writable
+	SimCockpit2EngineActuators_throttle_jet_rev_ratio                      string = "sim/cockpit2/engine/actuators/throttle_jet_rev_ratio"                      // float_array, writable
+	SimCockpit2EngineActuators_hardware_throttle_ratio                     string = "sim/cockpit2/engine/actuators/hardware_throttle_ratio"                     // float_array
+	SimCockpit2EngineActuators_beta_ratio                                  string = "sim/cockpit2/engine/actuators/beta_ratio"                                  // float_array, writable
+	SimCockpit2EngineActuators_throttle_ratio_all                          string = "sim/cockpit2/engine/actuators/throttle_ratio_all"                          // float, writable
+	SimCockpit2EngineActuators_throttle_beta_rev_ratio_all                 string = "sim/cockpit2/engine/actuators/throttle_beta_rev_ratio_all"                 // float, writable
+	SimCockpit2EngineActuators_throttle_jet_rev_ratio_all                  string = "sim/cockpit2/engine/actuators/throttle_jet_rev_ratio_all"                  // float, writable
+	SimCockpit2EngineActuators_prop_rotation_speed_rad_sec                 string = "sim/cockpit2/engine/actuators/prop_rotation_speed_rad_sec"                 // float_array, writable
+	SimCockpit2EngineActuators_prop_rotation_speed_rad_sec_all             string = "sim/cockpit2/engine/actuators/prop_rotation_speed_rad_sec_all"             // float, writable
+	SimCockpit2EngineActuators_prop_angle_degrees                          string = "sim/cockpit2/engine/actuators/prop_angle_degrees"                          // float_array, writable
+	SimCockpit2EngineActuators_prop_angle_degrees_all                      string = "sim/cockpit2/engine/actuators/prop_angle_degrees_all"                      // float, writable
+	SimCockpit2EngineActuators_prop_ratio                                  string = "sim/cockpit2/engine/actuators/prop_ratio"                                  // float_array, writable
+	SimCockpit2EngineActuators_prop_ratio_all                              string = "sim/cockpit2/engine/actuators/prop_ratio_all"                              // float, writable
+	SimCockpit2EngineActuators_mixture_ratio                               string = "sim/cockpit2/engine/actuators/mixture_ratio"                               // float_array, writable
+	SimCockpit2EngineActuators_mixture_ratio_all                           string = "sim/cockpit2/engine/actuators/mixture_ratio_all"                           // float, writable
+	SimCockpit2EngineActuators_carb_heat_ratio                             string = "sim/cockpit2/engine/actuators/carb_heat_ratio"                             // float_array, writable
+	SimCockpit2EngineActuators_cowl_flap_ratio                             string = "sim/cockpit2/engine/actuators/cowl_flap_ratio"                             // float_array, writable
+	SimCockpit2EngineActuators_primer_ratio                                string = "sim/cockpit2/engine/actuators/primer_ratio"                                // float_array, writable
+	SimCockpit2EngineActuators_afterburner_enabled                         string = "sim/cockpit2/engine/actuators/afterburner_enabled"                         // int_array, writable
+	SimCockpit2EngineActuators_eng_mode_selector                           string = "sim/cockpit2/engine/actuators/eng_mode_selector"                           // int, writable
+	SimCockpit2EngineActuators_eng_master                                  string = "sim/cockpit2/engine/actuators/eng_master"                                  // int_array, writable
+	SimCockpit2EngineActuators_igniter_on                                  string = "sim/cockpit2/engine/actuators/igniter_on"                                  // int_array, writable
+	SimCockpit2EngineActuators_auto_ignite_on                              string = "sim/cockpit2/engine/actuators/auto_ignite_on"                              // int_array, writable
+	SimCockpit2EngineActuators_ignition_on                                 string = "sim/cockpit2/engine/actuators/ignition_on"                                 // int_array, writable
+	SimCockpit2EngineActuators_ignition_key                                string = "sim/cockpit2/engine/actuators/ignition_key"                                // int_array, writable
+	SimCockpit2EngineActuators_starter_hit                                 string = "sim/cockpit2/engine/actuators/starter_hit"                                 // int_array
+	SimCockpit2EngineActuators_fadec_on                                    string = "sim/cockpit2/engine/actuators/fadec_on"                                    // int_array, writable
+	SimCockpit2EngineActuators_primer_on                                   string = "sim/cockpit2/engine/actuators/primer_on"                                   // int_array, writable
+	SimCockpit2EngineActuators_fuel_pump_on                                string = "sim/cockpit2/engine/actuators/fuel_pump_on"                                // int_array, writable
+	SimCockpit2EngineActuators_idle_speed                                  string = "sim/cockpit2/engine/actuators/idle_speed"                                  // int_array, writable
+	SimCockpit2EngineActuators_idle_speed_ratio                            string = "sim/cockpit2/engine/actuators/idle_speed_ratio"                            // float_array, writable
+	SimCockpit2EngineActuators_prop_mode                                   string = "sim/cockpit2/engine/actuators/prop_mode"                                   // int_array, writable
+	SimCockpit2EngineActuators_prop_pitch_deg                              string = "sim/cockpit2/engine/actuators/prop_pitch_deg"                              // float_array, writable
+	SimCockpit2EngineActuators_start_lock_engaged                          string = "sim/cockpit2/engine/actuators/start_lock_engaged"                          // int_array, writable
+	SimCockpit2EngineActuators_unfeather_pump_running                      string = "sim/cockpit2/engine/actuators/unfeather_pump_running"                      // int_array, writable
+	SimCockpit2EngineActuators_governor_on                                 string = "sim/cockpit2/engine/actuators/governor_on"                                 // int_array, writable
+	SimCockpit2EngineActuators_fire_extinguisher_on                        string = "sim/cockpit2/engine/actuators/fire_extinguisher_on"                        // int_array, writable
+	SimCockpit2EngineActuators_clutch_engage                               string = "sim/cockpit2/engine/actuators/clutch_engage"                               // float_array, writable
+	SimCockpit2EngineActuators_clutch_ratio                                string = "sim/cockpit2/engine/actuators/clutch_ratio"                                // float_array, writable
+	SimCockpit2EngineActuators_manual_feather_prop                         string = "sim/cockpit2/engine/actuators/manual_feather_prop"                         // float_array, writable
+	SimCockpit2EngineActuators_N1_target_bug                               string = "sim/cockpit2/engine/actuators/N1_target_bug"                               // float_array, writable
+	SimCockpit2EngineActuators_EPR_target_bug                              string = "sim/cockpit2/engine/actuators/EPR_target_bug"                              // float_array, writable
+	SimCockpit2EngineActuators_starter_fuel_flow_ratio                     string = "sim/cockpit2/engine/actuators/starter_fuel_flow_ratio"                     // float_array, writable
+	SimCockpit2EngineActuators_backup_throttle_ratio                       string = "sim/cockpit2/engine/actuators/backup_throttle_ratio"                       // float_array, writable
+	SimCockpit2EngineIndicators_N1_percent                                 string = "sim/cockpit2/engine/indicators/N1_percent"                                 // float_array, writable
+	SimCockpit2EngineIndicators_N2_percent                                 string = "sim/cockpit2/engine/indicators/N2_percent"                                 // float_array, writable
+	SimCockpit2EngineIndicators_MPR_in_hg                                  string = "sim/cockpit2/engine/indicators/MPR_in_hg"                                  // float_array, writable
+	SimCockpit2EngineIndicators_EPR_ratio                                  string = "sim/cockpit2/engine/indicators/EPR_ratio"                                  // float_array
+	SimCockpit2EngineIndicators_torque_n_mtr                               string = "sim/cockpit2/engine/indicators/torque_n_mtr"                               // float_array, writable
+	SimCockpit2EngineIndicators_fuel_flow_kg_sec                           string = "sim/cockpit2/engine/indicators/fuel_flow_kg_sec"                           // float_array, writable
+	SimCockpit2EngineIndicators_fuel_flow_dry_kg_sec                       string = "sim/cockpit2/engine/indicators/fuel_flow_dry_kg_sec"                       // float_array
+	SimCockpit2EngineIndicators_ITT_deg_C                                  string = "sim/cockpit2/engine/indicators/ITT_deg_C"                                  // float_array, writable
+	SimCockpit2EngineIndicators_ITT_deg_cel                                string = "sim/cockpit2/engine/indicators/ITT_deg_cel"                                // float_array, writable
+	SimCockpit2EngineIndicators_EGT_deg_C                                  string = "sim/cockpit2/engine/indicators/EGT_deg_C"                                  // float_array
+	SimCockpit2EngineIndicators_EGT_deg_cel                                string = "sim/cockpit2/engine/indicators/EGT_deg_cel"                                // float_array, writable
+	SimCockpit2EngineIndicators_CHT_deg_C                                  string = "sim/cockpit2/engine/indicators/CHT_deg_C"                                  // float_array
+	SimCockpit2EngineIndicators_CHT_deg_cel                                string = "sim/cockpit2/engine/indicators/CHT_deg_cel"                                // float_array, writable
+	SimCockpit2EngineIndicators_EGT_CYL_deg_C                              string = "sim/cockpit2/engine/indicators/EGT_CYL_deg_C"                              // float_array, writable
+	SimCockpit2EngineIndicators_EGT_CYL_deg_cel                            string = "sim/cockpit2/engine/indicators/EGT_CYL_deg_cel"                            // float_array, writable
+	SimCockpit2EngineIndicators_CHT_CYL_deg_C                              string = "sim/cockpit2/engine/indicators/CHT_CYL_deg_C"                              // float_array, writable
+	SimCockpit2EngineIndicators_CHT_CYL_deg_cel                            string = "sim/cockpit2/engine/indicators/CHT_CYL_deg_cel"                            // float_array, writable
+	SimCockpit2EngineIndicators_fuel_pressure_psi                          string = "sim/cockpit2/engine/indicators/fuel_pressure_psi"                          // float_array, writable
+	SimCockpit2EngineIndicators_oil_pressure_psi                           string = "sim/cockpit2/engine/indicators/oil_pressure_psi"                           // float_array, writable
+	SimCockpit2EngineIndicators_oil_temperature_deg_C                      string = "sim/cockpit2/engine/indicators/oil_temperature_deg_C"                      // float_array, writable
+	SimCockpit2EngineIndicators_oil_quantity_ratio                         string = "sim/cockpit2/engine/indicators/oil_quantity_ratio"                         // float_array
+	SimCockpit2EngineIndicators_power_watts                                string = "sim/cockpit2/engine/indicators/power_watts"                                // float_array
+	SimCockpit2EngineIndicators_thrust_n                                   string = "sim/cockpit2/engine/indicators/thrust_n"                                   // float_array
+	SimCockpit2EngineIndicators_thrust_dry_n                               string = "sim/cockpit2/engine/indicators/thrust_dry_n"                               // float_array
+	SimCockpit2EngineIndicators_engine_speed_rpm                           string = "sim/cockpit2/engine/indicators/engine_speed_rpm"                           // float_array
+	SimCockpit2EngineIndicators_prop_speed_rpm                             string = "sim/cockpit2/engine/indicators/prop_speed_rpm"                             // float_array
+	SimCockpit2EngineIndicators_prop_speed_rsc                             string = "sim/cockpit2/engine/indicators/prop_speed_rsc"                             // float_array, writable
+	SimCockpit2EngineIndicators_carburetor_temperature_C                   string = "sim/cockpit2/engine/indicators/carburetor_temperature_C"                   // float_array
+	SimCockpit2EngineIndicators_igniter_sparking                           string = "sim/cockpit2/engine/indicators/igniter_sparking"                           // int_array
+	SimCockpit2EngineIndicators_igniter_volt                               string = "sim/cockpit2/engine/indicators/igniter_volt"                               // float_array
+	SimCockpit2EngineIndicators_clutch_in_motion                           string = "sim/cockpit2/engine/indicators/clutch_in_motion"                           // int
+	SimCockpit2EngineIndicators_inverter_temp_C                            string = "sim/cockpit2/engine/indicators/inverter_temp_C"                            // float_array
+	SimCockpit2EngineIndicators_stator_A_temp_C                            string = "sim/cockpit2/engine/indicators/stator_A_temp_C"                            // float_array
+	SimCockpit2EngineIndicators_stator_B_temp_C                            string = "sim/cockpit2/engine/indicators/stator_B_temp_C"                            // float_array
+	SimCockpit2EngineIndicators_N1_fadec_arc                               string = "sim/cockpit2/engine/indicators/N1_fadec_arc"                               // float_array, writable
+	SimCockpit2Fuel_fuel_tank_selector_left                                string = "sim/cockpit2/fuel/fuel_tank_selector_left"                                 // int, writable
+	SimCockpit2Fuel_fuel_tank_selector_right                               string = "sim/cockpit2/fuel/fuel_tank_selector_right"                                // int, writable
+	SimCockpit2Fuel_fuel_tank_selector                                     string = "sim/cockpit2/fuel/fuel_tank_selector"                                      // int, writable
+	SimCockpit2Fuel_fuel_crossfeed_selector                                string = "sim/cockpit2/fuel/fuel_crossfeed_selector"                                 // int, writable
+	SimCockpit2Fuel_fuel_gravity_crossflow                                 string = "sim/cockpit2/fuel/fuel_gravity_crossflow"                                  // int, writable
+	SimCockpit2Fuel_fuel_tank_transfer_to                                  string = "sim/cockpit2/fuel/fuel_tank_transfer_to"                                   // int, writable
+	SimCockpit2Fuel_fuel_tank_transfer_from                                string = "sim/cockpit2/fuel/fuel_tank_transfer_from"                                 // int, writable
+	SimCockpit2Fuel_fuel_tank_pump_on                                      string = "sim/cockpit2/fuel/fuel_tank_pump_on"                                       // int_array, writable
+	SimCockpit2Fuel_showing_aux                                            string = "sim/cockpit2/fuel/showing_aux"                                             // int
+	SimCockpit2Fuel_fuel_quantity                                          string = "sim/cockpit2/fuel/fuel_quantity"                                           // float_array
+	SimCockpit2Fuel_fuel_temp_at_fuel_tank                                 string = "sim/cockpit2/fuel/fuel_temp_at_fuel_tank"                                  // float_array
+	SimCockpit2Fuel_fuel_temp_at_oil_cooler                                string = "sim/cockpit2/fuel/fuel_temp_at_oil_cooler"                                 // float_array
+	SimCockpit2Fuel_fuel_tank_source_for_engine                            string = "sim/cockpit2/fuel/fuel_tank_source_for_engine"                             // int_array
+	SimCockpit2Fuel_fuel_totalizer_init_kg                                 string = "sim/cockpit2/fuel/fuel_totalizer_init_kg"                                  // float, writable
+	SimCockpit2Fuel_fuel_totalizer_sum_kg                                  string = "sim/cockpit2/fuel/fuel_totalizer_sum_kg"                                   // float, writable
+	SimCockpit2Fuel_fuel_totalizer_sum_engine_kg                           string = "sim/cockpit2/fuel/fuel_totalizer_sum_engine_kg"                            // float_array, writable
+	SimCockpit2Fuel_transfer_pump_left                                     string = "sim/cockpit2/fuel/transfer_pump_left"                                      // int, writable
+	SimCockpit2Fuel_transfer_pump_right                                    string = "sim/cockpit2/fuel/transfer_pump_right"                                     // int, writable
+	SimCockpit2Fuel_transfer_pump_activation                               string = "sim/cockpit2/fuel/transfer_pump_activation"                                // float, writable
+	SimCockpit2Fuel_transfer_pump_deactivation                             string = "sim/cockpit2/fuel/transfer_pump_deactivation"                              // float, writable
+	SimCockpit2Fuel_fuel_level_indicated_left                              string = "sim/cockpit2/fuel/fuel_level_indicated_left"                               // float
+	SimCockpit2Fuel_fuel_level_indicated_right                             string = "sim/cockpit2/fuel/fuel_level_indicated_right"                              // float
+	SimCockpit2Fuel_firewall_closed_left                                   string = "sim/cockpit2/fuel/firewall_closed_left"                                    // int, writable
+	SimCockpit2Fuel_firewall_closed_right                                  string = "sim/cockpit2/fuel/firewall_closed_right"                                   // int, writable
+	SimCockpit2Fuel_auto_crossfeed                                         string = "sim/cockpit2/fuel/auto_crossfeed"                                          // int, writable
+	SimCockpit2Fuel_no_transfer_left                                       string = "sim/cockpit2/fuel/no_transfer_left"                                        // int
+	SimCockpit2Fuel_no_transfer_right                                      string = "sim/cockpit2/fuel/no_transfer_right"                                       // int
+	SimCockpit2Fuel_transfer_test                                          string = "sim/cockpit2/fuel/transfer_test"                                           // int, writable
+	SimCockpit2Fuel_tank_pump_pressure_psi                                 string = "sim/cockpit2/fuel/tank_pump_pressure_psi"                                  // float_array, writable
+	SimCockpit2GaugesActuators_barometer_setting_in_hg_pilot               string = "sim/cockpit2/gauges/actuators/barometer_setting_in_hg_pilot"               // float, writable
+	SimCockpit2GaugesActuators_barometer_setting_in_hg_copilot             string = "sim/cockpit2/gauges/actuators/barometer_setting_in_hg_copilot"             // float, writable
+	SimCockpit2GaugesActuators_barometer_setting_in_hg_stby                string = "sim/cockpit2/gauges/actuators/barometer_setting_in_hg_stby"                // float, writable
+	SimCockpit2GaugesActuators_radio_altimeter_bug_ft_pilot                string = "sim/cockpit2/gauges/actuators/radio_altimeter_bug_ft_pilot"                // float, writable
+	SimCockpit2GaugesActuators_radio_altimeter_bug_ft_copilot              string = "sim/cockpit2/gauges/actuators/radio_altimeter_bug_ft_copilot"              // float, writable
+	SimCockpit2GaugesActuators_baro_altimeter_bug_ft_pilot                 string = "sim/cockpit2/gauges/actuators/baro_altimeter_bug_ft_pilot"                 // float, writable
+	SimCockpit2GaugesActuators_baro_altimeter_bug_ft_copilot               string = "sim/cockpit2/gauges/actuators/baro_altimeter_bug_ft_copilot"               // float, writable
+	SimCockpit2GaugesActuators_artificial_horizon_adjust_deg_pilot         string = "sim/cockpit2/gauges/actuators/artificial_horizon_adjust_deg_pilot"         // float, writable
+	SimCockpit2GaugesActuators_artificial_horizon_adjust_deg_copilot       string = "sim/cockpit2/gauges/actuators/artificial_horizon_adjust_deg_copilot"       // float, writable
+	SimCockpit2GaugesActuators_airspeed_bug_deg                            string = "sim/cockpit2/gauges/actuators/airspeed_bug_deg"                            // float, writable
+	SimCockpit2GaugesActuators_airspeed_bugs                               string = "sim/cockpit2/gauges/actuators/airspeed_bugs"                               // float_array, writable
+	SimCockpit2GaugesIndicators_slip_deg                                   string = "sim/cockpit2/gauges/indicators/slip_deg"                                   // float, writable
+	SimCockpit2GaugesIndicators_compass_heading_deg_mag                    string = "sim/cockpit2/gauges/indicators/compass_heading_deg_mag"                    // float, writable
+	SimCockpit2GaugesIndicators_airspeed_acceleration_kts_sec_pilot        string = "sim/cockpit2/gauges/indicators/airspeed_acceleration_kts_sec_pilot"        // float, writable
+	SimCockpit2GaugesIndicators_airspeed_acceleration_kts_sec_copilot      string = "sim/cockpit2/gauges/indicators/airspeed_acceleration_kts_sec_copilot"      // float, writable
+	SimCockpit2GaugesIndicators_airspeed_kts_pilot                         string = "sim/cockpit2/gauges/indicators/airspeed_kts_pilot"                         // float, writable
+	SimCockpit2GaugesIndicators_airspeed_kts_copilot                       string = "sim/cockpit2/gauges/indicators/airspeed_kts_copilot"                       // float, writable
+	SimCockpit2GaugesIndicators_airspeed_kts_stby                          string = "sim/cockpit2/gauges/indicators/airspeed_kts_stby"                          // float, writable
+	SimCockpit2GaugesIndicators_calibrated_airspeed_kts_pilot              string = "sim/cockpit2/gauges/indicators/calibrated_airspeed_kts_pilot"              // float, writable
+	SimCockpit2GaugesIndicators_calibrated_airspeed_kts_copilot            string = "sim/cockpit2/gauges/indicators/calibrated_airspeed_kts_copilot"            // float, writable
+	SimCockpit2GaugesIndicators_calibrated_airspeed_kts_stby               string = "sim/cockpit2/gauges/indicators/calibrated_airspeed_kts_stby"               // float, writable
+	SimCockpit2GaugesIndicators_altitude_ft_pilot                          string = "sim/cockpit2/gauges/indicators/altitude_ft_pilot"                          // float, writable
+	SimCockpit2GaugesIndicators_altitude_ft_copilot                        string = "sim/cockpit2/gauges/indicators/altitude_ft_copilot"                        // float, writable
+	SimCockpit2GaugesIndicators_altitude_ft_stby                           string = "sim/cockpit2/gauges/indicators/altitude_ft_stby"                           // float, writable
+	SimCockpit2GaugesIndicators_vvi_fpm_pilot                              string = "sim/cockpit2/gauges/indicators/vvi_fpm_pilot"                              // float, writable
+	SimCockpit2GaugesIndicators_vvi_fpm_copilot                            string = "sim/cockpit2/gauges/indicators/vvi_fpm_copilot"                            // float, writable
+	SimCockpit2GaugesIndicators_turn_rate_roll_deg_pilot                   string = "sim/cockpit2/gauges/indicators/turn_rate_roll_deg_pilot"                   // float, writable
+	SimCockpit2GaugesIndicators_true_airspeed_kts_pilot                    string = "sim/cockpit2/gauges/indicators/true_airspeed_kts_pilot"                    // float, writable
+	SimCockpit2GaugesIndicators_true_airspeed_kts_copilot                  string = "sim/cockpit2/gauges/indicators/true_airspeed_kts_copilot"                  // float, writable
+	SimCockpit2GaugesIndicators_ground_speed_kt                            string = "sim/cockpit2/gauges/indicators/ground_speed_kt"                            // float, writable
+	SimCockpit2GaugesIndicators_mach_pilot                                 string = "sim/cockpit2/gauges/indicators/mach_pilot"                                 // float, writable
+	SimCockpit2GaugesIndicators_mach_copilot                               string = "sim/cockpit2/gauges/indicators/mach_copilot"                               // float, writable
+	SimCockpit2GaugesIndicators_turn_rate_roll_deg_copilot                 string = "sim/cockpit2/gauges/indicators/turn_rate_roll_deg_copilot"                 // float, writable
+	SimCockpit2GaugesIndicators_turn_rate_heading_deg_pilot                string = "sim/cockpit2/gauges/indicators/turn_rate_heading_deg_pilot"                // float, writable
+	SimCockpit2GaugesIndicators_turn_rate_heading_deg_copilot              string = "sim/cockpit2/gauges/indicators/turn_rate_heading_deg_copilot"              // float, writable
+	SimCockpit2GaugesIndicators_heading_AHARS_deg_mag_pilot                string = "sim/cockpit2/gauges/indicators/heading_AHARS_deg_mag_pilot"                // float, writable
+	SimCockpit2GaugesIndicators_heading_AHARS_deg_mag_copilot              string = "sim/cockpit2/gauges/indicators/heading_AHARS_deg_mag_copilot"              // float, writable
+	SimCockpit2GaugesIndicators_heading_electric_deg_mag_pilot             string = "sim/cockpit2/gauges/indicators/heading_electric_deg_mag_pilot"             // float, writable
+	SimCockpit2GaugesIndicators_heading_electric_deg_mag_copilot           string = "sim/cockpit2/gauges/indicators/heading_electric_deg_mag_copilot"           // float, writable
+	SimCockpit2GaugesIndicators_heading_vacuum_deg_mag_pilot               string = "sim/cockpit2/gauges/indicators/heading_vacuum_deg_mag_pilot"               // float, writable
+	SimCockpit2GaugesIndicators_heading_vacuum_deg_mag_copilot             string = "sim/cockpit2/gauges/indicators/heading_vacuum_deg_mag_copilot"             // float, writable
+	SimCockpit2GaugesIndicators_pitch_AHARS_deg_pilot                      string = "sim/cockpit2/gauges/indicators/pitch_AHARS_deg_pilot"                      // float, writable
+	SimCockpit2GaugesIndicators_pitch_AHARS_deg_copilot                    string = "sim/cockpit2/gauges/indicators/pitch_AHARS_deg_copilot"                    // float, writable
+	SimCockpit2GaugesIndicators_pitch_electric_deg_pilot                   string = "sim/cockpit2/gauges/indicators/pitch_electric_deg_pilot"                   // float, writable
+	SimCockpit2GaugesIndicators_pitch_electric_deg_copilot                 string = "sim/cockpit2/gauges/indicators/pitch_electric_deg_copilot"                 // float, writable
+	SimCockpit2GaugesIndicators_pitch_vacuum_deg_pilot                     string = "sim/cockpit2/gauges/indicators/pitch_vacuum_deg_pilot"                     // float, writable
+	SimCockpit2GaugesIndicators_pitch_vacuum_deg_copilot                   string = "sim/cockpit2/gauges/indicators/pitch_vacuum_deg_copilot"                   // float, writable
+	SimCockpit2GaugesIndicators_radio_altimeter_height_ft_pilot            string = "sim/cockpit2/gauges/indicators/radio_altimeter_height_ft_pilot"            // float, writable
+	SimCockpit2GaugesIndicators_radio_altimeter_height_ft_copilot          string = "sim/cockpit2/gauges/indicators/radio_altimeter_height_ft_copilot"          // float, writable
+	SimCockpit2GaugesIndicators_radio_altimeter_dh_lit_pilot               string = "sim/cockpit2/gauges/indicators/radio_altimeter_dh_lit_pilot"               // int, writable
+	SimCockpit2GaugesIndicators_radio_altimeter_dh_lit_copilot             string = "sim/cockpit2/gauges/indicators/radio_altimeter_dh_lit_copilot"             // int, writable
+	SimCockpit2GaugesIndicators_baro_altimeter_mda_lit_pilot               string = "sim/cockpit2/gauges/indicators/baro_altimeter_mda_lit_pilot"               // int, writable
+	SimCockpit2GaugesIndicators_baro_altimeter_mda_lit_copilot             string = "sim/cockpit2/gauges/indicators/baro_altimeter_mda_lit_copilot"             // int, writable
+	SimCockpit2GaugesIndicators_baro_altimeter_alert_lit_pilot             string = "sim/cockpit2/gauges/indicators/baro_altimeter_alert_lit_pilot"             // int, writable
+	SimCockpit2GaugesIndicators_baro_altimeter_alert_lit_copilot           string = "sim/cockpit2/gauges/indicators/baro_altimeter_alert_lit_copilot"           // int, writable
+	SimCockpit2GaugesIndicators_AoA_pilot                                  string = "sim/cockpit2/gauges/indicators/AoA_pilot"                                  // float, writable
+	SimCockpit2GaugesIndicators_AoA_copilot                                string = "sim/cockpit2/gauges/indicators/AoA_copilot"                                // float, writable
+	SimCockpit2GaugesIndicators_roll_AHARS_deg_pilot                       string = "sim/cockpit2/gauges/indicators/roll_AHARS_deg_pilot"                       // float, writable
+	SimCockpit2GaugesIndicators_roll_AHARS_deg_copilot                     string = "sim/cockpit2/gauges/indicators/roll_AHARS_deg_copilot"                     // float, writable
+	SimCockpit2GaugesIndicators_roll_electric_deg_pilot                    string = "sim/cockpit2/gauges/indicators/roll_electric_deg_pilot"                    // float, writable
+	SimCockpit2GaugesIndicators_roll_electric_deg_copilot                  string = "sim/cockpit2/gauges/indicators/roll_electric_deg_copilot"                  // float, writable
+	SimCockpit2GaugesIndicators_roll_vacuum_deg_pilot                      string = "sim/cockpit2/gauges/indicators/roll_vacuum_deg_pilot"                      // float, writable
+	SimCockpit2GaugesIndicators_roll_vacuum_deg_copilot                    string = "sim/cockpit2/gauges/indicators/roll_vacuum_deg_copilot"                    // float, writable
+	SimCockpit2GaugesIndicators_wind_heading_deg_mag                       string = "sim/cockpit2/gauges/indicators/wind_heading_deg_mag"                       // float, writable
+	SimCockpit2GaugesIndicators_wind_speed_kts                             string = "sim/cockpit2/gauges/indicators/wind_speed_kts"                             // float, writable
+	SimCockpit2GaugesIndicators_suction_1_ratio                            string = "sim/cockpit2/gauges/indicators/suction_1_ratio"                            // float, writable
+	SimCockpit2GaugesIndicators_suction_2_ratio                            string = "sim/cockpit2/gauges/indicators/suction_2_ratio"                            // float, writable
+	SimCockpit2GaugesIndicators_total_energy_fpm                           string = "sim/cockpit2/gauges/indicators/total_energy_fpm"                           // float, writable
+	SimCockpit2GaugesIndicators_water_ratio                                string = "sim/cockpit2/gauges/indicators/water_ratio"                                // float
+	SimCockpit2GaugesIndicators_sideslip_degrees                           string = "sim/cockpit2/gauges/indicators/sideslip_degrees"                           // float
+	SimCockpit2GaugesIndicators_prop_sync_degrees                          string = "sim/cockpit2/gauges/indicators/prop_sync_degrees"                          // float, writable
+	SimCockpit2GaugesIndicators_CG_indicator                               string = "sim/cockpit2/gauges/indicators/CG_indicator"                               // float
+	SimCockpit2GaugesIndicators_ground_track_mag_pilot                     string = "sim/cockpit2/gauges/indicators/ground_track_mag_pilot"                     // float
+	SimCockpit2GaugesIndicators_ground_track_mag_copilot                   string = "sim/cockpit2/gauges/indicators/ground_track_mag_copilot"                   // float
+	SimCockpit2GaugesIndicators_ground_track_true_pilot                    string = "sim/cockpit2/gauges/indicators/ground_track_true_pilot"                    // float
+	SimCockpit2GaugesIndicators_ground_track_true_copilot                  string = "sim/cockpit2/gauges/indicators/ground_track_true_copilot"                  // float
+	SimCockpit2GaugesIndicators_max_mach_number_in_kias                    string = "sim/cockpit2/gauges/indicators/max_mach_number_in_kias"                    // float
+	SimCockpit2GaugesIndicators_max_mach_number_or_speed_kias              string = "sim/cockpit2/gauges/indicators/max_mach_number_or_speed_kias"              // float
+	SimCockpit2GaugesIndicators_takeoff_config_flap                        string = "sim/cockpit2/gauges/indicators/takeoff_config_flap"                        // int, writable
+	SimCockpit2GaugesIndicators_takeoff_config_trim                        string = "sim/cockpit2/gauges/indicators/takeoff_config_trim"                        // float, writable
+	SimCockpit2GaugesIndicators_final_approach_name                        string = "sim/cockpit2/gauges/indicators/final_approach_name"                        // data
+	SimCockpit2HydraulicsActuators_electric_hydraulic_pump_on              string = "sim/cockpit2/hydraulics/actuators/electric_hydraulic_pump_on"              // int, writable
+	SimCockpit2HydraulicsActuators_electric_hydraulic_pump2_on             string = "sim/cockpit2/hydraulics/actuators/electric_hydraulic_pump2_on"             // int, writable
+	SimCockpit2HydraulicsActuators_electric_hydraulic_pump3_on             string = "sim/cockpit2/hydraulics/actuators/electric_hydraulic_pump3_on"             // int, writable
+	SimCockpit2HydraulicsActuators_ram_air_turbine_on                      string = "sim/cockpit2/hydraulics/actuators/ram_air_turbine_on"                      // int, writable
+	SimCockpit2HydraulicsActuators_engine_pump                             string = "sim/cockpit2/hydraulics/actuators/engine_pump"                             // int_array, writable
+	SimCockpit2HydraulicsActuators_engine_pumpA                            string = "sim/cockpit2/hydraulics/actuators/engine_pumpA"                            // int_array, writable
+	SimCockpit2HydraulicsActuators_engine_pumpB                            string = "sim/cockpit2/hydraulics/actuators/engine_pumpB"                            // int_array, writable
+	SimCockpit2HydraulicsActuators_engine_pumpC                            string = "sim/cockpit2/hydraulics/actuators/engine_pumpC"                            // int_array, writable
+	SimCockpit2HydraulicsActuators_prop_pump                               string = "sim/cockpit2/hydraulics/actuators/prop_pump"                               // int_array, writable
+	SimCockpit2HydraulicsActuators_prop_pumpA                              string = "sim/cockpit2/hydraulics/actuators/prop_pumpA"                              // int_array, writable
+	SimCockpit2HydraulicsActuators_prop_pumpB                              string = "sim/cockpit2/hydraulics/actuators/prop_pumpB"                              // int_array, writable
+	SimCockpit2HydraulicsActuators_prop_pumpC                              string = "sim/cockpit2/hydraulics/actuators/prop_pumpC"                              // int_array, writable
+	SimCockpit2HydraulicsActuators_PTU                                     string = "sim/cockpit2/hydraulics/actuators/PTU"                                     // int, writable
+	SimCockpit2HydraulicsIndicators_hydraulic_pressure_1                   string = "sim/cockpit2/hydraulics/indicators/hydraulic_pressure_1"                   // float
+	SimCockpit2HydraulicsIndicators_hydraulic_pressure_2                   string = "sim/cockpit2/hydraulics/indicators/hydraulic_pressure_2"                   // float
+	SimCockpit2HydraulicsIndicators_hydraulic_pressure_3                   string = "sim/cockpit2/hydraulics/indicators/hydraulic_pressure_3"                   // float
+	SimCockpit2HydraulicsIndicators_brake_accumulator_pressure_ratio       string = "sim/cockpit2/hydraulics/indicators/brake_accumulator_pressure_ratio"       // float
+	SimCockpit2HydraulicsIndicators_hydraulic_fluid_ratio_1                string = "sim/cockpit2/hydraulics/indicators/hydraulic_fluid_ratio_1"                // float
+	SimCockpit2HydraulicsIndicators_hydraulic_fluid_ratio_2                string = "sim/cockpit2/hydraulics/indicators/hydraulic_fluid_ratio_2"                // float
+	SimCockpit2HydraulicsIndicators_hydraulic_fluid_ratio_3                string = "sim/cockpit2/hydraulics/indicators/hydraulic_fluid_ratio_3"                // float
+	SimCockpit2Ice_ice_inlet_heat_on                                       string = "sim/cockpit2/ice/ice_inlet_heat_on"                                        // int, writable
+	SimCockpit2Ice_ice_prop_heat_on                                        string = "sim/cockpit2/ice/ice_prop_heat_on"                                         // int, writable
+	SimCockpit2Ice_ice_inlet_heat_on_per_engine                            string = "sim/cockpit2/ice/ice_inlet_heat_on_per_engine"                             // int_array, writable
+	SimCockpit2Ice_ice_prop_heat_on_per_engine                             string = "sim/cockpit2/ice/ice_prop_heat_on_per_engine"                              // int_array, writable
+	SimCockpit2Ice_ice_window_heat_on                                      string = "sim/cockpit2/ice/ice_window_heat_on"                                       // int, writable
+	SimCockpit2Ice_ice_window_heat_on_window                               string = "sim/cockpit2/ice/ice_window_heat_on_window"                                // int_array, writable
+	SimCockpit2Ice_ice_window_heat_running                                 string = "sim/cockpit2/ice/ice_window_heat_running"                                  // int_array
+	SimCockpit2Ice_ice_pitot_heat_on_pilot                                 string = "sim/cockpit2/ice/ice_pitot_heat_on_pilot"                                  // int, writable
+	SimCockpit2Ice_ice_pitot_heat_on_copilot                               string = "sim/cockpit2/ice/ice_pitot_heat_on_copilot"                                // int, writable
+	SimCockpit2Ice_ice_pitot_heat_on_standby                               string = "sim/cockpit2/ice/ice_pitot_heat_on_standby"                                // int, writable
+	SimCockpit2Ice_ice_AOA_heat_on                                         string = "sim/cockpit2/ice/ice_AOA_heat_on"                                          // int, writable
+	SimCockpit2Ice_ice_AOA_heat_on_copilot                                 string = "sim/cockpit2/ice/ice_AOA_heat_on_copilot"                                  // int, writable
+	SimCockpit2Ice_ice_static_heat_on_pilot                                string = "sim/cockpit2/ice/ice_static_heat_on_pilot"                                 // int, writable
+	SimCockpit2Ice_ice_static_heat_on_copilot                              string = "sim/cockpit2/ice/ice_static_heat_on_copilot"                               // int, writable
+	SimCockpit2Ice_ice_static_heat_on_standby                              string = "sim/cockpit2/ice/ice_static_heat_on_standby"                               // int, writable
+	SimCockpit2Ice_ice_surfce_heat_on                                      string = "sim/cockpit2/ice/ice_surfce_heat_on"                                       // int, writable
+	SimCockpit2Ice_ice_surfce_heat_left_on                                 string = "sim/cockpit2/ice/ice_surfce_heat_left_on"                                  // int, writable
+	SimCockpit2Ice_ice_surfce_heat_right_on                                string = "sim/cockpit2/ice/ice_surfce_heat_right_on"                                 // int, writable
+	SimCockpit2Ice_ice_surface_boot_on                                     string = "sim/cockpit2/ice/ice_surface_boot_on"                                      // int, writable
+	SimCockpit2Ice_ice_surface_boot_left_on                                string = "sim/cockpit2/ice/ice_surface_boot_left_on"                                 // int, writable
+	SimCockpit2Ice_ice_surface_boot_right_on                               string = "sim/cockpit2/ice/ice_surface_boot_right_on"                                // int, writable
+	SimCockpit2Ice_ice_surface_hot_bleed_air_on                            string = "sim/cockpit2/ice/ice_surface_hot_bleed_air_on"                             // int, writable
+	SimCockpit2Ice_ice_surface_hot_bleed_air_left_on                       string = "sim/cockpit2/ice/ice_surface_hot_bleed_air_left_on"                        // int, writable
+	SimCockpit2Ice_ice_surface_hot_bleed_air_right_on                      string = "sim/cockpit2/ice/ice_surface_hot_bleed_air_right_on"                       // int, writable
+	SimCockpit2Ice_ice_wing_hot_bleed_air_crossover_valve                  string = "sim/cockpit2/ice/ice_wing_hot_bleed_air_crossover_valve"                   // int, writable
+	SimCockpit2Ice_ice_surface_tks_on                                      string = "sim/cockpit2/ice/ice_surface_tks_on"                                       // int, writable
+	SimCockpit2Ice_ice_surface_tks_left_on                                 string = "sim/cockpit2/ice/ice_surface_tks_left_on"                                  // int, writable
+	SimCockpit2Ice_ice_surface_tks_right_on                                string = "sim/cockpit2/ice/ice_surface_tks_right_on"                                 // int, writable
+	SimCockpit2Ice_cowling_thermal_anti_ice_per_engine                     string = "sim/cockpit2/ice/cowling_thermal_anti_ice_per_engine"                      // int_array, writable
+	SimCockpit2Ice_ice_prop_tks_on_per_engine                              string = "sim/cockpit2/ice/ice_prop_tks_on_per_engine"                               // int_array, writable
+	SimCockpit2Ice_ice_wing_boots_inflation                                string = "sim/cockpit2/ice/ice_wing_boots_inflation"                                 // float_array, writable
+	SimCockpit2Ice_ice_tailplane_man                                       string = "sim/cockpit2/ice/ice_tailplane_man"                                        // int, writable
+	SimCockpit2Ice_ice_tail_heat_on                                        string = "sim/cockpit2/ice/ice_tail_heat_on"                                         // int, writable
+	SimCockpit2Ice_ice_tail_heat_left_on                                   string = "sim/cockpit2/ice/ice_tail_heat_left_on"                                    // int, writable
+	SimCockpit2Ice_ice_tail_heat_right_on                                  string = "sim/cockpit2/ice/ice_tail_heat_right_on"                                   // int, writable
+	SimCockpit2Ice_ice_tail_boot_on                                        string = "sim/cockpit2/ice/ice_tail_boot_on"                                         // int, writable
+	SimCockpit2Ice_ice_tail_boot_left_on                                   string = "sim/cockpit2/ice/ice_tail_boot_left_on"                                    // int, writable
+	SimCockpit2Ice_ice_tail_boot_right_on                                  string = "sim/cockpit2/ice/ice_tail_boot_right_on"                                   // int, writable
+	SimCockpit2Ice_ice_tail_hot_bleed_air_on                               string = "sim/cockpit2/ice/ice_tail_hot_bleed_air_on"                                // int, writable
+	SimCockpit2Ice_ice_tail_hot_bleed_air_left_on                          string = "sim/cockpit2/ice/ice_tail_hot_bleed_air_left_on"                           // int, writable
+	SimCockpit2Ice_ice_tail_hot_bleed_air_right_on                         string = "sim/cockpit2/ice/ice_tail_hot_bleed_air_right_on"                          // int, writable
+	SimCockpit2Ice_ice_tail_tks_on                                         string = "sim/cockpit2/ice/ice_tail_tks_on"                                          // int, writable
+	SimCockpit2Ice_ice_tail_tks_left_on                                    string = "sim/cockpit2/ice/ice_tail_tks_left_on"                                     // int, writable
+	SimCockpit2Ice_ice_tail_tks_right_on                                   string = "sim/cockpit2/ice/ice_tail_tks_right_on"                                    // int, writable
+	SimCockpit2Ice_ice_tail_boots_inflation                                string = "sim/cockpit2/ice/ice_tail_boots_inflation"                                 // float_array, writable
+	SimCockpit2Ice_anti_ice_engine_air                                     string = "sim/cockpit2/ice/anti_ice_engine_air"                                      // float_array, writable
+	SimCockpit2Ice_anti_ice_engine_air_b                                   string = "sim/cockpit2/ice/anti_ice_engine_air_b"                                    // float_array, writable
+	SimCockpit2Ice_ice_auto_ignite_on                                      string = "sim/cockpit2/ice/ice_auto_ignite_on"                                       // int, writable
+	SimCockpit2Ice_ice_detect_on                                           string = "sim/cockpit2/ice/ice_detect_on"                                            // int, writable
+	SimCockpit2Ice_ice_tks_fluid_liter                                     string = "sim/cockpit2/ice/ice_tks_fluid_liter"                                      // float, writable
+	SimCockpit2Ice_tks_backup_pump                                         string = "sim/cockpit2/ice/tks_backup_pump"                                          // int, writable
+	SimCockpit2Ice_tks_max_time_left                                       string = "sim/cockpit2/ice/tks_max_time_left"                                        // float, writable
+	SimCockpit2Ice_ice_deice_holdover_time_left_minutes                    string = "sim/cockpit2/ice/ice_deice_holdover_time_left_minutes"                     // float, writable
+	SimCockpit2PressurizationActuators_bleed_air_mode                      string = "sim/cockpit2/pressurization/actuators/bleed_air_mode"                      // int, writable
+	SimCockpit2PressurizationActuators_dump_all_on                         string = "sim/cockpit2/pressurization/actuators/dump_all_on"                         // int, writable
+	SimCockpit2PressurizationActuators_dump_to_altitude_on                 string = "sim/cockpit2/pressurization/actuators/dump_to_altitude_on"                 // int, writable
+	SimCockpit2PressurizationActuators_cabin_altitude_ft                   string = "sim/cockpit2/pressurization/actuators/cabin_altitude_ft"                   // float, writable
+	SimCockpit2PressurizationActuators_cabin_vvi_fpm                       string = "sim/cockpit2/pressurization/actuators/cabin_vvi_fpm"                       // float, writable
+	SimCockpit2PressurizationActuators_max_allowable_altitude_ft           string = "sim/cockpit2/pressurization/actuators/max_allowable_altitude_ft"           // float, writable
+	SimCockpit2PressurizationActuators_air_cond_on                         string = "sim/cockpit2/pressurization/actuators/air_cond_on"                         // int, writable
+	SimCockpit2PressurizationActuators_heater_on                           string = "sim/cockpit2/pressurization/actuators/heater_on"                           // int, writable
+	SimCockpit2PressurizationActuators_fan_setting                         string = "sim/cockpit2/pressurization/actuators/fan_setting"                         // int, writable
+	SimCockpit2PressurizationIndicators_cabin_altitude_ft                  string = "sim/cockpit2/pressurization/indicators/cabin_altitude_ft"                  // float
+	SimCockpit2PressurizationIndicators_cabin_vvi_fpm                      string = "sim/cockpit2/pressurization/indicators/cabin_vvi_fpm"                      // float
+	SimCockpit2PressurizationIndicators_pressure_diffential_psi            string = "sim/cockpit2/pressurization/indicators/pressure_diffential_psi"            // float
+	SimCockpit2PressurizationIndicators_outflow_valve                      string = "sim/cockpit2/pressurization/indicators/outflow_valve"                      // float, writable
+	SimCockpit2PressurizationIndicators_fan_speed                          string = "sim/cockpit2/pressurization/indicators/fan_speed"                          // float, writable
+	SimCockpit2BleedairActuators_engine_bleed_sov                          string = "sim/cockpit2/bleedair/actuators/engine_bleed_sov"                          // int_array, writable
+	SimCockpit2BleedairActuators_apu_bleed                                 string = "sim/cockpit2/bleedair/actuators/apu_bleed"                                 // int, writable
+	SimCockpit2BleedairActuators_gpu_bleed                                 string = "sim/cockpit2/bleedair/actuators/gpu_bleed"                                 // int, writable
+	SimCockpit2BleedairActuators_isol_valve_left                           string = "sim/cockpit2/bleedair/actuators/isol_valve_left"                           // int, writable
+	SimCockpit2BleedairActuators_isol_valve_right                          string = "sim/cockpit2/bleedair/actuators/isol_valve_right"                          // int, writable
+	SimCockpit2BleedairActuators_instrument_air_left                       string = "sim/cockpit2/bleedair/actuators/instrument_air_left"                       // int, writable
+	SimCockpit2BleedairActuators_instrument_air_right                      string = "sim/cockpit2/bleedair/actuators/instrument_air_right"                      // int, writable
+	SimCockpit2BleedairActuators_pack_left                                 string = "sim/cockpit2/bleedair/actuators/pack_left"                                 // int, writable
+	SimCockpit2BleedairActuators_pack_center                               string = "sim/cockpit2/bleedair/actuators/pack_center"                               // int, writable
+	SimCockpit2BleedairActuators_pack_right                                string = "sim/cockpit2/bleedair/actuators/pack_right"                                // int, writable
+	SimCockpit2BleedairIndicators_bleed_available_left                     string = "sim/cockpit2/bleedair/indicators/bleed_available_left"                     // float, writable
+	SimCockpit2BleedairIndicators_bleed_available_center                   string = "sim/cockpit2/bleedair/indicators/bleed_available_center"                   // float, writable
+	SimCockpit2BleedairIndicators_bleed_available_right                    string = "sim/cockpit2/bleedair/indicators/bleed_available_right"                    // float, writable
+	SimCockpit2BleedairIndicators_engine_loss_from_bleed_air_ratio         string = "sim/cockpit2/bleedair/indicators/engine_loss_from_bleed_air_ratio"         // float_array, writable
+	SimCockpit2BleedairIndicators_APU_loss_from_bleed_air_ratio            string = "sim/cockpit2/bleedair/indicators/APU_loss_from_bleed_air_ratio"            // float, writable
+	SimCockpit2OxygenActuators_o2_valve_on                                 string = "sim/cockpit2/oxygen/actuators/o2_valve_on"                                 // int, writable
+	SimCockpit2OxygenActuators_demand_flow_setting                         string = "sim/cockpit2/oxygen/actuators/demand_flow_setting"                         // int, writable
+	SimCockpit2OxygenActuators_num_plugged_in_o2                           string = "sim/cockpit2/oxygen/actuators/num_plugged_in_o2"                           // int, writable
+	SimCockpit2OxygenIndicators_o2_bottle_rem_liter                        string = "sim/cockpit2/oxygen/indicators/o2_bottle_rem_liter"                        // float, writable
+	SimCockpit2OxygenIndicators_o2_bottle_pressure_psi                     string = "sim/cockpit2/oxygen/indicators/o2_bottle_pressure_psi"                     // float, writable
+	SimCockpit2OxygenIndicators_pilot_felt_altitude_ft                     string = "sim/cockpit2/oxygen/indicators/pilot_felt_altitude_ft"                     // float, writable
+	SimCockpit2OxygenIndicators_pass_oxygenator_working                    string = "sim/cockpit2/oxygen/indicators/pass_oxygenator_working"                    // int
+	SimCockpit2OxygenIndicators_pass_oxygenator_min_rem                    string = "sim/cockpit2/oxygen/indicators/pass_oxygenator_min_rem"                    // float, writable
+	SimCockpit2RadiosActuators_nav1_power                                  string = "sim/cockpit2/radios/actuators/nav1_power"                                  // int, writable
+	SimCockpit2RadiosActuators_nav2_power                                  string = "sim/cockpit2/radios/actuators/nav2_power"                                  // int, writable
+	SimCockpit2RadiosActuators_nav1_tuning_mode                            string = "sim/cockpit2/radios/actuators/nav1_tuning_mode"                            // int
+	SimCockpit2RadiosActuators_nav2_tuning_mode                            string = "sim/cockpit2/radios/actuators/nav2_tuning_mode"                            // int
+	SimCockpit2RadiosActuators_nav_power                                   string = "sim/cockpit2/radios/actuators/nav_power"                                   // int_array, writable
+	SimCockpit2RadiosActuators_com1_power                                  string = "sim/cockpit2/radios/actuators/com1_power"                                  // int, writable
+	SimCockpit2RadiosActuators_com2_power                                  string = "sim/cockpit2/radios/actuators/com2_power"                                  // int, writable
+	SimCockpit2RadiosActuators_adf1_power                                  string = "sim/cockpit2/radios/actuators/adf1_power"                                  // int, writable
+	SimCockpit2RadiosActuators_adf2_power                                  string = "sim/cockpit2/radios/actuators/adf2_power"                                  // int, writable
+	SimCockpit2RadiosActuators_gps_power                                   string = "sim/cockpit2/radios/actuators/gps_power"                                   // int, writable
+	SimCockpit2RadiosActuators_gps2_power                                  string = "sim/cockpit2/radios/actuators/gps2_power"                                  // int, writable
+	SimCockpit2RadiosActuators_dme_power                                   string = "sim/cockpit2/radios/actuators/dme_power"                                   // int, writable
+	SimCockpit2RadiosActuators_nav1_frequency_hz                           string = "sim/cockpit2/radios/actuators/nav1_frequency_hz"                           // int, writable
+	SimCockpit2RadiosActuators_nav1_frequency_Mhz                          string = "sim/cockpit2/radios/actuators/nav1_frequency_Mhz"                          // int, writable
+	SimCockpit2RadiosActuators_nav1_frequency_khz                          string = "sim/cockpit2/radios/actuators/nav1_frequency_khz"                          // int, writable
+	SimCockpit2RadiosActuators_nav2_frequency_hz                           string = "sim/cockpit2/radios/actuators/nav2_frequency_hz"                           // int, writable
+	SimCockpit2RadiosActuators_nav2_frequency_Mhz                          string = "sim/cockpit2/radios/actuators/nav2_frequency_Mhz"                          // int, writable
+	SimCockpit2RadiosActuators_nav2_frequency_khz                          string = "sim/cockpit2/radios/actuators/nav2_frequency_khz"                          // int, writable
+	SimCockpit2RadiosActuators_nav_frequency_hz                            string = "sim/cockpit2/radios/actuators/nav_frequency_hz"                            // int_array, writable
+	SimCockpit2RadiosActuators_nav_frequency_Mhz                           string = "sim/cockpit2/radios/actuators/nav_frequency_Mhz"                           // int_array, writable
+	SimCockpit2RadiosActuators_nav_frequency_khz                           string = "sim/cockpit2/radios/actuators/nav_frequency_khz"                           // int_array, writable
+	SimCockpit2RadiosActuators_tac1_channel                                string = "sim/cockpit2/radios/actuators/tac1_channel"                                // int, writable
+	SimCockpit2RadiosActuators_tac1_mode                                   string = "sim/cockpit2/radios/actuators/tac1_mode"                                   // int, writable
+	SimCockpit2RadiosActuators_tac2_channel                                string = "sim/cockpit2/radios/actuators/tac2_channel"                                // int, writable
+	SimCockpit2RadiosActuators_tac2_mode                                   string = "sim/cockpit2/radios/actuators/tac2_mode"                                   // int, writable
+	SimCockpit2RadiosActuators_tac_channel                                 string = "sim/cockpit2/radios/actuators/tac_channel"                                 // int_array, writable
+	SimCockpit2RadiosActuators_tac_mode                                    string = "sim/cockpit2/radios/actuators/tac_mode"                                    // int_array, writable
+	SimCockpit2RadiosActuators_nav_receiver_glideslope_off                 string = "sim/cockpit2/radios/actuators/nav_receiver_glideslope_off"                 // int_array, writable
+	SimCockpit2RadiosActuators_nav_dme_hold                                string = "sim/cockpit2/radios/actuators/nav_dme_hold"                                // int_array, writable
+	SimCockpit2RadiosActuators_nav_dme_frequency_hz                        string = "sim/cockpit2/radios/actuators/nav_dme_frequency_hz"                        // int_array, writable
+	SimCockpit2RadiosActuators_nav_dme_frequency_Mhz                       string = "sim/cockpit2/radios/actuators/nav_dme_frequency_Mhz"                       // int_array, writable
+	SimCockpit2RadiosActuators_nav_dme_frequency_khz                       string = "sim/cockpit2/radios/actuators/nav_dme_frequency_khz"                       // int_array, writable
+	SimCockpit2RadiosActuators_com1_frequency_hz                           string = "sim/cockpit2/radios/actuators/com1_frequency_hz"                           // int, writable
+	SimCockpit2RadiosActuators_com1_frequency_Mhz                          string = "sim/cockpit2/radios/actuators/com1_frequency_Mhz"                          // int, writable
+	SimCockpit2RadiosActuators_com1_frequency_khz                          string = "sim/cockpit2/radios/actuators/com1_frequency_khz"                          // int, writable
+	SimCockpit2RadiosActuators_com1_frequency_hz_833                       string = "sim/cockpit2/radios/actuators/com1_frequency_hz_833"                       // int, writable
+	SimCockpit2RadiosActuators_com2_frequency_hz                           string = "sim/cockpit2/radios/actuators/com2_frequency_hz"                           // int, writable
+	SimCockpit2RadiosActuators_com2_frequency_Mhz                          string = "sim/cockpit2/radios/actuators/com2_frequency_Mhz"                          // int, writable
+	SimCockpit2RadiosActuators_com2_frequency_khz                          string = "sim/cockpit2/radios/actuators/com2_frequency_khz"                          // int, writable
+	SimCockpit2RadiosActuators_com2_frequency_hz_833                       string = "sim/cockpit2/radios/actuators/com2_frequency_hz_833"                       // int, writable
+	SimCockpit2RadiosActuators_adf1_frequency_hz                           string = "sim/cockpit2/radios/actuators/adf1_frequency_hz"                           // int, writable
+	SimCockpit2RadiosActuators_adf2_frequency_hz                           string = "sim/cockpit2/radios/actuators/adf2_frequency_hz"                           // int, writable
+	SimCockpit2RadiosActuators_dme_frequency_hz                            string = "sim/cockpit2/radios/actuators/dme_frequency_hz"                            // int, writable
+	SimCockpit2RadiosActuators_nav1_standby_frequency_hz                   string = "sim/cockpit2/radios/actuators/nav1_standby_frequency_hz"                   // int, writable
+	SimCockpit2RadiosActuators_nav1_standby_frequency_Mhz                  string = "sim/cockpit2/radios/actuators/nav1_standby_frequency_Mhz"                  // int, writable
+	SimCockpit2RadiosActuators_nav1_standby_frequency_khz                  string = "sim/cockpit2/radios/actuators/nav1_standby_frequency_khz"                  // int, writable
+	SimCockpit2RadiosActuators_nav2_standby_frequency_hz                   string = "sim/cockpit2/radios/actuators/nav2_standby_frequency_hz"                   // int, writable
+	SimCockpit2RadiosActuators_nav2_standby_frequency_Mhz                  string = "sim/cockpit2/radios/actuators/nav2_standby_frequency_Mhz"                  // int, writable
+	SimCockpit2RadiosActuators_nav2_standby_frequency_khz                  string = "sim/cockpit2/radios/actuators/nav2_standby_frequency_khz"                  // int, writable
+	SimCockpit2RadiosActuators_nav_standby_frequency_hz                    string = "sim/cockpit2/radios/actuators/nav_standby_frequency_hz"                    // int_array, writable
+	SimCockpit2RadiosActuators_nav_standby_frequency_Mhz                   string = "sim/cockpit2/radios/actuators/nav_standby_frequency_Mhz"                   // int_array, writable
+	SimCockpit2RadiosActuators_nav_standby_frequency_khz                   string = "sim/cockpit2/radios/actuators/nav_standby_frequency_khz"                   // int_array, writable
+	SimCockpit2RadiosActuators_com1_standby_frequency_hz                   string = "sim/cockpit2/radios/actuators/com1_standby_frequency_hz"                   // int, writable
+	SimCockpit2RadiosActuators_com1_standby_frequency_Mhz                  string = "sim/cockpit2/radios/actuators/com1_standby_frequency_Mhz"                  // int, writable
+	SimCockpit2RadiosActuators_com1_standby_frequency_khz                  string = "sim/cockpit2/radios/actuators/com1_standby_frequency_khz"                  // int, writable
+	SimCockpit2RadiosActuators_com1_standby_frequency_hz_833               string = "sim/cockpit2/radios/actuators/com1_standby_frequency_hz_833"               // int, writable
+	SimCockpit2RadiosActuators_com2_standby_frequency_hz                   string = "sim/cockpit2/radios/actuators/com2_standby_frequency_hz"                   // int, writable
+	SimCockpit2RadiosActuators_com2_standby_frequency_Mhz                  string = "sim/cockpit2/radios/actuators/com2_standby_frequency_Mhz"                  // int, writable
+	SimCockpit2RadiosActuators_com2_standby_frequency_khz                  string = "sim/cockpit2/radios/actuators/com2_standby_frequency_khz"                  // int, writable
+	SimCockpit2RadiosActuators_com2_standby_frequency_hz_833               string = "sim/cockpit2/radios/actuators/com2_standby_frequency_hz_833"               // int, writable
+	SimCockpit2RadiosActuators_adf1_standby_frequency_hz                   string = "sim/cockpit2/radios/actuators/adf1_standby_frequency_hz"                   // int, writable
+	SimCockpit2RadiosActuators_adf2_standby_frequency_hz                   string = "sim/cockpit2/radios/actuators/adf2_standby_frequency_hz"                   // int, writable
+	SimCockpit2RadiosActuators_dme_standby_frequency_hz                    string = "sim/cockpit2/radios/actuators/dme_standby_frequency_hz"                    // int, writable
+	SimCockpit2RadiosActuators_nav1_obs_deg_mag_pilot                      string = "sim/cockpit2/radios/actuators/nav1_obs_deg_mag_pilot"                      // float, writable
+	SimCockpit2RadiosActuators_nav2_obs_deg_mag_pilot                      string = "sim/cockpit2/radios/actuators/nav2_obs_deg_mag_pilot"                      // float, writable
+	SimCockpit2RadiosActuators_nav_obs_deg_mag_pilot                       string = "sim/cockpit2/radios/actuators/nav_obs_deg_mag_pilot"                       // float_array, writable
+	SimCockpit2RadiosActuators_nav1_obs_deg_mag_copilot                    string = "sim/cockpit2/radios/actuators/nav1_obs_deg_mag_copilot"                    // float, writable
+	SimCockpit2RadiosActuators_nav2_obs_deg_mag_copilot                    string = "sim/cockpit2/radios/actuators/nav2_obs_deg_mag_copilot"                    // float, writable
+	SimCockpit2RadiosActuators_nav_obs_deg_mag_copilot                     string = "sim/cockpit2/radios/actuators/nav_obs_deg_mag_copilot"                     // float_array, writable
+	SimCockpit2RadiosActuators_adf1_card_heading_deg_mag_pilot             string = "sim/cockpit2/radios/actuators/adf1_card_heading_deg_mag_pilot"             // float, writable
+	SimCockpit2RadiosActuators_adf2_card_heading_deg_mag_pilot             string = "sim/cockpit2/radios/actuators/adf2_card_heading_deg_mag_pilot"             // float, writable
+	SimCockpit2RadiosActuators_adf1_card_heading_deg_mag_copilot           string = "sim/cockpit2/radios/actuators/adf1_card_heading_deg_mag_copilot"           // float, writable
+	SimCockpit2RadiosActuators_adf2_card_heading_deg_mag_copilot           string = "sim/cockpit2/radios/actuators/adf2_card_heading_deg_mag_copilot"           // float, writable
+	SimCockpit2RadiosActuators_nav1_course_deg_mag_pilot                   string = "sim/cockpit2/radios/actuators/nav1_course_deg_mag_pilot"                   // float, writable
+	SimCockpit2RadiosActuators_nav2_course_deg_mag_pilot                   string = "sim/cockpit2/radios/actuators/nav2_course_deg_mag_pilot"                   // float, writable
+	SimCockpit2RadiosActuators_nav_course_deg_mag_pilot                    string = "sim/cockpit2/radios/actuators/nav_course_deg_mag_pilot"                    // float_array, writable
+	SimCockpit2RadiosActuators_nav1_course_deg_mag_copilot                 string = "sim/cockpit2/radios/actuators/nav1_course_deg_mag_copilot"                 // float, writable
+	SimCockpit2RadiosActuators_nav2_course_deg_mag_copilot                 string = "sim/cockpit2/radios/actuators/nav2_course_deg_mag_copilot"                 // float, writable
+	SimCockpit2RadiosActuators_nav_course_deg_mag_copilot                  string = "sim/cockpit2/radios/actuators/nav_course_deg_mag_copilot"                  // float_array, writable
+	SimCockpit2RadiosActuators_HSI_source_select_pilot                     string = "sim/cockpit2/radios/actuators/HSI_source_select_pilot"                     // int, writable
+	SimCockpit2RadiosActuators_HSI_source_select_copilot                   string = "sim/cockpit2/radios/actuators/HSI_source_select_copilot"                   // int, writable
+	SimCockpit2RadiosActuators_RMI_source_select_pilot                     string = "sim/cockpit2/radios/actuators/RMI_source_select_pilot"                     // int, writable
+	SimCockpit2RadiosActuators_RMI_source_select_copilot                   string = "sim/cockpit2/radios/actuators/RMI_source_select_copilot"                   // int, writable
+	SimCockpit2RadiosActuators_HSI_preview_source_select_pilot             string = "sim/cockpit2/radios/actuators/HSI_preview_source_select_pilot"             // int, writable
+	SimCockpit2RadiosActuators_HSI_preview_source_select_copilot           string = "sim/cockpit2/radios/actuators/HSI_preview_source_select_copilot"           // int, writable
+	SimCockpit2RadiosActuators_RMI_left_use_adf_pilot                      string = "sim/cockpit2/radios/actuators/RMI_left_use_adf_pilot"                      // int, writable
+	SimCockpit2RadiosActuators_RMI_left_use_adf_copilot                    string = "sim/cockpit2/radios/actuators/RMI_left_use_adf_copilot"                    // int, writable
+	SimCockpit2RadiosActuators_RMI_right_use_adf_pilot                     string = "sim/cockpit2/radios/actuators/RMI_right_use_adf_pilot"                     // int, writable
+	SimCockpit2RadiosActuators_RMI_right_use_adf_copilot                   string = "sim/cockpit2/radios/actuators/RMI_right_use_adf_copilot"                   // int, writable
+	SimCockpit2RadiosActuators_DME_mode                                    string = "sim/cockpit2/radios/actuators/DME_mode"                                    // int, writable
+	SimCockpit2RadiosActuators_DME_slave_source                            string = "sim/cockpit2/radios/actuators/DME_slave_source"                            // int, writable
+	SimCockpit2RadiosActuators_nav_com_adf_mode                            string = "sim/cockpit2/radios/actuators/nav_com_adf_mode"                            // int, writable
+	SimCockpit2RadiosActuators_transponder_code                            string = "sim/cockpit2/radios/actuators/transponder_code"                            // int, writable
+	SimCockpit2RadiosActuators_flight_id                                   string = "sim/cockpit2/radios/actuators/flight_id"                                   // data, writable
+	SimCockpit2RadiosActuators_transponder_mode                            string = "sim/cockpit2/radios/actuators/transponder_mode"                            // int, writable
+	SimCockpit2RadiosActuators_audio_com_selection                         string = "sim/cockpit2/radios/actuators/audio_com_selection"                         // int, writable
+	SimCockpit2RadiosActuators_audio_nav_selection                         string = "sim/cockpit2/radios/actuators/audio_nav_selection"                         // int, writable
+	SimCockpit2RadiosActuators_audio_com_selection_man                     string = "sim/cockpit2/radios/actuators/audio_com_selection_man"                     // int, writable
+	SimCockpit2RadiosActuators_audio_selection_com_auto                    string = "sim/cockpit2/radios/actuators/audio_selection_com_auto"                    // int, writable
+	SimCockpit2RadiosActuators_audio_selection_com1                        string = "sim/cockpit2/radios/actuators/audio_selection_com1"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_com2                        string = "sim/cockpit2/radios/actuators/audio_selection_com2"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav1                        string = "sim/cockpit2/radios/actuators/audio_selection_nav1"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav2                        string = "sim/cockpit2/radios/actuators/audio_selection_nav2"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_adf1                        string = "sim/cockpit2/radios/actuators/audio_selection_adf1"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_adf2                        string = "sim/cockpit2/radios/actuators/audio_selection_adf2"                        // int, writable
+	SimCockpit2RadiosActuators_audio_dme_enabled                           string = "sim/cockpit2/radios/actuators/audio_dme_enabled"                           // int, writable
+	SimCockpit2RadiosActuators_audio_selection_dme1                        string = "sim/cockpit2/radios/actuators/audio_selection_dme1"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_dme2                        string = "sim/cockpit2/radios/actuators/audio_selection_dme2"                        // int, writable
+	SimCockpit2RadiosActuators_audio_marker_enabled                        string = "sim/cockpit2/radios/actuators/audio_marker_enabled"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav3                        string = "sim/cockpit2/radios/actuators/audio_selection_nav3"                        // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav4                        string = "sim/cockpit2/radios/actuators/audio_selection_nav4"                        // int, writable
+	SimCockpit2RadiosActuators_audio_volume_com1                           string = "sim/cockpit2/radios/actuators/audio_volume_com1"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_com2                           string = "sim/cockpit2/radios/actuators/audio_volume_com2"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav1                           string = "sim/cockpit2/radios/actuators/audio_volume_nav1"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav2                           string = "sim/cockpit2/radios/actuators/audio_volume_nav2"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_adf1                           string = "sim/cockpit2/radios/actuators/audio_volume_adf1"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_adf2                           string = "sim/cockpit2/radios/actuators/audio_volume_adf2"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_dme                            string = "sim/cockpit2/radios/actuators/audio_volume_dme"                            // float, writable
+	SimCockpit2RadiosActuators_audio_volume_dme1                           string = "sim/cockpit2/radios/actuators/audio_volume_dme1"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_dme2                           string = "sim/cockpit2/radios/actuators/audio_volume_dme2"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_mark                           string = "sim/cockpit2/radios/actuators/audio_volume_mark"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav3                           string = "sim/cockpit2/radios/actuators/audio_volume_nav3"                           // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav4                           string = "sim/cockpit2/radios/actuators/audio_volume_nav4"                           // float, writable
+	SimCockpit2RadiosActuators_audio_com_selection_man_copilot             string = "sim/cockpit2/radios/actuators/audio_com_selection_man_copilot"             // int, writable
+	SimCockpit2RadiosActuators_audio_selection_com_auto_copilot            string = "sim/cockpit2/radios/actuators/audio_selection_com_auto_copilot"            // int, writable
+	SimCockpit2RadiosActuators_audio_selection_com1_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_com1_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_com2_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_com2_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav1_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_nav1_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav2_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_nav2_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_adf1_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_adf1_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_adf2_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_adf2_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_dme_enabled_copilot                   string = "sim/cockpit2/radios/actuators/audio_dme_enabled_copilot"                   // int, writable
+	SimCockpit2RadiosActuators_audio_selection_dme1_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_dme1_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_dme2_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_dme2_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_marker_enabled_copilot                string = "sim/cockpit2/radios/actuators/audio_marker_enabled_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav3_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_nav3_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_selection_nav4_copilot                string = "sim/cockpit2/radios/actuators/audio_selection_nav4_copilot"                // int, writable
+	SimCockpit2RadiosActuators_audio_volume_com1_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_com1_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_com2_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_com2_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav1_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_nav1_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav2_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_nav2_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_adf1_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_adf1_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_adf2_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_adf2_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_dme_copilot                    string = "sim/cockpit2/radios/actuators/audio_volume_dme_copilot"                    // float, writable
+	SimCockpit2RadiosActuators_audio_volume_dme1_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_dme1_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_dme2_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_dme2_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_mark_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_mark_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav3_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_nav3_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_audio_volume_nav4_copilot                   string = "sim/cockpit2/radios/actuators/audio_volume_nav4_copilot"                   // float, writable
+	SimCockpit2RadiosActuators_hsi_obs_deg_mag_pilot                       string = "sim/cockpit2/radios/actuators/hsi_obs_deg_mag_pilot"                       // float, writable
+	SimCockpit2RadiosActuators_hsi_obs_deg_mag_copilot                     string = "sim/cockpit2/radios/actuators/hsi_obs_deg_mag_copilot"                     // float, writable
+	SimCockpit2RadiosActuators_nav1_left_frequency_hz                      string = "sim/cockpit2/radios/actuators/nav1_left_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_nav2_left_frequency_hz                      string = "sim/cockpit2/radios/actuators/nav2_left_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_com1_left_frequency_hz                      string = "sim/cockpit2/radios/actuators/com1_left_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_com1_left_frequency_hz_833                  string = "sim/cockpit2/radios/actuators/com1_left_frequency_hz_833"                  // int, writable
+	SimCockpit2RadiosActuators_com2_left_frequency_hz                      string = "sim/cockpit2/radios/actuators/com2_left_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_com2_left_frequency_hz_833                  string = "sim/cockpit2/radios/actuators/com2_left_frequency_hz_833"                  // int, writable
+	SimCockpit2RadiosActuators_adf1_left_frequency_hz                      string = "sim/cockpit2/radios/actuators/adf1_left_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_adf2_left_frequency_hz                      string = "sim/cockpit2/radios/actuators/adf2_left_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_dme_left_frequency_hz                       string = "sim/cockpit2/radios/actuators/dme_left_frequency_hz"                       // int, writable
+	SimCockpit2RadiosActuators_nav1_right_frequency_hz                     string = "sim/cockpit2/radios/actuators/nav1_right_frequency_hz"                     // int, writable
+	SimCockpit2RadiosActuators_nav2_right_frequency_hz                     string = "sim/cockpit2/radios/actuators/nav2_right_frequency_hz"                     // int, writable
+	SimCockpit2RadiosActuators_com1_right_frequency_hz                     string = "sim/cockpit2/radios/actuators/com1_right_frequency_hz"                     // int, writable
+	SimCockpit2RadiosActuators_com2_right_frequency_hz                     string = "sim/cockpit2/radios/actuators/com2_right_frequency_hz"                     // int, writable
+	SimCockpit2RadiosActuators_adf1_right_frequency_hz                     string = "sim/cockpit2/radios/actuators/adf1_right_frequency_hz"                     // int, writable
+	SimCockpit2RadiosActuators_adf2_right_frequency_hz                     string = "sim/cockpit2/radios/actuators/adf2_right_frequency_hz"                     // int, writable
+	SimCockpit2RadiosActuators_dme_right_frequency_hz                      string = "sim/cockpit2/radios/actuators/dme_right_frequency_hz"                      // int, writable
+	SimCockpit2RadiosActuators_nav1_right_is_selected                      string = "sim/cockpit2/radios/actuators/nav1_right_is_selected"                      // int, writable
+	SimCockpit2RadiosActuators_nav2_right_is_selected                      string = "sim/cockpit2/radios/actuators/nav2_right_is_selected"                      // int, writable
+	SimCockpit2RadiosActuators_com1_right_is_selected                      string = "sim/cockpit2/radios/actuators/com1_right_is_selected"                      // int, writable
+	SimCockpit2RadiosActuators_com2_right_is_selected                      string = "sim/cockpit2/radios/actuators/com2_right_is_selected"                      // int, writable
+	SimCockpit2RadiosActuators_adf1_right_is_selected                      string = "sim/cockpit2/radios/actuators/adf1_right_is_selected"                      // int, writable
+	SimCockpit2RadiosActuators_adf2_right_is_selected                      string = "sim/cockpit2/radios/actuators/adf2_right_is_selected"                      // int, writable
+	SimCockpit2RadiosActuators_dme_right_is_selected                       string = "sim/cockpit2/radios/actuators/dme_right_is_selected"                       // int, writable
+	SimCockpit2RadiosActuators_marker_sens                                 string = "sim/cockpit2/radios/actuators/marker_sens"                                 // int, writable
+	SimCockpit2RadiosIndicators_nav1_bearing_deg_mag                       string = "sim/cockpit2/radios/indicators/nav1_bearing_deg_mag"                       // float, writable
+	SimCockpit2RadiosIndicators_nav2_bearing_deg_mag                       string = "sim/cockpit2/radios/indicators/nav2_bearing_deg_mag"                       // float, writable
+	SimCockpit2RadiosIndicators_nav_bearing_deg_mag                        string = "sim/cockpit2/radios/indicators/nav_bearing_deg_mag"                        // float_array, writable
+	SimCockpit2RadiosIndicators_adf1_bearing_deg_mag                       string = "sim/cockpit2/radios/indicators/adf1_bearing_deg_mag"                       // float, writable
+	SimCockpit2RadiosIndicators_adf2_bearing_deg_mag                       string = "sim/cockpit2/radios/indicators/adf2_bearing_deg_mag"                       // float, writable
+	SimCockpit2RadiosIndicators_gps_bearing_deg_mag                        string = "sim/cockpit2/radios/indicators/gps_bearing_deg_mag"                        // float, writable
+	SimCockpit2RadiosIndicators_gps2_bearing_deg_mag                       string = "sim/cockpit2/radios/indicators/gps2_bearing_deg_mag"                       // float, writable
+	SimCockpit2RadiosIndicators_nav1_relative_bearing_deg                  string = "sim/cockpit2/radios/indicators/nav1_relative_bearing_deg"                  // float, writable
+	SimCockpit2RadiosIndicators_nav2_relative_bearing_deg                  string = "sim/cockpit2/radios/indicators/nav2_relative_bearing_deg"                  // float, writable
+	SimCockpit2RadiosIndicators_nav_relative_bearing_deg                   string = "sim/cockpit2/radios/indicators/nav_relative_bearing_deg"                   // float_array, writable
+	SimCockpit2RadiosIndicators_adf1_relative_bearing_deg                  string = "sim/cockpit2/radios/indicators/adf1_relative_bearing_deg"                  // float, writable
+	SimCockpit2RadiosIndicators_adf2_relative_bearing_deg                  string = "sim/cockpit2/radios/indicators/adf2_relative_bearing_deg"                  // float, writable
+	SimCockpit2RadiosIndicators_gps_relative_bearing_deg                   string = "sim/cockpit2/radios/indicators/gps_relative_bearing_deg"                   // float, writable
+	SimCockpit2RadiosIndicators_gps2_relative_bearing_deg                  string = "sim/cockpit2/radios/indicators/gps2_relative_bearing_deg"                  // float, writable
+	SimCockpit2RadiosIndicators_nav1_flag_from_to_pilot                    string = "sim/cockpit2/radios/indicators/nav1_flag_from_to_pilot"                    // int, writable
+	SimCockpit2RadiosIndicators_nav2_flag_from_to_pilot                    string = "sim/cockpit2/radios/indicators/nav2_flag_from_to_pilot"                    // int, writable
+	SimCockpit2RadiosIndicators_nav_flag_from_to_pilot                     string = "sim/cockpit2/radios/indicators/nav_flag_from_to_pilot"                     // int_array, writable
+	SimCockpit2RadiosIndicators_nav1_flag_from_to_copilot                  string = "sim/cockpit2/radios/indicators/nav1_flag_from_to_copilot"                  // int, writable
+	SimCockpit2RadiosIndicators_nav2_flag_from_to_copilot                  string = "sim/cockpit2/radios/indicators/nav2_flag_from_to_copilot"                  // int, writable
+	SimCockpit2RadiosIndicators_nav_flag_from_to_copilot                   string = "sim/cockpit2/radios/indicators/nav_flag_from_to_copilot"                   // int_array, writable
+	SimCockpit2RadiosIndicators_nav1_flag_glideslope                       string = "sim/cockpit2/radios/indicators/nav1_flag_glideslope"                       // int
+	SimCockpit2RadiosIndicators_nav2_flag_glideslope                       string = "sim/cockpit2/radios/indicators/nav2_flag_glideslope"                       // int
+	SimCockpit2RadiosIndicators_nav_flag_glideslope                        string = "sim/cockpit2/radios/indicators/nav_flag_glideslope"                        // int_array
+	SimCockpit2RadiosIndicators_nav1_flag_glideslope_mech                  string = "sim/cockpit2/radios/indicators/nav1_flag_glideslope_mech"                  // int
+	SimCockpit2RadiosIndicators_nav2_flag_glideslope_mech                  string = "sim/cockpit2/radios/indicators/nav2_flag_glideslope_mech"                  // int
+	SimCockpit2RadiosIndicators_nav_flag_glideslope_mech                   string = "sim/cockpit2/radios/indicators/nav_flag_glideslope_mech"                   // int_array
+	SimCockpit2RadiosIndicators_nav1_display_horizontal                    string = "sim/cockpit2/radios/indicators/nav1_display_horizontal"                    // int
+	SimCockpit2RadiosIndicators_nav2_display_horizontal                    string = "sim/cockpit2/radios/indicators/nav2_display_horizontal"                    // int
+	SimCockpit2RadiosIndicators_nav_display_horizontal                     string = "sim/cockpit2/radios/indicators/nav_display_horizontal"                     // int_array
+	SimCockpit2RadiosIndicators_nav1_display_vertical                      string = "sim/cockpit2/radios/indicators/nav1_display_vertical"                      // int
+	SimCockpit2RadiosIndicators_nav2_display_vertical                      string = "sim/cockpit2/radios/indicators/nav2_display_vertical"                      // int
+	SimCockpit2RadiosIndicators_nav_display_vertical                       string = "sim/cockpit2/radios/indicators/nav_display_vertical"                       // int_array
+	SimCockpit2RadiosIndicators_nav1_hdef_dots_pilot                       string = "sim/cockpit2/radios/indicators/nav1_hdef_dots_pilot"                       // float, writable
+	SimCockpit2RadiosIndicators_nav2_hdef_dots_pilot                       string = "sim/cockpit2/radios/indicators/nav2_hdef_dots_pilot"                       // float, writable
+	SimCockpit2RadiosIndicators_nav_hdef_dots_pilot                        string = "sim/cockpit2/radios/indicators/nav_hdef_dots_pilot"                        // float_array, writable
+	SimCockpit2RadiosIndicators_gps_hdef_dots_pilot                        string = "sim/cockpit2/radios/indicators/gps_hdef_dots_pilot"                        // float, writable
+	SimCockpit2RadiosIndicators_gps2_hdef_dots_pilot                       string = "sim/cockpit2/radios/indicators/gps2_hdef_dots_pilot"                       // float, writable
+	SimCockpit2RadiosIndicators_gps_xtk                                    string = "sim/cockpit2/radios/indicators/gps_xtk"                                    // float, writable
+	SimCockpit2RadiosIndicators_gps2_xtk                                   string = "sim/cockpit2/radios/indicators/gps2_xtk"                                   // float, writable
+	SimCockpit2RadiosIndicators_nav1_hdef_dots_copilot                     string = "sim/cockpit2/radios/indicators/nav1_hdef_dots_copilot"                     // float, writable
+	SimCockpit2RadiosIndicators_nav2_hdef_dots_copilot                     string = "sim/cockpit2/radios/indicators/nav2_hdef_dots_copilot"                     // float, writable
+	SimCockpit2RadiosIndicators_nav_hdef_dots_copilot                      string = "sim/cockpit2/radios/indicators/nav_hdef_dots_copilot"                      // float_array, writable
+	SimCockpit2RadiosIndicators_gps_hdef_dots_copilot                      string = "sim/cockpit2/radios/indicators/gps_hdef_dots_copilot"                      // float, writable
+	SimCockpit2RadiosIndicators_gps2_hdef_dots_copilot                     string = "sim/cockpit2/radios/indicators/gps2_hdef_dots_copilot"                     // float, writable
+	SimCockpit2RadiosIndicators_nav1_vdef_dots_pilot                       string = "sim/cockpit2/radios/indicators/nav1_vdef_dots_pilot"                       // float, writable
+	SimCockpit2RadiosIndicators_nav2_vdef_dots_pilot                       string = "sim/cockpit2/radios/indicators/nav2_vdef_dots_pilot"                       // float, writable
+	SimCockpit2RadiosIndicators_nav_vdef_dots_pilot                        string = "sim/cockpit2/radios/indicators/nav_vdef_dots_pilot"                        // float_array, writable
+	SimCockpit2RadiosIndicators_nav1_vdef_dots_copilot                     string = "sim/cockpit2/radios/indicators/nav1_vdef_dots_copilot"                     // float, writable
+	SimCockpit2RadiosIndicators_nav2_vdef_dots_copilot                     string = "sim/cockpit2/radios/indicators/nav2_vdef_dots_copilot"                     // float, writable
+	SimCockpit2RadiosIndicators_nav_vdef_dots_copilot                      string = "sim/cockpit2/radios/indicators/nav_vdef_dots_copilot"                      // float_array, writable
+	SimCockpit2RadiosIndicators_nav1_has_dme                               string = "sim/cockpit2/radios/indicators/nav1_has_dme"                               // int, writable
+	SimCockpit2RadiosIndicators_nav2_has_dme                               string = "sim/cockpit2/radios/indicators/nav2_has_dme"                               // int, writable
+	SimCockpit2RadiosIndicators_nav_has_dme                                string = "sim/cockpit2/radios/indicators/nav_has_dme"                                // int_array, writable
+	SimCockpit2RadiosIndicators_adf1_has_dme                               string = "sim/cockpit2/radios/indicators/adf1_has_dme"                               // int, writable
+	SimCockpit2RadiosIndicators_adf2_has_dme                               string = "sim/cockpit2/radios/indicators/adf2_has_dme"                               // int, writable
+	SimCockpit2RadiosIndicators_gps_has_dme                                string = "sim/cockpit2/radios/indicators/gps_has_dme"                                // int, writable
+	SimCockpit2RadiosIndicators_gps2_has_dme                               string = "sim/cockpit2/radios/indicators/gps2_has_dme"                               // int, writable
+	SimCockpit2RadiosIndicators_dme_has_dme                                string = "sim/cockpit2/radios/indicators/dme_has_dme"                                // int, writable
+	SimCockpit2RadiosIndicators_nav1_dme_distance_nm                       string = "sim/cockpit2/radios/indicators/nav1_dme_distance_nm"                       // float, writable
+	SimCockpit2RadiosIndicators_nav2_dme_distance_nm                       string = "sim/cockpit2/radios/indicators/nav2_dme_distance_nm"                       // float, writable
+	SimCockpit2RadiosIndicators_nav_dme_distance_nm                        string = "sim/cockpit2/radios/indicators/nav_dme_distance_nm"                        // float_array, writable
+	SimCockpit2RadiosIndicators_adf1_dme_distance_nm                       string = "sim/cockpit2/radios/indicators/adf1_dme_distance_nm"                       // float, writable
+	SimCockpit2RadiosIndicators_adf2_dme_distance_nm                       string = "sim/cockpit2/radios/indicators/adf2_dme_distance_nm"                       // float, writable
+	SimCockpit2RadiosIndicators_gps_dme_distance_nm                        string = "sim/cockpit2/radios/indicators/gps_dme_distance_nm"                        // float, writable
+	SimCockpit2RadiosIndicators_gps2_dme_distance_nm                       string = "sim/cockpit2/radios/indicators/gps2_dme_distance_nm"                       // float, writable
+	SimCockpit2RadiosIndicators_dme_dme_distance_nm                        string = "sim/cockpit2/radios/indicators/dme_dme_distance_nm"                        // float, writable
+	SimCockpit2RadiosIndicators_nav1_dme_speed_kts                         string = "sim/cockpit2/radios/indicators/nav1_dme_speed_kts"                         // float, writable
+	SimCockpit2RadiosIndicators_nav2_dme_speed_kts                         string = "sim/cockpit2/radios/indicators/nav2_dme_speed_kts"                         // float, writable
+	SimCockpit2RadiosIndicators_nav_dme_speed_kts                          string = "sim/cockpit2/radios/indicators/nav_dme_speed_kts"                          // float_array, writable
+	SimCockpit2RadiosIndicators_adf1_dme_speed_kts                         string = "sim/cockpit2/radios/indicators/adf1_dme_speed_kts"                         // float, writable
+	SimCockpit2RadiosIndicators_adf2_dme_speed_kts                         string = "sim/cockpit2/radios/indicators/adf2_dme_speed_kts"                         // float, writable
+	SimCockpit2RadiosIndicators_gps_dme_speed_kts                          string = "sim/cockpit2/radios/indicators/gps_dme_speed_kts"                          // float, writable
+	SimCockpit2RadiosIndicators_gps2_dme_speed_kts                         string = "sim/cockpit2/radios/indicators/gps2_dme_speed_kts"                         // float, writable
+	SimCockpit2RadiosIndicators_dme_dme_speed_kts                          string = "sim/cockpit2/radios/indicators/dme_dme_speed_kts"                          // float, writable
+	SimCockpit2RadiosIndicators_nav1_dme_time_min                          string = "sim/cockpit2/radios/indicators/nav1_dme_time_min"                          // float, writable
+	SimCockpit2RadiosIndicators_nav2_dme_time_min                          string = "sim/cockpit2/radios/indicators/nav2_dme_time_min"                          // float, writable
+	SimCockpit2RadiosIndicators_nav_dme_time_min                           string = "sim/cockpit2/radios/indicators/nav_dme_time_min"                           // float_array, writable
+	SimCockpit2RadiosIndicators_adf1_dme_time_min                          string = "sim/cockpit2/radios/indicators/adf1_dme_time_min"                          // float, writable
+	SimCockpit2RadiosIndicators_adf2_dme_time_min                          string = "sim/cockpit2/radios/indicators/adf2_dme_time_min"                          // float, writable
+	SimCockpit2RadiosIndicators_gps_dme_time_min                           string = "sim/cockpit2/radios/indicators/gps_dme_time_min"                           // float, writable
+	SimCockpit2RadiosIndicators_gps2_dme_time_min                          string = "sim/cockpit2/radios/indicators/gps2_dme_time_min"                          // float, writable
+	SimCockpit2RadiosIndicators_dme_dme_time_min                           string = "sim/cockpit2/radios/indicators/dme_dme_time_min"                           // float, writable
+	SimCockpit2RadiosIndicators_nav1_nav_id                                string = "sim/cockpit2/radios/indicators/nav1_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav2_nav_id                                string = "sim/cockpit2/radios/indicators/nav2_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_adf1_nav_id                                string = "sim/cockpit2/radios/indicators/adf1_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_adf2_nav_id                                string = "sim/cockpit2/radios/indicators/adf2_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_gps_nav_id                                 string = "sim/cockpit2/radios/indicators/gps_nav_id"                                 // data, writable
+	SimCockpit2RadiosIndicators_gps2_nav_id                                string = "sim/cockpit2/radios/indicators/gps2_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_dme_nav_id                                 string = "sim/cockpit2/radios/indicators/dme_nav_id"                                 // data, writable
+	SimCockpit2RadiosIndicators_nav3_nav_id                                string = "sim/cockpit2/radios/indicators/nav3_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav4_nav_id                                string = "sim/cockpit2/radios/indicators/nav4_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav5_nav_id                                string = "sim/cockpit2/radios/indicators/nav5_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav6_nav_id                                string = "sim/cockpit2/radios/indicators/nav6_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav7_nav_id                                string = "sim/cockpit2/radios/indicators/nav7_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav8_nav_id                                string = "sim/cockpit2/radios/indicators/nav8_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav9_nav_id                                string = "sim/cockpit2/radios/indicators/nav9_nav_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav10_nav_id                               string = "sim/cockpit2/radios/indicators/nav10_nav_id"                               // data, writable
+	SimCockpit2RadiosIndicators_nav11_nav_id                               string = "sim/cockpit2/radios/indicators/nav11_nav_id"                               // data, writable
+	SimCockpit2RadiosIndicators_nav12_nav_id                               string = "sim/cockpit2/radios/indicators/nav12_nav_id"                               // data, writable
+	SimCockpit2RadiosIndicators_nav1_dme_id                                string = "sim/cockpit2/radios/indicators/nav1_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav2_dme_id                                string = "sim/cockpit2/radios/indicators/nav2_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav3_dme_id                                string = "sim/cockpit2/radios/indicators/nav3_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav4_dme_id                                string = "sim/cockpit2/radios/indicators/nav4_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav5_dme_id                                string = "sim/cockpit2/radios/indicators/nav5_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav6_dme_id                                string = "sim/cockpit2/radios/indicators/nav6_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav7_dme_id                                string = "sim/cockpit2/radios/indicators/nav7_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav8_dme_id                                string = "sim/cockpit2/radios/indicators/nav8_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav9_dme_id                                string = "sim/cockpit2/radios/indicators/nav9_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav10_dme_id                               string = "sim/cockpit2/radios/indicators/nav10_dme_id"                               // data, writable
+	SimCockpit2RadiosIndicators_nav11_dme_id                               string = "sim/cockpit2/radios/indicators/nav11_dme_id"                               // data, writable
+	SimCockpit2RadiosIndicators_nav12_dme_id                               string = "sim/cockpit2/radios/indicators/nav12_dme_id"                               // data, writable
+	SimCockpit2RadiosIndicators_gps_dme_id                                 string = "sim/cockpit2/radios/indicators/gps_dme_id"                                 // data, writable
+	SimCockpit2RadiosIndicators_gps2_dme_id                                string = "sim/cockpit2/radios/indicators/gps2_dme_id"                                // data, writable
+	SimCockpit2RadiosIndicators_nav1_type                                  string = "sim/cockpit2/radios/indicators/nav1_type"                                  // int
+	SimCockpit2RadiosIndicators_nav2_type                                  string = "sim/cockpit2/radios/indicators/nav2_type"                                  // int
+	SimCockpit2RadiosIndicators_nav_type                                   string = "sim/cockpit2/radios/indicators/nav_type"                                   // int_array
+	SimCockpit2RadiosIndicators_over_outer_marker                          string = "sim/cockpit2/radios/indicators/over_outer_marker"                          // int, writable
+	SimCockpit2RadiosIndicators_over_middle_marker                         string = "sim/cockpit2/radios/indicators/over_middle_marker"                         // int, writable
+	SimCockpit2RadiosIndicators_over_inner_marker                          string = "sim/cockpit2/radios/indicators/over_inner_marker"                          // int, writable
+	SimCockpit2RadiosIndicators_outer_marker_signal_ratio                  string = "sim/cockpit2/radios/indicators/outer_marker_signal_ratio"                  // float
+	SimCockpit2RadiosIndicators_middle_marker_signal_ratio                 string = "sim/cockpit2/radios/indicators/middle_marker_signal_ratio"                 // float
+	SimCockpit2RadiosIndicators_inner_marker_signal_ratio                  string = "sim/cockpit2/radios/indicators/inner_marker_signal_ratio"                  // float
+	SimCockpit2RadiosIndicators_outer_marker_lit                           string = "sim/cockpit2/radios/indicators/outer_marker_lit"                           // int, writable
+	SimCockpit2RadiosIndicators_middle_marker_lit                          string = "sim/cockpit2/radios/indicators/middle_marker_lit"                          // int, writable
+	SimCockpit2RadiosIndicators_inner_marker_lit                           string = "sim/cockpit2/radios/indicators/inner_marker_lit"                           // int, writable
+	SimCockpit2RadiosIndicators_morse_id_tone_nav1                         string = "sim/cockpit2/radios/indicators/morse_id_tone_nav1"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav2                         string = "sim/cockpit2/radios/indicators/morse_id_tone_nav2"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_adf1                         string = "sim/cockpit2/radios/indicators/morse_id_tone_adf1"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_adf2                         string = "sim/cockpit2/radios/indicators/morse_id_tone_adf2"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_dme                          string = "sim/cockpit2/radios/indicators/morse_id_tone_dme"                          // int
+	SimCockpit2RadiosIndicators_morse_id_tone_dme1                         string = "sim/cockpit2/radios/indicators/morse_id_tone_dme1"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_dme2                         string = "sim/cockpit2/radios/indicators/morse_id_tone_dme2"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav3                         string = "sim/cockpit2/radios/indicators/morse_id_tone_nav3"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav4                         string = "sim/cockpit2/radios/indicators/morse_id_tone_nav4"                         // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav1_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_nav1_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav2_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_nav2_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_adf1_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_adf1_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_adf2_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_adf2_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_dme_copilot                  string = "sim/cockpit2/radios/indicators/morse_id_tone_dme_copilot"                  // int
+	SimCockpit2RadiosIndicators_morse_id_tone_dme1_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_dme1_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_dme2_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_dme2_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav3_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_nav3_copilot"                 // int
+	SimCockpit2RadiosIndicators_morse_id_tone_nav4_copilot                 string = "sim/cockpit2/radios/indicators/morse_id_tone_nav4_copilot"                 // int
+	SimCockpit2RadiosIndicators_hsi_bearing_deg_mag_pilot                  string = "sim/cockpit2/radios/indicators/hsi_bearing_deg_mag_pilot"                  // float
+	SimCockpit2RadiosIndicators_hsi_bearing_deg_mag_copilot                string = "sim/cockpit2/radios/indicators/hsi_bearing_deg_mag_copilot"                // float
+	SimCockpit2RadiosIndicators_hsi_relative_bearing_deg_pilot             string = "sim/cockpit2/radios/indicators/hsi_relative_bearing_deg_pilot"             // float
+	SimCockpit2RadiosIndicators_hsi_relative_bearing_deg_copilot           string = "sim/cockpit2/radios/indicators/hsi_relative_bearing_deg_copilot"           // float
+	SimCockpit2RadiosIndicators_hsi_flag_from_to_pilot                     string = "sim/cockpit2/radios/indicators/hsi_flag_from_to_pilot"                     // int
+	SimCockpit2RadiosIndicators_hsi_flag_from_to_copilot                   string = "sim/cockpit2/radios/indicators/hsi_flag_from_to_copilot"                   // int
+	SimCockpit2RadiosIndicators_hsi_hdef_dots_pilot                        string = "sim/cockpit2/radios/indicators/hsi_hdef_dots_pilot"                        // float
+	SimCockpit2RadiosIndicators_hsi_hdef_dots_copilot                      string = "sim/cockpit2/radios/indicators/hsi_hdef_dots_copilot"                      // float
+	SimCockpit2RadiosIndicators_hsi_vdef_dots_pilot                        string = "sim/cockpit2/radios/indicators/hsi_vdef_dots_pilot"                        // float
+	SimCockpit2RadiosIndicators_hsi_vdef_dots_copilot                      string = "sim/cockpit2/radios/indicators/hsi_vdef_dots_copilot"                      // float
+	SimCockpit2RadiosIndicators_hsi_has_dme_pilot                          string = "sim/cockpit2/radios/indicators/hsi_has_dme_pilot"                          // int
+	SimCockpit2RadiosIndicators_hsi_has_dme_copilot                        string = "sim/cockpit2/radios/indicators/hsi_has_dme_copilot"                        // int
+	SimCockpit2RadiosIndicators_hsi_dme_distance_nm_pilot                  string = "sim/cockpit2/radios/indicators/hsi_dme_distance_nm_pilot"                  // float
+	SimCockpit2RadiosIndicators_hsi_dme_distance_nm_copilot                string = "sim/cockpit2/radios/indicators/hsi_dme_distance_nm_copilot"                // float
+	SimCockpit2RadiosIndicators_hsi_dme_speed_kts_pilot                    string = "sim/cockpit2/radios/indicators/hsi_dme_speed_kts_pilot"                    // float
+	SimCockpit2RadiosIndicators_hsi_dme_speed_kts_copilot                  string = "sim/cockpit2/radios/indicators/hsi_dme_speed_kts_copilot"                  // float
+	SimCockpit2RadiosIndicators_hsi_dme_time_min_pilot                     string = "sim/cockpit2/radios/indicators/hsi_dme_time_min_pilot"                     // float
+	SimCockpit2RadiosIndicators_hsi_dme_time_min_copilot                   string = "sim/cockpit2/radios/indicators/hsi_dme_time_min_copilot"                   // float
+	SimCockpit2RadiosIndicators_hsi_flag_glideslope_pilot                  string = "sim/cockpit2/radios/indicators/hsi_flag_glideslope_pilot"                  // int
+	SimCockpit2RadiosIndicators_hsi_flag_glideslope_copilot                string = "sim/cockpit2/radios/indicators/hsi_flag_glideslope_copilot"                // int
+	SimCockpit2RadiosIndicators_hsi_flag_glideslope_pilot_mech             string = "sim/cockpit2/radios/indicators/hsi_flag_glideslope_pilot_mech"             // int
+	SimCockpit2RadiosIndicators_hsi_flag_glideslope_copilot_mech           string = "sim/cockpit2/radios/indicators/hsi_flag_glideslope_copilot_mech"           // int
+	SimCockpit2RadiosIndicators_hsi_display_horizontal_pilot               string = "sim/cockpit2/radios/indicators/hsi_display_horizontal_pilot"               // int
+	SimCockpit2RadiosIndicators_hsi_display_horizontal_copilot             string = "sim/cockpit2/radios/indicators/hsi_display_horizontal_copilot"             // int
+	SimCockpit2RadiosIndicators_hsi_display_vertical_pilot                 string = "sim/cockpit2/radios/indicators/hsi_display_vertical_pilot"                 // int
+	SimCockpit2RadiosIndicators_hsi_display_vertical_copilot               string = "sim/cockpit2/radios/indicators/hsi_display_vertical_copilot"               // int
+	SimCockpit2RadiosIndicators_transponder_id                             string = "sim/cockpit2/radios/indicators/transponder_id"                             // int
+	SimCockpit2RadiosIndicators_transponder_brightness                     string = "sim/cockpit2/radios/indicators/transponder_brightness"                     // float
+	SimCockpit2RadiosIndicators_nav1_relative_heading_vacuum_deg_pilot     string = "sim/cockpit2/radios/indicators/nav1_relative_heading_vacuum_deg_pilot"     // float
+	SimCockpit2RadiosIndicators_nav1_relative_heading_vacuum_deg_copilot   string = "sim/cockpit2/radios/indicators/nav1_relative_heading_vacuum_deg_copilot"   // float
+	SimCockpit2RadiosIndicators_nav1_relative_heading_electric_deg_pilot   string = "sim/cockpit2/radios/indicators/nav1_relative_heading_electric_deg_pilot"   // float
+	SimCockpit2RadiosIndicators_nav1_relative_heading_electric_deg_copilot string = "sim/cockpit2/radios/indicators/nav1_relative_heading_electric_deg_copilot" // float
+	SimCockpit2RadiosIndicators_nav1_relative_heading_AHARS_deg_pilot      string = "sim/cockpit2/radios/indicators/nav1_relative_heading_AHARS_deg_pilot"      // float
+	SimCockpit2RadiosIndicators_nav1_relative_heading_AHARS_deg_copilot    string = "sim/cockpit2/radios/indicators/nav1_relative_heading_AHARS_deg_copilot"    // float
+	SimCockpit2RadiosIndicators_nav2_relative_heading_vacuum_deg_pilot     string = "sim/cockpit2/radios/indicators/nav2_relative_heading_vacuum_deg_pilot"     // float
+	SimCockpit2RadiosIndicators_nav2_relative_heading_vacuum_deg_copilot   string = "sim/cockpit2/radios/indicators/nav2_relative_heading_vacuum_deg_copilot"   // float
+	SimCockpit2RadiosIndicators_nav2_relative_heading_electric_deg_pilot   string = "sim/cockpit2/radios/indicators/nav2_relative_heading_electric_deg_pilot"   // float
+	SimCockpit2RadiosIndicators_nav2_relative_heading_electric_deg_copilot string = "sim/cockpit2/radios/indicators/nav2_relative_heading_electric_deg_copilot" // float
+	SimCockpit2RadiosIndicators_nav2_relative_heading_AHARS_deg_pilot      string = "sim/cockpit2/radios/indicators/nav2_relative_heading_AHARS_deg_pilot"      // float
+	SimCockpit2RadiosIndicators_nav2_relative_heading_AHARS_deg_copilot    string = "sim/cockpit2/radios/indicators/nav2_relative_heading_AHARS_deg_copilot"    // float
+	SimCockpit2RadiosIndicators_nav_relative_heading_vacuum_deg_pilot      string = "sim/cockpit2/radios/indicators/nav_relative_heading_vacuum_deg_pilot"      // float_array
+	SimCockpit2RadiosIndicators_nav_relative_heading_vacuum_deg_copilot    string = "sim/cockpit2/radios/indicators/nav_relative_heading_vacuum_deg_copilot"    // float_array
+	SimCockpit2RadiosIndicators_nav_relative_heading_electric_deg_pilot    string = "sim/cockpit2/radios/indicators/nav_relative_heading_electric_deg_pilot"    // float_array
+	SimCockpit2RadiosIndicators_nav_relative_heading_electric_deg_copilot  string = "sim/cockpit2/radios/indicators/nav_relative_heading_electric_deg_copilot"  // float_array
+	SimCockpit2RadiosIndicators_nav_relative_heading_AHARS_deg_pilot       string = "sim/cockpit2/radios/indicators/nav_relative_heading_AHARS_deg_pilot"       // float_array
+	SimCockpit2RadiosIndicators_nav_relative_heading_AHARS_deg_copilot     string = "sim/cockpit2/radios/indicators/nav_relative_heading_AHARS_deg_copilot"     // float_array
+	SimCockpit2RadiosIndicators_gps_relative_heading_vacuum_deg_pilot      string = "sim/cockpit2/radios/indicators/gps_relative_heading_vacuum_deg_pilot"      // float
+	SimCockpit2RadiosIndicators_gps_relative_heading_vacuum_deg_copilot    string = "sim/cockpit2/radios/indicators/gps_relative_heading_vacuum_deg_copilot"    // float
+	SimCockpit2RadiosIndicators_gps_relative_heading_electric_deg_pilot    string = "sim/cockpit2/radios/indicators/gps_relative_heading_electric_deg_pilot"    // float
+	SimCockpit2RadiosIndicators_gps_relative_heading_electric_deg_copilot  string = "sim/cockpit2/radios/indicators/gps_relative_heading_electric_deg_copilot"  // float
+	SimCockpit2RadiosIndicators_gps_relative_heading_AHARS_deg_pilot       string = "sim/cockpit2/radios/indicators/gps_relative_heading_AHARS_deg_pilot"       // float
+	SimCockpit2RadiosIndicators_gps_relative_heading_AHARS_deg_copilot     string = "sim/cockpit2/radios/indicators/gps_relative_heading_AHARS_deg_copilot"     // float
+	SimCockpit2RadiosIndicators_gps2_relative_heading_vacuum_deg_pilot     string = "sim/cockpit2/radios/indicators/gps2_relative_heading_vacuum_deg_pilot"     // float
+	SimCockpit2RadiosIndicators_gps2_relative_heading_vacuum_deg_copilot   string = "sim/cockpit2/radios/indicators/gps2_relative_heading_vacuum_deg_copilot"   // float
+	SimCockpit2RadiosIndicators_gps2_relative_heading_electric_deg_pilot   string = "sim/cockpit2/radios/indicators/gps2_relative_heading_electric_deg_pilot"   // float
+	SimCockpit2RadiosIndicators_gps2_relative_heading_electric_deg_copilot string = "sim/cockpit2/radios/indicators/gps2_relative_heading_electric_deg_copilot" // float
+	SimCockpit2RadiosIndicators_gps2_relative_heading_AHARS_deg_pilot      string = "sim/cockpit2/radios/indicators/gps2_relative_heading_AHARS_deg_pilot"      // float
+	SimCockpit2RadiosIndicators_gps2_relative_heading_AHARS_deg_copilot    string = "sim/cockpit2/radios/indicators/gps2_relative_heading_AHARS_deg_copilot"    // float
+	SimCockpit2RadiosIndicators_hsi_relative_heading_vacuum_deg_pilot      string = "sim/cockpit2/radios/indicators/hsi_relative_heading_vacuum_deg_pilot"      // float
+	SimCockpit2RadiosIndicators_hsi_relative_heading_vacuum_deg_copilot    string = "sim/cockpit2/radios/indicators/hsi_relative_heading_vacuum_deg_copilot"    // float
+	SimCockpit2RadiosIndicators_hsi_relative_heading_electric_deg_pilot    string = "sim/cockpit2/radios/indicators/hsi_relative_heading_electric_deg_pilot"    // float
+	SimCockpit2RadiosIndicators_hsi_relative_heading_electric_deg_copilot  string = "sim/cockpit2/radios/indicators/hsi_relative_heading_electric_deg_copilot"  // float
+	SimCockpit2RadiosIndicators_hsi_relative_heading_AHARS_deg_pilot       string = "sim/cockpit2/radios/indicators/hsi_relative_heading_AHARS_deg_pilot"       // float
+	SimCockpit2RadiosIndicators_hsi_relative_heading_AHARS_deg_copilot     string = "sim/cockpit2/radios/indicators/hsi_relative_heading_AHARS_deg_copilot"     // float
+	SimCockpit2RadiosIndicators_fms_exec_light_pilot                       string = "sim/cockpit2/radios/indicators/fms_exec_light_pilot"                       // int
+	SimCockpit2RadiosIndicators_fms_exec_light_copilot                     string = "sim/cockpit2/radios/indicators/fms_exec_light_copilot"                     // int
+	SimCockpit2RadiosIndicators_fms_fpta_pilot                             string = "sim/cockpit2/radios/indicators/fms_fpta_pilot"                             // float
+	SimCockpit2RadiosIndicators_fms_vpa_pilot                              string = "sim/cockpit2/radios/indicators/fms_vpa_pilot"                              // float
+	SimCockpit2RadiosIndicators_fms_vtk_pilot                              string = "sim/cockpit2/radios/indicators/fms_vtk_pilot"                              // float
+	SimCockpit2RadiosIndicators_fms_distance_to_tod_pilot                  string = "sim/cockpit2/radios/indicators/fms_distance_to_tod_pilot"                  // float
+	SimCockpit2RadiosIndicators_fms_acceleration_alt                       string = "sim/cockpit2/radios/indicators/fms_acceleration_alt"                       // float
+	SimCockpit2RadiosIndicators_fms_thrust_reduction_alt                   string = "sim/cockpit2/radios/indicators/fms_thrust_reduction_alt"                   // float
+	SimCockpit2RadiosIndicators_fms_tod_before_index_pilot                 string = "sim/cockpit2/radios/indicators/fms_tod_before_index_pilot"                 // int
+	SimCockpit2RadiosIndicators_fms_tod_before_distance_pilot              string = "sim/cockpit2/radios/indicators/fms_tod_before_distance_pilot"              // float
+	SimCockpit2RadiosIndicators_fms_vertical_msg_pilot                     string = "sim/cockpit2/radios/indicators/fms_vertical_msg_pilot"                     // int
+	SimCockpit2RadiosIndicators_fms_vertical_msg_copilot                   string = "sim/cockpit2/radios/indicators/fms_vertical_msg_copilot"                   // int
+	SimCockpit2RadiosIndicators_nav_src_ref                                string = "sim/cockpit2/radios/indicators/nav_src_ref"                                // int
+	SimCockpit2RadiosIndicators_ian_mode                                   string = "sim/cockpit2/radios/indicators/ian_mode"                                   // int
+	SimCockpit2RadiosIndicators_fas_id                                     string = "sim/cockpit2/radios/indicators/fas_id"                                     // data
+	SimCockpit2RadiosIndicators_fac                                        string = "sim/cockpit2/radios/indicators/fac"                                        // float
+	SimCockpit2RadiosIndicators_ltp_id                                     string = "sim/cockpit2/radios/indicators/ltp_id"                                     // data
+	SimCockpit2RadiosIndicators_ltp_dist_nm                                string = "sim/cockpit2/radios/indicators/ltp_dist_nm"                                // float
+	SimCockpit2RadiosIndicators_fas_has_dme                                string = "sim/cockpit2/radios/indicators/fas_has_dme"                                // int
+	SimCockpit2RadiosIndicators_fas_id_copilot                             string = "sim/cockpit2/radios/indicators/fas_id_copilot"                             // data
+	SimCockpit2RadiosIndicators_fac_copilot                                string = "sim/cockpit2/radios/indicators/fac_copilot"                                // float
+	SimCockpit2RadiosIndicators_ltp_id_copilot                             string = "sim/cockpit2/radios/indicators/ltp_id_copilot"                             // data
+	SimCockpit2RadiosIndicators_ltp_dist_nm_copilot                        string = "sim/cockpit2/radios/indicators/ltp_dist_nm_copilot"                        // float
+	SimCockpit2RadiosIndicators_fas_has_dme_copilot                        string = "sim/cockpit2/radios/indicators/fas_has_dme_copilot"                        // int
+	SimCockpit2RadiosIndicators_landing_alt_pilot                          string = "sim/cockpit2/radios/indicators/landing_alt_pilot"                          // float
+	SimCockpit2RadiosIndicators_landing_alt_copilot                        string = "sim/cockpit2/radios/indicators/landing_alt_copilot"                        // float
+	SimCockpit2RadiosIndicators_HAT_pilot                                  string = "sim/cockpit2/radios/indicators/HAT_pilot"                                  // float
+	SimCockpit2RadiosIndicators_HAT_copilot                                string = "sim/cockpit2/radios/indicators/HAT_copilot"                                // float
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line0                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line0"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line1                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line1"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line2                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line2"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line3                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line3"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line4                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line4"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line5                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line5"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line6                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line6"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line7                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line7"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line8                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line8"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line9                        string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line9"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line10                       string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line10"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line11                       string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line11"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line12                       string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line12"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line13                       string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line13"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line14                       string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line14"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_text_line15                       string = "sim/cockpit2/radios/indicators/fms_cdu1_text_line15"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line0                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line0"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line1                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line1"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line2                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line2"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line3                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line3"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line4                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line4"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line5                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line5"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line6                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line6"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line7                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line7"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line8                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line8"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line9                       string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line9"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line10                      string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line10"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line11                      string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line11"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line12                      string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line12"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line13                      string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line13"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line14                      string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line14"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu1_style_line15                      string = "sim/cockpit2/radios/indicators/fms_cdu1_style_line15"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line0                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line0"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line1                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line1"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line2                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line2"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line3                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line3"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line4                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line4"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line5                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line5"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line6                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line6"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line7                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line7"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line8                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line8"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line9                        string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line9"                        // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line10                       string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line10"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line11                       string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line11"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line12                       string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line12"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line13                       string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line13"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line14                       string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line14"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_text_line15                       string = "sim/cockpit2/radios/indicators/fms_cdu2_text_line15"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line0                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line0"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line1                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line1"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line2                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line2"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line3                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line3"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line4                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line4"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line5                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line5"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line6                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line6"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line7                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line7"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line8                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line8"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line9                       string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line9"                       // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line10                      string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line10"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line11                      string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line11"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line12                      string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line12"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line13                      string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line13"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line14                      string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line14"                      // data
+	SimCockpit2RadiosIndicators_fms_cdu2_style_line15                      string = "sim/cockpit2/radios/indicators/fms_cdu2_style_line15"                      // data
+	SimCockpit2Switches_avionics_power_on                                  string = "sim/cockpit2/switches/avionics_power_on"                                   // int, writable
+	SimCockpit2Switches_gnd_com_power_on                                   string = "sim/cockpit2/switches/gnd_com_power_on"                                    // int, writable
+	SimCockpit2Switches_navigation_lights_on                               string = "sim/cockpit2/switches/navigation_lights_on"                                // int, writable
+	SimCockpit2Switches_beacon_on                                          string = "sim/cockpit2/switches/beacon_on"                                           // int, writable
+	SimCockpit2Switches_strobe_lights_on                                   string = "sim/cockpit2/switches/strobe_lights_on"                                    // int, writable
+	SimCockpit2Switches_landing_lights_on                                  string = "sim/cockpit2/switches/landing_lights_on"                                   // int, writable
+	SimCockpit2Switches_landing_lights_switch                              string = "sim/cockpit2/switches/landing_lights_switch"                               // float_array, writable
+	SimCockpit2Switches_generic_lights_switch                              string = "sim/cockpit2/switches/generic_lights_switch"                               // float_array, writable
+	SimCockpit2Switches_taxi_light_on                                      string = "sim/cockpit2/switches/taxi_light_on"                                       // int, writable
+	SimCockpit2Switches_spot_light_on                                      string = "sim/cockpit2/switches/spot_light_on"                                       // int, writable
+	SimCockpit2Switches_dump_fuel                                          string = "sim/cockpit2/switches/dump_fuel"                                           // int, writable
+	SimCockpit2Switches_puffers_on                                         string = "sim/cockpit2/switches/puffers_on"                                          // int, writable
+	SimCockpit2Switches_prop_sync_on                                       string = "sim/cockpit2/switches/prop_sync_on"                                        // int, writable
+	SimCockpit2Switches_jet_sync_mode                                      string = "sim/cockpit2/switches/jet_sync_mode"                                       // int, writable
+	SimCockpit2Switches_electric_hydraulic_pump_on                         string = "sim/cockpit2/switches/electric_hydraulic_pump_on"                          // int, writable
+	SimCockpit2Switches_electric_hydraulic_pump2_on                        string = "sim/cockpit2/switches/electric_hydraulic_pump2_on"                         // int, writable
+	SimCockpit2Switches_ram_air_turbine_on                                 string = "sim/cockpit2/switches/ram_air_turbine_on"                                  // int, writable
+	SimCockpit2Switches_yaw_damper_on                                      string = "sim/cockpit2/switches/yaw_damper_on"                                       // int, writable
+	SimCockpit2Switches_artificial_stability_on                            string = "sim/cockpit2/switches/artificial_stability_on"                             // int, writable
+	SimCockpit2Switches_artificial_stability_pitch_on                      string = "sim/cockpit2/switches/artificial_stability_pitch_on"                       // int, writable
+	SimCockpit2Switches_artificial_stability_roll_on                       string = "sim/cockpit2/switches/artificial_stability_roll_on"                        // int, writable
+	SimCockpit2Switches_HUD_on                                             string = "sim/cockpit2/switches/HUD_on"                                              // int, writable
+	SimCockpit2Switches_parachute_deploy                                   string = "sim/cockpit2/switches/parachute_deploy"                                    // int, writable
+	SimCockpit2Switches_jato_on                                            string = "sim/cockpit2/switches/jato_on"                                             // int, writable
+	SimCockpit2Switches_tailhook_deploy                                    string = "sim/cockpit2/switches/tailhook_deploy"                                     // int, writable
+	SimCockpit2Switches_canopy_open                                        string = "sim/cockpit2/switches/canopy_open"                                         // int, writable
+	SimCockpit2Switches_door_open                                          string = "sim/cockpit2/switches/door_open"                                           // int_array, writable
+	SimCockpit2Switches_door_open_ratio                                    string = "sim/cockpit2/switches/door_open_ratio"                                     // float_array, writable
+	SimCockpit2Switches_water_scoop_deploy                                 string = "sim/cockpit2/switches/water_scoop_deploy"                                  // int, writable
+	SimCockpit2Switches_dump_water                                         string = "sim/cockpit2/switches/dump_water"                                          // int, writable
+	SimCockpit2Switches_no_smoking                                         string = "sim/cockpit2/switches/no_smoking"                                          // int, writable
+	SimCockpit2Switches_fasten_seat_belts                                  string = "sim/cockpit2/switches/fasten_seat_belts"                                   // int, writable
+	SimCockpit2Switches_total_energy_audio                                 string = "sim/cockpit2/switches/total_energy_audio"                                  // int, writable
+	SimCockpit2Switches_HSI_is_arc                                         string = "sim/cockpit2/switches/HSI_is_arc"                                          // int, writable
+	SimCockpit2Switches_HSI_is_arc_copilot                                 string = "sim/cockpit2/switches/HSI_is_arc_copilot"                                  // int, writable
+	SimCockpit2Switches_auto_brake_level                                   string = "sim/cockpit2/switches/auto_brake_level"                                    // int, writable
+	SimCockpit2Switches_auto_reverse_on                                    string = "sim/cockpit2/switches/auto_reverse_on"                                     // int, writable
+	SimCockpit2Switches_prop_feather_mode                                  string = "sim/cockpit2/switches/prop_feather_mode"                                   // int, writable
+	SimCockpit2Switches_pre_rotate_level                                   string = "sim/cockpit2/switches/pre_rotate_level"                                    // int, writable
+	SimCockpit2Switches_clutch_engage                                      string = "sim/cockpit2/switches/clutch_engage"                                       // int, writable
+	SimCockpit2Switches_rotor_brake                                        string = "sim/cockpit2/switches/rotor_brake"                                         // int, writable
+	SimCockpit2Switches_hotel_mode                                         string = "sim/cockpit2/switches/hotel_mode"                                          // int, writable
+	SimCockpit2Switches_rotor_brake_ratio                                  string = "sim/cockpit2/switches/rotor_brake_ratio"                                   // float, writable
+	SimCockpit2Switches_hotel_mode_ratio                                   string = "sim/cockpit2/switches/hotel_mode_ratio"                                    // float, writable
+	SimCockpit2Switches_clutch_ratio                                       string = "sim/cockpit2/switches/clutch_ratio"                                        // float, writable
+	SimCockpit2Switches_rocket_mode                                        string = "sim/cockpit2/switches/rocket_mode"                                         // int, writable
+	SimCockpit2Switches_burner_level                                       string = "sim/cockpit2/switches/burner_level"                                        // int, writable
+	SimCockpit2Switches_alternate_static_air_ratio                         string = "sim/cockpit2/switches/alternate_static_air_ratio"                          // float, writable
+	SimCockpit2Switches_standby_vacuum_pump                                string = "sim/cockpit2/switches/standby_vacuum_pump"                                 // int, writable
+	SimCockpit2Switches_wiper_speed                                        string = "sim/cockpit2/switches/wiper_speed"                                         // int, writable
+	SimCockpit2Switches_wiper_speed_switch                                 string = "sim/cockpit2/switches/wiper_speed_switch"                                  // int_array, writable
+	SimCockpit2Switches_rain_repellent_switch                              string = "sim/cockpit2/switches/rain_repellent_switch"                               // int_array, writable
+	SimCockpit2Switches_custom_slider_on                                   string = "sim/cockpit2/switches/custom_slider_on"                                    // int_array, writable
+	SimCockpit2Switches_panel_brightness_ratio                             string = "sim/cockpit2/switches/panel_brightness_ratio"                              // float_array, writable
+	SimCockpit2Switches_instrument_brightness_ratio                        string = "sim/cockpit2/switches/instrument_brightness_ratio"                         // float_array, writable
+	SimCockpit2Switches_HUD_brightness_ratio                               string = "sim/cockpit2/switches/HUD_brightness_ratio"                                // float, writable
+	SimCockpit2Switches_camera_power_on                                    string = "sim/cockpit2/switches/camera_power_on"                                     // int, writable
+	SimCockpit2Switches_total_energy_audio_on                              string = "sim/cockpit2/switches/total_energy_audio_on"                               // int, writable
+	SimCockpit2TcasIndicators_relative_bearing_degs                        string = "sim/cockpit2/tcas/indicators/relative_bearing_degs"                        // float_array, writable
+	SimCockpit2TcasIndicators_relative_distance_mtrs                       string = "sim/cockpit2/tcas/indicators/relative_distance_mtrs"                       // float_array, writable
+	SimCockpit2TcasIndicators_relative_altitude_mtrs                       string = "sim/cockpit2/tcas/indicators/relative_altitude_mtrs"                       // float_array, writable
+	SimCockpit2TcasIndicators_tcas_alert                                   string = "sim/cockpit2/tcas/indicators/tcas_alert"                                   // int, writable
+	SimCockpit2TcasIndicators_tcas_num_acf                                 string = "sim/cockpit2/tcas/indicators/tcas_num_acf"                                 // int
+	SimCockpit2TcasTargets_modeS_id                                        string = "sim/cockpit2/tcas/targets/modeS_id"                                        // int_array, writable
+	SimCockpit2TcasTargets_modeC_code                                      string = "sim/cockpit2/tcas/targets/modeC_code"                                      // int_array, writable
+	SimCockpit2TcasTargets_flight_id                                       string = "sim/cockpit2/tcas/targets/flight_id"                                       // data, writable
+	SimCockpit2TcasTargets_icao_type                                       string = "sim/cockpit2/tcas/targets/icao_type"                                       // data, writable
+	SimCockpit2TcasTargets_ssr_mode                                        string = "sim/cockpit2/tcas/targets/ssr_mode"                                        // int_array, writable
+	SimCockpit2TcasTargetsPosition_x                                       string = "sim/cockpit2/tcas/targets/position/x"                                      // float_array, writable
+	SimCockpit2TcasTargetsPosition_y                                       string = "sim/cockpit2/tcas/targets/position/y"                                      // float_array, writable
+	SimCockpit2TcasTargetsPosition_z                                       string = "sim/cockpit2/tcas/targets/position/z"                                      // float_array, writable
+	SimCockpit2TcasTargetsPosition_lat                                     string = "sim/cockpit2/tcas/targets/position/lat"                                    // float_array
+	SimCockpit2TcasTargetsPosition_lon                                     string = "sim/cockpit2/tcas/targets/position/lon"                                    // float_array
+	SimCockpit2TcasTargetsPosition_ele                                     string = "sim/cockpit2/tcas/targets/position/ele"                                    // float_array
+	SimCockpit2TcasTargetsPosition_vx                                      string = "sim/cockpit2/tcas/targets/position/vx"                                     // float_array, writable
+	SimCockpit2TcasTargetsPosition_vy                                      string = "sim/cockpit2/tcas/targets/position/vy"                                     // float_array, writable
+	SimCockpit2TcasTargetsPosition_vz                                      string = "sim/cockpit2/tcas/targets/position/vz"                                     // float_array, writable
+	SimCockpit2TcasTargetsPosition_vertical_speed                          string = "sim/cockpit2/tcas/targets/position/vertical_speed"                         // float_array, writable
+	SimCockpit2TcasTargetsPosition_hpath                                   string = "sim/cockpit2/tcas/targets/position/hpath"                                  // float_array
+	SimCockpit2TcasTargetsPosition_vpath                                   string = "sim/cockpit2/tcas/targets/position/vpath"                                  // float_array
+	SimCockpit2TcasTargetsPosition_V_msc                                   string = "sim/cockpit2/tcas/targets/position/V_msc"                                  // float_array
+	SimCockpit2TcasTargetsPosition_psi                                     string = "sim/cockpit2/tcas/targets/position/psi"                                    // float_array, writable
+	SimCockpit2TcasTargetsPosition_the                                     string = "sim/cockpit2/tcas/targets/position/the"                                    // float_array, writable
+	SimCockpit2TcasTargetsPosition_phi                                     string = "sim/cockpit2/tcas/targets/position/phi"                                    // float_array, writable
+	SimCockpit2TcasTargetsPosition_weight_on_wheels                        string = "sim/cockpit2/tcas/targets/position/weight_on_wheels"                       // int_array, writable
+	SimCockpit2TcasTargetsPosition_gear_deploy                             string = "sim/cockpit2/tcas/targets/position/gear_deploy"                            // float_array, writable
+	SimCockpit2TcasTargetsPosition_flap_ratio                              string = "sim/cockpit2/tcas/targets/position/flap_ratio"                             // float_array, writable
+	SimCockpit2TcasTargetsPosition_flap_ratio2                             string = "sim/cockpit2/tcas/targets/position/flap_ratio2"                            // float_array, writable
+	SimCockpit2TcasTargetsPosition_speedbrake_ratio                        string = "sim/cockpit2/tcas/targets/position/speedbrake_ratio"                       // float_array, writable
+	SimCockpit2TcasTargetsPosition_slat_ratio                              string = "sim/cockpit2/tcas/targets/position/slat_ratio"                             // float_array, writable
+	SimCockpit2TcasTargetsPosition_wing_sweep                              string = "sim/cockpit2/tcas/targets/position/wing_sweep"                             // float_array, writable
+	SimCockpit2TcasTargetsPosition_throttle                                string = "sim/cockpit2/tcas/targets/position/throttle"                               // float_array, writable
+	SimCockpit2TcasTargetsPosition_yolk_pitch                              string = "sim/cockpit2/tcas/targets/position/yolk_pitch"                             // float_array, writable
+	SimCockpit2TcasTargetsPosition_yolk_roll                               string = "sim/cockpit2/tcas/targets/position/yolk_roll"                              // float_array, writable
+	SimCockpit2TcasTargetsPosition_yolk_yaw                                string = "sim/cockpit2/tcas/targets/position/yolk_yaw"                               // float_array, writable
+	SimCockpit2TcasTargetsPosition_yoke_pitch                              string = "sim/cockpit2/tcas/targets/position/yoke_pitch"                             // float_array, writable
+	SimCockpit2TcasTargetsPosition_yoke_roll                               string = "sim/cockpit2/tcas/targets/position/yoke_roll"                              // float_array, writable
+	SimCockpit2TcasTargetsPosition_yoke_yaw                                string = "sim/cockpit2/tcas/targets/position/yoke_yaw"                               // float_array, writable
+	SimCockpit2TcasTargetsPosition_lights                                  string = "sim/cockpit2/tcas/targets/position/lights"                                 // int_array, writable
+	SimCockpit2TcasTargetsPositionDouble_plane1_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane1_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane1_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane1_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane1_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane1_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane2_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane2_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane2_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane2_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane2_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane2_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane3_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane3_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane3_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane3_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane3_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane3_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane4_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane4_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane4_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane4_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane4_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane4_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane5_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane5_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane5_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane5_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane5_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane5_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane6_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane6_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane6_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane6_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane6_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane6_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane7_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane7_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane7_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane7_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane7_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane7_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane8_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane8_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane8_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane8_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane8_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane8_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane9_lat                        string = "sim/cockpit2/tcas/targets/position/double/plane9_lat"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane9_lon                        string = "sim/cockpit2/tcas/targets/position/double/plane9_lon"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane9_ele                        string = "sim/cockpit2/tcas/targets/position/double/plane9_ele"                      // double
+	SimCockpit2TcasTargetsPositionDouble_plane10_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane10_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane10_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane10_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane10_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane10_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane11_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane11_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane11_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane11_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane11_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane11_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane12_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane12_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane12_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane12_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane12_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane12_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane13_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane13_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane13_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane13_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane13_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane13_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane14_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane14_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane14_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane14_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane14_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane14_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane15_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane15_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane15_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane15_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane15_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane15_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane16_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane16_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane16_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane16_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane16_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane16_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane17_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane17_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane17_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane17_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane17_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane17_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane18_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane18_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane18_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane18_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane18_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane18_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane19_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane19_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane19_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane19_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane19_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane19_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane20_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane20_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane20_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane20_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane20_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane20_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane21_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane21_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane21_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane21_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane21_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane21_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane22_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane22_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane22_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane22_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane22_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane22_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane23_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane23_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane23_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane23_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane23_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane23_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane24_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane24_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane24_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane24_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane24_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane24_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane25_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane25_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane25_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane25_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane25_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane25_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane26_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane26_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane26_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane26_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane26_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane26_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane27_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane27_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane27_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane27_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane27_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane27_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane28_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane28_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane28_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane28_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane28_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane28_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane29_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane29_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane29_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane29_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane29_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane29_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane30_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane30_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane30_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane30_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane30_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane30_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane31_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane31_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane31_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane31_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane31_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane31_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane32_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane32_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane32_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane32_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane32_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane32_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane33_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane33_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane33_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane33_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane33_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane33_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane34_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane34_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane34_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane34_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane34_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane34_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane35_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane35_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane35_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane35_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane35_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane35_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane36_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane36_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane36_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane36_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane36_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane36_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane37_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane37_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane37_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane37_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane37_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane37_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane38_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane38_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane38_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane38_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane38_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane38_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane39_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane39_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane39_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane39_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane39_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane39_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane40_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane40_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane40_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane40_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane40_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane40_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane41_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane41_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane41_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane41_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane41_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane41_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane42_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane42_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane42_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane42_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane42_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane42_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane43_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane43_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane43_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane43_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane43_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane43_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane44_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane44_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane44_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane44_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane44_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane44_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane45_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane45_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane45_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane45_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane45_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane45_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane46_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane46_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane46_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane46_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane46_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane46_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane47_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane47_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane47_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane47_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane47_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane47_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane48_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane48_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane48_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane48_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane48_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane48_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane49_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane49_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane49_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane49_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane49_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane49_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane50_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane50_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane50_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane50_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane50_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane50_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane51_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane51_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane51_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane51_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane51_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane51_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane52_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane52_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane52_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane52_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane52_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane52_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane53_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane53_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane53_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane53_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane53_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane53_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane54_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane54_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane54_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane54_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane54_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane54_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane55_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane55_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane55_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane55_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane55_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane55_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane56_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane56_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane56_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane56_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane56_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane56_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane57_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane57_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane57_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane57_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane57_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane57_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane58_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane58_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane58_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane58_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane58_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane58_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane59_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane59_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane59_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane59_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane59_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane59_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane60_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane60_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane60_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane60_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane60_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane60_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane61_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane61_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane61_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane61_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane61_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane61_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane62_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane62_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane62_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane62_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane62_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane62_ele"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane63_lat                       string = "sim/cockpit2/tcas/targets/position/double/plane63_lat"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane63_lon                       string = "sim/cockpit2/tcas/targets/position/double/plane63_lon"                     // double
+	SimCockpit2TcasTargetsPositionDouble_plane63_ele                       string = "sim/cockpit2/tcas/targets/position/double/plane63_ele"                     // double
+	SimCockpit2TcasTargetsWake_wing_span_m                                 string = "sim/cockpit2/tcas/targets/wake/wing_span_m"                                // float_array, writable
+	SimCockpit2TcasTargetsWake_wing_area_m2                                string = "sim/cockpit2/tcas/targets/wake/wing_area_m2"                               // float_array, writable
+	SimCockpit2TcasTargetsWake_wake_cat                                    string = "sim/cockpit2/tcas/targets/wake/wake_cat"                                   // int_array, writable
+	SimCockpit2TcasTargetsWake_mass_kg                                     string = "sim/cockpit2/tcas/targets/wake/mass_kg"                                    // float_array, writable
+	SimCockpit2TcasTargetsWake_aoa                                         string = "sim/cockpit2/tcas/targets/wake/aoa"                                        // float_array, writable
+	SimCockpit2TcasTargetsWake_lift_N                                      string = "sim/cockpit2/tcas/targets/wake/lift_N"                                     // float_array, writable
+	SimCockpit2Temperature_outside_air_temp_deg                            string = "sim/cockpit2/temperature/outside_air_temp_deg"                             // float
+	SimCockpit2Temperature_outside_air_temp_degc                           string = "sim/cockpit2/temperature/outside_air_temp_degc"                            // float, writable
+	SimCockpit2Temperature_outside_air_temp_degf                           string = "sim/cockpit2/temperature/outside_air_temp_degf"                            // float
+	SimCockpit2Temperature_outside_air_LE_temp_deg                         string = "sim/cockpit2/temperature/outside_air_LE_temp_deg"                          // float
+	SimCockpit2Temperature_outside_air_LE_temp_degc                        string = "sim/cockpit2/temperature/outside_air_LE_temp_degc"                         // float, writable
+	SimCockpit2Temperature_outside_air_LE_temp_degf                        string = "sim/cockpit2/temperature/outside_air_LE_temp_degf"                         // float
+	SimCockpit2Temperature_outside_air_temp_is_metric                      string = "sim/cockpit2/temperature/outside_air_temp_is_metric"                       // int, writable
+	SimCockpit2TransmissionsIndicators_oil_temperature                     string = "sim/cockpit2/transmissions/indicators/oil_temperature"                     // float, writable
+	SimCockpit2TransmissionsIndicators_oil_pressure                        string = "sim/cockpit2/transmissions/indicators/oil_pressure"                        // float, writable
+	SimCockpit2Weapons_weapon_select_console_index                         string = "sim/cockpit2/weapons/weapon_select_console_index"                          // int, writable
+	SimCockpit2Weapons_fire_mode                                           string = "sim/cockpit2/weapons/fire_mode"                                            // int, writable
+	SimCockpit2Weapons_fire_rate                                           string = "sim/cockpit2/weapons/fire_rate"                                            // int, writable
+	SimCockpit2Weapons_weapon_selected                                     string = "sim/cockpit2/weapons/weapon_selected"                                      // int, writable
+	SimCockpit2Weapons_gun_offset_heading_ratio                            string = "sim/cockpit2/weapons/gun_offset_heading_ratio"                             // float, writable
+	SimCockpit2Weapons_gun_offset_pitch_ratio                              string = "sim/cockpit2/weapons/gun_offset_pitch_ratio"                               // float, writable
+	SimCockpit2Weapons_master_arm                                          string = "sim/cockpit2/weapons/master_arm"                                           // int, writable
+	SimCockpit2Weapons_AA_heat_missile_armed                               string = "sim/cockpit2/weapons/AA_heat_missile_armed"                                // int
+	SimCockpit2Weapons_AA_heat_missile_has_tone                            string = "sim/cockpit2/weapons/AA_heat_missile_has_tone"                             // int
+	SimCockpit2Weapons_AA_heat_missile_trg_deg_offset                      string = "sim/cockpit2/weapons/AA_heat_missile_trg_deg_offset"                       // float
+	SimCockpit2Weapons_gun_sight_pitch_deg                                 string = "sim/cockpit2/weapons/gun_sight_pitch_deg"                                  // float
+	SimCockpit2Weapons_gun_sight_heading_deg                               string = "sim/cockpit2/weapons/gun_sight_heading_deg"                                // float
+	SimCockpit2Weapons_gun_sight_range                                     string = "sim/cockpit2/weapons/gun_sight_range"                                      // float, writable
+)
@@ -0,0 +1,759 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefflightmodel provides the known dataref names under sim/flightmodel/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefflightmodel
+
+const (
+	SimFlightmodelControls_sbrkrat                      string = "sim/flightmodel/controls/sbrkrat"                      // float, writable
+	SimFlightmodelControls_flaprqst                     string = "sim/flightmodel/controls/flaprqst"                     // float, writable
+	SimFlightmodelControls_tail_lock_rat                string = "sim/flightmodel/controls/tail_lock_rat"                // float, writable
+	SimFlightmodelControls_ail_trim                     string = "sim/flightmodel/controls/ail_trim"                     // float, writable
+	SimFlightmodelControls_dist                         string = "sim/flightmodel/controls/dist"                         // float, writable
+	SimFlightmodelControls_elv_trim                     string = "sim/flightmodel/controls/elv_trim"                     // float, writable
+	SimFlightmodelControls_flaprat                      string = "sim/flightmodel/controls/flaprat"                      // float, writable
+	SimFlightmodelControls_flap2rat                     string = "sim/flightmodel/controls/flap2rat"                     // float, writable
+	SimFlightmodelControls_l_brake_add                  string = "sim/flightmodel/controls/l_brake_add"                  // float, writable
+	SimFlightmodelControls_r_brake_add                  string = "sim/flightmodel/controls/r_brake_add"                  // float, writable
+	SimFlightmodelControls_lail1def                     string = "sim/flightmodel/controls/lail1def"                     // float, writable
+	SimFlightmodelControls_lail2def                     string = "sim/flightmodel/controls/lail2def"                     // float, writable
+	SimFlightmodelControls_rail1def                     string = "sim/flightmodel/controls/rail1def"                     // float, writable
+	SimFlightmodelControls_rail2def                     string = "sim/flightmodel/controls/rail2def"                     // float, writable
+	SimFlightmodelControls_ldruddef                     string = "sim/flightmodel/controls/ldruddef"                     // float, writable
+	SimFlightmodelControls_rdruddef                     string = "sim/flightmodel/controls/rdruddef"                     // float, writable
+	SimFlightmodelControls_lsplrdef                     string = "sim/flightmodel/controls/lsplrdef"                     // float, writable
+	SimFlightmodelControls_rsplrdef                     string = "sim/flightmodel/controls/rsplrdef"                     // float, writable
+	SimFlightmodelControls_ail1_def                     string = "sim/flightmodel/controls/ail1_def"                     // float_array, writable
+	SimFlightmodelControls_ail2_def                     string = "sim/flightmodel/controls/ail2_def"                     // float_array, writable
+	SimFlightmodelControls_splr_def                     string = "sim/flightmodel/controls/splr_def"                     // float_array, writable
+	SimFlightmodelControls_splr2_def                    string = "sim/flightmodel/controls/splr2_def"                    // float_array, writable
+	SimFlightmodelControls_yawb_def                     string = "sim/flightmodel/controls/yawb_def"                     // float_array, writable
+	SimFlightmodelControls_rudd_def                     string = "sim/flightmodel/controls/rudd_def"                     // float_array, writable
+	SimFlightmodelControls_rudd2_def                    string = "sim/flightmodel/controls/rudd2_def"                    // float_array, writable
+	SimFlightmodelControls_elv1_def                     string = "sim/flightmodel/controls/elv1_def"                     // float_array, writable
+	SimFlightmodelControls_elv2_def                     string = "sim/flightmodel/controls/elv2_def"                     // float_array, writable
+	SimFlightmodelControls_fla1_def                     string = "sim/flightmodel/controls/fla1_def"                     // float_array, writable
+	SimFlightmodelControls_fla2_def                     string = "sim/flightmodel/controls/fla2_def"                     // float_array, writable
+	SimFlightmodelControls_sbrkrqst                     string = "sim/flightmodel/controls/sbrkrqst"                     // float, writable
+	SimFlightmodelControls_vectrqst                     string = "sim/flightmodel/controls/vectrqst"                     // float, writable
+	SimFlightmodelControls_swdi                         string = "sim/flightmodel/controls/swdi"                         // float, writable
+	SimFlightmodelControls_swdirqst                     string = "sim/flightmodel/controls/swdirqst"                     // float, writable
+	SimFlightmodelControls_slatrat                      string = "sim/flightmodel/controls/slatrat"                      // float, writable
+	SimFlightmodelControls_parkbrake                    string = "sim/flightmodel/controls/parkbrake"                    // float, writable
+	SimFlightmodelControls_rud_trim                     string = "sim/flightmodel/controls/rud_trim"                     // float, writable
+	SimFlightmodelControls_incid_rqst                   string = "sim/flightmodel/controls/incid_rqst"                   // float, writable
+	SimFlightmodelControls_dihed_rqst                   string = "sim/flightmodel/controls/dihed_rqst"                   // float, writable
+	SimFlightmodelControls_vect_rat                     string = "sim/flightmodel/controls/vect_rat"                     // float, writable
+	SimFlightmodelControls_incid_rat                    string = "sim/flightmodel/controls/incid_rat"                    // float, writable
+	SimFlightmodelControls_dihed_rat                    string = "sim/flightmodel/controls/dihed_rat"                    // float, writable
+	SimFlightmodelControls_tailhook_ratio               string = "sim/flightmodel/controls/tailhook_ratio"               // float, writable
+	SimFlightmodelControls_canopy_ratio                 string = "sim/flightmodel/controls/canopy_ratio"                 // float, writable
+	SimFlightmodelControls_wing1l_ail1def               string = "sim/flightmodel/controls/wing1l_ail1def"               // float, writable
+	SimFlightmodelControls_wing1l_ail2def               string = "sim/flightmodel/controls/wing1l_ail2def"               // float, writable
+	SimFlightmodelControls_wing1r_ail1def               string = "sim/flightmodel/controls/wing1r_ail1def"               // float, writable
+	SimFlightmodelControls_wing1r_ail2def               string = "sim/flightmodel/controls/wing1r_ail2def"               // float, writable
+	SimFlightmodelControls_wing2l_ail1def               string = "sim/flightmodel/controls/wing2l_ail1def"               // float, writable
+	SimFlightmodelControls_wing2l_ail2def               string = "sim/flightmodel/controls/wing2l_ail2def"               // float, writable
+	SimFlightmodelControls_wing2r_ail1def               string = "sim/flightmodel/controls/wing2r_ail1def"               // float, writable
+	SimFlightmodelControls_wing2r_ail2def               string = "sim/flightmodel/controls/wing2r_ail2def"               // float, writable
+	SimFlightmodelControls_wing3l_ail1def               string = "sim/flightmodel/controls/wing3l_ail1def"               // float, writable
+	SimFlightmodelControls_wing3l_ail2def               string = "sim/flightmodel/controls/wing3l_ail2def"               // float, writable
+	SimFlightmodelControls_wing3r_ail1def               string = "sim/flightmodel/controls/wing3r_ail1def"               // float, writable
+	SimFlightmodelControls_wing3r_ail2def               string = "sim/flightmodel/controls/wing3r_ail2def"               // float, writable
+	SimFlightmodelControls_wing4l_ail1def               string = "sim/flightmodel/controls/wing4l_ail1def"               // float, writable
+	SimFlightmodelControls_wing4l_ail2def               string = "sim/flightmodel/controls/wing4l_ail2def"               // float, writable
+	SimFlightmodelControls_wing4r_ail1def               string = "sim/flightmodel/controls/wing4r_ail1def"               // float, writable
+	SimFlightmodelControls_wing4r_ail2def               string = "sim/flightmodel/controls/wing4r_ail2def"               // float, writable
+	SimFlightmodelControls_wing1l_spo1def               string = "sim/flightmodel/controls/wing1l_spo1def"               // float, writable
+	SimFlightmodelControls_wing1l_spo2def               string = "sim/flightmodel/controls/wing1l_spo2def"               // float, writable
+	SimFlightmodelControls_wing1r_spo1def               string = "sim/flightmodel/controls/wing1r_spo1def"               // float, writable
+	SimFlightmodelControls_wing1r_spo2def               string = "sim/flightmodel/controls/wing1r_spo2def"               // float, writable
+	SimFlightmodelControls_wing2l_spo1def               string = "sim/flightmodel/controls/wing2l_spo1def"               // float, writable
+	SimFlightmodelControls_wing2l_spo2def               string = "sim/flightmodel/controls/wing2l_spo2def"               // float, writable
+	SimFlightmodelControls_wing2r_spo1def               string = "sim/flightmodel/controls/wing2r_spo1def"               // float, writable
+	SimFlightmodelControls_wing2r_spo2def               string = "sim/flightmodel/controls/wing2r_spo2def"               // float, writable
+	SimFlightmodelControls_wing3l_spo1def               string = "sim/flightmodel/controls/wing3l_spo1def"               // float, writable
+	SimFlightmodelControls_wing3l_spo2def               string = "sim/flightmodel/controls/wing3l_spo2def"               // float, writable
+	SimFlightmodelControls_wing3r_spo1def               string = "sim/flightmodel/controls/wing3r_spo1def"               // float, writable
+	SimFlightmodelControls_wing3r_spo2def               string = "sim/flightmodel/controls/wing3r_spo2def"               // float, writable
+	SimFlightmodelControls_wing4l_spo1def               string = "sim/flightmodel/controls/wing4l_spo1def"               // float, writable
+	SimFlightmodelControls_wing4l_spo2def               string = "sim/flightmodel/controls/wing4l_spo2def"               // float, writable
+	SimFlightmodelControls_wing4r_spo1def               string = "sim/flightmodel/controls/wing4r_spo1def"               // float, writable
+	SimFlightmodelControls_wing4r_spo2def               string = "sim/flightmodel/controls/wing4r_spo2def"               // float, writable
+	SimFlightmodelControls_wing1l_fla1def               string = "sim/flightmodel/controls/wing1l_fla1def"               // float, writable
+	SimFlightmodelControls_wing1l_fla2def               string = "sim/flightmodel/controls/wing1l_fla2def"               // float, writable
+	SimFlightmodelControls_wing1r_fla1def               string = "sim/flightmodel/controls/wing1r_fla1def"               // float, writable
+	SimFlightmodelControls_wing1r_fla2def               string = "sim/flightmodel/controls/wing1r_fla2def"               // float, writable
+	SimFlightmodelControls_wing2l_fla1def               string = "sim/flightmodel/controls/wing2l_fla1def"               // float, writable
+	SimFlightmodelControls_wing2l_fla2def               string = "sim/flightmodel/controls/wing2l_fla2def"               // float, writable
+	SimFlightmodelControls_wing2r_fla1def               string = "sim/flightmodel/controls/wing2r_fla1def"               // float, writable
+	SimFlightmodelControls_wing2r_fla2def               string = "sim/flightmodel/controls/wing2r_fla2def"               // float, writable
+	SimFlightmodelControls_wing3l_fla1def               string = "sim/flightmodel/controls/wing3l_fla1def"               // float, writable
+	SimFlightmodelControls_wing3l_fla2def               string = "sim/flightmodel/controls/wing3l_fla2def"               // float, writable
+	SimFlightmodelControls_wing3r_fla1def               string = "sim/flightmodel/controls/wing3r_fla1def"               // float, writable
+	SimFlightmodelControls_wing3r_fla2def               string = "sim/flightmodel/controls/wing3r_fla2def"               // float, writable
+	SimFlightmodelControls_wing4l_fla1def               string = "sim/flightmodel/controls/wing4l_fla1def"               // float, writable
+	SimFlightmodelControls_wing4l_fla2def               string = "sim/flightmodel/controls/wing4l_fla2def"               // float, writable
+	SimFlightmodelControls_wing4r_fla1def               string = "sim/flightmodel/controls/wing4r_fla1def"               // float, writable
+	SimFlightmodelControls_wing4r_fla2def               string = "sim/flightmodel/controls/wing4r_fla2def"               // float, writable
+	SimFlightmodelControls_wing1l_yawbdef               string = "sim/flightmodel/controls/wing1l_yawbdef"               // float, writable
+	SimFlightmodelControls_wing1r_yawbdef               string = "sim/flightmodel/controls/wing1r_yawbdef"               // float, writable
+	SimFlightmodelControls_wing2l_yawbdef               string = "sim/flightmodel/controls/wing2l_yawbdef"               // float, writable
+	SimFlightmodelControls_wing2r_yawbdef               string = "sim/flightmodel/controls/wing2r_yawbdef"               // float, writable
+	SimFlightmodelControls_wing3l_yawbdef               string = "sim/flightmodel/controls/wing3l_yawbdef"               // float, writable
+	SimFlightmodelControls_wing3r_yawbdef               string = "sim/flightmodel/controls/wing3r_yawbdef"               // float, writable
+	SimFlightmodelControls_wing4l_yawbdef               string = "sim/flightmodel/controls/wing4l_yawbdef"               // float, writable
+	SimFlightmodelControls_wing4r_yawbdef               string = "sim/flightmodel/controls/wing4r_yawbdef"               // float, writable
+	SimFlightmodelControls_hstab1_elv1def               string = "sim/flightmodel/controls/hstab1_elv1def"               // float, writable
+	SimFlightmodelControls_hstab1_elv2def               string = "sim/flightmodel/controls/hstab1_elv2def"               // float, writable
+	SimFlightmodelControls_hstab2_elv1def               string = "sim/flightmodel/controls/hstab2_elv1def"               // float, writable
+	SimFlightmodelControls_hstab2_elv2def               string = "sim/flightmodel/controls/hstab2_elv2def"               // float, writable
+	SimFlightmodelControls_vstab1_rud1def               string = "sim/flightmodel/controls/vstab1_rud1def"               // float, writable
+	SimFlightmodelControls_vstab1_rud2def               string = "sim/flightmodel/controls/vstab1_rud2def"               // float, writable
+	SimFlightmodelControls_vstab2_rud1def               string = "sim/flightmodel/controls/vstab2_rud1def"               // float, writable
+	SimFlightmodelControls_vstab2_rud2def               string = "sim/flightmodel/controls/vstab2_rud2def"               // float, writable
+	SimFlightmodelControls_mwing01_ail1def              string = "sim/flightmodel/controls/mwing01_ail1def"              // float, writable
+	SimFlightmodelControls_mwing01_ail2def              string = "sim/flightmodel/controls/mwing01_ail2def"              // float, writable
+	SimFlightmodelControls_mwing01_spo1def              string = "sim/flightmodel/controls/mwing01_spo1def"              // float, writable
+	SimFlightmodelControls_mwing01_spo2def              string = "sim/flightmodel/controls/mwing01_spo2def"              // float, writable
+	SimFlightmodelControls_mwing01_fla1def              string = "sim/flightmodel/controls/mwing01_fla1def"              // float, writable
+	SimFlightmodelControls_mwing01_fla2def              string = "sim/flightmodel/controls/mwing01_fla2def"              // float, writable
+	SimFlightmodelControls_mwing01_yawbdef              string = "sim/flightmodel/controls/mwing01_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing01_elv1def              string = "sim/flightmodel/controls/mwing01_elv1def"              // float, writable
+	SimFlightmodelControls_mwing01_elv2def              string = "sim/flightmodel/controls/mwing01_elv2def"              // float, writable
+	SimFlightmodelControls_mwing01_rud1def              string = "sim/flightmodel/controls/mwing01_rud1def"              // float, writable
+	SimFlightmodelControls_mwing01_rud2def              string = "sim/flightmodel/controls/mwing01_rud2def"              // float, writable
+	SimFlightmodelControls_mwing02_ail1def              string = "sim/flightmodel/controls/mwing02_ail1def"              // float, writable
+	SimFlightmodelControls_mwing02_ail2def              string = "sim/flightmodel/controls/mwing02_ail2def"              // float, writable
+	SimFlightmodelControls_mwing02_spo1def              string = "sim/flightmodel/controls/mwing02_spo1def"              // float, writable
+	SimFlightmodelControls_mwing02_spo2def              string = "sim/flightmodel/controls/mwing02_spo2def"              // float, writable
+	SimFlightmodelControls_mwing02_fla1def              string = "sim/flightmodel/controls/mwing02_fla1def"              // float, writable
+	SimFlightmodelControls_mwing02_fla2def              string = "sim/flightmodel/controls/mwing02_fla2def"              // float, writable
+	SimFlightmodelControls_mwing02_yawbdef              string = "sim/flightmodel/controls/mwing02_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing02_elv1def              string = "sim/flightmodel/controls/mwing02_elv1def"              // float, writable
+	SimFlightmodelControls_mwing02_elv2def              string = "sim/flightmodel/controls/mwing02_elv2def"              // float, writable
+	SimFlightmodelControls_mwing02_rud1def              string = "sim/flightmodel/controls/mwing02_rud1def"              // float, writable
+	SimFlightmodelControls_mwing02_rud2def              string = "sim/flightmodel/controls/mwing02_rud2def"              // float, writable
+	SimFlightmodelControls_mwing03_ail1def              string = "sim/flightmodel/controls/mwing03_ail1def"              // float, writable
+	SimFlightmodelControls_mwing03_ail2def              string = "sim/flightmodel/controls/mwing03_ail2def"              // float, writable
+	SimFlightmodelControls_mwing03_spo1def              string = "sim/flightmodel/controls/mwing03_spo1def"              // float, writable
+	SimFlightmodelControls_mwing03_spo2def              string = "sim/flightmodel/controls/mwing03_spo2def"              // float, writable
+	SimFlightmodelControls_mwing03_fla1def              string = "sim/flightmodel/controls/mwing03_fla1def"              // float, writable
+	SimFlightmodelControls_mwing03_fla2def              string = "sim/flightmodel/controls/mwing03_fla2def"              // float, writable
+	SimFlightmodelControls_mwing03_yawbdef              string = "sim/flightmodel/controls/mwing03_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing03_elv1def              string = "sim/flightmodel/controls/mwing03_elv1def"              // float, writable
+	SimFlightmodelControls_mwing03_elv2def              string = "sim/flightmodel/controls/mwing03_elv2def"              // float, writable
+	SimFlightmodelControls_mwing03_rud1def              string = "sim/flightmodel/controls/mwing03_rud1def"              // float, writable
+	SimFlightmodelControls_mwing03_rud2def              string = "sim/flightmodel/controls/mwing03_rud2def"              // float, writable
+	SimFlightmodelControls_mwing04_ail1def              string = "sim/flightmodel/controls/mwing04_ail1def"              // float, writable
+	SimFlightmodelControls_mwing04_ail2def              string = "sim/flightmodel/controls/mwing04_ail2def"              // float, writable
+	SimFlightmodelControls_mwing04_spo1def              string = "sim/flightmodel/controls/mwing04_spo1def"              // float, writable
+	SimFlightmodelControls_mwing04_spo2def              string = "sim/flightmodel/controls/mwing04_spo2def"              // float, writable
+	SimFlightmodelControls_mwing04_fla1def              string = "sim/flightmodel/controls/mwing04_fla1def"              // float, writable
+	SimFlightmodelControls_mwing04_fla2def              string = "sim/flightmodel/controls/mwing04_fla2def"              // float, writable
+	SimFlightmodelControls_mwing04_yawbdef              string = "sim/flightmodel/controls/mwing04_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing04_elv1def              string = "sim/flightmodel/controls/mwing04_elv1def"              // float, writable
+	SimFlightmodelControls_mwing04_elv2def              string = "sim/flightmodel/controls/mwing04_elv2def"              // float, writable
+	SimFlightmodelControls_mwing04_rud1def              string = "sim/flightmodel/controls/mwing04_rud1def"              // float, writable
+	SimFlightmodelControls_mwing04_rud2def              string = "sim/flightmodel/controls/mwing04_rud2def"              // float, writable
+	SimFlightmodelControls_mwing05_ail1def              string = "sim/flightmodel/controls/mwing05_ail1def"              // float, writable
+	SimFlightmodelControls_mwing05_ail2def              string = "sim/flightmodel/controls/mwing05_ail2def"              // float, writable
+	SimFlightmodelControls_mwing05_spo1def              string = "sim/flightmodel/controls/mwing05_spo1def"              // float, writable
+	SimFlightmodelControls_mwing05_spo2def              string = "sim/flightmodel/controls/mwing05_spo2def"              // float, writable
+	SimFlightmodelControls_mwing05_fla1def              string = "sim/flightmodel/controls/mwing05_fla1def"              // float, writable
+	SimFlightmodelControls_mwing05_fla2def              string = "sim/flightmodel/controls/mwing05_fla2def"              // float, writable
+	SimFlightmodelControls_mwing05_yawbdef              string = "sim/flightmodel/controls/mwing05_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing05_elv1def              string = "sim/flightmodel/controls/mwing05_elv1def"              // float, writable
+	SimFlightmodelControls_mwing05_elv2def              string = "sim/flightmodel/controls/mwing05_elv2def"              // float, writable
+	SimFlightmodelControls_mwing05_rud1def              string = "sim/flightmodel/controls/mwing05_rud1def"              // float, writable
+	SimFlightmodelControls_mwing05_rud2def              string = "sim/flightmodel/controls/mwing05_rud2def"              // float, writable
+	SimFlightmodelControls_mwing06_ail1def              string = "sim/flightmodel/controls/mwing06_ail1def"              // float, writable
+	SimFlightmodelControls_mwing06_ail2def              string = "sim/flightmodel/controls/mwing06_ail2def"              // float, writable
+	SimFlightmodelControls_mwing06_spo1def              string = "sim/flightmodel/controls/mwing06_spo1def"              // float, writable
+	SimFlightmodelControls_mwing06_spo2def              string = "sim/flightmodel/controls/mwing06_spo2def"              // float, writable
+	SimFlightmodelControls_mwing06_fla1def              string = "sim/flightmodel/controls/mwing06_fla1def"              // float, writable
+	SimFlightmodelControls_mwing06_fla2def              string = "sim/flightmodel/controls/mwing06_fla2def"              // float, writable
+	SimFlightmodelControls_mwing06_yawbdef              string = "sim/flightmodel/controls/mwing06_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing06_elv1def              string = "sim/flightmodel/controls/mwing06_elv1def"              // float, writable
+	SimFlightmodelControls_mwing06_elv2def              string = "sim/flightmodel/controls/mwing06_elv2def"              // float, writable
+	SimFlightmodelControls_mwing06_rud1def              string = "sim/flightmodel/controls/mwing06_rud1def"              // float, writable
+	SimFlightmodelControls_mwing06_rud2def              string = "sim/flightmodel/controls/mwing06_rud2def"              // float, writable
+	SimFlightmodelControls_mwing07_ail1def              string = "sim/flightmodel/controls/mwing07_ail1def"              // float, writable
+	SimFlightmodelControls_mwing07_ail2def              string = "sim/flightmodel/controls/mwing07_ail2def"              // float, writable
+	SimFlightmodelControls_mwing07_spo1def              string = "sim/flightmodel/controls/mwing07_spo1def"              // float, writable
+	SimFlightmodelControls_mwing07_spo2def              string = "sim/flightmodel/controls/mwing07_spo2def"              // float, writable
+	SimFlightmodelControls_mwing07_fla1def              string = "sim/flightmodel/controls/mwing07_fla1def"              // float, writable
+	SimFlightmodelControls_mwing07_fla2def              string = "sim/flightmodel/controls/mwing07_fla2def"              // float, writable
+	SimFlightmodelControls_mwing07_yawbdef              string = "sim/flightmodel/controls/mwing07_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing07_elv1def              string = "sim/flightmodel/controls/mwing07_elv1def"              // float, writable
+	SimFlightmodelControls_mwing07_elv2def              string = "sim/flightmodel/controls/mwing07_elv2def"              // float, writable
+	SimFlightmodelControls_mwing07_rud1def              string = "sim/flightmodel/controls/mwing07_rud1def"              // float, writable
+	SimFlightmodelControls_mwing07_rud2def              string = "sim/flightmodel/controls/mwing07_rud2def"              // float, writable
+	SimFlightmodelControls_mwing08_ail1def              string = "sim/flightmodel/controls/mwing08_ail1def"              // float, writable
+	SimFlightmodelControls_mwing08_ail2def              string = "sim/flightmodel/controls/mwing08_ail2def"              // float, writable
+	SimFlightmodelControls_mwing08_spo1def              string = "sim/flightmodel/controls/mwing08_spo1def"              // float, writable
+	SimFlightmodelControls_mwing08_spo2def              string = "sim/flightmodel/controls/mwing08_spo2def"              // float, writable
+	SimFlightmodelControls_mwing08_fla1def              string = "sim/flightmodel/controls/mwing08_fla1def"              // float, writable
+	SimFlightmodelControls_mwing08_fla2def              string = "sim/flightmodel/controls/mwing08_fla2def"              // float, writable
+	SimFlightmodelControls_mwing08_yawbdef              string = "sim/flightmodel/controls/mwing08_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing08_elv1def              string = "sim/flightmodel/controls/mwing08_elv1def"              // float, writable
+	SimFlightmodelControls_mwing08_elv2def              string = "sim/flightmodel/controls/mwing08_elv2def"              // float, writable
+	SimFlightmodelControls_mwing08_rud1def              string = "sim/flightmodel/controls/mwing08_rud1def"              // float, writable
+	SimFlightmodelControls_mwing08_rud2def              string = "sim/flightmodel/controls/mwing08_rud2def"              // float, writable
+	SimFlightmodelControls_mwing09_ail1def              string = "sim/flightmodel/controls/mwing09_ail1def"              // float, writable
+	SimFlightmodelControls_mwing09_ail2def              string = "sim/flightmodel/controls/mwing09_ail2def"              // float, writable
+	SimFlightmodelControls_mwing09_spo1def              string = "sim/flightmodel/controls/mwing09_spo1def"              // float, writable
+	SimFlightmodelControls_mwing09_spo2def              string = "sim/flightmodel/controls/mwing09_spo2def"              // float, writable
+	SimFlightmodelControls_mwing09_fla1def              string = "sim/flightmodel/controls/mwing09_fla1def"              // float, writable
+	SimFlightmodelControls_mwing09_fla2def              string = "sim/flightmodel/controls/mwing09_fla2def"              // float, writable
+	SimFlightmodelControls_mwing09_yawbdef              string = "sim/flightmodel/controls/mwing09_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing09_elv1def              string = "sim/flightmodel/controls/mwing09_elv1def"              // float, writable
+	SimFlightmodelControls_mwing09_elv2def              string = "sim/flightmodel/controls/mwing09_elv2def"              // float, writable
+	SimFlightmodelControls_mwing09_rud1def              string = "sim/flightmodel/controls/mwing09_rud1def"              // float, writable
+	SimFlightmodelControls_mwing09_rud2def              string = "sim/flightmodel/controls/mwing09_rud2def"              // float, writable
+	SimFlightmodelControls_mwing10_ail1def              string = "sim/flightmodel/controls/mwing10_ail1def"              // float, writable
+	SimFlightmodelControls_mwing10_ail2def              string = "sim/flightmodel/controls/mwing10_ail2def"              // float, writable
+	SimFlightmodelControls_mwing10_spo1def              string = "sim/flightmodel/controls/mwing10_spo1def"              // float, writable
+	SimFlightmodelControls_mwing10_spo2def              string = "sim/flightmodel/controls/mwing10_spo2def"              // float, writable
+	SimFlightmodelControls_mwing10_fla1def              string = "sim/flightmodel/controls/mwing10_fla1def"              // float, writable
+	SimFlightmodelControls_mwing10_fla2def              string = "sim/flightmodel/controls/mwing10_fla2def"              // float, writable
+	SimFlightmodelControls_mwing10_yawbdef              string = "sim/flightmodel/controls/mwing10_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing10_elv1def              string = "sim/flightmodel/controls/mwing10_elv1def"              // float, writable
+	SimFlightmodelControls_mwing10_elv2def              string = "sim/flightmodel/controls/mwing10_elv2def"              // float, writable
+	SimFlightmodelControls_mwing10_rud1def              string = "sim/flightmodel/controls/mwing10_rud1def"              // float, writable
+	SimFlightmodelControls_mwing10_rud2def              string = "sim/flightmodel/controls/mwing10_rud2def"              // float, writable
+	SimFlightmodelControls_mwing11_ail1def              string = "sim/flightmodel/controls/mwing11_ail1def"              // float, writable
+	SimFlightmodelControls_mwing11_ail2def              string = "sim/flightmodel/controls/mwing11_ail2def"              // float, writable
+	SimFlightmodelControls_mwing11_spo1def              string = "sim/flightmodel/controls/mwing11_spo1def"              // float, writable
+	SimFlightmodelControls_mwing11_spo2def              string = "sim/flightmodel/controls/mwing11_spo2def"              // float, writable
+	SimFlightmodelControls_mwing11_fla1def              string = "sim/flightmodel/controls/mwing11_fla1def"              // float, writable
+	SimFlightmodelControls_mwing11_fla2def              string = "sim/flightmodel/controls/mwing11_fla2def"              // float, writable
+	SimFlightmodelControls_mwing11_yawbdef              string = "sim/flightmodel/controls/mwing11_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing11_elv1def              string = "sim/flightmodel/controls/mwing11_elv1def"              // float, writable
+	SimFlightmodelControls_mwing11_elv2def              string = "sim/flightmodel/controls/mwing11_elv2def"              // float, writable
+	SimFlightmodelControls_mwing11_rud1def              string = "sim/flightmodel/controls/mwing11_rud1def"              // float, writable
+	SimFlightmodelControls_mwing11_rud2def              string = "sim/flightmodel/controls/mwing11_rud2def"              // float, writable
+	SimFlightmodelControls_mwing12_ail1def              string = "sim/flightmodel/controls/mwing12_ail1def"              // float, writable
+	SimFlightmodelControls_mwing12_ail2def              string = "sim/flightmodel/controls/mwing12_ail2def"              // float, writable
+	SimFlightmodelControls_mwing12_spo1def              string = "sim/flightmodel/controls/mwing12_spo1def"              // float, writable
+	SimFlightmodelControls_mwing12_spo2def              string = "sim/flightmodel/controls/mwing12_spo2def"              // float, writable
+	SimFlightmodelControls_mwing12_fla1def              string = "sim/flightmodel/controls/mwing12_fla1def"              // float, writable
+	SimFlightmodelControls_mwing12_fla2def              string = "sim/flightmodel/controls/mwing12_fla2def"              // float, writable
+	SimFlightmodelControls_mwing12_yawbdef              string = "sim/flightmodel/controls/mwing12_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing12_elv1def              string = "sim/flightmodel/controls/mwing12_elv1def"              // float, writable
+	SimFlightmodelControls_mwing12_elv2def              string = "sim/flightmodel/controls/mwing12_elv2def"              // float, writable
+	SimFlightmodelControls_mwing12_rud1def              string = "sim/flightmodel/controls/mwing12_rud1def"              // float, writable
+	SimFlightmodelControls_mwing12_rud2def              string = "sim/flightmodel/controls/mwing12_rud2def"              // float, writable
+	SimFlightmodelControls_mwing13_ail1def              string = "sim/flightmodel/controls/mwing13_ail1def"              // float, writable
+	SimFlightmodelControls_mwing13_ail2def              string = "sim/flightmodel/controls/mwing13_ail2def"              // float, writable
+	SimFlightmodelControls_mwing13_spo1def              string = "sim/flightmodel/controls/mwing13_spo1def"              // float, writable
+	SimFlightmodelControls_mwing13_spo2def              string = "sim/flightmodel/controls/mwing13_spo2def"              // float, writable
+	SimFlightmodelControls_mwing13_fla1def              string = "sim/flightmodel/controls/mwing13_fla1def"              // float, writable
+	SimFlightmodelControls_mwing13_fla2def              string = "sim/flightmodel/controls/mwing13_fla2def"              // float, writable
+	SimFlightmodelControls_mwing13_yawbdef              string = "sim/flightmodel/controls/mwing13_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing13_elv1def              string = "sim/flightmodel/controls/mwing13_elv1def"              // float, writable
+	SimFlightmodelControls_mwing13_elv2def              string = "sim/flightmodel/controls/mwing13_elv2def"              // float, writable
+	SimFlightmodelControls_mwing13_rud1def              string = "sim/flightmodel/controls/mwing13_rud1def"              // float, writable
+	SimFlightmodelControls_mwing13_rud2def              string = "sim/flightmodel/controls/mwing13_rud2def"              // float, writable
+	SimFlightmodelControls_mwing14_ail1def              string = "sim/flightmodel/controls/mwing14_ail1def"              // float, writable
+	SimFlightmodelControls_mwing14_ail2def              string = "sim/flightmodel/controls/mwing14_ail2def"              // float, writable
+	SimFlightmodelControls_mwing14_spo1def              string = "sim/flightmodel/controls/mwing14_spo1def"              // float, writable
+	SimFlightmodelControls_mwing14_spo2def              string = "sim/flightmodel/controls/mwing14_spo2def"              // float, writable
+	SimFlightmodelControls_mwing14_fla1def              string = "sim/flightmodel/controls/mwing14_fla1def"              // float, writable
+	SimFlightmodelControls_mwing14_fla2def              string = "sim/flightmodel/controls/mwing14_fla2def"              // float, writable
+	SimFlightmodelControls_mwing14_yawbdef              string = "sim/flightmodel/controls/mwing14_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing14_elv1def              string = "sim/flightmodel/controls/mwing14_elv1def"              // float, writable
+	SimFlightmodelControls_mwing14_elv2def              string = "sim/flightmodel/controls/mwing14_elv2def"              // float, writable
+	SimFlightmodelControls_mwing14_rud1def              string = "sim/flightmodel/controls/mwing14_rud1def"              // float, writable
+	SimFlightmodelControls_mwing14_rud2def              string = "sim/flightmodel/controls/mwing14_rud2def"              // float, writable
+	SimFlightmodelControls_mwing15_ail1def              string = "sim/flightmodel/controls/mwing15_ail1def"              // float, writable
+	SimFlightmodelControls_mwing15_ail2def              string = "sim/flightmodel/controls/mwing15_ail2def"              // float, writable
+	SimFlightmodelControls_mwing15_spo1def              string = "sim/flightmodel/controls/mwing15_spo1def"              // float, writable
+	SimFlightmodelControls_mwing15_spo2def              string = "sim/flightmodel/controls/mwing15_spo2def"              // float, writable
+	SimFlightmodelControls_mwing15_fla1def              string = "sim/flightmodel/controls/mwing15_fla1def"              // float, writable
+	SimFlightmodelControls_mwing15_fla2def              string = "sim/flightmodel/controls/mwing15_fla2def"              // float, writable
+	SimFlightmodelControls_mwing15_yawbdef              string = "sim/flightmodel/controls/mwing15_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing15_elv1def              string = "sim/flightmodel/controls/mwing15_elv1def"              // float, writable
+	SimFlightmodelControls_mwing15_elv2def              string = "sim/flightmodel/controls/mwing15_elv2def"              // float, writable
+	SimFlightmodelControls_mwing15_rud1def              string = "sim/flightmodel/controls/mwing15_rud1def"              // float, writable
+	SimFlightmodelControls_mwing15_rud2def              string = "sim/flightmodel/controls/mwing15_rud2def"              // float, writable
+	SimFlightmodelControls_mwing16_ail1def              string = "sim/flightmodel/controls/mwing16_ail1def"              // float, writable
+	SimFlightmodelControls_mwing16_ail2def              string = "sim/flightmodel/controls/mwing16_ail2def"              // float, writable
+	SimFlightmodelControls_mwing16_spo1def              string = "sim/flightmodel/controls/mwing16_spo1def"              // float, writable
+	SimFlightmodelControls_mwing16_spo2def              string = "sim/flightmodel/controls/mwing16_spo2def"              // float, writable
+	SimFlightmodelControls_mwing16_fla1def              string = "sim/flightmodel/controls/mwing16_fla1def"              // float, writable
+	SimFlightmodelControls_mwing16_fla2def              string = "sim/flightmodel/controls/mwing16_fla2def"              // float, writable
+	SimFlightmodelControls_mwing16_yawbdef              string = "sim/flightmodel/controls/mwing16_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing16_elv1def              string = "sim/flightmodel/controls/mwing16_elv1def"              // float, writable
+	SimFlightmodelControls_mwing16_elv2def              string = "sim/flightmodel/controls/mwing16_elv2def"              // float, writable
+	SimFlightmodelControls_mwing16_rud1def              string = "sim/flightmodel/controls/mwing16_rud1def"              // float, writable
+	SimFlightmodelControls_mwing16_rud2def              string = "sim/flightmodel/controls/mwing16_rud2def"              // float, writable
+	SimFlightmodelControls_mwing17_ail1def              string = "sim/flightmodel/controls/mwing17_ail1def"              // float, writable
+	SimFlightmodelControls_mwing17_ail2def              string = "sim/flightmodel/controls/mwing17_ail2def"              // float, writable
+	SimFlightmodelControls_mwing17_spo1def              string = "sim/flightmodel/controls/mwing17_spo1def"              // float, writable
+	SimFlightmodelControls_mwing17_spo2def              string = "sim/flightmodel/controls/mwing17_spo2def"              // float, writable
+	SimFlightmodelControls_mwing17_fla1def              string = "sim/flightmodel/controls/mwing17_fla1def"              // float, writable
+	SimFlightmodelControls_mwing17_fla2def              string = "sim/flightmodel/controls/mwing17_fla2def"              // float, writable
+	SimFlightmodelControls_mwing17_yawbdef              string = "sim/flightmodel/controls/mwing17_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing17_elv1def              string = "sim/flightmodel/controls/mwing17_elv1def"              // float, writable
+	SimFlightmodelControls_mwing17_elv2def              string = "sim/flightmodel/controls/mwing17_elv2def"              // float, writable
+	SimFlightmodelControls_mwing17_rud1def              string = "sim/flightmodel/controls/mwing17_rud1def"              // float, writable
+	SimFlightmodelControls_mwing17_rud2def              string = "sim/flightmodel/controls/mwing17_rud2def"              // float, writable
+	SimFlightmodelControls_mwing18_ail1def              string = "sim/flightmodel/controls/mwing18_ail1def"              // float, writable
+	SimFlightmodelControls_mwing18_ail2def              string = "sim/flightmodel/controls/mwing18_ail2def"              // float, writable
+	SimFlightmodelControls_mwing18_spo1def              string = "sim/flightmodel/controls/mwing18_spo1def"              // float, writable
+	SimFlightmodelControls_mwing18_spo2def              string = "sim/flightmodel/controls/mwing18_spo2def"              // float, writable
+	SimFlightmodelControls_mwing18_fla1def              string = "sim/flightmodel/controls/mwing18_fla1def"              // float, writable
+	SimFlightmodelControls_mwing18_fla2def              string = "sim/flightmodel/controls/mwing18_fla2def"              // float, writable
+	SimFlightmodelControls_mwing18_yawbdef              string = "sim/flightmodel/controls/mwing18_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing18_elv1def              string = "sim/flightmodel/controls/mwing18_elv1def"              // float, writable
+	SimFlightmodelControls_mwing18_elv2def              string = "sim/flightmodel/controls/mwing18_elv2def"              // float, writable
+	SimFlightmodelControls_mwing18_rud1def              string = "sim/flightmodel/controls/mwing18_rud1def"              // float, writable
+	SimFlightmodelControls_mwing18_rud2def              string = "sim/flightmodel/controls/mwing18_rud2def"              // float, writable
+	SimFlightmodelControls_mwing19_ail1def              string = "sim/flightmodel/controls/mwing19_ail1def"              // float, writable
+	SimFlightmodelControls_mwing19_ail2def              string = "sim/flightmodel/controls/mwing19_ail2def"              // float, writable
+	SimFlightmodelControls_mwing19_spo1def              string = "sim/flightmodel/controls/mwing19_spo1def"              // float, writable
+	SimFlightmodelControls_mwing19_spo2def              string = "sim/flightmodel/controls/mwing19_spo2def"              // float, writable
+	SimFlightmodelControls_mwing19_fla1def              string = "sim/flightmodel/controls/mwing19_fla1def"              // float, writable
+	SimFlightmodelControls_mwing19_fla2def              string = "sim/flightmodel/controls/mwing19_fla2def"              // float, writable
+	SimFlightmodelControls_mwing19_yawbdef              string = "sim/flightmodel/controls/mwing19_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing19_elv1def              string = "sim/flightmodel/controls/mwing19_elv1def"              // float, writable
+	SimFlightmodelControls_mwing19_elv2def              string = "sim/flightmodel/controls/mwing19_elv2def"              // float, writable
+	SimFlightmodelControls_mwing19_rud1def              string = "sim/flightmodel/controls/mwing19_rud1def"              // float, writable
+	SimFlightmodelControls_mwing19_rud2def              string = "sim/flightmodel/controls/mwing19_rud2def"              // float, writable
+	SimFlightmodelControls_mwing20_ail1def              string = "sim/flightmodel/controls/mwing20_ail1def"              // float, writable
+	SimFlightmodelControls_mwing20_ail2def              string = "sim/flightmodel/controls/mwing20_ail2def"              // float, writable
+	SimFlightmodelControls_mwing20_spo1def              string = "sim/flightmodel/controls/mwing20_spo1def"              // float, writable
+	SimFlightmodelControls_mwing20_spo2def              string = "sim/flightmodel/controls/mwing20_spo2def"              // float, writable
+	SimFlightmodelControls_mwing20_fla1def              string = "sim/flightmodel/controls/mwing20_fla1def"              // float, writable
+	SimFlightmodelControls_mwing20_fla2def              string = "sim/flightmodel/controls/mwing20_fla2def"              // float, writable
+	SimFlightmodelControls_mwing20_yawbdef              string = "sim/flightmodel/controls/mwing20_yawbdef"              // float, writable
+	SimFlightmodelControls_mwing20_elv1def              string = "sim/flightmodel/controls/mwing20_elv1def"              // float, writable
+	SimFlightmodelControls_mwing20_elv2def              string = "sim/flightmodel/controls/mwing20_elv2def"              // float, writable
+	SimFlightmodelControls_mwing20_rud1def              string = "sim/flightmodel/controls/mwing20_rud1def"              // float, writable
+	SimFlightmodelControls_mwing20_rud2def              string = "sim/flightmodel/controls/mwing20_rud2def"              // float, writable
+	SimFlightmodelControls_wing1l_retract               string = "sim/flightmodel/controls/wing1l_retract"               // int, writable
+	SimFlightmodelControls_wing1r_retract               string = "sim/flightmodel/controls/wing1r_retract"               // int, writable
+	SimFlightmodelControls_wing2l_retract               string = "sim/flightmodel/controls/wing2l_retract"               // int, writable
+	SimFlightmodelControls_wing2r_retract               string = "sim/flightmodel/controls/wing2r_retract"               // int, writable
+	SimFlightmodelControls_wing3l_retract               string = "sim/flightmodel/controls/wing3l_retract"               // int, writable
+	SimFlightmodelControls_wing3r_retract               string = "sim/flightmodel/controls/wing3r_retract"               // int, writable
+	SimFlightmodelControls_wing4l_retract               string = "sim/flightmodel/controls/wing4l_retract"               // int, writable
+	SimFlightmodelControls_wing4r_retract               string = "sim/flightmodel/controls/wing4r_retract"               // int, writable
+	SimFlightmodelControls_hstab1_retract               string = "sim/flightmodel/controls/hstab1_retract"               // int, writable
+	SimFlightmodelControls_hstab2_retract               string = "sim/flightmodel/controls/hstab2_retract"               // int, writable
+	SimFlightmodelControls_vstab1_retract               string = "sim/flightmodel/controls/vstab1_retract"               // int, writable
+	SimFlightmodelControls_vstab2_retract               string = "sim/flightmodel/controls/vstab2_retract"               // int, writable
+	SimFlightmodelControls_mwing01_retract              string = "sim/flightmodel/controls/mwing01_retract"              // int, writable
+	SimFlightmodelControls_mwing02_retract              string = "sim/flightmodel/controls/mwing02_retract"              // int, writable
+	SimFlightmodelControls_mwing03_retract              string = "sim/flightmodel/controls/mwing03_retract"              // int, writable
+	SimFlightmodelControls_mwing04_retract              string = "sim/flightmodel/controls/mwing04_retract"              // int, writable
+	SimFlightmodelControls_mwing05_retract              string = "sim/flightmodel/controls/mwing05_retract"              // int, writable
+	SimFlightmodelControls_mwing06_retract              string = "sim/flightmodel/controls/mwing06_retract"              // int, writable
+	SimFlightmodelControls_mwing07_retract              string = "sim/flightmodel/controls/mwing07_retract"              // int, writable
+	SimFlightmodelControls_mwing08_retract              string = "sim/flightmodel/controls/mwing08_retract"              // int, writable
+	SimFlightmodelControls_mwing09_retract              string = "sim/flightmodel/controls/mwing09_retract"              // int, writable
+	SimFlightmodelControls_mwing10_retract              string = "sim/flightmodel/controls/mwing10_retract"              // int, writable
+	SimFlightmodelControls_mwing11_retract              string = "sim/flightmodel/controls/mwing11_retract"              // int, writable
+	SimFlightmodelControls_mwing12_retract              string = "sim/flightmodel/controls/mwing12_retract"              // int, writable
+	SimFlightmodelControls_mwing13_retract              string = "sim/flightmodel/controls/mwing13_retract"              // int, writable
+	SimFlightmodelControls_mwing14_retract              string = "sim/flightmodel/controls/mwing14_retract"              // int, writable
+	SimFlightmodelControls_mwing15_retract              string = "sim/flightmodel/controls/mwing15_retract"              // int, writable
+	SimFlightmodelControls_mwing16_retract              string = "sim/flightmodel/controls/mwing16_retract"              // int, writable
+	SimFlightmodelControls_mwing17_retract              string = "sim/flightmodel/controls/mwing17_retract"              // int, writable
+	SimFlightmodelControls_mwing18_retract              string = "sim/flightmodel/controls/mwing18_retract"              // int, writable
+	SimFlightmodelControls_mwing19_retract              string = "sim/flightmodel/controls/mwing19_retract"              // int, writable
+	SimFlightmodelControls_mwing20_retract              string = "sim/flightmodel/controls/mwing20_retract"              // int, writable
+	SimFlightmodelControls_wing1l_retract_now           string = "sim/flightmodel/controls/wing1l_retract_now"           // float, writable
+	SimFlightmodelControls_wing1r_retract_now           string = "sim/flightmodel/controls/wing1r_retract_now"           // float, writable
+	SimFlightmodelControls_wing2l_retract_now           string = "sim/flightmodel/controls/wing2l_retract_now"           // float, writable
+	SimFlightmodelControls_wing2r_retract_now           string = "sim/flightmodel/controls/wing2r_retract_now"           // float, writable
+	SimFlightmodelControls_wing3l_retract_now           string = "sim/flightmodel/controls/wing3l_retract_now"           // float, writable
+	SimFlightmodelControls_wing3r_retract_now           string = "sim/flightmodel/controls/wing3r_retract_now"           // float, writable
+	SimFlightmodelControls_wing4l_retract_now           string = "sim/flightmodel/controls/wing4l_retract_now"           // float, writable
+	SimFlightmodelControls_wing4r_retract_now           string = "sim/flightmodel/controls/wing4r_retract_now"           // float, writable
+	SimFlightmodelControls_hstab1_retract_now           string = "sim/flightmodel/controls/hstab1_retract_now"           // float, writable
+	SimFlightmodelControls_hstab2_retract_now           string = "sim/flightmodel/controls/hstab2_retract_now"           // float, writable
+	SimFlightmodelControls_vstab1_retract_now           string = "sim/flightmodel/controls/vstab1_retract_now"           // float, writable
+	SimFlightmodelControls_vstab2_retract_now           string = "sim/flightmodel/controls/vstab2_retract_now"           // float, writable
+	SimFlightmodelControls_mwing01_retract_now          string = "sim/flightmodel/controls/mwing01_retract_now"          // float, writable
+	SimFlightmodelControls_mwing02_retract_now          string = "sim/flightmodel/controls/mwing02_retract_now"          // float, writable
+	SimFlightmodelControls_mwing03_retract_now          string = "sim/flightmodel/controls/mwing03_retract_now"          // float, writable
+	SimFlightmodelControls_mwing04_retract_now          string = "sim/flightmodel/controls/mwing04_retract_now"          // float, writable
+	SimFlightmodelControls_mwing05_retract_now          string = "sim/flightmodel/controls/mwing05_retract_now"          // float, writable
+	SimFlightmodelControls_mwing06_retract_now          string = "sim/flightmodel/controls/mwing06_retract_now"          // float, writable
+	SimFlightmodelControls_mwing07_retract_now          string = "sim/flightmodel/controls/mwing07_retract_now"          // float, writable
+	SimFlightmodelControls_mwing08_retract_now          string = "sim/flightmodel/controls/mwing08_retract_now"          // float, writable
+	SimFlightmodelControls_mwing09_retract_now          string = "sim/flightmodel/controls/mwing09_retract_now"          // float, writable
+	SimFlightmodelControls_mwing10_retract_now          string = "sim/flightmodel/controls/mwing10_retract_now"          // float, writable
+	SimFlightmodelControls_mwing11_retract_now          string = "sim/flightmodel/controls/mwing11_retract_now"          // float, writable
+	SimFlightmodelControls_mwing12_retract_now          string = "sim/flightmodel/controls/mwing12_retract_now"          // float, writable
+	SimFlightmodelControls_mwing13_retract_now          string = "sim/flightmodel/controls/mwing13_retract_now"          // float, writable
+	SimFlightmodelControls_mwing14_retract_now          string = "sim/flightmodel/controls/mwing14_retract_now"          // float, writable
+	SimFlightmodelControls_mwing15_retract_now          string = "sim/flightmodel/controls/mwing15_retract_now"          // float, writable
+	SimFlightmodelControls_mwing16_retract_now          string = "sim/flightmodel/controls/mwing16_retract_now"          // float, writable
+	SimFlightmodelControls_mwing17_retract_now          string = "sim/flightmodel/controls/mwing17_retract_now"          // float, writable
+	SimFlightmodelControls_mwing18_retract_now          string = "sim/flightmodel/controls/mwing18_retract_now"          // float, writable
+	SimFlightmodelControls_mwing19_retract_now          string = "sim/flightmodel/controls/mwing19_retract_now"          // float, writable
+	SimFlightmodelControls_mwing20_retract_now          string = "sim/flightmodel/controls/mwing20_retract_now"          // float, writable
+	SimFlightmodelControls_wing1l_retract_max           string = "sim/flightmodel/controls/wing1l_retract_max"           // float, writable
+	SimFlightmodelControls_wing1r_retract_max           string = "sim/flightmodel/controls/wing1r_retract_max"           // float, writable
+	SimFlightmodelControls_wing2l_retract_max           string = "sim/flightmodel/controls/wing2l_retract_max"           // float, writable
+	SimFlightmodelControls_wing2r_retract_max           string = "sim/flightmodel/controls/wing2r_retract_max"           // float, writable
+	SimFlightmodelControls_wing3l_retract_max           string = "sim/flightmodel/controls/wing3l_retract_max"           // float, writable
+	SimFlightmodelControls_wing3r_retract_max           string = "sim/flightmodel/controls/wing3r_retract_max"           // float, writable
+	SimFlightmodelControls_wing4l_retract_max           string = "sim/flightmodel/controls/wing4l_retract_max"           // float, writable
+	SimFlightmodelControls_wing4r_retract_max           string = "sim/flightmodel/controls/wing4r_retract_max"           // float, writable
+	SimFlightmodelControls_hstab1_retract_max           string = "sim/flightmodel/controls/hstab1_retract_max"           // float, writable
+	SimFlightmodelControls_hstab2_retract_max           string = "sim/flightmodel/controls/hstab2_retract_max"           // float, writable
+	SimFlightmodelControls_vstab1_retract_max           string = "sim/flightmodel/controls/vstab1_retract_max"           // float, writable
+	SimFlightmodelControls_vstab2_retract_max           string = "sim/flightmodel/controls/vstab2_retract_max"           // float, writable
+	SimFlightmodelControls_mwing01_retract_max          string = "sim/flightmodel/controls/mwing01_retract_max"          // float, writable
+	SimFlightmodelControls_mwing02_retract_max          string = "sim/flightmodel/controls/mwing02_retract_max"          // float, writable
+	SimFlightmodelControls_mwing03_retract_max          string = "sim/flightmodel/controls/mwing03_retract_max"          // float, writable
+	SimFlightmodelControls_mwing04_retract_max          string = "sim/flightmodel/controls/mwing04_retract_max"          // float, writable
+	SimFlightmodelControls_mwing05_retract_max          string = "sim/flightmodel/controls/mwing05_retract_max"          // float, writable
+	SimFlightmodelControls_mwing06_retract_max          string = "sim/flightmodel/controls/mwing06_retract_max"          // float, writable
+	SimFlightmodelControls_mwing07_retract_max          string = "sim/flightmodel/controls/mwing07_retract_max"          // float, writable
+	SimFlightmodelControls_mwing08_retract_max          string = "sim/flightmodel/controls/mwing08_retract_max"          // float, writable
+	SimFlightmodelControls_mwing09_retract_max          string = "sim/flightmodel/controls/mwing09_retract_max"          // float, writable
+	SimFlightmodelControls_mwing10_retract_max          string = "sim/flightmodel/controls/mwing10_retract_max"          // float, writable
+	SimFlightmodelControls_mwing11_retract_max          string = "sim/flightmodel/controls/mwing11_retract_max"          // float, writable
+	SimFlightmodelControls_mwing12_retract_max          string = "sim/flightmodel/controls/mwing12_retract_max"          // float, writable
+	SimFlightmodelControls_mwing13_retract_max          string = "sim/flightmodel/controls/mwing13_retract_max"          // float, writable
+	SimFlightmodelControls_mwing14_retract_max          string = "sim/flightmodel/controls/mwing14_retract_max"          // float, writable
+	SimFlightmodelControls_mwing15_retract_max          string = "sim/flightmodel/controls/mwing15_retract_max"          // float, writable
+	SimFlightmodelControls_mwing16_retract_max          string = "sim/flightmodel/controls/mwing16_retract_max"          // float, writable
+	SimFlightmodelControls_mwing17_retract_max          string = "sim/flightmodel/controls/mwing17_retract_max"          // float, writable
+	SimFlightmodelControls_mwing18_retract_max          string = "sim/flightmodel/controls/mwing18_retract_max"          // float, writable
+	SimFlightmodelControls_mwing19_retract_max          string = "sim/flightmodel/controls/mwing19_retract_max"          // float, writable
+	SimFlightmodelControls_mwing20_retract_max          string = "sim/flightmodel/controls/mwing20_retract_max"          // float, writable
+	SimFlightmodelControls_wing1l_elv1def               string = "sim/flightmodel/controls/wing1l_elv1def"               // float, writable
+	SimFlightmodelControls_wing1l_elv2def               string = "sim/flightmodel/controls/wing1l_elv2def"               // float, writable
+	SimFlightmodelControls_wing1r_elv1def               string = "sim/flightmodel/controls/wing1r_elv1def"               // float, writable
+	SimFlightmodelControls_wing1r_elv2def               string = "sim/flightmodel/controls/wing1r_elv2def"               // float, writable
+	SimFlightmodelControls_wing2l_elv1def               string = "sim/flightmodel/controls/wing2l_elv1def"               // float, writable
+	SimFlightmodelControls_wing2l_elv2def               string = "sim/flightmodel/controls/wing2l_elv2def"               // float, writable
+	SimFlightmodelControls_wing2r_elv1def               string = "sim/flightmodel/controls/wing2r_elv1def"               // float, writable
+	SimFlightmodelControls_wing2r_elv2def               string = "sim/flightmodel/controls/wing2r_elv2def"               // float, writable
+	SimFlightmodelControls_wing3l_elv1def               string = "sim/flightmodel/controls/wing3l_elv1def"               // float, writable
+	SimFlightmodelControls_wing3l_elv2def               string = "sim/flightmodel/controls/wing3l_elv2def"               // float, writable
+	SimFlightmodelControls_wing3r_elv1def               string = "sim/flightmodel/controls/wing3r_elv1def"               // float, writable
+	SimFlightmodelControls_wing3r_elv2def               string = "sim/flightmodel/controls/wing3r_elv2def"               // float, writable
+	SimFlightmodelControls_wing4l_elv1def               string = "sim/flightmodel/controls/wing4l_elv1def"               // float, writable
+	SimFlightmodelControls_wing4l_elv2def               string = "sim/flightmodel/controls/wing4l_elv2def"               // float, writable
+	SimFlightmodelControls_wing4r_elv1def               string = "sim/flightmodel/controls/wing4r_elv1def"               // float, writable
+	SimFlightmodelControls_wing4r_elv2def               string = "sim/flightmodel/controls/wing4r_elv2def"               // float, writable
+	SimFlightmodelCyclic_cyclic_ailn_blad_alph          string = "sim/flightmodel/cyclic/cyclic_ailn_blad_alph"          // float_array
+	SimFlightmodelCyclic_cyclic_ailn_disc_tilt          string = "sim/flightmodel/cyclic/cyclic_ailn_disc_tilt"          // float_array
+	SimFlightmodelCyclic_cyclic_elev_blad_alph          string = "sim/flightmodel/cyclic/cyclic_elev_blad_alph"          // float_array
+	SimFlightmodelCyclic_cyclic_elev_disc_tilt          string = "sim/flightmodel/cyclic/cyclic_elev_disc_tilt"          // float_array
+	SimFlightmodelCyclic_cyclic_elev_command            string = "sim/flightmodel/cyclic/cyclic_elev_command"            // float_array
+	SimFlightmodelCyclic_cyclic_ailn_command            string = "sim/flightmodel/cyclic/cyclic_ailn_command"            // float_array
+	SimFlightmodelDrogue_drogue_lat_deg                 string = "sim/flightmodel/drogue/drogue_lat_deg"                 // float, writable
+	SimFlightmodelDrogue_drogue_vrt_deg                 string = "sim/flightmodel/drogue/drogue_vrt_deg"                 // float, writable
+	SimFlightmodelDrogue_drogue_vx                      string = "sim/flightmodel/drogue/drogue_vx"                      // double
+	SimFlightmodelDrogue_drogue_vy                      string = "sim/flightmodel/drogue/drogue_vy"                      // double
+	SimFlightmodelDrogue_drogue_vz                      string = "sim/flightmodel/drogue/drogue_vz"                      // double
+	SimFlightmodelDrogue_drogue_x                       string = "sim/flightmodel/drogue/drogue_x"                       // double
+	SimFlightmodelDrogue_drogue_y                       string = "sim/flightmodel/drogue/drogue_y"                       // double
+	SimFlightmodelDrogue_drogue_z                       string = "sim/flightmodel/drogue/drogue_z"                       // double
+	SimFlightmodelEngine_ENGN_N2                        string = "sim/flightmodel/engine/ENGN_N2_"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_EGT                       string = "sim/flightmodel/engine/ENGN_EGT"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_ITT                       string = "sim/flightmodel/engine/ENGN_ITT"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_CHT                       string = "sim/flightmodel/engine/ENGN_CHT"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_EGT_c                     string = "sim/flightmodel/engine/ENGN_EGT_c"                     // float_array, writable
+	SimFlightmodelEngine_ENGN_ITT_c                     string = "sim/flightmodel/engine/ENGN_ITT_c"                     // float_array, writable
+	SimFlightmodelEngine_ENGN_CHT_c                     string = "sim/flightmodel/engine/ENGN_CHT_c"                     // float_array, writable
+	SimFlightmodelEngine_ENGN_EGT_CYL_c                 string = "sim/flightmodel/engine/ENGN_EGT_CYL_c"                 // float_array, writable
+	SimFlightmodelEngine_ENGN_CHT_CYL_c                 string = "sim/flightmodel/engine/ENGN_CHT_CYL_c"                 // float_array, writable
+	SimFlightmodelEngine_ENGN_bat_amp                   string = "sim/flightmodel/engine/ENGN_bat_amp"                   // float_array, writable
+	SimFlightmodelEngine_ENGN_bat_volt                  string = "sim/flightmodel/engine/ENGN_bat_volt"                  // float_array, writable
+	SimFlightmodelEngine_ENGN_cowl                      string = "sim/flightmodel/engine/ENGN_cowl"                      // float_array, writable
+	SimFlightmodelEngine_ENGN_EPR                       string = "sim/flightmodel/engine/ENGN_EPR"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_FF                        string = "sim/flightmodel/engine/ENGN_FF_"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_gen_amp                   string = "sim/flightmodel/engine/ENGN_gen_amp"                   // float_array, writable
+	SimFlightmodelEngine_ENGN_heat                      string = "sim/flightmodel/engine/ENGN_heat"                      // float_array, writable
+	SimFlightmodelEngine_ENGN_mixt                      string = "sim/flightmodel/engine/ENGN_mixt"                      // float_array, writable
+	SimFlightmodelEngine_ENGN_MPR                       string = "sim/flightmodel/engine/ENGN_MPR"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_N1                        string = "sim/flightmodel/engine/ENGN_N1_"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_oil_press_psi             string = "sim/flightmodel/engine/ENGN_oil_press_psi"             // float_array, writable
+	SimFlightmodelEngine_ENGN_fuel_press_psi            string = "sim/flightmodel/engine/ENGN_fuel_press_psi"            // float_array, writable
+	SimFlightmodelEngine_ENGN_oil_temp_c                string = "sim/flightmodel/engine/ENGN_oil_temp_c"                // float_array, writable
+	SimFlightmodelEngine_ENGN_oil_temp                  string = "sim/flightmodel/engine/ENGN_oil_temp"                  // float_array, writable
+	SimFlightmodelEngine_ENGN_oil_press                 string = "sim/flightmodel/engine/ENGN_oil_press"                 // float_array, writable
+	SimFlightmodelEngine_ENGN_power                     string = "sim/flightmodel/engine/ENGN_power"                     // float_array, writable
+	SimFlightmodelEngine_ENGN_prop                      string = "sim/flightmodel/engine/ENGN_prop"                      // float_array, writable
+	SimFlightmodelEngine_ENGN_sigma                     string = "sim/flightmodel/engine/ENGN_sigma"                     // float_array
+	SimFlightmodelEngine_ENGN_assumed_temp              string = "sim/flightmodel/engine/ENGN_assumed_temp"              // float_array, writable
+	SimFlightmodelEngine_ENGN_fadec_pow_req             string = "sim/flightmodel/engine/ENGN_fadec_pow_req"             // int_array, writable
+	SimFlightmodelEngine_ENGN_fadec_paramter            string = "sim/flightmodel/engine/ENGN_fadec_paramter"            // int
+	SimFlightmodelEngine_ENGN_fadec_targets_ISA         string = "sim/flightmodel/engine/ENGN_fadec_targets_ISA"         // float_array, writable
+	SimFlightmodelEngine_ENGN_fadec_targets             string = "sim/flightmodel/engine/ENGN_fadec_targets"             // float_array, writable
+	SimFlightmodelEngine_ENGN_thro                      string = "sim/flightmodel/engine/ENGN_thro"                      // float_array, writable
+	SimFlightmodelEngine_ENGN_thro_use                  string = "sim/flightmodel/engine/ENGN_thro_use"                  // float_array, writable
+	SimFlightmodelEngine_ENGN_thro_override             string = "sim/flightmodel/engine/ENGN_thro_override"             // float, writable
+	SimFlightmodelEngine_ENGN_TRQ                       string = "sim/flightmodel/engine/ENGN_TRQ"                       // float_array, writable
+	SimFlightmodelEngine_ENGN_running                   string = "sim/flightmodel/engine/ENGN_running"                   // int_array, writable
+	SimFlightmodelEngine_ENGN_burning                   string = "sim/flightmodel/engine/ENGN_burning"                   // int_array, writable
+	SimFlightmodelEngine_ENGN_propmode                  string = "sim/flightmodel/engine/ENGN_propmode"                  // int_array, writable
+	SimFlightmodelEngine_ENGN_burnrat                   string = "sim/flightmodel/engine/ENGN_burnrat"                   // float_array, writable
+	SimFlightmodelEngine_ENGN_oil_quan                  string = "sim/flightmodel/engine/ENGN_oil_quan"                  // float_array, writable
+	SimFlightmodelEngine_ENGN_oil_lube_rat              string = "sim/flightmodel/engine/ENGN_oil_lube_rat"              // float_array, writable
+	SimFlightmodelEngine_ENGN_crbice                    string = "sim/flightmodel/engine/ENGN_crbice"                    // float_array, writable
+	SimFlightmodelEngine_ENGN_tacrad                    string = "sim/flightmodel/engine/ENGN_tacrad"                    // float_array, writable
+	SimFlightmodelEngine_POINT_pitch_deg                string = "sim/flightmodel/engine/POINT_pitch_deg"                // float_array, writable
+	SimFlightmodelEngine_POINT_prop_eff                 string = "sim/flightmodel/engine/POINT_prop_eff"                 // float_array, writable
+	SimFlightmodelEngine_POINT_tacrad                   string = "sim/flightmodel/engine/POINT_tacrad"                   // float_array, writable
+	SimFlightmodelEngine_POINT_thrust                   string = "sim/flightmodel/engine/POINT_thrust"                   // float_array, writable
+	SimFlightmodelEngine_POINT_drag_TRQ                 string = "sim/flightmodel/engine/POINT_drag_TRQ"                 // float_array, writable
+	SimFlightmodelEngine_POINT_driv_TRQ                 string = "sim/flightmodel/engine/POINT_driv_TRQ"                 // float_array
+	SimFlightmodelEngine_ENGN_driv_TRQ                  string = "sim/flightmodel/engine/ENGN_driv_TRQ"                  // float_array
+	SimFlightmodelEngine_POINT_max_TRQ                  string = "sim/flightmodel/engine/POINT_max_TRQ"                  // float_array
+	SimFlightmodelEngine_POINT_cone_rad                 string = "sim/flightmodel/engine/POINT_cone_rad"                 // float_array, writable
+	SimFlightmodelEngine_POINT_XYZ                      string = "sim/flightmodel/engine/POINT_XYZ"                      // float_array
+	SimFlightmodelEngine_POINT_pitch_deg_use            string = "sim/flightmodel/engine/POINT_pitch_deg_use"            // float_array, writable
+	SimFlightmodelEngine_POINT_prop_ang_deg             string = "sim/flightmodel/engine/POINT_prop_ang_deg"             // float_array, writable
+	SimFlightmodelEngine_burner_enabled                 string = "sim/flightmodel/engine/burner_enabled"                 // int, writable
+	SimFlightmodelEngine_burner_enabled_per_engine      string = "sim/flightmodel/engine/burner_enabled_per_engine"      // int_array, writable
+	SimFlightmodelEngine_burner_level                   string = "sim/flightmodel/engine/burner_level"                   // int, writable
+	SimFlightmodelEngine_apr_mode                       string = "sim/flightmodel/engine/apr_mode"                       // int, writable
+	SimFlightmodelEngine_descent_speed_ratio            string = "sim/flightmodel/engine/descent_speed_ratio"            // float_array, writable
+	SimFlightmodelEngine_vortex_ring_state              string = "sim/flightmodel/engine/vortex_ring_state"              // float_array
+	SimFlightmodelFailures_frm_ice                      string = "sim/flightmodel/failures/frm_ice"                      // float, writable
+	SimFlightmodelFailures_frm_ice2                     string = "sim/flightmodel/failures/frm_ice2"                     // float, writable
+	SimFlightmodelFailures_tail_ice                     string = "sim/flightmodel/failures/tail_ice"                     // float, writable
+	SimFlightmodelFailures_tail_ice2                    string = "sim/flightmodel/failures/tail_ice2"                    // float, writable
+	SimFlightmodelFailures_pitot_ice                    string = "sim/flightmodel/failures/pitot_ice"                    // float, writable
+	SimFlightmodelFailures_pitot_ice2                   string = "sim/flightmodel/failures/pitot_ice2"                   // float, writable
+	SimFlightmodelFailures_pitot_ice_stby               string = "sim/flightmodel/failures/pitot_ice_stby"               // float, writable
+	SimFlightmodelFailures_prop_ice                     string = "sim/flightmodel/failures/prop_ice"                     // float, writable
+	SimFlightmodelFailures_stat_ice                     string = "sim/flightmodel/failures/stat_ice"                     // float, writable
+	SimFlightmodelFailures_stat_ice2                    string = "sim/flightmodel/failures/stat_ice2"                    // float, writable
+	SimFlightmodelFailures_stat_ice_stby                string = "sim/flightmodel/failures/stat_ice_stby"                // float, writable
+	SimFlightmodelFailures_inlet_ice                    string = "sim/flightmodel/failures/inlet_ice"                    // float, writable
+	SimFlightmodelFailures_prop_ice_per_engine          string = "sim/flightmodel/failures/prop_ice_per_engine"          // float_array, writable
+	SimFlightmodelFailures_inlet_ice_per_engine         string = "sim/flightmodel/failures/inlet_ice_per_engine"         // float_array, writable
+	SimFlightmodelFailures_window_ice                   string = "sim/flightmodel/failures/window_ice"                   // float, writable
+	SimFlightmodelFailures_window_ice_per_window        string = "sim/flightmodel/failures/window_ice_per_window"        // float_array, writable
+	SimFlightmodelFailures_window_ice_unheated          string = "sim/flightmodel/failures/window_ice_unheated"          // float, writable
+	SimFlightmodelFailures_window_ice_added_delta       string = "sim/flightmodel/failures/window_ice_added_delta"       // float, writable
+	SimFlightmodelFailures_ice_delta                    string = "sim/flightmodel/failures/ice_delta"                    // float
+	SimFlightmodelFailures_aoa_ice                      string = "sim/flightmodel/failures/aoa_ice"                      // float, writable
+	SimFlightmodelFailures_aoa_ice2                     string = "sim/flightmodel/failures/aoa_ice2"                     // float, writable
+	SimFlightmodelFailures_stallwarning                 string = "sim/flightmodel/failures/stallwarning"                 // int, writable
+	SimFlightmodelFailures_over_g                       string = "sim/flightmodel/failures/over_g"                       // int, writable
+	SimFlightmodelFailures_over_vne                     string = "sim/flightmodel/failures/over_vne"                     // int, writable
+	SimFlightmodelFailures_over_vfe                     string = "sim/flightmodel/failures/over_vfe"                     // int, writable
+	SimFlightmodelFailures_over_vle                     string = "sim/flightmodel/failures/over_vle"                     // int, writable
+	SimFlightmodelFailures_onground_any                 string = "sim/flightmodel/failures/onground_any"                 // int, writable
+	SimFlightmodelFailures_onground_all                 string = "sim/flightmodel/failures/onground_all"                 // int, writable
+	SimFlightmodelFailures_smoking                      string = "sim/flightmodel/failures/smoking"                      // int, writable
+	SimFlightmodelFailures_lo_rotor_warning             string = "sim/flightmodel/failures/lo_rotor_warning"             // int, writable
+	SimFlightmodelForces_fside_aero                     string = "sim/flightmodel/forces/fside_aero"                     // float, writable
+	SimFlightmodelForces_fnrml_aero                     string = "sim/flightmodel/forces/fnrml_aero"                     // float, writable
+	SimFlightmodelForces_faxil_aero                     string = "sim/flightmodel/forces/faxil_aero"                     // float, writable
+	SimFlightmodelForces_fside_prop                     string = "sim/flightmodel/forces/fside_prop"                     // float, writable
+	SimFlightmodelForces_fnrml_prop                     string = "sim/flightmodel/forces/fnrml_prop"                     // float, writable
+	SimFlightmodelForces_faxil_prop                     string = "sim/flightmodel/forces/faxil_prop"                     // float, writable
+	SimFlightmodelForces_fside_gear                     string = "sim/flightmodel/forces/fside_gear"                     // float, writable
+	SimFlightmodelForces_fnrml_gear                     string = "sim/flightmodel/forces/fnrml_gear"                     // float, writable
+	SimFlightmodelForces_faxil_gear                     string = "sim/flightmodel/forces/faxil_gear"                     // float, writable
+	SimFlightmodelForces_fside_total                    string = "sim/flightmodel/forces/fside_total"                    // float, writable
+	SimFlightmodelForces_fnrml_total                    string = "sim/flightmodel/forces/fnrml_total"                    // float, writable
+	SimFlightmodelForces_faxil_total                    string = "sim/flightmodel/forces/faxil_total"                    // float, writable
+	SimFlightmodelForces_L_aero                         string = "sim/flightmodel/forces/L_aero"                         // float, writable
+	SimFlightmodelForces_M_aero                         string = "sim/flightmodel/forces/M_aero"                         // float, writable
+	SimFlightmodelForces_N_aero                         string = "sim/flightmodel/forces/N_aero"                         // float, writable
+	SimFlightmodelForces_L_prop                         string = "sim/flightmodel/forces/L_prop"                         // float, writable
+	SimFlightmodelForces_M_prop                         string = "sim/flightmodel/forces/M_prop"                         // float, writable
+	SimFlightmodelForces_N_prop                         string = "sim/flightmodel/forces/N_prop"                         // float, writable
+	SimFlightmodelForces_L_gear                         string = "sim/flightmodel/forces/L_gear"                         // float, writable
+	SimFlightmodelForces_M_gear                         string = "sim/flightmodel/forces/M_gear"                         // float, writable
+	SimFlightmodelForces_M_gear_integral                string = "sim/flightmodel/forces/M_gear_integral"                // float
+	SimFlightmodelForces_N_gear                         string = "sim/flightmodel/forces/N_gear"                         // float, writable
+	SimFlightmodelForces_L_mass                         string = "sim/flightmodel/forces/L_mass"                         // float
+	SimFlightmodelForces_M_mass                         string = "sim/flightmodel/forces/M_mass"                         // float
+	SimFlightmodelForces_N_mass                         string = "sim/flightmodel/forces/N_mass"                         // float
+	SimFlightmodelForces_L_total                        string = "sim/flightmodel/forces/L_total"                        // float, writable
+	SimFlightmodelForces_M_total                        string = "sim/flightmodel/forces/M_total"                        // float, writable
+	SimFlightmodelForces_N_total                        string = "sim/flightmodel/forces/N_total"                        // float, writable
+	SimFlightmodelForces_fside_plug_acf                 string = "sim/flightmodel/forces/fside_plug_acf"                 // float, writable
+	SimFlightmodelForces_fnrml_plug_acf                 string = "sim/flightmodel/forces/fnrml_plug_acf"                 // float, writable
+	SimFlightmodelForces_faxil_plug_acf                 string = "sim/flightmodel/forces/faxil_plug_acf"                 // float, writable
+	SimFlightmodelForces_L_plug_acf                     string = "sim/flightmodel/forces/L_plug_acf"                     // float, writable
+	SimFlightmodelForces_M_plug_acf                     string = "sim/flightmodel/forces/M_plug_acf"                     // float, writable
+	SimFlightmodelForces_N_plug_acf                     string = "sim/flightmodel/forces/N_plug_acf"                     // float, writable
+	SimFlightmodelForces_g_nrml                         string = "sim/flightmodel/forces/g_nrml"                         // float
+	SimFlightmodelForces_g_axil                         string = "sim/flightmodel/forces/g_axil"                         // float
+	SimFlightmodelForces_g_side                         string = "sim/flightmodel/forces/g_side"                         // float
+	SimFlightmodelForces_vx_air_on_acf                  string = "sim/flightmodel/forces/vx_air_on_acf"                  // float
+	SimFlightmodelForces_vy_air_on_acf                  string = "sim/flightmodel/forces/vy_air_on_acf"                  // float
+	SimFlightmodelForces_vz_air_on_acf                  string = "sim/flightmodel/forces/vz_air_on_acf"                  // float
+	SimFlightmodelForces_vx_acf_axis                    string = "sim/flightmodel/forces/vx_acf_axis"                    // float
+	SimFlightmodelForces_vy_acf_axis                    string = "sim/flightmodel/forces/vy_acf_axis"                    // float
+	SimFlightmodelForces_vz_acf_axis                    string = "sim/flightmodel/forces/vz_acf_axis"                    // float
+	SimFlightmodelForces_Q_rotor_rad                    string = "sim/flightmodel/forces/Q_rotor_rad"                    // float_array, writable
+	SimFlightmodelForces_R_rotor_rad                    string = "sim/flightmodel/forces/R_rotor_rad"                    // float_array, writable
+	SimFlightmodelForces_lift_path_axis                 string = "sim/flightmodel/forces/lift_path_axis"                 // float, writable
+	SimFlightmodelForces_drag_path_axis                 string = "sim/flightmodel/forces/drag_path_axis"                 // float, writable
+	SimFlightmodelForces_side_path_axis                 string = "sim/flightmodel/forces/side_path_axis"                 // float, writable
+	SimFlightmodelForces_wing_ground_scrape_v           string = "sim/flightmodel/forces/wing_ground_scrape_v"           // float_array
+	SimFlightmodelForces_wing_ground_scrape_f           string = "sim/flightmodel/forces/wing_ground_scrape_f"           // float_array
+	SimFlightmodelForces_wake_turbulence_effect         string = "sim/flightmodel/forces/wake_turbulence_effect"         // float, writable
+	SimFlightmodelJetwash_DVinc                         string = "sim/flightmodel/jetwash/DVinc"                         // float_array
+	SimFlightmodelJetwash_ringDVinc                     string = "sim/flightmodel/jetwash/ringDVinc"                     // float_array
+	SimFlightmodelMisc_jett_size                        string = "sim/flightmodel/misc/jett_size"                        // float, writable
+	SimFlightmodelMisc_jett_len                         string = "sim/flightmodel/misc/jett_len"                         // float, writable
+	SimFlightmodelMisc_sling_hook_force                 string = "sim/flightmodel/misc/sling_hook_force"                 // float
+	SimFlightmodelMisc_g_total                          string = "sim/flightmodel/misc/g_total"                          // float
+	SimFlightmodelMisc_nosewheel_speed                  string = "sim/flightmodel/misc/nosewheel_speed"                  // float
+	SimFlightmodelMisc_wing_tilt_ptch                   string = "sim/flightmodel/misc/wing_tilt_ptch"                   // float, writable
+	SimFlightmodelMisc_wing_tilt_roll                   string = "sim/flightmodel/misc/wing_tilt_roll"                   // float, writable
+	SimFlightmodelMisc_jato_left                        string = "sim/flightmodel/misc/jato_left"                        // float, writable
+	SimFlightmodelMisc_displace_rat                     string = "sim/flightmodel/misc/displace_rat"                     // float, writable
+	SimFlightmodelMisc_h_ind                            string = "sim/flightmodel/misc/h_ind"                            // float, writable
+	SimFlightmodelMisc_h_ind2                           string = "sim/flightmodel/misc/h_ind2"                           // float, writable
+	SimFlightmodelMisc_h_ind_copilot                    string = "sim/flightmodel/misc/h_ind_copilot"                    // float, writable
+	SimFlightmodelMisc_h_ind_copilot2                   string = "sim/flightmodel/misc/h_ind_copilot2"                   // float, writable
+	SimFlightmodelMisc_machno                           string = "sim/flightmodel/misc/machno"                           // float
+	SimFlightmodelMisc_Qstatic                          string = "sim/flightmodel/misc/Qstatic"                          // float
+	SimFlightmodelMisc_turnrate_roll                    string = "sim/flightmodel/misc/turnrate_roll"                    // float, writable
+	SimFlightmodelMisc_turnrate_roll2                   string = "sim/flightmodel/misc/turnrate_roll2"                   // float, writable
+	SimFlightmodelMisc_turnrate_noroll                  string = "sim/flightmodel/misc/turnrate_noroll"                  // float, writable
+	SimFlightmodelMisc_turnrate_noroll2                 string = "sim/flightmodel/misc/turnrate_noroll2"                 // float, writable
+	SimFlightmodelMisc_slip                             string = "sim/flightmodel/misc/slip"                             // float, writable
+	SimFlightmodelMisc_rocket_mode                      string = "sim/flightmodel/misc/rocket_mode"                      // int, writable
+	SimFlightmodelMisc_rocket_timeout                   string = "sim/flightmodel/misc/rocket_timeout"                   // float, writable
+	SimFlightmodelMisc_prop_ospeed_test_timeout         string = "sim/flightmodel/misc/prop_ospeed_test_timeout"         // float, writable
+	SimFlightmodelMisc_blown_flap_engage_rat            string = "sim/flightmodel/misc/blown_flap_engage_rat"            // float, writable
+	SimFlightmodelMisc_lift_fan_total_power             string = "sim/flightmodel/misc/lift_fan_total_power"             // float, writable
+	SimFlightmodelMisc_cgz_ref_to_default               string = "sim/flightmodel/misc/cgz_ref_to_default"               // float, writable
+	SimFlightmodelMisc_cgx_ref_to_default               string = "sim/flightmodel/misc/cgx_ref_to_default"               // float, writable
+	SimFlightmodelMisc_Q_centroid_MULT                  string = "sim/flightmodel/misc/Q_centroid_MULT"                  // float_array
+	SimFlightmodelMisc_cl_overall                       string = "sim/flightmodel/misc/cl_overall"                       // float, writable
+	SimFlightmodelMisc_cd_overall                       string = "sim/flightmodel/misc/cd_overall"                       // float, writable
+	SimFlightmodelMisc_l_o_d                            string = "sim/flightmodel/misc/l_o_d"                            // float, writable
+	SimFlightmodelMisc_act_frc_ptch_lb                  string = "sim/flightmodel/misc/act_frc_ptch_lb"                  // float, writable
+	SimFlightmodelMisc_act_frc_roll_lb                  string = "sim/flightmodel/misc/act_frc_roll_lb"                  // float, writable
+	SimFlightmodelMisc_act_frc_hdng_lb                  string = "sim/flightmodel/misc/act_frc_hdng_lb"                  // float, writable
+	SimFlightmodelMisc_act_frc_lbrk_lb                  string = "sim/flightmodel/misc/act_frc_lbrk_lb"                  // float, writable
+	SimFlightmodelMisc_act_frc_rbrk_lb                  string = "sim/flightmodel/misc/act_frc_rbrk_lb"                  // float, writable
+	SimFlightmodelMisc_slung_load_x                     string = "sim/flightmodel/misc/slung_load_x"                     // float
+	SimFlightmodelMisc_slung_load_y                     string = "sim/flightmodel/misc/slung_load_y"                     // float
+	SimFlightmodelMisc_slung_load_z                     string = "sim/flightmodel/misc/slung_load_z"                     // float
+	SimFlightmodelMisc_slung_obj_psi                    string = "sim/flightmodel/misc/slung_obj_psi"                    // float
+	SimFlightmodelMisc_slung_obj_the                    string = "sim/flightmodel/misc/slung_obj_the"                    // float
+	SimFlightmodelMisc_slung_obj_phi                    string = "sim/flightmodel/misc/slung_obj_phi"                    // float
+	SimFlightmodelMisc_slung_load_min_xyz               string = "sim/flightmodel/misc/slung_load_min_xyz"               // float_array, writable
+	SimFlightmodelMisc_slung_load_max_xyz               string = "sim/flightmodel/misc/slung_load_max_xyz"               // float_array, writable
+	SimFlightmodelMisc_line_aircraft_xyz                string = "sim/flightmodel/misc/line_aircraft_xyz"                // float_array
+	SimFlightmodelMisc_line_payload_xyz                 string = "sim/flightmodel/misc/line_payload_xyz"                 // float_array
+	SimFlightmodelMisc_line_force_xyz                   string = "sim/flightmodel/misc/line_force_xyz"                   // float_array
+	SimFlightmodelGround_surface_texture_type           string = "sim/flightmodel/ground/surface_texture_type"           // int, writable
+	SimFlightmodelGround_plugin_ground_center           string = "sim/flightmodel/ground/plugin_ground_center"           // float_array, writable
+	SimFlightmodelGround_plugin_ground_slope_normal     string = "sim/flightmodel/ground/plugin_ground_slope_normal"     // float_array, writable
+	SimFlightmodelGround_plugin_ground_terrain_velocity string = "sim/flightmodel/ground/plugin_ground_terrain_velocity" // float_array, writable
+	SimFlightmodelMovingparts_gear1def                  string = "sim/flightmodel/movingparts/gear1def"                  // float
+	SimFlightmodelMovingparts_gear2def                  string = "sim/flightmodel/movingparts/gear2def"                  // float
+	SimFlightmodelMovingparts_gear3def                  string = "sim/flightmodel/movingparts/gear3def"                  // float
+	SimFlightmodelMovingparts_gear4def                  string = "sim/flightmodel/movingparts/gear4def"                  // float
+	SimFlightmodelMovingparts_gear5def                  string = "sim/flightmodel/movingparts/gear5def"                  // float
+	SimFlightmodelParts_v_el                            string = "sim/flightmodel/parts/v_el"                            // float_array
+	SimFlightmodelParts_alpha_el                        string = "sim/flightmodel/parts/alpha_el"                        // float_array
+	SimFlightmodelParts_cl_el_raw                       string = "sim/flightmodel/parts/cl_el_raw"                       // float_array
+	SimFlightmodelParts_CL_grndeffect                   string = "sim/flightmodel/parts/CL_grndeffect"                   // float_array
+	SimFlightmodelParts_CD_grndeffect                   string = "sim/flightmodel/parts/CD_grndeffect"                   // float_array
+	SimFlightmodelParts_wash_grndeffect                 string = "sim/flightmodel/parts/wash_grndeffect"                 // float_array
+	SimFlightmodelParts_Q_centroid_loc                  string = "sim/flightmodel/parts/Q_centroid_loc"                  // float_array
+	SimFlightmodelParts_Q_centroid_MULT                 string = "sim/flightmodel/parts/Q_centroid_MULT"                 // float_array
+	SimFlightmodelParts_tire_drag_dis                   string = "sim/flightmodel/parts/tire_drag_dis"                   // float_array
+	SimFlightmodelParts_tire_speed_term                 string = "sim/flightmodel/parts/tire_speed_term"                 // float_array
+	SimFlightmodelParts_tire_speed_now                  string = "sim/flightmodel/parts/tire_speed_now"                  // float_array
+	SimFlightmodelParts_tire_prop_rot                   string = "sim/flightmodel/parts/tire_prop_rot"                   // float_array
+	SimFlightmodelParts_tire_x_no_deflection            string = "sim/flightmodel/parts/tire_x_no_deflection"            // float_array
+	SimFlightmodelParts_tire_y_no_deflection            string = "sim/flightmodel/parts/tire_y_no_deflection"            // float_array
+	SimFlightmodelParts_tire_z_no_deflection            string = "sim/flightmodel/parts/tire_z_no_deflection"            // float_array
+	SimFlightmodelParts_tire_vrt_def_veh                string = "sim/flightmodel/parts/tire_vrt_def_veh"                // float_array
+	SimFlightmodelParts_strut_vrt_def_veh               string = "sim/flightmodel/parts/strut_vrt_def_veh"               // float_array
+	SimFlightmodelParts_tire_vrt_frc_veh                string = "sim/flightmodel/parts/tire_vrt_frc_veh"                // float_array
+	SimFlightmodelParts_tire_steer_cmd                  string = "sim/flightmodel/parts/tire_steer_cmd"                  // float_array, writable
+	SimFlightmodelParts_tire_steer_act                  string = "sim/flightmodel/parts/tire_steer_act"                  // float_array, writable
+	SimFlightmodelParts_nrml_force                      string = "sim/flightmodel/parts/nrml_force"                      // float_array
+	SimFlightmodelParts_axil_force                      string = "sim/flightmodel/parts/axil_force"                      // float_array
+	SimFlightmodelParts_flap_def                        string = "sim/flightmodel/parts/flap_def"                        // float_array
+	SimFlightmodelParts_flap2_def                       string = "sim/flightmodel/parts/flap2_def"                       // float_array
+	SimFlightmodelParts_elev_cont_def                   string = "sim/flightmodel/parts/elev_cont_def"                   // float_array
+	SimFlightmodelParts_elev_trim_def                   string = "sim/flightmodel/parts/elev_trim_def"                   // float_array
+	SimFlightmodelParts_rudd_cont_def                   string = "sim/flightmodel/parts/rudd_cont_def"                   // float_array
+	SimFlightmodelParts_rudd2_cont_def                  string = "sim/flightmodel/parts/rudd2_cont_def"                  // float_array
+	SimFlightmodelParts_elem_inc                        string = "sim/flightmodel/parts/elem_inc"                        // float_array
+	SimFlightmodelPosition_local_x                      string = "sim/flightmodel/position/local_x"                      // double, writable
+	SimFlightmodelPosition_local_y                      string = "sim/flightmodel/position/local_y"                      // double, writable
+	SimFlightmodelPosition_local_z                      string = "sim/flightmodel/position/local_z"                      // double, writable
+	SimFlightmodelPosition_lat_ref                      string = "sim/flightmodel/position/lat_ref"                      // float
+	SimFlightmodelPosition_lon_ref                      string = "sim/flightmodel/position/lon_ref"                      // float
+	SimFlightmodelPosition_latitude                     string = "sim/flightmodel/position/latitude"                     // double
+	SimFlightmodelPosition_longitude                    string = "sim/flightmodel/position/longitude"                    // double
+	SimFlightmodelPosition_elevation                    string = "sim/flightmodel/position/elevation"                    // double
+	SimFlightmodelPosition_theta                        string = "sim/flightmodel/position/theta"                        // float, writable
+	SimFlightmodelPosition_phi                          string = "sim/flightmodel/position/phi"                          // float, writable
+	SimFlightmodelPosition_psi                          string = "sim/flightmodel/position/psi"                          // float, writable
+	SimFlightmodelPosition_magpsi                       string = "sim/flightmodel/position/magpsi"                       // float
+	SimFlightmodelPosition_true_theta                   string = "sim/flightmodel/position/true_theta"                   // float
+	SimFlightmodelPosition_true_phi                     string = "sim/flightmodel/position/true_phi"                     // float
+	SimFlightmodelPosition_true_psi                     string = "sim/flightmodel/position/true_psi"                     // float
+	SimFlightmodelPosition_mag_psi                      string = "sim/flightmodel/position/mag_psi"                      // float
+	SimFlightmodelPosition_local_vx                     string = "sim/flightmodel/position/local_vx"                     // float, writable
+	SimFlightmodelPosition_local_vy                     string = "sim/flightmodel/position/local_vy"                     // float, writable
+	SimFlightmodelPosition_local_vz                     string = "sim/flightmodel/position/local_vz"                     // float, writable
+	SimFlightmodelPosition_local_ax                     string = "sim/flightmodel/position/local_ax"                     // float, writable
+	SimFlightmodelPosition_local_ay                     string = "sim/flightmodel/position/local_ay"                     // float, writable
+	SimFlightmodelPosition_local_az                     string = "sim/flightmodel/position/local_az"                     // float, writable
+	SimFlightmodelPosition_alpha                        string = "sim/flightmodel/position/alpha"                        // float
+	SimFlightmodelPosition_beta                         string = "sim/flightmodel/position/beta"                         // float
+	SimFlightmodelPosition_vpath                        string = "sim/flightmodel/position/vpath"                        // float
+	SimFlightmodelPosition_hpath                        string = "sim/flightmodel/position/hpath"                        // float
+	SimFlightmodelPosition_groundspeed                  string = "sim/flightmodel/position/groundspeed"                  // float
+	SimFlightmodelPosition_indicated_airspeed           string = "sim/flightmodel/position/indicated_airspeed"           // float, writable
+	SimFlightmodelPosition_indicated_airspeed2          string = "sim/flightmodel/position/indicated_airspeed2"          // float, writable
+	SimFlightmodelPosition_equivalent_airspeed          string = "sim/flightmodel/position/equivalent_airspeed"          // float, writable
+	SimFlightmodelPosition_true_airspeed                string = "sim/flightmodel/position/true_airspeed"                // float, writable
+	SimFlightmodelPosition_magnetic_variation           string = "sim/flightmodel/position/magnetic_variation"           // float
+	SimFlightmodelPosition_M                            string = "sim/flightmodel/position/M"                            // float
+	SimFlightmodelPosition_N                            string = "sim/flightmodel/position/N"                            // float
+	SimFlightmodelPosition_L                            string = "sim/flightmodel/position/L"                            // float
+	SimFlightmodelPosition_P                            string = "sim/flightmodel/position/P"                            // float, writable
+	SimFlightmodelPosition_Q                            string = "sim/flightmodel/position/Q"                            // float, writable
+	SimFlightmodelPosition_R                            string = "sim/flightmodel/position/R"                            // float, writable
+	SimFlightmodelPosition_P_dot                        string = "sim/flightmodel/position/P_dot"                        // float
+	SimFlightmodelPosition_Q_dot                        string = "sim/flightmodel/position/Q_dot"                        // float
+	SimFlightmodelPosition_R_dot                        string = "sim/flightmodel/position/R_dot"                        // float
+	SimFlightmodelPosition_Prad                         string = "sim/flightmodel/position/Prad"                         // float, writable
+	SimFlightmodelPosition_Qrad                         string = "sim/flightmodel/position/Qrad"                         // float, writable
+	SimFlightmodelPosition_Rrad                         string = "sim/flightmodel/position/Rrad"                         // float, writable
+	SimFlightmodelPosition_q                            string = "sim/flightmodel/position/q"                            // float_array, writable
+	SimFlightmodelPosition_vh_ind                       string = "sim/flightmodel/position/vh_ind"                       // float
+	SimFlightmodelPosition_vh_ind_fpm                   string = "sim/flightmodel/position/vh_ind_fpm"                   // float, writable
+	SimFlightmodelPosition_vh_ind_fpm2                  string = "sim/flightmodel/position/vh_ind_fpm2"                  // float, writable
+	SimFlightmodelPosition_y_agl                        string = "sim/flightmodel/position/y_agl"                        // float
+	SimFlightmodelTransmissions_xmsn_press              string = "sim/flightmodel/transmissions/xmsn_press"              // float
+	SimFlightmodelTransmissions_xmsn_temp               string = "sim/flightmodel/transmissions/xmsn_temp"               // float
+	SimFlightmodelWeight_m_fixed                        string = "sim/flightmodel/weight/m_fixed"                        // float, writable
+	SimFlightmodelWeight_m_shift                        string = "sim/flightmodel/weight/m_shift"                        // float, writable
+	SimFlightmodelWeight_m_stations                     string = "sim/flightmodel/weight/m_stations"                     // float_array, writable
+	SimFlightmodelWeight_m_total                        string = "sim/flightmodel/weight/m_total"                        // float
+	SimFlightmodelWeight_m_fuel                         string = "sim/flightmodel/weight/m_fuel"                         // float_array, writable
+	SimFlightmodelWeight_m_fuel1                        string = "sim/flightmodel/weight/m_fuel1"                        // float, writable
+	SimFlightmodelWeight_m_fuel2                        string = "sim/flightmodel/weight/m_fuel2"                        // float, writable
+	SimFlightmodelWeight_m_fuel3                        string = "sim/flightmodel/weight/m_fuel3"                        // float, writable
+	SimFlightmodelWeight_m_jettison                     string = "sim/flightmodel/weight/m_jettison"                     // float, writable
+	SimFlightmodelWeight_m_fuel_total                   string = "sim/flightmodel/weight/m_fuel_total"                   // float
+	SimFlightmodelWeight_m_jettison_kg_sec              string = "sim/flightmodel/weight/m_jettison_kg_sec"              // float
+)
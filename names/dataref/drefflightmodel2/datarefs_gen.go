@@ -0,0 +1,254 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefflightmodel2 provides the known dataref names under sim/flightmodel2/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefflightmodel2
+
+const (
+	SimFlightmodel2Controls_airbus_law                      string = "sim/flightmodel2/controls/airbus_law"                       // int
+	SimFlightmodel2Controls_airbus_speed_warn_thro_0        string = "sim/flightmodel2/controls/airbus_speed_warn_thro_0"         // int
+	SimFlightmodel2Controls_airbus_speed_warn_thro_1        string = "sim/flightmodel2/controls/airbus_speed_warn_thro_1"         // int
+	SimFlightmodel2Controls_pitch_ratio                     string = "sim/flightmodel2/controls/pitch_ratio"                      // float, writable
+	SimFlightmodel2Controls_roll_ratio                      string = "sim/flightmodel2/controls/roll_ratio"                       // float, writable
+	SimFlightmodel2Controls_heading_ratio                   string = "sim/flightmodel2/controls/heading_ratio"                    // float, writable
+	SimFlightmodel2Controls_pitch_ratio_right               string = "sim/flightmodel2/controls/pitch_ratio_right"                // float, writable
+	SimFlightmodel2Controls_roll_ratio_right                string = "sim/flightmodel2/controls/roll_ratio_right"                 // float, writable
+	SimFlightmodel2Controls_speedbrake_ratio                string = "sim/flightmodel2/controls/speedbrake_ratio"                 // float, writable
+	SimFlightmodel2Controls_wingsweep_ratio                 string = "sim/flightmodel2/controls/wingsweep_ratio"                  // float, writable
+	SimFlightmodel2Controls_thrust_vector_ratio             string = "sim/flightmodel2/controls/thrust_vector_ratio"              // float, writable
+	SimFlightmodel2Controls_dihedral_ratio                  string = "sim/flightmodel2/controls/dihedral_ratio"                   // float, writable
+	SimFlightmodel2Controls_incidence_ratio                 string = "sim/flightmodel2/controls/incidence_ratio"                  // float, writable
+	SimFlightmodel2Controls_wing_retraction_ratio           string = "sim/flightmodel2/controls/wing_retraction_ratio"            // float, writable
+	SimFlightmodel2Controls_flap_handle_deploy_ratio        string = "sim/flightmodel2/controls/flap_handle_deploy_ratio"         // float, writable
+	SimFlightmodel2Controls_slat1_deploy_ratio              string = "sim/flightmodel2/controls/slat1_deploy_ratio"               // float, writable
+	SimFlightmodel2Controls_slat2_deploy_ratio              string = "sim/flightmodel2/controls/slat2_deploy_ratio"               // float, writable
+	SimFlightmodel2Controls_flap1_deploy_ratio              string = "sim/flightmodel2/controls/flap1_deploy_ratio"               // float, writable
+	SimFlightmodel2Controls_flap2_deploy_ratio              string = "sim/flightmodel2/controls/flap2_deploy_ratio"               // float, writable
+	SimFlightmodel2Controls_stabilizer_deflection_degrees   string = "sim/flightmodel2/controls/stabilizer_deflection_degrees"    // float, writable
+	SimFlightmodel2Controls_aileron_trim                    string = "sim/flightmodel2/controls/aileron_trim"                     // float, writable
+	SimFlightmodel2Controls_elevator_trim                   string = "sim/flightmodel2/controls/elevator_trim"                    // float, writable
+	SimFlightmodel2Controls_rudder_trim                     string = "sim/flightmodel2/controls/rudder_trim"                      // float, writable
+	SimFlightmodel2Controls_water_rudder_deploy_ratio       string = "sim/flightmodel2/controls/water_rudder_deploy_ratio"        // float
+	SimFlightmodel2Controls_water_rudder_def_deg            string = "sim/flightmodel2/controls/water_rudder_def_deg"             // float
+	SimFlightmodel2Controls_tailhook_handle                 string = "sim/flightmodel2/controls/tailhook_handle"                  // float, writable
+	SimFlightmodel2Engines_location_x_mtr                   string = "sim/flightmodel2/engines/location_x_mtr"                    // float_array
+	SimFlightmodel2Engines_location_y_mtr                   string = "sim/flightmodel2/engines/location_y_mtr"                    // float_array
+	SimFlightmodel2Engines_location_z_mtr                   string = "sim/flightmodel2/engines/location_z_mtr"                    // float_array
+	SimFlightmodel2Engines_throttle_used_ratio              string = "sim/flightmodel2/engines/throttle_used_ratio"               // float_array
+	SimFlightmodel2Engines_has_fuel_flow_before_mixture     string = "sim/flightmodel2/engines/has_fuel_flow_before_mixture"      // int_array, writable
+	SimFlightmodel2Engines_has_fuel_flow_after_mixture      string = "sim/flightmodel2/engines/has_fuel_flow_after_mixture"       // int_array
+	SimFlightmodel2Engines_engine_is_burning_fuel           string = "sim/flightmodel2/engines/engine_is_burning_fuel"            // int_array
+	SimFlightmodel2Engines_engine_fuel_in_intake            string = "sim/flightmodel2/engines/engine_fuel_in_intake"             // float_array, writable
+	SimFlightmodel2Engines_afterburner_on                   string = "sim/flightmodel2/engines/afterburner_on"                    // int_array
+	SimFlightmodel2Engines_afterburner_ratio                string = "sim/flightmodel2/engines/afterburner_ratio"                 // float_array
+	SimFlightmodel2Engines_engine_rotation_speed_rad_sec    string = "sim/flightmodel2/engines/engine_rotation_speed_rad_sec"     // float_array
+	SimFlightmodel2Engines_engine_rotation_angle_deg        string = "sim/flightmodel2/engines/engine_rotation_angle_deg"         // float_array
+	SimFlightmodel2Engines_prop_rotation_speed_rad_sec      string = "sim/flightmodel2/engines/prop_rotation_speed_rad_sec"       // float_array
+	SimFlightmodel2Engines_prop_rotation_angle_deg          string = "sim/flightmodel2/engines/prop_rotation_angle_deg"           // float_array, writable
+	SimFlightmodel2Engines_prop_no_disc_rotation_angle_deg  string = "sim/flightmodel2/engines/prop_no_disc_rotation_angle_deg"   // float_array
+	SimFlightmodel2Engines_prop_pitch_deg                   string = "sim/flightmodel2/engines/prop_pitch_deg"                    // float_array
+	SimFlightmodel2Engines_prop_cone_angle_rad              string = "sim/flightmodel2/engines/prop_cone_angle_rad"               // float_array
+	SimFlightmodel2Engines_prop_angle_deg                   string = "sim/flightmodel2/engines/prop_angle_deg"                    // float_array, writable
+	SimFlightmodel2Engines_rotor_cyclic_elevator_tilt_deg   string = "sim/flightmodel2/engines/rotor_cyclic_elevator_tilt_deg"    // float_array
+	SimFlightmodel2Engines_rotor_cyclic_aileron_tilt_deg    string = "sim/flightmodel2/engines/rotor_cyclic_aileron_tilt_deg"     // float_array
+	SimFlightmodel2Engines_thrust_reverser_deploy_ratio     string = "sim/flightmodel2/engines/thrust_reverser_deploy_ratio"      // float_array
+	SimFlightmodel2Engines_N1_FADEC                         string = "sim/flightmodel2/engines/N1_FADEC"                          // float_array
+	SimFlightmodel2Engines_EPR_FADEC                        string = "sim/flightmodel2/engines/EPR_FADEC"                         // float_array
+	SimFlightmodel2Engines_prop_is_disc                     string = "sim/flightmodel2/engines/prop_is_disc"                      // int_array, writable
+	SimFlightmodel2Engines_prop_tip_deflection_degrees      string = "sim/flightmodel2/engines/prop_tip_deflection_degrees"       // float_array
+	SimFlightmodel2Engines_fuel_pump_spinning               string = "sim/flightmodel2/engines/fuel_pump_spinning"                // float_array
+	SimFlightmodel2Engines_starter_is_running               string = "sim/flightmodel2/engines/starter_is_running"                // int_array
+	SimFlightmodel2Engines_starter_making_torque            string = "sim/flightmodel2/engines/starter_making_torque"             // int_array
+	SimFlightmodel2Engines_is_on_fire                       string = "sim/flightmodel2/engines/is_on_fire"                        // float_array
+	SimFlightmodel2Engines_side_sign                        string = "sim/flightmodel2/engines/side_sign"                         // float_array
+	SimFlightmodel2Engines_N1_percent                       string = "sim/flightmodel2/engines/N1_percent"                        // float_array
+	SimFlightmodel2Engines_N2_percent                       string = "sim/flightmodel2/engines/N2_percent"                        // float_array
+	SimFlightmodel2Engines_ITT_deg_C                        string = "sim/flightmodel2/engines/ITT_deg_C"                         // float_array
+	SimFlightmodel2Engines_EGT_deg_C                        string = "sim/flightmodel2/engines/EGT_deg_C"                         // float_array
+	SimFlightmodel2Engines_CHT_deg_C                        string = "sim/flightmodel2/engines/CHT_deg_C"                         // float_array
+	SimFlightmodel2Engines_ITT_deg_cel                      string = "sim/flightmodel2/engines/ITT_deg_cel"                       // float_array, writable
+	SimFlightmodel2Engines_EGT_deg_cel                      string = "sim/flightmodel2/engines/EGT_deg_cel"                       // float_array, writable
+	SimFlightmodel2Engines_CHT_deg_cel                      string = "sim/flightmodel2/engines/CHT_deg_cel"                       // float_array, writable
+	SimFlightmodel2Engines_EGT_CYL_cel                      string = "sim/flightmodel2/engines/EGT_CYL_cel"                       // float_array, writable
+	SimFlightmodel2Engines_CHT_CYL_cel                      string = "sim/flightmodel2/engines/CHT_CYL_cel"                       // float_array, writable
+	SimFlightmodel2Engines_jetwash_mtr_sec                  string = "sim/flightmodel2/engines/jetwash_mtr_sec"                   // float_array
+	SimFlightmodel2Engines_propwash_mtr_sec                 string = "sim/flightmodel2/engines/propwash_mtr_sec"                  // float_array, writable
+	SimFlightmodel2Engines_rotor_blade_alpha_deg            string = "sim/flightmodel2/engines/rotor_blade_alpha_deg"             // float_array
+	SimFlightmodel2Engines_rotor_disc_alpha_deg             string = "sim/flightmodel2/engines/rotor_disc_alpha_deg"              // float_array
+	SimFlightmodel2Engines_rotor_blade_slap_rat             string = "sim/flightmodel2/engines/rotor_blade_slap_rat"              // float_array
+	SimFlightmodel2Engines_engn_exhaust_speed_msc           string = "sim/flightmodel2/engines/engn_exhaust_speed_msc"            // float_array
+	SimFlightmodel2Engines_nozzle_speed_msc                 string = "sim/flightmodel2/engines/nozzle_speed_msc"                  // float_array
+	SimFlightmodel2EnginesPropDisc_override                 string = "sim/flightmodel2/engines/prop_disc/override"                // int_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_width               string = "sim/flightmodel2/engines/prop_disc/disc_width"              // float_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_length_ratio        string = "sim/flightmodel2/engines/prop_disc/disc_length_ratio"       // float_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_s                   string = "sim/flightmodel2/engines/prop_disc/disc_s"                  // float_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_t                   string = "sim/flightmodel2/engines/prop_disc/disc_t"                  // int_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_s_dim               string = "sim/flightmodel2/engines/prop_disc/disc_s_dim"              // int_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_t_dim               string = "sim/flightmodel2/engines/prop_disc/disc_t_dim"              // int_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_alpha_front         string = "sim/flightmodel2/engines/prop_disc/disc_alpha_front"        // float_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_alpha_side          string = "sim/flightmodel2/engines/prop_disc/disc_alpha_side"         // float_array, writable
+	SimFlightmodel2EnginesPropDisc_disc_alpha_inside        string = "sim/flightmodel2/engines/prop_disc/disc_alpha_inside"       // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_width               string = "sim/flightmodel2/engines/prop_disc/side_width"              // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_length_ratio        string = "sim/flightmodel2/engines/prop_disc/side_length_ratio"       // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_angle               string = "sim/flightmodel2/engines/prop_disc/side_angle"              // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_number_of_blades    string = "sim/flightmodel2/engines/prop_disc/side_number_of_blades"   // int_array, writable
+	SimFlightmodel2EnginesPropDisc_side_is_billboard        string = "sim/flightmodel2/engines/prop_disc/side_is_billboard"       // int_array, writable
+	SimFlightmodel2EnginesPropDisc_side_s                   string = "sim/flightmodel2/engines/prop_disc/side_s"                  // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_t                   string = "sim/flightmodel2/engines/prop_disc/side_t"                  // int_array, writable
+	SimFlightmodel2EnginesPropDisc_side_s_dim               string = "sim/flightmodel2/engines/prop_disc/side_s_dim"              // int_array, writable
+	SimFlightmodel2EnginesPropDisc_side_t_dim               string = "sim/flightmodel2/engines/prop_disc/side_t_dim"              // int_array, writable
+	SimFlightmodel2EnginesPropDisc_side_alpha_front         string = "sim/flightmodel2/engines/prop_disc/side_alpha_front"        // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_alpha_side          string = "sim/flightmodel2/engines/prop_disc/side_alpha_side"         // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_alpha_inside        string = "sim/flightmodel2/engines/prop_disc/side_alpha_inside"       // float_array, writable
+	SimFlightmodel2EnginesPropDisc_side_alpha_to_camera     string = "sim/flightmodel2/engines/prop_disc/side_alpha_to_camera"    // float_array, writable
+	SimFlightmodel2Engines_prop_whiteout_ratio              string = "sim/flightmodel2/engines/prop_whiteout_ratio"               // float_array, writable
+	SimFlightmodel2Engines_prop_brownout_ratio              string = "sim/flightmodel2/engines/prop_brownout_ratio"               // float_array, writable
+	SimFlightmodel2Engines_rotor_radius_mtr                 string = "sim/flightmodel2/engines/rotor_radius_mtr"                  // float_array
+	SimFlightmodel2Gear_tire_steer_command_deg              string = "sim/flightmodel2/gear/tire_steer_command_deg"               // float_array, writable
+	SimFlightmodel2Gear_tire_steer_actual_deg               string = "sim/flightmodel2/gear/tire_steer_actual_deg"                // float_array, writable
+	SimFlightmodel2Gear_tire_vertical_deflection_mtr        string = "sim/flightmodel2/gear/tire_vertical_deflection_mtr"         // float_array
+	SimFlightmodel2Gear_strut_vertical_deflection_mtr       string = "sim/flightmodel2/gear/strut_vertical_deflection_mtr"        // float_array
+	SimFlightmodel2Gear_tire_vertical_force_n_mtr           string = "sim/flightmodel2/gear/tire_vertical_force_n_mtr"            // float_array
+	SimFlightmodel2Gear_tire_rotation_speed_rad_sec         string = "sim/flightmodel2/gear/tire_rotation_speed_rad_sec"          // float_array
+	SimFlightmodel2Gear_tire_rotation_rate_rad_sec          string = "sim/flightmodel2/gear/tire_rotation_rate_rad_sec"           // float_array
+	SimFlightmodel2Gear_tire_rotation_angle_deg             string = "sim/flightmodel2/gear/tire_rotation_angle_deg"              // float_array
+	SimFlightmodel2Gear_tire_part_brake                     string = "sim/flightmodel2/gear/tire_part_brake"                      // float_array
+	SimFlightmodel2Gear_brake_absorbed_J                    string = "sim/flightmodel2/gear/brake_absorbed_J"                     // float_array, writable
+	SimFlightmodel2Gear_deploy_ratio                        string = "sim/flightmodel2/gear/deploy_ratio"                         // float_array
+	SimFlightmodel2Gear_brake_absorbed_rat                  string = "sim/flightmodel2/gear/brake_absorbed_rat"                   // float_array, writable
+	SimFlightmodel2Gear_eagle_claw_angle_deg                string = "sim/flightmodel2/gear/eagle_claw_angle_deg"                 // float_array
+	SimFlightmodel2Gear_tire_skid_ratio                     string = "sim/flightmodel2/gear/tire_skid_ratio"                      // float_array
+	SimFlightmodel2Gear_total_deflection_rate               string = "sim/flightmodel2/gear/total_deflection_rate"                // float_array
+	SimFlightmodel2Gear_on_ground                           string = "sim/flightmodel2/gear/on_ground"                            // int_array
+	SimFlightmodel2Gear_on_grass                            string = "sim/flightmodel2/gear/on_grass"                             // int_array
+	SimFlightmodel2Gear_on_noisy                            string = "sim/flightmodel2/gear/on_noisy"                             // int_array
+	SimFlightmodel2Gear_tire_skid_speed_mtr_sec             string = "sim/flightmodel2/gear/tire_skid_speed_mtr_sec"              // float_array
+	SimFlightmodel2Gear_tire_abs_gain                       string = "sim/flightmodel2/gear/tire_abs_gain"                        // float_array
+	SimFlightmodel2Gear_is_chocked                          string = "sim/flightmodel2/gear/is_chocked"                           // int_array, writable
+	SimFlightmodel2Gear_on_dusty                            string = "sim/flightmodel2/gear/on_dusty"                             // float_array
+	SimFlightmodel2Gear_on_misty                            string = "sim/flightmodel2/gear/on_misty"                             // float_array
+	SimFlightmodel2Gear_on_water                            string = "sim/flightmodel2/gear/on_water"                             // int_array
+	SimFlightmodel2Gear_tire_radius_mtrs                    string = "sim/flightmodel2/gear/tire_radius_mtrs"                     // float_array
+	SimFlightmodel2Gear_tire_width_mtrs                     string = "sim/flightmodel2/gear/tire_width_mtrs"                      // float_array
+	SimFlightmodel2Lights_landing_lights_brightness_ratio   string = "sim/flightmodel2/lights/landing_lights_brightness_ratio"    // float_array
+	SimFlightmodel2Lights_generic_lights_brightness_ratio   string = "sim/flightmodel2/lights/generic_lights_brightness_ratio"    // float_array
+	SimFlightmodel2Lights_taxi_lights_brightness_ratio      string = "sim/flightmodel2/lights/taxi_lights_brightness_ratio"       // float_array
+	SimFlightmodel2Lights_spot_lights_brightness_ratio      string = "sim/flightmodel2/lights/spot_lights_brightness_ratio"       // float_array
+	SimFlightmodel2Lights_nav_lights_brightness_ratio       string = "sim/flightmodel2/lights/nav_lights_brightness_ratio"        // float_array
+	SimFlightmodel2Lights_beacon_brightness_ratio           string = "sim/flightmodel2/lights/beacon_brightness_ratio"            // float_array, writable
+	SimFlightmodel2Lights_strobe_brightness_ratio           string = "sim/flightmodel2/lights/strobe_brightness_ratio"            // float_array, writable
+	SimFlightmodel2Lights_spot_light_heading_deg            string = "sim/flightmodel2/lights/spot_light_heading_deg"             // float_array, writable
+	SimFlightmodel2Lights_spot_light_pitch_deg              string = "sim/flightmodel2/lights/spot_light_pitch_deg"               // float_array, writable
+	SimFlightmodel2Lights_strobe_flash_now                  string = "sim/flightmodel2/lights/strobe_flash_now"                   // int, writable
+	SimFlightmodel2Lights_override_beacons_and_strobes      string = "sim/flightmodel2/lights/override_beacons_and_strobes"       // int, writable
+	SimFlightmodel2Misc_canopy_open_ratio                   string = "sim/flightmodel2/misc/canopy_open_ratio"                    // float
+	SimFlightmodel2Misc_door_open_ratio                     string = "sim/flightmodel2/misc/door_open_ratio"                      // float_array
+	SimFlightmodel2Misc_door_cycle_time                     string = "sim/flightmodel2/misc/door_cycle_time"                      // float_array, writable
+	SimFlightmodel2Misc_tailhook_deploy_ratio               string = "sim/flightmodel2/misc/tailhook_deploy_ratio"                // float
+	SimFlightmodel2Misc_water_scoop_deploy_ratio            string = "sim/flightmodel2/misc/water_scoop_deploy_ratio"             // float
+	SimFlightmodel2Misc_water_drop_deploy_ratio             string = "sim/flightmodel2/misc/water_drop_deploy_ratio"              // float
+	SimFlightmodel2Misc_wiper_angle_deg                     string = "sim/flightmodel2/misc/wiper_angle_deg"                      // float_array
+	SimFlightmodel2Misc_rain_repellent_ratio                string = "sim/flightmodel2/misc/rain_repellent_ratio"                 // float_array
+	SimFlightmodel2Misc_custom_slider_ratio                 string = "sim/flightmodel2/misc/custom_slider_ratio"                  // float_array
+	SimFlightmodel2Misc_pressure_outflow_ratio              string = "sim/flightmodel2/misc/pressure_outflow_ratio"               // float
+	SimFlightmodel2Misc_AoA_angle_degrees                   string = "sim/flightmodel2/misc/AoA_angle_degrees"                    // float
+	SimFlightmodel2Misc_yaw_string_angle                    string = "sim/flightmodel2/misc/yaw_string_angle"                     // float
+	SimFlightmodel2Misc_yaw_string_airspeed                 string = "sim/flightmodel2/misc/yaw_string_airspeed"                  // float
+	SimFlightmodel2Misc_gforce_normal                       string = "sim/flightmodel2/misc/gforce_normal"                        // float
+	SimFlightmodel2Misc_gforce_axil                         string = "sim/flightmodel2/misc/gforce_axil"                          // float
+	SimFlightmodel2Misc_gforce_side                         string = "sim/flightmodel2/misc/gforce_side"                          // float
+	SimFlightmodel2Misc_bouncer_x                           string = "sim/flightmodel2/misc/bouncer_x"                            // float_array
+	SimFlightmodel2Misc_bouncer_y                           string = "sim/flightmodel2/misc/bouncer_y"                            // float_array
+	SimFlightmodel2Misc_bouncer_z                           string = "sim/flightmodel2/misc/bouncer_z"                            // float_array
+	SimFlightmodel2Misc_bouncer_vx                          string = "sim/flightmodel2/misc/bouncer_vx"                           // float_array
+	SimFlightmodel2Misc_bouncer_vy                          string = "sim/flightmodel2/misc/bouncer_vy"                           // float_array
+	SimFlightmodel2Misc_bouncer_vz                          string = "sim/flightmodel2/misc/bouncer_vz"                           // float_array
+	SimFlightmodel2Misc_auto_start_in_progress              string = "sim/flightmodel2/misc/auto_start_in_progress"               // int, writable
+	SimFlightmodel2Misc_auto_board_in_progress              string = "sim/flightmodel2/misc/auto_board_in_progress"               // int, writable
+	SimFlightmodel2Misc_tailhook_angle_degrees              string = "sim/flightmodel2/misc/tailhook_angle_degrees"               // float
+	SimFlightmodel2Misc_tow_in_progress                     string = "sim/flightmodel2/misc/tow_in_progress"                      // int
+	SimFlightmodel2Misc_line_is_locked                      string = "sim/flightmodel2/misc/line_is_locked"                       // int
+	SimFlightmodel2Misc_has_crashed                         string = "sim/flightmodel2/misc/has_crashed"                          // int
+	SimFlightmodel2Misc_cg_offset_z                         string = "sim/flightmodel2/misc/cg_offset_z"                          // float, writable
+	SimFlightmodel2Misc_cg_offset_x                         string = "sim/flightmodel2/misc/cg_offset_x"                          // float, writable
+	SimFlightmodel2Misc_cg_offset_z_mac                     string = "sim/flightmodel2/misc/cg_offset_z_mac"                      // float, writable
+	SimFlightmodel2Misc_zfw_cg_offset_z                     string = "sim/flightmodel2/misc/zfw_cg_offset_z"                      // float
+	SimFlightmodel2Misc_zfw_cg_offset_x                     string = "sim/flightmodel2/misc/zfw_cg_offset_x"                      // float
+	SimFlightmodel2MiscContact_x                            string = "sim/flightmodel2/misc/contact/x"                            // float_array
+	SimFlightmodel2MiscContact_y                            string = "sim/flightmodel2/misc/contact/y"                            // float_array
+	SimFlightmodel2MiscContact_z                            string = "sim/flightmodel2/misc/contact/z"                            // float_array
+	SimFlightmodel2MiscContact_speed                        string = "sim/flightmodel2/misc/contact/speed"                        // float_array
+	SimFlightmodel2MiscContact_force                        string = "sim/flightmodel2/misc/contact/force"                        // float_array
+	SimFlightmodel2MiscContact_active                       string = "sim/flightmodel2/misc/contact/active"                       // int_array
+	SimFlightmodel2MiscContact_on_noisy                     string = "sim/flightmodel2/misc/contact/on_noisy"                     // int_array
+	SimFlightmodel2MiscContact_on_dusty                     string = "sim/flightmodel2/misc/contact/on_dusty"                     // float_array
+	SimFlightmodel2MiscContact_on_misty                     string = "sim/flightmodel2/misc/contact/on_misty"                     // float_array
+	SimFlightmodel2MiscContact_on_grassy                    string = "sim/flightmodel2/misc/contact/on_grassy"                    // int_array
+	SimFlightmodel2MiscContact_on_water                     string = "sim/flightmodel2/misc/contact/on_water"                     // int_array
+	SimFlightmodel2MiscContact_on_paved                     string = "sim/flightmodel2/misc/contact/on_paved"                     // int_array
+	SimFlightmodel2Position_true_theta                      string = "sim/flightmodel2/position/true_theta"                       // float
+	SimFlightmodel2Position_true_phi                        string = "sim/flightmodel2/position/true_phi"                         // float
+	SimFlightmodel2Position_true_psi                        string = "sim/flightmodel2/position/true_psi"                         // float
+	SimFlightmodel2Position_mag_psi                         string = "sim/flightmodel2/position/mag_psi"                          // float
+	SimFlightmodel2Position_alpha                           string = "sim/flightmodel2/position/alpha"                            // float
+	SimFlightmodel2Position_beta                            string = "sim/flightmodel2/position/beta"                             // float
+	SimFlightmodel2Position_drift_angle                     string = "sim/flightmodel2/position/drift_angle"                      // float
+	SimFlightmodel2Position_vpath                           string = "sim/flightmodel2/position/vpath"                            // float
+	SimFlightmodel2Position_hpath                           string = "sim/flightmodel2/position/hpath"                            // float, writable
+	SimFlightmodel2Position_groundspeed                     string = "sim/flightmodel2/position/groundspeed"                      // float
+	SimFlightmodel2Position_true_airspeed                   string = "sim/flightmodel2/position/true_airspeed"                    // float
+	SimFlightmodel2Position_y_agl                           string = "sim/flightmodel2/position/y_agl"                            // float
+	SimFlightmodel2Position_ecef_x                          string = "sim/flightmodel2/position/ecef_x"                           // double
+	SimFlightmodel2Position_ecef_y                          string = "sim/flightmodel2/position/ecef_y"                           // double
+	SimFlightmodel2Position_ecef_z                          string = "sim/flightmodel2/position/ecef_z"                           // double
+	SimFlightmodel2Position_ellipsoid_height                string = "sim/flightmodel2/position/ellipsoid_height"                 // double
+	SimFlightmodel2Position_pressure_altitude               string = "sim/flightmodel2/position/pressure_altitude"                // double
+	SimFlightmodel2Position_on_grass                        string = "sim/flightmodel2/position/on_grass"                         // int
+	SimFlightmodel2Position_on_noisy                        string = "sim/flightmodel2/position/on_noisy"                         // int
+	SimFlightmodel2Position_on_dusty                        string = "sim/flightmodel2/position/on_dusty"                         // float
+	SimFlightmodel2Position_on_misty                        string = "sim/flightmodel2/position/on_misty"                         // float
+	SimFlightmodel2Position_on_water                        string = "sim/flightmodel2/position/on_water"                         // int
+	SimFlightmodel2Wing_aileron1_deg                        string = "sim/flightmodel2/wing/aileron1_deg"                         // float_array, writable
+	SimFlightmodel2Wing_aileron2_deg                        string = "sim/flightmodel2/wing/aileron2_deg"                         // float_array, writable
+	SimFlightmodel2Wing_spoiler1_deg                        string = "sim/flightmodel2/wing/spoiler1_deg"                         // float_array, writable
+	SimFlightmodel2Wing_spoiler2_deg                        string = "sim/flightmodel2/wing/spoiler2_deg"                         // float_array, writable
+	SimFlightmodel2Wing_yawbrake_deg                        string = "sim/flightmodel2/wing/yawbrake_deg"                         // float_array, writable
+	SimFlightmodel2Wing_elevator1_deg                       string = "sim/flightmodel2/wing/elevator1_deg"                        // float_array, writable
+	SimFlightmodel2Wing_elevator2_deg                       string = "sim/flightmodel2/wing/elevator2_deg"                        // float_array, writable
+	SimFlightmodel2Wing_rudder1_deg                         string = "sim/flightmodel2/wing/rudder1_deg"                          // float_array, writable
+	SimFlightmodel2Wing_rudder2_deg                         string = "sim/flightmodel2/wing/rudder2_deg"                          // float_array, writable
+	SimFlightmodel2Wing_flap1_deg                           string = "sim/flightmodel2/wing/flap1_deg"                            // float_array, writable
+	SimFlightmodel2Wing_flap2_deg                           string = "sim/flightmodel2/wing/flap2_deg"                            // float_array, writable
+	SimFlightmodel2Wing_speedbrake1_deg                     string = "sim/flightmodel2/wing/speedbrake1_deg"                      // float_array, writable
+	SimFlightmodel2Wing_speedbrake2_deg                     string = "sim/flightmodel2/wing/speedbrake2_deg"                      // float_array, writable
+	SimFlightmodel2Wing_wing_tip_deflection_deg             string = "sim/flightmodel2/wing/wing_tip_deflection_deg"              // float_array
+	SimFlightmodel2Wing_wing_condensation_ratio             string = "sim/flightmodel2/wing/wing_condensation_ratio"              // float_array
+	SimFlightmodel2Wing_wing_tip_condensation_ratio         string = "sim/flightmodel2/wing/wing_tip_condensation_ratio"          // float_array
+	SimFlightmodel2WingElements_element_incidence_increase  string = "sim/flightmodel2/wing/elements/element_incidence_increase"  // float_array, writable
+	SimFlightmodel2WingElements_element_MAC_mtr             string = "sim/flightmodel2/wing/elements/element_MAC_mtr"             // float_array
+	SimFlightmodel2WingElements_element_surface_area_mtr_sq string = "sim/flightmodel2/wing/elements/element_surface_area_mtr_sq" // float_array
+	SimFlightmodel2WingElements_element_condensation_ratio  string = "sim/flightmodel2/wing/elements/element_condensation_ratio"  // float_array
+	SimFlightmodel2WingElements_element_is_stalled          string = "sim/flightmodel2/wing/elements/element_is_stalled"          // float_array
+	SimFlightmodel2WingElements_element_AOA_deg             string = "sim/flightmodel2/wing/elements/element_AOA_deg"             // float_array
+	SimFlightmodel2WingElements_element_speed_msc           string = "sim/flightmodel2/wing/elements/element_speed_msc"           // float_array
+	SimFlightmodel2WingElements_element_cl_addition         string = "sim/flightmodel2/wing/elements/element_cl_addition"         // float_array, writable
+	SimFlightmodel2WingElements_element_cd_addition         string = "sim/flightmodel2/wing/elements/element_cd_addition"         // float_array, writable
+	SimFlightmodel2WingElements_element_cm_addition         string = "sim/flightmodel2/wing/elements/element_cm_addition"         // float_array, writable
+	SimFlightmodel2WingElements_element_cl_total            string = "sim/flightmodel2/wing/elements/element_cl_total"            // float_array
+	SimFlightmodel2WingElements_element_cd_total            string = "sim/flightmodel2/wing/elements/element_cd_total"            // float_array
+	SimFlightmodel2WingElements_element_cm_total            string = "sim/flightmodel2/wing/elements/element_cm_total"            // float_array
+	SimFlightmodel2PropElements_element_AOA_deg             string = "sim/flightmodel2/prop/elements/element_AOA_deg"             // float_array
+	SimFlightmodel2PropElements_element_speed_msc           string = "sim/flightmodel2/prop/elements/element_speed_msc"           // float_array
+	SimFlightmodel2PropElements_element_cl_addition         string = "sim/flightmodel2/prop/elements/element_cl_addition"         // float_array, writable
+	SimFlightmodel2PropElements_element_cd_addition         string = "sim/flightmodel2/prop/elements/element_cd_addition"         // float_array, writable
+	SimFlightmodel2PropElements_element_cm_addition         string = "sim/flightmodel2/prop/elements/element_cm_addition"         // float_array, writable
+	SimFlightmodel2PropElements_element_cl_total            string = "sim/flightmodel2/prop/elements/element_cl_total"            // float_array
+	SimFlightmodel2PropElements_element_cd_total            string = "sim/flightmodel2/prop/elements/element_cd_total"            // float_array
+	SimFlightmodel2PropElements_element_cm_total            string = "sim/flightmodel2/prop/elements/element_cm_total"            // float_array
+)
@@ -0,0 +1,265 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefgraphics provides the known dataref names under sim/graphics/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefgraphics
+
+const (
+	SimGraphicsAnimationLights_flasher                            string = "sim/graphics/animation/lights/flasher"                            // float_array
+	SimGraphicsAnimationLights_pulse                              string = "sim/graphics/animation/lights/pulse"                              // float_array
+	SimGraphicsAnimationLights_strobe_v7                          string = "sim/graphics/animation/lights/strobe_v7"                          // float_array
+	SimGraphicsAnimationLights_traffic_light                      string = "sim/graphics/animation/lights/traffic_light"                      // float_array
+	SimGraphicsAnimationLights_normal                             string = "sim/graphics/animation/lights/normal"                             // float_array
+	SimGraphicsAnimationLights_vasi_papi                          string = "sim/graphics/animation/lights/vasi_papi"                          // float_array
+	SimGraphicsAnimationLights_vasi3                              string = "sim/graphics/animation/lights/vasi3"                              // float_array
+	SimGraphicsAnimationLights_rabbit                             string = "sim/graphics/animation/lights/rabbit"                             // float_array
+	SimGraphicsAnimationLights_rabbit_sp                          string = "sim/graphics/animation/lights/rabbit_sp"                          // float_array
+	SimGraphicsAnimationLights_strobe                             string = "sim/graphics/animation/lights/strobe"                             // float_array
+	SimGraphicsAnimationLights_strobe_sp                          string = "sim/graphics/animation/lights/strobe_sp"                          // float_array
+	SimGraphicsAnimationLights_wigwag                             string = "sim/graphics/animation/lights/wigwag"                             // float_array
+	SimGraphicsAnimationLights_wigwag2                            string = "sim/graphics/animation/lights/wigwag2"                            // float_array
+	SimGraphicsAnimationLights_wigwag_sp                          string = "sim/graphics/animation/lights/wigwag_sp"                          // float_array
+	SimGraphicsAnimationLights_wigwag2_sp                         string = "sim/graphics/animation/lights/wigwag2_sp"                         // float_array
+	SimGraphicsAnimationLights_carrier_waveoff                    string = "sim/graphics/animation/lights/carrier_waveoff"                    // float_array
+	SimGraphicsAnimationLights_carrier_mast_strobe                string = "sim/graphics/animation/lights/carrier_mast_strobe"                // float_array
+	SimGraphicsAnimationLights_carrier_centerline_pulse           string = "sim/graphics/animation/lights/carrier_centerline_pulse"           // float_array
+	SimGraphicsAnimationLights_fresnel_vertical                   string = "sim/graphics/animation/lights/fresnel_vertical"                   // float_array
+	SimGraphicsAnimationLights_fresnel_horizontal                 string = "sim/graphics/animation/lights/fresnel_horizontal"                 // float_array
+	SimGraphicsAnimationLights_airplane_landing_light             string = "sim/graphics/animation/lights/airplane_landing_light"             // float_array
+	SimGraphicsAnimationLights_airplane_landing_light_flash       string = "sim/graphics/animation/lights/airplane_landing_light_flash"       // float_array
+	SimGraphicsAnimationLights_airplane_landing_light_spill       string = "sim/graphics/animation/lights/airplane_landing_light_spill"       // float_array
+	SimGraphicsAnimationLights_airplane_generic_light             string = "sim/graphics/animation/lights/airplane_generic_light"             // float_array
+	SimGraphicsAnimationLights_airplane_generic_light_flash       string = "sim/graphics/animation/lights/airplane_generic_light_flash"       // float_array
+	SimGraphicsAnimationLights_airplane_generic_light_spill       string = "sim/graphics/animation/lights/airplane_generic_light_spill"       // float_array
+	SimGraphicsAnimationLights_airplane_taxi_light                string = "sim/graphics/animation/lights/airplane_taxi_light"                // float_array
+	SimGraphicsAnimationLights_airplane_taxi_light_flash          string = "sim/graphics/animation/lights/airplane_taxi_light_flash"          // float_array
+	SimGraphicsAnimationLights_airplane_taxi_light_spill          string = "sim/graphics/animation/lights/airplane_taxi_light_spill"          // float_array
+	SimGraphicsAnimationLights_airplane_spot_light                string = "sim/graphics/animation/lights/airplane_spot_light"                // float_array
+	SimGraphicsAnimationLights_airplane_spot_light_flash          string = "sim/graphics/animation/lights/airplane_spot_light_flash"          // float_array
+	SimGraphicsAnimationLights_airplane_spot_light_spill          string = "sim/graphics/animation/lights/airplane_spot_light_spill"          // float_array
+	SimGraphicsAnimationLights_airplane_beacon_light              string = "sim/graphics/animation/lights/airplane_beacon_light"              // float_array
+	SimGraphicsAnimationLights_airplane_navigation_light          string = "sim/graphics/animation/lights/airplane_navigation_light"          // float_array
+	SimGraphicsAnimationLights_airplane_strobe_light              string = "sim/graphics/animation/lights/airplane_strobe_light"              // float_array
+	SimGraphicsAnimationLights_airplane_beacon_light_dir          string = "sim/graphics/animation/lights/airplane_beacon_light_dir"          // float_array
+	SimGraphicsAnimationLights_airplane_navigation_light_dir      string = "sim/graphics/animation/lights/airplane_navigation_light_dir"      // float_array
+	SimGraphicsAnimationLights_airplane_strobe_light_dir          string = "sim/graphics/animation/lights/airplane_strobe_light_dir"          // float_array
+	SimGraphicsAnimationLights_airplane_beacon_light_spill        string = "sim/graphics/animation/lights/airplane_beacon_light_spill"        // float_array
+	SimGraphicsAnimationLights_airplane_navigation_light_spill    string = "sim/graphics/animation/lights/airplane_navigation_light_spill"    // float_array
+	SimGraphicsAnimationLights_airplane_strobe_light_spill        string = "sim/graphics/animation/lights/airplane_strobe_light_spill"        // float_array
+	SimGraphicsAnimationLights_airplane_panel_spill               string = "sim/graphics/animation/lights/airplane_panel_spill"               // float_array
+	SimGraphicsAnimationLights_airplane_inst_spill                string = "sim/graphics/animation/lights/airplane_inst_spill"                // float_array
+	SimGraphicsAnimationLights_airplane_beacon_light_rotate       string = "sim/graphics/animation/lights/airplane_beacon_light_rotate"       // float_array
+	SimGraphicsAnimationLights_airplane_beacon_light_rotate_spill string = "sim/graphics/animation/lights/airplane_beacon_light_rotate_spill" // float_array
+	SimGraphicsAnimation_draw_object_x                            string = "sim/graphics/animation/draw_object_x"                             // float
+	SimGraphicsAnimation_draw_object_y                            string = "sim/graphics/animation/draw_object_y"                             // float
+	SimGraphicsAnimation_draw_object_z                            string = "sim/graphics/animation/draw_object_z"                             // float
+	SimGraphicsAnimation_draw_object_psi                          string = "sim/graphics/animation/draw_object_psi"                           // float
+	SimGraphicsAnimation_draw_light_level                         string = "sim/graphics/animation/draw_light_level"                          // float
+	SimGraphicsAnimation_airport_beacon_rotation                  string = "sim/graphics/animation/airport_beacon_rotation"                   // float
+	SimGraphicsAnimation_airport_beacon_rotation_military         string = "sim/graphics/animation/airport_beacon_rotation_military"          // float
+	SimGraphicsAnimation_windsock_psi                             string = "sim/graphics/animation/windsock_psi"                              // float
+	SimGraphicsAnimation_windsock_the                             string = "sim/graphics/animation/windsock_the"                              // float
+	SimGraphicsAnimation_windsock_phi                             string = "sim/graphics/animation/windsock_phi"                              // float
+	SimGraphicsAnimation_crane_psi                                string = "sim/graphics/animation/crane_psi"                                 // float
+	SimGraphicsAnimation_buoy_height                              string = "sim/graphics/animation/buoy_height"                               // float
+	SimGraphicsAnimation_ping_pong_2                              string = "sim/graphics/animation/ping_pong_2"                               // float
+	SimGraphicsAnimation_sin_wave_2                               string = "sim/graphics/animation/sin_wave_2"                                // float
+	SimGraphicsAnimation_obj_wigwag_brightness                    string = "sim/graphics/animation/obj_wigwag_brightness"                     // float
+	SimGraphicsAnimation_carrier_shuttle_ratio_lf                 string = "sim/graphics/animation/carrier_shuttle_ratio_lf"                  // float
+	SimGraphicsAnimation_carrier_shuttle_ratio_rf                 string = "sim/graphics/animation/carrier_shuttle_ratio_rf"                  // float
+	SimGraphicsAnimation_carrier_shuttle_ratio_lr                 string = "sim/graphics/animation/carrier_shuttle_ratio_lr"                  // float
+	SimGraphicsAnimation_carrier_shuttle_ratio_rr                 string = "sim/graphics/animation/carrier_shuttle_ratio_rr"                  // float
+	SimGraphicsAnimation_carrier_blast_def_lf                     string = "sim/graphics/animation/carrier_blast_def_lf"                      // float
+	SimGraphicsAnimation_carrier_blast_def_rf                     string = "sim/graphics/animation/carrier_blast_def_rf"                      // float
+	SimGraphicsAnimation_carrier_blast_def_lr                     string = "sim/graphics/animation/carrier_blast_def_lr"                      // float
+	SimGraphicsAnimation_carrier_blast_def_rr                     string = "sim/graphics/animation/carrier_blast_def_rr"                      // float
+	SimGraphicsAnimation_carrier_elevator_rat                     string = "sim/graphics/animation/carrier_elevator_rat"                      // float_array
+	SimGraphicsAnimation_carrier_hangar_door_rat                  string = "sim/graphics/animation/carrier_hangar_door_rat"                   // float_array
+	SimGraphicsAnimation_carrier_catapult_station_rat             string = "sim/graphics/animation/carrier_catapult_station_rat"              // float
+	SimGraphicsAnimation_level_crossing_gate                      string = "sim/graphics/animation/level_crossing_gate"                       // float
+	SimGraphicsAnimationJetways_jw_base_rotation                  string = "sim/graphics/animation/jetways/jw_base_rotation"                  // float
+	SimGraphicsAnimationJetways_jw_tunnel_pitch                   string = "sim/graphics/animation/jetways/jw_tunnel_pitch"                   // float
+	SimGraphicsAnimationJetways_jw_tunnel_extension               string = "sim/graphics/animation/jetways/jw_tunnel_extension"               // float
+	SimGraphicsAnimationJetways_jw_cabin_rotation                 string = "sim/graphics/animation/jetways/jw_cabin_rotation"                 // float
+	SimGraphicsAnimationJetways_jw_bogie_elevation                string = "sim/graphics/animation/jetways/jw_bogie_elevation"                // float
+	SimGraphicsAnimationJetways_jw_bogie_rotation                 string = "sim/graphics/animation/jetways/jw_bogie_rotation"                 // float
+	SimGraphicsAnimationJetways_jw_bogie_bogie_tilt               string = "sim/graphics/animation/jetways/jw_bogie_bogie_tilt"               // float
+	SimGraphicsAnimationJetways_jw_wheel_left                     string = "sim/graphics/animation/jetways/jw_wheel_left"                     // float
+	SimGraphicsAnimationJetways_jw_wheel_right                    string = "sim/graphics/animation/jetways/jw_wheel_right"                    // float
+	SimGraphicsAnimationJetways_jw_stairs_angle                   string = "sim/graphics/animation/jetways/jw_stairs_angle"                   // float
+	SimGraphicsAnimationJetways_jw_stairs_bogie_angle             string = "sim/graphics/animation/jetways/jw_stairs_bogie_angle"             // float
+	SimGraphicsAnimationJetways_jw_is_moving                      string = "sim/graphics/animation/jetways/jw_is_moving"                      // float
+	SimGraphicsAnimationBirds_wing_flap_deg                       string = "sim/graphics/animation/birds/wing_flap_deg"                       // float
+	SimGraphicsAnimationBirds_feet_retract_deg                    string = "sim/graphics/animation/birds/feet_retract_deg"                    // float
+	SimGraphicsAnimationGroundTraffic_tire_steer_deg              string = "sim/graphics/animation/ground_traffic/tire_steer_deg"             // float
+	SimGraphicsAnimationGroundTraffic_tire_rotation_angle_deg     string = "sim/graphics/animation/ground_traffic/tire_rotation_angle_deg"    // float_array
+	SimGraphicsAnimationGroundTraffic_wiper_angle_deg             string = "sim/graphics/animation/ground_traffic/wiper_angle_deg"            // float_array
+	SimGraphicsAnimationGroundTraffic_towbar_heading_deg          string = "sim/graphics/animation/ground_traffic/towbar_heading_deg"         // float
+	SimGraphicsAnimationGroundTraffic_towbar_pitch_deg            string = "sim/graphics/animation/ground_traffic/towbar_pitch_deg"           // float
+	SimGraphicsAnimationGroundTraffic_belt_loader_height_meters   string = "sim/graphics/animation/ground_traffic/belt_loader_height_meters"  // float
+	SimGraphicsAnimationGroundTraffic_door_open                   string = "sim/graphics/animation/ground_traffic/door_open"                  // float_array
+	SimGraphicsAnimationGroundTraffic_running_lift                string = "sim/graphics/animation/ground_traffic/running_lift"               // float
+	SimGraphicsAnimationGroundTraffic_running_misc                string = "sim/graphics/animation/ground_traffic/running_misc"               // float
+	SimGraphicsAnimationGroundTraffic_engine_running              string = "sim/graphics/animation/ground_traffic/engine_running"             // float
+	SimGraphicsAnimationGroundTraffic_engine_run_duration         string = "sim/graphics/animation/ground_traffic/engine_run_duration"        // float
+	SimGraphicsAnimationGroundTraffic_engine_load_rat             string = "sim/graphics/animation/ground_traffic/engine_load_rat"            // float
+	SimGraphicsAnimationGroundTraffic_engine_rpm_rat              string = "sim/graphics/animation/ground_traffic/engine_rpm_rat"             // float
+	SimGraphicsAnimationGroundTraffic_speed_ms                    string = "sim/graphics/animation/ground_traffic/speed_ms"                   // float
+	SimGraphicsColors_background_rgb                              string = "sim/graphics/colors/background_rgb"                               // float_array
+	SimGraphicsColors_menu_dark_rgb                               string = "sim/graphics/colors/menu_dark_rgb"                                // float_array
+	SimGraphicsColors_menu_hilite_rgb                             string = "sim/graphics/colors/menu_hilite_rgb"                              // float_array
+	SimGraphicsColors_menu_lite_rgb                               string = "sim/graphics/colors/menu_lite_rgb"                                // float_array
+	SimGraphicsColors_menu_text_rgb                               string = "sim/graphics/colors/menu_text_rgb"                                // float_array
+	SimGraphicsColors_menu_text_disabled_rgb                      string = "sim/graphics/colors/menu_text_disabled_rgb"                       // float_array
+	SimGraphicsColors_subtitle_text_rgb                           string = "sim/graphics/colors/subtitle_text_rgb"                            // float_array
+	SimGraphicsColors_tab_front_rgb                               string = "sim/graphics/colors/tab_front_rgb"                                // float_array
+	SimGraphicsColors_tab_back_rgb                                string = "sim/graphics/colors/tab_back_rgb"                                 // float_array
+	SimGraphicsColors_caption_text_rgb                            string = "sim/graphics/colors/caption_text_rgb"                             // float_array
+	SimGraphicsColors_list_text_rgb                               string = "sim/graphics/colors/list_text_rgb"                                // float_array
+	SimGraphicsColors_glass_text_rgb                              string = "sim/graphics/colors/glass_text_rgb"                               // float_array
+	SimGraphicsMisc_kill_tow_lines                                string = "sim/graphics/misc/kill_tow_lines"                                 // int, writable
+	SimGraphicsMisc_show_panel_click_spots                        string = "sim/graphics/misc/show_panel_click_spots"                         // int, writable
+	SimGraphicsMisc_show_instrument_descriptions                  string = "sim/graphics/misc/show_instrument_descriptions"                   // int, writable
+	SimGraphicsMisc_cockpit_light_level_r                         string = "sim/graphics/misc/cockpit_light_level_r"                          // float
+	SimGraphicsMisc_cockpit_light_level_g                         string = "sim/graphics/misc/cockpit_light_level_g"                          // float
+	SimGraphicsMisc_cockpit_light_level_b                         string = "sim/graphics/misc/cockpit_light_level_b"                          // float
+	SimGraphicsMisc_outside_light_level_r                         string = "sim/graphics/misc/outside_light_level_r"                          // float
+	SimGraphicsMisc_outside_light_level_g                         string = "sim/graphics/misc/outside_light_level_g"                          // float
+	SimGraphicsMisc_outside_light_level_b                         string = "sim/graphics/misc/outside_light_level_b"                          // float
+	SimGraphicsMisc_light_attenuation                             string = "sim/graphics/misc/light_attenuation"                              // float
+	SimGraphicsMisc_light_attenuation_2d                          string = "sim/graphics/misc/light_attenuation_2d"                           // float
+	SimGraphicsMisc_photo_auto_atten                              string = "sim/graphics/misc/photo_auto_atten"                               // float
+	SimGraphicsMisc_use_proportional_fonts                        string = "sim/graphics/misc/use_proportional_fonts"                         // int, writable
+	SimGraphicsMisc_kill_map_fms_line                             string = "sim/graphics/misc/kill_map_fms_line"                              // int, writable
+	SimGraphicsMisc_kill_g1000_ah                                 string = "sim/graphics/misc/kill_g1000_ah"                                  // int, writable
+	SimGraphicsMisc_kill_g1000_engine                             string = "sim/graphics/misc/kill_g1000_engine"                              // int, writable
+	SimGraphicsMisc_g1000_vfov_px                                 string = "sim/graphics/misc/g1000_vfov_px"                                  // int, writable
+	SimGraphicsMisc_red_flashlight_on                             string = "sim/graphics/misc/red_flashlight_on"                              // int, writable
+	SimGraphicsMisc_white_flashlight_on                           string = "sim/graphics/misc/white_flashlight_on"                            // int, writable
+	SimGraphicsMisc_user_interface_scale                          string = "sim/graphics/misc/user_interface_scale"                           // float
+	SimGraphicsMisc_default_scroll_pos                            string = "sim/graphics/misc/default_scroll_pos"                             // float
+	SimGraphicsMisc_current_scroll_pos                            string = "sim/graphics/misc/current_scroll_pos"                             // float, writable
+	SimGraphicsMisc_default_scroll_pos_x                          string = "sim/graphics/misc/default_scroll_pos_x"                           // float
+	SimGraphicsMisc_current_scroll_pos_x                          string = "sim/graphics/misc/current_scroll_pos_x"                           // float, writable
+	SimGraphicsScenery_current_planet                             string = "sim/graphics/scenery/current_planet"                              // int
+	SimGraphicsScenery_percent_lights_on                          string = "sim/graphics/scenery/percent_lights_on"                           // float
+	SimGraphicsScenery_sun_pitch_degrees                          string = "sim/graphics/scenery/sun_pitch_degrees"                           // float
+	SimGraphicsScenery_sun_heading_degrees                        string = "sim/graphics/scenery/sun_heading_degrees"                         // float
+	SimGraphicsScenery_moon_pitch_degrees                         string = "sim/graphics/scenery/moon_pitch_degrees"                          // float
+	SimGraphicsScenery_moon_heading_degrees                       string = "sim/graphics/scenery/moon_heading_degrees"                        // float
+	SimGraphicsScenery_moon_phase                                 string = "sim/graphics/scenery/moon_phase"                                  // float
+	SimGraphicsScenery_moon_illumination                          string = "sim/graphics/scenery/moon_illumination"                           // float
+	SimGraphicsScenery_airport_light_level                        string = "sim/graphics/scenery/airport_light_level"                         // float, writable
+	SimGraphicsScenery_airport_lights_on                          string = "sim/graphics/scenery/airport_lights_on"                           // int, writable
+	SimGraphicsScenery_async_scenery_load_in_progress             string = "sim/graphics/scenery/async_scenery_load_in_progress"              // int
+	SimGraphicsSettings_rendering_res                             string = "sim/graphics/settings/rendering_res"                              // int
+	SimGraphicsSettings_dim_gload                                 string = "sim/graphics/settings/dim_gload"                                  // int, writable
+	SimGraphicsSettings_non_proportional_vertical_FOV             string = "sim/graphics/settings/non_proportional_vertical_FOV"              // int, writable
+	SimGraphicsSettings_HDR_on                                    string = "sim/graphics/settings/HDR_on"                                     // int
+	SimGraphicsSettings_scattering_on                             string = "sim/graphics/settings/scattering_on"                              // int
+	SimGraphicsView_view_type                                     string = "sim/graphics/view/view_type"                                      // int
+	SimGraphicsView_panel_render_type                             string = "sim/graphics/view/panel_render_type"                              // int
+	SimGraphicsView_panel_render_new_blending                     string = "sim/graphics/view/panel_render_new_blending"                      // int
+	SimGraphicsView_world_render_type                             string = "sim/graphics/view/world_render_type"                              // int
+	SimGraphicsView_plane_render_type                             string = "sim/graphics/view/plane_render_type"                              // int
+	SimGraphicsView_draw_call_type                                string = "sim/graphics/view/draw_call_type"                                 // int
+	SimGraphicsView_view_is_external                              string = "sim/graphics/view/view_is_external"                               // int
+	SimGraphicsView_view_x                                        string = "sim/graphics/view/view_x"                                         // float
+	SimGraphicsView_view_y                                        string = "sim/graphics/view/view_y"                                         // float
+	SimGraphicsView_view_z                                        string = "sim/graphics/view/view_z"                                         // float
+	SimGraphicsView_view_pitch                                    string = "sim/graphics/view/view_pitch"                                     // float
+	SimGraphicsView_view_roll                                     string = "sim/graphics/view/view_roll"                                      // float
+	SimGraphicsView_view_heading                                  string = "sim/graphics/view/view_heading"                                   // float
+	SimGraphicsView_view_elevation_msl_mtrs                       string = "sim/graphics/view/view_elevation_msl_mtrs"                        // float
+	SimGraphicsView_view_elevation_agl_mtrs                       string = "sim/graphics/view/view_elevation_agl_mtrs"                        // float
+	SimGraphicsView_cockpit_pitch                                 string = "sim/graphics/view/cockpit_pitch"                                  // float
+	SimGraphicsView_cockpit_roll                                  string = "sim/graphics/view/cockpit_roll"                                   // float
+	SimGraphicsView_cockpit_heading                               string = "sim/graphics/view/cockpit_heading"                                // float
+	SimGraphicsView_field_of_view_deg                             string = "sim/graphics/view/field_of_view_deg"                              // float, writable
+	SimGraphicsView_vertical_field_of_view_deg                    string = "sim/graphics/view/vertical_field_of_view_deg"                     // float, writable
+	SimGraphicsView_field_of_view_vertical_deg                    string = "sim/graphics/view/field_of_view_vertical_deg"                     // float, writable
+	SimGraphicsView_field_of_view_horizontal_deg                  string = "sim/graphics/view/field_of_view_horizontal_deg"                   // float, writable
+	SimGraphicsView_field_of_view_roll_deg                        string = "sim/graphics/view/field_of_view_roll_deg"                         // float, writable
+	SimGraphicsView_field_of_view_horizontal_ratio                string = "sim/graphics/view/field_of_view_horizontal_ratio"                 // float, writable
+	SimGraphicsView_field_of_view_vertical_ratio                  string = "sim/graphics/view/field_of_view_vertical_ratio"                   // float, writable
+	SimGraphicsView_window_width                                  string = "sim/graphics/view/window_width"                                   // int
+	SimGraphicsView_window_height                                 string = "sim/graphics/view/window_height"                                  // int
+	SimGraphicsView_visibility_effective_m                        string = "sim/graphics/view/visibility_effective_m"                         // float
+	SimGraphicsView_visibility_terrain_m                          string = "sim/graphics/view/visibility_terrain_m"                           // float
+	SimGraphicsView_visibility_framerate_ratio                    string = "sim/graphics/view/visibility_framerate_ratio"                     // float
+	SimGraphicsView_visibility_math_level                         string = "sim/graphics/view/visibility_math_level"                          // int, writable
+	SimGraphicsView_cinema_verite                                 string = "sim/graphics/view/cinema_verite"                                  // int, writable
+	SimGraphicsView_gloaded_internal_cam                          string = "sim/graphics/view/gloaded_internal_cam"                           // int, writable
+	SimGraphicsView_handheld_external_cam                         string = "sim/graphics/view/handheld_external_cam"                          // int, writable
+	SimGraphicsView_mouse_wheel_zoom_internal                     string = "sim/graphics/view/mouse_wheel_zoom_internal"                      // int, writable
+	SimGraphicsView_mouse_wheel_zoom_external                     string = "sim/graphics/view/mouse_wheel_zoom_external"                      // int, writable
+	SimGraphicsView_panel_total_pnl_l                             string = "sim/graphics/view/panel_total_pnl_l"                              // float
+	SimGraphicsView_panel_total_pnl_b                             string = "sim/graphics/view/panel_total_pnl_b"                              // float
+	SimGraphicsView_panel_total_pnl_r                             string = "sim/graphics/view/panel_total_pnl_r"                              // float
+	SimGraphicsView_panel_total_pnl_t                             string = "sim/graphics/view/panel_total_pnl_t"                              // float
+	SimGraphicsView_panel_visible_pnl_l                           string = "sim/graphics/view/panel_visible_pnl_l"                            // float
+	SimGraphicsView_panel_visible_pnl_b                           string = "sim/graphics/view/panel_visible_pnl_b"                            // float
+	SimGraphicsView_panel_visible_pnl_r                           string = "sim/graphics/view/panel_visible_pnl_r"                            // float
+	SimGraphicsView_panel_visible_pnl_t                           string = "sim/graphics/view/panel_visible_pnl_t"                            // float
+	SimGraphicsView_panel_total_win_l                             string = "sim/graphics/view/panel_total_win_l"                              // float
+	SimGraphicsView_panel_total_win_b                             string = "sim/graphics/view/panel_total_win_b"                              // float
+	SimGraphicsView_panel_total_win_r                             string = "sim/graphics/view/panel_total_win_r"                              // float
+	SimGraphicsView_panel_total_win_t                             string = "sim/graphics/view/panel_total_win_t"                              // float
+	SimGraphicsView_panel_visible_win_l                           string = "sim/graphics/view/panel_visible_win_l"                            // float
+	SimGraphicsView_panel_visible_win_b                           string = "sim/graphics/view/panel_visible_win_b"                            // float
+	SimGraphicsView_panel_visible_win_r                           string = "sim/graphics/view/panel_visible_win_r"                            // float
+	SimGraphicsView_panel_visible_win_t                           string = "sim/graphics/view/panel_visible_win_t"                            // float
+	SimGraphicsView_pilots_head_x                                 string = "sim/graphics/view/pilots_head_x"                                  // float, writable
+	SimGraphicsView_pilots_head_y                                 string = "sim/graphics/view/pilots_head_y"                                  // float, writable
+	SimGraphicsView_pilots_head_z                                 string = "sim/graphics/view/pilots_head_z"                                  // float, writable
+	SimGraphicsView_pilots_head_psi                               string = "sim/graphics/view/pilots_head_psi"                                // float, writable
+	SimGraphicsView_pilots_head_the                               string = "sim/graphics/view/pilots_head_the"                                // float, writable
+	SimGraphicsView_pilots_head_phi                               string = "sim/graphics/view/pilots_head_phi"                                // float, writable
+	SimGraphicsView_click_3d_x                                    string = "sim/graphics/view/click_3d_x"                                     // float
+	SimGraphicsView_click_3d_y                                    string = "sim/graphics/view/click_3d_y"                                     // float
+	SimGraphicsView_click_3d_x_pixels                             string = "sim/graphics/view/click_3d_x_pixels"                              // float
+	SimGraphicsView_click_3d_y_pixels                             string = "sim/graphics/view/click_3d_y_pixels"                              // float
+	SimGraphicsView_local_map_l                                   string = "sim/graphics/view/local_map_l"                                    // float
+	SimGraphicsView_local_map_b                                   string = "sim/graphics/view/local_map_b"                                    // float
+	SimGraphicsView_local_map_r                                   string = "sim/graphics/view/local_map_r"                                    // float
+	SimGraphicsView_local_map_t                                   string = "sim/graphics/view/local_map_t"                                    // float
+	SimGraphicsView_dome_offset_heading                           string = "sim/graphics/view/dome_offset_heading"                            // float, writable
+	SimGraphicsView_dome_offset_pitch                             string = "sim/graphics/view/dome_offset_pitch"                              // float, writable
+	SimGraphicsView_eq_trackir                                    string = "sim/graphics/view/eq_trackir"                                     // int
+	SimGraphicsView_hide_yoke                                     string = "sim/graphics/view/hide_yoke"                                      // int, writable
+	SimGraphicsView_projection_matrix                             string = "sim/graphics/view/projection_matrix"                              // float_array
+	SimGraphicsView_projection_matrix_3d                          string = "sim/graphics/view/projection_matrix_3d"                           // float_array
+	SimGraphicsView_world_matrix                                  string = "sim/graphics/view/world_matrix"                                   // float_array
+	SimGraphicsView_acf_matrix                                    string = "sim/graphics/view/acf_matrix"                                     // float_array
+	SimGraphicsView_modelview_matrix                              string = "sim/graphics/view/modelview_matrix"                               // float_array
+	SimGraphicsView_viewport                                      string = "sim/graphics/view/viewport"                                       // int_array
+	SimGraphicsView_is_reverse_float_z                            string = "sim/graphics/view/is_reverse_float_z"                             // int
+	SimGraphicsView_is_reverse_y                                  string = "sim/graphics/view/is_reverse_y"                                   // int
+	SimGraphicsView_using_modern_driver                           string = "sim/graphics/view/using_modern_driver"                            // int
+	SimGraphicsView_current_gl_fbo                                string = "sim/graphics/view/current_gl_fbo"                                 // int
+	SimGraphicsVR_button_axis_x                                   string = "sim/graphics/VR/button_axis_x"                                    // float_array
+	SimGraphicsVR_button_axis_y                                   string = "sim/graphics/VR/button_axis_y"                                    // float_array
+	SimGraphicsVR_button_down                                     string = "sim/graphics/VR/button_down"                                      // int_array
+	SimGraphicsVR_backlight_level                                 string = "sim/graphics/VR/backlight_level"                                  // float
+	SimGraphicsVR_seeker_light_level                              string = "sim/graphics/VR/seeker_light_level"                               // float
+	SimGraphicsVR_touch_spot_light_level                          string = "sim/graphics/VR/touch_spot_light_level"                           // float
+	SimGraphicsVR_enabled                                         string = "sim/graphics/VR/enabled"                                          // int
+	SimGraphicsVR_using_3d_mouse                                  string = "sim/graphics/VR/using_3d_mouse"                                   // int
+	SimGraphicsVR_teleport_on_ground                              string = "sim/graphics/VR/teleport_on_ground"                               // int
+	SimGraphicsAnimation_lights_fx_sequence_2                     string = "sim/graphics/animation/lights_fx_sequence_2"                      // float_array
+	SimGraphicsAnimation_lights_fx_sequence_1                     string = "sim/graphics/animation/lights_fx_sequence_1"                      // float_array
+	SimGraphicsAnimation_digital_clock                            string = "sim/graphics/animation/digital_clock"                             // float_array
+	SimGraphicsAnimation_digital_clock_temp                       string = "sim/graphics/animation/digital_clock_temp"                        // float_array
+	SimGraphicsAnimation_lights_fx_chaser_16                      string = "sim/graphics/animation/lights_fx_chaser_16"                       // float_array
+	SimGraphicsAnimation_lights_fx_chaser_3_fast                  string = "sim/graphics/animation/lights_fx_chaser_3_fast"                   // float_array
+	SimGraphicsAnimation_lights_fx_chaser_3_slow                  string = "sim/graphics/animation/lights_fx_chaser_3_slow"                   // float_array
+	SimGraphicsAnimation_lights_fx_squarewaves_8                  string = "sim/graphics/animation/lights_fx_squarewaves_8"                   // float_array
+	SimGraphicsAnimation_lights_fx_flicker                        string = "sim/graphics/animation/lights_fx_flicker"                         // float
+	SimGraphicsAnimation_lights_fx_1_spill                        string = "sim/graphics/animation/lights_fx_1_spill"                         // float_array
+)
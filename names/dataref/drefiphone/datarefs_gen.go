@@ -0,0 +1,16 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefiphone provides the known dataref names under iphone/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefiphone
+
+const (
+	IphoneIndicators_descent_angle_deg string = "iphone/indicators/descent_angle_deg" // float
+	IphoneFlightmodel_ground_status    string = "iphone/flightmodel/ground_status"    // float
+)
@@ -0,0 +1,75 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefjoystick provides the known dataref names under sim/joystick/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefjoystick
+
+const (
+	SimJoystick_has_joystick                 string = "sim/joystick/has_joystick"                 // int
+	SimJoystick_has_throttle                 string = "sim/joystick/has_throttle"                 // int
+	SimJoystick_mouse_is_joystick            string = "sim/joystick/mouse_is_joystick"            // int
+	SimJoystick_mouse_can_be_joystick        string = "sim/joystick/mouse_can_be_joystick"        // int
+	SimJoystick_yoke_pitch_ratio             string = "sim/joystick/yoke_pitch_ratio"             // float, writable
+	SimJoystick_yoke_pitch_ratio_copilot     string = "sim/joystick/yoke_pitch_ratio_copilot"     // float, writable
+	SimJoystick_yolk_pitch_ratio             string = "sim/joystick/yolk_pitch_ratio"             // float, writable
+	SimJoystick_yoke_roll_ratio              string = "sim/joystick/yoke_roll_ratio"              // float, writable
+	SimJoystick_yoke_roll_ratio_copilot      string = "sim/joystick/yoke_roll_ratio_copilot"      // float, writable
+	SimJoystick_yolk_roll_ratio              string = "sim/joystick/yolk_roll_ratio"              // float, writable
+	SimJoystick_yoke_heading_ratio           string = "sim/joystick/yoke_heading_ratio"           // float, writable
+	SimJoystick_yoke_heading_ratio_copilot   string = "sim/joystick/yoke_heading_ratio_copilot"   // float, writable
+	SimJoystick_yolk_heading_ratio           string = "sim/joystick/yolk_heading_ratio"           // float, writable
+	SimJoystick_artstab_pitch_ratio          string = "sim/joystick/artstab_pitch_ratio"          // float, writable
+	SimJoystick_artstab_roll_ratio           string = "sim/joystick/artstab_roll_ratio"           // float, writable
+	SimJoystick_artstab_heading_ratio        string = "sim/joystick/artstab_heading_ratio"        // float, writable
+	SimJoystick_servo_pitch_ratio            string = "sim/joystick/servo_pitch_ratio"            // float, writable
+	SimJoystick_servo_roll_ratio             string = "sim/joystick/servo_roll_ratio"             // float, writable
+	SimJoystick_servo_heading_ratio          string = "sim/joystick/servo_heading_ratio"          // float, writable
+	SimJoystick_FC_hdng                      string = "sim/joystick/FC_hdng"                      // float, writable
+	SimJoystick_FC_ptch                      string = "sim/joystick/FC_ptch"                      // float, writable
+	SimJoystick_FC_ptch_rgt                  string = "sim/joystick/FC_ptch_rgt"                  // float, writable
+	SimJoystick_FC_roll                      string = "sim/joystick/FC_roll"                      // float, writable
+	SimJoystick_FC_roll_rgt                  string = "sim/joystick/FC_roll_rgt"                  // float, writable
+	SimJoystick_joystick_pitch_nullzone      string = "sim/joystick/joystick_pitch_nullzone"      // float, writable
+	SimJoystick_joystick_roll_nullzone       string = "sim/joystick/joystick_roll_nullzone"       // float, writable
+	SimJoystick_joystick_heading_nullzone    string = "sim/joystick/joystick_heading_nullzone"    // float, writable
+	SimJoystick_joystick_pitch_center        string = "sim/joystick/joystick_pitch_center"        // float, writable
+	SimJoystick_joystick_roll_center         string = "sim/joystick/joystick_roll_center"         // float, writable
+	SimJoystick_joystick_heading_center      string = "sim/joystick/joystick_heading_center"      // float, writable
+	SimJoystick_joystick_pitch_sensitivity   string = "sim/joystick/joystick_pitch_sensitivity"   // float, writable
+	SimJoystick_joystick_roll_sensitivity    string = "sim/joystick/joystick_roll_sensitivity"    // float, writable
+	SimJoystick_joystick_heading_sensitivity string = "sim/joystick/joystick_heading_sensitivity" // float, writable
+	SimJoystick_joystick_axis_assignments    string = "sim/joystick/joystick_axis_assignments"    // int_array, writable
+	SimJoystick_joystick_button_assignments  string = "sim/joystick/joystick_button_assignments"  // int_array, writable
+	SimJoystick_joystick_axis_reverse        string = "sim/joystick/joystick_axis_reverse"        // int_array, writable
+	SimJoystick_joystick_axis_values         string = "sim/joystick/joystick_axis_values"         // float_array
+	SimJoystick_joystick_axis_minimum        string = "sim/joystick/joystick_axis_minimum"        // float_array, writable
+	SimJoystick_joystick_axis_maximum        string = "sim/joystick/joystick_axis_maximum"        // float_array, writable
+	SimJoystick_joystick_button_values       string = "sim/joystick/joystick_button_values"       // int_array
+	SimJoystick_joy_mapped_axis_avail        string = "sim/joystick/joy_mapped_axis_avail"        // int_array
+	SimJoystick_joy_mapped_axis_value        string = "sim/joystick/joy_mapped_axis_value"        // float_array
+	SimJoystick_eq_ped_nobrk                 string = "sim/joystick/eq_ped_nobrk"                 // int, writable
+	SimJoystick_eq_ped_wibrk                 string = "sim/joystick/eq_ped_wibrk"                 // int, writable
+	SimJoystick_eq_pfc_pedals                string = "sim/joystick/eq_pfc_pedals"                // int, writable
+	SimJoystick_eq_pfc_yoke                  string = "sim/joystick/eq_pfc_yoke"                  // int, writable
+	SimJoystick_eq_pfc_throt                 string = "sim/joystick/eq_pfc_throt"                 // int, writable
+	SimJoystick_eq_pfc_avio                  string = "sim/joystick/eq_pfc_avio"                  // int, writable
+	SimJoystick_eq_pfc_centercon             string = "sim/joystick/eq_pfc_centercon"             // int, writable
+	SimJoystick_eq_pfc_elec_trim             string = "sim/joystick/eq_pfc_elec_trim"             // int, writable
+	SimJoystick_eq_pfc_brake_tog             string = "sim/joystick/eq_pfc_brake_tog"             // int, writable
+	SimJoystick_eq_pfc_dual_cowl             string = "sim/joystick/eq_pfc_dual_cowl"             // int, writable
+	SimJoystick_fire_key_is_down             string = "sim/joystick/fire_key_is_down"             // int, writable
+	SimJoystick_has_certified_roll           string = "sim/joystick/has_certified_roll"           // int, writable
+	SimJoystick_has_certified_pitch          string = "sim/joystick/has_certified_pitch"          // int, writable
+	SimJoystick_has_certified_heading        string = "sim/joystick/has_certified_heading"        // int, writable
+	SimJoystick_has_certified_brakes         string = "sim/joystick/has_certified_brakes"         // int, writable
+	SimJoystick_has_certified_throttle       string = "sim/joystick/has_certified_throttle"       // int, writable
+	SimJoystick_has_certified_prop           string = "sim/joystick/has_certified_prop"           // int, writable
+	SimJoystick_has_certified_mixture        string = "sim/joystick/has_certified_mixture"        // int, writable
+	SimJoystick_priority_side                string = "sim/joystick/priority_side"                // int, writable
+)
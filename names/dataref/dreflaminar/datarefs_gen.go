@@ -0,0 +1,24 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package dreflaminar provides the known dataref names under laminar/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package dreflaminar
+
+const (
+	LaminarC172_knob_TAS                 string = "laminar/c172/knob_TAS"                  // double, writable
+	LaminarC172_knob_EGT                 string = "laminar/c172/knob_EGT"                  // double, writable
+	LaminarC172_knob_OAT                 string = "laminar/c172/knob_OAT"                  // double, writable
+	LaminarC172_flap_side_shift          string = "laminar/c172/flap_side_shift"           // double, writable
+	LaminarC172Electrical_battery_amps   string = "laminar/c172/electrical/battery_amps"   // double
+	LaminarC172Fuel_fuel_tank_selector   string = "laminar/c172/fuel/fuel_tank_selector"   // double
+	LaminarC172Fuel_fuel_cutoff_selector string = "laminar/c172/fuel/fuel_cutoff_selector" // double
+	LaminarC172Fuel_fuel_quantity_L      string = "laminar/c172/fuel/fuel_quantity_L"      // double
+	LaminarC172Fuel_fuel_quantity_R      string = "laminar/c172/fuel/fuel_quantity_R"      // double
+	LaminarC172Sound_radio_volume_pilot  string = "laminar/C172/sound/radio_volume_pilot"  // float_array
+)
@@ -0,0 +1,568 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefmultiplayer provides the known dataref names under sim/multiplayer/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefmultiplayer
+
+const (
+	SimMultiplayerControls_yoke_pitch_ratio          string = "sim/multiplayer/controls/yoke_pitch_ratio"          // float_array, writable
+	SimMultiplayerControls_yoke_roll_ratio           string = "sim/multiplayer/controls/yoke_roll_ratio"           // float_array, writable
+	SimMultiplayerControls_yoke_heading_ratio        string = "sim/multiplayer/controls/yoke_heading_ratio"        // float_array, writable
+	SimMultiplayerControls_gear_request              string = "sim/multiplayer/controls/gear_request"              // int_array, writable
+	SimMultiplayerControls_flap_request              string = "sim/multiplayer/controls/flap_request"              // float_array, writable
+	SimMultiplayerControls_speed_brake_request       string = "sim/multiplayer/controls/speed_brake_request"       // float_array, writable
+	SimMultiplayerControls_vector_request            string = "sim/multiplayer/controls/vector_request"            // float_array, writable
+	SimMultiplayerControls_sweep__request            string = "sim/multiplayer/controls/sweep__request"            // float_array, writable
+	SimMultiplayerControls_incidence_request         string = "sim/multiplayer/controls/incidence_request"         // float_array, writable
+	SimMultiplayerControls_dihedral_request          string = "sim/multiplayer/controls/dihedral_request"          // float_array, writable
+	SimMultiplayerControls_tail_lock_ratio           string = "sim/multiplayer/controls/tail_lock_ratio"           // float_array, writable
+	SimMultiplayerControls_l_brake_add               string = "sim/multiplayer/controls/l_brake_add"               // float_array, writable
+	SimMultiplayerControls_r_brake_add               string = "sim/multiplayer/controls/r_brake_add"               // float_array, writable
+	SimMultiplayerControls_parking_brake             string = "sim/multiplayer/controls/parking_brake"             // float_array, writable
+	SimMultiplayerControls_aileron_trim              string = "sim/multiplayer/controls/aileron_trim"              // float_array, writable
+	SimMultiplayerControls_elevator_trim             string = "sim/multiplayer/controls/elevator_trim"             // float_array, writable
+	SimMultiplayerControls_rudder_trim               string = "sim/multiplayer/controls/rudder_trim"               // float_array, writable
+	SimMultiplayerControls_engine_throttle_request   string = "sim/multiplayer/controls/engine_throttle_request"   // float_array, writable
+	SimMultiplayerControls_engine_prop_request       string = "sim/multiplayer/controls/engine_prop_request"       // float_array, writable
+	SimMultiplayerControls_engine_pitch_request      string = "sim/multiplayer/controls/engine_pitch_request"      // float_array, writable
+	SimMultiplayerControls_engine_mixture_request    string = "sim/multiplayer/controls/engine_mixture_request"    // float_array, writable
+	SimMultiplayerPosition_plane1_x                  string = "sim/multiplayer/position/plane1_x"                  // double, writable
+	SimMultiplayerPosition_plane1_y                  string = "sim/multiplayer/position/plane1_y"                  // double, writable
+	SimMultiplayerPosition_plane1_z                  string = "sim/multiplayer/position/plane1_z"                  // double, writable
+	SimMultiplayerPosition_plane1_the                string = "sim/multiplayer/position/plane1_the"                // float, writable
+	SimMultiplayerPosition_plane1_phi                string = "sim/multiplayer/position/plane1_phi"                // float, writable
+	SimMultiplayerPosition_plane1_psi                string = "sim/multiplayer/position/plane1_psi"                // float, writable
+	SimMultiplayerPosition_plane1_gear_deploy        string = "sim/multiplayer/position/plane1_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane1_flap_ratio         string = "sim/multiplayer/position/plane1_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane1_flap_ratio2        string = "sim/multiplayer/position/plane1_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane1_spoiler_ratio      string = "sim/multiplayer/position/plane1_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane1_speedbrake_ratio   string = "sim/multiplayer/position/plane1_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane1_slat_ratio         string = "sim/multiplayer/position/plane1_slat_ratio"         // float, writable
+	SimMultiplayerPosition_plane1_wing_sweep         string = "sim/multiplayer/position/plane1_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane1_throttle           string = "sim/multiplayer/position/plane1_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane1_yolk_pitch         string = "sim/multiplayer/position/plane1_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane1_yolk_roll          string = "sim/multiplayer/position/plane1_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane1_yolk_yaw           string = "sim/multiplayer/position/plane1_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane2_x                  string = "sim/multiplayer/position/plane2_x"                  // double, writable
+	SimMultiplayerPosition_plane2_y                  string = "sim/multiplayer/position/plane2_y"                  // double, writable
+	SimMultiplayerPosition_plane2_z                  string = "sim/multiplayer/position/plane2_z"                  // double, writable
+	SimMultiplayerPosition_plane2_the                string = "sim/multiplayer/position/plane2_the"                // float, writable
+	SimMultiplayerPosition_plane2_phi                string = "sim/multiplayer/position/plane2_phi"                // float, writable
+	SimMultiplayerPosition_plane2_psi                string = "sim/multiplayer/position/plane2_psi"                // float, writable
+	SimMultiplayerPosition_plane2_gear_deploy        string = "sim/multiplayer/position/plane2_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane2_flap_ratio         string = "sim/multiplayer/position/plane2_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane2_flap_ratio2        string = "sim/multiplayer/position/plane2_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane2_spoiler_ratio      string = "sim/multiplayer/position/plane2_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane2_speedbrake_ratio   string = "sim/multiplayer/position/plane2_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane2_sla1_ratio         string = "sim/multiplayer/position/plane2_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane2_wing_sweep         string = "sim/multiplayer/position/plane2_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane2_throttle           string = "sim/multiplayer/position/plane2_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane2_yolk_pitch         string = "sim/multiplayer/position/plane2_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane2_yolk_roll          string = "sim/multiplayer/position/plane2_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane2_yolk_yaw           string = "sim/multiplayer/position/plane2_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane3_x                  string = "sim/multiplayer/position/plane3_x"                  // double, writable
+	SimMultiplayerPosition_plane3_y                  string = "sim/multiplayer/position/plane3_y"                  // double, writable
+	SimMultiplayerPosition_plane3_z                  string = "sim/multiplayer/position/plane3_z"                  // double, writable
+	SimMultiplayerPosition_plane3_the                string = "sim/multiplayer/position/plane3_the"                // float, writable
+	SimMultiplayerPosition_plane3_phi                string = "sim/multiplayer/position/plane3_phi"                // float, writable
+	SimMultiplayerPosition_plane3_psi                string = "sim/multiplayer/position/plane3_psi"                // float, writable
+	SimMultiplayerPosition_plane3_gear_deploy        string = "sim/multiplayer/position/plane3_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane3_flap_ratio         string = "sim/multiplayer/position/plane3_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane3_flap_ratio2        string = "sim/multiplayer/position/plane3_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane3_spoiler_ratio      string = "sim/multiplayer/position/plane3_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane3_speedbrake_ratio   string = "sim/multiplayer/position/plane3_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane3_sla1_ratio         string = "sim/multiplayer/position/plane3_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane3_wing_sweep         string = "sim/multiplayer/position/plane3_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane3_throttle           string = "sim/multiplayer/position/plane3_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane3_yolk_pitch         string = "sim/multiplayer/position/plane3_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane3_yolk_roll          string = "sim/multiplayer/position/plane3_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane3_yolk_yaw           string = "sim/multiplayer/position/plane3_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane4_x                  string = "sim/multiplayer/position/plane4_x"                  // double, writable
+	SimMultiplayerPosition_plane4_y                  string = "sim/multiplayer/position/plane4_y"                  // double, writable
+	SimMultiplayerPosition_plane4_z                  string = "sim/multiplayer/position/plane4_z"                  // double, writable
+	SimMultiplayerPosition_plane4_the                string = "sim/multiplayer/position/plane4_the"                // float, writable
+	SimMultiplayerPosition_plane4_phi                string = "sim/multiplayer/position/plane4_phi"                // float, writable
+	SimMultiplayerPosition_plane4_psi                string = "sim/multiplayer/position/plane4_psi"                // float, writable
+	SimMultiplayerPosition_plane4_gear_deploy        string = "sim/multiplayer/position/plane4_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane4_flap_ratio         string = "sim/multiplayer/position/plane4_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane4_flap_ratio2        string = "sim/multiplayer/position/plane4_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane4_spoiler_ratio      string = "sim/multiplayer/position/plane4_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane4_speedbrake_ratio   string = "sim/multiplayer/position/plane4_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane4_sla1_ratio         string = "sim/multiplayer/position/plane4_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane4_wing_sweep         string = "sim/multiplayer/position/plane4_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane4_throttle           string = "sim/multiplayer/position/plane4_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane4_yolk_pitch         string = "sim/multiplayer/position/plane4_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane4_yolk_roll          string = "sim/multiplayer/position/plane4_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane4_yolk_yaw           string = "sim/multiplayer/position/plane4_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane5_x                  string = "sim/multiplayer/position/plane5_x"                  // double, writable
+	SimMultiplayerPosition_plane5_y                  string = "sim/multiplayer/position/plane5_y"                  // double, writable
+	SimMultiplayerPosition_plane5_z                  string = "sim/multiplayer/position/plane5_z"                  // double, writable
+	SimMultiplayerPosition_plane5_the                string = "sim/multiplayer/position/plane5_the"                // float, writable
+	SimMultiplayerPosition_plane5_phi                string = "sim/multiplayer/position/plane5_phi"                // float, writable
+	SimMultiplayerPosition_plane5_psi                string = "sim/multiplayer/position/plane5_psi"                // float, writable
+	SimMultiplayerPosition_plane5_gear_deploy        string = "sim/multiplayer/position/plane5_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane5_flap_ratio         string = "sim/multiplayer/position/plane5_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane5_flap_ratio2        string = "sim/multiplayer/position/plane5_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane5_spoiler_ratio      string = "sim/multiplayer/position/plane5_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane5_speedbrake_ratio   string = "sim/multiplayer/position/plane5_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane5_sla1_ratio         string = "sim/multiplayer/position/plane5_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane5_wing_sweep         string = "sim/multiplayer/position/plane5_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane5_throttle           string = "sim/multiplayer/position/plane5_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane5_yolk_pitch         string = "sim/multiplayer/position/plane5_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane5_yolk_roll          string = "sim/multiplayer/position/plane5_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane5_yolk_yaw           string = "sim/multiplayer/position/plane5_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane6_x                  string = "sim/multiplayer/position/plane6_x"                  // double, writable
+	SimMultiplayerPosition_plane6_y                  string = "sim/multiplayer/position/plane6_y"                  // double, writable
+	SimMultiplayerPosition_plane6_z                  string = "sim/multiplayer/position/plane6_z"                  // double, writable
+	SimMultiplayerPosition_plane6_the                string = "sim/multiplayer/position/plane6_the"                // float, writable
+	SimMultiplayerPosition_plane6_phi                string = "sim/multiplayer/position/plane6_phi"                // float, writable
+	SimMultiplayerPosition_plane6_psi                string = "sim/multiplayer/position/plane6_psi"                // float, writable
+	SimMultiplayerPosition_plane6_gear_deploy        string = "sim/multiplayer/position/plane6_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane6_flap_ratio         string = "sim/multiplayer/position/plane6_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane6_flap_ratio2        string = "sim/multiplayer/position/plane6_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane6_spoiler_ratio      string = "sim/multiplayer/position/plane6_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane6_speedbrake_ratio   string = "sim/multiplayer/position/plane6_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane6_sla1_ratio         string = "sim/multiplayer/position/plane6_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane6_wing_sweep         string = "sim/multiplayer/position/plane6_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane6_throttle           string = "sim/multiplayer/position/plane6_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane6_yolk_pitch         string = "sim/multiplayer/position/plane6_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane6_yolk_roll          string = "sim/multiplayer/position/plane6_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane6_yolk_yaw           string = "sim/multiplayer/position/plane6_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane7_x                  string = "sim/multiplayer/position/plane7_x"                  // double, writable
+	SimMultiplayerPosition_plane7_y                  string = "sim/multiplayer/position/plane7_y"                  // double, writable
+	SimMultiplayerPosition_plane7_z                  string = "sim/multiplayer/position/plane7_z"                  // double, writable
+	SimMultiplayerPosition_plane7_the                string = "sim/multiplayer/position/plane7_the"                // float, writable
+	SimMultiplayerPosition_plane7_phi                string = "sim/multiplayer/position/plane7_phi"                // float, writable
+	SimMultiplayerPosition_plane7_psi                string = "sim/multiplayer/position/plane7_psi"                // float, writable
+	SimMultiplayerPosition_plane7_gear_deploy        string = "sim/multiplayer/position/plane7_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane7_flap_ratio         string = "sim/multiplayer/position/plane7_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane7_flap_ratio2        string = "sim/multiplayer/position/plane7_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane7_spoiler_ratio      string = "sim/multiplayer/position/plane7_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane7_speedbrake_ratio   string = "sim/multiplayer/position/plane7_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane7_sla1_ratio         string = "sim/multiplayer/position/plane7_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane7_wing_sweep         string = "sim/multiplayer/position/plane7_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane7_throttle           string = "sim/multiplayer/position/plane7_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane7_yolk_pitch         string = "sim/multiplayer/position/plane7_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane7_yolk_roll          string = "sim/multiplayer/position/plane7_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane7_yolk_yaw           string = "sim/multiplayer/position/plane7_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane8_x                  string = "sim/multiplayer/position/plane8_x"                  // double, writable
+	SimMultiplayerPosition_plane8_y                  string = "sim/multiplayer/position/plane8_y"                  // double, writable
+	SimMultiplayerPosition_plane8_z                  string = "sim/multiplayer/position/plane8_z"                  // double, writable
+	SimMultiplayerPosition_plane8_the                string = "sim/multiplayer/position/plane8_the"                // float, writable
+	SimMultiplayerPosition_plane8_phi                string = "sim/multiplayer/position/plane8_phi"                // float, writable
+	SimMultiplayerPosition_plane8_psi                string = "sim/multiplayer/position/plane8_psi"                // float, writable
+	SimMultiplayerPosition_plane8_gear_deploy        string = "sim/multiplayer/position/plane8_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane8_flap_ratio         string = "sim/multiplayer/position/plane8_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane8_flap_ratio2        string = "sim/multiplayer/position/plane8_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane8_spoiler_ratio      string = "sim/multiplayer/position/plane8_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane8_speedbrake_ratio   string = "sim/multiplayer/position/plane8_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane8_sla1_ratio         string = "sim/multiplayer/position/plane8_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane8_wing_sweep         string = "sim/multiplayer/position/plane8_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane8_throttle           string = "sim/multiplayer/position/plane8_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane8_yolk_pitch         string = "sim/multiplayer/position/plane8_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane8_yolk_roll          string = "sim/multiplayer/position/plane8_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane8_yolk_yaw           string = "sim/multiplayer/position/plane8_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane9_x                  string = "sim/multiplayer/position/plane9_x"                  // double, writable
+	SimMultiplayerPosition_plane9_y                  string = "sim/multiplayer/position/plane9_y"                  // double, writable
+	SimMultiplayerPosition_plane9_z                  string = "sim/multiplayer/position/plane9_z"                  // double, writable
+	SimMultiplayerPosition_plane9_the                string = "sim/multiplayer/position/plane9_the"                // float, writable
+	SimMultiplayerPosition_plane9_phi                string = "sim/multiplayer/position/plane9_phi"                // float, writable
+	SimMultiplayerPosition_plane9_psi                string = "sim/multiplayer/position/plane9_psi"                // float, writable
+	SimMultiplayerPosition_plane9_gear_deploy        string = "sim/multiplayer/position/plane9_gear_deploy"        // float_array, writable
+	SimMultiplayerPosition_plane9_flap_ratio         string = "sim/multiplayer/position/plane9_flap_ratio"         // float, writable
+	SimMultiplayerPosition_plane9_flap_ratio2        string = "sim/multiplayer/position/plane9_flap_ratio2"        // float, writable
+	SimMultiplayerPosition_plane9_spoiler_ratio      string = "sim/multiplayer/position/plane9_spoiler_ratio"      // float, writable
+	SimMultiplayerPosition_plane9_speedbrake_ratio   string = "sim/multiplayer/position/plane9_speedbrake_ratio"   // float, writable
+	SimMultiplayerPosition_plane9_sla1_ratio         string = "sim/multiplayer/position/plane9_sla1_ratio"         // float, writable
+	SimMultiplayerPosition_plane9_wing_sweep         string = "sim/multiplayer/position/plane9_wing_sweep"         // float, writable
+	SimMultiplayerPosition_plane9_throttle           string = "sim/multiplayer/position/plane9_throttle"           // float_array, writable
+	SimMultiplayerPosition_plane9_yolk_pitch         string = "sim/multiplayer/position/plane9_yolk_pitch"         // float, writable
+	SimMultiplayerPosition_plane9_yolk_roll          string = "sim/multiplayer/position/plane9_yolk_roll"          // float, writable
+	SimMultiplayerPosition_plane9_yolk_yaw           string = "sim/multiplayer/position/plane9_yolk_yaw"           // float, writable
+	SimMultiplayerPosition_plane1_lat                string = "sim/multiplayer/position/plane1_lat"                // double
+	SimMultiplayerPosition_plane1_lon                string = "sim/multiplayer/position/plane1_lon"                // double
+	SimMultiplayerPosition_plane1_el                 string = "sim/multiplayer/position/plane1_el"                 // double
+	SimMultiplayerPosition_plane1_v_x                string = "sim/multiplayer/position/plane1_v_x"                // float, writable
+	SimMultiplayerPosition_plane1_v_y                string = "sim/multiplayer/position/plane1_v_y"                // float, writable
+	SimMultiplayerPosition_plane1_v_z                string = "sim/multiplayer/position/plane1_v_z"                // float, writable
+	SimMultiplayerPosition_plane2_lat                string = "sim/multiplayer/position/plane2_lat"                // double
+	SimMultiplayerPosition_plane2_lon                string = "sim/multiplayer/position/plane2_lon"                // double
+	SimMultiplayerPosition_plane2_el                 string = "sim/multiplayer/position/plane2_el"                 // double
+	SimMultiplayerPosition_plane2_v_x                string = "sim/multiplayer/position/plane2_v_x"                // float, writable
+	SimMultiplayerPosition_plane2_v_y                string = "sim/multiplayer/position/plane2_v_y"                // float, writable
+	SimMultiplayerPosition_plane2_v_z                string = "sim/multiplayer/position/plane2_v_z"                // float, writable
+	SimMultiplayerPosition_plane3_lat                string = "sim/multiplayer/position/plane3_lat"                // double
+	SimMultiplayerPosition_plane3_lon                string = "sim/multiplayer/position/plane3_lon"                // double
+	SimMultiplayerPosition_plane3_el                 string = "sim/multiplayer/position/plane3_el"                 // double
+	SimMultiplayerPosition_plane3_v_x                string = "sim/multiplayer/position/plane3_v_x"                // float, writable
+	SimMultiplayerPosition_plane3_v_y                string = "sim/multiplayer/position/plane3_v_y"                // float, writable
+	SimMultiplayerPosition_plane3_v_z                string = "sim/multiplayer/position/plane3_v_z"                // float, writable
+	SimMultiplayerPosition_plane4_lat                string = "sim/multiplayer/position/plane4_lat"                // double
+	SimMultiplayerPosition_plane4_lon                string = "sim/multiplayer/position/plane4_lon"                // double
+	SimMultiplayerPosition_plane4_el                 string = "sim/multiplayer/position/plane4_el"                 // double
+	SimMultiplayerPosition_plane4_v_x                string = "sim/multiplayer/position/plane4_v_x"                // float, writable
+	SimMultiplayerPosition_plane4_v_y                string = "sim/multiplayer/position/plane4_v_y"                // float, writable
+	SimMultiplayerPosition_plane4_v_z                string = "sim/multiplayer/position/plane4_v_z"                // float, writable
+	SimMultiplayerPosition_plane5_lat                string = "sim/multiplayer/position/plane5_lat"                // double
+	SimMultiplayerPosition_plane5_lon                string = "sim/multiplayer/position/plane5_lon"                // double
+	SimMultiplayerPosition_plane5_el                 string = "sim/multiplayer/position/plane5_el"                 // double
+	SimMultiplayerPosition_plane5_v_x                string = "sim/multiplayer/position/plane5_v_x"                // float, writable
+	SimMultiplayerPosition_plane5_v_y                string = "sim/multiplayer/position/plane5_v_y"                // float, writable
+	SimMultiplayerPosition_plane5_v_z                string = "sim/multiplayer/position/plane5_v_z"                // float, writable
+	SimMultiplayerPosition_plane6_lat                string = "sim/multiplayer/position/plane6_lat"                // double
+	SimMultiplayerPosition_plane6_lon                string = "sim/multiplayer/position/plane6_lon"                // double
+	SimMultiplayerPosition_plane6_el                 string = "sim/multiplayer/position/plane6_el"                 // double
+	SimMultiplayerPosition_plane6_v_x                string = "sim/multiplayer/position/plane6_v_x"                // float, writable
+	SimMultiplayerPosition_plane6_v_y                string = "sim/multiplayer/position/plane6_v_y"                // float, writable
+	SimMultiplayerPosition_plane6_v_z                string = "sim/multiplayer/position/plane6_v_z"                // float, writable
+	SimMultiplayerPosition_plane7_lat                string = "sim/multiplayer/position/plane7_lat"                // double
+	SimMultiplayerPosition_plane7_lon                string = "sim/multiplayer/position/plane7_lon"                // double
+	SimMultiplayerPosition_plane7_el                 string = "sim/multiplayer/position/plane7_el"                 // double
+	SimMultiplayerPosition_plane7_v_x                string = "sim/multiplayer/position/plane7_v_x"                // float, writable
+	SimMultiplayerPosition_plane7_v_y                string = "sim/multiplayer/position/plane7_v_y"                // float, writable
+	SimMultiplayerPosition_plane7_v_z                string = "sim/multiplayer/position/plane7_v_z"                // float, writable
+	SimMultiplayerPosition_plane8_lat                string = "sim/multiplayer/position/plane8_lat"                // double
+	SimMultiplayerPosition_plane8_lon                string = "sim/multiplayer/position/plane8_lon"                // double
+	SimMultiplayerPosition_plane8_el                 string = "sim/multiplayer/position/plane8_el"                 // double
+	SimMultiplayerPosition_plane8_v_x                string = "sim/multiplayer/position/plane8_v_x"                // float, writable
+	SimMultiplayerPosition_plane8_v_y                string = "sim/multiplayer/position/plane8_v_y"                // float, writable
+	SimMultiplayerPosition_plane8_v_z                string = "sim/multiplayer/position/plane8_v_z"                // float, writable
+	SimMultiplayerPosition_plane9_lat                string = "sim/multiplayer/position/plane9_lat"                // double
+	SimMultiplayerPosition_plane9_lon                string = "sim/multiplayer/position/plane9_lon"                // double
+	SimMultiplayerPosition_plane9_el                 string = "sim/multiplayer/position/plane9_el"                 // double
+	SimMultiplayerPosition_plane9_v_x                string = "sim/multiplayer/position/plane9_v_x"                // float, writable
+	SimMultiplayerPosition_plane9_v_y                string = "sim/multiplayer/position/plane9_v_y"                // float, writable
+	SimMultiplayerPosition_plane9_v_z                string = "sim/multiplayer/position/plane9_v_z"                // float, writable
+	SimMultiplayerPosition_plane1_beacon_lights_on   string = "sim/multiplayer/position/plane1_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane1_landing_lights_on  string = "sim/multiplayer/position/plane1_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane1_nav_lights_on      string = "sim/multiplayer/position/plane1_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane1_strobe_lights_on   string = "sim/multiplayer/position/plane1_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane1_taxi_light_on      string = "sim/multiplayer/position/plane1_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane2_beacon_lights_on   string = "sim/multiplayer/position/plane2_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane2_landing_lights_on  string = "sim/multiplayer/position/plane2_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane2_nav_lights_on      string = "sim/multiplayer/position/plane2_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane2_strobe_lights_on   string = "sim/multiplayer/position/plane2_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane2_taxi_light_on      string = "sim/multiplayer/position/plane2_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane3_beacon_lights_on   string = "sim/multiplayer/position/plane3_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane3_landing_lights_on  string = "sim/multiplayer/position/plane3_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane3_nav_lights_on      string = "sim/multiplayer/position/plane3_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane3_strobe_lights_on   string = "sim/multiplayer/position/plane3_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane3_taxi_light_on      string = "sim/multiplayer/position/plane3_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane4_beacon_lights_on   string = "sim/multiplayer/position/plane4_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane4_landing_lights_on  string = "sim/multiplayer/position/plane4_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane4_nav_lights_on      string = "sim/multiplayer/position/plane4_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane4_strobe_lights_on   string = "sim/multiplayer/position/plane4_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane4_taxi_light_on      string = "sim/multiplayer/position/plane4_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane5_beacon_lights_on   string = "sim/multiplayer/position/plane5_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane5_landing_lights_on  string = "sim/multiplayer/position/plane5_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane5_nav_lights_on      string = "sim/multiplayer/position/plane5_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane5_strobe_lights_on   string = "sim/multiplayer/position/plane5_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane5_taxi_light_on      string = "sim/multiplayer/position/plane5_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane6_beacon_lights_on   string = "sim/multiplayer/position/plane6_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane6_landing_lights_on  string = "sim/multiplayer/position/plane6_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane6_nav_lights_on      string = "sim/multiplayer/position/plane6_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane6_strobe_lights_on   string = "sim/multiplayer/position/plane6_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane6_taxi_light_on      string = "sim/multiplayer/position/plane6_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane7_beacon_lights_on   string = "sim/multiplayer/position/plane7_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane7_landing_lights_on  string = "sim/multiplayer/position/plane7_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane7_nav_lights_on      string = "sim/multiplayer/position/plane7_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane7_strobe_lights_on   string = "sim/multiplayer/position/plane7_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane7_taxi_light_on      string = "sim/multiplayer/position/plane7_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane8_beacon_lights_on   string = "sim/multiplayer/position/plane8_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane8_landing_lights_on  string = "sim/multiplayer/position/plane8_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane8_nav_lights_on      string = "sim/multiplayer/position/plane8_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane8_strobe_lights_on   string = "sim/multiplayer/position/plane8_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane8_taxi_light_on      string = "sim/multiplayer/position/plane8_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane9_beacon_lights_on   string = "sim/multiplayer/position/plane9_beacon_lights_on"   // int, writable
+	SimMultiplayerPosition_plane9_landing_lights_on  string = "sim/multiplayer/position/plane9_landing_lights_on"  // int, writable
+	SimMultiplayerPosition_plane9_nav_lights_on      string = "sim/multiplayer/position/plane9_nav_lights_on"      // int, writable
+	SimMultiplayerPosition_plane9_strobe_lights_on   string = "sim/multiplayer/position/plane9_strobe_lights_on"   // int, writable
+	SimMultiplayerPosition_plane9_taxi_light_on      string = "sim/multiplayer/position/plane9_taxi_light_on"      // int, writable
+	SimMultiplayerPosition_plane10_beacon_lights_on  string = "sim/multiplayer/position/plane10_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane10_landing_lights_on string = "sim/multiplayer/position/plane10_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane10_nav_lights_on     string = "sim/multiplayer/position/plane10_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane10_strobe_lights_on  string = "sim/multiplayer/position/plane10_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane10_taxi_light_on     string = "sim/multiplayer/position/plane10_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane11_beacon_lights_on  string = "sim/multiplayer/position/plane11_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane11_landing_lights_on string = "sim/multiplayer/position/plane11_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane11_nav_lights_on     string = "sim/multiplayer/position/plane11_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane11_strobe_lights_on  string = "sim/multiplayer/position/plane11_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane11_taxi_light_on     string = "sim/multiplayer/position/plane11_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane12_beacon_lights_on  string = "sim/multiplayer/position/plane12_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane12_landing_lights_on string = "sim/multiplayer/position/plane12_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane12_nav_lights_on     string = "sim/multiplayer/position/plane12_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane12_strobe_lights_on  string = "sim/multiplayer/position/plane12_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane12_taxi_light_on     string = "sim/multiplayer/position/plane12_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane13_beacon_lights_on  string = "sim/multiplayer/position/plane13_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane13_landing_lights_on string = "sim/multiplayer/position/plane13_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane13_nav_lights_on     string = "sim/multiplayer/position/plane13_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane13_strobe_lights_on  string = "sim/multiplayer/position/plane13_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane13_taxi_light_on     string = "sim/multiplayer/position/plane13_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane14_beacon_lights_on  string = "sim/multiplayer/position/plane14_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane14_landing_lights_on string = "sim/multiplayer/position/plane14_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane14_nav_lights_on     string = "sim/multiplayer/position/plane14_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane14_strobe_lights_on  string = "sim/multiplayer/position/plane14_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane14_taxi_light_on     string = "sim/multiplayer/position/plane14_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane15_beacon_lights_on  string = "sim/multiplayer/position/plane15_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane15_landing_lights_on string = "sim/multiplayer/position/plane15_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane15_nav_lights_on     string = "sim/multiplayer/position/plane15_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane15_strobe_lights_on  string = "sim/multiplayer/position/plane15_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane15_taxi_light_on     string = "sim/multiplayer/position/plane15_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane16_beacon_lights_on  string = "sim/multiplayer/position/plane16_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane16_landing_lights_on string = "sim/multiplayer/position/plane16_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane16_nav_lights_on     string = "sim/multiplayer/position/plane16_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane16_strobe_lights_on  string = "sim/multiplayer/position/plane16_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane16_taxi_light_on     string = "sim/multiplayer/position/plane16_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane17_beacon_lights_on  string = "sim/multiplayer/position/plane17_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane17_landing_lights_on string = "sim/multiplayer/position/plane17_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane17_nav_lights_on     string = "sim/multiplayer/position/plane17_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane17_strobe_lights_on  string = "sim/multiplayer/position/plane17_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane17_taxi_light_on     string = "sim/multiplayer/position/plane17_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane18_beacon_lights_on  string = "sim/multiplayer/position/plane18_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane18_landing_lights_on string = "sim/multiplayer/position/plane18_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane18_nav_lights_on     string = "sim/multiplayer/position/plane18_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane18_strobe_lights_on  string = "sim/multiplayer/position/plane18_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane18_taxi_light_on     string = "sim/multiplayer/position/plane18_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane19_beacon_lights_on  string = "sim/multiplayer/position/plane19_beacon_lights_on"  // int, writable
+	SimMultiplayerPosition_plane19_landing_lights_on string = "sim/multiplayer/position/plane19_landing_lights_on" // int, writable
+	SimMultiplayerPosition_plane19_nav_lights_on     string = "sim/multiplayer/position/plane19_nav_lights_on"     // int, writable
+	SimMultiplayerPosition_plane19_strobe_lights_on  string = "sim/multiplayer/position/plane19_strobe_lights_on"  // int, writable
+	SimMultiplayerPosition_plane19_taxi_light_on     string = "sim/multiplayer/position/plane19_taxi_light_on"     // int, writable
+	SimMultiplayerPosition_plane10_x                 string = "sim/multiplayer/position/plane10_x"                 // double, writable
+	SimMultiplayerPosition_plane10_y                 string = "sim/multiplayer/position/plane10_y"                 // double, writable
+	SimMultiplayerPosition_plane10_z                 string = "sim/multiplayer/position/plane10_z"                 // double, writable
+	SimMultiplayerPosition_plane10_the               string = "sim/multiplayer/position/plane10_the"               // float, writable
+	SimMultiplayerPosition_plane10_phi               string = "sim/multiplayer/position/plane10_phi"               // float, writable
+	SimMultiplayerPosition_plane10_psi               string = "sim/multiplayer/position/plane10_psi"               // float, writable
+	SimMultiplayerPosition_plane10_gear_deploy       string = "sim/multiplayer/position/plane10_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane10_flap_ratio        string = "sim/multiplayer/position/plane10_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane10_flap_ratio2       string = "sim/multiplayer/position/plane10_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane10_spoiler_ratio     string = "sim/multiplayer/position/plane10_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane10_speedbrake_ratio  string = "sim/multiplayer/position/plane10_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane10_sla1_ratio        string = "sim/multiplayer/position/plane10_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane10_wing_sweep        string = "sim/multiplayer/position/plane10_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane10_throttle          string = "sim/multiplayer/position/plane10_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane10_yolk_pitch        string = "sim/multiplayer/position/plane10_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane10_yolk_roll         string = "sim/multiplayer/position/plane10_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane10_yolk_yaw          string = "sim/multiplayer/position/plane10_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane10_lat               string = "sim/multiplayer/position/plane10_lat"               // double
+	SimMultiplayerPosition_plane10_lon               string = "sim/multiplayer/position/plane10_lon"               // double
+	SimMultiplayerPosition_plane10_el                string = "sim/multiplayer/position/plane10_el"                // double
+	SimMultiplayerPosition_plane10_v_x               string = "sim/multiplayer/position/plane10_v_x"               // float, writable
+	SimMultiplayerPosition_plane10_v_y               string = "sim/multiplayer/position/plane10_v_y"               // float, writable
+	SimMultiplayerPosition_plane10_v_z               string = "sim/multiplayer/position/plane10_v_z"               // float, writable
+	SimMultiplayerPosition_plane11_x                 string = "sim/multiplayer/position/plane11_x"                 // double, writable
+	SimMultiplayerPosition_plane11_y                 string = "sim/multiplayer/position/plane11_y"                 // double, writable
+	SimMultiplayerPosition_plane11_z                 string = "sim/multiplayer/position/plane11_z"                 // double, writable
+	SimMultiplayerPosition_plane11_the               string = "sim/multiplayer/position/plane11_the"               // float, writable
+	SimMultiplayerPosition_plane11_phi               string = "sim/multiplayer/position/plane11_phi"               // float, writable
+	SimMultiplayerPosition_plane11_psi               string = "sim/multiplayer/position/plane11_psi"               // float, writable
+	SimMultiplayerPosition_plane11_gear_deploy       string = "sim/multiplayer/position/plane11_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane11_flap_ratio        string = "sim/multiplayer/position/plane11_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane11_flap_ratio2       string = "sim/multiplayer/position/plane11_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane11_spoiler_ratio     string = "sim/multiplayer/position/plane11_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane11_speedbrake_ratio  string = "sim/multiplayer/position/plane11_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane11_sla1_ratio        string = "sim/multiplayer/position/plane11_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane11_wing_sweep        string = "sim/multiplayer/position/plane11_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane11_throttle          string = "sim/multiplayer/position/plane11_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane11_yolk_pitch        string = "sim/multiplayer/position/plane11_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane11_yolk_roll         string = "sim/multiplayer/position/plane11_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane11_yolk_yaw          string = "sim/multiplayer/position/plane11_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane11_lat               string = "sim/multiplayer/position/plane11_lat"               // double
+	SimMultiplayerPosition_plane11_lon               string = "sim/multiplayer/position/plane11_lon"               // double
+	SimMultiplayerPosition_plane11_el                string = "sim/multiplayer/position/plane11_el"                // double
+	SimMultiplayerPosition_plane11_v_x               string = "sim/multiplayer/position/plane11_v_x"               // float, writable
+	SimMultiplayerPosition_plane11_v_y               string = "sim/multiplayer/position/plane11_v_y"               // float, writable
+	SimMultiplayerPosition_plane11_v_z               string = "sim/multiplayer/position/plane11_v_z"               // float, writable
+	SimMultiplayerPosition_plane12_x                 string = "sim/multiplayer/position/plane12_x"                 // double, writable
+	SimMultiplayerPosition_plane12_y                 string = "sim/multiplayer/position/plane12_y"                 // double, writable
+	SimMultiplayerPosition_plane12_z                 string = "sim/multiplayer/position/plane12_z"                 // double, writable
+	SimMultiplayerPosition_plane12_the               string = "sim/multiplayer/position/plane12_the"               // float, writable
+	SimMultiplayerPosition_plane12_phi               string = "sim/multiplayer/position/plane12_phi"               // float, writable
+	SimMultiplayerPosition_plane12_psi               string = "sim/multiplayer/position/plane12_psi"               // float, writable
+	SimMultiplayerPosition_plane12_gear_deploy       string = "sim/multiplayer/position/plane12_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane12_flap_ratio        string = "sim/multiplayer/position/plane12_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane12_flap_ratio2       string = "sim/multiplayer/position/plane12_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane12_spoiler_ratio     string = "sim/multiplayer/position/plane12_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane12_speedbrake_ratio  string = "sim/multiplayer/position/plane12_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane12_sla1_ratio        string = "sim/multiplayer/position/plane12_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane12_wing_sweep        string = "sim/multiplayer/position/plane12_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane12_throttle          string = "sim/multiplayer/position/plane12_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane12_yolk_pitch        string = "sim/multiplayer/position/plane12_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane12_yolk_roll         string = "sim/multiplayer/position/plane12_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane12_yolk_yaw          string = "sim/multiplayer/position/plane12_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane12_lat               string = "sim/multiplayer/position/plane12_lat"               // double
+	SimMultiplayerPosition_plane12_lon               string = "sim/multiplayer/position/plane12_lon"               // double
+	SimMultiplayerPosition_plane12_el                string = "sim/multiplayer/position/plane12_el"                // double
+	SimMultiplayerPosition_plane12_v_x               string = "sim/multiplayer/position/plane12_v_x"               // float, writable
+	SimMultiplayerPosition_plane12_v_y               string = "sim/multiplayer/position/plane12_v_y"               // float, writable
+	SimMultiplayerPosition_plane12_v_z               string = "sim/multiplayer/position/plane12_v_z"               // float, writable
+	SimMultiplayerPosition_plane13_x                 string = "sim/multiplayer/position/plane13_x"                 // double, writable
+	SimMultiplayerPosition_plane13_y                 string = "sim/multiplayer/position/plane13_y"                 // double, writable
+	SimMultiplayerPosition_plane13_z                 string = "sim/multiplayer/position/plane13_z"                 // double, writable
+	SimMultiplayerPosition_plane13_the               string = "sim/multiplayer/position/plane13_the"               // float, writable
+	SimMultiplayerPosition_plane13_phi               string = "sim/multiplayer/position/plane13_phi"               // float, writable
+	SimMultiplayerPosition_plane13_psi               string = "sim/multiplayer/position/plane13_psi"               // float, writable
+	SimMultiplayerPosition_plane13_gear_deploy       string = "sim/multiplayer/position/plane13_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane13_flap_ratio        string = "sim/multiplayer/position/plane13_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane13_flap_ratio2       string = "sim/multiplayer/position/plane13_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane13_spoiler_ratio     string = "sim/multiplayer/position/plane13_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane13_speedbrake_ratio  string = "sim/multiplayer/position/plane13_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane13_sla1_ratio        string = "sim/multiplayer/position/plane13_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane13_wing_sweep        string = "sim/multiplayer/position/plane13_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane13_throttle          string = "sim/multiplayer/position/plane13_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane13_yolk_pitch        string = "sim/multiplayer/position/plane13_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane13_yolk_roll         string = "sim/multiplayer/position/plane13_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane13_yolk_yaw          string = "sim/multiplayer/position/plane13_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane13_lat               string = "sim/multiplayer/position/plane13_lat"               // double
+	SimMultiplayerPosition_plane13_lon               string = "sim/multiplayer/position/plane13_lon"               // double
+	SimMultiplayerPosition_plane13_el                string = "sim/multiplayer/position/plane13_el"                // double
+	SimMultiplayerPosition_plane13_v_x               string = "sim/multiplayer/position/plane13_v_x"               // float, writable
+	SimMultiplayerPosition_plane13_v_y               string = "sim/multiplayer/position/plane13_v_y"               // float, writable
+	SimMultiplayerPosition_plane13_v_z               string = "sim/multiplayer/position/plane13_v_z"               // float, writable
+	SimMultiplayerPosition_plane14_x                 string = "sim/multiplayer/position/plane14_x"                 // double, writable
+	SimMultiplayerPosition_plane14_y                 string = "sim/multiplayer/position/plane14_y"                 // double, writable
+	SimMultiplayerPosition_plane14_z                 string = "sim/multiplayer/position/plane14_z"                 // double, writable
+	SimMultiplayerPosition_plane14_the               string = "sim/multiplayer/position/plane14_the"               // float, writable
+	SimMultiplayerPosition_plane14_phi               string = "sim/multiplayer/position/plane14_phi"               // float, writable
+	SimMultiplayerPosition_plane14_psi               string = "sim/multiplayer/position/plane14_psi"               // float, writable
+	SimMultiplayerPosition_plane14_gear_deploy       string = "sim/multiplayer/position/plane14_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane14_flap_ratio        string = "sim/multiplayer/position/plane14_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane14_flap_ratio2       string = "sim/multiplayer/position/plane14_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane14_spoiler_ratio     string = "sim/multiplayer/position/plane14_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane14_speedbrake_ratio  string = "sim/multiplayer/position/plane14_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane14_sla1_ratio        string = "sim/multiplayer/position/plane14_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane14_wing_sweep        string = "sim/multiplayer/position/plane14_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane14_throttle          string = "sim/multiplayer/position/plane14_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane14_yolk_pitch        string = "sim/multiplayer/position/plane14_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane14_yolk_roll         string = "sim/multiplayer/position/plane14_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane14_yolk_yaw          string = "sim/multiplayer/position/plane14_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane14_lat               string = "sim/multiplayer/position/plane14_lat"               // double
+	SimMultiplayerPosition_plane14_lon               string = "sim/multiplayer/position/plane14_lon"               // double
+	SimMultiplayerPosition_plane14_el                string = "sim/multiplayer/position/plane14_el"                // double
+	SimMultiplayerPosition_plane14_v_x               string = "sim/multiplayer/position/plane14_v_x"               // float, writable
+	SimMultiplayerPosition_plane14_v_y               string = "sim/multiplayer/position/plane14_v_y"               // float, writable
+	SimMultiplayerPosition_plane14_v_z               string = "sim/multiplayer/position/plane14_v_z"               // float, writable
+	SimMultiplayerPosition_plane15_x                 string = "sim/multiplayer/position/plane15_x"                 // double, writable
+	SimMultiplayerPosition_plane15_y                 string = "sim/multiplayer/position/plane15_y"                 // double, writable
+	SimMultiplayerPosition_plane15_z                 string = "sim/multiplayer/position/plane15_z"                 // double, writable
+	SimMultiplayerPosition_plane15_the               string = "sim/multiplayer/position/plane15_the"               // float, writable
+	SimMultiplayerPosition_plane15_phi               string = "sim/multiplayer/position/plane15_phi"               // float, writable
+	SimMultiplayerPosition_plane15_psi               string = "sim/multiplayer/position/plane15_psi"               // float, writable
+	SimMultiplayerPosition_plane15_gear_deploy       string = "sim/multiplayer/position/plane15_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane15_flap_ratio        string = "sim/multiplayer/position/plane15_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane15_flap_ratio2       string = "sim/multiplayer/position/plane15_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane15_spoiler_ratio     string = "sim/multiplayer/position/plane15_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane15_speedbrake_ratio  string = "sim/multiplayer/position/plane15_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane15_sla1_ratio        string = "sim/multiplayer/position/plane15_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane15_wing_sweep        string = "sim/multiplayer/position/plane15_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane15_throttle          string = "sim/multiplayer/position/plane15_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane15_yolk_pitch        string = "sim/multiplayer/position/plane15_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane15_yolk_roll         string = "sim/multiplayer/position/plane15_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane15_yolk_yaw          string = "sim/multiplayer/position/plane15_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane15_lat               string = "sim/multiplayer/position/plane15_lat"               // double
+	SimMultiplayerPosition_plane15_lon               string = "sim/multiplayer/position/plane15_lon"               // double
+	SimMultiplayerPosition_plane15_el                string = "sim/multiplayer/position/plane15_el"                // double
+	SimMultiplayerPosition_plane15_v_x               string = "sim/multiplayer/position/plane15_v_x"               // float, writable
+	SimMultiplayerPosition_plane15_v_y               string = "sim/multiplayer/position/plane15_v_y"               // float, writable
+	SimMultiplayerPosition_plane15_v_z               string = "sim/multiplayer/position/plane15_v_z"               // float, writable
+	SimMultiplayerPosition_plane16_x                 string = "sim/multiplayer/position/plane16_x"                 // double, writable
+	SimMultiplayerPosition_plane16_y                 string = "sim/multiplayer/position/plane16_y"                 // double, writable
+	SimMultiplayerPosition_plane16_z                 string = "sim/multiplayer/position/plane16_z"                 // double, writable
+	SimMultiplayerPosition_plane16_the               string = "sim/multiplayer/position/plane16_the"               // float, writable
+	SimMultiplayerPosition_plane16_phi               string = "sim/multiplayer/position/plane16_phi"               // float, writable
+	SimMultiplayerPosition_plane16_psi               string = "sim/multiplayer/position/plane16_psi"               // float, writable
+	SimMultiplayerPosition_plane16_gear_deploy       string = "sim/multiplayer/position/plane16_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane16_flap_ratio        string = "sim/multiplayer/position/plane16_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane16_flap_ratio2       string = "sim/multiplayer/position/plane16_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane16_spoiler_ratio     string = "sim/multiplayer/position/plane16_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane16_speedbrake_ratio  string = "sim/multiplayer/position/plane16_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane16_sla1_ratio        string = "sim/multiplayer/position/plane16_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane16_wing_sweep        string = "sim/multiplayer/position/plane16_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane16_throttle          string = "sim/multiplayer/position/plane16_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane16_yolk_pitch        string = "sim/multiplayer/position/plane16_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane16_yolk_roll         string = "sim/multiplayer/position/plane16_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane16_yolk_yaw          string = "sim/multiplayer/position/plane16_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane16_lat               string = "sim/multiplayer/position/plane16_lat"               // double
+	SimMultiplayerPosition_plane16_lon               string = "sim/multiplayer/position/plane16_lon"               // double
+	SimMultiplayerPosition_plane16_el                string = "sim/multiplayer/position/plane16_el"                // double
+	SimMultiplayerPosition_plane16_v_x               string = "sim/multiplayer/position/plane16_v_x"               // float, writable
+	SimMultiplayerPosition_plane16_v_y               string = "sim/multiplayer/position/plane16_v_y"               // float, writable
+	SimMultiplayerPosition_plane16_v_z               string = "sim/multiplayer/position/plane16_v_z"               // float, writable
+	SimMultiplayerPosition_plane17_x                 string = "sim/multiplayer/position/plane17_x"                 // double, writable
+	SimMultiplayerPosition_plane17_y                 string = "sim/multiplayer/position/plane17_y"                 // double, writable
+	SimMultiplayerPosition_plane17_z                 string = "sim/multiplayer/position/plane17_z"                 // double, writable
+	SimMultiplayerPosition_plane17_the               string = "sim/multiplayer/position/plane17_the"               // float, writable
+	SimMultiplayerPosition_plane17_phi               string = "sim/multiplayer/position/plane17_phi"               // float, writable
+	SimMultiplayerPosition_plane17_psi               string = "sim/multiplayer/position/plane17_psi"               // float, writable
+	SimMultiplayerPosition_plane17_gear_deploy       string = "sim/multiplayer/position/plane17_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane17_flap_ratio        string = "sim/multiplayer/position/plane17_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane17_flap_ratio2       string = "sim/multiplayer/position/plane17_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane17_spoiler_ratio     string = "sim/multiplayer/position/plane17_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane17_speedbrake_ratio  string = "sim/multiplayer/position/plane17_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane17_sla1_ratio        string = "sim/multiplayer/position/plane17_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane17_wing_sweep        string = "sim/multiplayer/position/plane17_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane17_throttle          string = "sim/multiplayer/position/plane17_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane17_yolk_pitch        string = "sim/multiplayer/position/plane17_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane17_yolk_roll         string = "sim/multiplayer/position/plane17_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane17_yolk_yaw          string = "sim/multiplayer/position/plane17_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane17_lat               string = "sim/multiplayer/position/plane17_lat"               // double
+	SimMultiplayerPosition_plane17_lon               string = "sim/multiplayer/position/plane17_lon"               // double
+	SimMultiplayerPosition_plane17_el                string = "sim/multiplayer/position/plane17_el"                // double
+	SimMultiplayerPosition_plane17_v_x               string = "sim/multiplayer/position/plane17_v_x"               // float, writable
+	SimMultiplayerPosition_plane17_v_y               string = "sim/multiplayer/position/plane17_v_y"               // float, writable
+	SimMultiplayerPosition_plane17_v_z               string = "sim/multiplayer/position/plane17_v_z"               // float, writable
+	SimMultiplayerPosition_plane18_x                 string = "sim/multiplayer/position/plane18_x"                 // double, writable
+	SimMultiplayerPosition_plane18_y                 string = "sim/multiplayer/position/plane18_y"                 // double, writable
+	SimMultiplayerPosition_plane18_z                 string = "sim/multiplayer/position/plane18_z"                 // double, writable
+	SimMultiplayerPosition_plane18_the               string = "sim/multiplayer/position/plane18_the"               // float, writable
+	SimMultiplayerPosition_plane18_phi               string = "sim/multiplayer/position/plane18_phi"               // float, writable
+	SimMultiplayerPosition_plane18_psi               string = "sim/multiplayer/position/plane18_psi"               // float, writable
+	SimMultiplayerPosition_plane18_gear_deploy       string = "sim/multiplayer/position/plane18_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane18_flap_ratio        string = "sim/multiplayer/position/plane18_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane18_flap_ratio2       string = "sim/multiplayer/position/plane18_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane18_spoiler_ratio     string = "sim/multiplayer/position/plane18_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane18_speedbrake_ratio  string = "sim/multiplayer/position/plane18_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane18_sla1_ratio        string = "sim/multiplayer/position/plane18_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane18_wing_sweep        string = "sim/multiplayer/position/plane18_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane18_throttle          string = "sim/multiplayer/position/plane18_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane18_yolk_pitch        string = "sim/multiplayer/position/plane18_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane18_yolk_roll         string = "sim/multiplayer/position/plane18_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane18_yolk_yaw          string = "sim/multiplayer/position/plane18_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane18_lat               string = "sim/multiplayer/position/plane18_lat"               // double
+	SimMultiplayerPosition_plane18_lon               string = "sim/multiplayer/position/plane18_lon"               // double
+	SimMultiplayerPosition_plane18_el                string = "sim/multiplayer/position/plane18_el"                // double
+	SimMultiplayerPosition_plane18_v_x               string = "sim/multiplayer/position/plane18_v_x"               // float, writable
+	SimMultiplayerPosition_plane18_v_y               string = "sim/multiplayer/position/plane18_v_y"               // float, writable
+	SimMultiplayerPosition_plane18_v_z               string = "sim/multiplayer/position/plane18_v_z"               // float, writable
+	SimMultiplayerPosition_plane19_x                 string = "sim/multiplayer/position/plane19_x"                 // double, writable
+	SimMultiplayerPosition_plane19_y                 string = "sim/multiplayer/position/plane19_y"                 // double, writable
+	SimMultiplayerPosition_plane19_z                 string = "sim/multiplayer/position/plane19_z"                 // double, writable
+	SimMultiplayerPosition_plane19_the               string = "sim/multiplayer/position/plane19_the"               // float, writable
+	SimMultiplayerPosition_plane19_phi               string = "sim/multiplayer/position/plane19_phi"               // float, writable
+	SimMultiplayerPosition_plane19_psi               string = "sim/multiplayer/position/plane19_psi"               // float, writable
+	SimMultiplayerPosition_plane19_gear_deploy       string = "sim/multiplayer/position/plane19_gear_deploy"       // float_array, writable
+	SimMultiplayerPosition_plane19_flap_ratio        string = "sim/multiplayer/position/plane19_flap_ratio"        // float, writable
+	SimMultiplayerPosition_plane19_flap_ratio2       string = "sim/multiplayer/position/plane19_flap_ratio2"       // float, writable
+	SimMultiplayerPosition_plane19_spoiler_ratio     string = "sim/multiplayer/position/plane19_spoiler_ratio"     // float, writable
+	SimMultiplayerPosition_plane19_speedbrake_ratio  string = "sim/multiplayer/position/plane19_speedbrake_ratio"  // float, writable
+	SimMultiplayerPosition_plane19_sla1_ratio        string = "sim/multiplayer/position/plane19_sla1_ratio"        // float, writable
+	SimMultiplayerPosition_plane19_wing_sweep        string = "sim/multiplayer/position/plane19_wing_sweep"        // float, writable
+	SimMultiplayerPosition_plane19_throttle          string = "sim/multiplayer/position/plane19_throttle"          // float_array, writable
+	SimMultiplayerPosition_plane19_yolk_pitch        string = "sim/multiplayer/position/plane19_yolk_pitch"        // float, writable
+	SimMultiplayerPosition_plane19_yolk_roll         string = "sim/multiplayer/position/plane19_yolk_roll"         // float, writable
+	SimMultiplayerPosition_plane19_yolk_yaw          string = "sim/multiplayer/position/plane19_yolk_yaw"          // float, writable
+	SimMultiplayerPosition_plane19_lat               string = "sim/multiplayer/position/plane19_lat"               // double
+	SimMultiplayerPosition_plane19_lon               string = "sim/multiplayer/position/plane19_lon"               // double
+	SimMultiplayerPosition_plane19_el                string = "sim/multiplayer/position/plane19_el"                // double
+	SimMultiplayerPosition_plane19_v_x               string = "sim/multiplayer/position/plane19_v_x"               // float, writable
+	SimMultiplayerPosition_plane19_v_y               string = "sim/multiplayer/position/plane19_v_y"               // float, writable
+	SimMultiplayerPosition_plane19_v_z               string = "sim/multiplayer/position/plane19_v_z"               // float, writable
+	SimMultiplayerCombat_team_status                 string = "sim/multiplayer/combat/team_status"                 // int_array
+)
@@ -0,0 +1,30 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefnetwork provides the known dataref names under sim/network/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefnetwork
+
+const (
+	SimNetworkDataout_network_data_rate      string = "sim/network/dataout/network_data_rate"      // int, writable
+	SimNetworkDataout_data_to_internet       string = "sim/network/dataout/data_to_internet"       // int_array, writable
+	SimNetworkDataout_data_to_disk           string = "sim/network/dataout/data_to_disk"           // int_array, writable
+	SimNetworkDataout_data_to_graph          string = "sim/network/dataout/data_to_graph"          // int_array, writable
+	SimNetworkDataout_data_to_screen         string = "sim/network/dataout/data_to_screen"         // int_array, writable
+	SimNetworkDataout_dump_parts_props       string = "sim/network/dataout/dump_parts_props"       // int, writable
+	SimNetworkDataout_dump_parts_wings       string = "sim/network/dataout/dump_parts_wings"       // int, writable
+	SimNetworkDataout_dump_parts_vstabs      string = "sim/network/dataout/dump_parts_vstabs"      // int, writable
+	SimNetworkDataout_is_external_visual     string = "sim/network/dataout/is_external_visual"     // int
+	SimNetworkDataout_is_multiplayer_session string = "sim/network/dataout/is_multiplayer_session" // int
+	SimNetworkDataout_multiplayer_ip         string = "sim/network/dataout/multiplayer_ip"         // int_array
+	SimNetworkDataout_external_visual_ip     string = "sim/network/dataout/external_visual_ip"     // int_array
+	SimNetworkDataout_track_external_visual  string = "sim/network/dataout/track_external_visual"  // int_array
+	SimNetworkDataout_exvis_tracks_view      string = "sim/network/dataout/exvis_tracks_view"      // int_array, writable
+	SimNetworkMisc_opentransport_inited      string = "sim/network/misc/opentransport_inited"      // int
+	SimNetworkMisc_network_time_sec          string = "sim/network/misc/network_time_sec"          // float
+)
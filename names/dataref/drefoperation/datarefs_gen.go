@@ -0,0 +1,929 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefoperation provides the known dataref names under sim/operation/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefoperation
+
+const (
+	SimOperationFailures_hydraulic_pressure_ratio            string = "sim/operation/failures/hydraulic_pressure_ratio"            // float
+	SimOperationFailures_hydraulic_pressure_ratio2           string = "sim/operation/failures/hydraulic_pressure_ratio2"           // float
+	SimOperationFailures_hydraulic_pressure_ratio_3          string = "sim/operation/failures/hydraulic_pressure_ratio_3"          // float
+	SimOperationFailures_oil_power_thrust_ratio              string = "sim/operation/failures/oil_power_thrust_ratio"              // float_array
+	SimOperationFailures_enable_random_falures               string = "sim/operation/failures/enable_random_falures"               // int, writable
+	SimOperationFailures_enable_random_failures              string = "sim/operation/failures/enable_random_failures"              // int, writable
+	SimOperationFailures_mean_time_between_failure_hrs       string = "sim/operation/failures/mean_time_between_failure_hrs"       // float, writable
+	SimOperationFailures_ram_air_turbine_on                  string = "sim/operation/failures/ram_air_turbine_on"                  // int, writable
+	SimOperationFailures_failures                            string = "sim/operation/failures/failures"                            // int_array
+	SimOperationFailures_rel_conlock                         string = "sim/operation/failures/rel_conlock"                         // int, writable
+	SimOperationFailures_rel_door_open                       string = "sim/operation/failures/rel_door_open"                       // int, writable
+	SimOperationFailures_rel_ex_power_on                     string = "sim/operation/failures/rel_ex_power_on"                     // int, writable
+	SimOperationFailures_rel_pass_o2_on                      string = "sim/operation/failures/rel_pass_o2_on"                      // int, writable
+	SimOperationFailures_rel_fuelcap                         string = "sim/operation/failures/rel_fuelcap"                         // int, writable
+	SimOperationFailures_rel_fuel_leak                       string = "sim/operation/failures/rel_fuel_leak"                       // int, writable
+	SimOperationFailures_rel_fuel_water                      string = "sim/operation/failures/rel_fuel_water"                      // int, writable
+	SimOperationFailures_rel_fuel_type                       string = "sim/operation/failures/rel_fuel_type"                       // int, writable
+	SimOperationFailures_rel_fuel_block0                     string = "sim/operation/failures/rel_fuel_block0"                     // int, writable
+	SimOperationFailures_rel_fuel_block1                     string = "sim/operation/failures/rel_fuel_block1"                     // int, writable
+	SimOperationFailures_rel_fuel_block2                     string = "sim/operation/failures/rel_fuel_block2"                     // int, writable
+	SimOperationFailures_rel_fuel_block3                     string = "sim/operation/failures/rel_fuel_block3"                     // int, writable
+	SimOperationFailures_rel_fuel_block4                     string = "sim/operation/failures/rel_fuel_block4"                     // int, writable
+	SimOperationFailures_rel_fuel_block5                     string = "sim/operation/failures/rel_fuel_block5"                     // int, writable
+	SimOperationFailures_rel_fuel_block6                     string = "sim/operation/failures/rel_fuel_block6"                     // int, writable
+	SimOperationFailures_rel_fuel_block7                     string = "sim/operation/failures/rel_fuel_block7"                     // int, writable
+	SimOperationFailures_rel_fuel_block8                     string = "sim/operation/failures/rel_fuel_block8"                     // int, writable
+	SimOperationFailures_rel_vasi                            string = "sim/operation/failures/rel_vasi"                            // int, writable
+	SimOperationFailures_rel_rwy_lites                       string = "sim/operation/failures/rel_rwy_lites"                       // int, writable
+	SimOperationFailures_rel_bird_strike_eng1                string = "sim/operation/failures/rel_bird_strike_eng1"                // int, writable
+	SimOperationFailures_rel_bird_strike_eng2                string = "sim/operation/failures/rel_bird_strike_eng2"                // int, writable
+	SimOperationFailures_rel_bird_strike                     string = "sim/operation/failures/rel_bird_strike"                     // int, writable
+	SimOperationFailures_rel_wind_shear                      string = "sim/operation/failures/rel_wind_shear"                      // int, writable
+	SimOperationFailures_rel_smoke_cpit                      string = "sim/operation/failures/rel_smoke_cpit"                      // int, writable
+	SimOperationFailures_rel_brown_out                       string = "sim/operation/failures/rel_brown_out"                       // int, writable
+	SimOperationFailures_rel_flt_incursion                   string = "sim/operation/failures/rel_flt_incursion"                   // int, writable
+	SimOperationFailures_rel_rwy_incursion_1                 string = "sim/operation/failures/rel_rwy_incursion_1"                 // int, writable
+	SimOperationFailures_rel_rwy_incursion_2                 string = "sim/operation/failures/rel_rwy_incursion_2"                 // int, writable
+	SimOperationFailures_rel_esys                            string = "sim/operation/failures/rel_esys"                            // int, writable
+	SimOperationFailures_rel_esys2                           string = "sim/operation/failures/rel_esys2"                           // int, writable
+	SimOperationFailures_rel_esys3                           string = "sim/operation/failures/rel_esys3"                           // int, writable
+	SimOperationFailures_rel_esys4                           string = "sim/operation/failures/rel_esys4"                           // int, writable
+	SimOperationFailures_rel_esys5                           string = "sim/operation/failures/rel_esys5"                           // int, writable
+	SimOperationFailures_rel_esys6                           string = "sim/operation/failures/rel_esys6"                           // int, writable
+	SimOperationFailures_rel_invert0                         string = "sim/operation/failures/rel_invert0"                         // int, writable
+	SimOperationFailures_rel_invert1                         string = "sim/operation/failures/rel_invert1"                         // int, writable
+	SimOperationFailures_rel_genera0                         string = "sim/operation/failures/rel_genera0"                         // int, writable
+	SimOperationFailures_rel_genera1                         string = "sim/operation/failures/rel_genera1"                         // int, writable
+	SimOperationFailures_rel_genera2                         string = "sim/operation/failures/rel_genera2"                         // int, writable
+	SimOperationFailures_rel_genera3                         string = "sim/operation/failures/rel_genera3"                         // int, writable
+	SimOperationFailures_rel_genera4                         string = "sim/operation/failures/rel_genera4"                         // int, writable
+	SimOperationFailures_rel_genera5                         string = "sim/operation/failures/rel_genera5"                         // int, writable
+	SimOperationFailures_rel_genera6                         string = "sim/operation/failures/rel_genera6"                         // int, writable
+	SimOperationFailures_rel_genera7                         string = "sim/operation/failures/rel_genera7"                         // int, writable
+	SimOperationFailures_rel_batter0                         string = "sim/operation/failures/rel_batter0"                         // int, writable
+	SimOperationFailures_rel_batter1                         string = "sim/operation/failures/rel_batter1"                         // int, writable
+	SimOperationFailures_rel_batter2                         string = "sim/operation/failures/rel_batter2"                         // int, writable
+	SimOperationFailures_rel_batter3                         string = "sim/operation/failures/rel_batter3"                         // int, writable
+	SimOperationFailures_rel_batter4                         string = "sim/operation/failures/rel_batter4"                         // int, writable
+	SimOperationFailures_rel_batter5                         string = "sim/operation/failures/rel_batter5"                         // int, writable
+	SimOperationFailures_rel_batter6                         string = "sim/operation/failures/rel_batter6"                         // int, writable
+	SimOperationFailures_rel_batter7                         string = "sim/operation/failures/rel_batter7"                         // int, writable
+	SimOperationFailures_rel_gen0_lo                         string = "sim/operation/failures/rel_gen0_lo"                         // int, writable
+	SimOperationFailures_rel_gen0_hi                         string = "sim/operation/failures/rel_gen0_hi"                         // int, writable
+	SimOperationFailures_rel_gen1_lo                         string = "sim/operation/failures/rel_gen1_lo"                         // int, writable
+	SimOperationFailures_rel_gen1_hi                         string = "sim/operation/failures/rel_gen1_hi"                         // int, writable
+	SimOperationFailures_rel_bat0_lo                         string = "sim/operation/failures/rel_bat0_lo"                         // int, writable
+	SimOperationFailures_rel_bat0_hi                         string = "sim/operation/failures/rel_bat0_hi"                         // int, writable
+	SimOperationFailures_rel_bat1_lo                         string = "sim/operation/failures/rel_bat1_lo"                         // int, writable
+	SimOperationFailures_rel_bat1_hi                         string = "sim/operation/failures/rel_bat1_hi"                         // int, writable
+	SimOperationFailures_rel_lites_nav                       string = "sim/operation/failures/rel_lites_nav"                       // int, writable
+	SimOperationFailures_rel_lites_strobe                    string = "sim/operation/failures/rel_lites_strobe"                    // int, writable
+	SimOperationFailures_rel_lites_beac                      string = "sim/operation/failures/rel_lites_beac"                      // int, writable
+	SimOperationFailures_rel_lites_taxi                      string = "sim/operation/failures/rel_lites_taxi"                      // int, writable
+	SimOperationFailures_rel_lites_land                      string = "sim/operation/failures/rel_lites_land"                      // int, writable
+	SimOperationFailures_rel_lites_ins                       string = "sim/operation/failures/rel_lites_ins"                       // int, writable
+	SimOperationFailures_rel_clights                         string = "sim/operation/failures/rel_clights"                         // int, writable
+	SimOperationFailures_rel_lites_hud                       string = "sim/operation/failures/rel_lites_hud"                       // int, writable
+	SimOperationFailures_rel_servo_rudd                      string = "sim/operation/failures/rel_servo_rudd"                      // int, writable
+	SimOperationFailures_rel_otto                            string = "sim/operation/failures/rel_otto"                            // int, writable
+	SimOperationFailures_rel_auto_runaway                    string = "sim/operation/failures/rel_auto_runaway"                    // int, writable
+	SimOperationFailures_rel_auto_servos                     string = "sim/operation/failures/rel_auto_servos"                     // int, writable
+	SimOperationFailures_rel_servo_ailn                      string = "sim/operation/failures/rel_servo_ailn"                      // int, writable
+	SimOperationFailures_rel_servo_elev                      string = "sim/operation/failures/rel_servo_elev"                      // int, writable
+	SimOperationFailures_rel_servo_thro                      string = "sim/operation/failures/rel_servo_thro"                      // int, writable
+	SimOperationFailures_rel_stbaug                          string = "sim/operation/failures/rel_stbaug"                          // int, writable
+	SimOperationFailures_rel_fc_rud_L                        string = "sim/operation/failures/rel_fc_rud_L"                        // int, writable
+	SimOperationFailures_rel_fc_rud_R                        string = "sim/operation/failures/rel_fc_rud_R"                        // int, writable
+	SimOperationFailures_rel_fc_ail_L                        string = "sim/operation/failures/rel_fc_ail_L"                        // int, writable
+	SimOperationFailures_rel_fc_ail_R                        string = "sim/operation/failures/rel_fc_ail_R"                        // int, writable
+	SimOperationFailures_rel_fc_elv_U                        string = "sim/operation/failures/rel_fc_elv_U"                        // int, writable
+	SimOperationFailures_rel_fc_elv_D                        string = "sim/operation/failures/rel_fc_elv_D"                        // int, writable
+	SimOperationFailures_rel_rud_L                           string = "sim/operation/failures/rel_rud_L"                           // int, writable
+	SimOperationFailures_rel_rud_L1                          string = "sim/operation/failures/rel_rud_L1"                          // int, writable
+	SimOperationFailures_rel_rud_L2                          string = "sim/operation/failures/rel_rud_L2"                          // int, writable
+	SimOperationFailures_rel_rud_R                           string = "sim/operation/failures/rel_rud_R"                           // int, writable
+	SimOperationFailures_rel_rud_R1                          string = "sim/operation/failures/rel_rud_R1"                          // int, writable
+	SimOperationFailures_rel_rud_R2                          string = "sim/operation/failures/rel_rud_R2"                          // int, writable
+	SimOperationFailures_rel_rud_L_jam                       string = "sim/operation/failures/rel_rud_L_jam"                       // int, writable
+	SimOperationFailures_rel_rud_R_jam                       string = "sim/operation/failures/rel_rud_R_jam"                       // int, writable
+	SimOperationFailures_rel_ail_L                           string = "sim/operation/failures/rel_ail_L"                           // int, writable
+	SimOperationFailures_rel_ail_L1                          string = "sim/operation/failures/rel_ail_L1"                          // int, writable
+	SimOperationFailures_rel_ail_L2                          string = "sim/operation/failures/rel_ail_L2"                          // int, writable
+	SimOperationFailures_rel_ail_R                           string = "sim/operation/failures/rel_ail_R"                           // int, writable
+	SimOperationFailures_rel_ail_R1                          string = "sim/operation/failures/rel_ail_R1"                          // int, writable
+	SimOperationFailures_rel_ail_R2                          string = "sim/operation/failures/rel_ail_R2"                          // int, writable
+	SimOperationFailures_rel_ail_L_jam                       string = "sim/operation/failures/rel_ail_L_jam"                       // int, writable
+	SimOperationFailures_rel_ail_R_jam                       string = "sim/operation/failures/rel_ail_R_jam"                       // int, writable
+	SimOperationFailures_rel_elv_U                           string = "sim/operation/failures/rel_elv_U"                           // int, writable
+	SimOperationFailures_rel_elv_U1                          string = "sim/operation/failures/rel_elv_U1"                          // int, writable
+	SimOperationFailures_rel_elv_U2                          string = "sim/operation/failures/rel_elv_U2"                          // int, writable
+	SimOperationFailures_rel_elv_D                           string = "sim/operation/failures/rel_elv_D"                           // int, writable
+	SimOperationFailures_rel_elv_D1                          string = "sim/operation/failures/rel_elv_D1"                          // int, writable
+	SimOperationFailures_rel_elv_D2                          string = "sim/operation/failures/rel_elv_D2"                          // int, writable
+	SimOperationFailures_rel_elv_L_jam                       string = "sim/operation/failures/rel_elv_L_jam"                       // int, writable
+	SimOperationFailures_rel_elv_R_jam                       string = "sim/operation/failures/rel_elv_R_jam"                       // int, writable
+	SimOperationFailures_rel_trim_rud                        string = "sim/operation/failures/rel_trim_rud"                        // int, writable
+	SimOperationFailures_rel_trim_ail                        string = "sim/operation/failures/rel_trim_ail"                        // int, writable
+	SimOperationFailures_rel_trim_elv                        string = "sim/operation/failures/rel_trim_elv"                        // int, writable
+	SimOperationFailures_rel_rud_trim_run                    string = "sim/operation/failures/rel_rud_trim_run"                    // int, writable
+	SimOperationFailures_rel_ail_trim_run                    string = "sim/operation/failures/rel_ail_trim_run"                    // int, writable
+	SimOperationFailures_rel_elv_trim_run                    string = "sim/operation/failures/rel_elv_trim_run"                    // int, writable
+	SimOperationFailures_rel_fc_slt                          string = "sim/operation/failures/rel_fc_slt"                          // int, writable
+	SimOperationFailures_rel_flap_act                        string = "sim/operation/failures/rel_flap_act"                        // int, writable
+	SimOperationFailures_rel_fc_L_flp                        string = "sim/operation/failures/rel_fc_L_flp"                        // int, writable
+	SimOperationFailures_rel_fc_R_flp                        string = "sim/operation/failures/rel_fc_R_flp"                        // int, writable
+	SimOperationFailures_rel_L_flp_off                       string = "sim/operation/failures/rel_L_flp_off"                       // int, writable
+	SimOperationFailures_rel_R_flp_off                       string = "sim/operation/failures/rel_R_flp_off"                       // int, writable
+	SimOperationFailures_rel_fc_L_flp2                       string = "sim/operation/failures/rel_fc_L_flp2"                       // int, writable
+	SimOperationFailures_rel_fc_R_flp2                       string = "sim/operation/failures/rel_fc_R_flp2"                       // int, writable
+	SimOperationFailures_rel_L_flp2_off                      string = "sim/operation/failures/rel_L_flp2_off"                      // int, writable
+	SimOperationFailures_rel_R_flp2_off                      string = "sim/operation/failures/rel_R_flp2_off"                      // int, writable
+	SimOperationFailures_rel_gear_act                        string = "sim/operation/failures/rel_gear_act"                        // int, writable
+	SimOperationFailures_rel_gear_ind                        string = "sim/operation/failures/rel_gear_ind"                        // int, writable
+	SimOperationFailures_rel_lbrakes                         string = "sim/operation/failures/rel_lbrakes"                         // int, writable
+	SimOperationFailures_rel_rbrakes                         string = "sim/operation/failures/rel_rbrakes"                         // int, writable
+	SimOperationFailures_rel_lagear1                         string = "sim/operation/failures/rel_lagear1"                         // int, writable
+	SimOperationFailures_rel_lagear2                         string = "sim/operation/failures/rel_lagear2"                         // int, writable
+	SimOperationFailures_rel_lagear3                         string = "sim/operation/failures/rel_lagear3"                         // int, writable
+	SimOperationFailures_rel_lagear4                         string = "sim/operation/failures/rel_lagear4"                         // int, writable
+	SimOperationFailures_rel_lagear5                         string = "sim/operation/failures/rel_lagear5"                         // int, writable
+	SimOperationFailures_rel_collapse1                       string = "sim/operation/failures/rel_collapse1"                       // int, writable
+	SimOperationFailures_rel_collapse2                       string = "sim/operation/failures/rel_collapse2"                       // int, writable
+	SimOperationFailures_rel_collapse3                       string = "sim/operation/failures/rel_collapse3"                       // int, writable
+	SimOperationFailures_rel_collapse4                       string = "sim/operation/failures/rel_collapse4"                       // int, writable
+	SimOperationFailures_rel_collapse5                       string = "sim/operation/failures/rel_collapse5"                       // int, writable
+	SimOperationFailures_rel_collapse6                       string = "sim/operation/failures/rel_collapse6"                       // int, writable
+	SimOperationFailures_rel_collapse7                       string = "sim/operation/failures/rel_collapse7"                       // int, writable
+	SimOperationFailures_rel_collapse8                       string = "sim/operation/failures/rel_collapse8"                       // int, writable
+	SimOperationFailures_rel_collapse9                       string = "sim/operation/failures/rel_collapse9"                       // int, writable
+	SimOperationFailures_rel_collapse10                      string = "sim/operation/failures/rel_collapse10"                      // int, writable
+	SimOperationFailures_rel_tire1                           string = "sim/operation/failures/rel_tire1"                           // int, writable
+	SimOperationFailures_rel_tire2                           string = "sim/operation/failures/rel_tire2"                           // int, writable
+	SimOperationFailures_rel_tire3                           string = "sim/operation/failures/rel_tire3"                           // int, writable
+	SimOperationFailures_rel_tire4                           string = "sim/operation/failures/rel_tire4"                           // int, writable
+	SimOperationFailures_rel_tire5                           string = "sim/operation/failures/rel_tire5"                           // int, writable
+	SimOperationFailures_rel_antice                          string = "sim/operation/failures/rel_antice"                          // int, writable
+	SimOperationFailures_rel_ice_detect                      string = "sim/operation/failures/rel_ice_detect"                      // int, writable
+	SimOperationFailures_rel_ice_pitot_heat1                 string = "sim/operation/failures/rel_ice_pitot_heat1"                 // int, writable
+	SimOperationFailures_rel_ice_pitot_heat2                 string = "sim/operation/failures/rel_ice_pitot_heat2"                 // int, writable
+	SimOperationFailures_rel_ice_pitot_heat_stby             string = "sim/operation/failures/rel_ice_pitot_heat_stby"             // int, writable
+	SimOperationFailures_rel_ice_static_heat                 string = "sim/operation/failures/rel_ice_static_heat"                 // int, writable
+	SimOperationFailures_rel_ice_static_heat2                string = "sim/operation/failures/rel_ice_static_heat2"                // int, writable
+	SimOperationFailures_rel_ice_static_heat_stby            string = "sim/operation/failures/rel_ice_static_heat_stby"            // int, writable
+	SimOperationFailures_rel_ice_AOA_heat                    string = "sim/operation/failures/rel_ice_AOA_heat"                    // int, writable
+	SimOperationFailures_rel_ice_AOA_heat2                   string = "sim/operation/failures/rel_ice_AOA_heat2"                   // int, writable
+	SimOperationFailures_rel_ice_window_heat                 string = "sim/operation/failures/rel_ice_window_heat"                 // int, writable
+	SimOperationFailures_rel_ice_window_heat_cop             string = "sim/operation/failures/rel_ice_window_heat_cop"             // int, writable
+	SimOperationFailures_rel_ice_window_heat_l_side          string = "sim/operation/failures/rel_ice_window_heat_l_side"          // int, writable
+	SimOperationFailures_rel_ice_window_heat_r_side          string = "sim/operation/failures/rel_ice_window_heat_r_side"          // int, writable
+	SimOperationFailures_rel_ice_surf_boot                   string = "sim/operation/failures/rel_ice_surf_boot"                   // int, writable
+	SimOperationFailures_rel_ice_surf_heat                   string = "sim/operation/failures/rel_ice_surf_heat"                   // int, writable
+	SimOperationFailures_rel_ice_surf_heat2                  string = "sim/operation/failures/rel_ice_surf_heat2"                  // int, writable
+	SimOperationFailures_rel_ice_brake_heat                  string = "sim/operation/failures/rel_ice_brake_heat"                  // int, writable
+	SimOperationFailures_rel_ice_alt_air1                    string = "sim/operation/failures/rel_ice_alt_air1"                    // int, writable
+	SimOperationFailures_rel_ice_alt_air2                    string = "sim/operation/failures/rel_ice_alt_air2"                    // int, writable
+	SimOperationFailures_rel_ice_inlet_heat                  string = "sim/operation/failures/rel_ice_inlet_heat"                  // int, writable
+	SimOperationFailures_rel_ice_inlet_heat2                 string = "sim/operation/failures/rel_ice_inlet_heat2"                 // int, writable
+	SimOperationFailures_rel_ice_inlet_heat3                 string = "sim/operation/failures/rel_ice_inlet_heat3"                 // int, writable
+	SimOperationFailures_rel_ice_inlet_heat4                 string = "sim/operation/failures/rel_ice_inlet_heat4"                 // int, writable
+	SimOperationFailures_rel_ice_inlet_heat5                 string = "sim/operation/failures/rel_ice_inlet_heat5"                 // int, writable
+	SimOperationFailures_rel_ice_inlet_heat6                 string = "sim/operation/failures/rel_ice_inlet_heat6"                 // int, writable
+	SimOperationFailures_rel_ice_inlet_heat7                 string = "sim/operation/failures/rel_ice_inlet_heat7"                 // int, writable
+	SimOperationFailures_rel_ice_inlet_heat8                 string = "sim/operation/failures/rel_ice_inlet_heat8"                 // int, writable
+	SimOperationFailures_rel_ice_prop_heat                   string = "sim/operation/failures/rel_ice_prop_heat"                   // int, writable
+	SimOperationFailures_rel_ice_prop_heat2                  string = "sim/operation/failures/rel_ice_prop_heat2"                  // int, writable
+	SimOperationFailures_rel_ice_prop_heat3                  string = "sim/operation/failures/rel_ice_prop_heat3"                  // int, writable
+	SimOperationFailures_rel_ice_prop_heat4                  string = "sim/operation/failures/rel_ice_prop_heat4"                  // int, writable
+	SimOperationFailures_rel_ice_prop_heat5                  string = "sim/operation/failures/rel_ice_prop_heat5"                  // int, writable
+	SimOperationFailures_rel_ice_prop_heat6                  string = "sim/operation/failures/rel_ice_prop_heat6"                  // int, writable
+	SimOperationFailures_rel_ice_prop_heat7                  string = "sim/operation/failures/rel_ice_prop_heat7"                  // int, writable
+	SimOperationFailures_rel_ice_prop_heat8                  string = "sim/operation/failures/rel_ice_prop_heat8"                  // int, writable
+	SimOperationFailures_rel_dice_tks_pump_0                 string = "sim/operation/failures/rel_dice_tks_pump_0"                 // int, writable
+	SimOperationFailures_rel_dice_tks_pump_1                 string = "sim/operation/failures/rel_dice_tks_pump_1"                 // int, writable
+	SimOperationFailures_rel_HVAC                            string = "sim/operation/failures/rel_HVAC"                            // int, writable
+	SimOperationFailures_rel_bleed_air_lft                   string = "sim/operation/failures/rel_bleed_air_lft"                   // int, writable
+	SimOperationFailures_rel_bleed_air_rgt                   string = "sim/operation/failures/rel_bleed_air_rgt"                   // int, writable
+	SimOperationFailures_rel_APU_press                       string = "sim/operation/failures/rel_APU_press"                       // int, writable
+	SimOperationFailures_rel_depres_slow                     string = "sim/operation/failures/rel_depres_slow"                     // int, writable
+	SimOperationFailures_rel_depres_fast                     string = "sim/operation/failures/rel_depres_fast"                     // int, writable
+	SimOperationFailures_rel_hydpmp_ele                      string = "sim/operation/failures/rel_hydpmp_ele"                      // int, writable
+	SimOperationFailures_rel_hydpmp_el2                      string = "sim/operation/failures/rel_hydpmp_el2"                      // int, writable
+	SimOperationFailures_rel_hydpmp_el3                      string = "sim/operation/failures/rel_hydpmp_el3"                      // int, writable
+	SimOperationFailures_rel_hydptu                          string = "sim/operation/failures/rel_hydptu"                          // int, writable
+	SimOperationFailures_rel_hydpmp                          string = "sim/operation/failures/rel_hydpmp"                          // int, writable
+	SimOperationFailures_rel_hydpmp2                         string = "sim/operation/failures/rel_hydpmp2"                         // int, writable
+	SimOperationFailures_rel_hydpmp3                         string = "sim/operation/failures/rel_hydpmp3"                         // int, writable
+	SimOperationFailures_rel_hydpmp4                         string = "sim/operation/failures/rel_hydpmp4"                         // int, writable
+	SimOperationFailures_rel_hydpmp5                         string = "sim/operation/failures/rel_hydpmp5"                         // int, writable
+	SimOperationFailures_rel_hydpmp6                         string = "sim/operation/failures/rel_hydpmp6"                         // int, writable
+	SimOperationFailures_rel_hydpmp7                         string = "sim/operation/failures/rel_hydpmp7"                         // int, writable
+	SimOperationFailures_rel_hydpmp8                         string = "sim/operation/failures/rel_hydpmp8"                         // int, writable
+	SimOperationFailures_rel_hydleak                         string = "sim/operation/failures/rel_hydleak"                         // int, writable
+	SimOperationFailures_rel_hydleak2                        string = "sim/operation/failures/rel_hydleak2"                        // int, writable
+	SimOperationFailures_rel_hydleak3                        string = "sim/operation/failures/rel_hydleak3"                        // int, writable
+	SimOperationFailures_rel_hydoverp                        string = "sim/operation/failures/rel_hydoverp"                        // int, writable
+	SimOperationFailures_rel_hydoverp2                       string = "sim/operation/failures/rel_hydoverp2"                       // int, writable
+	SimOperationFailures_rel_hydoverp3                       string = "sim/operation/failures/rel_hydoverp3"                       // int, writable
+	SimOperationFailures_rel_clutch                          string = "sim/operation/failures/rel_clutch"                          // int, writable
+	SimOperationFailures_rel_throt_lo                        string = "sim/operation/failures/rel_throt_lo"                        // int, writable
+	SimOperationFailures_rel_throt_hi                        string = "sim/operation/failures/rel_throt_hi"                        // int, writable
+	SimOperationFailures_rel_fc_thr                          string = "sim/operation/failures/rel_fc_thr"                          // int, writable
+	SimOperationFailures_rel_prop_sync                       string = "sim/operation/failures/rel_prop_sync"                       // int, writable
+	SimOperationFailures_rel_feather                         string = "sim/operation/failures/rel_feather"                         // int, writable
+	SimOperationFailures_rel_trotor                          string = "sim/operation/failures/rel_trotor"                          // int, writable
+	SimOperationFailures_rel_apu                             string = "sim/operation/failures/rel_apu"                             // int, writable
+	SimOperationFailures_rel_apu_fire                        string = "sim/operation/failures/rel_apu_fire"                        // int, writable
+	SimOperationFailures_rel_vacuum                          string = "sim/operation/failures/rel_vacuum"                          // int, writable
+	SimOperationFailures_rel_vacuum2                         string = "sim/operation/failures/rel_vacuum2"                         // int, writable
+	SimOperationFailures_rel_elec_gyr                        string = "sim/operation/failures/rel_elec_gyr"                        // int, writable
+	SimOperationFailures_rel_elec_gyr2                       string = "sim/operation/failures/rel_elec_gyr2"                       // int, writable
+	SimOperationFailures_rel_pitot                           string = "sim/operation/failures/rel_pitot"                           // int, writable
+	SimOperationFailures_rel_pitot2                          string = "sim/operation/failures/rel_pitot2"                          // int, writable
+	SimOperationFailures_rel_pitot_stby                      string = "sim/operation/failures/rel_pitot_stby"                      // int, writable
+	SimOperationFailures_rel_static                          string = "sim/operation/failures/rel_static"                          // int, writable
+	SimOperationFailures_rel_static2                         string = "sim/operation/failures/rel_static2"                         // int, writable
+	SimOperationFailures_rel_static_stby                     string = "sim/operation/failures/rel_static_stby"                     // int, writable
+	SimOperationFailures_rel_static1_err                     string = "sim/operation/failures/rel_static1_err"                     // int, writable
+	SimOperationFailures_rel_static2_err                     string = "sim/operation/failures/rel_static2_err"                     // int, writable
+	SimOperationFailures_rel_static_stby_err                 string = "sim/operation/failures/rel_static_stby_err"                 // int, writable
+	SimOperationFailures_rel_g_oat                           string = "sim/operation/failures/rel_g_oat"                           // int, writable
+	SimOperationFailures_rel_g_fuel                          string = "sim/operation/failures/rel_g_fuel"                          // int, writable
+	SimOperationFailures_rel_ss_asi                          string = "sim/operation/failures/rel_ss_asi"                          // int, writable
+	SimOperationFailures_rel_ss_ahz                          string = "sim/operation/failures/rel_ss_ahz"                          // int, writable
+	SimOperationFailures_rel_ss_alt                          string = "sim/operation/failures/rel_ss_alt"                          // int, writable
+	SimOperationFailures_rel_ss_tsi                          string = "sim/operation/failures/rel_ss_tsi"                          // int, writable
+	SimOperationFailures_rel_ss_dgy                          string = "sim/operation/failures/rel_ss_dgy"                          // int, writable
+	SimOperationFailures_rel_ss_vvi                          string = "sim/operation/failures/rel_ss_vvi"                          // int, writable
+	SimOperationFailures_rel_cop_asi                         string = "sim/operation/failures/rel_cop_asi"                         // int, writable
+	SimOperationFailures_rel_cop_ahz                         string = "sim/operation/failures/rel_cop_ahz"                         // int, writable
+	SimOperationFailures_rel_cop_alt                         string = "sim/operation/failures/rel_cop_alt"                         // int, writable
+	SimOperationFailures_rel_cop_tsi                         string = "sim/operation/failures/rel_cop_tsi"                         // int, writable
+	SimOperationFailures_rel_cop_dgy                         string = "sim/operation/failures/rel_cop_dgy"                         // int, writable
+	SimOperationFailures_rel_cop_vvi                         string = "sim/operation/failures/rel_cop_vvi"                         // int, writable
+	SimOperationFailures_rel_efis_1                          string = "sim/operation/failures/rel_efis_1"                          // int, writable
+	SimOperationFailures_rel_efis_2                          string = "sim/operation/failures/rel_efis_2"                          // int, writable
+	SimOperationFailures_rel_AOA                             string = "sim/operation/failures/rel_AOA"                             // int, writable
+	SimOperationFailures_rel_stall_warn                      string = "sim/operation/failures/rel_stall_warn"                      // int, writable
+	SimOperationFailures_rel_gear_warning                    string = "sim/operation/failures/rel_gear_warning"                    // int, writable
+	SimOperationFailures_rel_navcom1                         string = "sim/operation/failures/rel_navcom1"                         // int, writable
+	SimOperationFailures_rel_navcom2                         string = "sim/operation/failures/rel_navcom2"                         // int, writable
+	SimOperationFailures_rel_nav1                            string = "sim/operation/failures/rel_nav1"                            // int, writable
+	SimOperationFailures_rel_nav2                            string = "sim/operation/failures/rel_nav2"                            // int, writable
+	SimOperationFailures_rel_com1                            string = "sim/operation/failures/rel_com1"                            // int, writable
+	SimOperationFailures_rel_com2                            string = "sim/operation/failures/rel_com2"                            // int, writable
+	SimOperationFailures_rel_adf1                            string = "sim/operation/failures/rel_adf1"                            // int, writable
+	SimOperationFailures_rel_adf2                            string = "sim/operation/failures/rel_adf2"                            // int, writable
+	SimOperationFailures_rel_gps                             string = "sim/operation/failures/rel_gps"                             // int, writable
+	SimOperationFailures_rel_gps2                            string = "sim/operation/failures/rel_gps2"                            // int, writable
+	SimOperationFailures_rel_dme                             string = "sim/operation/failures/rel_dme"                             // int, writable
+	SimOperationFailures_rel_loc                             string = "sim/operation/failures/rel_loc"                             // int, writable
+	SimOperationFailures_rel_gls                             string = "sim/operation/failures/rel_gls"                             // int, writable
+	SimOperationFailures_rel_gp                              string = "sim/operation/failures/rel_gp"                              // int, writable
+	SimOperationFailures_rel_xpndr                           string = "sim/operation/failures/rel_xpndr"                           // int, writable
+	SimOperationFailures_rel_marker                          string = "sim/operation/failures/rel_marker"                          // int, writable
+	SimOperationFailures_rel_RPM_ind_0                       string = "sim/operation/failures/rel_RPM_ind_0"                       // int, writable
+	SimOperationFailures_rel_RPM_ind_1                       string = "sim/operation/failures/rel_RPM_ind_1"                       // int, writable
+	SimOperationFailures_rel_N1_ind0                         string = "sim/operation/failures/rel_N1_ind0"                         // int, writable
+	SimOperationFailures_rel_N1_ind1                         string = "sim/operation/failures/rel_N1_ind1"                         // int, writable
+	SimOperationFailures_rel_N2_ind0                         string = "sim/operation/failures/rel_N2_ind0"                         // int, writable
+	SimOperationFailures_rel_N2_ind1                         string = "sim/operation/failures/rel_N2_ind1"                         // int, writable
+	SimOperationFailures_rel_MP_ind_0                        string = "sim/operation/failures/rel_MP_ind_0"                        // int, writable
+	SimOperationFailures_rel_MP_ind_1                        string = "sim/operation/failures/rel_MP_ind_1"                        // int, writable
+	SimOperationFailures_rel_TRQind0                         string = "sim/operation/failures/rel_TRQind0"                         // int, writable
+	SimOperationFailures_rel_TRQind1                         string = "sim/operation/failures/rel_TRQind1"                         // int, writable
+	SimOperationFailures_rel_EPRind0                         string = "sim/operation/failures/rel_EPRind0"                         // int, writable
+	SimOperationFailures_rel_EPRind1                         string = "sim/operation/failures/rel_EPRind1"                         // int, writable
+	SimOperationFailures_rel_CHT_ind_0                       string = "sim/operation/failures/rel_CHT_ind_0"                       // int, writable
+	SimOperationFailures_rel_CHT_ind_1                       string = "sim/operation/failures/rel_CHT_ind_1"                       // int, writable
+	SimOperationFailures_rel_ITTind0                         string = "sim/operation/failures/rel_ITTind0"                         // int, writable
+	SimOperationFailures_rel_ITTind1                         string = "sim/operation/failures/rel_ITTind1"                         // int, writable
+	SimOperationFailures_rel_EGT_ind_0                       string = "sim/operation/failures/rel_EGT_ind_0"                       // int, writable
+	SimOperationFailures_rel_EGT_ind_1                       string = "sim/operation/failures/rel_EGT_ind_1"                       // int, writable
+	SimOperationFailures_rel_FF_ind0                         string = "sim/operation/failures/rel_FF_ind0"                         // int, writable
+	SimOperationFailures_rel_FF_ind1                         string = "sim/operation/failures/rel_FF_ind1"                         // int, writable
+	SimOperationFailures_rel_fp_ind_0                        string = "sim/operation/failures/rel_fp_ind_0"                        // int, writable
+	SimOperationFailures_rel_fp_ind_1                        string = "sim/operation/failures/rel_fp_ind_1"                        // int, writable
+	SimOperationFailures_rel_oilp_ind_0                      string = "sim/operation/failures/rel_oilp_ind_0"                      // int, writable
+	SimOperationFailures_rel_oilp_ind_1                      string = "sim/operation/failures/rel_oilp_ind_1"                      // int, writable
+	SimOperationFailures_rel_oilt_ind_0                      string = "sim/operation/failures/rel_oilt_ind_0"                      // int, writable
+	SimOperationFailures_rel_oilt_ind_1                      string = "sim/operation/failures/rel_oilt_ind_1"                      // int, writable
+	SimOperationFailures_rel_g430_gps1                       string = "sim/operation/failures/rel_g430_gps1"                       // int, writable
+	SimOperationFailures_rel_g430_gps2                       string = "sim/operation/failures/rel_g430_gps2"                       // int, writable
+	SimOperationFailures_rel_g430_rad1_tune                  string = "sim/operation/failures/rel_g430_rad1_tune"                  // int, writable
+	SimOperationFailures_rel_g430_rad2_tune                  string = "sim/operation/failures/rel_g430_rad2_tune"                  // int, writable
+	SimOperationFailures_rel_g_gia1                          string = "sim/operation/failures/rel_g_gia1"                          // int, writable
+	SimOperationFailures_rel_g_gia2                          string = "sim/operation/failures/rel_g_gia2"                          // int, writable
+	SimOperationFailures_rel_g_gea                           string = "sim/operation/failures/rel_g_gea"                           // int, writable
+	SimOperationFailures_rel_adc_comp                        string = "sim/operation/failures/rel_adc_comp"                        // int, writable
+	SimOperationFailures_rel_adc_comp_2                      string = "sim/operation/failures/rel_adc_comp_2"                      // int, writable
+	SimOperationFailures_rel_g_arthorz                       string = "sim/operation/failures/rel_g_arthorz"                       // int, writable
+	SimOperationFailures_rel_g_arthorz_2                     string = "sim/operation/failures/rel_g_arthorz_2"                     // int, writable
+	SimOperationFailures_rel_g_asi                           string = "sim/operation/failures/rel_g_asi"                           // int, writable
+	SimOperationFailures_rel_g_alt                           string = "sim/operation/failures/rel_g_alt"                           // int, writable
+	SimOperationFailures_rel_g_magmtr                        string = "sim/operation/failures/rel_g_magmtr"                        // int, writable
+	SimOperationFailures_rel_g_vvi                           string = "sim/operation/failures/rel_g_vvi"                           // int, writable
+	SimOperationFailures_rel_g_mfd                           string = "sim/operation/failures/rel_g_mfd"                           // int, writable
+	SimOperationFailures_rel_g_pfd                           string = "sim/operation/failures/rel_g_pfd"                           // int, writable
+	SimOperationFailures_rel_g_pfd2                          string = "sim/operation/failures/rel_g_pfd2"                          // int, writable
+	SimOperationFailures_rel_stat_A0                         string = "sim/operation/failures/rel_stat_A0"                         // int, writable
+	SimOperationFailures_rel_stat_A1                         string = "sim/operation/failures/rel_stat_A1"                         // int, writable
+	SimOperationFailures_rel_stat_A2                         string = "sim/operation/failures/rel_stat_A2"                         // int, writable
+	SimOperationFailures_rel_stat_A3                         string = "sim/operation/failures/rel_stat_A3"                         // int, writable
+	SimOperationFailures_rel_stat_A4                         string = "sim/operation/failures/rel_stat_A4"                         // int, writable
+	SimOperationFailures_rel_stat_A5                         string = "sim/operation/failures/rel_stat_A5"                         // int, writable
+	SimOperationFailures_rel_stat_A6                         string = "sim/operation/failures/rel_stat_A6"                         // int, writable
+	SimOperationFailures_rel_stat_A7                         string = "sim/operation/failures/rel_stat_A7"                         // int, writable
+	SimOperationFailures_rel_stat_B0                         string = "sim/operation/failures/rel_stat_B0"                         // int, writable
+	SimOperationFailures_rel_stat_B1                         string = "sim/operation/failures/rel_stat_B1"                         // int, writable
+	SimOperationFailures_rel_stat_B2                         string = "sim/operation/failures/rel_stat_B2"                         // int, writable
+	SimOperationFailures_rel_stat_B3                         string = "sim/operation/failures/rel_stat_B3"                         // int, writable
+	SimOperationFailures_rel_stat_B4                         string = "sim/operation/failures/rel_stat_B4"                         // int, writable
+	SimOperationFailures_rel_stat_B5                         string = "sim/operation/failures/rel_stat_B5"                         // int, writable
+	SimOperationFailures_rel_stat_B6                         string = "sim/operation/failures/rel_stat_B6"                         // int, writable
+	SimOperationFailures_rel_stat_B7                         string = "sim/operation/failures/rel_stat_B7"                         // int, writable
+	SimOperationFailures_rel_magLFT0                         string = "sim/operation/failures/rel_magLFT0"                         // int, writable
+	SimOperationFailures_rel_magLFT1                         string = "sim/operation/failures/rel_magLFT1"                         // int, writable
+	SimOperationFailures_rel_magLFT2                         string = "sim/operation/failures/rel_magLFT2"                         // int, writable
+	SimOperationFailures_rel_magLFT3                         string = "sim/operation/failures/rel_magLFT3"                         // int, writable
+	SimOperationFailures_rel_magLFT4                         string = "sim/operation/failures/rel_magLFT4"                         // int, writable
+	SimOperationFailures_rel_magLFT5                         string = "sim/operation/failures/rel_magLFT5"                         // int, writable
+	SimOperationFailures_rel_magLFT6                         string = "sim/operation/failures/rel_magLFT6"                         // int, writable
+	SimOperationFailures_rel_magLFT7                         string = "sim/operation/failures/rel_magLFT7"                         // int, writable
+	SimOperationFailures_rel_magRGT0                         string = "sim/operation/failures/rel_magRGT0"                         // int, writable
+	SimOperationFailures_rel_magRGT1                         string = "sim/operation/failures/rel_magRGT1"                         // int, writable
+	SimOperationFailures_rel_magRGT2                         string = "sim/operation/failures/rel_magRGT2"                         // int, writable
+	SimOperationFailures_rel_magRGT3                         string = "sim/operation/failures/rel_magRGT3"                         // int, writable
+	SimOperationFailures_rel_magRGT4                         string = "sim/operation/failures/rel_magRGT4"                         // int, writable
+	SimOperationFailures_rel_magRGT5                         string = "sim/operation/failures/rel_magRGT5"                         // int, writable
+	SimOperationFailures_rel_magRGT6                         string = "sim/operation/failures/rel_magRGT6"                         // int, writable
+	SimOperationFailures_rel_magRGT7                         string = "sim/operation/failures/rel_magRGT7"                         // int, writable
+	SimOperationFailures_rel_engfir0                         string = "sim/operation/failures/rel_engfir0"                         // int, writable
+	SimOperationFailures_rel_engfir1                         string = "sim/operation/failures/rel_engfir1"                         // int, writable
+	SimOperationFailures_rel_engfir2                         string = "sim/operation/failures/rel_engfir2"                         // int, writable
+	SimOperationFailures_rel_engfir3                         string = "sim/operation/failures/rel_engfir3"                         // int, writable
+	SimOperationFailures_rel_engfir4                         string = "sim/operation/failures/rel_engfir4"                         // int, writable
+	SimOperationFailures_rel_engfir5                         string = "sim/operation/failures/rel_engfir5"                         // int, writable
+	SimOperationFailures_rel_engfir6                         string = "sim/operation/failures/rel_engfir6"                         // int, writable
+	SimOperationFailures_rel_engfir7                         string = "sim/operation/failures/rel_engfir7"                         // int, writable
+	SimOperationFailures_rel_engfla0                         string = "sim/operation/failures/rel_engfla0"                         // int, writable
+	SimOperationFailures_rel_engfla1                         string = "sim/operation/failures/rel_engfla1"                         // int, writable
+	SimOperationFailures_rel_engfla2                         string = "sim/operation/failures/rel_engfla2"                         // int, writable
+	SimOperationFailures_rel_engfla3                         string = "sim/operation/failures/rel_engfla3"                         // int, writable
+	SimOperationFailures_rel_engfla4                         string = "sim/operation/failures/rel_engfla4"                         // int, writable
+	SimOperationFailures_rel_engfla5                         string = "sim/operation/failures/rel_engfla5"                         // int, writable
+	SimOperationFailures_rel_engfla6                         string = "sim/operation/failures/rel_engfla6"                         // int, writable
+	SimOperationFailures_rel_engfla7                         string = "sim/operation/failures/rel_engfla7"                         // int, writable
+	SimOperationFailures_rel_engfai0                         string = "sim/operation/failures/rel_engfai0"                         // int, writable
+	SimOperationFailures_rel_engfai1                         string = "sim/operation/failures/rel_engfai1"                         // int, writable
+	SimOperationFailures_rel_engfai2                         string = "sim/operation/failures/rel_engfai2"                         // int, writable
+	SimOperationFailures_rel_engfai3                         string = "sim/operation/failures/rel_engfai3"                         // int, writable
+	SimOperationFailures_rel_engfai4                         string = "sim/operation/failures/rel_engfai4"                         // int, writable
+	SimOperationFailures_rel_engfai5                         string = "sim/operation/failures/rel_engfai5"                         // int, writable
+	SimOperationFailures_rel_engfai6                         string = "sim/operation/failures/rel_engfai6"                         // int, writable
+	SimOperationFailures_rel_engfai7                         string = "sim/operation/failures/rel_engfai7"                         // int, writable
+	SimOperationFailures_rel_engsep0                         string = "sim/operation/failures/rel_engsep0"                         // int, writable
+	SimOperationFailures_rel_engsep1                         string = "sim/operation/failures/rel_engsep1"                         // int, writable
+	SimOperationFailures_rel_engsep2                         string = "sim/operation/failures/rel_engsep2"                         // int, writable
+	SimOperationFailures_rel_engsep3                         string = "sim/operation/failures/rel_engsep3"                         // int, writable
+	SimOperationFailures_rel_engsep4                         string = "sim/operation/failures/rel_engsep4"                         // int, writable
+	SimOperationFailures_rel_engsep5                         string = "sim/operation/failures/rel_engsep5"                         // int, writable
+	SimOperationFailures_rel_engsep6                         string = "sim/operation/failures/rel_engsep6"                         // int, writable
+	SimOperationFailures_rel_engsep7                         string = "sim/operation/failures/rel_engsep7"                         // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp0                string = "sim/operation/failures/rel_lo_press_fuepmp0"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp1                string = "sim/operation/failures/rel_lo_press_fuepmp1"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp2                string = "sim/operation/failures/rel_lo_press_fuepmp2"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp3                string = "sim/operation/failures/rel_lo_press_fuepmp3"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp4                string = "sim/operation/failures/rel_lo_press_fuepmp4"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp5                string = "sim/operation/failures/rel_lo_press_fuepmp5"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp6                string = "sim/operation/failures/rel_lo_press_fuepmp6"                // int, writable
+	SimOperationFailures_rel_lo_press_fuepmp7                string = "sim/operation/failures/rel_lo_press_fuepmp7"                // int, writable
+	SimOperationFailures_rel_fuepmp0                         string = "sim/operation/failures/rel_fuepmp0"                         // int, writable
+	SimOperationFailures_rel_fuepmp1                         string = "sim/operation/failures/rel_fuepmp1"                         // int, writable
+	SimOperationFailures_rel_fuepmp2                         string = "sim/operation/failures/rel_fuepmp2"                         // int, writable
+	SimOperationFailures_rel_fuepmp3                         string = "sim/operation/failures/rel_fuepmp3"                         // int, writable
+	SimOperationFailures_rel_fuepmp4                         string = "sim/operation/failures/rel_fuepmp4"                         // int, writable
+	SimOperationFailures_rel_fuepmp5                         string = "sim/operation/failures/rel_fuepmp5"                         // int, writable
+	SimOperationFailures_rel_fuepmp6                         string = "sim/operation/failures/rel_fuepmp6"                         // int, writable
+	SimOperationFailures_rel_fuepmp7                         string = "sim/operation/failures/rel_fuepmp7"                         // int, writable
+	SimOperationFailures_rel_ele_fuepmp0                     string = "sim/operation/failures/rel_ele_fuepmp0"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp1                     string = "sim/operation/failures/rel_ele_fuepmp1"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp2                     string = "sim/operation/failures/rel_ele_fuepmp2"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp3                     string = "sim/operation/failures/rel_ele_fuepmp3"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp4                     string = "sim/operation/failures/rel_ele_fuepmp4"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp5                     string = "sim/operation/failures/rel_ele_fuepmp5"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp6                     string = "sim/operation/failures/rel_ele_fuepmp6"                     // int, writable
+	SimOperationFailures_rel_ele_fuepmp7                     string = "sim/operation/failures/rel_ele_fuepmp7"                     // int, writable
+	SimOperationFailures_rel_eng_lo0                         string = "sim/operation/failures/rel_eng_lo0"                         // int, writable
+	SimOperationFailures_rel_eng_lo1                         string = "sim/operation/failures/rel_eng_lo1"                         // int, writable
+	SimOperationFailures_rel_eng_lo2                         string = "sim/operation/failures/rel_eng_lo2"                         // int, writable
+	SimOperationFailures_rel_eng_lo3                         string = "sim/operation/failures/rel_eng_lo3"                         // int, writable
+	SimOperationFailures_rel_eng_lo4                         string = "sim/operation/failures/rel_eng_lo4"                         // int, writable
+	SimOperationFailures_rel_eng_lo5                         string = "sim/operation/failures/rel_eng_lo5"                         // int, writable
+	SimOperationFailures_rel_eng_lo6                         string = "sim/operation/failures/rel_eng_lo6"                         // int, writable
+	SimOperationFailures_rel_eng_lo7                         string = "sim/operation/failures/rel_eng_lo7"                         // int, writable
+	SimOperationFailures_rel_airres0                         string = "sim/operation/failures/rel_airres0"                         // int, writable
+	SimOperationFailures_rel_airres1                         string = "sim/operation/failures/rel_airres1"                         // int, writable
+	SimOperationFailures_rel_airres2                         string = "sim/operation/failures/rel_airres2"                         // int, writable
+	SimOperationFailures_rel_airres3                         string = "sim/operation/failures/rel_airres3"                         // int, writable
+	SimOperationFailures_rel_airres4                         string = "sim/operation/failures/rel_airres4"                         // int, writable
+	SimOperationFailures_rel_airres5                         string = "sim/operation/failures/rel_airres5"                         // int, writable
+	SimOperationFailures_rel_airres6                         string = "sim/operation/failures/rel_airres6"                         // int, writable
+	SimOperationFailures_rel_airres7                         string = "sim/operation/failures/rel_airres7"                         // int, writable
+	SimOperationFailures_rel_fuelfl0                         string = "sim/operation/failures/rel_fuelfl0"                         // int, writable
+	SimOperationFailures_rel_fuelfl1                         string = "sim/operation/failures/rel_fuelfl1"                         // int, writable
+	SimOperationFailures_rel_fuelfl2                         string = "sim/operation/failures/rel_fuelfl2"                         // int, writable
+	SimOperationFailures_rel_fuelfl3                         string = "sim/operation/failures/rel_fuelfl3"                         // int, writable
+	SimOperationFailures_rel_fuelfl4                         string = "sim/operation/failures/rel_fuelfl4"                         // int, writable
+	SimOperationFailures_rel_fuelfl5                         string = "sim/operation/failures/rel_fuelfl5"                         // int, writable
+	SimOperationFailures_rel_fuelfl6                         string = "sim/operation/failures/rel_fuelfl6"                         // int, writable
+	SimOperationFailures_rel_fuelfl7                         string = "sim/operation/failures/rel_fuelfl7"                         // int, writable
+	SimOperationFailures_rel_comsta0                         string = "sim/operation/failures/rel_comsta0"                         // int, writable
+	SimOperationFailures_rel_comsta1                         string = "sim/operation/failures/rel_comsta1"                         // int, writable
+	SimOperationFailures_rel_comsta2                         string = "sim/operation/failures/rel_comsta2"                         // int, writable
+	SimOperationFailures_rel_comsta3                         string = "sim/operation/failures/rel_comsta3"                         // int, writable
+	SimOperationFailures_rel_comsta4                         string = "sim/operation/failures/rel_comsta4"                         // int, writable
+	SimOperationFailures_rel_comsta5                         string = "sim/operation/failures/rel_comsta5"                         // int, writable
+	SimOperationFailures_rel_comsta6                         string = "sim/operation/failures/rel_comsta6"                         // int, writable
+	SimOperationFailures_rel_comsta7                         string = "sim/operation/failures/rel_comsta7"                         // int, writable
+	SimOperationFailures_rel_startr0                         string = "sim/operation/failures/rel_startr0"                         // int, writable
+	SimOperationFailures_rel_startr1                         string = "sim/operation/failures/rel_startr1"                         // int, writable
+	SimOperationFailures_rel_startr2                         string = "sim/operation/failures/rel_startr2"                         // int, writable
+	SimOperationFailures_rel_startr3                         string = "sim/operation/failures/rel_startr3"                         // int, writable
+	SimOperationFailures_rel_startr4                         string = "sim/operation/failures/rel_startr4"                         // int, writable
+	SimOperationFailures_rel_startr5                         string = "sim/operation/failures/rel_startr5"                         // int, writable
+	SimOperationFailures_rel_startr6                         string = "sim/operation/failures/rel_startr6"                         // int, writable
+	SimOperationFailures_rel_startr7                         string = "sim/operation/failures/rel_startr7"                         // int, writable
+	SimOperationFailures_rel_ignitr0                         string = "sim/operation/failures/rel_ignitr0"                         // int, writable
+	SimOperationFailures_rel_ignitr1                         string = "sim/operation/failures/rel_ignitr1"                         // int, writable
+	SimOperationFailures_rel_ignitr2                         string = "sim/operation/failures/rel_ignitr2"                         // int, writable
+	SimOperationFailures_rel_ignitr3                         string = "sim/operation/failures/rel_ignitr3"                         // int, writable
+	SimOperationFailures_rel_ignitr4                         string = "sim/operation/failures/rel_ignitr4"                         // int, writable
+	SimOperationFailures_rel_ignitr5                         string = "sim/operation/failures/rel_ignitr5"                         // int, writable
+	SimOperationFailures_rel_ignitr6                         string = "sim/operation/failures/rel_ignitr6"                         // int, writable
+	SimOperationFailures_rel_ignitr7                         string = "sim/operation/failures/rel_ignitr7"                         // int, writable
+	SimOperationFailures_rel_hunsta0                         string = "sim/operation/failures/rel_hunsta0"                         // int, writable
+	SimOperationFailures_rel_hunsta1                         string = "sim/operation/failures/rel_hunsta1"                         // int, writable
+	SimOperationFailures_rel_hunsta2                         string = "sim/operation/failures/rel_hunsta2"                         // int, writable
+	SimOperationFailures_rel_hunsta3                         string = "sim/operation/failures/rel_hunsta3"                         // int, writable
+	SimOperationFailures_rel_hunsta4                         string = "sim/operation/failures/rel_hunsta4"                         // int, writable
+	SimOperationFailures_rel_hunsta5                         string = "sim/operation/failures/rel_hunsta5"                         // int, writable
+	SimOperationFailures_rel_hunsta6                         string = "sim/operation/failures/rel_hunsta6"                         // int, writable
+	SimOperationFailures_rel_hunsta7                         string = "sim/operation/failures/rel_hunsta7"                         // int, writable
+	SimOperationFailures_rel_clonoz0                         string = "sim/operation/failures/rel_clonoz0"                         // int, writable
+	SimOperationFailures_rel_clonoz1                         string = "sim/operation/failures/rel_clonoz1"                         // int, writable
+	SimOperationFailures_rel_clonoz2                         string = "sim/operation/failures/rel_clonoz2"                         // int, writable
+	SimOperationFailures_rel_clonoz3                         string = "sim/operation/failures/rel_clonoz3"                         // int, writable
+	SimOperationFailures_rel_clonoz4                         string = "sim/operation/failures/rel_clonoz4"                         // int, writable
+	SimOperationFailures_rel_clonoz5                         string = "sim/operation/failures/rel_clonoz5"                         // int, writable
+	SimOperationFailures_rel_clonoz6                         string = "sim/operation/failures/rel_clonoz6"                         // int, writable
+	SimOperationFailures_rel_clonoz7                         string = "sim/operation/failures/rel_clonoz7"                         // int, writable
+	SimOperationFailures_rel_hotsta0                         string = "sim/operation/failures/rel_hotsta0"                         // int, writable
+	SimOperationFailures_rel_hotsta1                         string = "sim/operation/failures/rel_hotsta1"                         // int, writable
+	SimOperationFailures_rel_hotsta2                         string = "sim/operation/failures/rel_hotsta2"                         // int, writable
+	SimOperationFailures_rel_hotsta3                         string = "sim/operation/failures/rel_hotsta3"                         // int, writable
+	SimOperationFailures_rel_hotsta4                         string = "sim/operation/failures/rel_hotsta4"                         // int, writable
+	SimOperationFailures_rel_hotsta5                         string = "sim/operation/failures/rel_hotsta5"                         // int, writable
+	SimOperationFailures_rel_hotsta6                         string = "sim/operation/failures/rel_hotsta6"                         // int, writable
+	SimOperationFailures_rel_hotsta7                         string = "sim/operation/failures/rel_hotsta7"                         // int, writable
+	SimOperationFailures_rel_runITT0                         string = "sim/operation/failures/rel_runITT0"                         // int, writable
+	SimOperationFailures_rel_runITT1                         string = "sim/operation/failures/rel_runITT1"                         // int, writable
+	SimOperationFailures_rel_runITT2                         string = "sim/operation/failures/rel_runITT2"                         // int, writable
+	SimOperationFailures_rel_runITT3                         string = "sim/operation/failures/rel_runITT3"                         // int, writable
+	SimOperationFailures_rel_runITT4                         string = "sim/operation/failures/rel_runITT4"                         // int, writable
+	SimOperationFailures_rel_runITT5                         string = "sim/operation/failures/rel_runITT5"                         // int, writable
+	SimOperationFailures_rel_runITT6                         string = "sim/operation/failures/rel_runITT6"                         // int, writable
+	SimOperationFailures_rel_runITT7                         string = "sim/operation/failures/rel_runITT7"                         // int, writable
+	SimOperationFailures_rel_prpfin0                         string = "sim/operation/failures/rel_prpfin0"                         // int, writable
+	SimOperationFailures_rel_prpfin1                         string = "sim/operation/failures/rel_prpfin1"                         // int, writable
+	SimOperationFailures_rel_prpfin2                         string = "sim/operation/failures/rel_prpfin2"                         // int, writable
+	SimOperationFailures_rel_prpfin3                         string = "sim/operation/failures/rel_prpfin3"                         // int, writable
+	SimOperationFailures_rel_prpfin4                         string = "sim/operation/failures/rel_prpfin4"                         // int, writable
+	SimOperationFailures_rel_prpfin5                         string = "sim/operation/failures/rel_prpfin5"                         // int, writable
+	SimOperationFailures_rel_prpfin6                         string = "sim/operation/failures/rel_prpfin6"                         // int, writable
+	SimOperationFailures_rel_prpfin7                         string = "sim/operation/failures/rel_prpfin7"                         // int, writable
+	SimOperationFailures_rel_prpcrs0                         string = "sim/operation/failures/rel_prpcrs0"                         // int, writable
+	SimOperationFailures_rel_prpcrs1                         string = "sim/operation/failures/rel_prpcrs1"                         // int, writable
+	SimOperationFailures_rel_prpcrs2                         string = "sim/operation/failures/rel_prpcrs2"                         // int, writable
+	SimOperationFailures_rel_prpcrs3                         string = "sim/operation/failures/rel_prpcrs3"                         // int, writable
+	SimOperationFailures_rel_prpcrs4                         string = "sim/operation/failures/rel_prpcrs4"                         // int, writable
+	SimOperationFailures_rel_prpcrs5                         string = "sim/operation/failures/rel_prpcrs5"                         // int, writable
+	SimOperationFailures_rel_prpcrs6                         string = "sim/operation/failures/rel_prpcrs6"                         // int, writable
+	SimOperationFailures_rel_prpcrs7                         string = "sim/operation/failures/rel_prpcrs7"                         // int, writable
+	SimOperationFailures_rel_prpsep0                         string = "sim/operation/failures/rel_prpsep0"                         // int, writable
+	SimOperationFailures_rel_prpsep1                         string = "sim/operation/failures/rel_prpsep1"                         // int, writable
+	SimOperationFailures_rel_prpsep2                         string = "sim/operation/failures/rel_prpsep2"                         // int, writable
+	SimOperationFailures_rel_prpsep3                         string = "sim/operation/failures/rel_prpsep3"                         // int, writable
+	SimOperationFailures_rel_prpsep4                         string = "sim/operation/failures/rel_prpsep4"                         // int, writable
+	SimOperationFailures_rel_prpsep5                         string = "sim/operation/failures/rel_prpsep5"                         // int, writable
+	SimOperationFailures_rel_prpsep6                         string = "sim/operation/failures/rel_prpsep6"                         // int, writable
+	SimOperationFailures_rel_prpsep7                         string = "sim/operation/failures/rel_prpsep7"                         // int, writable
+	SimOperationFailures_rel_pshaft0                         string = "sim/operation/failures/rel_pshaft0"                         // int, writable
+	SimOperationFailures_rel_pshaft1                         string = "sim/operation/failures/rel_pshaft1"                         // int, writable
+	SimOperationFailures_rel_pshaft2                         string = "sim/operation/failures/rel_pshaft2"                         // int, writable
+	SimOperationFailures_rel_pshaft3                         string = "sim/operation/failures/rel_pshaft3"                         // int, writable
+	SimOperationFailures_rel_pshaft4                         string = "sim/operation/failures/rel_pshaft4"                         // int, writable
+	SimOperationFailures_rel_pshaft5                         string = "sim/operation/failures/rel_pshaft5"                         // int, writable
+	SimOperationFailures_rel_pshaft6                         string = "sim/operation/failures/rel_pshaft6"                         // int, writable
+	SimOperationFailures_rel_pshaft7                         string = "sim/operation/failures/rel_pshaft7"                         // int, writable
+	SimOperationFailures_rel_seize_0                         string = "sim/operation/failures/rel_seize_0"                         // int, writable
+	SimOperationFailures_rel_seize_1                         string = "sim/operation/failures/rel_seize_1"                         // int, writable
+	SimOperationFailures_rel_seize_2                         string = "sim/operation/failures/rel_seize_2"                         // int, writable
+	SimOperationFailures_rel_seize_3                         string = "sim/operation/failures/rel_seize_3"                         // int, writable
+	SimOperationFailures_rel_seize_4                         string = "sim/operation/failures/rel_seize_4"                         // int, writable
+	SimOperationFailures_rel_seize_5                         string = "sim/operation/failures/rel_seize_5"                         // int, writable
+	SimOperationFailures_rel_seize_6                         string = "sim/operation/failures/rel_seize_6"                         // int, writable
+	SimOperationFailures_rel_seize_7                         string = "sim/operation/failures/rel_seize_7"                         // int, writable
+	SimOperationFailures_rel_revers0                         string = "sim/operation/failures/rel_revers0"                         // int, writable
+	SimOperationFailures_rel_revers1                         string = "sim/operation/failures/rel_revers1"                         // int, writable
+	SimOperationFailures_rel_revers2                         string = "sim/operation/failures/rel_revers2"                         // int, writable
+	SimOperationFailures_rel_revers3                         string = "sim/operation/failures/rel_revers3"                         // int, writable
+	SimOperationFailures_rel_revers4                         string = "sim/operation/failures/rel_revers4"                         // int, writable
+	SimOperationFailures_rel_revers5                         string = "sim/operation/failures/rel_revers5"                         // int, writable
+	SimOperationFailures_rel_revers6                         string = "sim/operation/failures/rel_revers6"                         // int, writable
+	SimOperationFailures_rel_revers7                         string = "sim/operation/failures/rel_revers7"                         // int, writable
+	SimOperationFailures_rel_revdep0                         string = "sim/operation/failures/rel_revdep0"                         // int, writable
+	SimOperationFailures_rel_revdep1                         string = "sim/operation/failures/rel_revdep1"                         // int, writable
+	SimOperationFailures_rel_revdep2                         string = "sim/operation/failures/rel_revdep2"                         // int, writable
+	SimOperationFailures_rel_revdep3                         string = "sim/operation/failures/rel_revdep3"                         // int, writable
+	SimOperationFailures_rel_revdep4                         string = "sim/operation/failures/rel_revdep4"                         // int, writable
+	SimOperationFailures_rel_revdep5                         string = "sim/operation/failures/rel_revdep5"                         // int, writable
+	SimOperationFailures_rel_revdep6                         string = "sim/operation/failures/rel_revdep6"                         // int, writable
+	SimOperationFailures_rel_revdep7                         string = "sim/operation/failures/rel_revdep7"                         // int, writable
+	SimOperationFailures_rel_revloc0                         string = "sim/operation/failures/rel_revloc0"                         // int, writable
+	SimOperationFailures_rel_revloc1                         string = "sim/operation/failures/rel_revloc1"                         // int, writable
+	SimOperationFailures_rel_revloc2                         string = "sim/operation/failures/rel_revloc2"                         // int, writable
+	SimOperationFailures_rel_revloc3                         string = "sim/operation/failures/rel_revloc3"                         // int, writable
+	SimOperationFailures_rel_revloc4                         string = "sim/operation/failures/rel_revloc4"                         // int, writable
+	SimOperationFailures_rel_revloc5                         string = "sim/operation/failures/rel_revloc5"                         // int, writable
+	SimOperationFailures_rel_revloc6                         string = "sim/operation/failures/rel_revloc6"                         // int, writable
+	SimOperationFailures_rel_revloc7                         string = "sim/operation/failures/rel_revloc7"                         // int, writable
+	SimOperationFailures_rel_aftbur0                         string = "sim/operation/failures/rel_aftbur0"                         // int, writable
+	SimOperationFailures_rel_aftbur1                         string = "sim/operation/failures/rel_aftbur1"                         // int, writable
+	SimOperationFailures_rel_aftbur2                         string = "sim/operation/failures/rel_aftbur2"                         // int, writable
+	SimOperationFailures_rel_aftbur3                         string = "sim/operation/failures/rel_aftbur3"                         // int, writable
+	SimOperationFailures_rel_aftbur4                         string = "sim/operation/failures/rel_aftbur4"                         // int, writable
+	SimOperationFailures_rel_aftbur5                         string = "sim/operation/failures/rel_aftbur5"                         // int, writable
+	SimOperationFailures_rel_aftbur6                         string = "sim/operation/failures/rel_aftbur6"                         // int, writable
+	SimOperationFailures_rel_aftbur7                         string = "sim/operation/failures/rel_aftbur7"                         // int, writable
+	SimOperationFailures_rel_govnr_0                         string = "sim/operation/failures/rel_govnr_0"                         // int, writable
+	SimOperationFailures_rel_govnr_1                         string = "sim/operation/failures/rel_govnr_1"                         // int, writable
+	SimOperationFailures_rel_govnr_2                         string = "sim/operation/failures/rel_govnr_2"                         // int, writable
+	SimOperationFailures_rel_govnr_3                         string = "sim/operation/failures/rel_govnr_3"                         // int, writable
+	SimOperationFailures_rel_govnr_4                         string = "sim/operation/failures/rel_govnr_4"                         // int, writable
+	SimOperationFailures_rel_govnr_5                         string = "sim/operation/failures/rel_govnr_5"                         // int, writable
+	SimOperationFailures_rel_govnr_6                         string = "sim/operation/failures/rel_govnr_6"                         // int, writable
+	SimOperationFailures_rel_govnr_7                         string = "sim/operation/failures/rel_govnr_7"                         // int, writable
+	SimOperationFailures_rel_fadec_0                         string = "sim/operation/failures/rel_fadec_0"                         // int, writable
+	SimOperationFailures_rel_fadec_1                         string = "sim/operation/failures/rel_fadec_1"                         // int, writable
+	SimOperationFailures_rel_fadec_2                         string = "sim/operation/failures/rel_fadec_2"                         // int, writable
+	SimOperationFailures_rel_fadec_3                         string = "sim/operation/failures/rel_fadec_3"                         // int, writable
+	SimOperationFailures_rel_fadec_4                         string = "sim/operation/failures/rel_fadec_4"                         // int, writable
+	SimOperationFailures_rel_fadec_5                         string = "sim/operation/failures/rel_fadec_5"                         // int, writable
+	SimOperationFailures_rel_fadec_6                         string = "sim/operation/failures/rel_fadec_6"                         // int, writable
+	SimOperationFailures_rel_fadec_7                         string = "sim/operation/failures/rel_fadec_7"                         // int, writable
+	SimOperationFailures_rel_oilpmp0                         string = "sim/operation/failures/rel_oilpmp0"                         // int, writable
+	SimOperationFailures_rel_oilpmp1                         string = "sim/operation/failures/rel_oilpmp1"                         // int, writable
+	SimOperationFailures_rel_oilpmp2                         string = "sim/operation/failures/rel_oilpmp2"                         // int, writable
+	SimOperationFailures_rel_oilpmp3                         string = "sim/operation/failures/rel_oilpmp3"                         // int, writable
+	SimOperationFailures_rel_oilpmp4                         string = "sim/operation/failures/rel_oilpmp4"                         // int, writable
+	SimOperationFailures_rel_oilpmp5                         string = "sim/operation/failures/rel_oilpmp5"                         // int, writable
+	SimOperationFailures_rel_oilpmp6                         string = "sim/operation/failures/rel_oilpmp6"                         // int, writable
+	SimOperationFailures_rel_oilpmp7                         string = "sim/operation/failures/rel_oilpmp7"                         // int, writable
+	SimOperationFailures_rel_chipde0                         string = "sim/operation/failures/rel_chipde0"                         // int, writable
+	SimOperationFailures_rel_chipde1                         string = "sim/operation/failures/rel_chipde1"                         // int, writable
+	SimOperationFailures_rel_chipde2                         string = "sim/operation/failures/rel_chipde2"                         // int, writable
+	SimOperationFailures_rel_chipde3                         string = "sim/operation/failures/rel_chipde3"                         // int, writable
+	SimOperationFailures_rel_chipde4                         string = "sim/operation/failures/rel_chipde4"                         // int, writable
+	SimOperationFailures_rel_chipde5                         string = "sim/operation/failures/rel_chipde5"                         // int, writable
+	SimOperationFailures_rel_chipde6                         string = "sim/operation/failures/rel_chipde6"                         // int, writable
+	SimOperationFailures_rel_chipde7                         string = "sim/operation/failures/rel_chipde7"                         // int, writable
+	SimOperationFailures_rel_wing1L                          string = "sim/operation/failures/rel_wing1L"                          // int, writable
+	SimOperationFailures_rel_wing1R                          string = "sim/operation/failures/rel_wing1R"                          // int, writable
+	SimOperationFailures_rel_wing2L                          string = "sim/operation/failures/rel_wing2L"                          // int, writable
+	SimOperationFailures_rel_wing2R                          string = "sim/operation/failures/rel_wing2R"                          // int, writable
+	SimOperationFailures_rel_wing3L                          string = "sim/operation/failures/rel_wing3L"                          // int, writable
+	SimOperationFailures_rel_wing3R                          string = "sim/operation/failures/rel_wing3R"                          // int, writable
+	SimOperationFailures_rel_wing4L                          string = "sim/operation/failures/rel_wing4L"                          // int, writable
+	SimOperationFailures_rel_wing4R                          string = "sim/operation/failures/rel_wing4R"                          // int, writable
+	SimOperationFailures_rel_hstbL                           string = "sim/operation/failures/rel_hstbL"                           // int, writable
+	SimOperationFailures_rel_hstbR                           string = "sim/operation/failures/rel_hstbR"                           // int, writable
+	SimOperationFailures_rel_vstb1                           string = "sim/operation/failures/rel_vstb1"                           // int, writable
+	SimOperationFailures_rel_vstb2                           string = "sim/operation/failures/rel_vstb2"                           // int, writable
+	SimOperationFailures_rel_mwing1                          string = "sim/operation/failures/rel_mwing1"                          // int, writable
+	SimOperationFailures_rel_mwing2                          string = "sim/operation/failures/rel_mwing2"                          // int, writable
+	SimOperationFailures_rel_mwing3                          string = "sim/operation/failures/rel_mwing3"                          // int, writable
+	SimOperationFailures_rel_mwing4                          string = "sim/operation/failures/rel_mwing4"                          // int, writable
+	SimOperationFailures_rel_mwing5                          string = "sim/operation/failures/rel_mwing5"                          // int, writable
+	SimOperationFailures_rel_mwing6                          string = "sim/operation/failures/rel_mwing6"                          // int, writable
+	SimOperationFailures_rel_mwing7                          string = "sim/operation/failures/rel_mwing7"                          // int, writable
+	SimOperationFailures_rel_mwing8                          string = "sim/operation/failures/rel_mwing8"                          // int, writable
+	SimOperationFailures_rel_mwing9                          string = "sim/operation/failures/rel_mwing9"                          // int, writable
+	SimOperationFailures_rel_mwing10                         string = "sim/operation/failures/rel_mwing10"                         // int, writable
+	SimOperationFailures_rel_mwing11                         string = "sim/operation/failures/rel_mwing11"                         // int, writable
+	SimOperationFailures_rel_mwing12                         string = "sim/operation/failures/rel_mwing12"                         // int, writable
+	SimOperationFailures_rel_mwing13                         string = "sim/operation/failures/rel_mwing13"                         // int, writable
+	SimOperationFailures_rel_mwing14                         string = "sim/operation/failures/rel_mwing14"                         // int, writable
+	SimOperationFailures_rel_mwing15                         string = "sim/operation/failures/rel_mwing15"                         // int, writable
+	SimOperationFailures_rel_mwing16                         string = "sim/operation/failures/rel_mwing16"                         // int, writable
+	SimOperationFailures_rel_mwing17                         string = "sim/operation/failures/rel_mwing17"                         // int, writable
+	SimOperationFailures_rel_mwing18                         string = "sim/operation/failures/rel_mwing18"                         // int, writable
+	SimOperationFailures_rel_mwing19                         string = "sim/operation/failures/rel_mwing19"                         // int, writable
+	SimOperationFailures_rel_mwing20                         string = "sim/operation/failures/rel_mwing20"                         // int, writable
+	SimOperationFailures_rel_mwing21                         string = "sim/operation/failures/rel_mwing21"                         // int, writable
+	SimOperationFailures_rel_mwing22                         string = "sim/operation/failures/rel_mwing22"                         // int, writable
+	SimOperationFailures_rel_mwing23                         string = "sim/operation/failures/rel_mwing23"                         // int, writable
+	SimOperationFailures_rel_mwing24                         string = "sim/operation/failures/rel_mwing24"                         // int, writable
+	SimOperationFailures_rel_mwing25                         string = "sim/operation/failures/rel_mwing25"                         // int, writable
+	SimOperationFailures_rel_mwing26                         string = "sim/operation/failures/rel_mwing26"                         // int, writable
+	SimOperationFailures_rel_mwing27                         string = "sim/operation/failures/rel_mwing27"                         // int, writable
+	SimOperationFailures_rel_mwing28                         string = "sim/operation/failures/rel_mwing28"                         // int, writable
+	SimOperationFailures_rel_mwing29                         string = "sim/operation/failures/rel_mwing29"                         // int, writable
+	SimOperationFailures_rel_mwing30                         string = "sim/operation/failures/rel_mwing30"                         // int, writable
+	SimOperationFailures_rel_mwing31                         string = "sim/operation/failures/rel_mwing31"                         // int, writable
+	SimOperationFailures_rel_mwing32                         string = "sim/operation/failures/rel_mwing32"                         // int, writable
+	SimOperationFailures_rel_mwing33                         string = "sim/operation/failures/rel_mwing33"                         // int, writable
+	SimOperationFailures_rel_mwing34                         string = "sim/operation/failures/rel_mwing34"                         // int, writable
+	SimOperationFailures_rel_mwing35                         string = "sim/operation/failures/rel_mwing35"                         // int, writable
+	SimOperationFailures_rel_mwing36                         string = "sim/operation/failures/rel_mwing36"                         // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_lft_lock            string = "sim/operation/failures/rel_fcon_ailn_1_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_rgt_lock            string = "sim/operation/failures/rel_fcon_ailn_1_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_lft_lock            string = "sim/operation/failures/rel_fcon_ailn_2_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_rgt_lock            string = "sim/operation/failures/rel_fcon_ailn_2_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_lft_lock            string = "sim/operation/failures/rel_fcon_elev_1_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_rgt_lock            string = "sim/operation/failures/rel_fcon_elev_1_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_lft_lock            string = "sim/operation/failures/rel_fcon_elev_2_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_rgt_lock            string = "sim/operation/failures/rel_fcon_elev_2_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_1_ctr_lock            string = "sim/operation/failures/rel_fcon_rudd_1_ctr_lock"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_2_ctr_lock            string = "sim/operation/failures/rel_fcon_rudd_2_ctr_lock"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_lft_lock            string = "sim/operation/failures/rel_fcon_rspo_1_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_rgt_lock            string = "sim/operation/failures/rel_fcon_rspo_1_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_lft_lock            string = "sim/operation/failures/rel_fcon_rspo_2_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_rgt_lock            string = "sim/operation/failures/rel_fcon_rspo_2_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_lft_lock            string = "sim/operation/failures/rel_fcon_yawb_x_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_rgt_lock            string = "sim/operation/failures/rel_fcon_yawb_x_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_lft_lock            string = "sim/operation/failures/rel_fcon_sbrk_1_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_rgt_lock            string = "sim/operation/failures/rel_fcon_sbrk_1_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_lft_lock            string = "sim/operation/failures/rel_fcon_sbrk_2_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_rgt_lock            string = "sim/operation/failures/rel_fcon_sbrk_2_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_lft_lock            string = "sim/operation/failures/rel_fcon_flap_1_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_rgt_lock            string = "sim/operation/failures/rel_fcon_flap_1_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_lft_lock            string = "sim/operation/failures/rel_fcon_flap_2_lft_lock"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_rgt_lock            string = "sim/operation/failures/rel_fcon_flap_2_rgt_lock"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_lft_mxdn            string = "sim/operation/failures/rel_fcon_ailn_1_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_rgt_mxdn            string = "sim/operation/failures/rel_fcon_ailn_1_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_lft_mxdn            string = "sim/operation/failures/rel_fcon_ailn_2_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_rgt_mxdn            string = "sim/operation/failures/rel_fcon_ailn_2_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_lft_mxdn            string = "sim/operation/failures/rel_fcon_elev_1_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_rgt_mxdn            string = "sim/operation/failures/rel_fcon_elev_1_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_lft_mxdn            string = "sim/operation/failures/rel_fcon_elev_2_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_rgt_mxdn            string = "sim/operation/failures/rel_fcon_elev_2_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_1_ctr_mxdn            string = "sim/operation/failures/rel_fcon_rudd_1_ctr_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_2_ctr_mxdn            string = "sim/operation/failures/rel_fcon_rudd_2_ctr_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_lft_mxdn            string = "sim/operation/failures/rel_fcon_rspo_1_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_rgt_mxdn            string = "sim/operation/failures/rel_fcon_rspo_1_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_lft_mxdn            string = "sim/operation/failures/rel_fcon_rspo_2_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_rgt_mxdn            string = "sim/operation/failures/rel_fcon_rspo_2_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_lft_mxdn            string = "sim/operation/failures/rel_fcon_yawb_x_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_rgt_mxdn            string = "sim/operation/failures/rel_fcon_yawb_x_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_lft_mxdn            string = "sim/operation/failures/rel_fcon_sbrk_1_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_rgt_mxdn            string = "sim/operation/failures/rel_fcon_sbrk_1_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_lft_mxdn            string = "sim/operation/failures/rel_fcon_sbrk_2_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_rgt_mxdn            string = "sim/operation/failures/rel_fcon_sbrk_2_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_lft_mxdn            string = "sim/operation/failures/rel_fcon_flap_1_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_rgt_mxdn            string = "sim/operation/failures/rel_fcon_flap_1_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_lft_mxdn            string = "sim/operation/failures/rel_fcon_flap_2_lft_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_rgt_mxdn            string = "sim/operation/failures/rel_fcon_flap_2_rgt_mxdn"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_lft_mxup            string = "sim/operation/failures/rel_fcon_ailn_1_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_rgt_mxup            string = "sim/operation/failures/rel_fcon_ailn_1_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_lft_mxup            string = "sim/operation/failures/rel_fcon_ailn_2_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_rgt_mxup            string = "sim/operation/failures/rel_fcon_ailn_2_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_lft_mxup            string = "sim/operation/failures/rel_fcon_elev_1_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_rgt_mxup            string = "sim/operation/failures/rel_fcon_elev_1_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_lft_mxup            string = "sim/operation/failures/rel_fcon_elev_2_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_rgt_mxup            string = "sim/operation/failures/rel_fcon_elev_2_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_1_ctr_mxup            string = "sim/operation/failures/rel_fcon_rudd_1_ctr_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_2_ctr_mxup            string = "sim/operation/failures/rel_fcon_rudd_2_ctr_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_lft_mxup            string = "sim/operation/failures/rel_fcon_rspo_1_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_rgt_mxup            string = "sim/operation/failures/rel_fcon_rspo_1_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_lft_mxup            string = "sim/operation/failures/rel_fcon_rspo_2_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_rgt_mxup            string = "sim/operation/failures/rel_fcon_rspo_2_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_lft_mxup            string = "sim/operation/failures/rel_fcon_yawb_x_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_rgt_mxup            string = "sim/operation/failures/rel_fcon_yawb_x_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_lft_mxup            string = "sim/operation/failures/rel_fcon_sbrk_1_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_rgt_mxup            string = "sim/operation/failures/rel_fcon_sbrk_1_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_lft_mxup            string = "sim/operation/failures/rel_fcon_sbrk_2_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_rgt_mxup            string = "sim/operation/failures/rel_fcon_sbrk_2_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_lft_mxup            string = "sim/operation/failures/rel_fcon_flap_1_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_rgt_mxup            string = "sim/operation/failures/rel_fcon_flap_1_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_lft_mxup            string = "sim/operation/failures/rel_fcon_flap_2_lft_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_rgt_mxup            string = "sim/operation/failures/rel_fcon_flap_2_rgt_mxup"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_lft_cntr            string = "sim/operation/failures/rel_fcon_ailn_1_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_rgt_cntr            string = "sim/operation/failures/rel_fcon_ailn_1_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_lft_cntr            string = "sim/operation/failures/rel_fcon_ailn_2_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_rgt_cntr            string = "sim/operation/failures/rel_fcon_ailn_2_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_lft_cntr            string = "sim/operation/failures/rel_fcon_elev_1_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_rgt_cntr            string = "sim/operation/failures/rel_fcon_elev_1_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_lft_cntr            string = "sim/operation/failures/rel_fcon_elev_2_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_rgt_cntr            string = "sim/operation/failures/rel_fcon_elev_2_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_1_ctr_cntr            string = "sim/operation/failures/rel_fcon_rudd_1_ctr_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_2_ctr_cntr            string = "sim/operation/failures/rel_fcon_rudd_2_ctr_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_lft_cntr            string = "sim/operation/failures/rel_fcon_rspo_1_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_rgt_cntr            string = "sim/operation/failures/rel_fcon_rspo_1_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_lft_cntr            string = "sim/operation/failures/rel_fcon_rspo_2_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_rgt_cntr            string = "sim/operation/failures/rel_fcon_rspo_2_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_lft_cntr            string = "sim/operation/failures/rel_fcon_yawb_x_lft_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_rgt_cntr            string = "sim/operation/failures/rel_fcon_yawb_x_rgt_cntr"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_lft_gone            string = "sim/operation/failures/rel_fcon_flap_1_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_lft_gone            string = "sim/operation/failures/rel_fcon_ailn_1_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_1_rgt_gone            string = "sim/operation/failures/rel_fcon_ailn_1_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_lft_gone            string = "sim/operation/failures/rel_fcon_ailn_2_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_ailn_2_rgt_gone            string = "sim/operation/failures/rel_fcon_ailn_2_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_lft_gone            string = "sim/operation/failures/rel_fcon_elev_1_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_elev_1_rgt_gone            string = "sim/operation/failures/rel_fcon_elev_1_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_lft_gone            string = "sim/operation/failures/rel_fcon_elev_2_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_elev_2_rgt_gone            string = "sim/operation/failures/rel_fcon_elev_2_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_1_ctr_gone            string = "sim/operation/failures/rel_fcon_rudd_1_ctr_gone"            // int, writable
+	SimOperationFailures_rel_fcon_rudd_2_ctr_gone            string = "sim/operation/failures/rel_fcon_rudd_2_ctr_gone"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_lft_gone            string = "sim/operation/failures/rel_fcon_rspo_1_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_1_rgt_gone            string = "sim/operation/failures/rel_fcon_rspo_1_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_lft_gone            string = "sim/operation/failures/rel_fcon_rspo_2_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_rspo_2_rgt_gone            string = "sim/operation/failures/rel_fcon_rspo_2_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_lft_gone            string = "sim/operation/failures/rel_fcon_yawb_x_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_yawb_x_rgt_gone            string = "sim/operation/failures/rel_fcon_yawb_x_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_lft_gone            string = "sim/operation/failures/rel_fcon_sbrk_1_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_1_rgt_gone            string = "sim/operation/failures/rel_fcon_sbrk_1_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_lft_gone            string = "sim/operation/failures/rel_fcon_sbrk_2_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_sbrk_2_rgt_gone            string = "sim/operation/failures/rel_fcon_sbrk_2_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_flap_1_rgt_gone            string = "sim/operation/failures/rel_fcon_flap_1_rgt_gone"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_lft_gone            string = "sim/operation/failures/rel_fcon_flap_2_lft_gone"            // int, writable
+	SimOperationFailures_rel_fcon_flap_2_rgt_gone            string = "sim/operation/failures/rel_fcon_flap_2_rgt_gone"            // int, writable
+	SimOperationFailures_rel_vector_actuator                 string = "sim/operation/failures/rel_vector_actuator"                 // int, writable
+	SimOperationFailures_rel_upper_IMU                       string = "sim/operation/failures/rel_upper_IMU"                       // int, writable
+	SimOperationFailures_rel_lower_IMU                       string = "sim/operation/failures/rel_lower_IMU"                       // int, writable
+	SimOperationFailures_rel_upper_battery                   string = "sim/operation/failures/rel_upper_battery"                   // int, writable
+	SimOperationFailures_rel_lower_battery                   string = "sim/operation/failures/rel_lower_battery"                   // int, writable
+	SimOperationFailures_rel_cascade_prop_LF                 string = "sim/operation/failures/rel_cascade_prop_LF"                 // int, writable
+	SimOperationFailures_rel_cascade_prop_RF                 string = "sim/operation/failures/rel_cascade_prop_RF"                 // int, writable
+	SimOperationFailures_rel_cascade_prop_LA                 string = "sim/operation/failures/rel_cascade_prop_LA"                 // int, writable
+	SimOperationFailures_rel_cascade_prop_RA                 string = "sim/operation/failures/rel_cascade_prop_RA"                 // int, writable
+	SimOperationFailures_rel_gen_esys                        string = "sim/operation/failures/rel_gen_esys"                        // int, writable
+	SimOperationFailures_rel_avionics_fan                    string = "sim/operation/failures/rel_avionics_fan"                    // int, writable
+	SimOperationFailures_rel_airbus_alternate                string = "sim/operation/failures/rel_airbus_alternate"                // int, writable
+	SimOperationFailures_rel_airbus_alternate2               string = "sim/operation/failures/rel_airbus_alternate2"               // int, writable
+	SimOperationFailures_rel_airbus_direct                   string = "sim/operation/failures/rel_airbus_direct"                   // int, writable
+	SimOperationFailures_rel_autoslats                       string = "sim/operation/failures/rel_autoslats"                       // int, writable
+	SimOperationFailures_rel_lagear_6                        string = "sim/operation/failures/rel_lagear_6"                        // int, writable
+	SimOperationFailures_rel_lagear_7                        string = "sim/operation/failures/rel_lagear_7"                        // int, writable
+	SimOperationFailures_rel_lagear_8                        string = "sim/operation/failures/rel_lagear_8"                        // int, writable
+	SimOperationFailures_rel_lagear_9                        string = "sim/operation/failures/rel_lagear_9"                        // int, writable
+	SimOperationFailures_rel_lagear_10                       string = "sim/operation/failures/rel_lagear_10"                       // int, writable
+	SimOperationFailures_rel_tire_6                          string = "sim/operation/failures/rel_tire_6"                          // int, writable
+	SimOperationFailures_rel_tire_7                          string = "sim/operation/failures/rel_tire_7"                          // int, writable
+	SimOperationFailures_rel_tire_8                          string = "sim/operation/failures/rel_tire_8"                          // int, writable
+	SimOperationFailures_rel_tire_9                          string = "sim/operation/failures/rel_tire_9"                          // int, writable
+	SimOperationFailures_rel_tire_10                         string = "sim/operation/failures/rel_tire_10"                         // int, writable
+	SimOperationFailures_rel_cop_AOA                         string = "sim/operation/failures/rel_cop_AOA"                         // int, writable
+	SimOperationFailures_rel_pil_radalt                      string = "sim/operation/failures/rel_pil_radalt"                      // int, writable
+	SimOperationFailures_rel_cop_radalt                      string = "sim/operation/failures/rel_cop_radalt"                      // int, writable
+	SimOperationFailures_rel_bus0_other_bus                  string = "sim/operation/failures/rel_bus0_other_bus"                  // int, writable
+	SimOperationFailures_rel_bus1_other_bus                  string = "sim/operation/failures/rel_bus1_other_bus"                  // int, writable
+	SimOperationFailures_rel_bus2_other_bus                  string = "sim/operation/failures/rel_bus2_other_bus"                  // int, writable
+	SimOperationFailures_rel_bus3_other_bus                  string = "sim/operation/failures/rel_bus3_other_bus"                  // int, writable
+	SimOperationFailures_rel_bus4_other_bus                  string = "sim/operation/failures/rel_bus4_other_bus"                  // int, writable
+	SimOperationFailures_rel_bus5_other_bus                  string = "sim/operation/failures/rel_bus5_other_bus"                  // int, writable
+	SimOperationG430_G430_is_vloc                            string = "sim/operation/g430/G430_is_vloc"                            // int_array
+	SimOperationMisc_debug_network                           string = "sim/operation/misc/debug_network"                           // int, writable
+	SimOperationMisc_frame_rate_period                       string = "sim/operation/misc/frame_rate_period"                       // float, writable
+	SimOperationMisc_time_ratio                              string = "sim/operation/misc/time_ratio"                              // float
+	SimOperationMisc_commandline                             string = "sim/operation/misc/commandline"                             // data
+	SimOperationOverride_override_joystick                   string = "sim/operation/override/override_joystick"                   // int, writable
+	SimOperationOverride_override_artstab                    string = "sim/operation/override/override_artstab"                    // int, writable
+	SimOperationOverride_override_flightcontrol              string = "sim/operation/override/override_flightcontrol"              // int, writable
+	SimOperationOverride_override_pitch_trim                 string = "sim/operation/override/override_pitch_trim"                 // int, writable
+	SimOperationOverride_override_roll_trim                  string = "sim/operation/override/override_roll_trim"                  // int, writable
+	SimOperationOverride_override_yaw_trim                   string = "sim/operation/override/override_yaw_trim"                   // int, writable
+	SimOperationOverride_override_gearbrake                  string = "sim/operation/override/override_gearbrake"                  // int, writable
+	SimOperationOverride_override_planepath                  string = "sim/operation/override/override_planepath"                  // int_array, writable
+	SimOperationOverride_override_plane_ai_autopilot         string = "sim/operation/override/override_plane_ai_autopilot"         // int_array, writable
+	SimOperationOverride_override_navneedles                 string = "sim/operation/override/override_navneedles"                 // int, writable
+	SimOperationOverride_override_nav1_needles               string = "sim/operation/override/override_nav1_needles"               // int, writable
+	SimOperationOverride_override_nav2_needles               string = "sim/operation/override/override_nav2_needles"               // int, writable
+	SimOperationOverride_override_adf                        string = "sim/operation/override/override_adf"                        // int, writable
+	SimOperationOverride_override_dme                        string = "sim/operation/override/override_dme"                        // int, writable
+	SimOperationOverride_override_gps                        string = "sim/operation/override/override_gps"                        // int, writable
+	SimOperationOverride_override_nav_heading                string = "sim/operation/override/override_nav_heading"                // int, writable
+	SimOperationOverride_override_flightdir                  string = "sim/operation/override/override_flightdir"                  // int, writable
+	SimOperationOverride_override_flightdir_ptch             string = "sim/operation/override/override_flightdir_ptch"             // int, writable
+	SimOperationOverride_override_flightdir_roll             string = "sim/operation/override/override_flightdir_roll"             // int, writable
+	SimOperationOverride_override_camera                     string = "sim/operation/override/override_camera"                     // int, writable
+	SimOperationOverride_override_annunciators               string = "sim/operation/override/override_annunciators"               // int, writable
+	SimOperationOverride_override_autopilot                  string = "sim/operation/override/override_autopilot"                  // int, writable
+	SimOperationOverride_override_esp                        string = "sim/operation/override/override_esp"                        // int, writable
+	SimOperationOverride_override_joystick_heading           string = "sim/operation/override/override_joystick_heading"           // int, writable
+	SimOperationOverride_override_joystick_pitch             string = "sim/operation/override/override_joystick_pitch"             // int, writable
+	SimOperationOverride_override_joystick_roll              string = "sim/operation/override/override_joystick_roll"              // int, writable
+	SimOperationOverride_override_joystick_heading_copilot   string = "sim/operation/override/override_joystick_heading_copilot"   // int, writable
+	SimOperationOverride_override_joystick_pitch_copilot     string = "sim/operation/override/override_joystick_pitch_copilot"     // int, writable
+	SimOperationOverride_override_joystick_roll_copilot      string = "sim/operation/override/override_joystick_roll_copilot"      // int, writable
+	SimOperationOverride_override_throttles                  string = "sim/operation/override/override_throttles"                  // int, writable
+	SimOperationOverride_override_FADEC                      string = "sim/operation/override/override_FADEC"                      // int, writable
+	SimOperationOverride_override_prop_pitch                 string = "sim/operation/override/override_prop_pitch"                 // int, writable
+	SimOperationOverride_override_prop_mode                  string = "sim/operation/override/override_prop_mode"                  // int, writable
+	SimOperationOverride_override_mixture                    string = "sim/operation/override/override_mixture"                    // int, writable
+	SimOperationOverride_override_groundplane                string = "sim/operation/override/override_groundplane"                // int, writable
+	SimOperationOverride_override_fms_advance                string = "sim/operation/override/override_fms_advance"                // int, writable
+	SimOperationOverride_override_fuel_flow                  string = "sim/operation/override/override_fuel_flow"                  // int, writable
+	SimOperationOverride_override_itt_egt                    string = "sim/operation/override/override_itt_egt"                    // int, writable
+	SimOperationOverride_override_cht_oilt                   string = "sim/operation/override/override_cht_oilt"                   // int, writable
+	SimOperationOverride_override_ias                        string = "sim/operation/override/override_ias"                        // int, writable
+	SimOperationOverride_override_gs                         string = "sim/operation/override/override_gs"                         // int, writable
+	SimOperationOverride_override_control_surfaces           string = "sim/operation/override/override_control_surfaces"           // int, writable
+	SimOperationOverride_override_engines                    string = "sim/operation/override/override_engines"                    // int, writable
+	SimOperationOverride_override_torque_motors              string = "sim/operation/override/override_torque_motors"              // int, writable
+	SimOperationOverride_override_forces                     string = "sim/operation/override/override_forces"                     // int, writable
+	SimOperationOverride_override_wing_forces                string = "sim/operation/override/override_wing_forces"                // int, writable
+	SimOperationOverride_override_engine_forces              string = "sim/operation/override/override_engine_forces"              // int, writable
+	SimOperationOverride_override_gear_forces                string = "sim/operation/override/override_gear_forces"                // int, writable
+	SimOperationOverride_override_boats                      string = "sim/operation/override/override_boats"                      // int_array, writable
+	SimOperationOverride_override_wheel_steer                string = "sim/operation/override/override_wheel_steer"                // int, writable
+	SimOperationOverride_override_airport_lites              string = "sim/operation/override/override_airport_lites"              // int, writable
+	SimOperationOverride_override_fuel_system                string = "sim/operation/override/override_fuel_system"                // int, writable
+	SimOperationOverride_override_autotune                   string = "sim/operation/override/override_autotune"                   // int, writable
+	SimOperationOverride_override_TCAS                       string = "sim/operation/override/override_TCAS"                       // int, writable
+	SimOperationOverride_override_multiplayer_map_layer      string = "sim/operation/override/override_multiplayer_map_layer"      // int, writable
+	SimOperationOverride_override_toe_brakes                 string = "sim/operation/override/override_toe_brakes"                 // int, writable
+	SimOperationOverride_override_ground_trucks              string = "sim/operation/override/override_ground_trucks"              // int, writable
+	SimOperationOverride_override_timestep                   string = "sim/operation/override/override_timestep"                   // int, writable
+	SimOperationOverride_override_pressurization             string = "sim/operation/override/override_pressurization"             // int, writable
+	SimOperationOverride_override_oxygen_system              string = "sim/operation/override/override_oxygen_system"              // int, writable
+	SimOperationOverride_override_slung_load_size            string = "sim/operation/override/override_slung_load_size"            // int, writable
+	SimOperationOverride_override_wake_turbulence            string = "sim/operation/override/override_wake_turbulence"            // int, writable
+	SimOperationOverride_override_drogue                     string = "sim/operation/override/override_drogue"                     // int, writable
+	SimOperationOverride_override_GPU_volts                  string = "sim/operation/override/override_GPU_volts"                  // int, writable
+	SimOperationPrefs_startup_running                        string = "sim/operation/prefs/startup_running"                        // int, writable
+	SimOperationPrefs_warn_overspeed                         string = "sim/operation/prefs/warn_overspeed"                         // int, writable
+	SimOperationPrefs_warn_overgforce                        string = "sim/operation/prefs/warn_overgforce"                        // int, writable
+	SimOperationPrefs_warn_overspeed_flaps                   string = "sim/operation/prefs/warn_overspeed_flaps"                   // int, writable
+	SimOperationPrefs_warn_overspeed_gear                    string = "sim/operation/prefs/warn_overspeed_gear"                    // int, writable
+	SimOperationPrefs_reset_on_crash                         string = "sim/operation/prefs/reset_on_crash"                         // int
+	SimOperationPrefs_warn_nonobvious_stuff                  string = "sim/operation/prefs/warn_nonobvious_stuff"                  // int, writable
+	SimOperationPrefs_text_out                               string = "sim/operation/prefs/text_out"                               // int, writable
+	SimOperationPrefs_replay_mode                            string = "sim/operation/prefs/replay_mode"                            // int, writable
+	SimOperationPrefs_ai_flies_aircraft                      string = "sim/operation/prefs/ai_flies_aircraft"                      // int, writable
+	SimOperationPrefs_using_experimental_fm                  string = "sim/operation/prefs/using_experimental_fm"                  // int
+	SimOperationPrefsMisc_language                           string = "sim/operation/prefs/misc/language"                          // int
+	SimOperationPrefsMisc_has_lua_alloc                      string = "sim/operation/prefs/misc/has_lua_alloc"                     // int
+	SimOperationSound_has_sound                              string = "sim/operation/sound/has_sound"                              // int
+	SimOperationSound_has_speech_synth                       string = "sim/operation/sound/has_speech_synth"                       // int
+	SimOperationSound_sound_on                               string = "sim/operation/sound/sound_on"                               // int, writable
+	SimOperationSound_speech_on                              string = "sim/operation/sound/speech_on"                              // int, writable
+	SimOperationSound_master_volume_ratio                    string = "sim/operation/sound/master_volume_ratio"                    // float, writable
+	SimOperationSound_exterior_volume_ratio                  string = "sim/operation/sound/exterior_volume_ratio"                  // float, writable
+	SimOperationSound_interior_volume_ratio                  string = "sim/operation/sound/interior_volume_ratio"                  // float, writable
+	SimOperationSound_pilot_volume_ratio                     string = "sim/operation/sound/pilot_volume_ratio"                     // float, writable
+	SimOperationSound_copilot_volume_ratio                   string = "sim/operation/sound/copilot_volume_ratio"                   // float, writable
+	SimOperationSound_radio_volume_ratio                     string = "sim/operation/sound/radio_volume_ratio"                     // float, writable
+	SimOperationSound_radio_copilot_audio_panel_volume_ratio string = "sim/operation/sound/radio_copilot_audio_panel_volume_ratio" // float, writable
+	SimOperationSound_enviro_volume_ratio                    string = "sim/operation/sound/enviro_volume_ratio"                    // float, writable
+	SimOperationSound_ui_volume_ratio                        string = "sim/operation/sound/ui_volume_ratio"                        // float, writable
+	SimOperationSound_engine_volume_ratio                    string = "sim/operation/sound/engine_volume_ratio"                    // float, writable
+	SimOperationSound_prop_volume_ratio                      string = "sim/operation/sound/prop_volume_ratio"                      // float, writable
+	SimOperationSound_ground_volume_ratio                    string = "sim/operation/sound/ground_volume_ratio"                    // float, writable
+	SimOperationSound_weather_volume_ratio                   string = "sim/operation/sound/weather_volume_ratio"                   // float, writable
+	SimOperationSound_warning_volume_ratio                   string = "sim/operation/sound/warning_volume_ratio"                   // float, writable
+	SimOperationSound_fan_volume_ratio                       string = "sim/operation/sound/fan_volume_ratio"                       // float, writable
+	SimOperationSound_inside_ratio                           string = "sim/operation/sound/inside_ratio"                           // float_array
+	SimOperationSound_inside_any                             string = "sim/operation/sound/inside_any"                             // int
+	SimOperationSound_users_canopy_open_ratio                string = "sim/operation/sound/users_canopy_open_ratio"                // float
+	SimOperationSound_users_door_open_ratio                  string = "sim/operation/sound/users_door_open_ratio"                  // float_array
+	SimOperationSound_radios_are_copilot                     string = "sim/operation/sound/radios_are_copilot"                     // int
+	SimOperationSound_amb_barren_near_ratio                  string = "sim/operation/sound/amb_barren_near_ratio"                  // float_array
+	SimOperationSound_amb_barren_far_ratio                   string = "sim/operation/sound/amb_barren_far_ratio"                   // float_array
+	SimOperationSound_amb_lake_near_ratio                    string = "sim/operation/sound/amb_lake_near_ratio"                    // float_array
+	SimOperationSound_amb_lake_far_ratio                     string = "sim/operation/sound/amb_lake_far_ratio"                     // float_array
+	SimOperationSound_amb_forest_near_ratio                  string = "sim/operation/sound/amb_forest_near_ratio"                  // float_array
+	SimOperationSound_amb_forest_far_ratio                   string = "sim/operation/sound/amb_forest_far_ratio"                   // float_array
+	SimOperationSound_amb_rural_near_ratio                   string = "sim/operation/sound/amb_rural_near_ratio"                   // float_array
+	SimOperationSound_amb_rural_far_ratio                    string = "sim/operation/sound/amb_rural_far_ratio"                    // float_array
+	SimOperationSound_amb_urban_low_near_ratio               string = "sim/operation/sound/amb_urban_low_near_ratio"               // float_array
+	SimOperationSound_amb_urban_low_far_ratio                string = "sim/operation/sound/amb_urban_low_far_ratio"                // float_array
+	SimOperationSound_amb_urban_town_near_ratio              string = "sim/operation/sound/amb_urban_town_near_ratio"              // float_array
+	SimOperationSound_amb_urban_town_far_ratio               string = "sim/operation/sound/amb_urban_town_far_ratio"               // float_array
+	SimOperationSound_amb_urban_high_near_ratio              string = "sim/operation/sound/amb_urban_high_near_ratio"              // float_array
+	SimOperationSound_amb_urban_high_far_ratio               string = "sim/operation/sound/amb_urban_high_far_ratio"               // float_array
+	SimOperationSound_amb_industrial_near_ratio              string = "sim/operation/sound/amb_industrial_near_ratio"              // float_array
+	SimOperationSound_amb_industrial_far_ratio               string = "sim/operation/sound/amb_industrial_far_ratio"               // float_array
+	SimOperationSound_amb_airport_near_ratio                 string = "sim/operation/sound/amb_airport_near_ratio"                 // float_array
+	SimOperationSound_amb_airport_far_ratio                  string = "sim/operation/sound/amb_airport_far_ratio"                  // float_array
+	SimOperationSound_amb_airport_barren_near_ratio          string = "sim/operation/sound/amb_airport_barren_near_ratio"          // float_array
+	SimOperationSound_amb_airport_barren_far_ratio           string = "sim/operation/sound/amb_airport_barren_far_ratio"           // float_array
+	SimOperationSound_amb_airport_low_near_ratio             string = "sim/operation/sound/amb_airport_low_near_ratio"             // float_array
+	SimOperationSound_amb_airport_low_far_ratio              string = "sim/operation/sound/amb_airport_low_far_ratio"              // float_array
+	SimOperationSound_amb_road_distance                      string = "sim/operation/sound/amb_road_distance"                      // float_array
+	SimOperationWindows_system_window                        string = "sim/operation/windows/system_window"                        // int
+	SimOperationWindows_system_window_64                     string = "sim/operation/windows/system_window_64"                     // int_array
+)
@@ -0,0 +1,23 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefphysics provides the known dataref names under sim/physics/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefphysics
+
+const (
+	SimPhysics_earth_mu         string = "sim/physics/earth_mu"         // float
+	SimPhysics_earth_radius_m   string = "sim/physics/earth_radius_m"   // float
+	SimPhysics_earth_temp_c     string = "sim/physics/earth_temp_c"     // float
+	SimPhysics_earth_pressure_p string = "sim/physics/earth_pressure_p" // float
+	SimPhysics_rho_sea_level    string = "sim/physics/rho_sea_level"    // float
+	SimPhysics_g_sealevel       string = "sim/physics/g_sealevel"       // float
+	SimPhysics_rho_water        string = "sim/physics/rho_water"        // float
+	SimPhysics_metric_temp      string = "sim/physics/metric_temp"      // int, writable
+	SimPhysics_metric_press     string = "sim/physics/metric_press"     // int, writable
+)
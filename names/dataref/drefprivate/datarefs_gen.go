@@ -0,0 +1,1722 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefprivate provides the known dataref names under sim/private/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefprivate
+
+const (
+	SimPrivateNetDisables_ai_loc_0                                    string = "sim/private/net_disables/ai_loc[0]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_1                                    string = "sim/private/net_disables/ai_loc[1]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_2                                    string = "sim/private/net_disables/ai_loc[2]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_3                                    string = "sim/private/net_disables/ai_loc[3]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_4                                    string = "sim/private/net_disables/ai_loc[4]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_5                                    string = "sim/private/net_disables/ai_loc[5]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_6                                    string = "sim/private/net_disables/ai_loc[6]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_7                                    string = "sim/private/net_disables/ai_loc[7]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_8                                    string = "sim/private/net_disables/ai_loc[8]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_9                                    string = "sim/private/net_disables/ai_loc[9]"                                       // int, writable
+	SimPrivateNetDisables_ai_loc_10                                   string = "sim/private/net_disables/ai_loc[10]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_11                                   string = "sim/private/net_disables/ai_loc[11]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_12                                   string = "sim/private/net_disables/ai_loc[12]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_13                                   string = "sim/private/net_disables/ai_loc[13]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_14                                   string = "sim/private/net_disables/ai_loc[14]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_15                                   string = "sim/private/net_disables/ai_loc[15]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_16                                   string = "sim/private/net_disables/ai_loc[16]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_17                                   string = "sim/private/net_disables/ai_loc[17]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_18                                   string = "sim/private/net_disables/ai_loc[18]"                                      // int, writable
+	SimPrivateNetDisables_ai_loc_19                                   string = "sim/private/net_disables/ai_loc[19]"                                      // int, writable
+	SimPrivateFmod_studio_lo                                          string = "sim/private/fmod/studio_lo"                                               // int
+	SimPrivateFmod_studio_hi                                          string = "sim/private/fmod/studio_hi"                                               // int
+	SimPrivateFmod_system_lo                                          string = "sim/private/fmod/system_lo"                                               // int
+	SimPrivateFmod_system_hi                                          string = "sim/private/fmod/system_hi"                                               // int
+	SimPrivateStatsGfxPipelinesTerrain_num_pipelines                  string = "sim/private/stats/gfx/pipelines/terrain/num_pipelines"                    // float, writable
+	SimPrivateStatsGfxPipelinesLine3d_num_pipelines                   string = "sim/private/stats/gfx/pipelines/line3d/num_pipelines"                     // float, writable
+	SimPrivateStatsGfxPipelinesFakeTerrain_num_pipelines              string = "sim/private/stats/gfx/pipelines/fake_terrain/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesResolve_num_pipelines                  string = "sim/private/stats/gfx/pipelines/resolve/num_pipelines"                    // float, writable
+	SimPrivateStatsGfxPipelinesBackgroundBlur_num_pipelines           string = "sim/private/stats/gfx/pipelines/background_blur/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesSinglePassBlur_num_pipelines           string = "sim/private/stats/gfx/pipelines/single_pass_blur/num_pipelines"           // float, writable
+	SimPrivateStatsGfxPipelinesInPlaceBlur_num_pipelines              string = "sim/private/stats/gfx/pipelines/in_place_blur/num_pipelines"              // float, writable
+	SimPrivateStatsGfxPipelinesGstack_num_pipelines                   string = "sim/private/stats/gfx/pipelines/gstack/num_pipelines"                     // float, writable
+	SimPrivateStatsGfxPipelinesMinify_num_pipelines                   string = "sim/private/stats/gfx/pipelines/minify/num_pipelines"                     // float, writable
+	SimPrivateStatsGfxPipelinesFont_num_pipelines                     string = "sim/private/stats/gfx/pipelines/font/num_pipelines"                       // float, writable
+	SimPrivateStatsGfxPipelinesBlit_num_pipelines                     string = "sim/private/stats/gfx/pipelines/blit/num_pipelines"                       // float, writable
+	SimPrivateStatsGfxPipelinesLegacyDepth_num_pipelines              string = "sim/private/stats/gfx/pipelines/legacy_depth/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesLegacyFlat_num_pipelines               string = "sim/private/stats/gfx/pipelines/legacy_flat/num_pipelines"                // float, writable
+	SimPrivateStatsGfxPipelinesDepthResolve_num_pipelines             string = "sim/private/stats/gfx/pipelines/depth_resolve/num_pipelines"              // float, writable
+	SimPrivateStatsLights3d_atten3                                    string = "sim/private/stats/lights/3d/atten3"                                       // float, writable
+	SimPrivateStatsLights3d_atten2                                    string = "sim/private/stats/lights/3d/atten2"                                       // float, writable
+	SimPrivateStatsLights3d_atten1                                    string = "sim/private/stats/lights/3d/atten1"                                       // float, writable
+	SimPrivateStatsForests_pass                                       string = "sim/private/stats/forests/pass"                                           // float, writable
+	SimPrivateStatsForests_try                                        string = "sim/private/stats/forests/try"                                            // float, writable
+	SimPrivateStatsForests_total_trees_ever                           string = "sim/private/stats/forests/total_trees_ever"                               // float, writable
+	SimPrivateStatsLua_total_bytes_alloc_maximum                      string = "sim/private/stats/lua/total_bytes_alloc_maximum"                          // float, writable
+	SimPrivateStatsLua_total_bytes_alloc                              string = "sim/private/stats/lua/total_bytes_alloc"                                  // float, writable
+	SimPrivateStatsUboCaches_projection                               string = "sim/private/stats/ubo/caches/projection"                                  // float, writable
+	SimPrivateStatsUboCaches_material                                 string = "sim/private/stats/ubo/caches/material"                                    // float, writable
+	SimPrivateStatsFont_tex_mem                                       string = "sim/private/stats/font/tex_mem"                                           // float, writable
+	SimPrivateStatsGfxPipelines_total_jit_pipelines                   string = "sim/private/stats/gfx/pipelines/total_jit_pipelines"                      // float, writable
+	SimPrivateStatsGfxPipelines_total_pipelines                       string = "sim/private/stats/gfx/pipelines/total_pipelines"                          // float, writable
+	SimPrivateStatsGfxManagedBuffersMissed_bytes                      string = "sim/private/stats/gfx/managed_buffers/missed/bytes"                       // float, writable
+	SimPrivateStatsGfxManagedBuffersMissed_count                      string = "sim/private/stats/gfx/managed_buffers/missed/count"                       // float, writable
+	SimPrivateStatsGfxManagedBuffersCold_bytes                        string = "sim/private/stats/gfx/managed_buffers/cold/bytes"                         // float, writable
+	SimPrivateStatsGfxManagedBuffersCold_count                        string = "sim/private/stats/gfx/managed_buffers/cold/count"                         // float, writable
+	SimPrivateStatsGfxManagedBuffersWarm_bytes                        string = "sim/private/stats/gfx/managed_buffers/warm/bytes"                         // float, writable
+	SimPrivateStatsGfxManagedBuffersWarm_count                        string = "sim/private/stats/gfx/managed_buffers/warm/count"                         // float, writable
+	SimPrivateStatsGfxManagedBuffersHot_count                         string = "sim/private/stats/gfx/managed_buffers/hot/count"                          // float, writable
+	SimPrivateStatsGfxManagedBuffersHot_bytes                         string = "sim/private/stats/gfx/managed_buffers/hot/bytes"                          // float, writable
+	SimPrivateStatsGfxManagedBuffersDevice_bytes                      string = "sim/private/stats/gfx/managed_buffers/device/bytes"                       // float, writable
+	SimPrivateStatsGfxManagedBuffersDevice_count                      string = "sim/private/stats/gfx/managed_buffers/device/count"                       // float, writable
+	SimPrivateStatsGfxManagedBuffersHost_count                        string = "sim/private/stats/gfx/managed_buffers/host/count"                         // float, writable
+	SimPrivateStatsGfxManagedBuffersHost_bytes                        string = "sim/private/stats/gfx/managed_buffers/host/bytes"                         // float, writable
+	SimPrivateStatsGfxManagedBuffers_count                            string = "sim/private/stats/gfx/managed_buffers/count"                              // float, writable
+	SimPrivateStatsGfxManagedBuffers_paging_arena_bytes               string = "sim/private/stats/gfx/managed_buffers/paging_arena_bytes"                 // float, writable
+	SimPrivateStatsGfxManagedBuffers_paging_enabled                   string = "sim/private/stats/gfx/managed_buffers/paging_enabled"                     // float, writable
+	SimPrivateStatsAtc_pattern                                        string = "sim/private/stats/atc/pattern"                                            // float, writable
+	SimPrivateStatsTestMempool_wasted_large                           string = "sim/private/stats/test/mempool/wasted_large"                              // float, writable
+	SimPrivateStatsTestMempool_wasted                                 string = "sim/private/stats/test/mempool/wasted"                                    // float, writable
+	SimPrivateStatsTestMempool_available                              string = "sim/private/stats/test/mempool/available"                                 // float, writable
+	SimPrivateStatsTestMempool_arena                                  string = "sim/private/stats/test/mempool/arena"                                     // float, writable
+	SimPrivateStatsOgl_swap_time_total                                string = "sim/private/stats/ogl/swap_time_total"                                    // float, writable
+	SimPrivateStatsAtcDebugSpawner_total_enr                          string = "sim/private/stats/atc/debug/spawner/total_enr"                            // float, writable
+	SimPrivateStatsAtcDebugSpawner_total_dep                          string = "sim/private/stats/atc/debug/spawner/total_dep"                            // float, writable
+	SimPrivateStatsAtcDebugSpawner_total_arr                          string = "sim/private/stats/atc/debug/spawner/total_arr"                            // float, writable
+	SimPrivateStatsAtcDebug_takeoff_check_in_progress                 string = "sim/private/stats/atc/debug/takeoff_check_in_progress"                    // float, writable
+	SimPrivateStatsAtcDebug_landing_check_in_progress                 string = "sim/private/stats/atc/debug/landing_check_in_progress"                    // float, writable
+	SimPrivateStatsSkyc_mon_amb_b                                     string = "sim/private/stats/skyc/mon_amb_b"                                         // float, writable
+	SimPrivateStatsSkyc_mon_amb_g                                     string = "sim/private/stats/skyc/mon_amb_g"                                         // float, writable
+	SimPrivateStatsSkyc_mon_amb_r                                     string = "sim/private/stats/skyc/mon_amb_r"                                         // float, writable
+	SimPrivateStatsSkyc_sun_amb_b                                     string = "sim/private/stats/skyc/sun_amb_b"                                         // float, writable
+	SimPrivateStatsSkyc_sun_amb_g                                     string = "sim/private/stats/skyc/sun_amb_g"                                         // float, writable
+	SimPrivateStatsSkyc_sun_amb_r                                     string = "sim/private/stats/skyc/sun_amb_r"                                         // float, writable
+	SimPrivateStatsSkyc_mon_dir_b                                     string = "sim/private/stats/skyc/mon_dir_b"                                         // float, writable
+	SimPrivateStatsSkyc_mon_dir_g                                     string = "sim/private/stats/skyc/mon_dir_g"                                         // float, writable
+	SimPrivateStatsSkyc_mon_dir_r                                     string = "sim/private/stats/skyc/mon_dir_r"                                         // float, writable
+	SimPrivateStatsSkyc_sun_dir_b                                     string = "sim/private/stats/skyc/sun_dir_b"                                         // float, writable
+	SimPrivateStatsSkyc_sun_dir_g                                     string = "sim/private/stats/skyc/sun_dir_g"                                         // float, writable
+	SimPrivateStatsSkyc_sun_dir_r                                     string = "sim/private/stats/skyc/sun_dir_r"                                         // float, writable
+	SimPrivateStatsSkycFog_geoid_max_vis_mtr                          string = "sim/private/stats/skyc/fog/geoid_max_vis_mtr"                             // float, writable
+	SimPrivateStatsSkycFog_far_fog_cld                                string = "sim/private/stats/skyc/fog/far_fog_cld"                                   // float, writable
+	SimPrivateStatsSkycFog_near_fog_cld                               string = "sim/private/stats/skyc/fog/near_fog_cld"                                  // float, writable
+	SimPrivateStatsSkycFog_far_fog_lit                                string = "sim/private/stats/skyc/fog/far_fog_lit"                                   // float, writable
+	SimPrivateStatsSkycFog_near_fog_lit                               string = "sim/private/stats/skyc/fog/near_fog_lit"                                  // float, writable
+	SimPrivateStatsSkycFog_far_fog_unlit                              string = "sim/private/stats/skyc/fog/far_fog_unlit"                                 // float, writable
+	SimPrivateStatsSkycFog_near_fog_unlit                             string = "sim/private/stats/skyc/fog/near_fog_unlit"                                // float, writable
+	SimPrivateStatsSkycFog_far_dis_clip                               string = "sim/private/stats/skyc/fog/far_dis_clip"                                  // float, writable
+	SimPrivateStatsSkycFog_near_dis_clip                              string = "sim/private/stats/skyc/fog/near_dis_clip"                                 // float, writable
+	SimPrivateStatsSkycFog_far_dis_dsf                                string = "sim/private/stats/skyc/fog/far_dis_dsf"                                   // float, writable
+	SimPrivateStatsSkycFog_near_dis_dsf                               string = "sim/private/stats/skyc/fog/near_dis_dsf"                                  // float, writable
+	SimPrivateStatsSkycVis_view_sigma                                 string = "sim/private/stats/skyc/vis/view_sigma"                                    // float, writable
+	SimPrivateStatsScattering_cache_far                               string = "sim/private/stats/scattering/cache_far"                                   // float, writable
+	SimPrivateStatsScattering_cache_near                              string = "sim/private/stats/scattering/cache_near"                                  // float, writable
+	SimPrivateStatsScattering_est_view_vis_fog                        string = "sim/private/stats/scattering/est_view_vis_fog"                            // float, writable
+	SimPrivateStatsVegetationStat_extruded_points                     string = "sim/private/stats/vegetation/stat/extruded_points"                        // float, writable
+	SimPrivateStatsLights_tile_bytes                                  string = "sim/private/stats/lights/tile_bytes"                                      // float, writable
+	SimPrivateStatsLights_index_bytes                                 string = "sim/private/stats/lights/index_bytes"                                     // float, writable
+	SimPrivateStatsLights_transform_bytes                             string = "sim/private/stats/lights/transform_bytes"                                 // float, writable
+	SimPrivateStatsLights_tiles_y                                     string = "sim/private/stats/lights/tiles_y"                                         // float, writable
+	SimPrivateStatsLights_tiles_x                                     string = "sim/private/stats/lights/tiles_x"                                         // float, writable
+	SimPrivateStatsObject_num_mouse_tri                               string = "sim/private/stats/object/num_mouse_tri"                                   // float, writable
+	SimPrivateStatsInstance_num_cluster_objs                          string = "sim/private/stats/instance/num_cluster_objs"                              // float, writable
+	SimPrivateStatsInstance_num_clusters                              string = "sim/private/stats/instance/num_clusters"                                  // float, writable
+	SimPrivateStatsWater_msl                                          string = "sim/private/stats/water/msl"                                              // float, writable
+	SimPrivateStatsTerrain_LOD_bias_final                             string = "sim/private/stats/terrain/LOD_bias_final"                                 // float, writable
+	SimPrivateStatsAg_num_tiles                                       string = "sim/private/stats/ag/num_tiles"                                           // float, writable
+	SimPrivateStatsAg_num_trees                                       string = "sim/private/stats/ag/num_trees"                                           // float, writable
+	SimPrivateStatsParticle_editor_math_usec                          string = "sim/private/stats/particle/editor_math_usec"                              // float, writable
+	SimPrivateStatsParticle_editor_plot_usec                          string = "sim/private/stats/particle/editor_plot_usec"                              // float, writable
+	SimPrivateStatsCars_rebucket_cache_hit                            string = "sim/private/stats/cars/rebucket_cache_hit"                                // float, writable
+	SimPrivateStatsCars_rebucket_cache_miss                           string = "sim/private/stats/cars/rebucket_cache_miss"                               // float, writable
+	SimPrivateStatsPlanet_effective_vis                               string = "sim/private/stats/planet/effective_vis"                                   // float, writable
+	SimPrivateStatsPlanet_passes                                      string = "sim/private/stats/planet/passes"                                          // float, writable
+	SimPrivateStatsGfxVulkanDescriptors_num_descriptor_templates      string = "sim/private/stats/gfx/vulkan/descriptors/num_descriptor_templates"        // float, writable
+	SimPrivateStatsGfxVulkanDescriptors_num_descriptor_layouts        string = "sim/private/stats/gfx/vulkan/descriptors/num_descriptor_layouts"          // float, writable
+	SimPrivateStatsGfxVulkanDescriptors_num_pipeline_layouts          string = "sim/private/stats/gfx/vulkan/descriptors/num_pipeline_layouts"            // float, writable
+	SimPrivateStatsGfxVulkanDescriptors_num_pool_containers           string = "sim/private/stats/gfx/vulkan/descriptors/num_pool_containers"             // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsDynamic_average_working_set    string = "sim/private/stats/gfx/vulkan/descriptors/dynamic/average_working_set"     // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsDynamic_average_usage          string = "sim/private/stats/gfx/vulkan/descriptors/dynamic/average_usage"           // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsDynamic_num_pools              string = "sim/private/stats/gfx/vulkan/descriptors/dynamic/num_pools"               // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsDynamic_num_sets               string = "sim/private/stats/gfx/vulkan/descriptors/dynamic/num_sets"                // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsStatic_num_pools               string = "sim/private/stats/gfx/vulkan/descriptors/static/num_pools"                // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsStaticSets_allocated           string = "sim/private/stats/gfx/vulkan/descriptors/static/sets/allocated"           // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsStaticSets_free                string = "sim/private/stats/gfx/vulkan/descriptors/static/sets/free"                // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsStaticSets_total               string = "sim/private/stats/gfx/vulkan/descriptors/static/sets/total"               // float, writable
+	SimPrivateStatsGfxVulkanCommandBuffers_executing                  string = "sim/private/stats/gfx/vulkan/command_buffers/executing"                   // float, writable
+	SimPrivateStatsGfxVulkanCommandBuffers_count                      string = "sim/private/stats/gfx/vulkan/command_buffers/count"                       // float, writable
+	SimPrivateStatsGfxVulkanCommandPools_count                        string = "sim/private/stats/gfx/vulkan/command_pools/count"                         // float, writable
+	SimPrivateStatsPerfClouds_update                                  string = "sim/private/stats/perf/clouds/update"                                     // float, writable
+	SimPrivateStatsObject_pln_obj_count                               string = "sim/private/stats/object/pln_obj_count"                                   // float, writable
+	SimPrivateStatsPlanes_plot_per_frame                              string = "sim/private/stats/planes/plot_per_frame"                                  // float, writable
+	SimPrivateStatsPerfWaterFft_readback                              string = "sim/private/stats/perf/water/fft/readback"                                // float, writable
+	SimPrivateStatsPerfWaterFft_update                                string = "sim/private/stats/perf/water/fft/update"                                  // float, writable
+	SimPrivateStatsLights_dynamic_spill_count                         string = "sim/private/stats/lights/dynamic_spill_count"                             // float, writable
+	SimPrivateStatsLights_dynamic_dir_count                           string = "sim/private/stats/lights/dynamic_dir_count"                               // float, writable
+	SimPrivateStatsUboNumWrite_projection                             string = "sim/private/stats/ubo/num_write/projection"                               // float, writable
+	SimPrivateStatsUboNumWrite_material_no_cache                      string = "sim/private/stats/ubo/num_write/material_no_cache"                        // float, writable
+	SimPrivateStatsUboNumWrite_projection_no_cache                    string = "sim/private/stats/ubo/num_write/projection_no_cache"                      // float, writable
+	SimPrivateStatsUboNumWrite_misc                                   string = "sim/private/stats/ubo/num_write/misc"                                     // float, writable
+	SimPrivateStatsUboNumWrite_water                                  string = "sim/private/stats/ubo/num_write/water"                                    // float, writable
+	SimPrivateStatsUboNumWrite_shadow                                 string = "sim/private/stats/ubo/num_write/shadow"                                   // float, writable
+	SimPrivateStatsUboNumWrite_light_data                             string = "sim/private/stats/ubo/num_write/light_data"                               // float, writable
+	SimPrivateStatsGfxManagedBuffersAgp_bytes                         string = "sim/private/stats/gfx/managed_buffers/agp/bytes"                          // float, writable
+	SimPrivateStatsGfxManagedBuffersAgp_count                         string = "sim/private/stats/gfx/managed_buffers/agp/count"                          // float, writable
+	SimPrivateStatsGfxStat_num_compute_passes                         string = "sim/private/stats/gfx/stat/num_compute_passes"                            // float, writable
+	SimPrivateStatsGfxStat_num_render_passes                          string = "sim/private/stats/gfx/stat/num_render_passes"                             // float, writable
+	SimPrivateStatsBird_num_draw                                      string = "sim/private/stats/bird/num_draw"                                          // float, writable
+	SimPrivateStatsXdl_num_xdl_inst                                   string = "sim/private/stats/xdl/num_xdl_inst"                                       // float, writable
+	SimPrivateStatsXdl_num_xdl_vert                                   string = "sim/private/stats/xdl/num_xdl_vert"                                       // float, writable
+	SimPrivateStatsXdl_num_xdl_draw                                   string = "sim/private/stats/xdl/num_xdl_draw"                                       // float, writable
+	SimPrivateStatsSkyc_shadow_offset_final                           string = "sim/private/stats/skyc/shadow_offset_final"                               // float, writable
+	SimPrivateStatsSkyc_shadow_level_final                            string = "sim/private/stats/skyc/shadow_level_final"                                // float, writable
+	SimPrivateStatsSkyc_direct_ratio_final                            string = "sim/private/stats/skyc/direct_ratio_final"                                // float, writable
+	SimPrivateStatsSkyc_ambient_ratio_final                           string = "sim/private/stats/skyc/ambient_ratio_final"                               // float, writable
+	SimPrivateStatsUboNumWrite_environment                            string = "sim/private/stats/ubo/num_write/environment"                              // float, writable
+	SimPrivateStatsVegetationStat_pre_allowed_points                  string = "sim/private/stats/vegetation/stat/pre_allowed_points"                     // float, writable
+	SimPrivateStatsVegetationStat_pre_culled_points                   string = "sim/private/stats/vegetation/stat/pre_culled_points"                      // float, writable
+	SimPrivateStatsLights_transformed                                 string = "sim/private/stats/lights/transformed"                                     // float, writable
+	SimPrivateStatsLights_transform_invocations                       string = "sim/private/stats/lights/transform_invocations"                           // float, writable
+	SimPrivateStatsObject_num_cluster_tris                            string = "sim/private/stats/object/num_cluster_tris"                                // float, writable
+	SimPrivateStatsObject_num_cluster_batches                         string = "sim/private/stats/object/num_cluster_batches"                             // float, writable
+	SimPrivateStatsObject_num_obj_cluster_objs                        string = "sim/private/stats/object/num_obj_cluster_objs"                            // float, writable
+	SimPrivateStatsObject_num_obj_clusters                            string = "sim/private/stats/object/num_obj_clusters"                                // float, writable
+	SimPrivateStatsObject_num_objs_change                             string = "sim/private/stats/object/num_objs_change"                                 // float, writable
+	SimPrivateStatsObject_num_lines                                   string = "sim/private/stats/object/num_lines"                                       // float, writable
+	SimPrivateStatsObject_num_tris                                    string = "sim/private/stats/object/num_tris"                                        // float, writable
+	SimPrivateStatsObject_num_batces                                  string = "sim/private/stats/object/num_batces"                                      // float, writable
+	SimPrivateStatsObject_num_cmds                                    string = "sim/private/stats/object/num_cmds"                                        // float, writable
+	SimPrivateStatsObject_num_objs                                    string = "sim/private/stats/object/num_objs"                                        // float, writable
+	SimPrivateStatsPanel_read_passes                                  string = "sim/private/stats/panel/read_passes"                                      // float, writable
+	SimPrivateStatsPanel_read_pixels                                  string = "sim/private/stats/panel/read_pixels"                                      // float, writable
+	SimPrivateStatsPanel_num_gen_insts_culled                         string = "sim/private/stats/panel/num_gen_insts_culled"                             // float, writable
+	SimPrivateStatsPanel_num_insts_total                              string = "sim/private/stats/panel/num_insts_total"                                  // float, writable
+	SimPrivateStatsPanel_num_insts_drawn                              string = "sim/private/stats/panel/num_insts_drawn"                                  // float, writable
+	SimPrivateStatsShadow_passes_per_frame                            string = "sim/private/stats/shadow/passes_per_frame"                                // float, writable
+	SimPrivateStatsShadow_num_csm_culls                               string = "sim/private/stats/shadow/num_csm_culls"                                   // float, writable
+	SimPrivateStatsTerrain_num_car_drape                              string = "sim/private/stats/terrain/num_car_drape"                                  // float, writable
+	SimPrivateStatsTerrain_num_car_test                               string = "sim/private/stats/terrain/num_car_test"                                   // float, writable
+	SimPrivateStatsTerrain_num_car_buckets                            string = "sim/private/stats/terrain/num_car_buckets"                                // float, writable
+	SimPrivateStatsTerrain_num_object_draw                            string = "sim/private/stats/terrain/num_object_draw"                                // float, writable
+	SimPrivateStatsTerrain_num_cluster_draw                           string = "sim/private/stats/terrain/num_cluster_draw"                               // float, writable
+	SimPrivateStatsTerrain_num_vegetation_draw                        string = "sim/private/stats/terrain/num_vegetation_draw"                            // float, writable
+	SimPrivateStatsTerrain_num_patch_draw                             string = "sim/private/stats/terrain/num_patch_draw"                                 // float, writable
+	SimPrivateStatsTerrain_num_patch_test                             string = "sim/private/stats/terrain/num_patch_test"                                 // float, writable
+	SimPrivateStatsTerrain_num_patch_bucket                           string = "sim/private/stats/terrain/num_patch_bucket"                               // float, writable
+	SimPrivateStatsTerrain_num_layers                                 string = "sim/private/stats/terrain/num_layers"                                     // float, writable
+	SimPrivateStatsCars_dead_end                                      string = "sim/private/stats/cars/dead_end"                                          // float, writable
+	SimPrivateStatsCars_out_of_bounds                                 string = "sim/private/stats/cars/out_of_bounds"                                     // float, writable
+	SimPrivateStatsPlanet_num_10x10                                   string = "sim/private/stats/planet/num_10x10"                                       // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWrite_count_no_set             string = "sim/private/stats/gfx/vulkan/descriptors/write/count_no_set"              // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWrite_count_static             string = "sim/private/stats/gfx/vulkan/descriptors/write/count_static"              // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWrite_count                    string = "sim/private/stats/gfx/vulkan/descriptors/write/count"                     // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWriteMiss_generation           string = "sim/private/stats/gfx/vulkan/descriptors/write/miss/generation"           // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWriteMiss_fresh                string = "sim/private/stats/gfx/vulkan/descriptors/write/miss/fresh"                // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWriteMiss_compatible           string = "sim/private/stats/gfx/vulkan/descriptors/write/miss/compatible"           // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWriteMiss_template             string = "sim/private/stats/gfx/vulkan/descriptors/write/miss/template"             // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWriteMiss_layout               string = "sim/private/stats/gfx/vulkan/descriptors/write/miss/layout"               // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWriteMiss_set                  string = "sim/private/stats/gfx/vulkan/descriptors/write/miss/set"                  // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsWrite_hit                      string = "sim/private/stats/gfx/vulkan/descriptors/write/hit"                       // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsBindCount_push                 string = "sim/private/stats/gfx/vulkan/descriptors/bind_count/push"                 // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsBindCount_buffers              string = "sim/private/stats/gfx/vulkan/descriptors/bind_count/buffers"              // float, writable
+	SimPrivateStatsGfxVulkanDescriptorsBindCount_sets                 string = "sim/private/stats/gfx/vulkan/descriptors/bind_count/sets"                 // float, writable
+	SimPrivateStatsTime_thread_sync_wait                              string = "sim/private/stats/time/thread_sync_wait"                                  // float, writable
+	SimPrivateControlsHidDebug_joys                                   string = "sim/private/controls/hid/debug/joys"                                      // float, writable
+	SimPrivateControlsWxrMap_side_bar                                 string = "sim/private/controls/wxr/map/side_bar"                                    // float, writable
+	SimPrivateControlsWxrMap_grid_alpha                               string = "sim/private/controls/wxr/map/grid_alpha"                                  // float, writable
+	SimPrivateControlsNewClouds_scan_interval                         string = "sim/private/controls/new_clouds/scan_interval"                            // float, writable
+	SimPrivateControlsWeather_rwx_metar_buckets                       string = "sim/private/controls/weather/rwx_metar_buckets"                           // float, writable
+	SimPrivateControlsWeather_max_tropo_delta                         string = "sim/private/controls/weather/max_tropo_delta"                             // float, writable
+	SimPrivateControlsWeather_warble_factor                           string = "sim/private/controls/weather/warble_factor"                               // float, writable
+	SimPrivateControlsWeather_kill_time_based_push                    string = "sim/private/controls/weather/kill_time_based_push"                        // float, writable
+	SimPrivateControlsWeather_fuzzy_grib_bounds                       string = "sim/private/controls/weather/fuzzy_grib_bounds"                           // float, writable
+	SimPrivateControlsWeather_fuzzy_metar_bounds                      string = "sim/private/controls/weather/fuzzy_metar_bounds"                          // float, writable
+	SimPrivateControlsWeather_worley_octaves                          string = "sim/private/controls/weather/worley_octaves"                              // float, writable
+	SimPrivateControlsWeather_real_perlin_scale                       string = "sim/private/controls/weather/real_perlin_scale"                           // float, writable
+	SimPrivateControlsWeather_new_wind                                string = "sim/private/controls/weather/new_wind"                                    // float, writable
+	SimPrivateControlsWeather_rwx_blend_debug                         string = "sim/private/controls/weather/rwx_blend_debug"                             // float, writable
+	SimPrivateControlsWeather_grib_feather_edge                       string = "sim/private/controls/weather/grib_feather_edge"                           // float, writable
+	SimPrivateControlsWeather_metar_decay_hours                       string = "sim/private/controls/weather/metar_decay_hours"                           // float, writable
+	SimPrivateControlsWeather_metar_feather_edge                      string = "sim/private/controls/weather/metar_feather_edge"                          // float, writable
+	SimPrivateControlsWeather_grib_max_dist                           string = "sim/private/controls/weather/grib_max_dist"                               // float, writable
+	SimPrivateControlsWeather_metar_auto_max_dist                     string = "sim/private/controls/weather/metar_auto_max_dist"                         // float, writable
+	SimPrivateControlsWeather_metar_max_dist                          string = "sim/private/controls/weather/metar_max_dist"                              // float, writable
+	SimPrivateControlsWeather_grib_voronoi_soften                     string = "sim/private/controls/weather/grib_voronoi_soften"                         // float, writable
+	SimPrivateControlsWeather_metar_voronoi_soften                    string = "sim/private/controls/weather/metar_voronoi_soften"                        // float, writable
+	SimPrivateControlsWeather_conpad_metaball_r                       string = "sim/private/controls/weather/conpad_metaball_r"                           // float, writable
+	SimPrivateControlsWeather_rwx_nearest_neighbor                    string = "sim/private/controls/weather/rwx_nearest_neighbor"                        // float, writable
+	SimPrivateControlsWeatherRwx_grib_cb_ratio_strength               string = "sim/private/controls/weather/rwx/grib_cb_ratio_strength"                  // float, writable
+	SimPrivateControlsWeather_multisample_static                      string = "sim/private/controls/weather/multisample_static"                          // float, writable
+	SimPrivateControlsWeatherRwx_use_metars                           string = "sim/private/controls/weather/rwx/use_metars"                              // float, writable
+	SimPrivateControlsWeatherRwx_use_gribs                            string = "sim/private/controls/weather/rwx/use_gribs"                               // float, writable
+	SimPrivateControlsWeather_interp_lock                             string = "sim/private/controls/weather/interp_lock"                                 // float, writable
+	SimPrivateControlsWxr_all_cld_rain                                string = "sim/private/controls/wxr/all_cld_rain"                                    // float, writable
+	SimPrivateControlsWxr_adjust_bases                                string = "sim/private/controls/wxr/adjust_bases"                                    // float, writable
+	SimPrivateControlsWxrPrecipDensity_cumulonimbus_hi                string = "sim/private/controls/wxr/precip_density/cumulonimbus_hi"                  // float, writable
+	SimPrivateControlsWxrPrecipDensity_cumulonimbus_lo                string = "sim/private/controls/wxr/precip_density/cumulonimbus_lo"                  // float, writable
+	SimPrivateControlsWxrPrecipDensity_cumulus_hi                     string = "sim/private/controls/wxr/precip_density/cumulus_hi"                       // float, writable
+	SimPrivateControlsWxrPrecipDensity_cumulus_lo                     string = "sim/private/controls/wxr/precip_density/cumulus_lo"                       // float, writable
+	SimPrivateControlsWxrPrecipDensity_stratus_hi                     string = "sim/private/controls/wxr/precip_density/stratus_hi"                       // float, writable
+	SimPrivateControlsWxrPrecipDensity_stratus_lo                     string = "sim/private/controls/wxr/precip_density/stratus_lo"                       // float, writable
+	SimPrivateControlsClouds_debug_shadow_matrix                      string = "sim/private/controls/clouds/debug_shadow_matrix"                          // float, writable
+	SimPrivateControlsCloud_offscreen_tweak                           string = "sim/private/controls/cloud/offscreen_tweak"                               // float, writable
+	SimPrivateControlsCloud_resolution_max                            string = "sim/private/controls/cloud/resolution_max"                                // float, writable
+	SimPrivateControlsCloud_resolution_scale                          string = "sim/private/controls/cloud/resolution_scale"                              // float, writable
+	SimPrivateControlsCloud_resolution_mode                           string = "sim/private/controls/cloud/resolution_mode"                               // float, writable
+	SimPrivateControlsCloudsDebug_kill_details                        string = "sim/private/controls/clouds/debug/kill_details"                           // float, writable
+	SimPrivateControlsCloudsDebug_kill_hiz                            string = "sim/private/controls/clouds/debug/kill_hiz"                               // float, writable
+	SimPrivateControlsNewClouds_debug_w                               string = "sim/private/controls/new_clouds/debug_w"                                  // float, writable
+	SimPrivateControlsNewClouds_debug_z                               string = "sim/private/controls/new_clouds/debug_z"                                  // float, writable
+	SimPrivateControlsNewClouds_debug_y                               string = "sim/private/controls/new_clouds/debug_y"                                  // float, writable
+	SimPrivateControlsNewClouds_debug_x                               string = "sim/private/controls/new_clouds/debug_x"                                  // float, writable
+	SimPrivateControlsNewClouds_fog                                   string = "sim/private/controls/new_clouds/fog"                                      // float, writable
+	SimPrivateControlsNewClouds_phase_g                               string = "sim/private/controls/new_clouds/phase_g"                                  // float, writable
+	SimPrivateControlsCloudsCirrus_scale                              string = "sim/private/controls/clouds/cirrus/scale"                                 // float, writable
+	SimPrivateControlsNewClouds_high_freq_amp                         string = "sim/private/controls/new_clouds/high_freq_amp"                            // float, writable
+	SimPrivateControlsNewClouds_high_freq_rat                         string = "sim/private/controls/new_clouds/high_freq_rat"                            // float, writable
+	SimPrivateControlsNewClouds_low_freq_rat                          string = "sim/private/controls/new_clouds/low_freq_rat"                             // float, writable
+	SimPrivateControlsNewClouds_direct                                string = "sim/private/controls/new_clouds/direct"                                   // float, writable
+	SimPrivateControlsNewClouds_ambient_mix                           string = "sim/private/controls/new_clouds/ambient_mix"                              // float, writable
+	SimPrivateControlsNewClouds_ambient_minimum                       string = "sim/private/controls/new_clouds/ambient_minimum"                          // float, writable
+	SimPrivateControlsNewClouds_ambient_strength                      string = "sim/private/controls/new_clouds/ambient_strength"                         // float, writable
+	SimPrivateControlsCloudMarch_skip_rate_sdf                        string = "sim/private/controls/cloud/march/skip_rate_sdf"                           // float, writable
+	SimPrivateControlsCloudMarch_skip_rate                            string = "sim/private/controls/cloud/march/skip_rate"                               // float, writable
+	SimPrivateControlsCloudMarch_trace_mode                           string = "sim/private/controls/cloud/march/trace_mode"                              // float, writable
+	SimPrivateControlsNewCloudsMarch_samples_max_km                   string = "sim/private/controls/new_clouds/march/samples_max_km"                     // float, writable
+	SimPrivateControlsNewCloudsMarch_samples_max                      string = "sim/private/controls/new_clouds/march/samples_max"                        // float, writable
+	SimPrivateControlsNewCloudsMarch_samples_min                      string = "sim/private/controls/new_clouds/march/samples_min"                        // float, writable
+	SimPrivateControlsCloud_intersection_lo_dist_km                   string = "sim/private/controls/cloud/intersection_lo_dist_km"                       // float, writable
+	SimPrivateControlsCloud_intersection_min_samples_lo               string = "sim/private/controls/cloud/intersection_min_samples_lo"                   // float, writable
+	SimPrivateControlsCloud_intersection_max_samples_lo               string = "sim/private/controls/cloud/intersection_max_samples_lo"                   // float, writable
+	SimPrivateControlsCloud_intersection_min_samples                  string = "sim/private/controls/cloud/intersection_min_samples"                      // float, writable
+	SimPrivateControlsCloud_intersection_max_samples                  string = "sim/private/controls/cloud/intersection_max_samples"                      // float, writable
+	SimPrivateControlsCloud_intersection_max_samples_length_km        string = "sim/private/controls/cloud/intersection_max_samples_length_km"            // float, writable
+	SimPrivateControlsCloud_scan_samples_per_km                       string = "sim/private/controls/cloud/scan_samples_per_km"                           // float, writable
+	SimPrivateControlsCloud_scan_max_samples                          string = "sim/private/controls/cloud/scan_max_samples"                              // float, writable
+	SimPrivateControlsCloud_scan_min_samples                          string = "sim/private/controls/cloud/scan_min_samples"                              // float, writable
+	SimPrivateControlsCloud_compute_mode                              string = "sim/private/controls/cloud/compute_mode"                                  // float, writable
+	SimPrivateControlsNewCloudsMarch_seg_mul                          string = "sim/private/controls/new_clouds/march/seg_mul"                            // float, writable
+	SimPrivateControlsNewCloudsMarch_seg_count                        string = "sim/private/controls/new_clouds/march/seg_count"                          // float, writable
+	SimPrivateControlsNewCloudsMarch_step_len_start                   string = "sim/private/controls/new_clouds/march/step_len_start"                     // float, writable
+	SimPrivateControlsNewCloudsMarch_seg_steps                        string = "sim/private/controls/new_clouds/march/seg_steps"                          // float, writable
+	SimPrivateControlsCloud_min_distance                              string = "sim/private/controls/cloud/min_distance"                                  // float, writable
+	SimPrivateControlsClouds_map_quality                              string = "sim/private/controls/clouds/map_quality"                                  // float, writable
+	SimPrivateControlsCloud_onscreen_sample_rate                      string = "sim/private/controls/cloud/onscreen_sample_rate"                          // float, writable
+	SimPrivateControlsClouds_categorize_far_depth_margin              string = "sim/private/controls/clouds/categorize_far_depth_margin"                  // float, writable
+	SimPrivateControlsClouds_categorize_max_distance_km               string = "sim/private/controls/clouds/categorize_max_distance_km"                   // float, writable
+	SimPrivateControlsClouds_categorize_opacity_floor                 string = "sim/private/controls/clouds/categorize_opacity_floor"                     // float, writable
+	SimPrivateControlsClouds_min_density_clip                         string = "sim/private/controls/clouds/min_density_clip"                             // float, writable
+	SimPrivateControlsClouds_depth_hint_factor                        string = "sim/private/controls/clouds/depth_hint_factor"                            // float, writable
+	SimPrivateControlsCloud_sunset_threshold                          string = "sim/private/controls/cloud/sunset_threshold"                              // float, writable
+	SimPrivateControlsCloud_hi_threshold                              string = "sim/private/controls/cloud/hi_threshold"                                  // float, writable
+	SimPrivateControlsCloud_noise_hi_limit_km                         string = "sim/private/controls/cloud/noise_hi_limit_km"                             // float, writable
+	SimPrivateControlsCloud_ms_length                                 string = "sim/private/controls/cloud/ms_length"                                     // float, writable
+	SimPrivateControlsCloud_ms_attenuation_mix                        string = "sim/private/controls/cloud/ms_attenuation_mix"                            // float, writable
+	SimPrivateControlsCloud_ms_attenuation                            string = "sim/private/controls/cloud/ms_attenuation"                                // float, writable
+	SimPrivateControlsCloud_shadow_length                             string = "sim/private/controls/cloud/shadow_length"                                 // float, writable
+	SimPrivateControlsCloud_shadow_steps                              string = "sim/private/controls/cloud/shadow_steps"                                  // float, writable
+	SimPrivateControlsCloud_opacity_floor                             string = "sim/private/controls/cloud/opacity_floor"                                 // float, writable
+	SimPrivateControlsCloud_enable_color                              string = "sim/private/controls/cloud/enable_color"                                  // float, writable
+	SimPrivateControlsNewClouds_trace_precision                       string = "sim/private/controls/new_clouds/trace_precision"                          // float, writable
+	SimPrivateControlsCloud_temporal_samples                          string = "sim/private/controls/cloud/temporal_samples"                              // float, writable
+	SimPrivateControlsCloud_upscale_mode                              string = "sim/private/controls/cloud/upscale_mode"                                  // float, writable
+	SimPrivateControlsNewClouds_coverage_epsilon                      string = "sim/private/controls/new_clouds/coverage_epsilon"                         // float, writable
+	SimPrivateControlsClouds_debug_flags                              string = "sim/private/controls/clouds/debug_flags"                                  // float, writable
+	SimPrivateControlsNewClouds_sdf_edge_dist                         string = "sim/private/controls/new_clouds/sdf_edge_dist"                            // float, writable
+	SimPrivateControlsNewClouds_cull_hold                             string = "sim/private/controls/new_clouds/cull_hold"                                // float, writable
+	SimPrivateControlsNewClouds_kill_sdf_cull                         string = "sim/private/controls/new_clouds/kill_sdf_cull"                            // float, writable
+	SimPrivateControlsClouds_precipitation_step                       string = "sim/private/controls/clouds/precipitation_step"                           // float, writable
+	SimPrivateControlsClouds_precipitation_samples                    string = "sim/private/controls/clouds/precipitation_samples"                        // float, writable
+	SimPrivateControlsClouds_precipitation_density_km                 string = "sim/private/controls/clouds/precipitation_density_km"                     // float, writable
+	SimPrivateControlsClouds_precipitation_size_km                    string = "sim/private/controls/clouds/precipitation_size_km"                        // float, writable
+	SimPrivateControlsClouds_precipitation_resolution                 string = "sim/private/controls/clouds/precipitation_resolution"                     // float, writable
+	SimPrivateControlsCloud_shadow_projection                         string = "sim/private/controls/cloud/shadow_projection"                             // float, writable
+	SimPrivateControlsCloud_shadow_time_slice                         string = "sim/private/controls/cloud/shadow_time_slice"                             // float, writable
+	SimPrivateControlsCloud_shadow_spatial_filter                     string = "sim/private/controls/cloud/shadow_spatial_filter"                         // float, writable
+	SimPrivateControlsCloud_shadow_cascades                           string = "sim/private/controls/cloud/shadow_cascades"                               // float, writable
+	SimPrivateControlsNewClouds_shadow_map_width                      string = "sim/private/controls/new_clouds/shadow_map_width"                         // float, writable
+	SimPrivateControlsNewClouds_shadow_limit_angle                    string = "sim/private/controls/new_clouds/shadow_limit_angle"                       // float, writable
+	SimPrivateControlsNewClouds_async_shadow                          string = "sim/private/controls/new_clouds/async_shadow"                             // float, writable
+	SimPrivateControlsCloud_shadow_alpha                              string = "sim/private/controls/cloud/shadow_alpha"                                  // float, writable
+	SimPrivateControlsCloud_shadow_jitter                             string = "sim/private/controls/cloud/shadow_jitter"                                 // float, writable
+	SimPrivateControlsCloud_shadow_samples_horizon_multiplier         string = "sim/private/controls/cloud/shadow_samples_horizon_multiplier"             // float, writable
+	SimPrivateControlsCloud_shadow_samples_zenith                     string = "sim/private/controls/cloud/shadow_samples_zenith"                         // float, writable
+	SimPrivateControlsClouds_shadow_vertical_extent_km                string = "sim/private/controls/clouds/shadow_vertical_extent_km"                    // float, writable
+	SimPrivateControlsClouds_shadow_extent_min_km                     string = "sim/private/controls/clouds/shadow_extent_min_km"                         // float, writable
+	SimPrivateControlsClouds_shadow_extent_km                         string = "sim/private/controls/clouds/shadow_extent_km"                             // float, writable
+	SimPrivateControlsClouds_noise_compression                        string = "sim/private/controls/clouds/noise_compression"                            // float, writable
+	SimPrivateControlsClouds_noise_hi_resolution                      string = "sim/private/controls/clouds/noise_hi_resolution"                          // float, writable
+	SimPrivateControlsClouds_noise_lo_resolution                      string = "sim/private/controls/clouds/noise_lo_resolution"                          // float, writable
+	SimPrivateControlsCloud_precipitation_enabled                     string = "sim/private/controls/cloud/precipitation_enabled"                         // float, writable
+	SimPrivateControlsNewClouds_kill_shadow_render                    string = "sim/private/controls/new_clouds/kill_shadow_render"                       // float, writable
+	SimPrivateControlsNewClouds_lighting_mode                         string = "sim/private/controls/new_clouds/lighting_mode"                            // float, writable
+	SimPrivateControlsNewClouds_density                               string = "sim/private/controls/new_clouds/density"                                  // float, writable
+	SimPrivateControlsNewClouds_top                                   string = "sim/private/controls/new_clouds/top"                                      // float, writable
+	SimPrivateControlsNewClouds_bottom                                string = "sim/private/controls/new_clouds/bottom"                                   // float, writable
+	SimPrivateControlsNewClouds_scale                                 string = "sim/private/controls/new_clouds/scale"                                    // float, writable
+	SimPrivateControlsNewCloudsDiag_always_push                       string = "sim/private/controls/new_clouds/diag/always_push"                         // float, writable
+	SimPrivateControlsNexradDebug_slice                               string = "sim/private/controls/nexrad/debug/slice"                                  // float, writable
+	SimPrivateControlsNexradCoef2_instability_y2                      string = "sim/private/controls/nexrad/coef2/instability_y2"                         // float, writable
+	SimPrivateControlsNexradCoef2_instability_y1                      string = "sim/private/controls/nexrad/coef2/instability_y1"                         // float, writable
+	SimPrivateControlsNexradCoef2_instability_x2                      string = "sim/private/controls/nexrad/coef2/instability_x2"                         // float, writable
+	SimPrivateControlsNexradCoef2_instability_x1                      string = "sim/private/controls/nexrad/coef2/instability_x1"                         // float, writable
+	SimPrivateControlsNexradCoef2_density_y2                          string = "sim/private/controls/nexrad/coef2/density_y2"                             // float, writable
+	SimPrivateControlsNexradCoef2_density_y1                          string = "sim/private/controls/nexrad/coef2/density_y1"                             // float, writable
+	SimPrivateControlsNexradCoef2_density_x2                          string = "sim/private/controls/nexrad/coef2/density_x2"                             // float, writable
+	SimPrivateControlsNexradCoef2_density_x1                          string = "sim/private/controls/nexrad/coef2/density_x1"                             // float, writable
+	SimPrivateControlsNexradCoef1_instability_y2                      string = "sim/private/controls/nexrad/coef1/instability_y2"                         // float, writable
+	SimPrivateControlsNexradCoef1_instability_y1                      string = "sim/private/controls/nexrad/coef1/instability_y1"                         // float, writable
+	SimPrivateControlsNexradCoef1_instability_x2                      string = "sim/private/controls/nexrad/coef1/instability_x2"                         // float, writable
+	SimPrivateControlsNexradCoef1_instability_x1                      string = "sim/private/controls/nexrad/coef1/instability_x1"                         // float, writable
+	SimPrivateControlsNexradCoef1_density_y2                          string = "sim/private/controls/nexrad/coef1/density_y2"                             // float, writable
+	SimPrivateControlsNexradCoef1_density_y1                          string = "sim/private/controls/nexrad/coef1/density_y1"                             // float, writable
+	SimPrivateControlsNexradCoef1_density_x2                          string = "sim/private/controls/nexrad/coef1/density_x2"                             // float, writable
+	SimPrivateControlsNexradCoef1_density_x1                          string = "sim/private/controls/nexrad/coef1/density_x1"                             // float, writable
+	SimPrivateControlsClouds_latch_step                               string = "sim/private/controls/clouds/latch_step"                                   // float, writable
+	SimPrivateControlsClouds_kill_render                              string = "sim/private/controls/clouds/kill_render"                                  // float, writable
+	SimPrivateControlsWxrnBlend_precip                                string = "sim/private/controls/wxrn/blend/precip"                                   // float, writable
+	SimPrivateControlsWxrnBlend_type                                  string = "sim/private/controls/wxrn/blend/type"                                     // float, writable
+	SimPrivateControlsWxrnBlend_coverage                              string = "sim/private/controls/wxrn/blend/coverage"                                 // float, writable
+	SimPrivateControlsWxrnBlend_height                                string = "sim/private/controls/wxrn/blend/height"                                   // float, writable
+	SimPrivateControlsWxrnBlend_bases                                 string = "sim/private/controls/wxrn/blend/bases"                                    // float, writable
+	SimPrivateControlsWxrn_noise_detail                               string = "sim/private/controls/wxrn/noise_detail"                                   // float, writable
+	SimPrivateControlsWxrn_density                                    string = "sim/private/controls/wxrn/density"                                        // float, writable
+	SimPrivateControlsWxrn_thickness                                  string = "sim/private/controls/wxrn/thickness"                                      // float, writable
+	SimPrivateControlsWxrn_precip                                     string = "sim/private/controls/wxrn/precip"                                         // float, writable
+	SimPrivateControlsWxrn_bases                                      string = "sim/private/controls/wxrn/bases"                                          // float, writable
+	SimPrivateControlsWxrn_edge_softness                              string = "sim/private/controls/wxrn/edge_softness"                                  // float, writable
+	SimPrivateControlsWxrn_waterline                                  string = "sim/private/controls/wxrn/waterline"                                      // float, writable
+	SimPrivateControlsCloudNoise_nimbus_aspect_x                      string = "sim/private/controls/cloud_noise/nimbus_aspect_x"                         // float, writable
+	SimPrivateControlsCloudNoise_nimbus_aspect_z                      string = "sim/private/controls/cloud_noise/nimbus_aspect_z"                         // float, writable
+	SimPrivateControlsThunderAnvil_power                              string = "sim/private/controls/thunder/anvil/power"                                 // float, writable
+	SimPrivateControlsThunderAnvil_strength                           string = "sim/private/controls/thunder/anvil/strength"                              // float, writable
+	SimPrivateControlsThunder_anvil_h                                 string = "sim/private/controls/thunder/anvil_h"                                     // float, writable
+	SimPrivateControlsThunder_no_top_knife                            string = "sim/private/controls/thunder/no_top_knife"                                // float, writable
+	SimPrivateControlsNewCloudsShape_density_topline                  string = "sim/private/controls/new_clouds/shape/density_topline"                    // float, writable
+	SimPrivateControlsNewCloudsShape_density_reduce                   string = "sim/private/controls/new_clouds/shape/density_reduce"                     // float, writable
+	SimPrivateControlsNewCloudsShape_density_curve                    string = "sim/private/controls/new_clouds/shape/density_curve"                      // float, writable
+	SimPrivateControlsNewCloudsShape_density_baseline                 string = "sim/private/controls/new_clouds/shape/density_baseline"                   // float, writable
+	SimPrivateControlsNewCloudsShape_altitude_loss_curve              string = "sim/private/controls/new_clouds/shape/altitude_loss_curve"                // float, writable
+	SimPrivateControlsNewCloudsShape_alt_density_rand_hi              string = "sim/private/controls/new_clouds/shape/alt_density_rand_hi"                // float, writable
+	SimPrivateControlsNewCloudsShape_alt_density_rand_lo              string = "sim/private/controls/new_clouds/shape/alt_density_rand_lo"                // float, writable
+	SimPrivateControlsNewCloudsShape_snap_shape                       string = "sim/private/controls/new_clouds/shape/snap_shape"                         // float, writable
+	SimPrivateControlsWeatherRwx_worker_ratio                         string = "sim/private/controls/weather/rwx/worker_ratio"                            // float, writable
+	SimPrivateControlsNewClouds_kill_hf_wind                          string = "sim/private/controls/new_clouds/kill_hf_wind"                             // float, writable
+	SimPrivateControlsNewClouds_render_lead_time                      string = "sim/private/controls/new_clouds/render_lead_time"                         // float, writable
+	SimPrivateControlsVr_hud_project_dist                             string = "sim/private/controls/vr/hud_project_dist"                                 // float, writable
+	SimPrivateControlsPuff_kill_afterburner                           string = "sim/private/controls/puff/kill_afterburner"                               // float, writable
+	SimPrivateControlsContactPts_merge_distance                       string = "sim/private/controls/contact_pts/merge_distance"                          // float, writable
+	SimPrivateControlsLights_spill_scale                              string = "sim/private/controls/lights/spill_scale"                                  // float, writable
+	SimPrivateControlsAircraft_interior_lit_nodim_nts                 string = "sim/private/controls/aircraft/interior_lit_nodim_nts"                     // float, writable
+	SimPrivateControlsAircraft_interior_lit_night_nts                 string = "sim/private/controls/aircraft/interior_lit_night_nts"                     // float, writable
+	SimPrivateControlsAircraft_interior_lit_day_nts                   string = "sim/private/controls/aircraft/interior_lit_day_nts"                       // float, writable
+	SimPrivateControlsAircraft_exterior_lit_nts                       string = "sim/private/controls/aircraft/exterior_lit_nts"                           // float, writable
+	SimPrivateControlsLights3d_constant_atten                         string = "sim/private/controls/lights/3d/constant_atten"                            // float, writable
+	SimPrivateControlsLights3d_rheo_size_curve                        string = "sim/private/controls/lights/3d/rheo_size_curve"                           // float, writable
+	SimPrivateControlsPreview_lite_the                                string = "sim/private/controls/preview/lite_the"                                    // float, writable
+	SimPrivateControlsPreview_fov                                     string = "sim/private/controls/preview/fov"                                         // float, writable
+	SimPrivateControlsPreview_acf_the_hi                              string = "sim/private/controls/preview/acf_the_hi"                                  // float, writable
+	SimPrivateControlsPreview_acf_rad_hi                              string = "sim/private/controls/preview/acf_rad_hi"                                  // float, writable
+	SimPrivateControlsPreview_acf_the_lo                              string = "sim/private/controls/preview/acf_the_lo"                                  // float, writable
+	SimPrivateControlsPreview_acf_rad_lo                              string = "sim/private/controls/preview/acf_rad_lo"                                  // float, writable
+	SimPrivateControlsPreview_acf_psi                                 string = "sim/private/controls/preview/acf_psi"                                     // float, writable
+	SimPrivateControlsDebug_use_obj_wheel_emitters                    string = "sim/private/controls/debug/use_obj_wheel_emitters"                        // float, writable
+	SimPrivateControlsDebug_show_acf_bones                            string = "sim/private/controls/debug/show_acf_bones"                                // float, writable
+	SimPrivateControlsDebug_show_ctc_emitters                         string = "sim/private/controls/debug/show_ctc_emitters"                             // float, writable
+	SimPrivateControlsDebug_show_acf_emitters                         string = "sim/private/controls/debug/show_acf_emitters"                             // float, writable
+	SimPrivateControlsDebug_show_physics_aircraft                     string = "sim/private/controls/debug/show_physics_aircraft"                         // float, writable
+	SimPrivateControlsChock_lon_offset                                string = "sim/private/controls/chock/lon_offset"                                    // float, writable
+	SimPrivateControlsChock_plot_debug                                string = "sim/private/controls/chock/plot_debug"                                    // float, writable
+	SimPrivateControlsDebug_show_engn_puff_velocity                   string = "sim/private/controls/debug/show_engn_puff_velocity"                       // float, writable
+	SimPrivateControlsSoundscape_kill_roads                           string = "sim/private/controls/soundscape/kill_roads"                               // float, writable
+	SimPrivateControlsSoundscape_show_raster                          string = "sim/private/controls/soundscape/show_raster"                              // float, writable
+	SimPrivateControlsSoundscape_show_probes                          string = "sim/private/controls/soundscape/show_probes"                              // float, writable
+	SimPrivateControlsSoundscape_max_road_distance                    string = "sim/private/controls/soundscape/max_road_distance"                        // float, writable
+	SimPrivateControlsSoundscape_probe_radius_far                     string = "sim/private/controls/soundscape/probe_radius_far"                         // float, writable
+	SimPrivateControlsSoundscape_probe_radius_near                    string = "sim/private/controls/soundscape/probe_radius_near"                        // float, writable
+	SimPrivateControlsSoundDebug_spaces                               string = "sim/private/controls/sound/debug/spaces"                                  // float, writable
+	SimPrivateControlsSoundDebug_trace_volumes                        string = "sim/private/controls/sound/debug/trace_volumes"                           // float, writable
+	SimPrivateControlsGeoid_force_space_dsfs                          string = "sim/private/controls/geoid/force_space_dsfs"                              // float, writable
+	SimPrivateControlsGeoid_override_vis_limit                        string = "sim/private/controls/geoid/override_vis_limit"                            // float, writable
+	SimPrivateControlsGeoid_extended_dsfs                             string = "sim/private/controls/geoid/extended_dsfs"                                 // float, writable
+	SimPrivateControlsVolumetric_fog_width                            string = "sim/private/controls/volumetric/fog_width"                                // float, writable
+	SimPrivateControlsVolumetric_fog_height                           string = "sim/private/controls/volumetric/fog_height"                               // float, writable
+	SimPrivateControlsVolumetric_fog_density                          string = "sim/private/controls/volumetric/fog_density"                              // float, writable
+	SimPrivateControlsVolumetric_proxy_threshold                      string = "sim/private/controls/volumetric/proxy_threshold"                          // float, writable
+	SimPrivateControlsVolumetric_apply_cloud_margin                   string = "sim/private/controls/volumetric/apply_cloud_margin"                       // float, writable
+	SimPrivateControlsVolumetric_apply_far_limit                      string = "sim/private/controls/volumetric/apply_far_limit"                          // float, writable
+	SimPrivateControlsVolumetric_debug_mode                           string = "sim/private/controls/volumetric/debug_mode"                               // float, writable
+	SimPrivateControlsVolumetric_ignore_scene                         string = "sim/private/controls/volumetric/ignore_scene"                             // float, writable
+	SimPrivateControlsVolumetricLights_max_distance_km                string = "sim/private/controls/volumetric/lights/max_distance_km"                   // float, writable
+	SimPrivateControlsVolumetric_z_mip_bias                           string = "sim/private/controls/volumetric/z_mip_bias"                               // float, writable
+	SimPrivateControlsVolumetric_march_opacity_floor                  string = "sim/private/controls/volumetric/march_opacity_floor"                      // float, writable
+	SimPrivateControlsVolumetric_march_cloud_shadow                   string = "sim/private/controls/volumetric/march_cloud_shadow"                       // float, writable
+	SimPrivateControlsVolumetricFog_samples                           string = "sim/private/controls/volumetric/fog/samples"                              // float, writable
+	SimPrivateControlsVolumetricFog_min_light                         string = "sim/private/controls/volumetric/fog/min_light"                            // float, writable
+	SimPrivateControlsVolumetricFog_resolution_z                      string = "sim/private/controls/volumetric/fog/resolution_z"                         // float, writable
+	SimPrivateControlsVolumetricFog_resolution_y                      string = "sim/private/controls/volumetric/fog/resolution_y"                         // float, writable
+	SimPrivateControlsVolumetricFog_resolution_x                      string = "sim/private/controls/volumetric/fog/resolution_x"                         // float, writable
+	SimPrivateControlsVolumetricFog_temporal_alpha                    string = "sim/private/controls/volumetric/fog/temporal_alpha"                       // float, writable
+	SimPrivateControlsVolumetricFog_phase_rev                         string = "sim/private/controls/volumetric/fog/phase_rev"                            // float, writable
+	SimPrivateControlsVolumetricFog_phase_fwd                         string = "sim/private/controls/volumetric/fog/phase_fwd"                            // float, writable
+	SimPrivateControlsVolumetricFog_jitter                            string = "sim/private/controls/volumetric/fog/jitter"                               // float, writable
+	SimPrivateControlsVolumetricFog_albedo                            string = "sim/private/controls/volumetric/fog/albedo"                               // float, writable
+	SimPrivateControlsVolumetricFog_scatter                           string = "sim/private/controls/volumetric/fog/scatter"                              // float, writable
+	SimPrivateControlsVolumetricFog_rain_albedo                       string = "sim/private/controls/volumetric/fog/rain_albedo"                          // float, writable
+	SimPrivateControlsVolumetricFog_rain_scatter                      string = "sim/private/controls/volumetric/fog/rain_scatter"                         // float, writable
+	SimPrivateControlsVolumetricFog_density                           string = "sim/private/controls/volumetric/fog/density"                              // float, writable
+	SimPrivateControlsVolumetricFog_z_samples                         string = "sim/private/controls/volumetric/fog/z_samples"                            // float, writable
+	SimPrivateControlsVolumetricFog_slice_transform                   string = "sim/private/controls/volumetric/fog/slice_transform"                      // float, writable
+	SimPrivateControlsVolumetricFog_max_distance_km                   string = "sim/private/controls/volumetric/fog/max_distance_km"                      // float, writable
+	SimPrivateControlsVolumetricFog_enabled                           string = "sim/private/controls/volumetric/fog/enabled"                              // float, writable
+	SimPrivateControlsCloud_upscale_transmission_clip                 string = "sim/private/controls/cloud/upscale_transmission_clip"                     // float, writable
+	SimPrivateControlsCloud_upscale_brightness_clip                   string = "sim/private/controls/cloud/upscale_brightness_clip"                       // float, writable
+	SimPrivateControlsCloud_temporal_depth_change_limit               string = "sim/private/controls/cloud/temporal_depth_change_limit"                   // float, writable
+	SimPrivateControlsCloud_temporal_alpha                            string = "sim/private/controls/cloud/temporal_alpha"                                // float, writable
+	SimPrivateControlsCloud_upscale_flags                             string = "sim/private/controls/cloud/upscale_flags"                                 // float, writable
+	SimPrivateControlsShader_tile_default_noise_y                     string = "sim/private/controls/shader/tile_default_noise_y"                         // float, writable
+	SimPrivateControlsShader_tile_default_noise_x                     string = "sim/private/controls/shader/tile_default_noise_x"                         // float, writable
+	SimPrivateControlsYter_default_dsf_surface_is_asphalt             string = "sim/private/controls/yter/default_dsf_surface_is_asphalt"                 // float, writable
+	SimPrivateControlsTerrain_composite_far_dist_bias                 string = "sim/private/controls/terrain/composite_far_dist_bias"                     // float, writable
+	SimPrivateControlsOffscreen_scattering_apply                      string = "sim/private/controls/offscreen/scattering_apply"                          // float, writable
+	SimPrivateControlsIbl_cloud_res                                   string = "sim/private/controls/ibl/cloud_res"                                       // float, writable
+	SimPrivateControlsIbl_scattering_res                              string = "sim/private/controls/ibl/scattering_res"                                  // float, writable
+	SimPrivateControlsFsr_clamp_size                                  string = "sim/private/controls/fsr/clamp_size"                                      // float, writable
+	SimPrivateControlsHdr_use_reverse_z                               string = "sim/private/controls/hdr/use_reverse_z"                                   // float, writable
+	SimPrivateControlsCloudTaa_freeze                                 string = "sim/private/controls/cloud/taa/freeze"                                    // float, writable
+	SimPrivateControlsMsaa_early_resolve_mode                         string = "sim/private/controls/msaa/early_resolve_mode"                             // float, writable
+	SimPrivateControlsFbo_shadow_cam_size                             string = "sim/private/controls/fbo/shadow_cam_size"                                 // float, writable
+	SimPrivateControlsClouds_shadow_size                              string = "sim/private/controls/clouds/shadow_size"                                  // float, writable
+	SimPrivateControlsCubemap_x_scale                                 string = "sim/private/controls/cubemap/x_scale"                                     // float, writable
+	SimPrivateControlsCubemap_z_offset                                string = "sim/private/controls/cubemap/z_offset"                                    // float, writable
+	SimPrivateControlsForest_use_quad_lod                             string = "sim/private/controls/forest/use_quad_lod"                                 // float, writable
+	SimPrivateControlsForest_quad_count                               string = "sim/private/controls/forest/quad_count"                                   // float, writable
+	SimPrivateControlsForest_quality_level                            string = "sim/private/controls/forest/quality_level"                                // float, writable
+	SimPrivateControlsForest_lod_multiplier                           string = "sim/private/controls/forest/lod_multiplier"                               // float, writable
+	SimPrivateControlsForest_lod                                      string = "sim/private/controls/forest/lod"                                          // float, writable
+	SimPrivateControlsForest_density                                  string = "sim/private/controls/forest/density"                                      // float, writable
+	SimPrivateControlsForests_no_3d_hash                              string = "sim/private/controls/forests/no_3d_hash"                                  // float, writable
+	SimPrivateControlsForest_no_billboard_hash                        string = "sim/private/controls/forest/no_billboard_hash"                            // float, writable
+	SimPrivateControlsFacades_maximum_mitre_angle                     string = "sim/private/controls/facades/maximum_mitre_angle"                         // float, writable
+	SimPrivateControlsTex_distance_pad                                string = "sim/private/controls/tex/distance_pad"                                    // float, writable
+	SimPrivateControlsTex_ortho_boost_factor                          string = "sim/private/controls/tex/ortho_boost_factor"                              // float, writable
+	SimPrivateControlsTex_preload_dist                                string = "sim/private/controls/tex/preload_dist"                                    // float, writable
+	SimPrivateControlsIbl_furnace_mode                                string = "sim/private/controls/ibl/furnace_mode"                                    // float, writable
+	SimPrivateControlsIbl_identify_probes                             string = "sim/private/controls/ibl/identify_probes"                                 // float, writable
+	SimPrivateControlsRender_tickle_cubemaps                          string = "sim/private/controls/render/tickle_cubemaps"                              // float, writable
+	SimPrivateControlsCubemap_interior_far_dist                       string = "sim/private/controls/cubemap/interior_far_dist"                           // float, writable
+	SimPrivateControlsCubemap_interior_near_dist                      string = "sim/private/controls/cubemap/interior_near_dist"                          // float, writable
+	SimPrivateControlsCubemap_interior_proj_size                      string = "sim/private/controls/cubemap/interior_proj_size"                          // float, writable
+	SimPrivateControlsRender_int_render_count                         string = "sim/private/controls/render/int_render_count"                             // float, writable
+	SimPrivateControlsRender_ext_render_count                         string = "sim/private/controls/render/ext_render_count"                             // float, writable
+	SimPrivateControlsIbl_kill_ibl                                    string = "sim/private/controls/ibl/kill_ibl"                                        // float, writable
+	SimPrivateControlsIbl_alpha_exterior                              string = "sim/private/controls/ibl/alpha_exterior"                                  // float, writable
+	SimPrivateControlsIbl_update_period_sec                           string = "sim/private/controls/ibl/update_period_sec"                               // float, writable
+	SimPrivateControlsIbl_update_mode                                 string = "sim/private/controls/ibl/update_mode"                                     // float, writable
+	SimPrivateControlsCube_recalc_all_degs_cos                        string = "sim/private/controls/cube/recalc_all_degs_cos"                            // float, writable
+	SimPrivateControlsCube_recalc_degs_cos                            string = "sim/private/controls/cube/recalc_degs_cos"                                // float, writable
+	SimPrivateControlsCube_max_dsf_dist                               string = "sim/private/controls/cube/max_dsf_dist"                                   // float, writable
+	SimPrivateControlsIblPmrem_samples5                               string = "sim/private/controls/ibl/pmrem/samples5"                                  // float, writable
+	SimPrivateControlsIblPmrem_samples4                               string = "sim/private/controls/ibl/pmrem/samples4"                                  // float, writable
+	SimPrivateControlsIblPmrem_samples3                               string = "sim/private/controls/ibl/pmrem/samples3"                                  // float, writable
+	SimPrivateControlsIblPmrem_samples2                               string = "sim/private/controls/ibl/pmrem/samples2"                                  // float, writable
+	SimPrivateControlsIblPmrem_samples1                               string = "sim/private/controls/ibl/pmrem/samples1"                                  // float, writable
+	SimPrivateControlsIblPmrem_rough_mip5                             string = "sim/private/controls/ibl/pmrem/rough_mip5"                                // float, writable
+	SimPrivateControlsIblPmrem_rough_mip4                             string = "sim/private/controls/ibl/pmrem/rough_mip4"                                // float, writable
+	SimPrivateControlsIblPmrem_rough_mip3                             string = "sim/private/controls/ibl/pmrem/rough_mip3"                                // float, writable
+	SimPrivateControlsIblPmrem_rough_mip2                             string = "sim/private/controls/ibl/pmrem/rough_mip2"                                // float, writable
+	SimPrivateControlsIblPmrem_rough_mip1                             string = "sim/private/controls/ibl/pmrem/rough_mip1"                                // float, writable
+	SimPrivateControlsIbl_force_spec_probes                           string = "sim/private/controls/ibl/force_spec_probes"                               // float, writable
+	SimPrivateControlsLighting_probe_resolution                       string = "sim/private/controls/lighting/probe_resolution"                           // float, writable
+	SimPrivateControlsLighting_probe_count                            string = "sim/private/controls/lighting/probe_count"                                // float, writable
+	SimPrivateControlsLights_combine_transforms                       string = "sim/private/controls/lights/combine_transforms"                           // float, writable
+	SimPrivateControlsTerrain_kill_vegetation_legacy                  string = "sim/private/controls/terrain/kill_vegetation_legacy"                      // float, writable
+	SimPrivateControlsTerrain_kill_vegetation_billboard               string = "sim/private/controls/terrain/kill_vegetation_billboard"                   // float, writable
+	SimPrivateControlsTerrain_kill_vegetation_3d                      string = "sim/private/controls/terrain/kill_vegetation_3d"                          // float, writable
+	SimPrivateControlsTerrain_kill_vegetation                         string = "sim/private/controls/terrain/kill_vegetation"                             // float, writable
+	SimPrivateControlsYter_y_probe_error_margin                       string = "sim/private/controls/yter/y_probe_error_margin"                           // float, writable
+	SimPrivateControlsWater_cascade_3_scale                           string = "sim/private/controls/water/cascade_3_scale"                               // float, writable
+	SimPrivateControlsWater_cascade_2_scale                           string = "sim/private/controls/water/cascade_2_scale"                               // float, writable
+	SimPrivateControlsWater_cascade_1_scale                           string = "sim/private/controls/water/cascade_1_scale"                               // float, writable
+	SimPrivateControlsWater_cascade_0_scale                           string = "sim/private/controls/water/cascade_0_scale"                               // float, writable
+	SimPrivateControlsWaterTurbidity_cutoff                           string = "sim/private/controls/water/turbidity/cutoff"                              // float, writable
+	SimPrivateControlsWater_async_compute                             string = "sim/private/controls/water/async_compute"                                 // float, writable
+	SimPrivateControlsWater_F0                                        string = "sim/private/controls/water/F0"                                            // float, writable
+	SimPrivateControlsWater_gloss                                     string = "sim/private/controls/water/gloss"                                         // float, writable
+	SimPrivateControlsWater_deep_ratio                                string = "sim/private/controls/water/deep_ratio"                                    // float, writable
+	SimPrivateControlsWater_foam_ratio                                string = "sim/private/controls/water/foam_ratio"                                    // float, writable
+	SimPrivateControlsWater_foam_scale                                string = "sim/private/controls/water/foam_scale"                                    // float, writable
+	SimPrivateControlsWater_foam_bias                                 string = "sim/private/controls/water/foam_bias"                                     // float, writable
+	SimPrivateControlsWater_cascade_end                               string = "sim/private/controls/water/cascade_end"                                   // float, writable
+	SimPrivateControlsWater_cascade_start                             string = "sim/private/controls/water/cascade_start"                                 // float, writable
+	SimPrivateControlsWaterGrid_height                                string = "sim/private/controls/water/grid/height"                                   // float, writable
+	SimPrivateControlsWaterGrid_width                                 string = "sim/private/controls/water/grid/width"                                    // float, writable
+	SimPrivateControlsWaterProjector_displacement                     string = "sim/private/controls/water/projector/displacement"                        // float, writable
+	SimPrivateControlsWater_enable_turbidity                          string = "sim/private/controls/water/enable_turbidity"                              // float, writable
+	SimPrivateControlsWater_enable_cloud_shadows                      string = "sim/private/controls/water/enable_cloud_shadows"                          // float, writable
+	SimPrivateControlsWater_enable_csm_shadows                        string = "sim/private/controls/water/enable_csm_shadows"                            // float, writable
+	SimPrivateControlsWater_disable_far_water                         string = "sim/private/controls/water/disable_far_water"                             // float, writable
+	SimPrivateControlsWaterProjector_freeze_camera                    string = "sim/private/controls/water/projector/freeze_camera"                       // float, writable
+	SimPrivateControlsWaterProjector_freeze                           string = "sim/private/controls/water/projector/freeze"                              // float, writable
+	SimPrivateControlsWater_disable_proj_grid                         string = "sim/private/controls/water/disable_proj_grid"                             // float, writable
+	SimPrivateControlsWater_ripple_noise_speed                        string = "sim/private/controls/water/ripple_noise_speed"                            // float, writable
+	SimPrivateControlsWater_ripple_noise_strength                     string = "sim/private/controls/water/ripple_noise_strength"                         // float, writable
+	SimPrivateControlsWater_ripple_noise_scale                        string = "sim/private/controls/water/ripple_noise_scale"                            // float, writable
+	SimPrivateControlsWater_ripple_noise_fade                         string = "sim/private/controls/water/ripple_noise_fade"                             // float, writable
+	SimPrivateControlsWaterSpectrum_short_waves_fade                  string = "sim/private/controls/water/spectrum/short_waves_fade"                     // float, writable
+	SimPrivateControlsWaterSpectrum_spread_blend                      string = "sim/private/controls/water/spectrum/spread_blend"                         // float, writable
+	SimPrivateControlsWaterSpectrum_swell                             string = "sim/private/controls/water/spectrum/swell"                                // float, writable
+	SimPrivateControlsWaterSpectrum_wind_direction                    string = "sim/private/controls/water/spectrum/wind_direction"                       // float, writable
+	SimPrivateControlsWaterSpectrum_fetch                             string = "sim/private/controls/water/spectrum/fetch"                                // float, writable
+	SimPrivateControlsWaterSpectrum_wind_speed                        string = "sim/private/controls/water/spectrum/wind_speed"                           // float, writable
+	SimPrivateControlsWaterSpectrum_wave_height                       string = "sim/private/controls/water/spectrum/wave_height"                          // float, writable
+	SimPrivateControlsWaterSpectrum_override                          string = "sim/private/controls/water/spectrum/override"                             // float, writable
+	SimPrivateControlsWaterSpectrum_fetch_hi_m                        string = "sim/private/controls/water/spectrum/fetch_hi_m"                           // float, writable
+	SimPrivateControlsWaterSpectrum_height_hi_m                       string = "sim/private/controls/water/spectrum/height_hi_m"                          // float, writable
+	SimPrivateControlsWaterSpectrum_fetch_lo_m                        string = "sim/private/controls/water/spectrum/fetch_lo_m"                           // float, writable
+	SimPrivateControlsWaterSpectrum_height_lo_m                       string = "sim/private/controls/water/spectrum/height_lo_m"                          // float, writable
+	SimPrivateControlsWaterSpectrum_max_swell                         string = "sim/private/controls/water/spectrum/max_swell"                            // float, writable
+	SimPrivateControlsWaterSpectrum_height_swell_m                    string = "sim/private/controls/water/spectrum/height_swell_m"                       // float, writable
+	SimPrivateControlsWaterSpectrum_height_no_swell_m                 string = "sim/private/controls/water/spectrum/height_no_swell_m"                    // float, writable
+	SimPrivateControlsWaterSpectrum_fetch_multiplier                  string = "sim/private/controls/water/spectrum/fetch_multiplier"                     // float, writable
+	SimPrivateControlsWater_h_displace_lambda                         string = "sim/private/controls/water/h_displace_lambda"                             // float, writable
+	SimPrivateControlsWater_turbidity                                 string = "sim/private/controls/water/turbidity"                                     // float, writable
+	SimPrivateControlsWaterMesh_wireframe                             string = "sim/private/controls/water/mesh/wireframe"                                // float, writable
+	SimPrivateControlsRegion_async_per_frame                          string = "sim/private/controls/region/async_per_frame"                              // float, writable
+	SimPrivateControlsCars_lod_min                                    string = "sim/private/controls/cars/lod_min"                                        // float, writable
+	SimPrivateControlsCollectorLayerRange_max                         string = "sim/private/controls/collector/layer_range/max"                           // float, writable
+	SimPrivateControlsCollectorLayerRange_min                         string = "sim/private/controls/collector/layer_range/min"                           // float, writable
+	SimPrivateControlsCollector_disable_instancing                    string = "sim/private/controls/collector/disable_instancing"                        // float, writable
+	SimPrivateControlsCollector_max_imm                               string = "sim/private/controls/collector/max_imm"                                   // float, writable
+	SimPrivateControlsCollector_kill_hw                               string = "sim/private/controls/collector/kill_hw"                                   // float, writable
+	SimPrivateControlsCollector_kill_imm                              string = "sim/private/controls/collector/kill_imm"                                  // float, writable
+	SimPrivateControlsCollector_kill_slow                             string = "sim/private/controls/collector/kill_slow"                                 // float, writable
+	SimPrivateControlsCollector_dump_stats                            string = "sim/private/controls/collector/dump_stats"                                // float, writable
+	SimPrivateControlsCollector_use_modern                            string = "sim/private/controls/collector/use_modern"                                // float, writable
+	SimPrivateControlsDebug_show_actor_surface                        string = "sim/private/controls/debug/show_actor_surface"                            // float, writable
+	SimPrivateControlsBeaches_max_batch                               string = "sim/private/controls/beaches/max_batch"                                   // float, writable
+	SimPrivateControlsBeaches_kill_beaches                            string = "sim/private/controls/beaches/kill_beaches"                                // float, writable
+	SimPrivateControlsXplm_debug_nav_calls                            string = "sim/private/controls/xplm/debug_nav_calls"                                // float, writable
+	SimPrivateControlsInstance_tiny_obj_cutoff                        string = "sim/private/controls/instance/tiny_obj_cutoff"                            // float, writable
+	SimPrivateControlsLights_dump_volumetric_lights                   string = "sim/private/controls/lights/dump_volumetric_lights"                       // float, writable
+	SimPrivateControlsLights_collect_volumetric                       string = "sim/private/controls/lights/collect_volumetric"                           // float, writable
+	SimPrivateControlsLights_kill_dynamic_spill                       string = "sim/private/controls/lights/kill_dynamic_spill"                           // float, writable
+	SimPrivateControlsLights_kill_dynamic_billboards                  string = "sim/private/controls/lights/kill_dynamic_billboards"                      // float, writable
+	SimPrivateControlsLights_hdr_mix                                  string = "sim/private/controls/lights/hdr_mix"                                      // float, writable
+	SimPrivateControlsLightsPhotobb_dist_exp3                         string = "sim/private/controls/lights/photobb/dist_exp3"                            // float, writable
+	SimPrivateControlsLightsPhotobb_dist_exp2                         string = "sim/private/controls/lights/photobb/dist_exp2"                            // float, writable
+	SimPrivateControlsLightsPhotobb_dist_exp1                         string = "sim/private/controls/lights/photobb/dist_exp1"                            // float, writable
+	SimPrivateControlsLightsPhotobb_hack_value_hi                     string = "sim/private/controls/lights/photobb/hack_value_hi"                        // float, writable
+	SimPrivateControlsLightsPhotobb_hack_value_lo                     string = "sim/private/controls/lights/photobb/hack_value_lo"                        // float, writable
+	SimPrivateControlsLightsPhotobb_hack_ev_hi                        string = "sim/private/controls/lights/photobb/hack_ev_hi"                           // float, writable
+	SimPrivateControlsLightsPhotobb_hack_ev_lo                        string = "sim/private/controls/lights/photobb/hack_ev_lo"                           // float, writable
+	SimPrivateControlsLightsPhotobb_global_lim                        string = "sim/private/controls/lights/photobb/global_lim"                           // float, writable
+	SimPrivateControlsLightsPhotobb_exp_lim3                          string = "sim/private/controls/lights/photobb/exp_lim3"                             // float, writable
+	SimPrivateControlsLightsPhotobb_exp_lim2                          string = "sim/private/controls/lights/photobb/exp_lim2"                             // float, writable
+	SimPrivateControlsLightsPhotobb_exp_lim1                          string = "sim/private/controls/lights/photobb/exp_lim1"                             // float, writable
+	SimPrivateControlsLightsPhotobb_size3                             string = "sim/private/controls/lights/photobb/size3"                                // float, writable
+	SimPrivateControlsLightsPhotobb_size2                             string = "sim/private/controls/lights/photobb/size2"                                // float, writable
+	SimPrivateControlsLightsPhotobb_size1                             string = "sim/private/controls/lights/photobb/size1"                                // float, writable
+	SimPrivateControlsLightsPhotobb_attenuation3                      string = "sim/private/controls/lights/photobb/attenuation3"                         // float, writable
+	SimPrivateControlsLightsPhotobb_attenuation2                      string = "sim/private/controls/lights/photobb/attenuation2"                         // float, writable
+	SimPrivateControlsLightsPhotobb_attenuation1                      string = "sim/private/controls/lights/photobb/attenuation1"                         // float, writable
+	SimPrivateControlsLightsPhotobb_celly3                            string = "sim/private/controls/lights/photobb/celly3"                               // float, writable
+	SimPrivateControlsLightsPhotobb_celly2                            string = "sim/private/controls/lights/photobb/celly2"                               // float, writable
+	SimPrivateControlsLightsPhotobb_celly1                            string = "sim/private/controls/lights/photobb/celly1"                               // float, writable
+	SimPrivateControlsLightsPhotobb_cellx3                            string = "sim/private/controls/lights/photobb/cellx3"                               // float, writable
+	SimPrivateControlsLightsPhotobb_cellx2                            string = "sim/private/controls/lights/photobb/cellx2"                               // float, writable
+	SimPrivateControlsLightsPhotobb_cellx1                            string = "sim/private/controls/lights/photobb/cellx1"                               // float, writable
+	SimPrivateControlsLightsPhotobb_mult3                             string = "sim/private/controls/lights/photobb/mult3"                                // float, writable
+	SimPrivateControlsLightsPhotobb_mult2                             string = "sim/private/controls/lights/photobb/mult2"                                // float, writable
+	SimPrivateControlsLightsPhotobb_mult1                             string = "sim/private/controls/lights/photobb/mult1"                                // float, writable
+	SimPrivateControlsLights_low_light_cutoff_end                     string = "sim/private/controls/lights/low_light_cutoff_end"                         // float, writable
+	SimPrivateControlsLights_low_light_cutoff_begin                   string = "sim/private/controls/lights/low_light_cutoff_begin"                       // float, writable
+	SimPrivateControlsLights_scale_far                                string = "sim/private/controls/lights/scale_far"                                    // float, writable
+	SimPrivateControlsLights_scale_near                               string = "sim/private/controls/lights/scale_near"                                   // float, writable
+	SimPrivateControlsLights_exponent_far                             string = "sim/private/controls/lights/exponent_far"                                 // float, writable
+	SimPrivateControlsLights_exponent_near                            string = "sim/private/controls/lights/exponent_near"                                // float, writable
+	SimPrivateControlsLights_dist_far                                 string = "sim/private/controls/lights/dist_far"                                     // float, writable
+	SimPrivateControlsLights_dist_near                                string = "sim/private/controls/lights/dist_near"                                    // float, writable
+	SimPrivateControlsLights_atten_const_max                          string = "sim/private/controls/lights/atten_const_max"                              // float, writable
+	SimPrivateControlsLights_atten_const_start_dist                   string = "sim/private/controls/lights/atten_const_start_dist"                       // float, writable
+	SimPrivateControlsLights_spill_cutoff_level                       string = "sim/private/controls/lights/spill_cutoff_level"                           // float, writable
+	SimPrivateControlsLights_occlusion_mode                           string = "sim/private/controls/lights/occlusion_mode"                               // float, writable
+	SimPrivateControlsLights_legacy_ref_level                         string = "sim/private/controls/lights/legacy_ref_level"                             // float, writable
+	SimPrivateControlsLights_legacy_luminance_billboards_day          string = "sim/private/controls/lights/legacy_luminance_billboards_day"              // float, writable
+	SimPrivateControlsLights_legacy_luminance_billboards_night        string = "sim/private/controls/lights/legacy_luminance_billboards_night"            // float, writable
+	SimPrivateControlsLights_legacy_luminance_spills                  string = "sim/private/controls/lights/legacy_luminance_spills"                      // float, writable
+	SimPrivateControlsLights_no_light_groups                          string = "sim/private/controls/lights/no_light_groups"                              // float, writable
+	SimPrivateControlsLights_fat_omni_cutoff                          string = "sim/private/controls/lights/fat_omni_cutoff"                              // float, writable
+	SimPrivateControlsLights_srgb_fog_curve                           string = "sim/private/controls/lights/srgb_fog_curve"                               // float, writable
+	SimPrivateControlsLightsCarrier_rabbit_add                        string = "sim/private/controls/lights/carrier/rabbit_add"                           // float, writable
+	SimPrivateControlsLightsCarrier_rabbit_mul                        string = "sim/private/controls/lights/carrier/rabbit_mul"                           // float, writable
+	SimPrivateControlsLightsCarrier_rabbit_count                      string = "sim/private/controls/lights/carrier/rabbit_count"                         // float, writable
+	SimPrivateControlsLightsCarrier_rabbit_cycle                      string = "sim/private/controls/lights/carrier/rabbit_cycle"                         // float, writable
+	SimPrivateControlsLightsCarrier_mast_strobe_fraction              string = "sim/private/controls/lights/carrier/mast_strobe_fraction"                 // float, writable
+	SimPrivateControlsLightsCarrier_mast_strobe_freq                  string = "sim/private/controls/lights/carrier/mast_strobe_freq"                     // float, writable
+	SimPrivateControlsLightsCarrier_waveoff_flash_amp                 string = "sim/private/controls/lights/carrier/waveoff_flash_amp"                    // float, writable
+	SimPrivateControlsLightsCarrier_waveoff_flash_rate                string = "sim/private/controls/lights/carrier/waveoff_flash_rate"                   // float, writable
+	SimPrivateControlsLightsBoats_night_dim                           string = "sim/private/controls/lights/boats/night_dim"                              // float, writable
+	SimPrivateControlsLightsFresnelHorizontal_fade_v_deg              string = "sim/private/controls/lights/fresnel_horizontal/fade_v_deg"                // float, writable
+	SimPrivateControlsLightsFresnelHorizontal_fade_h_deg              string = "sim/private/controls/lights/fresnel_horizontal/fade_h_deg"                // float, writable
+	SimPrivateControlsLightsFresnelVertical_fade_v_deg                string = "sim/private/controls/lights/fresnel_vertical/fade_v_deg"                  // float, writable
+	SimPrivateControlsLightsFresnelVertical_fade_h_deg                string = "sim/private/controls/lights/fresnel_vertical/fade_h_deg"                  // float, writable
+	SimPrivateControlsLightsVasi3_v_max_sin                           string = "sim/private/controls/lights/vasi3/v_max_sin"                              // float, writable
+	SimPrivateControlsLightsVasi3_v_min_sin                           string = "sim/private/controls/lights/vasi3/v_min_sin"                              // float, writable
+	SimPrivateControlsLightsVasi3_h_max_sin                           string = "sim/private/controls/lights/vasi3/h_max_sin"                              // float, writable
+	SimPrivateControlsLightsVasi3_h_min_sin                           string = "sim/private/controls/lights/vasi3/h_min_sin"                              // float, writable
+	SimPrivateControlsLightsVasi3_width                               string = "sim/private/controls/lights/vasi3/width"                                  // float, writable
+	SimPrivateControlsLightsVasi3Green_b                              string = "sim/private/controls/lights/vasi3/green/b"                                // float, writable
+	SimPrivateControlsLightsVasi3Green_g                              string = "sim/private/controls/lights/vasi3/green/g"                                // float, writable
+	SimPrivateControlsLightsVasi3Green_r                              string = "sim/private/controls/lights/vasi3/green/r"                                // float, writable
+	SimPrivateControlsLightsVasi3Amber_b                              string = "sim/private/controls/lights/vasi3/amber/b"                                // float, writable
+	SimPrivateControlsLightsVasi3Amber_g                              string = "sim/private/controls/lights/vasi3/amber/g"                                // float, writable
+	SimPrivateControlsLightsVasi3Amber_r                              string = "sim/private/controls/lights/vasi3/amber/r"                                // float, writable
+	SimPrivateControlsLightsVasi3Red_b                                string = "sim/private/controls/lights/vasi3/red/b"                                  // float, writable
+	SimPrivateControlsLightsVasi3Red_g                                string = "sim/private/controls/lights/vasi3/red/g"                                  // float, writable
+	SimPrivateControlsLightsVasi3Red_r                                string = "sim/private/controls/lights/vasi3/red/r"                                  // float, writable
+	SimPrivateControlsLights_vasi_papi_night_dim                      string = "sim/private/controls/lights/vasi_papi_night_dim"                          // float, writable
+	SimPrivateControlsLightsVasiPapi_v_max_sin                        string = "sim/private/controls/lights/vasi_papi/v_max_sin"                          // float, writable
+	SimPrivateControlsLightsVasiPapi_v_min_sin                        string = "sim/private/controls/lights/vasi_papi/v_min_sin"                          // float, writable
+	SimPrivateControlsLightsVasiPapi_h_max_sin                        string = "sim/private/controls/lights/vasi_papi/h_max_sin"                          // float, writable
+	SimPrivateControlsLightsVasiPapi_h_min_sin                        string = "sim/private/controls/lights/vasi_papi/h_min_sin"                          // float, writable
+	SimPrivateControlsLightsVasiPapiWhite_b                           string = "sim/private/controls/lights/vasi_papi/white/b"                            // float, writable
+	SimPrivateControlsLightsVasiPapiWhite_g                           string = "sim/private/controls/lights/vasi_papi/white/g"                            // float, writable
+	SimPrivateControlsLightsVasiPapiWhite_r                           string = "sim/private/controls/lights/vasi_papi/white/r"                            // float, writable
+	SimPrivateControlsLightsVasiPapiRed_b                             string = "sim/private/controls/lights/vasi_papi/red/b"                              // float, writable
+	SimPrivateControlsLightsVasiPapiRed_g                             string = "sim/private/controls/lights/vasi_papi/red/g"                              // float, writable
+	SimPrivateControlsLightsVasiPapiRed_r                             string = "sim/private/controls/lights/vasi_papi/red/r"                              // float, writable
+	SimPrivateControlsLights_test_waveoff                             string = "sim/private/controls/lights/test_waveoff"                                 // float, writable
+	SimPrivateControlsLights_wigwag_flash_speed                       string = "sim/private/controls/lights/wigwag_flash_speed"                           // float, writable
+	SimPrivateControlsLights_rabbit_flash_speed                       string = "sim/private/controls/lights/rabbit_flash_speed"                           // float, writable
+	SimPrivateControlsLights_strobe_flash_speed                       string = "sim/private/controls/lights/strobe_flash_speed"                           // float, writable
+	SimPrivateControlsLights_beacon_strobe_billboard                  string = "sim/private/controls/lights/beacon_strobe_billboard"                      // float, writable
+	SimPrivateControlsLights_beacon_strobe_spill                      string = "sim/private/controls/lights/beacon_strobe_spill"                          // float, writable
+	SimPrivateControlsLights_beacon_rotate_billboard                  string = "sim/private/controls/lights/beacon_rotate_billboard"                      // float, writable
+	SimPrivateControlsLights_beacon_rotate_spill                      string = "sim/private/controls/lights/beacon_rotate_spill"                          // float, writable
+	SimPrivateControlsManipulators_click_delay                        string = "sim/private/controls/manipulators/click_delay"                            // float, writable
+	SimPrivateControlsDebug_draw_cpu_command_geo                      string = "sim/private/controls/debug/draw_cpu_command_geo"                          // float, writable
+	SimPrivateControlsDebug_manip_bounds                              string = "sim/private/controls/debug/manip_bounds"                                  // float, writable
+	SimPrivateControlsDebug_show_manipulator_axis                     string = "sim/private/controls/debug/show_manipulator_axis"                         // float, writable
+	SimPrivateControlsObj_show_drag_lines                             string = "sim/private/controls/obj/show_drag_lines"                                 // float, writable
+	SimPrivateControlsCamera_minimum_agl                              string = "sim/private/controls/camera/minimum_agl"                                  // float, writable
+	SimPrivateControlsView_beacon_view_height                         string = "sim/private/controls/view/beacon_view_height"                             // float, writable
+	SimPrivateControlsSound_kill_world_soundscape                     string = "sim/private/controls/sound/kill_world_soundscape"                         // float, writable
+	SimPrivateControlsCockpit_allow_solid_wall_testing                string = "sim/private/controls/cockpit/allow_solid_wall_testing"                    // float, writable
+	SimPrivateControlsCockpit_minimum_agl                             string = "sim/private/controls/cockpit/minimum_agl"                                 // float, writable
+	SimPrivateControlsCockpit_wall_hit_extra                          string = "sim/private/controls/cockpit/wall_hit_extra"                              // float, writable
+	SimPrivateControlsMouseYoke_thickness                             string = "sim/private/controls/mouse_yoke/thickness"                                // float, writable
+	SimPrivateControlsMouseYoke_plus_size                             string = "sim/private/controls/mouse_yoke/plus_size"                                // float, writable
+	SimPrivateControlsMouseYoke_box_size                              string = "sim/private/controls/mouse_yoke/box_size"                                 // float, writable
+	SimPrivateControlsMouseYoke_alpha_joys                            string = "sim/private/controls/mouse_yoke/alpha_joys"                               // float, writable
+	SimPrivateControlsMouseYoke_alpha_rect                            string = "sim/private/controls/mouse_yoke/alpha_rect"                               // float, writable
+	SimPrivateControlsDeadReckoning_max_rot_look_ahead_time_ms        string = "sim/private/controls/dead_reckoning/max_rot_look_ahead_time_ms"           // float, writable
+	SimPrivateControlsPerf_kill_udp_read                              string = "sim/private/controls/perf/kill_udp_read"                                  // float, writable
+	SimPrivateControlsVrDebug_y2                                      string = "sim/private/controls/vr/debug/y2"                                         // float, writable
+	SimPrivateControlsVrDebug_x2                                      string = "sim/private/controls/vr/debug/x2"                                         // float, writable
+	SimPrivateControlsVrDebug_y1                                      string = "sim/private/controls/vr/debug/y1"                                         // float, writable
+	SimPrivateControlsVrDebug_x1                                      string = "sim/private/controls/vr/debug/x1"                                         // float, writable
+	SimPrivateControlsVr_teleport_tune_rate                           string = "sim/private/controls/vr/teleport_tune_rate"                               // float, writable
+	SimPrivateControlsVr_self_hotspot_angle                           string = "sim/private/controls/vr/self_hotspot_angle"                               // float, writable
+	SimPrivateControlsVr_use_interleave                               string = "sim/private/controls/vr/use_interleave"                                   // float, writable
+	SimPrivateControlsVr_use_post_present                             string = "sim/private/controls/vr/use_post_present"                                 // float, writable
+	SimPrivateControlsShadow_bias_const                               string = "sim/private/controls/shadow/bias_const"                                   // float, writable
+	SimPrivateControlsShadow_bias_slope                               string = "sim/private/controls/shadow/bias_slope"                                   // float, writable
+	SimPrivateControlsShader_bypass_optimizer                         string = "sim/private/controls/shader/bypass_optimizer"                             // float, writable
+	SimPrivateControlsWater_deep2_b                                   string = "sim/private/controls/water/deep2_b"                                       // float, writable
+	SimPrivateControlsWater_deep2_g                                   string = "sim/private/controls/water/deep2_g"                                       // float, writable
+	SimPrivateControlsWater_deep2_r                                   string = "sim/private/controls/water/deep2_r"                                       // float, writable
+	SimPrivateControlsWater_deep1_b                                   string = "sim/private/controls/water/deep1_b"                                       // float, writable
+	SimPrivateControlsWater_deep1_g                                   string = "sim/private/controls/water/deep1_g"                                       // float, writable
+	SimPrivateControlsWater_deep1_r                                   string = "sim/private/controls/water/deep1_r"                                       // float, writable
+	SimPrivateControlsWater_color_end                                 string = "sim/private/controls/water/color_end"                                     // float, writable
+	SimPrivateControlsWater_color_start                               string = "sim/private/controls/water/color_start"                                   // float, writable
+	SimPrivateControlsTerrain_filter_kappa                            string = "sim/private/controls/terrain/filter_kappa"                                // float, writable
+	SimPrivateControlsTerrain_filter_normals                          string = "sim/private/controls/terrain/filter_normals"                              // float, writable
+	SimPrivateControlsWater_sea_floor_coef                            string = "sim/private/controls/water/sea_floor_coef"                                // float, writable
+	SimPrivateControlsSnow_luma_a                                     string = "sim/private/controls/snow/luma_a"                                         // float, writable
+	SimPrivateControlsSnow_luma_b                                     string = "sim/private/controls/snow/luma_b"                                         // float, writable
+	SimPrivateControlsSnow_luma_g                                     string = "sim/private/controls/snow/luma_g"                                         // float, writable
+	SimPrivateControlsSnow_luma_r                                     string = "sim/private/controls/snow/luma_r"                                         // float, writable
+	SimPrivateControlsVegetation_normal_up                            string = "sim/private/controls/vegetation/normal_up"                                // float, writable
+	SimPrivateControlsVegetation_normal_bend                          string = "sim/private/controls/vegetation/normal_bend"                              // float, writable
+	SimPrivateControlsVegetation_speed                                string = "sim/private/controls/vegetation/speed"                                    // float, writable
+	SimPrivateControlsVegetation_wind_speed_scale                     string = "sim/private/controls/vegetation/wind_speed_scale"                         // float, writable
+	SimPrivateControlsVegetation_vertex_bend_scale                    string = "sim/private/controls/vegetation/vertex_bend_scale"                        // float, writable
+	SimPrivateControlsVegetation_branch_amplitude                     string = "sim/private/controls/vegetation/branch_amplitude"                         // float, writable
+	SimPrivateControlsVegetation_bend_scale                           string = "sim/private/controls/vegetation/bend_scale"                               // float, writable
+	SimPrivateControlsClouds_cloud_shadow_lighten_ratio               string = "sim/private/controls/clouds/cloud_shadow_lighten_ratio"                   // float, writable
+	SimPrivateControlsTerrain_far_lit_ratio                           string = "sim/private/controls/terrain/far_lit_ratio"                               // float, writable
+	SimPrivateControlsShaders_faded_lod                               string = "sim/private/controls/shaders/faded_lod"                                   // float, writable
+	SimPrivateControlsTranslate_debug_hard_coded_strings              string = "sim/private/controls/translate/debug_hard_coded_strings"                  // float, writable
+	SimPrivateControlsDebug_histo_top                                 string = "sim/private/controls/debug/histo_top"                                     // float, writable
+	SimPrivateControlsDebug_histo_bottom                              string = "sim/private/controls/debug/histo_bottom"                                  // float, writable
+	SimPrivateControlsDebug_lumi_histo_mip                            string = "sim/private/controls/debug/lumi_histo_mip"                                // float, writable
+	SimPrivateControlsDebug_luminance_histo_scale                     string = "sim/private/controls/debug/luminance_histo_scale"                         // float, writable
+	SimPrivateControlsUiMENU2Blur_shadow_expand                       string = "sim/private/controls/ui/MENU2/blur/shadow_expand"                         // float, writable
+	SimPrivateControlsUiMENU2Blur_shadow_sigma                        string = "sim/private/controls/ui/MENU2/blur/shadow_sigma"                          // float, writable
+	SimPrivateControlsUiMENU2Blur_shadow_alpha                        string = "sim/private/controls/ui/MENU2/blur/shadow_alpha"                          // float, writable
+	SimPrivateControlsUiMENU2Blur_shadow_offset                       string = "sim/private/controls/ui/MENU2/blur/shadow_offset"                         // float, writable
+	SimPrivateControlsUiMENU2Blur_top_a                               string = "sim/private/controls/ui/MENU2/blur/top_a"                                 // float, writable
+	SimPrivateControlsUiMENU2Blur_top_b                               string = "sim/private/controls/ui/MENU2/blur/top_b"                                 // float, writable
+	SimPrivateControlsUiMENU2Blur_top_g                               string = "sim/private/controls/ui/MENU2/blur/top_g"                                 // float, writable
+	SimPrivateControlsUiMENU2Blur_top_r                               string = "sim/private/controls/ui/MENU2/blur/top_r"                                 // float, writable
+	SimPrivateControlsUiMENU2Blur_saturation                          string = "sim/private/controls/ui/MENU2/blur/saturation"                            // float, writable
+	SimPrivateControlsUiMENU2Blur_contrast                            string = "sim/private/controls/ui/MENU2/blur/contrast"                              // float, writable
+	SimPrivateControlsUiMENU2Blur_brightness                          string = "sim/private/controls/ui/MENU2/blur/brightness"                            // float, writable
+	SimPrivateControlsUiMENU2Blur_lod_base                            string = "sim/private/controls/ui/MENU2/blur/lod_base"                              // float, writable
+	SimPrivateControlsUiMENU2Blur_depth                               string = "sim/private/controls/ui/MENU2/blur/depth"                                 // float, writable
+	SimPrivateControlsUiMENU2Blur_3                                   string = "sim/private/controls/ui/MENU2/blur/3"                                     // float, writable
+	SimPrivateControlsUiMENU2Blur_2                                   string = "sim/private/controls/ui/MENU2/blur/2"                                     // float, writable
+	SimPrivateControlsUiMENU2Blur_1                                   string = "sim/private/controls/ui/MENU2/blur/1"                                     // float, writable
+	SimPrivateControlsUiMENU2Blur_0                                   string = "sim/private/controls/ui/MENU2/blur/0"                                     // float, writable
+	SimPrivateControlsUiMENU1Blur_shadow_expand                       string = "sim/private/controls/ui/MENU1/blur/shadow_expand"                         // float, writable
+	SimPrivateControlsUiMENU1Blur_shadow_sigma                        string = "sim/private/controls/ui/MENU1/blur/shadow_sigma"                          // float, writable
+	SimPrivateControlsUiMENU1Blur_shadow_alpha                        string = "sim/private/controls/ui/MENU1/blur/shadow_alpha"                          // float, writable
+	SimPrivateControlsUiMENU1Blur_shadow_offset                       string = "sim/private/controls/ui/MENU1/blur/shadow_offset"                         // float, writable
+	SimPrivateControlsUiMENU1Blur_top_a                               string = "sim/private/controls/ui/MENU1/blur/top_a"                                 // float, writable
+	SimPrivateControlsUiMENU1Blur_top_b                               string = "sim/private/controls/ui/MENU1/blur/top_b"                                 // float, writable
+	SimPrivateControlsUiMENU1Blur_top_g                               string = "sim/private/controls/ui/MENU1/blur/top_g"                                 // float, writable
+	SimPrivateControlsUiMENU1Blur_top_r                               string = "sim/private/controls/ui/MENU1/blur/top_r"                                 // float, writable
+	SimPrivateControlsUiMENU1Blur_saturation                          string = "sim/private/controls/ui/MENU1/blur/saturation"                            // float, writable
+	SimPrivateControlsUiMENU1Blur_contrast                            string = "sim/private/controls/ui/MENU1/blur/contrast"                              // float, writable
+	SimPrivateControlsUiMENU1Blur_brightness                          string = "sim/private/controls/ui/MENU1/blur/brightness"                            // float, writable
+	SimPrivateControlsUiMENU1Blur_lod_base                            string = "sim/private/controls/ui/MENU1/blur/lod_base"                              // float, writable
+	SimPrivateControlsUiMENU1Blur_depth                               string = "sim/private/controls/ui/MENU1/blur/depth"                                 // float, writable
+	SimPrivateControlsUiMENU1Blur_3                                   string = "sim/private/controls/ui/MENU1/blur/3"                                     // float, writable
+	SimPrivateControlsUiMENU1Blur_2                                   string = "sim/private/controls/ui/MENU1/blur/2"                                     // float, writable
+	SimPrivateControlsUiMENU1Blur_1                                   string = "sim/private/controls/ui/MENU1/blur/1"                                     // float, writable
+	SimPrivateControlsUiMENU1Blur_0                                   string = "sim/private/controls/ui/MENU1/blur/0"                                     // float, writable
+	SimPrivateControlsUiUIBlur_shadow_expand                          string = "sim/private/controls/ui/UI/blur/shadow_expand"                            // float, writable
+	SimPrivateControlsUiUIBlur_shadow_sigma                           string = "sim/private/controls/ui/UI/blur/shadow_sigma"                             // float, writable
+	SimPrivateControlsUiUIBlur_shadow_alpha                           string = "sim/private/controls/ui/UI/blur/shadow_alpha"                             // float, writable
+	SimPrivateControlsUiUIBlur_shadow_offset                          string = "sim/private/controls/ui/UI/blur/shadow_offset"                            // float, writable
+	SimPrivateControlsUiUIBlur_top_a                                  string = "sim/private/controls/ui/UI/blur/top_a"                                    // float, writable
+	SimPrivateControlsUiUIBlur_top_b                                  string = "sim/private/controls/ui/UI/blur/top_b"                                    // float, writable
+	SimPrivateControlsUiUIBlur_top_g                                  string = "sim/private/controls/ui/UI/blur/top_g"                                    // float, writable
+	SimPrivateControlsUiUIBlur_top_r                                  string = "sim/private/controls/ui/UI/blur/top_r"                                    // float, writable
+	SimPrivateControlsUiUIBlur_saturation                             string = "sim/private/controls/ui/UI/blur/saturation"                               // float, writable
+	SimPrivateControlsUiUIBlur_contrast                               string = "sim/private/controls/ui/UI/blur/contrast"                                 // float, writable
+	SimPrivateControlsUiUIBlur_brightness                             string = "sim/private/controls/ui/UI/blur/brightness"                               // float, writable
+	SimPrivateControlsUiUIBlur_lod_base                               string = "sim/private/controls/ui/UI/blur/lod_base"                                 // float, writable
+	SimPrivateControlsUiUIBlur_depth                                  string = "sim/private/controls/ui/UI/blur/depth"                                    // float, writable
+	SimPrivateControlsUiUIBlur_3                                      string = "sim/private/controls/ui/UI/blur/3"                                        // float, writable
+	SimPrivateControlsUiUIBlur_2                                      string = "sim/private/controls/ui/UI/blur/2"                                        // float, writable
+	SimPrivateControlsUiUIBlur_1                                      string = "sim/private/controls/ui/UI/blur/1"                                        // float, writable
+	SimPrivateControlsUiUIBlur_0                                      string = "sim/private/controls/ui/UI/blur/0"                                        // float, writable
+	SimPrivateControlsAtc_show_menu_for_readbacks                     string = "sim/private/controls/atc/show_menu_for_readbacks"                         // float, writable
+	SimPrivateControlsAtc_switch_to_minimal_ui_secs                   string = "sim/private/controls/atc/switch_to_minimal_ui_secs"                       // float, writable
+	SimPrivateControlsAcfDebug_reload_config                          string = "sim/private/controls/acf/debug/reload_config"                             // float, writable
+	SimPrivateControlsPerf_kill_atc                                   string = "sim/private/controls/perf/kill_atc"                                       // float, writable
+	SimPrivateControlsMissions_no_ending                              string = "sim/private/controls/missions/no_ending"                                  // float, writable
+	SimPrivateControlsMap_boat_max_size_factor                        string = "sim/private/controls/map/boat_max_size_factor"                            // float, writable
+	SimPrivateControlsMap_boat_size_factor                            string = "sim/private/controls/map/boat_size_factor"                                // float, writable
+	SimPrivateControlsMap_wind_spacing                                string = "sim/private/controls/map/wind_spacing"                                    // float, writable
+	SimPrivateControlsMap_atc_bounds_owner_only                       string = "sim/private/controls/map/atc_bounds_owner_only"                           // float, writable
+	SimPrivateControlsMap_apt_cross_end                               string = "sim/private/controls/map/apt_cross_end"                                   // float, writable
+	SimPrivateControlsMap_apt_cross_start                             string = "sim/private/controls/map/apt_cross_start"                                 // float, writable
+	SimPrivateControlsMap_nav_max_size_m                              string = "sim/private/controls/map/nav_max_size_m"                                  // float, writable
+	SimPrivateControlsMap_fix_max_size_m                              string = "sim/private/controls/map/fix_max_size_m"                                  // float, writable
+	SimPrivateControlsMap_acf_power                                   string = "sim/private/controls/map/acf_power"                                       // float, writable
+	SimPrivateControlsMap_acf_size                                    string = "sim/private/controls/map/acf_size"                                        // float, writable
+	SimPrivateControlsDebugTextureBrowser_keep_textures_alive         string = "sim/private/controls/debug/texture_browser/keep_textures_alive"           // float, writable
+	SimPrivateControlsTextureBrowser_show_negative                    string = "sim/private/controls/texture_browser/show_negative"                       // float, writable
+	SimPrivateControlsTextureBrowser_show_nans                        string = "sim/private/controls/texture_browser/show_nans"                           // float, writable
+	SimPrivateControlsTextureBrowser_alpha_grid_size                  string = "sim/private/controls/texture_browser/alpha_grid_size"                     // float, writable
+	SimPrivateControlsUiButtonDropShadow_alpha                        string = "sim/private/controls/ui/button_drop_shadow/alpha"                         // float, writable
+	SimPrivateControlsWindowMgr_modal_opacity                         string = "sim/private/controls/window_mgr/modal_opacity"                            // float, writable
+	SimPrivateControlsMenus_close_menu_delay_seconds                  string = "sim/private/controls/menus/close_menu_delay_seconds"                      // float, writable
+	SimPrivateControlsCarrierTrap_pow                                 string = "sim/private/controls/carrier/trap/pow"                                    // float, writable
+	SimPrivateControlsCarrierTrap_dis                                 string = "sim/private/controls/carrier/trap/dis"                                    // float, writable
+	SimPrivateControlsPuff_terrain_dust_ratio                         string = "sim/private/controls/puff/terrain_dust_ratio"                             // float, writable
+	SimPrivateControlsPuff_terrain_spray_ratio                        string = "sim/private/controls/puff/terrain_spray_ratio"                            // float, writable
+	SimPrivateControlsHUD_data_sim                                    string = "sim/private/controls/HUD/data_sim"                                        // float, writable
+	SimPrivateControlsHUD_data_brt                                    string = "sim/private/controls/HUD/data_brt"                                        // float, writable
+	SimPrivateControlsHUD_rect_dim                                    string = "sim/private/controls/HUD/rect_dim"                                        // float, writable
+	SimPrivateControlsHUD_rect_brt                                    string = "sim/private/controls/HUD/rect_brt"                                        // float, writable
+	SimPrivateControlsAirbus_no_protections                           string = "sim/private/controls/airbus/no_protections"                               // float, writable
+	SimPrivateControlsProp_alpha_hi                                   string = "sim/private/controls/prop/alpha_hi"                                       // float, writable
+	SimPrivateControlsProp_alpha_lo                                   string = "sim/private/controls/prop/alpha_lo"                                       // float, writable
+	SimPrivateControlsProp_tacrad_for_alpha_hi                        string = "sim/private/controls/prop/tacrad_for_alpha_hi"                            // float, writable
+	SimPrivateControlsProp_tacrad_for_alpha_lo                        string = "sim/private/controls/prop/tacrad_for_alpha_lo"                            // float, writable
+	SimPrivateControlsProp_disc_rot_hi                                string = "sim/private/controls/prop/disc_rot_hi"                                    // float, writable
+	SimPrivateControlsProp_disc_rot_md                                string = "sim/private/controls/prop/disc_rot_md"                                    // float, writable
+	SimPrivateControlsProp_disc_rot_lo                                string = "sim/private/controls/prop/disc_rot_lo"                                    // float, writable
+	SimPrivateControlsProp_tacrad_for_rot_hi                          string = "sim/private/controls/prop/tacrad_for_rot_hi"                              // float, writable
+	SimPrivateControlsProp_tacrad_for_rot_md                          string = "sim/private/controls/prop/tacrad_for_rot_md"                              // float, writable
+	SimPrivateControlsProp_tacrad_for_rot_lo                          string = "sim/private/controls/prop/tacrad_for_rot_lo"                              // float, writable
+	SimPrivateControlsAiDebug_min_turnaround_time_secs                string = "sim/private/controls/ai/debug/min_turnaround_time_secs"                   // float, writable
+	SimPrivateControlsAiDebug_brake_hard                              string = "sim/private/controls/ai/debug/brake_hard"                                 // float, writable
+	SimPrivateControlsWingpuffs_puff_alph_rat                         string = "sim/private/controls/wingpuffs/puff_alph_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_hi_z_rat                         string = "sim/private/controls/wingpuffs/puff_hi_z_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_hi_y_rat                         string = "sim/private/controls/wingpuffs/puff_hi_y_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_hi_x_rat                         string = "sim/private/controls/wingpuffs/puff_hi_x_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_lo_z_rat                         string = "sim/private/controls/wingpuffs/puff_lo_z_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_lo_y_rat                         string = "sim/private/controls/wingpuffs/puff_lo_y_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_lo_x_rat                         string = "sim/private/controls/wingpuffs/puff_lo_x_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_size_rat                         string = "sim/private/controls/wingpuffs/puff_size_rat"                             // float, writable
+	SimPrivateControlsWingpuffs_puff_numb_rat                         string = "sim/private/controls/wingpuffs/puff_numb_rat"                             // float, writable
+	SimPrivateControlsChocks_min_version                              string = "sim/private/controls/chocks/min_version"                                  // float, writable
+	SimPrivateControlsPerf_kill_counters                              string = "sim/private/controls/perf/kill_counters"                                  // float, writable
+	SimPrivateControlsGfxManagedBuffers_max_movement_bytes            string = "sim/private/controls/gfx/managed_buffers/max_movement_bytes"              // float, writable
+	SimPrivateControlsGfxManagedBuffers_max_movement_count            string = "sim/private/controls/gfx/managed_buffers/max_movement_count"              // float, writable
+	SimPrivateControlsGfx_dump_queries                                string = "sim/private/controls/gfx/dump_queries"                                    // float, writable
+	SimPrivateControlsGfx_disable_async_compute                       string = "sim/private/controls/gfx/disable_async_compute"                           // float, writable
+	SimPrivateControlsEffects_test_fireworks                          string = "sim/private/controls/effects/test_fireworks"                              // float, writable
+	SimPrivateControlsWakes_pontoon_splash_mod                        string = "sim/private/controls/wakes/pontoon_splash_mod"                            // float, writable
+	SimPrivateControlsBoats_kill_draw_wakes                           string = "sim/private/controls/boats/kill_draw_wakes"                               // float, writable
+	SimPrivateControlsBoats_hide_all_objs                             string = "sim/private/controls/boats/hide_all_objs"                                 // float, writable
+	SimPrivateControlsBoats_tweak                                     string = "sim/private/controls/boats/tweak"                                         // float, writable
+	SimPrivateControlsBoats_boat_density                              string = "sim/private/controls/boats/boat_density"                                  // float, writable
+	SimPrivateControlsWater_wake_min_rat                              string = "sim/private/controls/water/wake_min_rat"                                  // float, writable
+	SimPrivateControlsWater_wake_cull                                 string = "sim/private/controls/water/wake_cull"                                     // float, writable
+	SimPrivateControlsWater_wake_displace_rat                         string = "sim/private/controls/water/wake_displace_rat"                             // float, writable
+	SimPrivateControlsPerf_kill_draped_hard_tris                      string = "sim/private/controls/perf/kill_draped_hard_tris"                          // float, writable
+	SimPrivateControlsDrape_lock_mesh_st                              string = "sim/private/controls/drape/lock_mesh_st"                                  // float, writable
+	SimPrivateControlsDrape_lock_mesh_n                               string = "sim/private/controls/drape/lock_mesh_n"                                   // float, writable
+	SimPrivateControlsDrape_lock_mesh_v                               string = "sim/private/controls/drape/lock_mesh_v"                                   // float, writable
+	SimPrivateControlsLights_lod_max_static_sp                        string = "sim/private/controls/lights/lod_max_static_sp"                            // float, writable
+	SimPrivateControlsLights_lod_max_static_bb                        string = "sim/private/controls/lights/lod_max_static_bb"                            // float, writable
+	SimPrivateControlsLights_lod_max_static_gnd                       string = "sim/private/controls/lights/lod_max_static_gnd"                           // float, writable
+	SimPrivateControlsLights_always_night_lights                      string = "sim/private/controls/lights/always_night_lights"                          // float, writable
+	SimPrivateControlsLight_random_percent                            string = "sim/private/controls/light/random_percent"                                // float, writable
+	SimPrivateControlsInstance_max_lod_ratio                          string = "sim/private/controls/instance/max_lod_ratio"                              // float, writable
+	SimPrivateControlsInstance_max_radius_ratio                       string = "sim/private/controls/instance/max_radius_ratio"                           // float, writable
+	SimPrivateControlsInstance_merge_vbos                             string = "sim/private/controls/instance/merge_vbos"                                 // float, writable
+	SimPrivateControlsInstance_kill_instance                          string = "sim/private/controls/instance/kill_instance"                              // float, writable
+	SimPrivateControlsInstance_instance_grid                          string = "sim/private/controls/instance/instance_grid"                              // float, writable
+	SimPrivateControlsDsf_max_y_tries                                 string = "sim/private/controls/dsf/max_y_tries"                                     // float, writable
+	SimPrivateControlsDsf_max_obj_y_err                               string = "sim/private/controls/dsf/max_obj_y_err"                                   // float, writable
+	SimPrivateControlsVrTchochke_aabb_slop_touch                      string = "sim/private/controls/vr/tchochke/aabb_slop_touch"                         // float, writable
+	SimPrivateControlsVrGrowlPos_z                                    string = "sim/private/controls/vr/growl_pos/z"                                      // float, writable
+	SimPrivateControlsVrGrowlPos_y                                    string = "sim/private/controls/vr/growl_pos/y"                                      // float, writable
+	SimPrivateControlsVrGrowlPos_x                                    string = "sim/private/controls/vr/growl_pos/x"                                      // float, writable
+	SimPrivateControlsVr_mouse_menu_fwd                               string = "sim/private/controls/vr/mouse_menu_fwd"                                   // float, writable
+	SimPrivateControlsVr_z_test                                       string = "sim/private/controls/vr/z_test"                                           // float, writable
+	SimPrivateControlsVr_mouse_size                                   string = "sim/private/controls/vr/mouse_size"                                       // float, writable
+	SimPrivateControlsVr_mouse_z_off_test                             string = "sim/private/controls/vr/mouse_z_off_test"                                 // float, writable
+	SimPrivateControlsVr_mouse_z_off_draw                             string = "sim/private/controls/vr/mouse_z_off_draw"                                 // float, writable
+	SimPrivateControlsVrMouse_max_z                                   string = "sim/private/controls/vr/mouse/max_z"                                      // float, writable
+	SimPrivateControlsVrDebug_show_virgin_manips                      string = "sim/private/controls/vr/debug/show_virgin_manips"                         // float, writable
+	SimPrivateControlsVr_thumbstick_nullzone                          string = "sim/private/controls/vr/thumbstick_nullzone"                              // float, writable
+	SimPrivateControlsVrDebug_reload_manips                           string = "sim/private/controls/vr/debug/reload_manips"                              // float, writable
+	SimPrivateControlsVrDebug_show_hotspots                           string = "sim/private/controls/vr/debug/show_hotspots"                              // float, writable
+	SimPrivateControlsVr_sitting_is_fwd                               string = "sim/private/controls/vr/sitting_is_fwd"                                   // float, writable
+	SimPrivateControlsVrDebug_knob_manip_damp                         string = "sim/private/controls/vr/debug/knob_manip_damp"                            // float, writable
+	SimPrivateControlsVrDebug_knob_manip_bias                         string = "sim/private/controls/vr/debug/knob_manip_bias"                            // float, writable
+	SimPrivateControlsVrDebug_log_missing_predicates                  string = "sim/private/controls/vr/debug/log_missing_predicates"                     // float, writable
+	SimPrivateControlsVrDebug_log_manipulators                        string = "sim/private/controls/vr/debug/log_manipulators"                           // float, writable
+	SimPrivateControlsDebug_log_2_printf                              string = "sim/private/controls/debug/log_2_printf"                                  // float, writable
+	SimPrivateControlsAtcTaxi_parking_turn_in_ratio                   string = "sim/private/controls/atc/taxi/parking_turn_in_ratio"                      // float, writable
+	SimPrivateControlsAtcDebug_vector_w3                              string = "sim/private/controls/atc/debug/vector_w3"                                 // float, writable
+	SimPrivateControlsAtcDebug_vector_w2                              string = "sim/private/controls/atc/debug/vector_w2"                                 // float, writable
+	SimPrivateControlsAtcDebug_vector_w1                              string = "sim/private/controls/atc/debug/vector_w1"                                 // float, writable
+	SimPrivateControlsAtcDebug_vector_tee                             string = "sim/private/controls/atc/debug/vector_tee"                                // float, writable
+	SimPrivateControlsAtcDebugDijkstra_show_node_cost                 string = "sim/private/controls/atc/debug/dijkstra/show_node_cost"                   // float, writable
+	SimPrivateControlsAtcDebugDijkstra_log                            string = "sim/private/controls/atc/debug/dijkstra/log"                              // float, writable
+	SimPrivateControlsIbl_filter_mip_limit                            string = "sim/private/controls/ibl/filter_mip_limit"                                // float, writable
+	SimPrivateControlsIbl_filter_specular                             string = "sim/private/controls/ibl/filter_specular"                                 // float, writable
+	SimPrivateControlsCubeMap_extra_samples                           string = "sim/private/controls/cube_map/extra samples"                              // float, writable
+	SimPrivateControlsShadow_extra_near                               string = "sim/private/controls/shadow/extra_near"                                   // float, writable
+	SimPrivateControlsShadowCsm_pad_near_ratio                        string = "sim/private/controls/shadow/csm/pad_near_ratio"                           // float, writable
+	SimPrivateControlsShadowCsm_pad_near_const                        string = "sim/private/controls/shadow/csm/pad_near_const"                           // float, writable
+	SimPrivateControlsPerf_kill_clip                                  string = "sim/private/controls/perf/kill_clip"                                      // float, writable
+	SimPrivateControlsExposure_speed_up                               string = "sim/private/controls/exposure/speed_up"                                   // float, writable
+	SimPrivateControlsExposure_speed_down                             string = "sim/private/controls/exposure/speed_down"                                 // float, writable
+	SimPrivateControlsToneMap_filter_nan                              string = "sim/private/controls/tone_map/filter_nan"                                 // float, writable
+	SimPrivateControlsXdl_always_use_vram                             string = "sim/private/controls/xdl/always_use_vram"                                 // float, writable
+	SimPrivateControlsVboStream_use_mbr                               string = "sim/private/controls/vbo_stream/use_mbr"                                  // float, writable
+	SimPrivateControlsSh_kill_below_horizon                           string = "sim/private/controls/sh/kill_below_horizon"                               // float, writable
+	SimPrivateControlsSh_debug                                        string = "sim/private/controls/sh/debug"                                            // float, writable
+	SimPrivateControlsSh_sample_count                                 string = "sim/private/controls/sh/sample_count"                                     // float, writable
+	SimPrivateControlsLine_occlusion_mode                             string = "sim/private/controls/line/occlusion_mode"                                 // float, writable
+	SimPrivateControlsTexPaging_size_fudge_factor                     string = "sim/private/controls/tex/paging/size_fudge_factor"                        // float, writable
+	SimPrivateControlsTexPaging_max_overdrive                         string = "sim/private/controls/tex/paging/max_overdrive"                            // float, writable
+	SimPrivateControlsTexPaging_downscale_cooldown                    string = "sim/private/controls/tex/paging/downscale_cooldown"                       // float, writable
+	SimPrivateControlsTexPaging_max_distance                          string = "sim/private/controls/tex/paging/max_distance"                             // float, writable
+	SimPrivateControlsTextures_good_minify                            string = "sim/private/controls/textures/good_minify"                                // float, writable
+	SimPrivateControlsTime_wait_workers                               string = "sim/private/controls/time/wait_workers"                                   // float, writable
+	SimPrivateControlsRunloop_time_per_frame_usec                     string = "sim/private/controls/runloop/time_per_frame_usec"                         // float, writable
+	SimPrivateControlsRunloop_tasks_per_frame                         string = "sim/private/controls/runloop/tasks_per_frame"                             // float, writable
+	SimPrivateControlsTess_scale                                      string = "sim/private/controls/tess/scale"                                          // float, writable
+	SimPrivateControlsCaps_use_3dwater                                string = "sim/private/controls/caps/use_3dwater"                                    // float, writable
+	SimPrivateControlsShadow_csm_split_interior                       string = "sim/private/controls/shadow/csm_split_interior"                           // float, writable
+	SimPrivateControlsShadow_csm_split_exterior                       string = "sim/private/controls/shadow/csm_split_exterior"                           // float, writable
+	SimPrivateControlsCaps_use_csm                                    string = "sim/private/controls/caps/use_csm"                                        // float, writable
+	SimPrivateControlsCaps_use_reflective_water                       string = "sim/private/controls/caps/use_reflective_water"                           // float, writable
+	SimPrivateControlsCaps_use_HDR                                    string = "sim/private/controls/caps/use_HDR"                                        // float, writable
+	SimPrivateControlsPowerlines_fade_distance                        string = "sim/private/controls/powerlines/fade_distance"                            // float, writable
+	SimPrivateControlsAtcTimingTakeoffCross_hold_short                string = "sim/private/controls/atc/timing/takeoff/cross/hold_short"                 // float, writable
+	SimPrivateControlsAtcTimingTakeoffCross_lined_up                  string = "sim/private/controls/atc/timing/takeoff/cross/lined_up"                   // float, writable
+	SimPrivateControlsAtcTimingTakeoffSrc_hold_short                  string = "sim/private/controls/atc/timing/takeoff/src/hold_short"                   // float, writable
+	SimPrivateControlsAtcTimingTakeoffSrs_lined_up                    string = "sim/private/controls/atc/timing/takeoff/srs/lined_up"                     // float, writable
+	SimPrivateControlsAtc_arrival_spacing_seconds                     string = "sim/private/controls/atc/arrival_spacing_seconds"                         // float, writable
+	SimPrivateControlsAtcDebug_reload_atc_voices                      string = "sim/private/controls/atc/debug/reload_atc_voices"                         // float, writable
+	SimPrivateControlsAtcDebugSpawner_force_arr                       string = "sim/private/controls/atc/debug/spawner/force_arr"                         // float, writable
+	SimPrivateControlsAtcDebug_log_spawn                              string = "sim/private/controls/atc/debug/log_spawn"                                 // float, writable
+	SimPrivateControlsAtcDebug_rwy_flow                               string = "sim/private/controls/atc/debug/rwy_flow"                                  // float, writable
+	SimPrivateControlsAtcDebug_rwy_selection                          string = "sim/private/controls/atc/debug/rwy_selection"                             // float, writable
+	SimPrivateControlsAtcRouting_runway_steep_angle                   string = "sim/private/controls/atc/routing/runway_steep_angle"                      // float, writable
+	SimPrivateControlsAtcRouting_runway_mult_steep_max                string = "sim/private/controls/atc/routing/runway_mult_steep_max"                   // float, writable
+	SimPrivateControlsAtcRouting_runway_mult_steep_min                string = "sim/private/controls/atc/routing/runway_mult_steep_min"                   // float, writable
+	SimPrivateControlsAtcRouting_runway_mult_shallow_max              string = "sim/private/controls/atc/routing/runway_mult_shallow_max"                 // float, writable
+	SimPrivateControlsAtcRouting_runway_mult_shallow_min              string = "sim/private/controls/atc/routing/runway_mult_shallow_min"                 // float, writable
+	SimPrivateControlsAtcRouting_runway_ldg_oppdir_weight             string = "sim/private/controls/atc/routing/runway_ldg_oppdir_weight"                // float, writable
+	SimPrivateControlsAtcRouting_runway_ldg_samedir_weight            string = "sim/private/controls/atc/routing/runway_ldg_samedir_weight"               // float, writable
+	SimPrivateControlsAtcRouting_runway_inactive_samedir_fee          string = "sim/private/controls/atc/routing/runway_inactive_samedir_fee"             // float, writable
+	SimPrivateControlsAtcRouting_runway_active_oppdir_fee             string = "sim/private/controls/atc/routing/runway_active_oppdir_fee"                // float, writable
+	SimPrivateControlsAtcRouting_runway_active_samedir_fee            string = "sim/private/controls/atc/routing/runway_active_samedir_fee"               // float, writable
+	SimPrivateControlsAtcRouting_taxiway_opposite_shared_corner       string = "sim/private/controls/atc/routing/taxiway_opposite_shared_corner"          // float, writable
+	SimPrivateControlsAtcRouting_taxiway_opposite_busy                string = "sim/private/controls/atc/routing/taxiway_opposite_busy"                   // float, writable
+	SimPrivateControlsAtcRouting_taxiway_steep_angle                  string = "sim/private/controls/atc/routing/taxiway_steep_angle"                     // float, writable
+	SimPrivateControlsAtcRouting_taxiway_mult_steep_max               string = "sim/private/controls/atc/routing/taxiway_mult_steep_max"                  // float, writable
+	SimPrivateControlsAtcRouting_taxiway_mult_steep_min               string = "sim/private/controls/atc/routing/taxiway_mult_steep_min"                  // float, writable
+	SimPrivateControlsAtcRouting_taxiway_mult_shallow_max             string = "sim/private/controls/atc/routing/taxiway_mult_shallow_max"                // float, writable
+	SimPrivateControlsAtcRouting_taxiway_mult_shallow_min             string = "sim/private/controls/atc/routing/taxiway_mult_shallow_min"                // float, writable
+	SimPrivateControlsAtcRouting_taxiway_oppdir_weight                string = "sim/private/controls/atc/routing/taxiway_oppdir_weight"                   // float, writable
+	SimPrivateControlsAtcRouting_taxiway_too_small_weight             string = "sim/private/controls/atc/routing/taxiway_too_small_weight"                // float, writable
+	SimPrivateControlsAtcRouting_taxiway_samedir_weight               string = "sim/private/controls/atc/routing/taxiway_samedir_weight"                  // float, writable
+	SimPrivateControlsAtcRouting_min_seg_len_for_steep_penalty        string = "sim/private/controls/atc/routing/min_seg_len_for_steep_penalty"           // float, writable
+	SimPrivateControlsAtcDebug_queues                                 string = "sim/private/controls/atc/debug/queues"                                    // float, writable
+	SimPrivateControlsAtcDebug_multi_rwy                              string = "sim/private/controls/atc/debug/multi_rwy"                                 // float, writable
+	SimPrivateControlsAtcDebug_network                                string = "sim/private/controls/atc/debug/network"                                   // float, writable
+	SimPrivateControlsAtcDebug_taxi                                   string = "sim/private/controls/atc/debug/taxi"                                      // float, writable
+	SimPrivateControlsAtcDebugTaxi_departure                          string = "sim/private/controls/atc/debug/taxi/departure"                            // float, writable
+	SimPrivateControlsAtcDebugTaxi_debug_to_runway                    string = "sim/private/controls/atc/debug/taxi/debug_to_runway"                      // float, writable
+	SimPrivateControlsAtcDebugTaxi_debug_to_gate                      string = "sim/private/controls/atc/debug/taxi/debug_to_gate"                        // float, writable
+	SimPrivateControlsAtcDebug_print_q                                string = "sim/private/controls/atc/debug/print_q"                                   // float, writable
+	SimPrivateControlsAtcDebug_airspace                               string = "sim/private/controls/atc/debug/airspace"                                  // float, writable
+	SimPrivateControlsAtcTxon_nag_timer_sec                           string = "sim/private/controls/atc/txon/nag_timer_sec"                              // float, writable
+	SimPrivateControlsAtcDebug_vectors                                string = "sim/private/controls/atc/debug/vectors"                                   // float, writable
+	SimPrivateControlsAtcRadio_chan_occupancy_limit                   string = "sim/private/controls/atc/radio/chan_occupancy_limit"                      // float, writable
+	SimPrivateControlsAtcDebug_show_ai_metrics                        string = "sim/private/controls/atc/debug/show_ai_metrics"                           // float, writable
+	SimPrivateControlsAtcDebug_acf_prediction_draw                    string = "sim/private/controls/atc/debug/acf_prediction_draw"                       // float, writable
+	SimPrivateControlsAtc_acf_prediction_time                         string = "sim/private/controls/atc/acf_prediction_time"                             // float, writable
+	SimPrivateControlsAtc_acf_trend_max_sample_count                  string = "sim/private/controls/atc/acf_trend_max_sample_count"                      // float, writable
+	SimPrivateControlsAtc_acf_trend_sampling_period                   string = "sim/private/controls/atc/acf_trend_sampling_period"                       // float, writable
+	SimPrivateControlsAtcDebug_acfCmds                                string = "sim/private/controls/atc/debug/acfCmds"                                   // float, writable
+	SimPrivateControlsAtcDebug_test_taxi_width                        string = "sim/private/controls/atc/debug/test_taxi_width"                           // float, writable
+	SimPrivateControlsAtc_record_ai_crumbs                            string = "sim/private/controls/atc/record_ai_crumbs"                                // float, writable
+	SimPrivateControlsAtcDebug_ai_one_per_frame                       string = "sim/private/controls/atc/debug/ai_one_per_frame"                          // float, writable
+	SimPrivateControlsAiDebug_ai_perf_fatal                           string = "sim/private/controls/ai/debug/ai_perf_fatal"                              // float, writable
+	SimPrivateControlsAiDebug_rate_approach                           string = "sim/private/controls/ai/debug/rate_approach"                              // float, writable
+	SimPrivateControlsAiDebug_rate_takeoffs                           string = "sim/private/controls/ai/debug/rate_takeoffs"                              // float, writable
+	SimPrivateControlsAiDebug_rate_landings                           string = "sim/private/controls/ai/debug/rate_landings"                              // float, writable
+	SimPrivateControlsSkyc_scale_light_2d                             string = "sim/private/controls/skyc/scale_light_2d"                                 // float, writable
+	SimPrivateControlsSkyc_sun_angle_cockpit                          string = "sim/private/controls/skyc/sun_angle_cockpit"                              // float, writable
+	SimPrivateControlsSkyc_sun_angle_aircraft                         string = "sim/private/controls/skyc/sun_angle_aircraft"                             // float, writable
+	SimPrivateControlsSkyc_sun_angle_airport                          string = "sim/private/controls/skyc/sun_angle_airport"                              // float, writable
+	SimPrivateControlsSkyc_sun_angle_cars                             string = "sim/private/controls/skyc/sun_angle_cars"                                 // float, writable
+	SimPrivateControlsSkyc_sun_angle_lights                           string = "sim/private/controls/skyc/sun_angle_lights"                               // float, writable
+	SimPrivateControlsSkyc_sun_angle_texture                          string = "sim/private/controls/skyc/sun_angle_texture"                              // float, writable
+	SimPrivateControlsSkyc_max_shadow_angle                           string = "sim/private/controls/skyc/max_shadow_angle"                               // float, writable
+	SimPrivateControlsSkyc_min_shadow_angle                           string = "sim/private/controls/skyc/min_shadow_angle"                               // float, writable
+	SimPrivateControlsSkyc_wxr_vis                                    string = "sim/private/controls/skyc/wxr_vis"                                        // float, writable
+	SimPrivateControlsSkyc_dsf_dis_conditions                         string = "sim/private/controls/skyc/dsf_dis_conditions"                             // float, writable
+	SimPrivateControlsSkyc_dsf_cutover_scale                          string = "sim/private/controls/skyc/dsf_cutover_scale"                              // float, writable
+	SimPrivateControlsSkyc_min_dsf_vis_ever                           string = "sim/private/controls/skyc/min_dsf_vis_ever"                               // float, writable
+	SimPrivateControlsSkyc_max_dsf_vis_ever                           string = "sim/private/controls/skyc/max_dsf_vis_ever"                               // float, writable
+	SimPrivateControlsSkyc_dsf_fade_ratio                             string = "sim/private/controls/skyc/dsf_fade_ratio"                                 // float, writable
+	SimPrivateControlsSkyc_near_clip_zoom_ratio                       string = "sim/private/controls/skyc/near_clip_zoom_ratio"                           // float, writable
+	SimPrivateControlsSkyc_plugin_forced_near_clip                    string = "sim/private/controls/skyc/plugin_forced_near_clip"                        // float, writable
+	SimPrivateControlsSkyc_near_clip_maximum                          string = "sim/private/controls/skyc/near_clip_maximum"                              // float, writable
+	SimPrivateControlsSkyc_near_clip_minimum                          string = "sim/private/controls/skyc/near_clip_minimum"                              // float, writable
+	SimPrivateControlsTerrain_fog_clip_scale                          string = "sim/private/controls/terrain/fog_clip_scale"                              // float, writable
+	SimPrivateControlsTerrain_fog_clip_pad                            string = "sim/private/controls/terrain/fog_clip_pad"                                // float, writable
+	SimPrivateControlsTerrain_min_clip                                string = "sim/private/controls/terrain/min_clip"                                    // float, writable
+	SimPrivateControlsHdr_attenuation_scale                           string = "sim/private/controls/hdr/attenuation_scale"                               // float, writable
+	SimPrivateControlsSkyc_fog_start_hi_alt                           string = "sim/private/controls/skyc/fog_start_hi_alt"                               // float, writable
+	SimPrivateControlsSkyc_fog_start_lo_alt                           string = "sim/private/controls/skyc/fog_start_lo_alt"                               // float, writable
+	SimPrivateControlsSkyc_shadow_offset_snowy                        string = "sim/private/controls/skyc/shadow_offset_snowy"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_strat                        string = "sim/private/controls/skyc/shadow_offset_strat"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_ocast                        string = "sim/private/controls/skyc/shadow_offset_ocast"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_orbit                        string = "sim/private/controls/skyc/shadow_offset_orbit"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_hialt                        string = "sim/private/controls/skyc/shadow_offset_hialt"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_mount                        string = "sim/private/controls/skyc/shadow_offset_mount"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_clean                        string = "sim/private/controls/skyc/shadow_offset_clean"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_hazy                         string = "sim/private/controls/skyc/shadow_offset_hazy"                             // float, writable
+	SimPrivateControlsSkyc_shadow_offset_foggy                        string = "sim/private/controls/skyc/shadow_offset_foggy"                            // float, writable
+	SimPrivateControlsSkyc_shadow_offset_sockd                        string = "sim/private/controls/skyc/shadow_offset_sockd"                            // float, writable
+	SimPrivateControlsSkyc_shadow_level_snowy                         string = "sim/private/controls/skyc/shadow_level_snowy"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_strat                         string = "sim/private/controls/skyc/shadow_level_strat"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_ocast                         string = "sim/private/controls/skyc/shadow_level_ocast"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_orbit                         string = "sim/private/controls/skyc/shadow_level_orbit"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_hialt                         string = "sim/private/controls/skyc/shadow_level_hialt"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_mount                         string = "sim/private/controls/skyc/shadow_level_mount"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_clean                         string = "sim/private/controls/skyc/shadow_level_clean"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_hazy                          string = "sim/private/controls/skyc/shadow_level_hazy"                              // float, writable
+	SimPrivateControlsSkyc_shadow_level_foggy                         string = "sim/private/controls/skyc/shadow_level_foggy"                             // float, writable
+	SimPrivateControlsSkyc_shadow_level_sockd                         string = "sim/private/controls/skyc/shadow_level_sockd"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_snowy                         string = "sim/private/controls/skyc/direct_ratio_snowy"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_strat                         string = "sim/private/controls/skyc/direct_ratio_strat"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_ocast                         string = "sim/private/controls/skyc/direct_ratio_ocast"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_orbit                         string = "sim/private/controls/skyc/direct_ratio_orbit"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_hialt                         string = "sim/private/controls/skyc/direct_ratio_hialt"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_mount                         string = "sim/private/controls/skyc/direct_ratio_mount"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_clean                         string = "sim/private/controls/skyc/direct_ratio_clean"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_hazy                          string = "sim/private/controls/skyc/direct_ratio_hazy"                              // float, writable
+	SimPrivateControlsSkyc_direct_ratio_foggy                         string = "sim/private/controls/skyc/direct_ratio_foggy"                             // float, writable
+	SimPrivateControlsSkyc_direct_ratio_sockd                         string = "sim/private/controls/skyc/direct_ratio_sockd"                             // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_snowy                        string = "sim/private/controls/skyc/ambient_ratio_snowy"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_strat                        string = "sim/private/controls/skyc/ambient_ratio_strat"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_ocast                        string = "sim/private/controls/skyc/ambient_ratio_ocast"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_orbit                        string = "sim/private/controls/skyc/ambient_ratio_orbit"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_hialt                        string = "sim/private/controls/skyc/ambient_ratio_hialt"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_mount                        string = "sim/private/controls/skyc/ambient_ratio_mount"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_clean                        string = "sim/private/controls/skyc/ambient_ratio_clean"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_hazy                         string = "sim/private/controls/skyc/ambient_ratio_hazy"                             // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_foggy                        string = "sim/private/controls/skyc/ambient_ratio_foggy"                            // float, writable
+	SimPrivateControlsSkyc_ambient_ratio_sockd                        string = "sim/private/controls/skyc/ambient_ratio_sockd"                            // float, writable
+	SimPrivateControlsPanel_auto_atten_stops                          string = "sim/private/controls/panel/auto_atten_stops"                              // float, writable
+	SimPrivateControlsScattering_fog_mix                              string = "sim/private/controls/scattering/fog_mix"                                  // float, writable
+	SimPrivateControlsAtmo_fog_albedo                                 string = "sim/private/controls/atmo/fog_albedo"                                     // float, writable
+	SimPrivateControlsScattering_vis_cutoff_ratio                     string = "sim/private/controls/scattering/vis_cutoff_ratio"                         // float, writable
+	SimPrivateControlsScattering_override_turbidity_t                 string = "sim/private/controls/scattering/override_turbidity_t"                     // float, writable
+	SimPrivateControlsScattering_override_visibility_m                string = "sim/private/controls/scattering/override_visibility_m"                    // float, writable
+	SimPrivateControlsDout_hide_in_screenshots                        string = "sim/private/controls/dout/hide_in_screenshots"                            // float, writable
+	SimPrivateControlsDebug_show_walls                                string = "sim/private/controls/debug/show_walls"                                    // float, writable
+	SimPrivateControlsDebug_show_nearest_manip                        string = "sim/private/controls/debug/show_nearest_manip"                            // float, writable
+	SimPrivateControlsWeather_temp_profile_graph                      string = "sim/private/controls/weather/temp_profile_graph"                          // float, writable
+	SimPrivateControlsClouds_kill_onscreen_render                     string = "sim/private/controls/clouds/kill_onscreen_render"                         // float, writable
+	SimPrivateControlsClouds_kill_offscreen_blit                      string = "sim/private/controls/clouds/kill_offscreen_blit"                          // float, writable
+	SimPrivateControlsNightvision_gain                                string = "sim/private/controls/nightvision/gain"                                    // float, writable
+	SimPrivateControlsNightvision_static_alpha                        string = "sim/private/controls/nightvision/static_alpha"                            // float, writable
+	SimPrivateControlsPlanes_cull_small_pix                           string = "sim/private/controls/planes/cull_small_pix"                               // float, writable
+	SimPrivateControlsPerf_kill_click_3d                              string = "sim/private/controls/perf/kill_click_3d"                                  // float, writable
+	SimPrivateControlsPerf_kill_cockpit_objects                       string = "sim/private/controls/perf/kill_cockpit_objects"                           // float, writable
+	SimPrivateControlsPerf_kill_planes                                string = "sim/private/controls/perf/kill_planes"                                    // float, writable
+	SimPrivateControlsScattering_cloud_shadows                        string = "sim/private/controls/scattering/cloud_shadows"                            // float, writable
+	SimPrivateControlsScattering_sky_view_max_distance                string = "sim/private/controls/scattering/sky_view_max_distance"                    // float, writable
+	SimPrivateControlsScattering_sky_view_max_samples                 string = "sim/private/controls/scattering/sky_view_max_samples"                     // float, writable
+	SimPrivateControlsScattering_sky_view_min_samples                 string = "sim/private/controls/scattering/sky_view_min_samples"                     // float, writable
+	SimPrivateControlsScattering_sky_view_cache_angle                 string = "sim/private/controls/scattering/sky_view_cache_angle"                     // float, writable
+	SimPrivateControlsScattering_sky_view_cache_dist                  string = "sim/private/controls/scattering/sky_view_cache_dist"                      // float, writable
+	SimPrivateControlsScattering_sky_dome_max_distance                string = "sim/private/controls/scattering/sky_dome_max_distance"                    // float, writable
+	SimPrivateControlsScattering_sky_dome_max_angle                   string = "sim/private/controls/scattering/sky_dome_max_angle"                       // float, writable
+	SimPrivateControlsScattering_sky_dome_alpha                       string = "sim/private/controls/scattering/sky_dome_alpha"                           // float, writable
+	SimPrivateControlsScattering_live_sky_view                        string = "sim/private/controls/scattering/live_sky_view"                            // float, writable
+	SimPrivateControlsScattering_cache_depth_max_ele                  string = "sim/private/controls/scattering/cache_depth_max_ele"                      // float, writable
+	SimPrivateControlsScattering_cache_depth_max                      string = "sim/private/controls/scattering/cache_depth_max"                          // float, writable
+	SimPrivateControlsScattering_cache_depth_min                      string = "sim/private/controls/scattering/cache_depth_min"                          // float, writable
+	SimPrivateControlsScattering_cache_depth                          string = "sim/private/controls/scattering/cache_depth"                              // float, writable
+	SimPrivateControlsScattering_cache_height                         string = "sim/private/controls/scattering/cache_height"                             // float, writable
+	SimPrivateControlsScattering_cache_width                          string = "sim/private/controls/scattering/cache_width"                              // float, writable
+	SimPrivateControlsScattering_cache_distance_max                   string = "sim/private/controls/scattering/cache_distance_max"                       // float, writable
+	SimPrivateControlsScattering_cache_distance_min                   string = "sim/private/controls/scattering/cache_distance_min"                       // float, writable
+	SimPrivateControlsScattering_max_samples                          string = "sim/private/controls/scattering/max_samples"                              // float, writable
+	SimPrivateControlsScattering_min_samples                          string = "sim/private/controls/scattering/min_samples"                              // float, writable
+	SimPrivateControlsScattering_samples_per_km                       string = "sim/private/controls/scattering/samples_per_km"                           // float, writable
+	SimPrivateControlsScattering_override_depth                       string = "sim/private/controls/scattering/override_depth"                           // float, writable
+	SimPrivateControlsScattering_override_froxel_far                  string = "sim/private/controls/scattering/override_froxel_far"                      // float, writable
+	SimPrivateControlsScattering_override_froxel_near                 string = "sim/private/controls/scattering/override_froxel_near"                     // float, writable
+	SimPrivateControlsScattering_ambient_elevation                    string = "sim/private/controls/scattering/ambient_elevation"                        // float, writable
+	SimPrivateControlsScattering_mode                                 string = "sim/private/controls/scattering/mode"                                     // float, writable
+	SimPrivateControlsScattering_pass                                 string = "sim/private/controls/scattering/pass"                                     // float, writable
+	SimPrivateControlsScattering_async_compute                        string = "sim/private/controls/scattering/async_compute"                            // float, writable
+	SimPrivateControlsScattering_live                                 string = "sim/private/controls/scattering/live"                                     // float, writable
+	SimPrivateControlsScattering_g_cornette_shanks                    string = "sim/private/controls/scattering/g_cornette_shanks"                        // float, writable
+	SimPrivateControlsScattering_limit_phase_func                     string = "sim/private/controls/scattering/limit_phase_func"                         // float, writable
+	SimPrivateControlsScattering_mie_absorption_exponent              string = "sim/private/controls/scattering/mie_absorption_exponent"                  // float, writable
+	SimPrivateControlsScattering_mie_scattering_exponent              string = "sim/private/controls/scattering/mie_scattering_exponent"                  // float, writable
+	SimPrivateControlsScattering_mie_albedo                           string = "sim/private/controls/scattering/mie_albedo"                               // float, writable
+	SimPrivateControlsScattering_mie_rat                              string = "sim/private/controls/scattering/mie_rat"                                  // float, writable
+	SimPrivateControlsScattering_multi_rat                            string = "sim/private/controls/scattering/multi_rat"                                // float, writable
+	SimPrivateControlsScattering_single_rat                           string = "sim/private/controls/scattering/single_rat"                               // float, writable
+	SimPrivateControlsScattering_earth_albedo                         string = "sim/private/controls/scattering/earth_albedo"                             // float, writable
+	SimPrivateControlsScattering_hack_cloud_shadows                   string = "sim/private/controls/scattering/hack_cloud_shadows"                       // float, writable
+	SimPrivateControlsReno_use_detail_textures                        string = "sim/private/controls/reno/use_detail_textures"                            // int, writable
+	SimPrivateControlsReno_use_bump_maps                              string = "sim/private/controls/reno/use_bump_maps"                                  // int, writable
+	SimPrivateControlsReno_draw_fft_water                             string = "sim/private/controls/reno/draw_fft_water"                                 // int, writable
+	SimPrivateControlsReno_draw_water3d                               string = "sim/private/controls/reno/draw_water3d"                                   // int, writable
+	SimPrivateControlsReno_draw_HDR                                   string = "sim/private/controls/reno/draw_HDR"                                       // int, writable
+	SimPrivateControlsReno_draw_volume_fog01                          string = "sim/private/controls/reno/draw_volume_fog01"                              // int, writable
+	SimPrivateControlsReno_default_view                               string = "sim/private/controls/reno/default_view"                                   // int, writable
+	SimPrivateControlsReno_comp_texes                                 string = "sim/private/controls/reno/comp_texes"                                     // int, writable
+	SimPrivateControlsReno_rcas_rat                                   string = "sim/private/controls/reno/rcas_rat"                                       // float, writable
+	SimPrivateControlsReno_aniso_filter                               string = "sim/private/controls/reno/aniso_filter"                                   // int, writable
+	SimPrivateControlsReno_draw_gload                                 string = "sim/private/controls/reno/draw_gload"                                     // int, writable
+	SimPrivateControlsReno_draw_view_ind                              string = "sim/private/controls/reno/draw_view_ind"                                  // int, writable
+	SimPrivateControlsReno_draw_cars_05                               string = "sim/private/controls/reno/draw_cars_05"                                   // int, writable
+	SimPrivateControlsReno_draw_detail_apt_03                         string = "sim/private/controls/reno/draw_detail_apt_03"                             // int, writable
+	SimPrivateControlsReno_draw_vecs_03                               string = "sim/private/controls/reno/draw_vecs_03"                                   // int, writable
+	SimPrivateControlsReno_draw_objs_06                               string = "sim/private/controls/reno/draw_objs_06"                                   // int, writable
+	SimPrivateControlsReno_LOD_bias_rat                               string = "sim/private/controls/reno/LOD_bias_rat"                                   // float, writable
+	SimPrivateControlsReno_tex_res                                    string = "sim/private/controls/reno/tex_res"                                        // int, writable
+	SimPrivateControlsRain_streak_threshold                           string = "sim/private/controls/rain/streak_threshold"                               // float, writable
+	SimPrivateControlsRain_spawn_adjust                               string = "sim/private/controls/rain/spawn_adjust"                                   // float, writable
+	SimPrivateControlsRain_dynamic_drag                               string = "sim/private/controls/rain/dynamic_drag"                                   // float, writable
+	SimPrivateControlsRain_friction_dynamic                           string = "sim/private/controls/rain/friction_dynamic"                               // float, writable
+	SimPrivateControlsRain_friction_static                            string = "sim/private/controls/rain/friction_static"                                // float, writable
+	SimPrivateControlsRain_max_force                                  string = "sim/private/controls/rain/max_force"                                      // float, writable
+	SimPrivateControlsRain_intensity_scale                            string = "sim/private/controls/rain/intensity_scale"                                // float, writable
+	SimPrivateControlsRain_intensity_power                            string = "sim/private/controls/rain/intensity_power"                                // float, writable
+	SimPrivateControlsRain_acceleration_factor                        string = "sim/private/controls/rain/acceleration_factor"                            // float, writable
+	SimPrivateControlsRain_force_factor                               string = "sim/private/controls/rain/force_factor"                                   // float, writable
+	SimPrivateControlsRain_ice_legacy_end_rate                        string = "sim/private/controls/rain/ice_legacy_end_rate"                            // float, writable
+	SimPrivateControlsRain_ice_legacy_end_c                           string = "sim/private/controls/rain/ice_legacy_end_c"                               // float, writable
+	SimPrivateControlsRain_ice_legacy_start_rate                      string = "sim/private/controls/rain/ice_legacy_start_rate"                          // float, writable
+	SimPrivateControlsRain_ice_legacy_start_c                         string = "sim/private/controls/rain/ice_legacy_start_c"                             // float, writable
+	SimPrivateControlsRainControl_icing_edge_y                        string = "sim/private/controls/rain/control/icing_edge_y"                           // float, writable
+	SimPrivateControlsRainControl_icing_edge_x                        string = "sim/private/controls/rain/control/icing_edge_x"                           // float, writable
+	SimPrivateControlsRainControl_ice_ramp                            string = "sim/private/controls/rain/control/ice_ramp"                               // float, writable
+	SimPrivateControlsRainControl_ice_tint_scale                      string = "sim/private/controls/rain/control/ice_tint_scale"                         // float, writable
+	SimPrivateControlsRainControl_ice_normal_limit                    string = "sim/private/controls/rain/control/ice_normal_limit"                       // float, writable
+	SimPrivateControlsRainControl_ice_normal_scale                    string = "sim/private/controls/rain/control/ice_normal_scale"                       // float, writable
+	SimPrivateControlsRain_bump_scale                                 string = "sim/private/controls/rain/bump_scale"                                     // float, writable
+	SimPrivateControlsRain_history_rate                               string = "sim/private/controls/rain/history_rate"                                   // float, writable
+	SimPrivateControlsRain_scale                                      string = "sim/private/controls/rain/scale"                                          // float, writable
+	SimPrivateControlsOldRain_hail_alpha                              string = "sim/private/controls/old_rain/hail_alpha"                                 // float, writable
+	SimPrivateControlsOldRain_snow_alpha                              string = "sim/private/controls/old_rain/snow_alpha"                                 // float, writable
+	SimPrivateControlsOldRain_rain_alpha                              string = "sim/private/controls/old_rain/rain_alpha"                                 // float, writable
+	SimPrivateControlsOldRain_hail_col                                string = "sim/private/controls/old_rain/hail_col"                                   // float, writable
+	SimPrivateControlsOldRain_snow_col                                string = "sim/private/controls/old_rain/snow_col"                                   // float, writable
+	SimPrivateControlsOldRain_rain_col                                string = "sim/private/controls/old_rain/rain_col"                                   // float, writable
+	SimPrivateControlsLightning_threshold                             string = "sim/private/controls/lightning/threshold"                                 // float, writable
+	SimPrivateControlsLightning_brightness                            string = "sim/private/controls/lightning/brightness"                                // float, writable
+	SimPrivateControlsLightning_strike_plane                          string = "sim/private/controls/lightning/strike_plane"                              // float, writable
+	SimPrivateControlsRain_kill_3d_rain                               string = "sim/private/controls/rain/kill_3d_rain"                                   // float, writable
+	SimPrivateControlsPhotometric_light_storage_scale                 string = "sim/private/controls/photometric/light_storage_scale"                     // float, writable
+	SimPrivateControlsLighting_E_moon_lx                              string = "sim/private/controls/lighting/E_moon_lx"                                  // float, writable
+	SimPrivateControlsLighting_E_sun_lx                               string = "sim/private/controls/lighting/E_sun_lx"                                   // float, writable
+	SimPrivateControlsDome_debug_celestial                            string = "sim/private/controls/dome/debug_celestial"                                // float, writable
+	SimPrivateControlsDebug_show_moon                                 string = "sim/private/controls/debug/show_moon"                                     // float, writable
+	SimPrivateControlsPerf_kill_moon                                  string = "sim/private/controls/perf/kill_moon"                                      // float, writable
+	SimPrivateControlsSun_glare_falloff_min                           string = "sim/private/controls/sun/glare_falloff_min"                               // float, writable
+	SimPrivateControlsSun_glare_falloff_max                           string = "sim/private/controls/sun/glare_falloff_max"                               // float, writable
+	SimPrivateControlsSun_max_illuminance                             string = "sim/private/controls/sun/max_illuminance"                                 // float, writable
+	SimPrivateControlsSun_angular_diameter                            string = "sim/private/controls/sun/angular_diameter"                                // float, writable
+	SimPrivateControlsMoon_angular_diameter                           string = "sim/private/controls/moon/angular_diameter"                               // float, writable
+	SimPrivateControlsMoon_nits                                       string = "sim/private/controls/moon/nits"                                           // float, writable
+	SimPrivateControlsStars_gain_photometric                          string = "sim/private/controls/stars/gain_photometric"                              // float, writable
+	SimPrivateControlsHackPetrWxrControl_snow                         string = "sim/private/controls/hack/petr/wxr/control/snow"                          // float, writable
+	SimPrivateControlsHackPetrWxrControl_ice                          string = "sim/private/controls/hack/petr/wxr/control/ice"                           // float, writable
+	SimPrivateControlsHackPetrWxrControl_puddles                      string = "sim/private/controls/hack/petr/wxr/control/puddles"                       // float, writable
+	SimPrivateControlsHackPetrWxrControl_rain                         string = "sim/private/controls/hack/petr/wxr/control/rain"                          // float, writable
+	SimPrivateControlsHackPetrWxr_force_set                           string = "sim/private/controls/hack/petr/wxr/force_set"                             // float, writable
+	SimPrivateControlsHackPetrWxr_control                             string = "sim/private/controls/hack/petr/wxr/control"                               // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_out_4                        string = "sim/private/controls/hack/petr/wxr/kf/snow_out_4"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_in_4                         string = "sim/private/controls/hack/petr/wxr/kf/snow_in_4"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_out_3                        string = "sim/private/controls/hack/petr/wxr/kf/snow_out_3"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_in_3                         string = "sim/private/controls/hack/petr/wxr/kf/snow_in_3"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_out_2                        string = "sim/private/controls/hack/petr/wxr/kf/snow_out_2"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_in_2                         string = "sim/private/controls/hack/petr/wxr/kf/snow_in_2"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_out_1                        string = "sim/private/controls/hack/petr/wxr/kf/snow_out_1"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_in_1                         string = "sim/private/controls/hack/petr/wxr/kf/snow_in_1"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_out_0                        string = "sim/private/controls/hack/petr/wxr/kf/snow_out_0"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_snow_in_0                         string = "sim/private/controls/hack/petr/wxr/kf/snow_in_0"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_out_4                         string = "sim/private/controls/hack/petr/wxr/kf/ice_out_4"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_in_4                          string = "sim/private/controls/hack/petr/wxr/kf/ice_in_4"                           // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_out_3                         string = "sim/private/controls/hack/petr/wxr/kf/ice_out_3"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_in_3                          string = "sim/private/controls/hack/petr/wxr/kf/ice_in_3"                           // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_out_2                         string = "sim/private/controls/hack/petr/wxr/kf/ice_out_2"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_in_2                          string = "sim/private/controls/hack/petr/wxr/kf/ice_in_2"                           // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_out_1                         string = "sim/private/controls/hack/petr/wxr/kf/ice_out_1"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_in_1                          string = "sim/private/controls/hack/petr/wxr/kf/ice_in_1"                           // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_out_0                         string = "sim/private/controls/hack/petr/wxr/kf/ice_out_0"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_ice_in_0                          string = "sim/private/controls/hack/petr/wxr/kf/ice_in_0"                           // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_out_4                     string = "sim/private/controls/hack/petr/wxr/kf/puddles_out_4"                      // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_in_4                      string = "sim/private/controls/hack/petr/wxr/kf/puddles_in_4"                       // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_out_3                     string = "sim/private/controls/hack/petr/wxr/kf/puddles_out_3"                      // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_in_3                      string = "sim/private/controls/hack/petr/wxr/kf/puddles_in_3"                       // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_out_2                     string = "sim/private/controls/hack/petr/wxr/kf/puddles_out_2"                      // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_in_2                      string = "sim/private/controls/hack/petr/wxr/kf/puddles_in_2"                       // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_out_1                     string = "sim/private/controls/hack/petr/wxr/kf/puddles_out_1"                      // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_in_1                      string = "sim/private/controls/hack/petr/wxr/kf/puddles_in_1"                       // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_out_0                     string = "sim/private/controls/hack/petr/wxr/kf/puddles_out_0"                      // float, writable
+	SimPrivateControlsHackPetrWxrKf_puddles_in_0                      string = "sim/private/controls/hack/petr/wxr/kf/puddles_in_0"                       // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_out_4                        string = "sim/private/controls/hack/petr/wxr/kf/rain_out_4"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_in_4                         string = "sim/private/controls/hack/petr/wxr/kf/rain_in_4"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_out_3                        string = "sim/private/controls/hack/petr/wxr/kf/rain_out_3"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_in_3                         string = "sim/private/controls/hack/petr/wxr/kf/rain_in_3"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_out_2                        string = "sim/private/controls/hack/petr/wxr/kf/rain_out_2"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_in_2                         string = "sim/private/controls/hack/petr/wxr/kf/rain_in_2"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_out_1                        string = "sim/private/controls/hack/petr/wxr/kf/rain_out_1"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_in_1                         string = "sim/private/controls/hack/petr/wxr/kf/rain_in_1"                          // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_out_0                        string = "sim/private/controls/hack/petr/wxr/kf/rain_out_0"                         // float, writable
+	SimPrivateControlsHackPetrWxrKf_rain_in_0                         string = "sim/private/controls/hack/petr/wxr/kf/rain_in_0"                          // float, writable
+	SimPrivateControlsTwxrIce_decal2_a                                string = "sim/private/controls/twxr/ice/decal2_a"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal2_b                                string = "sim/private/controls/twxr/ice/decal2_b"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal2_g                                string = "sim/private/controls/twxr/ice/decal2_g"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal2_r                                string = "sim/private/controls/twxr/ice/decal2_r"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal2_k                                string = "sim/private/controls/twxr/ice/decal2_k"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal1_a                                string = "sim/private/controls/twxr/ice/decal1_a"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal1_b                                string = "sim/private/controls/twxr/ice/decal1_b"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal1_g                                string = "sim/private/controls/twxr/ice/decal1_g"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal1_r                                string = "sim/private/controls/twxr/ice/decal1_r"                                   // float, writable
+	SimPrivateControlsTwxrIce_decal1_k                                string = "sim/private/controls/twxr/ice/decal1_k"                                   // float, writable
+	SimPrivateControlsTwxrIce_scale_decal                             string = "sim/private/controls/twxr/ice/scale_decal"                                // float, writable
+	SimPrivateControlsTwxrIce_scale_normal                            string = "sim/private/controls/twxr/ice/scale_normal"                               // float, writable
+	SimPrivateControlsTwxrIce_scale_albedo                            string = "sim/private/controls/twxr/ice/scale_albedo"                               // float, writable
+	SimPrivateControlsTwxrSnow_decal2_a                               string = "sim/private/controls/twxr/snow/decal2_a"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal2_b                               string = "sim/private/controls/twxr/snow/decal2_b"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal2_g                               string = "sim/private/controls/twxr/snow/decal2_g"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal2_r                               string = "sim/private/controls/twxr/snow/decal2_r"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal2_k                               string = "sim/private/controls/twxr/snow/decal2_k"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal1_a                               string = "sim/private/controls/twxr/snow/decal1_a"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal1_b                               string = "sim/private/controls/twxr/snow/decal1_b"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal1_g                               string = "sim/private/controls/twxr/snow/decal1_g"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal1_r                               string = "sim/private/controls/twxr/snow/decal1_r"                                  // float, writable
+	SimPrivateControlsTwxrSnow_decal1_k                               string = "sim/private/controls/twxr/snow/decal1_k"                                  // float, writable
+	SimPrivateControlsTwxrSnow_scale_decal                            string = "sim/private/controls/twxr/snow/scale_decal"                               // float, writable
+	SimPrivateControlsTwxrSnow_scale_normal                           string = "sim/private/controls/twxr/snow/scale_normal"                              // float, writable
+	SimPrivateControlsTwxrSnow_scale_albedo                           string = "sim/private/controls/twxr/snow/scale_albedo"                              // float, writable
+	SimPrivateControlsTwxrSnow_noise_depth                            string = "sim/private/controls/twxr/snow/noise_depth"                               // float, writable
+	SimPrivateControlsTwxrSnow_slope_max                              string = "sim/private/controls/twxr/snow/slope_max"                                 // float, writable
+	SimPrivateControlsTwxrSnow_slope_min                              string = "sim/private/controls/twxr/snow/slope_min"                                 // float, writable
+	SimPrivateControlsTwxr_snow_area_width                            string = "sim/private/controls/twxr/snow_area_width"                                // float, writable
+	SimPrivateControlsTwxr_snow_area_scale                            string = "sim/private/controls/twxr/snow_area_scale"                                // float, writable
+	SimPrivateControlsTwxr_ice_area_width                             string = "sim/private/controls/twxr/ice_area_width"                                 // float, writable
+	SimPrivateControlsTwxr_ice_area_scale                             string = "sim/private/controls/twxr/ice_area_scale"                                 // float, writable
+	SimPrivateControlsTwxr_rain_darkening_end                         string = "sim/private/controls/twxr/rain_darkening_end"                             // float, writable
+	SimPrivateControlsTwxr_rain_darkening_start                       string = "sim/private/controls/twxr/rain_darkening_start"                           // float, writable
+	SimPrivateControlsTwxr_rain_noise_modifier                        string = "sim/private/controls/twxr/rain_noise_modifier"                            // float, writable
+	SimPrivateControlsTwxr_rain_darken_gloss_rat                      string = "sim/private/controls/twxr/rain_darken_gloss_rat"                          // float, writable
+	SimPrivateControlsTwxr_rain_darken_albedo_rat                     string = "sim/private/controls/twxr/rain_darken_albedo_rat"                         // float, writable
+	SimPrivateControlsTwxr_rain_darkening_roughness_end               string = "sim/private/controls/twxr/rain_darkening_roughness_end"                   // float, writable
+	SimPrivateControlsTwxr_rain_darkening_roughness_start             string = "sim/private/controls/twxr/rain_darkening_roughness_start"                 // float, writable
+	SimPrivateControlsTwxr_rain_darkening_roughness_multiplier_dark   string = "sim/private/controls/twxr/rain_darkening_roughness_multiplier_dark"       // float, writable
+	SimPrivateControlsTwxr_rain_darkening_roughness_multiplier_light  string = "sim/private/controls/twxr/rain_darkening_roughness_multiplier_light"      // float, writable
+	SimPrivateControlsTwxr_rain_darkening_strength                    string = "sim/private/controls/twxr/rain_darkening_strength"                        // float, writable
+	SimPrivateControlsTwxr_rain_darkening_scale                       string = "sim/private/controls/twxr/rain_darkening_scale"                           // float, writable
+	SimPrivateControlsTwxr_rain_area_width                            string = "sim/private/controls/twxr/rain_area_width"                                // float, writable
+	SimPrivateControlsTwxr_rain_area_scale                            string = "sim/private/controls/twxr/rain_area_scale"                                // float, writable
+	SimPrivateControlsTwxr_override                                   string = "sim/private/controls/twxr/override"                                       // float, writable
+	SimPrivateControlsTwxr_debug_channel                              string = "sim/private/controls/twxr/debug_channel"                                  // float, writable
+	SimPrivateControlsTwxr_debug_mode                                 string = "sim/private/controls/twxr/debug_mode"                                     // float, writable
+	SimPrivateControlsWxr_ice_now                                     string = "sim/private/controls/wxr/ice_now"                                         // float, writable
+	SimPrivateControlsWxr_snow_now                                    string = "sim/private/controls/wxr/snow_now"                                        // float, writable
+	SimPrivateControlsWxr_puddles_now                                 string = "sim/private/controls/wxr/puddles_now"                                     // float, writable
+	SimPrivateControlsWxr_rain_now                                    string = "sim/private/controls/wxr/rain_now"                                        // float, writable
+	SimPrivateControlsDroplets_scale                                  string = "sim/private/controls/droplets/scale"                                      // float, writable
+	SimPrivateControlsColorManagementSdr_output_gamma                 string = "sim/private/controls/color_management/sdr/output_gamma"                   // float, writable
+	SimPrivateControlsColorManagementSdr_output_gain                  string = "sim/private/controls/color_management/sdr/output_gain"                    // float, writable
+	SimPrivateControlsColorCorrection_ratio                           string = "sim/private/controls/color_correction/ratio"                              // float, writable
+	SimPrivateControlsColorManagementSdr_saturation                   string = "sim/private/controls/color_management/sdr/saturation"                     // float, writable
+	SimPrivateControlsColorManagementSdr_power_blue                   string = "sim/private/controls/color_management/sdr/power_blue"                     // float, writable
+	SimPrivateControlsColorManagementSdr_power_green                  string = "sim/private/controls/color_management/sdr/power_green"                    // float, writable
+	SimPrivateControlsColorManagementSdr_power_red                    string = "sim/private/controls/color_management/sdr/power_red"                      // float, writable
+	SimPrivateControlsColorManagementSdr_offset_blue                  string = "sim/private/controls/color_management/sdr/offset_blue"                    // float, writable
+	SimPrivateControlsColorManagementSdr_offset_green                 string = "sim/private/controls/color_management/sdr/offset_green"                   // float, writable
+	SimPrivateControlsColorManagementSdr_offset_red                   string = "sim/private/controls/color_management/sdr/offset_red"                     // float, writable
+	SimPrivateControlsColorManagementSdr_slope_blue                   string = "sim/private/controls/color_management/sdr/slope_blue"                     // float, writable
+	SimPrivateControlsColorManagementSdr_slope_green                  string = "sim/private/controls/color_management/sdr/slope_green"                    // float, writable
+	SimPrivateControlsColorManagementSdr_slope_red                    string = "sim/private/controls/color_management/sdr/slope_red"                      // float, writable
+	SimPrivateControlsColorManagement_abney_correction                string = "sim/private/controls/color_management/abney_correction"                   // float, writable
+	SimPrivateControlsColorManagement_compression                     string = "sim/private/controls/color_management/compression"                        // float, writable
+	SimPrivateControlsTonemap_mode                                    string = "sim/private/controls/tonemap/mode"                                        // float, writable
+	SimPrivateControlsPhotometric_speed                               string = "sim/private/controls/photometric/speed"                                   // float, writable
+	SimPrivateControlsPhotometric_K                                   string = "sim/private/controls/photometric/K"                                       // float, writable
+	SimPrivateControlsPhotometric_ev100_bias                          string = "sim/private/controls/photometric/ev100_bias"                              // float, writable
+	SimPrivateControlsPhotometric_ev100                               string = "sim/private/controls/photometric/ev100"                                   // float, writable
+	SimPrivateControlsTonemap_false_color                             string = "sim/private/controls/tonemap/false_color"                                 // float, writable
+	SimPrivateControlsTonemap_exposure_fusion                         string = "sim/private/controls/tonemap/exposure_fusion"                             // float, writable
+	SimPrivateControlsAutoexposure_bins                               string = "sim/private/controls/autoexposure/bins"                                   // float, writable
+	SimPrivateControlsTonemap_desaturate                              string = "sim/private/controls/tonemap/desaturate"                                  // float, writable
+	SimPrivateControlsTonemap_blend                                   string = "sim/private/controls/tonemap/blend"                                       // float, writable
+	SimPrivateControlsAutoexposure_trim_hi                            string = "sim/private/controls/autoexposure/trim_hi"                                // float, writable
+	SimPrivateControlsAutoexposure_trim_lo                            string = "sim/private/controls/autoexposure/trim_lo"                                // float, writable
+	SimPrivateControlsAutoexposure_gain_hi                            string = "sim/private/controls/autoexposure/gain_hi"                                // float, writable
+	SimPrivateControlsAutoexposure_gain_lo                            string = "sim/private/controls/autoexposure/gain_lo"                                // float, writable
+	SimPrivateControlsAutoexposure_null_hi                            string = "sim/private/controls/autoexposure/null_hi"                                // float, writable
+	SimPrivateControlsAutoexposure_null_lo                            string = "sim/private/controls/autoexposure/null_lo"                                // float, writable
+	SimPrivateControlsPhotometric_ev100_mtr                           string = "sim/private/controls/photometric/ev100_mtr"                               // float, writable
+	SimPrivateControlsPhotometric_ev100_mid                           string = "sim/private/controls/photometric/ev100_mid"                               // float, writable
+	SimPrivateControlsPhotometric_ev100_max                           string = "sim/private/controls/photometric/ev100_max"                               // float, writable
+	SimPrivateControlsPhotometric_ev100_min                           string = "sim/private/controls/photometric/ev100_min"                               // float, writable
+	SimPrivateControlsExposureFusion_sigma                            string = "sim/private/controls/exposure_fusion/sigma"                               // float, writable
+	SimPrivateControlsExposureFusion_max_mip                          string = "sim/private/controls/exposure_fusion/max_mip"                             // float, writable
+	SimPrivateControlsExposureFusion_hq_blur                          string = "sim/private/controls/exposure_fusion/hq_blur"                             // float, writable
+	SimPrivateControlsRoad_no_plug                                    string = "sim/private/controls/road/no_plug"                                        // float, writable
+	SimPrivateControlsRoad_no_composites                              string = "sim/private/controls/road/no_composites"                                  // float, writable
+	SimPrivateControlsRoad_no_straighten                              string = "sim/private/controls/road/no_straighten"                                  // float, writable
+	SimPrivateControlsRoad_extra_for_straightening                    string = "sim/private/controls/road/extra_for_straightening"                        // float, writable
+	SimPrivateControlsRoad_pull_factor                                string = "sim/private/controls/road/pull_factor"                                    // float, writable
+	SimPrivateControlsRoad_max_smooth_depth                           string = "sim/private/controls/road/max_smooth_depth"                               // float, writable
+	SimPrivateControlsRoad_max_smooth_err                             string = "sim/private/controls/road/max_smooth_err"                                 // float, writable
+	SimPrivateControlsRoad_min_smooth_len                             string = "sim/private/controls/road/min_smooth_len"                                 // float, writable
+	SimPrivateControlsRoad_slash_angle                                string = "sim/private/controls/road/slash_angle"                                    // float, writable
+	SimPrivateControlsRoad_subdivide_angle                            string = "sim/private/controls/road/subdivide_angle"                                // float, writable
+	SimPrivateControlsCars_density_factor                             string = "sim/private/controls/cars/density_factor"                                 // float, writable
+	SimPrivateControlsRoads_limit_strip                               string = "sim/private/controls/roads/limit_strip"                                   // float, writable
+	SimPrivateControlsRoads_bezier_calc_split_len                     string = "sim/private/controls/roads/bezier_calc_split_len"                         // float, writable
+	SimPrivateControlsRoads_min_dot_for_mitre                         string = "sim/private/controls/roads/min_dot_for_mitre"                             // float, writable
+	SimPrivateControlsRoads_noise_freq                                string = "sim/private/controls/roads/noise_freq"                                    // float, writable
+	SimPrivateControlsRoads_sink_draped_junctions                     string = "sim/private/controls/roads/sink_draped_junctions"                         // float, writable
+	SimPrivateControlsRoads_minimum_stacking                          string = "sim/private/controls/roads/minimum_stacking"                              // float, writable
+	SimPrivateControlsRoad_start_uv_off                               string = "sim/private/controls/road/start_uv_off"                                   // float, writable
+	SimPrivateControlsRoad_max_uv_distort                             string = "sim/private/controls/road/max_uv_distort"                                 // float, writable
+	SimPrivateControlsRoad_do_slash                                   string = "sim/private/controls/road/do_slash"                                       // float, writable
+	SimPrivateControlsRoad_do_pencil                                  string = "sim/private/controls/road/do_pencil"                                      // float, writable
+	SimPrivateControlsPerf_kill_chains                                string = "sim/private/controls/perf/kill_chains"                                    // float, writable
+	SimPrivateControlsPerf_kill_junctions                             string = "sim/private/controls/perf/kill_junctions"                                 // float, writable
+	SimPrivateControlsRoad_drape_level_space                          string = "sim/private/controls/road/drape_level_space"                              // float, writable
+	SimPrivateControlsRoad_test_real_types                            string = "sim/private/controls/road/test_real_types"                                // float, writable
+	SimPrivateControlsRoad_bridge_minimum                             string = "sim/private/controls/road/bridge_minimum"                                 // float, writable
+	SimPrivateControlsRoad_grade_minimum                              string = "sim/private/controls/road/grade_minimum"                                  // float, writable
+	SimPrivateControlsRoad_bury_draped                                string = "sim/private/controls/road/bury_draped"                                    // float, writable
+	SimPrivateControlsRoad_powerline_interval_steep                   string = "sim/private/controls/road/powerline_interval_steep"                       // float, writable
+	SimPrivateControlsRoad_powerline_interval_flat                    string = "sim/private/controls/road/powerline_interval_flat"                        // float, writable
+	SimPrivateControlsRoad_over_under_slop                            string = "sim/private/controls/road/over_under_slop"                                // float, writable
+	SimPrivateControlsRoad_check_over_under                           string = "sim/private/controls/road/check_over_under"                               // float, writable
+	SimPrivateControlsRoad_smooth_cutoff                              string = "sim/private/controls/road/smooth_cutoff"                                  // float, writable
+	SimPrivateControlsRoad_smooth_weight                              string = "sim/private/controls/road/smooth_weight"                                  // float, writable
+	SimPrivateControlsRoad_powerline_base_max                         string = "sim/private/controls/road/powerline_base_max"                             // float, writable
+	SimPrivateControlsRoad_powerline_base_extra                       string = "sim/private/controls/road/powerline_base_extra"                           // float, writable
+	SimPrivateControlsRoad_powerline_base_width                       string = "sim/private/controls/road/powerline_base_width"                           // float, writable
+	SimPrivateControlsRoad_powerline_v_slop                           string = "sim/private/controls/road/powerline_v_slop"                               // float, writable
+	SimPrivateControlsRoad_powerline_h_slop                           string = "sim/private/controls/road/powerline_h_slop"                               // float, writable
+	SimPrivateControlsRoad_powerline_buffer_dist                      string = "sim/private/controls/road/powerline_buffer_dist"                          // float, writable
+	SimPrivateControlsRoad_max_default_shear                          string = "sim/private/controls/road/max_default_shear"                              // float, writable
+	SimPrivateControlsVegetation_billboard_shadows                    string = "sim/private/controls/vegetation/billboard_shadows"                        // float, writable
+	SimPrivateControlsVegetationStat_filter_render_type               string = "sim/private/controls/vegetation/stat/filter_render_type"                  // float, writable
+	SimPrivateControlsVegetationCull_min_points                       string = "sim/private/controls/vegetation/cull/min_points"                          // float, writable
+	SimPrivateControlsVegetationCull_downsize_factor                  string = "sim/private/controls/vegetation/cull/downsize_factor"                     // float, writable
+	SimPrivateControlsVegetationLod_far_lod_feather                   string = "sim/private/controls/vegetation/lod/far_lod_feather"                      // float, writable
+	SimPrivateControlsVegetationLod_far_multiplier                    string = "sim/private/controls/vegetation/lod/far_multiplier"                       // float, writable
+	SimPrivateControlsVegetationLod_near_multiplier                   string = "sim/private/controls/vegetation/lod/near_multiplier"                      // float, writable
+	SimPrivateControlsVegetationLod_last_crossfade_distance_max       string = "sim/private/controls/vegetation/lod/last_crossfade_distance_max"          // float, writable
+	SimPrivateControlsVegetationLod_last_crossfade_distance_min       string = "sim/private/controls/vegetation/lod/last_crossfade_distance_min"          // float, writable
+	SimPrivateControlsVegetationLod_crossfade_distance                string = "sim/private/controls/vegetation/lod/crossfade_distance"                   // float, writable
+	SimPrivateControlsShadow_bias_n_slope                             string = "sim/private/controls/shadow/bias_n_slope"                                 // float, writable
+	SimPrivateControlsShadow_bias_n_constant                          string = "sim/private/controls/shadow/bias_n_constant"                              // float, writable
+	SimPrivateControlsShadowAdhoc_extra_far                           string = "sim/private/controls/shadow/adhoc/extra_far"                              // float, writable
+	SimPrivateControlsHdr_bloom_end_mip                               string = "sim/private/controls/hdr/bloom_end_mip"                                   // float, writable
+	SimPrivateControlsHdr_bloom_start_mip                             string = "sim/private/controls/hdr/bloom_start_mip"                                 // float, writable
+	SimPrivateControlsHdr_bloom_exponent                              string = "sim/private/controls/hdr/bloom_exponent"                                  // float, writable
+	SimPrivateControlsHdr_bloom_taps                                  string = "sim/private/controls/hdr/bloom_taps"                                      // float, writable
+	SimPrivateControlsHdr_bloom_hi                                    string = "sim/private/controls/hdr/bloom_hi"                                        // float, writable
+	SimPrivateControlsHdr_bloom_lo                                    string = "sim/private/controls/hdr/bloom_lo"                                        // float, writable
+	SimPrivateControlsHdr_bloom1                                      string = "sim/private/controls/hdr/bloom1"                                          // float, writable
+	SimPrivateControlsHdr_use_post_aa                                 string = "sim/private/controls/hdr/use_post_aa"                                     // float, writable
+	SimPrivateControlsSsr_sample_count                                string = "sim/private/controls/ssr/sample_count"                                    // float, writable
+	SimPrivateControlsSsr_use_prefiltered                             string = "sim/private/controls/ssr/use_prefiltered"                                 // float, writable
+	SimPrivateControlsSsr_lod_bias                                    string = "sim/private/controls/ssr/lod_bias"                                        // float, writable
+	SimPrivateControlsSsr_min_gloss                                   string = "sim/private/controls/ssr/min_gloss"                                       // float, writable
+	SimPrivateControlsDebug_kill_ssr_reproj                           string = "sim/private/controls/debug/kill_ssr_reproj"                               // float, writable
+	SimPrivateControlsLights_debug_lights                             string = "sim/private/controls/lights/debug_lights"                                 // float, writable
+	SimPrivateControlsLights_debug_tiles                              string = "sim/private/controls/lights/debug_tiles"                                  // float, writable
+	SimPrivateControlsLights_transform_max_invocations                string = "sim/private/controls/lights/transform_max_invocations"                    // float, writable
+	SimPrivateControlsLights_tile_depth_inband                        string = "sim/private/controls/lights/tile_depth_inband"                            // float, writable
+	SimPrivateControlsLights_tile_bitmask                             string = "sim/private/controls/lights/tile_bitmask"                                 // float, writable
+	SimPrivateControlsLights_tile_ztest                               string = "sim/private/controls/lights/tile_ztest"                                   // float, writable
+	SimPrivateControlsLights_transform_ztest                          string = "sim/private/controls/lights/transform_ztest"                              // float, writable
+	SimPrivateControlsLights_tile_static                              string = "sim/private/controls/lights/tile_static"                                  // float, writable
+	SimPrivateControlsLights_min_size                                 string = "sim/private/controls/lights/min_size"                                     // float, writable
+	SimPrivateControlsLights_max_distance_fadeout                     string = "sim/private/controls/lights/max_distance_fadeout"                         // float, writable
+	SimPrivateControlsLights_max_distance                             string = "sim/private/controls/lights/max_distance"                                 // float, writable
+	SimPrivateControlsLights_max_lights_dynamic                       string = "sim/private/controls/lights/max_lights_dynamic"                           // float, writable
+	SimPrivateControlsLights_max_lights                               string = "sim/private/controls/lights/max_lights"                                   // float, writable
+	SimPrivateControlsLights_tile_max_lights                          string = "sim/private/controls/lights/tile_max_lights"                              // float, writable
+	SimPrivateControlsLights_index_size                               string = "sim/private/controls/lights/index_size"                                   // float, writable
+	SimPrivateControlsLights_index_enabled                            string = "sim/private/controls/lights/index_enabled"                                // float, writable
+	SimPrivateControlsLights_tile_pixels                              string = "sim/private/controls/lights/tile_pixels"                                  // float, writable
+	SimPrivateControlsLights_tile_lights                              string = "sim/private/controls/lights/tile_lights"                                  // float, writable
+	SimPrivateControlsShadow_debug_show_csm_layer                     string = "sim/private/controls/shadow/debug_show_csm_layer"                         // float, writable
+	SimPrivateControlsHdr_debug_lights                                string = "sim/private/controls/hdr/debug_lights"                                    // float, writable
+	SimPrivateControlsDebug_kill_ssr                                  string = "sim/private/controls/debug/kill_ssr"                                      // float, writable
+	SimPrivateControlsGbuffer_debug_mode                              string = "sim/private/controls/gbuffer/debug_mode"                                  // float, writable
+	SimPrivateControlsPlanet_want_true_depth                          string = "sim/private/controls/planet/want_true_depth"                              // float, writable
+	SimPrivateControlsPlanet_od_tex_size_y                            string = "sim/private/controls/planet/od_tex_size_y"                                // float, writable
+	SimPrivateControlsPlanet_od_tex_size_x                            string = "sim/private/controls/planet/od_tex_size_x"                                // float, writable
+	SimPrivateControlsSsao_interior                                   string = "sim/private/controls/ssao/interior"                                       // float, writable
+	SimPrivateControlsGbuf_use_tile_lights                            string = "sim/private/controls/gbuf/use_tile_lights"                                // float, writable
+	SimPrivateControlsLights_spill_fog_samples_max                    string = "sim/private/controls/lights/spill_fog_samples_max"                        // float, writable
+	SimPrivateControlsLights_spill_fog_samples_min                    string = "sim/private/controls/lights/spill_fog_samples_min"                        // float, writable
+	SimPrivateControlsLights_spill_fog_samples_min_dist               string = "sim/private/controls/lights/spill_fog_samples_min_dist"                   // float, writable
+	SimPrivateControlsMaterial_specular_bias                          string = "sim/private/controls/material/specular_bias"                              // float, writable
+	SimPrivateControlsMaterial_specular_samples                       string = "sim/private/controls/material/specular_samples"                           // float, writable
+	SimPrivateControlsMaterial_reference_albedo                       string = "sim/private/controls/material/reference_albedo"                           // float, writable
+	SimPrivateControlsMaterial_override_albedo                        string = "sim/private/controls/material/override_albedo"                            // float, writable
+	SimPrivateControlsAtmo_ozone_width                                string = "sim/private/controls/atmo/ozone_width"                                    // float, writable
+	SimPrivateControlsAtmo_ozone_center                               string = "sim/private/controls/atmo/ozone_center"                                   // float, writable
+	SimPrivateControlsAtmo_ozone_b                                    string = "sim/private/controls/atmo/ozone_b"                                        // float, writable
+	SimPrivateControlsAtmo_ozone_g                                    string = "sim/private/controls/atmo/ozone_g"                                        // float, writable
+	SimPrivateControlsAtmo_ozone_r                                    string = "sim/private/controls/atmo/ozone_r"                                        // float, writable
+	SimPrivateControlsAtmo_rayleigh_b                                 string = "sim/private/controls/atmo/rayleigh_b"                                     // float, writable
+	SimPrivateControlsAtmo_rayleigh_g                                 string = "sim/private/controls/atmo/rayleigh_g"                                     // float, writable
+	SimPrivateControlsAtmo_rayleigh_r                                 string = "sim/private/controls/atmo/rayleigh_r"                                     // float, writable
+	SimPrivateControlsSsao_interior_strength                          string = "sim/private/controls/ssao/interior_strength"                              // float, writable
+	SimPrivateControlsSsao_interior_curve                             string = "sim/private/controls/ssao/interior_curve"                                 // float, writable
+	SimPrivateControlsSsao_exterior_strength                          string = "sim/private/controls/ssao/exterior_strength"                              // float, writable
+	SimPrivateControlsSsao_exterior_curve                             string = "sim/private/controls/ssao/exterior_curve"                                 // float, writable
+	SimPrivateControlsDof_quality                                     string = "sim/private/controls/dof/quality"                                         // float, writable
+	SimPrivateControlsDof_coc_limit                                   string = "sim/private/controls/dof/coc_limit"                                       // float, writable
+	SimPrivateControlsDof_sensor_size                                 string = "sim/private/controls/dof/sensor_size"                                     // float, writable
+	SimPrivateControlsDof_focus_distance                              string = "sim/private/controls/dof/focus_distance"                                  // float, writable
+	SimPrivateControlsDof_fnumber                                     string = "sim/private/controls/dof/fnumber"                                         // float, writable
+	SimPrivateControlsDof_enable                                      string = "sim/private/controls/dof/enable"                                          // float, writable
+	SimPrivateControlsFsr_enable                                      string = "sim/private/controls/fsr/enable"                                          // float, writable
+	SimPrivateControlsFsr_quality                                     string = "sim/private/controls/fsr/quality"                                         // float, writable
+	SimPrivateControlsFsr_bypass                                      string = "sim/private/controls/fsr/bypass"                                          // float, writable
+	SimPrivateControlsSsao_dynamic_values                             string = "sim/private/controls/ssao/dynamic_values"                                 // float, writable
+	SimPrivateControlsSsao_visibility                                 string = "sim/private/controls/ssao/visibility"                                     // float, writable
+	SimPrivateControlsSsao_downsample                                 string = "sim/private/controls/ssao/downsample"                                     // float, writable
+	SimPrivateControlsSsao_bilateral_similarity_distance_sigma        string = "sim/private/controls/ssao/bilateral_similarity_distance_sigma"            // float, writable
+	SimPrivateControlsSsao_bilateral_sigma_squared                    string = "sim/private/controls/ssao/bilateral_sigma_squared"                        // float, writable
+	SimPrivateControlsSsao_detail_shadow_strength                     string = "sim/private/controls/ssao/detail_shadow_strength"                         // float, writable
+	SimPrivateControlsSsao_sharpness                                  string = "sim/private/controls/ssao/sharpness"                                      // float, writable
+	SimPrivateControlsSsao_blur_pass_count                            string = "sim/private/controls/ssao/blur_pass_count"                                // float, writable
+	SimPrivateControlsSsao_adaptive_quality_limit                     string = "sim/private/controls/ssao/adaptive_quality_limit"                         // float, writable
+	SimPrivateControlsSsao_quality_level                              string = "sim/private/controls/ssao/quality_level"                                  // float, writable
+	SimPrivateControlsSsao_fade_in_to                                 string = "sim/private/controls/ssao/fade_in_to"                                     // float, writable
+	SimPrivateControlsSsao_fade_in_from                               string = "sim/private/controls/ssao/fade_in_from"                                   // float, writable
+	SimPrivateControlsSsao_fade_out_to                                string = "sim/private/controls/ssao/fade_out_to"                                    // float, writable
+	SimPrivateControlsSsao_fade_out_from                              string = "sim/private/controls/ssao/fade_out_from"                                  // float, writable
+	SimPrivateControlsSsao_horizon_angle_threshold                    string = "sim/private/controls/ssao/horizon_angle_threshold"                        // float, writable
+	SimPrivateControlsSsao_shadow_clamp                               string = "sim/private/controls/ssao/shadow_clamp"                                   // float, writable
+	SimPrivateControlsSsao_shadow_power                               string = "sim/private/controls/ssao/shadow_power"                                   // float, writable
+	SimPrivateControlsSsao_shadow_multiplier                          string = "sim/private/controls/ssao/shadow_multiplier"                              // float, writable
+	SimPrivateControlsSsao_radius                                     string = "sim/private/controls/ssao/radius"                                         // float, writable
+	SimPrivateControlsTrucks_any_service_time                         string = "sim/private/controls/trucks/any_service_time"                             // float, writable
+	SimPrivateControlsTrucks_crew_service_time                        string = "sim/private/controls/trucks/crew_service_time"                            // float, writable
+	SimPrivateControlsPark_static_plane_density                       string = "sim/private/controls/park/static_plane_density"                           // float, writable
+	SimPrivateControlsPark_static_plane_build_dis                     string = "sim/private/controls/park/static_plane_build_dis"                         // float, writable
+	SimPrivateControlsAirp_debug_ramp_starts                          string = "sim/private/controls/airp/debug_ramp_starts"                              // float, writable
+	SimPrivateControlsDebug_probe_broadphase                          string = "sim/private/controls/debug/probe_broadphase"                              // float, writable
+	SimPrivateControlsDebug_show_manip_commands                       string = "sim/private/controls/debug/show_manip_commands"                           // float, writable
+	SimPrivateControlsInstance_min_count_for_vbo                      string = "sim/private/controls/instance/min_count_for_vbo"                          // float, writable
+	SimPrivateControlsObj_allow_short_index                           string = "sim/private/controls/obj/allow_short_index"                               // float, writable
+	SimPrivateControlsObj_allow_short_vertex                          string = "sim/private/controls/obj/allow_short_vertex"                              // float, writable
+	SimPrivateControlsParticleSystem_phase_g                          string = "sim/private/controls/particle_system/phase_g"                             // float, writable
+	SimPrivateControlsParticleSystem_occlusion_mode                   string = "sim/private/controls/particle_system/occlusion_mode"                      // float, writable
+	SimPrivateControlsParticleSystem_debug_mode                       string = "sim/private/controls/particle_system/debug_mode"                          // float, writable
+	SimPrivateControlsParticle_old_emit_model                         string = "sim/private/controls/particle/old_emit_model"                             // float, writable
+	SimPrivateControlsParticle_jitter_hz                              string = "sim/private/controls/particle/jitter_hz"                                  // float, writable
+	SimPrivateControlsPhotometric_interior_lit_boost                  string = "sim/private/controls/photometric/interior_lit_boost"                      // float, writable
+	SimPrivateControlsLighting_device_tex_ref_nts                     string = "sim/private/controls/lighting/device_tex_ref_nts"                         // float, writable
+	SimPrivateControlsLighting_panel_tex_ref_nts                      string = "sim/private/controls/lighting/panel_tex_ref_nts"                          // float, writable
+	SimPrivateControlsTerrain_fade_start_rat                          string = "sim/private/controls/terrain/fade_start_rat"                              // float, writable
+	SimPrivateControlsPerf_cockpit_kill_dist                          string = "sim/private/controls/perf/cockpit_kill_dist"                              // float, writable
+	SimPrivateControlsInstruments_camera_night_cutoff                 string = "sim/private/controls/instruments/camera_night_cutoff"                     // float, writable
+	SimPrivateControlsInstruments_full_camera                         string = "sim/private/controls/instruments/full_camera"                             // float, writable
+	SimPrivateControlsDebug_show_avionics_bounds                      string = "sim/private/controls/debug/show_avionics_bounds"                          // float, writable
+	SimPrivateControlsPerf_kill_panel_bkgnd                           string = "sim/private/controls/perf/kill_panel_bkgnd"                               // float, writable
+	SimPrivateControlsPerf_kill_instruments                           string = "sim/private/controls/perf/kill_instruments"                               // float, writable
+	SimPrivateControlsExposureFusion_dis_far                          string = "sim/private/controls/exposure_fusion/dis_far"                             // float, writable
+	SimPrivateControlsExposureFusion_dis_near                         string = "sim/private/controls/exposure_fusion/dis_near"                            // float, writable
+	SimPrivateControlsPanel_always_render                             string = "sim/private/controls/panel/always_render"                                 // float, writable
+	SimPrivateControlsPerf_disable_cockpit_readback                   string = "sim/private/controls/perf/disable_cockpit_readback"                       // float, writable
+	SimPrivateControlsCube_kill_all                                   string = "sim/private/controls/cube/kill_all"                                       // float, writable
+	SimPrivateControlsTest_capture_offscreen                          string = "sim/private/controls/test/capture_offscreen"                              // float, writable
+	SimPrivateControlsDeferred_fast_lighting_mode                     string = "sim/private/controls/deferred/fast_lighting_mode"                         // float, writable
+	SimPrivateControlsTonemap_tone_tap_bias                           string = "sim/private/controls/tonemap/tone_tap_bias"                               // float, writable
+	SimPrivateControlsDebug_kill_weather_apply                        string = "sim/private/controls/debug/kill_weather_apply"                            // float, writable
+	SimPrivateControlsDebug_show_histo                                string = "sim/private/controls/debug/show_histo"                                    // float, writable
+	SimPrivateControlsCloud_z_bias                                    string = "sim/private/controls/cloud/z_bias"                                        // float, writable
+	SimPrivateControlsClouds_min_proxy                                string = "sim/private/controls/clouds/min_proxy"                                    // float, writable
+	SimPrivateControlsClouds_compute_clouds                           string = "sim/private/controls/clouds/compute_clouds"                               // float, writable
+	SimPrivateControlsDebug_offscreen_cloud_mode                      string = "sim/private/controls/debug/offscreen_cloud_mode"                          // float, writable
+	SimPrivateControlsPerf_test_prefill                               string = "sim/private/controls/perf/test_prefill"                                   // float, writable
+	SimPrivateControlsPerf_kill_prefill                               string = "sim/private/controls/perf/kill_prefill"                                   // float, writable
+	SimPrivateControlsClouds_full_msaa                                string = "sim/private/controls/clouds/full_msaa"                                    // float, writable
+	SimPrivateControlsLights_do_spill_fog                             string = "sim/private/controls/lights/do_spill_fog"                                 // float, writable
+	SimPrivateControlsSsr_want_cockpit                                string = "sim/private/controls/ssr/want_cockpit"                                    // float, writable
+	SimPrivateControlsShadowCsm_clamp                                 string = "sim/private/controls/shadow/csm/clamp"                                    // float, writable
+	SimPrivateControlsShadow_overlap_ratio                            string = "sim/private/controls/shadow/overlap_ratio"                                // float, writable
+	SimPrivateControlsShadowCsm_lambda                                string = "sim/private/controls/shadow/csm/lambda"                                   // float, writable
+	SimPrivateControlsShadowCsm_near_limit_exterior                   string = "sim/private/controls/shadow/csm/near_limit_exterior"                      // float, writable
+	SimPrivateControlsShadowCsm_near_limit_interior                   string = "sim/private/controls/shadow/csm/near_limit_interior"                      // float, writable
+	SimPrivateControlsShadowCsm_far_limit_exterior                    string = "sim/private/controls/shadow/csm/far_limit_exterior"                       // float, writable
+	SimPrivateControlsShadowCsm_far_limit_interior                    string = "sim/private/controls/shadow/csm/far_limit_interior"                       // float, writable
+	SimPrivateControlsShadowCsm_print_splits                          string = "sim/private/controls/shadow/csm/print_splits"                             // float, writable
+	SimPrivateControlsShadow_want_soft                                string = "sim/private/controls/shadow/want_soft"                                    // float, writable
+	SimPrivateControlsShadow_screenspace                              string = "sim/private/controls/shadow/screenspace"                                  // float, writable
+	SimPrivateControlsShadow_scenery_shadows                          string = "sim/private/controls/shadow/scenery_shadows"                              // float, writable
+	SimPrivateControlsPerf_disable_reflection_cam                     string = "sim/private/controls/perf/disable_reflection_cam"                         // float, writable
+	SimPrivateControlsPerf_kill_hdr_tone_blit                         string = "sim/private/controls/perf/kill_hdr_tone_blit"                             // float, writable
+	SimPrivateControlsPerf_kill_gbuf_lights                           string = "sim/private/controls/perf/kill_gbuf_lights"                               // float, writable
+	SimPrivateControlsPerf_kill_gbuf_sun                              string = "sim/private/controls/perf/kill_gbuf_sun"                                  // float, writable
+	SimPrivateControlsShadow_cockpit_near_proxy                       string = "sim/private/controls/shadow/cockpit_near_proxy"                           // float, writable
+	SimPrivateControlsShadow_cockpit_near_adjust                      string = "sim/private/controls/shadow/cockpit_near_adjust"                          // float, writable
+	SimPrivateControlsVolumetric_apply_with_msaa                      string = "sim/private/controls/volumetric/apply_with_msaa"                          // float, writable
+	SimPrivateControlsDome_per_view                                   string = "sim/private/controls/dome/per_view"                                       // float, writable
+	SimPrivateControlsVolumetricFog_stencil_mode                      string = "sim/private/controls/volumetric/fog/stencil_mode"                         // float, writable
+	SimPrivateControlsVolumetric_use_scene_z                          string = "sim/private/controls/volumetric/use_scene_z"                              // float, writable
+	SimPrivateControlsVolumetric_sample_use_scene_z                   string = "sim/private/controls/volumetric/sample_use_scene_z"                       // float, writable
+	SimPrivateControlsVolumetricFog_kill_opaque                       string = "sim/private/controls/volumetric/fog/kill_opaque"                          // float, writable
+	SimPrivateControlsClouds_categorize_per_sample                    string = "sim/private/controls/clouds/categorize_per_sample"                        // float, writable
+	SimPrivateControlsClouds_composite_mode                           string = "sim/private/controls/clouds/composite_mode"                               // float, writable
+	SimPrivateControlsCloud_onscreen_bias                             string = "sim/private/controls/cloud/onscreen_bias"                                 // float, writable
+	SimPrivateControlsPlanet_render_pass                              string = "sim/private/controls/planet/render_pass"                                  // float, writable
+	SimPrivateControlsStencil_debug_mask                              string = "sim/private/controls/stencil/debug_mask"                                  // float, writable
+	SimPrivateControlsStencil_debug_key                               string = "sim/private/controls/stencil/debug_key"                                   // float, writable
+	SimPrivateControlsShadow_first_3d_pass                            string = "sim/private/controls/shadow/first_3d_pass"                                // float, writable
+	SimPrivateControlsShadow_total_fade_ratio                         string = "sim/private/controls/shadow/total_fade_ratio"                             // float, writable
+	SimPrivateControlsPerf_disable_shadow_prep                        string = "sim/private/controls/perf/disable_shadow_prep"                            // float, writable
+	SimPrivateControlsPerf_cull_far_plane                             string = "sim/private/controls/perf/cull_far_plane"                                 // float, writable
+	SimPrivateControlsVr_cross_eye                                    string = "sim/private/controls/vr/cross_eye"                                        // float, writable
+	SimPrivateControlsLighting_scenery_ref_nts                        string = "sim/private/controls/lighting/scenery_ref_nts"                            // float, writable
+	SimPrivateControlsClip_override_far                               string = "sim/private/controls/clip/override_far"                                   // float, writable
+	SimPrivateControlsClip_override_near                              string = "sim/private/controls/clip/override_near"                                  // float, writable
+	SimPrivateControlsHdr_msaa_hw                                     string = "sim/private/controls/hdr/msaa_hw"                                         // float, writable
+	SimPrivateControlsSsao_enable                                     string = "sim/private/controls/ssao/enable"                                         // float, writable
+	SimPrivateControlsCockpit_far_clip_ratio                          string = "sim/private/controls/cockpit/far_clip_ratio"                              // float, writable
+	SimPrivateControlsCockpit_near_clip_ratio                         string = "sim/private/controls/cockpit/near_clip_ratio"                             // float, writable
+	SimPrivateControlsWater_clip_plane_adjust                         string = "sim/private/controls/water/clip_plane_adjust"                             // float, writable
+	SimPrivateControlsWater_extra_fov_rat_semi                        string = "sim/private/controls/water/extra_fov_rat_semi"                            // float, writable
+	SimPrivateControlsTerrain_far_clip_lim                            string = "sim/private/controls/terrain/far_clip_lim"                                // float, writable
+	SimPrivateControlsFog_std_deviation_cutoff                        string = "sim/private/controls/fog/std_deviation_cutoff"                            // float, writable
+	SimPrivateControlsExposureFusion_shadows                          string = "sim/private/controls/exposure_fusion/shadows"                             // float, writable
+	SimPrivateControlsCubemap_interior_acf_cloud_shadow               string = "sim/private/controls/cubemap/interior_acf_cloud_shadow"                   // float, writable
+	SimPrivateControlsTerrain_kill_water                              string = "sim/private/controls/terrain/kill_water"                                  // float, writable
+	SimPrivateControlsTerrain_kill_cars                               string = "sim/private/controls/terrain/kill_cars"                                   // float, writable
+	SimPrivateControlsTerrain_kill_patches                            string = "sim/private/controls/terrain/kill_patches"                                // float, writable
+	SimPrivateControlsTerrain_skip_sphere_cull_cluster                string = "sim/private/controls/terrain/skip_sphere_cull_cluster"                    // float, writable
+	SimPrivateControlsTerrain_skip_lod_cull_cluster                   string = "sim/private/controls/terrain/skip_lod_cull_cluster"                       // float, writable
+	SimPrivateControlsTerrain_skip_sphere_cull                        string = "sim/private/controls/terrain/skip_sphere_cull"                            // float, writable
+	SimPrivateControlsTerrain_skip_lod_cull                           string = "sim/private/controls/terrain/skip_lod_cull"                               // float, writable
+	SimPrivateControlsShadow_lod_bias_adjust                          string = "sim/private/controls/shadow/lod_bias_adjust"                              // float, writable
+	SimPrivateControlsCube_lod_bias_objects                           string = "sim/private/controls/cube/lod_bias_objects"                               // float, writable
+	SimPrivateControlsCube_lod_bias_forest                            string = "sim/private/controls/cube/lod_bias_forest"                                // float, writable
+	SimPrivateControlsCars_lod_hdr                                    string = "sim/private/controls/cars/lod_hdr"                                        // float, writable
+	SimPrivateControlsTerrain_car_lod_boost                           string = "sim/private/controls/terrain/car_lod_boost"                               // float, writable
+	SimPrivateControlsTerrain_minimum_zoomed_FOV                      string = "sim/private/controls/terrain/minimum_zoomed_FOV"                          // float, writable
+	SimPrivateControlsWater_tess_bucket_bias                          string = "sim/private/controls/water/tess_bucket_bias"                              // float, writable
+	SimPrivateControlsWater_land_bias                                 string = "sim/private/controls/water/land_bias"                                     // float, writable
+	SimPrivateControlsAg_tree_height_jitter                           string = "sim/private/controls/ag/tree_height_jitter"                               // float, writable
+	SimPrivateControlsAg_tree_rotation_jitter                         string = "sim/private/controls/ag/tree_rotation_jitter"                             // float, writable
+	SimPrivateControlsAg_shrub_height                                 string = "sim/private/controls/ag/shrub_height"                                     // float, writable
+	SimPrivateControlsAg_tile_lod_bias                                string = "sim/private/controls/ag/tile_lod_bias"                                    // float, writable
+	SimPrivateControlsAutogen_use_atomic_corners                      string = "sim/private/controls/autogen/use_atomic_corners"                          // float, writable
+	SimPrivateControlsAutogen_ags_corner                              string = "sim/private/controls/autogen/ags_corner"                                  // float, writable
+	SimPrivateControlsAutogen_ags_slop_h                              string = "sim/private/controls/autogen/ags_slop_h"                                  // float, writable
+	SimPrivateControlsAutogen_ags_slop_v                              string = "sim/private/controls/autogen/ags_slop_v"                                  // float, writable
+	SimPrivateControlsAg_agb_hilite_bad_fit                           string = "sim/private/controls/ag/agb_hilite_bad_fit"                               // float, writable
+	SimPrivateControlsAg_agb_warnings                                 string = "sim/private/controls/ag/agb_warnings"                                     // float, writable
+	SimPrivateControlsAgb_debug_spelling_gaps                         string = "sim/private/controls/agb/debug_spelling_gaps"                             // float, writable
+	SimPrivateControlsAg_default_tile_lod                             string = "sim/private/controls/ag/default_tile_lod"                                 // float, writable
+	SimPrivateControlsFor_show_dsf_poly                               string = "sim/private/controls/for/show_dsf_poly"                                   // float, writable
+	SimPrivateControlsFacades_kill_facs                               string = "sim/private/controls/facades/kill_facs"                                   // float, writable
+	SimPrivateControlsDebugPol_kill_wet                               string = "sim/private/controls/debug/pol/kill_wet"                                  // float, writable
+	SimPrivateControlsDebugPol_kill_dry                               string = "sim/private/controls/debug/pol/kill_dry"                                  // float, writable
+	SimPrivateControlsAg_show_dsf_poly                                string = "sim/private/controls/ag/show_dsf_poly"                                    // float, writable
+	SimPrivateControlsTerrain_patch_grid                              string = "sim/private/controls/terrain/patch_grid"                                  // float, writable
+	SimPrivateControlsParticle_preview_radius                         string = "sim/private/controls/particle/preview_radius"                             // float, writable
+	SimPrivateControlsParticle_preview_rpm                            string = "sim/private/controls/particle/preview_rpm"                                // float, writable
+	SimPrivateControlsParticle_preview_level                          string = "sim/private/controls/particle/preview_level"                              // float, writable
+	SimPrivateControlsSound_sample_max_func                           string = "sim/private/controls/sound/sample_max_func"                               // float, writable
+	SimPrivateControlsWater_floor_decal_noise_4                       string = "sim/private/controls/water/floor_decal_noise_4"                           // float, writable
+	SimPrivateControlsWater_floor_decal_noise_3                       string = "sim/private/controls/water/floor_decal_noise_3"                           // float, writable
+	SimPrivateControlsWater_floor_decal_noise_2                       string = "sim/private/controls/water/floor_decal_noise_2"                           // float, writable
+	SimPrivateControlsWater_floor_decal_noise_1                       string = "sim/private/controls/water/floor_decal_noise_1"                           // float, writable
+	SimPrivateControlsWaterFloor_scale                                string = "sim/private/controls/water/floor/scale"                                   // float, writable
+	SimPrivateControlsCars_show_spawn                                 string = "sim/private/controls/cars/show_spawn"                                     // float, writable
+	SimPrivateControlsAgBlock_slope_sample_max                        string = "sim/private/controls/ag/block/slope_sample_max"                           // float, writable
+	SimPrivateControlsAgBlock_slope_sample_dist                       string = "sim/private/controls/ag/block/slope_sample_dist"                          // float, writable
+	SimPrivateControlsAg_inflate_dist_min                             string = "sim/private/controls/ag/inflate_dist_min"                                 // float, writable
+	SimPrivateControlsDebug_debug_text_distance                       string = "sim/private/controls/debug/debug_text_distance"                           // float, writable
+	SimPrivateControlsDebug_debug_point_size                          string = "sim/private/controls/debug/debug_point_size"                              // float, writable
+	SimPrivateControlsDebug_debug_line_width                          string = "sim/private/controls/debug/debug_line_width"                              // float, writable
+	SimPrivateControlsDebug_kill_debug_lines                          string = "sim/private/controls/debug/kill_debug_lines"                              // float, writable
+	SimPrivateControlsCars_min_cull                                   string = "sim/private/controls/cars/min_cull"                                       // float, writable
+	SimPrivateControlsAirport_taxi_straight_line_split                string = "sim/private/controls/airport/taxi_straight_line_split"                    // float, writable
+	SimPrivateControlsAirport_min_error                               string = "sim/private/controls/airport/min_error"                                   // float, writable
+	SimPrivateControlsAirport_max_error                               string = "sim/private/controls/airport/max_error"                                   // float, writable
+	SimPrivateControlsAirport_recurse_depth                           string = "sim/private/controls/airport/recurse_depth"                               // float, writable
+	SimPrivateControlsAirport_max_tight_curve                         string = "sim/private/controls/airport/max_tight_curve"                             // float, writable
+	SimPrivateControlsAirport_min_tight_curve                         string = "sim/private/controls/airport/min_tight_curve"                             // float, writable
+	SimPrivateControlsAppLightsTowers_test                            string = "sim/private/controls/app_lights/towers/test"                              // float, writable
+	SimPrivateControlsTaxiSigns_inset_divider                         string = "sim/private/controls/taxi_signs/inset_divider"                            // float, writable
+	SimPrivateControlsAtcDefault_hot_zone_length                      string = "sim/private/controls/atc/default/hot_zone_length"                         // float, writable
+	SimPrivateControlsAtcDefault_hot_zone_width                       string = "sim/private/controls/atc/default/hot_zone_width"                          // float, writable
+	SimPrivateControlsRunway_blastpad_scale_t                         string = "sim/private/controls/runway/blastpad_scale_t"                             // float, writable
+	SimPrivateControlsRunway_blastpad_scale_s                         string = "sim/private/controls/runway/blastpad_scale_s"                             // float, writable
+	SimPrivateControlsPlanet_max_ap_altitude                          string = "sim/private/controls/planet/max_ap_altitude"                              // float, writable
+	SimPrivateControlsPlanet_forward_scatter_mode                     string = "sim/private/controls/planet/forward_scatter_mode"                         // float, writable
+	SimPrivateControlsPlanet_depth_bias_forward                       string = "sim/private/controls/planet/depth_bias_forward"                           // float, writable
+	SimPrivateControlsPlanet_depth_bias                               string = "sim/private/controls/planet/depth_bias"                                   // float, writable
+	SimPrivateControlsPlanet_res_hi                                   string = "sim/private/controls/planet/res_hi"                                       // float, writable
+	SimPrivateControlsPlanet_kill_hi                                  string = "sim/private/controls/planet/kill_hi"                                      // float, writable
+	SimPrivateControlsPlanet_kill_lo                                  string = "sim/private/controls/planet/kill_lo"                                      // float, writable
+	SimPrivateControlsPlanet_wire_frame                               string = "sim/private/controls/planet/wire_frame"                                   // float, writable
+	SimPrivateControlsPlanet_dump_data                                string = "sim/private/controls/planet/dump_data"                                    // float, writable
+	SimPrivateControlsDsf_async_ter                                   string = "sim/private/controls/dsf/async_ter"                                       // float, writable
+	SimPrivateControlsDsf_always_use_autogen                          string = "sim/private/controls/dsf/always_use_autogen"                              // float, writable
+	SimPrivateControlsDsf_debug_depth                                 string = "sim/private/controls/dsf/debug_depth"                                     // float, writable
+	SimPrivateControlsDsf_upside_down_tri_cos                         string = "sim/private/controls/dsf/upside_down_tri_cos"                             // float, writable
+	SimPrivateControlsDsf_base_terrain_in_vram                        string = "sim/private/controls/dsf/base_terrain_in_vram"                            // float, writable
+	SimPrivateControlsDsf_use_dem                                     string = "sim/private/controls/dsf/use_dem"                                         // float, writable
+	SimPrivateControlsDsf_normal_rescale                              string = "sim/private/controls/dsf/normal_rescale"                                  // float, writable
+	SimPrivateControlsDsf_log_ter_load                                string = "sim/private/controls/dsf/log_ter_load"                                    // float, writable
+	SimPrivateControlsDsf_water_uv_offset                             string = "sim/private/controls/dsf/water_uv_offset"                                 // float, writable
+	SimPrivateControlsDsf_kill_fetch                                  string = "sim/private/controls/dsf/kill_fetch"                                      // float, writable
+	SimPrivateControlsDsf_auto_depth_z                                string = "sim/private/controls/dsf/auto_depth_z"                                    // float, writable
+	SimPrivateControlsDsf_auto_depth_physics                          string = "sim/private/controls/dsf/auto_depth_physics"                              // float, writable
+	SimPrivateControlsDsf_auto_depth                                  string = "sim/private/controls/dsf/auto_depth"                                      // float, writable
+	SimPrivateRemote_cmd_port                                         string = "sim/private/remote/cmd_port"                                              // int, writable
+	SimPrivateStatsGfxPipelinesTchotchke_num_pipelines                string = "sim/private/stats/gfx/pipelines/tchotchke/num_pipelines"                  // float, writable
+	SimPrivateStatsGfxPipelinesCubeFilterCompute_num_pipelines        string = "sim/private/stats/gfx/pipelines/cube_filter_compute/num_pipelines"        // float, writable
+	SimPrivateStatsGfxPipelinesCubeFilterRaster_num_pipelines         string = "sim/private/stats/gfx/pipelines/cube_filter_raster/num_pipelines"         // float, writable
+	SimPrivateStatsGfxPipelinesSsrDeferred_num_pipelines              string = "sim/private/stats/gfx/pipelines/ssr_deferred/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesSsrMesh_num_pipelines                  string = "sim/private/stats/gfx/pipelines/ssr_mesh/num_pipelines"                   // float, writable
+	SimPrivateStatsGfxPipelinesGroundLights_num_pipelines             string = "sim/private/stats/gfx/pipelines/ground_lights/num_pipelines"              // float, writable
+	SimPrivateStatsGfxPipelinesLight_num_pipelines                    string = "sim/private/stats/gfx/pipelines/light/num_pipelines"                      // float, writable
+	SimPrivateStatsGfxPipelinesLightTile_num_pipelines                string = "sim/private/stats/gfx/pipelines/light_tile/num_pipelines"                 // float, writable
+	SimPrivateStatsGfxPipelinesLightTransform_num_pipelines           string = "sim/private/stats/gfx/pipelines/light_transform/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesLightVis_num_pipelines                 string = "sim/private/stats/gfx/pipelines/light_vis/num_pipelines"                  // float, writable
+	SimPrivateStatsGfxPipelinesRain_num_pipelines                     string = "sim/private/stats/gfx/pipelines/rain/num_pipelines"                       // float, writable
+	SimPrivateStatsGfxPipelinesShadowRect_num_pipelines               string = "sim/private/stats/gfx/pipelines/shadow_rect/num_pipelines"                // float, writable
+	SimPrivateStatsGfxPipelinesDebug_num_pipelines                    string = "sim/private/stats/gfx/pipelines/debug/num_pipelines"                      // float, writable
+	SimPrivateStatsGfxPipelinesZMinmax_num_pipelines                  string = "sim/private/stats/gfx/pipelines/z_minmax/num_pipelines"                   // float, writable
+	SimPrivateStatsGfxPipelinesLumiHisto_num_pipelines                string = "sim/private/stats/gfx/pipelines/lumi_histo/num_pipelines"                 // float, writable
+	SimPrivateStatsGfxPipelinesHistoDebug_num_pipelines               string = "sim/private/stats/gfx/pipelines/histo_debug/num_pipelines"                // float, writable
+	SimPrivateStatsGfxPipelinesVegetationData_num_pipelines           string = "sim/private/stats/gfx/pipelines/vegetation_data/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesVegetationCull_num_pipelines           string = "sim/private/stats/gfx/pipelines/vegetation_cull/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesWeatherApplyCompute_num_pipelines      string = "sim/private/stats/gfx/pipelines/weather_apply_compute/num_pipelines"      // float, writable
+	SimPrivateStatsGfxPipelinesWeatherApplyRaster_num_pipelines       string = "sim/private/stats/gfx/pipelines/weather_apply_raster/num_pipelines"       // float, writable
+	SimPrivateStatsGfxPipelinesCloudUpscale_num_pipelines             string = "sim/private/stats/gfx/pipelines/cloud_upscale/num_pipelines"              // float, writable
+	SimPrivateStatsGfxPipelinesVolumetricApply_num_pipelines          string = "sim/private/stats/gfx/pipelines/volumetric_apply/num_pipelines"           // float, writable
+	SimPrivateStatsGfxPipelinesVolumetricFog_num_pipelines            string = "sim/private/stats/gfx/pipelines/volumetric_fog/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesCloudRenderRaster_num_pipelines        string = "sim/private/stats/gfx/pipelines/cloud_render_raster/num_pipelines"        // float, writable
+	SimPrivateStatsGfxPipelinesCloudRenderCompute_num_pipelines       string = "sim/private/stats/gfx/pipelines/cloud_render_compute/num_pipelines"       // float, writable
+	SimPrivateStatsGfxPipelinesCloudUpdate_num_pipelines              string = "sim/private/stats/gfx/pipelines/cloud_update/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesCloudMinify_num_pipelines              string = "sim/private/stats/gfx/pipelines/cloud_minify/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesCloudMap_num_pipelines                 string = "sim/private/stats/gfx/pipelines/cloud_map/num_pipelines"                  // float, writable
+	SimPrivateStatsGfxPipelinesCloudShadows_num_pipelines             string = "sim/private/stats/gfx/pipelines/cloud_shadows/num_pipelines"              // float, writable
+	SimPrivateStatsGfxPipelinesCloudPrecipitation_num_pipelines       string = "sim/private/stats/gfx/pipelines/cloud_precipitation/num_pipelines"        // float, writable
+	SimPrivateStatsGfxPipelinesCloudCategorize_num_pipelines          string = "sim/private/stats/gfx/pipelines/cloud_categorize/num_pipelines"           // float, writable
+	SimPrivateStatsGfxPipelinesRipple_num_pipelines                   string = "sim/private/stats/gfx/pipelines/ripple/num_pipelines"                     // float, writable
+	SimPrivateStatsGfxPipelinesRainSurface_num_pipelines              string = "sim/private/stats/gfx/pipelines/rain_surface/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesRainVisualize_num_pipelines            string = "sim/private/stats/gfx/pipelines/rain_visualize/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesRainForcesVisualize_num_pipelines      string = "sim/private/stats/gfx/pipelines/rain_forces_visualize/num_pipelines"      // float, writable
+	SimPrivateStatsGfxPipelinesRainIceAccumulation_num_pipelines      string = "sim/private/stats/gfx/pipelines/rain_ice_accumulation/num_pipelines"      // float, writable
+	SimPrivateStatsGfxPipelinesRainDropletsCompute_num_pipelines      string = "sim/private/stats/gfx/pipelines/rain_droplets_compute/num_pipelines"      // float, writable
+	SimPrivateStatsGfxPipelinesRainDropletsWipers_num_pipelines       string = "sim/private/stats/gfx/pipelines/rain_droplets_wipers/num_pipelines"       // float, writable
+	SimPrivateStatsGfxPipelinesRainForces_num_pipelines               string = "sim/private/stats/gfx/pipelines/rain_forces/num_pipelines"                // float, writable
+	SimPrivateStatsGfxPipelinesRainNormals_num_pipelines              string = "sim/private/stats/gfx/pipelines/rain_normals/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesRainDraw_num_pipelines                 string = "sim/private/stats/gfx/pipelines/rain_draw/num_pipelines"                  // float, writable
+	SimPrivateStatsGfxPipelinesComputeFft_num_pipelines               string = "sim/private/stats/gfx/pipelines/compute_fft/num_pipelines"                // float, writable
+	SimPrivateStatsGfxPipelinesCacao_num_pipelines                    string = "sim/private/stats/gfx/pipelines/cacao/num_pipelines"                      // float, writable
+	SimPrivateStatsGfxPipelinesDepthOfField_num_pipelines             string = "sim/private/stats/gfx/pipelines/depth_of_field/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesFsr_num_pipelines                      string = "sim/private/stats/gfx/pipelines/fsr/num_pipelines"                        // float, writable
+	SimPrivateStatsGfxPipelinesSectional2_num_pipelines               string = "sim/private/stats/gfx/pipelines/sectional2/num_pipelines"                 // float, writable
+	SimPrivateStatsGfxPipelinesScatterComputeT_num_pipelines          string = "sim/private/stats/gfx/pipelines/scatter_compute_t/num_pipelines"          // float, writable
+	SimPrivateStatsGfxPipelinesScatterComputeMulti_num_pipelines      string = "sim/private/stats/gfx/pipelines/scatter_compute_multi/num_pipelines"      // float, writable
+	SimPrivateStatsGfxPipelinesScatterComputeSkydome_num_pipelines    string = "sim/private/stats/gfx/pipelines/scatter_compute_skydome/num_pipelines"    // float, writable
+	SimPrivateStatsGfxPipelinesScatterComputeScattering_num_pipelines string = "sim/private/stats/gfx/pipelines/scatter_compute_scattering/num_pipelines" // float, writable
+	SimPrivateStatsGfxPipelinesScatterRenderAtmosphere_num_pipelines  string = "sim/private/stats/gfx/pipelines/scatter_render_atmosphere/num_pipelines"  // float, writable
+	SimPrivateStatsGfxPipelinesDeferredGbuf_num_pipelines             string = "sim/private/stats/gfx/pipelines/deferred_gbuf/num_pipelines"              // float, writable
+	SimPrivateStatsGfxPipelinesMsaaCategorize_num_pipelines           string = "sim/private/stats/gfx/pipelines/msaa_categorize/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesCsmResolve_num_pipelines               string = "sim/private/stats/gfx/pipelines/csm_resolve/num_pipelines"                // float, writable
+	SimPrivateStatsGfxPipelinesHdr_num_pipelines                      string = "sim/private/stats/gfx/pipelines/hdr/num_pipelines"                        // float, writable
+	SimPrivateStatsGfxPipelinesBloom_num_pipelines                    string = "sim/private/stats/gfx/pipelines/bloom/num_pipelines"                      // float, writable
+	SimPrivateStatsGfxPipelinesExposureFusion_num_pipelines           string = "sim/private/stats/gfx/pipelines/exposure_fusion/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesAirportRaster_num_pipelines            string = "sim/private/stats/gfx/pipelines/airport_raster/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesAcfMapIcon_num_pipelines               string = "sim/private/stats/gfx/pipelines/acf_map_icon/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesOceanSpectrum_num_pipelines            string = "sim/private/stats/gfx/pipelines/ocean_spectrum/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesOceanBakeTextures_num_pipelines        string = "sim/private/stats/gfx/pipelines/ocean_bake_textures/num_pipelines"        // float, writable
+	SimPrivateStatsGfxPipelinesOceanVariance_num_pipelines            string = "sim/private/stats/gfx/pipelines/ocean_variance/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesOceanMeshData_num_pipelines            string = "sim/private/stats/gfx/pipelines/ocean_mesh_data/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesOceanMeshBake_num_pipelines            string = "sim/private/stats/gfx/pipelines/ocean_mesh_bake/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesOceanShading_num_pipelines             string = "sim/private/stats/gfx/pipelines/ocean_shading/num_pipelines"              // float, writable
+	SimPrivateStatsGfxPipelinesOceanMetaData_num_pipelines            string = "sim/private/stats/gfx/pipelines/ocean_meta_data/num_pipelines"            // float, writable
+	SimPrivateStatsGfxPipelinesOceanReadback_num_pipelines            string = "sim/private/stats/gfx/pipelines/ocean_readback/num_pipelines"             // float, writable
+	SimPrivateStatsGfxPipelinesPlanet_num_pipelines                   string = "sim/private/stats/gfx/pipelines/planet/num_pipelines"                     // float, writable
+	SimPrivateStatsGfxPipelinesDome_num_pipelines                     string = "sim/private/stats/gfx/pipelines/dome/num_pipelines"                       // float, writable
+	SimPrivateStatsGfxPipelinesAstronomical_num_pipelines             string = "sim/private/stats/gfx/pipelines/astronomical/num_pipelines"               // float, writable
+	SimPrivateStatsGfxPipelinesParticle_num_pipelines                 string = "sim/private/stats/gfx/pipelines/particle/num_pipelines"                   // float, writable
+	SimPrivateStatsGfxPipelinesManip3d_num_pipelines                  string = "sim/private/stats/gfx/pipelines/manip3d/num_pipelines"                    // float, writable
+)
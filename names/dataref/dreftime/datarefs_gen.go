@@ -0,0 +1,32 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package dreftime provides the known dataref names under sim/time/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package dreftime
+
+const (
+	SimTime_timer_is_running_sec          string = "sim/time/timer_is_running_sec"          // int, writable
+	SimTime_total_running_time_sec        string = "sim/time/total_running_time_sec"        // float, writable
+	SimTime_total_flight_time_sec         string = "sim/time/total_flight_time_sec"         // float, writable
+	SimTime_timer_elapsed_time_sec        string = "sim/time/timer_elapsed_time_sec"        // float, writable
+	SimTime_local_time_sec                string = "sim/time/local_time_sec"                // float
+	SimTime_zulu_time_sec                 string = "sim/time/zulu_time_sec"                 // float, writable
+	SimTime_local_date_days               string = "sim/time/local_date_days"               // int, writable
+	SimTime_use_system_time               string = "sim/time/use_system_time"               // int, writable
+	SimTime_paused                        string = "sim/time/paused"                        // int
+	SimTime_sim_speed                     string = "sim/time/sim_speed"                     // int, writable
+	SimTime_sim_speed_actual              string = "sim/time/sim_speed_actual"              // float
+	SimTime_sim_speed_actual_ogl          string = "sim/time/sim_speed_actual_ogl"          // float
+	SimTime_ground_speed                  string = "sim/time/ground_speed"                  // int, writable
+	SimTime_ground_speed_flt              string = "sim/time/ground_speed_flt"              // float, writable
+	SimTime_hobbs_time                    string = "sim/time/hobbs_time"                    // float, writable
+	SimTime_is_in_replay                  string = "sim/time/is_in_replay"                  // int
+	SimTime_framerate_period              string = "sim/time/framerate_period"              // float
+	SimTime_gpu_time_per_frame_sec_approx string = "sim/time/gpu_time_per_frame_sec_approx" // float
+)
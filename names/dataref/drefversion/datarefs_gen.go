@@ -0,0 +1,18 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefversion provides the known dataref names under sim/version/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefversion
+
+const (
+	SimVersion_sim_build_string         string = "sim/version/sim_build_string"         // data
+	SimVersion_xplm_build_string        string = "sim/version/xplm_build_string"        // data
+	SimVersion_xplanee_internal_version string = "sim/version/xplanee_internal_version" // int
+	SimVersion_xplane_internal_version  string = "sim/version/xplane_internal_version"  // int
+)
@@ -0,0 +1,98 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefweapons provides the known dataref names under sim/weapons/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefweapons
+
+const (
+	SimWeapons_weapon_count                string = "sim/weapons/weapon_count"                // int
+	SimWeapons_type                        string = "sim/weapons/type"                        // int_array, writable
+	SimWeapons_free_flyer                  string = "sim/weapons/free_flyer"                  // int_array
+	SimWeapons_action_mode                 string = "sim/weapons/action_mode"                 // int_array
+	SimWeapons_x_wpn_att                   string = "sim/weapons/x_wpn_att"                   // float_array, writable
+	SimWeapons_y_wpn_att                   string = "sim/weapons/y_wpn_att"                   // float_array, writable
+	SimWeapons_z_wpn_att                   string = "sim/weapons/z_wpn_att"                   // float_array, writable
+	SimWeapons_cgY                         string = "sim/weapons/cgY"                         // float_array, writable
+	SimWeapons_cgZ                         string = "sim/weapons/cgZ"                         // float_array, writable
+	SimWeapons_las_range                   string = "sim/weapons/las_range"                   // float_array, writable
+	SimWeapons_conv_range                  string = "sim/weapons/conv_range"                  // float_array, writable
+	SimWeapons_bul_rounds_per_sec          string = "sim/weapons/bul_rounds_per_sec"          // float_array, writable
+	SimWeapons_bul_rounds                  string = "sim/weapons/bul_rounds"                  // float_array, writable
+	SimWeapons_bul_muzzle_speed            string = "sim/weapons/bul_muzzle_speed"            // float_array, writable
+	SimWeapons_bul_area                    string = "sim/weapons/bul_area"                    // float_array, writable
+	SimWeapons_added_mass                  string = "sim/weapons/added_mass"                  // float_array, writable
+	SimWeapons_total_weapon_mass_max       string = "sim/weapons/total_weapon_mass_max"       // float_array, writable
+	SimWeapons_fuel_warhead_mass_max       string = "sim/weapons/fuel_warhead_mass_max"       // float_array, writable
+	SimWeapons_warhead_type                string = "sim/weapons/warhead_type"                // int_array, writable
+	SimWeapons_mis_drag_co                 string = "sim/weapons/mis_drag_co"                 // float_array, writable
+	SimWeapons_mis_drag_chute_S            string = "sim/weapons/mis_drag_chute_S"            // float_array, writable
+	SimWeapons_mis_cone_width              string = "sim/weapons/mis_cone_width"              // float_array, writable
+	SimWeapons_mis_crat_per_deg_bore       string = "sim/weapons/mis_crat_per_deg_bore"       // float_array, writable
+	SimWeapons_mis_crat_per_degpersec_bore string = "sim/weapons/mis_crat_per_degpersec_bore" // float_array, writable
+	SimWeapons_mis_crat_per_degpersec      string = "sim/weapons/mis_crat_per_degpersec"      // float_array, writable
+	SimWeapons_gun_del_psi_deg_max         string = "sim/weapons/gun_del_psi_deg_max"         // float_array, writable
+	SimWeapons_gun_del_the_deg_max         string = "sim/weapons/gun_del_the_deg_max"         // float_array, writable
+	SimWeapons_s_frn                       string = "sim/weapons/s_frn"                       // float_array, writable
+	SimWeapons_s_sid                       string = "sim/weapons/s_sid"                       // float_array, writable
+	SimWeapons_s_top                       string = "sim/weapons/s_top"                       // float_array, writable
+	SimWeapons_X_body_aero                 string = "sim/weapons/X_body_aero"                 // float_array, writable
+	SimWeapons_Y_body_aero                 string = "sim/weapons/Y_body_aero"                 // float_array, writable
+	SimWeapons_Z_body_aero                 string = "sim/weapons/Z_body_aero"                 // float_array, writable
+	SimWeapons_Jxx_unitmass                string = "sim/weapons/Jxx_unitmass"                // float_array, writable
+	SimWeapons_Jyy_unitmass                string = "sim/weapons/Jyy_unitmass"                // float_array, writable
+	SimWeapons_Jzz_unitmass                string = "sim/weapons/Jzz_unitmass"                // float_array, writable
+	SimWeapons_target_index                string = "sim/weapons/target_index"                // int_array, writable
+	SimWeapons_targ_lat                    string = "sim/weapons/targ_lat"                    // float_array, writable
+	SimWeapons_targ_lon                    string = "sim/weapons/targ_lon"                    // float_array, writable
+	SimWeapons_targ_h                      string = "sim/weapons/targ_h"                      // float_array, writable
+	SimWeapons_del_psi                     string = "sim/weapons/del_psi"                     // float_array, writable
+	SimWeapons_del_the                     string = "sim/weapons/del_the"                     // float_array, writable
+	SimWeapons_rudd_rat                    string = "sim/weapons/rudd_rat"                    // float_array, writable
+	SimWeapons_elev_rat                    string = "sim/weapons/elev_rat"                    // float_array, writable
+	SimWeapons_V_msc                       string = "sim/weapons/V_msc"                       // float_array, writable
+	SimWeapons_AV_msc                      string = "sim/weapons/AV_msc"                      // float_array, writable
+	SimWeapons_dist_targ                   string = "sim/weapons/dist_targ"                   // float_array, writable
+	SimWeapons_dist_point                  string = "sim/weapons/dist_point"                  // float_array, writable
+	SimWeapons_time_point                  string = "sim/weapons/time_point"                  // float_array, writable
+	SimWeapons_fx_axis                     string = "sim/weapons/fx_axis"                     // float_array, writable
+	SimWeapons_fy_axis                     string = "sim/weapons/fy_axis"                     // float_array, writable
+	SimWeapons_fz_axis                     string = "sim/weapons/fz_axis"                     // float_array, writable
+	SimWeapons_vx                          string = "sim/weapons/vx"                          // float_array, writable
+	SimWeapons_vy                          string = "sim/weapons/vy"                          // float_array, writable
+	SimWeapons_vz                          string = "sim/weapons/vz"                          // float_array, writable
+	SimWeapons_x                           string = "sim/weapons/x"                           // float_array, writable
+	SimWeapons_y                           string = "sim/weapons/y"                           // float_array, writable
+	SimWeapons_z                           string = "sim/weapons/z"                           // float_array, writable
+	SimWeapons_L                           string = "sim/weapons/L"                           // float_array, writable
+	SimWeapons_M                           string = "sim/weapons/M"                           // float_array, writable
+	SimWeapons_N                           string = "sim/weapons/N"                           // float_array, writable
+	SimWeapons_Prad                        string = "sim/weapons/Prad"                        // float_array, writable
+	SimWeapons_Qrad                        string = "sim/weapons/Qrad"                        // float_array, writable
+	SimWeapons_Rrad                        string = "sim/weapons/Rrad"                        // float_array, writable
+	SimWeapons_the                         string = "sim/weapons/the"                         // float_array
+	SimWeapons_psi                         string = "sim/weapons/psi"                         // float_array
+	SimWeapons_phi                         string = "sim/weapons/phi"                         // float_array
+	SimWeapons_next_bull_time              string = "sim/weapons/next_bull_time"              // float_array, writable
+	SimWeapons_total_weapon_mass_now       string = "sim/weapons/total_weapon_mass_now"       // float_array, writable
+	SimWeapons_fuel_warhead_mass_now       string = "sim/weapons/fuel_warhead_mass_now"       // float_array, writable
+	SimWeapons_mis_thrust1                 string = "sim/weapons/mis_thrust1"                 // float_array, writable
+	SimWeapons_mis_thrust2                 string = "sim/weapons/mis_thrust2"                 // float_array, writable
+	SimWeapons_mis_thrust3                 string = "sim/weapons/mis_thrust3"                 // float_array, writable
+	SimWeapons_mis_duration1               string = "sim/weapons/mis_duration1"               // float_array, writable
+	SimWeapons_mis_duration2               string = "sim/weapons/mis_duration2"               // float_array, writable
+	SimWeapons_mis_duration3               string = "sim/weapons/mis_duration3"               // float_array, writable
+	SimWeapons_q1                          string = "sim/weapons/q1"                          // float_array, writable
+	SimWeapons_q2                          string = "sim/weapons/q2"                          // float_array, writable
+	SimWeapons_q3                          string = "sim/weapons/q3"                          // float_array, writable
+	SimWeapons_q4                          string = "sim/weapons/q4"                          // float_array, writable
+	SimWeapons_armed                       string = "sim/weapons/armed"                       // int_array, writable
+	SimWeapons_firing                      string = "sim/weapons/firing"                      // int_array
+	SimWeaponsShell_is_attached            string = "sim/weapons/shell/is_attached"           // int
+	SimWeaponsShell_thrust_rat             string = "sim/weapons/shell/thrust_rat"            // float
+)
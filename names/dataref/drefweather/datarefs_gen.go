@@ -0,0 +1,153 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefweather provides the known dataref names under sim/weather/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefweather
+
+const (
+	SimWeather_cloud_type_0                               string = "sim/weather/cloud_type[0]"                               // int, writable
+	SimWeather_cloud_type_1                               string = "sim/weather/cloud_type[1]"                               // int, writable
+	SimWeather_cloud_type_2                               string = "sim/weather/cloud_type[2]"                               // int, writable
+	SimWeather_cloud_coverage_0                           string = "sim/weather/cloud_coverage[0]"                           // float, writable
+	SimWeather_cloud_coverage_1                           string = "sim/weather/cloud_coverage[1]"                           // float, writable
+	SimWeather_cloud_coverage_2                           string = "sim/weather/cloud_coverage[2]"                           // float, writable
+	SimWeather_cloud_base_msl_m_0                         string = "sim/weather/cloud_base_msl_m[0]"                         // float, writable
+	SimWeather_cloud_base_msl_m_1                         string = "sim/weather/cloud_base_msl_m[1]"                         // float, writable
+	SimWeather_cloud_base_msl_m_2                         string = "sim/weather/cloud_base_msl_m[2]"                         // float, writable
+	SimWeather_cloud_tops_msl_m_0                         string = "sim/weather/cloud_tops_msl_m[0]"                         // float, writable
+	SimWeather_cloud_tops_msl_m_1                         string = "sim/weather/cloud_tops_msl_m[1]"                         // float, writable
+	SimWeather_cloud_tops_msl_m_2                         string = "sim/weather/cloud_tops_msl_m[2]"                         // float, writable
+	SimWeather_visibility_reported_m                      string = "sim/weather/visibility_reported_m"                       // float, writable
+	SimWeather_rain_percent                               string = "sim/weather/rain_percent"                                // float, writable
+	SimWeather_wind_turbulence_percent                    string = "sim/weather/wind_turbulence_percent"                     // float, writable
+	SimWeather_barometer_sealevel_inhg                    string = "sim/weather/barometer_sealevel_inhg"                     // float, writable
+	SimWeather_has_real_weather_bool                      string = "sim/weather/has_real_weather_bool"                       // int
+	SimWeather_use_real_weather_bool                      string = "sim/weather/use_real_weather_bool"                       // int
+	SimWeather_download_real_weather                      string = "sim/weather/download_real_weather"                       // int
+	SimWeather_sigma                                      string = "sim/weather/sigma"                                       // float
+	SimWeather_rho                                        string = "sim/weather/rho"                                         // float
+	SimWeather_barometer_current_inhg                     string = "sim/weather/barometer_current_inhg"                      // float
+	SimWeather_gravity_mss                                string = "sim/weather/gravity_mss"                                 // float
+	SimWeather_speed_sound_ms                             string = "sim/weather/speed_sound_ms"                              // float
+	SimWeather_wind_altitude_msl_m_0                      string = "sim/weather/wind_altitude_msl_m[0]"                      // float, writable
+	SimWeather_wind_altitude_msl_m_1                      string = "sim/weather/wind_altitude_msl_m[1]"                      // float, writable
+	SimWeather_wind_altitude_msl_m_2                      string = "sim/weather/wind_altitude_msl_m[2]"                      // float, writable
+	SimWeather_wind_direction_degt_0                      string = "sim/weather/wind_direction_degt[0]"                      // float, writable
+	SimWeather_wind_direction_degt_1                      string = "sim/weather/wind_direction_degt[1]"                      // float, writable
+	SimWeather_wind_direction_degt_2                      string = "sim/weather/wind_direction_degt[2]"                      // float, writable
+	SimWeather_wind_speed_kt_0                            string = "sim/weather/wind_speed_kt[0]"                            // float, writable
+	SimWeather_wind_speed_kt_1                            string = "sim/weather/wind_speed_kt[1]"                            // float, writable
+	SimWeather_wind_speed_kt_2                            string = "sim/weather/wind_speed_kt[2]"                            // float, writable
+	SimWeather_shear_direction_degt_0                     string = "sim/weather/shear_direction_degt[0]"                     // float, writable
+	SimWeather_shear_direction_degt_1                     string = "sim/weather/shear_direction_degt[1]"                     // float, writable
+	SimWeather_shear_direction_degt_2                     string = "sim/weather/shear_direction_degt[2]"                     // float, writable
+	SimWeather_shear_speed_kt_0                           string = "sim/weather/shear_speed_kt[0]"                           // float, writable
+	SimWeather_shear_speed_kt_1                           string = "sim/weather/shear_speed_kt[1]"                           // float, writable
+	SimWeather_shear_speed_kt_2                           string = "sim/weather/shear_speed_kt[2]"                           // float, writable
+	SimWeather_turbulence_0                               string = "sim/weather/turbulence[0]"                               // float, writable
+	SimWeather_turbulence_1                               string = "sim/weather/turbulence[1]"                               // float, writable
+	SimWeather_turbulence_2                               string = "sim/weather/turbulence[2]"                               // float, writable
+	SimWeather_wave_amplitude                             string = "sim/weather/wave_amplitude"                              // float, writable
+	SimWeather_wave_length                                string = "sim/weather/wave_length"                                 // float
+	SimWeather_wave_speed                                 string = "sim/weather/wave_speed"                                  // float
+	SimWeather_wave_dir                                   string = "sim/weather/wave_dir"                                    // int, writable
+	SimWeather_temperature_sealevel_c                     string = "sim/weather/temperature_sealevel_c"                      // float, writable
+	SimWeather_temperature_tropo_c                        string = "sim/weather/temperature_tropo_c"                         // float
+	SimWeather_tropo_alt_mtr                              string = "sim/weather/tropo_alt_mtr"                               // float
+	SimWeather_dewpoi_sealevel_c                          string = "sim/weather/dewpoi_sealevel_c"                           // float, writable
+	SimWeather_relative_humidity_sealevel_percent         string = "sim/weather/relative_humidity_sealevel_percent"          // float
+	SimWeather_temperature_ambient_c                      string = "sim/weather/temperature_ambient_c"                       // float
+	SimWeather_temperature_le_c                           string = "sim/weather/temperature_le_c"                            // float
+	SimWeather_thermal_rate_ms                            string = "sim/weather/thermal_rate_ms"                             // float, writable
+	SimWeather_runway_friction                            string = "sim/weather/runway_friction"                             // float, writable
+	SimWeather_wind_direction_degt                        string = "sim/weather/wind_direction_degt"                         // float
+	SimWeather_wind_speed_kt                              string = "sim/weather/wind_speed_kt"                               // float
+	SimWeather_wind_now_x_msc                             string = "sim/weather/wind_now_x_msc"                              // float
+	SimWeather_wind_now_y_msc                             string = "sim/weather/wind_now_y_msc"                              // float
+	SimWeather_wind_now_z_msc                             string = "sim/weather/wind_now_z_msc"                              // float
+	SimWeather_precipitation_on_aircraft_ratio            string = "sim/weather/precipitation_on_aircraft_ratio"             // float
+	SimWeatherAircraft_visibility_reported_sm             string = "sim/weather/aircraft/visibility_reported_sm"             // float
+	SimWeatherAircraft_altimeter_temperature_error        string = "sim/weather/aircraft/altimeter_temperature_error"        // float
+	SimWeatherAircraft_wind_altitude_msl_m                string = "sim/weather/aircraft/wind_altitude_msl_m"                // float_array
+	SimWeatherAircraft_wind_speed_kts                     string = "sim/weather/aircraft/wind_speed_kts"                     // float_array
+	SimWeatherAircraft_wind_direction_degt                string = "sim/weather/aircraft/wind_direction_degt"                // float_array
+	SimWeatherAircraft_shear_speed_kts                    string = "sim/weather/aircraft/shear_speed_kts"                    // float_array
+	SimWeatherAircraft_shear_direction_degt               string = "sim/weather/aircraft/shear_direction_degt"               // float_array
+	SimWeatherAircraft_turbulence                         string = "sim/weather/aircraft/turbulence"                         // float_array
+	SimWeatherAircraft_dewpoint_deg_c                     string = "sim/weather/aircraft/dewpoint_deg_c"                     // float_array
+	SimWeatherAircraft_relative_humidity_sealevel_percent string = "sim/weather/aircraft/relative_humidity_sealevel_percent" // float
+	SimWeatherAircraft_qnh_pas                            string = "sim/weather/aircraft/qnh_pas"                            // float
+	SimWeatherAircraft_temperatures_aloft_deg_c           string = "sim/weather/aircraft/temperatures_aloft_deg_c"           // float_array
+	SimWeatherAircraft_cloud_type                         string = "sim/weather/aircraft/cloud_type"                         // float_array
+	SimWeatherAircraft_cloud_coverage_percent             string = "sim/weather/aircraft/cloud_coverage_percent"             // float_array
+	SimWeatherAircraft_cloud_base_msl_m                   string = "sim/weather/aircraft/cloud_base_msl_m"                   // float_array
+	SimWeatherAircraft_cloud_tops_msl_m                   string = "sim/weather/aircraft/cloud_tops_msl_m"                   // float_array
+	SimWeatherAircraft_barometer_current_pas              string = "sim/weather/aircraft/barometer_current_pas"              // float
+	SimWeatherAircraft_wind_now_direction_degt            string = "sim/weather/aircraft/wind_now_direction_degt"            // float
+	SimWeatherAircraft_wind_now_speed_msc                 string = "sim/weather/aircraft/wind_now_speed_msc"                 // float
+	SimWeatherAircraft_wind_now_x_msc                     string = "sim/weather/aircraft/wind_now_x_msc"                     // float
+	SimWeatherAircraft_wind_now_y_msc                     string = "sim/weather/aircraft/wind_now_y_msc"                     // float
+	SimWeatherAircraft_wind_now_z_msc                     string = "sim/weather/aircraft/wind_now_z_msc"                     // float
+	SimWeatherAircraft_precipitation_on_aircraft_ratio    string = "sim/weather/aircraft/precipitation_on_aircraft_ratio"    // float
+	SimWeatherAircraft_snow_on_aircraft_ratio             string = "sim/weather/aircraft/snow_on_aircraft_ratio"             // float
+	SimWeatherAircraft_hail_on_aircraft_ratio             string = "sim/weather/aircraft/hail_on_aircraft_ratio"             // float
+	SimWeatherAircraft_thermal_rate_ms                    string = "sim/weather/aircraft/thermal_rate_ms"                    // float
+	SimWeatherAircraft_wave_amplitude                     string = "sim/weather/aircraft/wave_amplitude"                     // float
+	SimWeatherAircraft_wave_length                        string = "sim/weather/aircraft/wave_length"                        // float
+	SimWeatherAircraft_wave_speed                         string = "sim/weather/aircraft/wave_speed"                         // float
+	SimWeatherAircraft_wave_dir                           string = "sim/weather/aircraft/wave_dir"                           // float
+	SimWeatherAircraft_gravity_mss                        string = "sim/weather/aircraft/gravity_mss"                        // float
+	SimWeatherAircraft_speed_sound_ms                     string = "sim/weather/aircraft/speed_sound_ms"                     // float
+	SimWeatherAircraft_temperature_ambient_deg_c          string = "sim/weather/aircraft/temperature_ambient_deg_c"          // float
+	SimWeatherAircraft_temperature_leadingedge_deg_c      string = "sim/weather/aircraft/temperature_leadingedge_deg_c"      // float
+	SimWeatherRegion_visibility_reported_sm               string = "sim/weather/region/visibility_reported_sm"               // float, writable
+	SimWeatherRegion_sealevel_pressure_pas                string = "sim/weather/region/sealevel_pressure_pas"                // float, writable
+	SimWeatherRegion_sealevel_temperature_c               string = "sim/weather/region/sealevel_temperature_c"               // float, writable
+	SimWeatherRegion_qnh_base_elevation                   string = "sim/weather/region/qnh_base_elevation"                   // float, writable
+	SimWeatherRegion_qnh_pas                              string = "sim/weather/region/qnh_pas"                              // float, writable
+	SimWeatherRegion_rain_percent                         string = "sim/weather/region/rain_percent"                         // float, writable
+	SimWeatherRegion_change_mode                          string = "sim/weather/region/change_mode"                          // int, writable
+	SimWeatherRegion_weather_source                       string = "sim/weather/region/weather_source"                       // int
+	SimWeatherRegion_update_immediately                   string = "sim/weather/region/update_immediately"                   // int, writable
+	SimWeatherRegion_atmosphere_alt_levels_m              string = "sim/weather/region/atmosphere_alt_levels_m"              // float_array
+	SimWeatherRegion_wind_altitude_msl_m                  string = "sim/weather/region/wind_altitude_msl_m"                  // float_array, writable
+	SimWeatherRegion_wind_speed_msc                       string = "sim/weather/region/wind_speed_msc"                       // float_array, writable
+	SimWeatherRegion_wind_direction_degt                  string = "sim/weather/region/wind_direction_degt"                  // float_array, writable
+	SimWeatherRegion_shear_speed_msc                      string = "sim/weather/region/shear_speed_msc"                      // float_array, writable
+	SimWeatherRegion_shear_direction_degt                 string = "sim/weather/region/shear_direction_degt"                 // float_array, writable
+	SimWeatherRegion_turbulence                           string = "sim/weather/region/turbulence"                           // float_array, writable
+	SimWeatherRegion_dewpoint_deg_c                       string = "sim/weather/region/dewpoint_deg_c"                       // float_array, writable
+	SimWeatherRegion_temperature_altitude_msl_m           string = "sim/weather/region/temperature_altitude_msl_m"           // float_array, writable
+	SimWeatherRegion_temperatures_aloft_deg_c             string = "sim/weather/region/temperatures_aloft_deg_c"             // float_array, writable
+	SimWeatherRegion_cloud_type                           string = "sim/weather/region/cloud_type"                           // float_array, writable
+	SimWeatherRegion_cloud_coverage_percent               string = "sim/weather/region/cloud_coverage_percent"               // float_array, writable
+	SimWeatherRegion_cloud_base_msl_m                     string = "sim/weather/region/cloud_base_msl_m"                     // float_array, writable
+	SimWeatherRegion_cloud_tops_msl_m                     string = "sim/weather/region/cloud_tops_msl_m"                     // float_array, writable
+	SimWeatherRegion_tropo_temp_c                         string = "sim/weather/region/tropo_temp_c"                         // float, writable
+	SimWeatherRegion_tropo_alt_m                          string = "sim/weather/region/tropo_alt_m"                          // float, writable
+	SimWeatherRegion_thermal_rate_ms                      string = "sim/weather/region/thermal_rate_ms"                      // float, writable
+	SimWeatherRegion_wave_amplitude                       string = "sim/weather/region/wave_amplitude"                       // float, writable
+	SimWeatherRegion_wave_length                          string = "sim/weather/region/wave_length"                          // float
+	SimWeatherRegion_wave_speed                           string = "sim/weather/region/wave_speed"                           // float
+	SimWeatherRegion_wave_dir                             string = "sim/weather/region/wave_dir"                             // float, writable
+	SimWeatherRegion_runway_friction                      string = "sim/weather/region/runway_friction"                      // float, writable
+	SimWeatherRegion_variability_pct                      string = "sim/weather/region/variability_pct"                      // float, writable
+	SimWeatherRegion_weather_preset                       string = "sim/weather/region/weather_preset"                       // int, writable
+	SimWeatherView_rain_ratio                             string = "sim/weather/view/rain_ratio"                             // float
+	SimWeatherView_snow_ratio                             string = "sim/weather/view/snow_ratio"                             // float
+	SimWeatherView_hail_ratio                             string = "sim/weather/view/hail_ratio"                             // float
+	SimWeatherView_urban_ratio                            string = "sim/weather/view/urban_ratio"                            // float
+	SimWeatherView_wind_speed_msc                         string = "sim/weather/view/wind_speed_msc"                         // float
+	SimWeatherView_wind_relative_heading_deg              string = "sim/weather/view/wind_relative_heading_deg"              // float
+	SimWeatherView_wind_relative_pitch_deg                string = "sim/weather/view/wind_relative_pitch_deg"                // float
+	SimWeatherView_wind_base_speed_kts                    string = "sim/weather/view/wind_base_speed_kts"                    // float
+	SimWeatherView_wind_gust_kts                          string = "sim/weather/view/wind_gust_kts"                          // float
+	SimWeatherView_wind_shear_deg                         string = "sim/weather/view/wind_shear_deg"                         // float
+	SimWeatherView_temperature_C                          string = "sim/weather/view/temperature_C"                          // float
+)
@@ -0,0 +1,32 @@
+//
+// This file is generated, and changes made directly to this file will be overwritten.  To update
+// this file, modify either data/datarefs.json or gen_names.go and then execute 'go generate'.
+
+// Package drefworld provides the known dataref names under sim/world/ as string constants to
+// limit repetition of string literals and the risk of typos that can't be caught during
+// lint/compile.  The value type and writability of each dataref is noted beside its constant.
+//
+// Importing only the domains which are needed keeps builds small.  Package dataref provides every
+// known name.
+package drefworld
+
+const (
+	SimWorldBoat_velocity_msc             string = "sim/world/boat/velocity_msc"             // float_array, writable
+	SimWorldBoat_x_mtr                    string = "sim/world/boat/x_mtr"                    // float_array, writable
+	SimWorldBoat_y_mtr                    string = "sim/world/boat/y_mtr"                    // float_array, writable
+	SimWorldBoat_z_mtr                    string = "sim/world/boat/z_mtr"                    // float_array, writable
+	SimWorldBoat_heading_deg              string = "sim/world/boat/heading_deg"              // float_array, writable
+	SimWorldBoat_frigate_deck_height_mtr  string = "sim/world/boat/frigate_deck_height_mtr"  // float, writable
+	SimWorldBoat_frigate_ILS_offset_x_mtr string = "sim/world/boat/frigate_ILS_offset_x_mtr" // float, writable
+	SimWorldBoat_frigate_ILS_offset_z_mtr string = "sim/world/boat/frigate_ILS_offset_z_mtr" // float, writable
+	SimWorldBoat_carrier_deck_height_mtr  string = "sim/world/boat/carrier_deck_height_mtr"  // float, writable
+	SimWorldBoat_carrier_ILS_offset_x_mtr string = "sim/world/boat/carrier_ILS_offset_x_mtr" // float, writable
+	SimWorldBoat_carrier_ILS_offset_z_mtr string = "sim/world/boat/carrier_ILS_offset_z_mtr" // float, writable
+	SimWorldBoat_carrier_approach_heading string = "sim/world/boat/carrier_approach_heading" // float, writable
+	SimWorldBoat_carrier_catshot_status   string = "sim/world/boat/carrier_catshot_status"   // int
+	SimWorldBoat_total_boat_count         string = "sim/world/boat/total_boat_count"         // int
+	SimWorldWinch_winch_ramp_up_time_sec  string = "sim/world/winch/winch_ramp_up_time_sec"  // float, writable
+	SimWorldWinch_winch_speed_knots       string = "sim/world/winch/winch_speed_knots"       // float, writable
+	SimWorldWinch_winch_initial_length    string = "sim/world/winch/winch_initial_length"    // float, writable
+	SimWorldWinch_winch_max_bhp           string = "sim/world/winch/winch_max_bhp"           // float, writable
+)
@@ -0,0 +1,159 @@
+package xpweb
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseValue converts a user-provided string, such as a CLI argument or a configuration file
+// value, into a Go value suitable for writing to a dataref of the specified type.
+//
+//   - int values may be decimal or hexadecimal (0x1F), and must not have a fractional part
+//   - float and double values may use either a decimal point (1.5) or a decimal comma (1,5), but
+//     digit grouping (1,234.5) is rejected as ambiguous
+//   - booleans (true/false, on/off, yes/no) are accepted by numeric types as 1 or 0
+//   - array values are a list of elements, optionally enclosed in brackets, separated by commas or
+//     whitespace; if elements use decimal commas, they must be separated by semicolons instead
+//     (e.g. [1,5; 2,5])
+//   - data values are returned verbatim as a string
+//
+// The returned value is an int, float64, []int, []float64, or string.
+func ParseValue(input string, valueType ValueType) (any, error) {
+	switch valueType {
+	case ValueTypeInt:
+		return parseInt(input)
+	case ValueTypeFloat, ValueTypeDouble:
+		return parseFloat(input)
+	case ValueTypeIntArray:
+		return parseArray(input, parseInt)
+	case ValueTypeFloatArray:
+		return parseArray(input, parseFloat)
+	case ValueTypeData:
+		return input, nil
+	default:
+		return nil, fmt.Errorf("unsupported value type: %s", valueType)
+	}
+}
+
+// ParseDatarefValue behaves like [ParseValue], using the cached value type of the dataref with the
+// specified name.
+func (c *Client) ParseDatarefValue(name string, input string) (any, error) {
+	dref, err := c.LookupDataref(name)
+	if err != nil {
+		return nil, err
+	}
+	return ParseValue(input, dref.ValueType)
+}
+
+// parseBool returns 1 or 0 if the specified string is a recognized boolean word.
+func parseBool(s string) (int, bool) {
+	switch strings.ToLower(s) {
+	case "true", "on", "yes":
+		return 1, true
+	case "false", "off", "no":
+		return 0, true
+	}
+	return 0, false
+}
+
+func parseInt(input string) (int, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return 0, fmt.Errorf("invalid int value %q: empty value", input)
+	}
+	if b, ok := parseBool(s); ok {
+		return b, nil
+	}
+
+	// base 0 accepts 0x, 0o, and 0b prefixes, but treats a leading zero as octal, which is rarely
+	// what a user entering a value like 0755 for a transponder code means
+	base := 10
+	digits := s
+	sign := ""
+	if digits[0] == '-' || digits[0] == '+' {
+		sign, digits = digits[:1], digits[1:]
+	}
+	if lower := strings.ToLower(digits); strings.HasPrefix(lower, "0x") {
+		base, digits = 16, digits[2:]
+	}
+
+	val, err := strconv.ParseInt(sign+digits, base, 0)
+	if err == nil {
+		return int(val), nil
+	}
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("invalid int value %q: out of range", input)
+	}
+
+	// give a more helpful error for values which are numeric but not integers
+	if f, ferr := parseFloat(s); ferr == nil && f != math.Trunc(f) {
+		return 0, fmt.Errorf("invalid int value %q: fractional value", input)
+	} else if ferr == nil {
+		// the bounds of int are powers of two, so are exactly representable as float64
+		if f < float64(math.MinInt) || f >= -float64(math.MinInt) {
+			return 0, fmt.Errorf("invalid int value %q: out of range", input)
+		}
+		return int(f), nil
+	}
+	return 0, fmt.Errorf("invalid int value %q: not a number", input)
+}
+
+func parseFloat(input string) (float64, error) {
+	s := strings.TrimSpace(input)
+	if s == "" {
+		return 0, fmt.Errorf("invalid float value %q: empty value", input)
+	}
+	if b, ok := parseBool(s); ok {
+		return float64(b), nil
+	}
+
+	hasComma := strings.Contains(s, ",")
+	hasPoint := strings.Contains(s, ".")
+	switch {
+	case hasComma && hasPoint:
+		return 0, fmt.Errorf("invalid float value %q: ambiguous digit grouping", input)
+	case strings.Count(s, ",") > 1:
+		return 0, fmt.Errorf("invalid float value %q: multiple decimal commas", input)
+	case hasComma:
+		s = strings.Replace(s, ",", ".", 1)
+	}
+
+	val, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid float value %q: not a number", input)
+	}
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return 0, fmt.Errorf("invalid float value %q: not a finite number", input)
+	}
+	return val, nil
+}
+
+func parseArray[T any](input string, parseElem func(string) (T, error)) ([]T, error) {
+	s := strings.TrimSpace(input)
+	if strings.HasPrefix(s, "[") != strings.HasSuffix(s, "]") {
+		return nil, fmt.Errorf("invalid array value %q: unbalanced brackets", input)
+	}
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	var elems []string
+	if strings.Contains(s, ";") {
+		elems = strings.Split(s, ";")
+	} else {
+		elems = strings.FieldsFunc(s, func(r rune) bool {
+			return r == ',' || r == ' ' || r == '\t'
+		})
+	}
+
+	vals := make([]T, 0, len(elems))
+	for idx, elem := range elems {
+		val, err := parseElem(elem)
+		if err != nil {
+			return nil, fmt.Errorf("invalid array element %d: %w", idx, err)
+		}
+		vals = append(vals, val)
+	}
+	return vals, nil
+}
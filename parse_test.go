@@ -0,0 +1,33 @@
+package xpweb
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseInt(t *testing.T) {
+	for _, tc := range []struct {
+		input string
+		want  int
+		err   string
+	}{
+		{"42", 42, ""},
+		{"-0x1F", -31, ""},
+		{"1e3", 1000, ""},
+		{"1.5", 0, "fractional"},
+		{"99999999999999999999", 0, "out of range"},
+		{"0xFFFFFFFFFFFFFFFFFF", 0, "out of range"},
+		{"1e30", 0, "out of range"},
+		{"abc", 0, "not a number"},
+	} {
+		got, err := parseInt(tc.input)
+		switch {
+		case tc.err == "" && err != nil:
+			t.Errorf("%s: %v", tc.input, err)
+		case tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)):
+			t.Errorf("%s: got error %v, expected %q", tc.input, err, tc.err)
+		case got != tc.want:
+			t.Errorf("%s: got %d, expected %d", tc.input, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Poller periodically reads datarefs via the REST API, each at its own interval, and delivers the
+// values as [WSMessageDatarefUpdate] messages to the same handlers which receive websocket
+// subscription updates.  This allows REST-only deployments to use the same update handling code
+// as those using the websocket service.
+//
+//	poller := client.NewPoller()
+//	poller.Add("sim/flightmodel/position/theta", 100*time.Millisecond)
+//	poller.Add("sim/flightmodel/weight/m_fuel", 10*time.Second)
+//	poller.AddFunc(dataref.SimCockpit2GaugesIndicators_airspeed_kts_pilot, time.Second,
+//		func(val *xpweb.DatarefValue) { fmt.Println(val.GetFloatValue()) })
+//	go poller.Run(ctx)
+type Poller struct {
+	client    *Client
+	intervals map[string]time.Duration
+	handlers  map[string]DatarefValueHandler
+	lock      sync.Mutex
+	changed   chan struct{}
+	// held while delivering an update, so that the handlers are not called concurrently by the
+	// poll loops of different intervals
+	deliverLock sync.Mutex
+}
+
+// NewPoller instantiates and returns a pointer to a new [Poller] object which has no datarefs
+// configured.
+func (c *Client) NewPoller() *Poller {
+	return &Poller{
+		client:    c,
+		intervals: make(map[string]time.Duration),
+		handlers:  make(map[string]DatarefValueHandler),
+		changed:   make(chan struct{}, 1),
+	}
+}
+
+// Add configures the dataref with the specified name to be read at the specified interval.  If
+// the dataref was already configured, its interval is replaced.  It may be called while the
+// Poller is running.  An error matching [ErrInvalidInterval] is returned if the interval is not
+// positive.
+func (p *Poller) Add(name string, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("%w: %v for dataref %s", ErrInvalidInterval, interval, name)
+	}
+	p.lock.Lock()
+	p.intervals[name] = interval
+	p.lock.Unlock()
+	p.notifyChanged()
+	return nil
+}
+
+// AddFunc configures the dataref with the specified name to be read at the specified interval,
+// like [Poller.Add], and additionally calls the specified handler with each value read.  The
+// handler is called after the value has been delivered to the dataref update handlers.
+func (p *Poller) AddFunc(
+	name string,
+	interval time.Duration,
+	handler DatarefValueHandler,
+) error {
+	if interval <= 0 {
+		return fmt.Errorf("%w: %v for dataref %s", ErrInvalidInterval, interval, name)
+	}
+	p.lock.Lock()
+	p.intervals[name] = interval
+	p.handlers[name] = handler
+	p.lock.Unlock()
+	p.notifyChanged()
+	return nil
+}
+
+// Remove stops the dataref with the specified name from being read, and removes any handler added
+// for it with [Poller.AddFunc].  It may be called while the Poller is running.
+func (p *Poller) Remove(name string) {
+	p.lock.Lock()
+	delete(p.intervals, name)
+	delete(p.handlers, name)
+	p.lock.Unlock()
+	p.notifyChanged()
+}
+
+// pollGroup is a running poll loop for the datarefs sharing an interval.
+type pollGroup struct {
+	names  []string
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// stop stops the poll loop and waits for it to return.
+func (g *pollGroup) stop() {
+	g.cancel()
+	<-g.done
+}
+
+// Run reads the configured datarefs at their configured intervals until the context is done.
+// Datarefs sharing an interval are read together and delivered in a single update message.
+// Updates of different intervals are delivered one at a time.
+func (p *Poller) Run(ctx context.Context) error {
+	running := make(map[time.Duration]*pollGroup)
+	defer func() {
+		for _, group := range running {
+			group.stop()
+		}
+	}()
+
+	for {
+		// restart only the poll loops whose datarefs have changed, so that the others are not
+		// read early
+		groups := p.groups()
+		for interval, group := range running {
+			if names, ok := groups[interval]; !ok || !slices.Equal(names, group.names) {
+				group.stop()
+				delete(running, interval)
+			}
+		}
+		for interval, names := range groups {
+			if _, ok := running[interval]; !ok {
+				running[interval] = p.startGroup(ctx, interval, names)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-p.changed:
+		}
+	}
+}
+
+// startGroup starts a poll loop for the specified datarefs.
+func (p *Poller) startGroup(
+	ctx context.Context,
+	interval time.Duration,
+	names []string,
+) *pollGroup {
+	groupCtx, cancel := context.WithCancel(ctx)
+	group := &pollGroup{names: names, cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(group.done)
+		p.pollLoop(groupCtx, interval, names)
+	}()
+	return group
+}
+
+// notifyChanged signals a running Poller to reload its configuration.
+func (p *Poller) notifyChanged() {
+	select {
+	case p.changed <- struct{}{}:
+	default:
+	}
+}
+
+// groups returns the configured dataref names grouped by interval.
+func (p *Poller) groups() map[time.Duration][]string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	groups := make(map[time.Duration][]string)
+	for _, name := range slices.Sorted(maps.Keys(p.intervals)) {
+		interval := p.intervals[name]
+		groups[interval] = append(groups[interval], name)
+	}
+	return groups
+}
+
+// pollLoop reads the specified datarefs immediately, and then at the specified interval until the
+// context is done.
+func (p *Poller) pollLoop(ctx context.Context, interval time.Duration, names []string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		p.poll(ctx, names)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll reads the specified datarefs and delivers their values in a single update message.
+func (p *Poller) poll(ctx context.Context, names []string) {
+	var dataLock sync.Mutex
+	msg := &WSMessageDatarefUpdate{
+		Type: MessageTypeDatarefUpdate,
+		Data: make(WSDatarefValuesMap),
+	}
+	byName := make(map[string]*DatarefValue, len(names))
+
+	err := p.client.REST.forEachDataref(slices.Values(names), func(name string) error {
+		val, err := p.client.REST.GetDatarefValue(ctx, name)
+		if err != nil {
+			return err
+		}
+		dataLock.Lock()
+		msg.Data[val.Dataref.ID] = val
+		byName[name] = val
+		dataLock.Unlock()
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		p.client.logger.Error("failed to poll datarefs", "error", err)
+	}
+
+	p.deliverLock.Lock()
+	defer p.deliverLock.Unlock()
+	if len(msg.Data) == 0 || ctx.Err() != nil {
+		return
+	}
+	p.client.WS.deliverDatarefUpdate(msg)
+
+	for name, val := range byName {
+		p.lock.Lock()
+		handler := p.handlers[name]
+		p.lock.Unlock()
+		if handler != nil {
+			handler(val)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newPollerClient returns a client of a server which responds to value requests of the datarefs
+// with the specified names, and counts the requests for each ID.
+func newPollerClient(t *testing.T, names ...string) (*Client, *sync.Map) {
+	t.Helper()
+	reads := &sync.Map{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var id uint64
+		if _, err := fmt.Sscanf(r.URL.Path, "/api/v2/datarefs/%d/value", &id); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		count, _ := reads.LoadOrStore(id, &atomic.Int32{})
+		count.(*atomic.Int32).Add(1)
+		fmt.Fprintf(w, `{"data":%d}`, id)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewClient(&ClientConfig{URL: server.URL, APIVersion: APIVersion2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	datarefs := make([]*Dataref, len(names))
+	for idx, name := range names {
+		datarefs[idx] = &Dataref{ID: uint64(idx + 1), Name: name, ValueType: ValueTypeInt}
+	}
+	client.setDatarefs(datarefs)
+	return client, reads
+}
+
+// readCount returns the number of requests for the value of the dataref with the specified ID.
+func readCount(reads *sync.Map, id uint64) int32 {
+	if count, ok := reads.Load(id); ok {
+		return count.(*atomic.Int32).Load()
+	}
+	return 0
+}
+
+func TestPollerDeliversSerially(t *testing.T) {
+	names := []string{"sim/test/a", "sim/test/b", "sim/test/c", "sim/test/d"}
+	client, _ := newPollerClient(t, names...)
+
+	var active, overlaps, delivered atomic.Int32
+	client.WS.datarefUpdateHandler = func(msg *WSMessageDatarefUpdate) {
+		if active.Add(1) > 1 {
+			overlaps.Add(1)
+		}
+		time.Sleep(time.Millisecond)
+		delivered.Add(1)
+		active.Add(-1)
+	}
+
+	poller := client.NewPoller()
+	for idx, name := range names {
+		if err := poller.Add(name, time.Duration(idx+1)*time.Millisecond); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	poller.Run(ctx)
+
+	if delivered.Load() < int32(len(names)) {
+		t.Fatalf("only %d updates delivered", delivered.Load())
+	}
+	if overlaps.Load() > 0 {
+		t.Errorf("%d updates delivered concurrently with another", overlaps.Load())
+	}
+}
+
+func TestPollerRestartsOnlyChangedGroups(t *testing.T) {
+	client, reads := newPollerClient(t, "sim/test/a", "sim/test/b", "sim/test/c")
+
+	poller := client.NewPoller()
+	if err := poller.Add("sim/test/a", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		poller.Run(ctx)
+	}()
+
+	// a poll loop reads its datarefs as soon as it starts
+	waitForReads := func(id uint64, want int32) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for readCount(reads, id) < want {
+			if time.Now().After(deadline) {
+				t.Fatalf("dataref %d read %d times, expected %d", id, readCount(reads, id), want)
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	waitForReads(1, 1)
+
+	// adding and removing a dataref of another interval leaves the first loop running
+	if err := poller.Add("sim/test/b", 2*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	waitForReads(2, 1)
+	poller.Remove("sim/test/b")
+	if err := poller.Add("sim/test/c", 3*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	waitForReads(3, 1)
+	if got := readCount(reads, 1); got != 1 {
+		t.Errorf("unchanged dataref read %d times, expected 1", got)
+	}
+
+	// adding a dataref of the same interval restarts the first loop
+	if err := poller.Add("sim/test/b", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	waitForReads(1, 2)
+
+	cancel()
+	<-done
+}
@@ -0,0 +1,109 @@
+package xpweb
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb/names/dataref/drefflightmodel"
+)
+
+// Position is the position, attitude, and velocity of the user's aircraft at a moment in time.
+type Position struct {
+	// Latitude in degrees.
+	Latitude float64
+	// Longitude in degrees.
+	Longitude float64
+	// Elevation above mean sea level in meters.
+	Elevation float64
+	// Height above ground level in meters.
+	AGL float64
+	// Pitch in degrees, positive nose up.
+	Pitch float64
+	// Roll in degrees, positive right wing down.
+	Roll float64
+	// True heading in degrees.
+	Heading float64
+	// True track over the ground in degrees.
+	Track float64
+	// Ground speed in meters per second.
+	GroundSpeed float64
+	// Vertical speed in feet per minute.
+	VerticalSpeed float64
+	// The time at which the position was read.
+	Time time.Time
+}
+
+// PositionDatarefs contains the names of the datarefs from which a [Position] is built.  These may
+// be subscribed to, and the received values passed to [PositionFromValues].
+var PositionDatarefs = []string{
+	drefflightmodel.SimFlightmodelPosition_latitude,
+	drefflightmodel.SimFlightmodelPosition_longitude,
+	drefflightmodel.SimFlightmodelPosition_elevation,
+	drefflightmodel.SimFlightmodelPosition_y_agl,
+	drefflightmodel.SimFlightmodelPosition_theta,
+	drefflightmodel.SimFlightmodelPosition_phi,
+	drefflightmodel.SimFlightmodelPosition_true_psi,
+	drefflightmodel.SimFlightmodelPosition_hpath,
+	drefflightmodel.SimFlightmodelPosition_groundspeed,
+	drefflightmodel.SimFlightmodelPosition_vh_ind_fpm,
+}
+
+// PositionFromValues builds a [Position] from dataref values keyed by name, such as those returned
+// by [Store.Snapshot] for [PositionDatarefs].  Missing values are left as zero.
+func PositionFromValues(values map[string]*DatarefValue) *Position {
+	return &Position{
+		Latitude:      values[drefflightmodel.SimFlightmodelPosition_latitude].GetFloatValue(),
+		Longitude:     values[drefflightmodel.SimFlightmodelPosition_longitude].GetFloatValue(),
+		Elevation:     values[drefflightmodel.SimFlightmodelPosition_elevation].GetFloatValue(),
+		AGL:           values[drefflightmodel.SimFlightmodelPosition_y_agl].GetFloatValue(),
+		Pitch:         values[drefflightmodel.SimFlightmodelPosition_theta].GetFloatValue(),
+		Roll:          values[drefflightmodel.SimFlightmodelPosition_phi].GetFloatValue(),
+		Heading:       values[drefflightmodel.SimFlightmodelPosition_true_psi].GetFloatValue(),
+		Track:         values[drefflightmodel.SimFlightmodelPosition_hpath].GetFloatValue(),
+		GroundSpeed:   values[drefflightmodel.SimFlightmodelPosition_groundspeed].GetFloatValue(),
+		VerticalSpeed: values[drefflightmodel.SimFlightmodelPosition_vh_ind_fpm].GetFloatValue(),
+		Time:          time.Now(),
+	}
+}
+
+// Position returns the current position, attitude, and velocity of the user's aircraft.  If the
+// websocket is connected, all values are taken from a single subscription update so that they are
+// consistent with one another.  Otherwise, they are read concurrently via REST.
+func (c *Client) Position(ctx context.Context) (*Position, error) {
+	if c.WS.currentConn() != nil {
+		ids, err := c.WS.lookupDatarefIDs(PositionDatarefs)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err := c.WS.snapshot(ctx, ids...)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[string]*DatarefValue, len(snapshot))
+		for _, val := range snapshot {
+			if val.Dataref != nil {
+				values[val.Dataref.Name] = val
+			}
+		}
+		return PositionFromValues(values), nil
+	}
+
+	var valuesLock sync.Mutex
+	values := make(map[string]*DatarefValue, len(PositionDatarefs))
+	err := c.REST.forEachDataref(slices.Values(PositionDatarefs), func(name string) error {
+		val, err := c.REST.GetDatarefValue(ctx, name)
+		if err != nil {
+			return err
+		}
+		valuesLock.Lock()
+		values[name] = val
+		valuesLock.Unlock()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return PositionFromValues(values), nil
+}
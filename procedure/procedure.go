@@ -0,0 +1,305 @@
+// Package procedure runs declarative checklists, such as aircraft startup and shutdown
+// procedures, which are loaded from YAML or JSON files so that they can be shared and edited
+// without recompiling Go code.
+//
+//	name: skyhawk start
+//	items:
+//	  - item: Battery
+//	    response: "ON"
+//	    command: sim/electrical/battery_1_on
+//	    check: {dataref: sim/cockpit2/electrical/battery_on, index: 0, equals: 1}
+//	  - item: Mixture
+//	    response: RICH
+//	    command: sim/engines/mixture_max
+//	    check: {dataref: sim/cockpit2/engine/actuators/mixture_ratio, index: 0, min: 0.99}
+//	  - item: Starter
+//	    response: ENGAGE
+//	    command: sim/engines/engage_starters
+//	    duration: 3
+//	    check: {dataref: sim/flightmodel/engine/ENGN_running, index: 0, equals: 1}
+//	    timeout: 15
+//
+// Each item performs at most one action, activating a command or writing a dataref, and may
+// check a condition.  If the condition is already satisfied, the action is skipped, so that a
+// procedure does not toggle a switch which is already in the desired position.  Otherwise the
+// action is performed and the condition must become satisfied before the timeout elapses.  Items
+// run in order, and a procedure stops at the first item which fails.
+//
+//	proc, err := procedure.Load("skyhawk_start.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	err = proc.Run(ctx, client)
+package procedure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/internal/yaml"
+	"github.com/janeprather/xpweb/sequence"
+)
+
+// DefaultTimeout is the time, in seconds, which an item waits for its condition after performing
+// its action, if the item does not specify a timeout.
+const DefaultTimeout = 5
+
+// Procedure is a named checklist of items.
+type Procedure struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description,omitempty"`
+	Items       []*Item `json:"items"`
+}
+
+// Item is a single checklist item, consisting of a challenge and response, an optional action,
+// an optional condition, and an optional delay.  At most one of Command and Set should be
+// specified.
+type Item struct {
+	// The challenge and response, such as "Battery" and "ON", used to describe the item.
+	Challenge string `json:"item"`
+	Response  string `json:"response,omitempty"`
+
+	// The name of a command to activate, for Duration seconds.  If Duration is omitted, the
+	// command is activated according to its [xpweb.CommandKind].
+	Command  string   `json:"command,omitempty"`
+	Duration *float64 `json:"duration,omitempty"`
+
+	// The name of a dataref to which Value is written, or to whose Index element it is written if
+	// Index is specified.
+	Set   string `json:"set,omitempty"`
+	Index *int   `json:"index,omitempty"`
+	Value any    `json:"value,omitempty"`
+
+	// A condition which must be satisfied for the item to be complete.
+	Check *Condition `json:"check,omitempty"`
+	// The number of seconds to wait for the condition after performing the action.  If omitted,
+	// DefaultTimeout is used.
+	Timeout *float64 `json:"timeout,omitempty"`
+
+	// A number of seconds to pause after the item is complete.
+	Delay float64 `json:"delay,omitempty"`
+}
+
+// Condition is a test of the value of a dataref, or of one element of an array dataref.  Equals
+// is compared with the value, and may be a number, a boolean (true matching any non-zero value),
+// or for data datarefs, a string.  Otherwise, numeric values must be between Min and Max,
+// inclusive, either of which may be omitted.
+type Condition struct {
+	Dataref string   `json:"dataref"`
+	Index   *int     `json:"index,omitempty"`
+	Equals  any      `json:"equals,omitempty"`
+	Min     *float64 `json:"min,omitempty"`
+	Max     *float64 `json:"max,omitempty"`
+}
+
+// Load reads a [Procedure] from the specified file, which is decoded as JSON if its name ends in
+// .json, or as YAML otherwise.
+func Load(path string) (*Procedure, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		proc := &Procedure{}
+		if err := json.Unmarshal(data, proc); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal procedure: %w", err)
+		}
+		return proc, proc.Validate()
+	}
+	return Parse(data)
+}
+
+// Parse decodes a [Procedure] from YAML.
+func Parse(data []byte) (*Procedure, error) {
+	proc := &Procedure{}
+	if err := yaml.Unmarshal(data, proc); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal procedure: %w", err)
+	}
+	return proc, proc.Validate()
+}
+
+// Validate checks that each item of the procedure is well formed.
+func (p *Procedure) Validate() error {
+	for idx, item := range p.Items {
+		if err := item.validate(); err != nil {
+			return fmt.Errorf("%s: item %d (%s): %w", p.Name, idx+1, item.Challenge, err)
+		}
+	}
+	return nil
+}
+
+// Sequence returns a [sequence.Sequence] with a step for each item of the procedure, so that the
+// procedure may be run with progress reporting or alongside other sequences.
+func (p *Procedure) Sequence() *sequence.Sequence {
+	seq := sequence.New(p.Name)
+	for _, item := range p.Items {
+		seq.Then(item)
+	}
+	return seq
+}
+
+// Run performs each item of the procedure in order against the specified client.
+func (p *Procedure) Run(ctx context.Context, client *xpweb.Client) error {
+	return p.Sequence().Run(ctx, client)
+}
+
+func (i *Item) validate() error {
+	if i.Command != "" && i.Set != "" {
+		return fmt.Errorf("both command and set are specified")
+	}
+	if i.Set != "" && i.Value == nil {
+		return fmt.Errorf("set requires a value")
+	}
+	if i.Check != nil {
+		if i.Check.Dataref == "" {
+			return fmt.Errorf("check requires a dataref")
+		}
+		switch i.Check.Equals.(type) {
+		case nil:
+			if i.Check.Min == nil && i.Check.Max == nil {
+				return fmt.Errorf("check requires equals, min, or max")
+			}
+		case float64, bool, string:
+		default:
+			return fmt.Errorf("check equals must be a number, boolean, or string")
+		}
+	}
+	return nil
+}
+
+// Run performs the item against the specified client, allowing an Item to be used as a
+// [sequence.Step].
+func (i *Item) Run(ctx context.Context, client *xpweb.Client) error {
+	if i.Check != nil {
+		val, err := client.GetValue(ctx, i.Check.Dataref)
+		if err != nil {
+			return err
+		}
+		if i.Check.Satisfied(val) {
+			return i.delay(ctx, client)
+		}
+	}
+
+	if err := i.act(ctx, client); err != nil {
+		return err
+	}
+
+	if i.Check != nil {
+		timeout := float64(DefaultTimeout)
+		if i.Timeout != nil {
+			timeout = *i.Timeout
+		}
+		wait := &sequence.WaitStep{
+			Name:      i.Check.Dataref,
+			Predicate: i.Check.Satisfied,
+			Timeout:   secondsToDuration(timeout),
+		}
+		if err := wait.Run(ctx, client); err != nil {
+			return fmt.Errorf("checking %s: %w", i.Check, err)
+		}
+	}
+	return i.delay(ctx, client)
+}
+
+// act performs the action of the item, if any.
+func (i *Item) act(ctx context.Context, client *xpweb.Client) error {
+	switch {
+	case i.Command != "" && i.Duration == nil:
+		return client.TriggerCommand(ctx, i.Command)
+	case i.Command != "":
+		return client.ActivateCommand(ctx, i.Command, *i.Duration)
+	case i.Set != "" && i.Index != nil:
+		return client.REST.SetDatarefElementValue(ctx, i.Set, *i.Index, i.Value)
+	case i.Set != "":
+		return client.SetValue(ctx, i.Set, i.Value)
+	}
+	return nil
+}
+
+// delay pauses after the item, if a delay is specified.
+func (i *Item) delay(ctx context.Context, client *xpweb.Client) error {
+	if i.Delay <= 0 {
+		return nil
+	}
+	return (&sequence.SleepStep{Duration: secondsToDuration(i.Delay)}).Run(ctx, client)
+}
+
+// String returns the challenge and response of the item.
+func (i *Item) String() string {
+	if i.Response == "" {
+		return i.Challenge
+	}
+	return fmt.Sprintf("%s: %s", i.Challenge, i.Response)
+}
+
+// Satisfied returns whether the specified dataref value satisfies the condition.
+func (c *Condition) Satisfied(val *xpweb.DatarefValue) bool {
+	if val == nil {
+		return false
+	}
+	if text, ok := c.Equals.(string); ok {
+		return strings.TrimRight(val.GetStringValue(), "\x00") == text
+	}
+
+	value, ok := c.value(val)
+	if !ok {
+		return false
+	}
+	switch equals := c.Equals.(type) {
+	case float64:
+		return value == equals
+	case bool:
+		return (value != 0) == equals
+	}
+	return (c.Min == nil || value >= *c.Min) && (c.Max == nil || value <= *c.Max)
+}
+
+// value returns the numeric value, or element value, to which the condition applies.
+func (c *Condition) value(val *xpweb.DatarefValue) (float64, bool) {
+	rv := reflect.ValueOf(val.Value)
+	if c.Index != nil {
+		if rv.Kind() != reflect.Slice || *c.Index < 0 || *c.Index >= rv.Len() {
+			return 0, false
+		}
+		rv = rv.Index(*c.Index)
+		if rv.Kind() == reflect.Interface {
+			rv = rv.Elem()
+		}
+	}
+	if rv.CanFloat() {
+		return rv.Float(), true
+	}
+	if rv.CanInt() {
+		return float64(rv.Int()), true
+	}
+	return 0, false
+}
+
+// String returns a description of the condition.
+func (c *Condition) String() string {
+	name := c.Dataref
+	if c.Index != nil {
+		name = fmt.Sprintf("%s[%d]", c.Dataref, *c.Index)
+	}
+	switch {
+	case c.Equals != nil:
+		return fmt.Sprintf("%s == %v", name, c.Equals)
+	case c.Min != nil && c.Max != nil:
+		return fmt.Sprintf("%s between %v and %v", name, *c.Min, *c.Max)
+	case c.Min != nil:
+		return fmt.Sprintf("%s >= %v", name, *c.Min)
+	default:
+		return fmt.Sprintf("%s <= %v", name, *c.Max)
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
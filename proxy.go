@@ -0,0 +1,173 @@
+package xpweb
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// proxyFunc returns the proxy URL to use for a request, or nil if the request should not be
+// proxied, in the manner of http.Transport.Proxy.
+type proxyFunc func(*http.Request) (*url.URL, error)
+
+// newProxyFunc returns a proxyFunc which always selects the specified proxy URL, or which honors
+// the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables if none is specified.
+func newProxyFunc(proxyURL string) (proxyFunc, error) {
+	if proxyURL == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch parsed.Scheme {
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("unsupported proxy URL scheme: %s", parsed.Scheme)
+	}
+	return http.ProxyURL(parsed), nil
+}
+
+// dialConn establishes the websocket connection described by the config, tunneling it through a
+// proxy with the CONNECT method if one is selected for the REST API URL.
+func (wsc *WSClient) dialConn(
+	ctx context.Context,
+	config *websocket.Config,
+) (*websocket.Conn, error) {
+	proxyURL, err := wsc.proxyFor(config.Location)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL == nil {
+		return config.DialContext(ctx)
+	}
+
+	netConn, err := dialTunnel(ctx, proxyURL, authority(config.Location), wsc.tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect via proxy %s: %w", proxyURL.Host, err)
+	}
+	if config.Location.Scheme == "wss" {
+		tlsConfig := &tls.Config{}
+		if config.TlsConfig != nil {
+			tlsConfig = config.TlsConfig.Clone()
+		}
+		if tlsConfig.ServerName == "" {
+			tlsConfig.ServerName = config.Location.Hostname()
+		}
+		tlsConn := tls.Client(netConn, tlsConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			netConn.Close()
+			return nil, err
+		}
+		netConn = tlsConn
+	}
+
+	// the websocket handshake does not observe the context, so enforce it with a deadline
+	stop := context.AfterFunc(ctx, func() { netConn.SetDeadline(time.Now()) })
+	defer stop()
+	conn, err := websocket.NewClient(config, netConn)
+	if err != nil {
+		netConn.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+// proxyFor returns the proxy URL selected for the websocket location, or nil if it should be
+// dialed directly.  The selection is made as for the equivalent http or https URL, so that the
+// HTTP_PROXY and HTTPS_PROXY environment variables apply to ws and wss respectively.
+func (wsc *WSClient) proxyFor(location *url.URL) (*url.URL, error) {
+	if wsc.proxy == nil {
+		return nil, nil
+	}
+	httpURL := *location
+	httpURL.Scheme = "http"
+	if location.Scheme == "wss" {
+		httpURL.Scheme = "https"
+	}
+	return wsc.proxy(&http.Request{Method: http.MethodGet, URL: &httpURL})
+}
+
+// dialTunnel connects to the proxy and requests a tunnel to the target address with the CONNECT
+// method.
+func dialTunnel(
+	ctx context.Context,
+	proxyURL *url.URL,
+	target string,
+	tlsConfig *tls.Config,
+) (net.Conn, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", authority(proxyURL))
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		proxyTLS := &tls.Config{}
+		if tlsConfig != nil {
+			proxyTLS = tlsConfig.Clone()
+		}
+		proxyTLS.ServerName = proxyURL.Hostname()
+		tlsConn := tls.Client(conn, proxyTLS)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: target},
+		Host:   target,
+		Header: make(http.Header),
+	}
+	if user := proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy refused tunnel: %s", resp.Status)
+	}
+	return conn, nil
+}
+
+// authority returns the host:port of the URL, supplying the default port for its scheme if none is
+// specified.
+func authority(u *url.URL) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	switch u.Scheme {
+	case "https", "wss":
+		return net.JoinHostPort(u.Hostname(), "443")
+	default:
+		return net.JoinHostPort(u.Hostname(), "80")
+	}
+}
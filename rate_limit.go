@@ -0,0 +1,71 @@
+package xpweb
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimit configures a token bucket limiting the rate of REST API requests, so that bulk scripts
+// do not overwhelm the simulator's single-threaded web server and cause frame-rate hitches.
+type RateLimit struct {
+	// The sustained number of requests permitted per second.
+	RequestsPerSecond float64
+	// The number of requests which may be made in a burst above the sustained rate.  If
+	// unspecified, a burst of 1 is used.
+	Burst int
+}
+
+// rateLimiter is a token bucket.  Tokens are reserved in order of arrival, so the balance may go
+// negative, in which case the caller waits until its token would have accrued.
+type rateLimiter struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	lock   sync.Mutex
+}
+
+func newRateLimiter(limit *RateLimit) *rateLimiter {
+	if limit == nil || limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+	burst := float64(max(limit.Burst, 1))
+	return &rateLimiter{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until a request is permitted, or the context is done.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.lock.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// return the reserved token
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+		return ctx.Err()
+	}
+}
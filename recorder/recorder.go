@@ -0,0 +1,254 @@
+// Package recorder captures dataref updates to files for later analysis, such as recording a
+// flight or debugging an aircraft's systems.  Each update is written as a timestamped record in
+// either JSON Lines or CSV format, with optional size-based rotation.
+//
+//	rec, err := recorder.New(client, recorder.Config{
+//		Path:     "flight.jsonl",
+//		Datarefs: []string{dataref.SimFlightmodelPosition_latitude, dataref.SimFlightmodelPosition_longitude},
+//		MaxBytes: 64 << 20,
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	err = rec.Run(ctx)
+package recorder
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// Format is the file format in which records are written.
+type Format int
+
+const (
+	// JSONL writes each record as a JSON object on its own line.
+	JSONL Format = iota
+	// CSV writes each record as a row of comma-separated values, with a header row at the start of
+	// each file.  Array values are written as JSON arrays.
+	CSV
+)
+
+// csvHeader is the header row of CSV files.
+var csvHeader = []string{"time", "elapsed", "dataref", "value"}
+
+// Config configures a [Recorder].
+type Config struct {
+	// The path of the file to write.  When the file is rotated, it is renamed with a timestamp
+	// inserted before its extension, and a new file is started at this path.
+	Path string
+	// The format of the records.
+	Format Format
+	// The names of the datarefs to record.
+	Datarefs []string
+	// The size in bytes at which the file is rotated, or 0 to never rotate.
+	MaxBytes int64
+	// The interval at which buffered records are flushed to the file, or 0 to flush after every
+	// update.
+	FlushInterval time.Duration
+	// An optional clock, such as an [xpweb.SimClock], from which the elapsed time of each record is
+	// taken.  If unspecified, elapsed time is measured from the start of the recording by the wall
+	// clock.  With a SimClock, time spent paused is excluded, keeping recordings synchronized with
+	// the simulation.
+	Clock xpweb.Clock
+}
+
+// Record is a single recorded dataref value.
+type Record struct {
+	// The wall clock time at which the update was received.
+	Time time.Time `json:"time"`
+	// The elapsed time, in seconds, according to the recorder's clock.
+	Elapsed float64 `json:"elapsed"`
+	// The name of the dataref.
+	Dataref string `json:"dataref"`
+	// The value of the dataref.
+	Value any `json:"value"`
+}
+
+// Recorder writes dataref updates to a file.
+type Recorder struct {
+	client *xpweb.Client
+	cfg    Config
+
+	file    *os.File
+	buf     *bufio.Writer
+	csv     *csv.Writer
+	written int64
+	start   time.Duration
+}
+
+// New validates the configuration and returns a [Recorder].  Recording begins when
+// [Recorder.Run] is called.
+func New(client *xpweb.Client, cfg Config) (*Recorder, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("recorder path is required")
+	}
+	if len(cfg.Datarefs) == 0 {
+		return nil, errors.New("recorder requires at least one dataref")
+	}
+	if cfg.Format != JSONL && cfg.Format != CSV {
+		return nil, fmt.Errorf("unsupported recorder format: %d", cfg.Format)
+	}
+	if cfg.Clock == nil {
+		cfg.Clock = xpweb.WallClock
+	}
+	return &Recorder{client: client, cfg: cfg}, nil
+}
+
+// Run subscribes to the configured datarefs and writes their updates until the context is done,
+// at which point buffered records are flushed and the file is closed.  A nil error is returned
+// when recording stops because the context is done.
+func (r *Recorder) Run(ctx context.Context) (err error) {
+	if err := r.open(); err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := r.close(); err == nil {
+			err = closeErr
+		}
+	}()
+	r.start = r.cfg.Clock.Elapsed()
+
+	updates, err := r.client.SubscribeFor(ctx, r.cfg.Datarefs...)
+	if err != nil {
+		return err
+	}
+
+	var flushC <-chan time.Time
+	if r.cfg.FlushInterval > 0 {
+		ticker := time.NewTicker(r.cfg.FlushInterval)
+		defer ticker.Stop()
+		flushC = ticker.C
+	}
+
+	for {
+		select {
+		case msg, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			now := time.Now()
+			elapsed := (r.cfg.Clock.Elapsed() - r.start).Seconds()
+			for _, val := range msg.Data {
+				record := &Record{Time: now, Elapsed: elapsed, Dataref: val.Dataref.Name, Value: val.Value}
+				if err := r.write(record); err != nil {
+					return err
+				}
+			}
+			if r.cfg.FlushInterval == 0 {
+				if err := r.flush(); err != nil {
+					return err
+				}
+			}
+		case <-flushC:
+			if err := r.flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// open creates the file at the configured path.
+func (r *Recorder) open() error {
+	file, err := os.OpenFile(r.cfg.Path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	r.file = file
+	r.buf = bufio.NewWriter(&countingWriter{w: file, n: &r.written})
+	r.written = 0
+	if r.cfg.Format == CSV {
+		r.csv = csv.NewWriter(r.buf)
+		return r.csv.Write(csvHeader)
+	}
+	return nil
+}
+
+// close flushes buffered records and closes the file.
+func (r *Recorder) close() error {
+	if r.file == nil {
+		return nil
+	}
+	flushErr := r.flush()
+	closeErr := r.file.Close()
+	r.file = nil
+	return errors.Join(flushErr, closeErr)
+}
+
+// flush writes buffered records to the file.
+func (r *Recorder) flush() error {
+	if r.csv != nil {
+		r.csv.Flush()
+		if err := r.csv.Error(); err != nil {
+			return err
+		}
+	}
+	return r.buf.Flush()
+}
+
+// write writes a record, rotating the file first if it has reached the configured size.
+func (r *Recorder) write(record *Record) error {
+	if r.cfg.MaxBytes > 0 && r.written+int64(r.buf.Buffered()) >= r.cfg.MaxBytes {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if r.cfg.Format == CSV {
+		value, err := json.Marshal(record.Value)
+		if err != nil {
+			return err
+		}
+		return r.csv.Write([]string{
+			record.Time.Format(time.RFC3339Nano),
+			strconv.FormatFloat(record.Elapsed, 'f', -1, 64),
+			record.Dataref,
+			strings.Trim(string(value), `"`),
+		})
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+	_, err = r.buf.Write(data)
+	return err
+}
+
+// rotate closes the current file, renames it with a timestamp, and starts a new file.
+func (r *Recorder) rotate() error {
+	if err := r.close(); err != nil {
+		return err
+	}
+	ext := filepath.Ext(r.cfg.Path)
+	rotated := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(r.cfg.Path, ext),
+		time.Now().Format("20060102T150405.000"), ext)
+	if err := os.Rename(r.cfg.Path, rotated); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+// countingWriter counts the bytes written to the underlying file.
+type countingWriter struct {
+	w *os.File
+	n *int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	*c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,74 @@
+package regression
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Time     float64         `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      float64       `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the result as a JUnit-style XML report.
+func (r *SuiteResult) WriteJUnit(w io.Writer) error {
+	suite := junitTestSuite{
+		Name:  r.Suite.Name,
+		Tests: len(r.Cases),
+		Time:  r.Duration.Seconds(),
+	}
+
+	for _, caseResult := range r.Cases {
+		testCase := junitTestCase{
+			Name:      caseResult.Case.Name,
+			ClassName: r.Suite.Name,
+			Time:      caseResult.Duration.Seconds(),
+		}
+		if caseResult.Error != nil {
+			suite.Errors++
+			testCase.Error = &junitMessage{Message: caseResult.Error.Error()}
+		}
+		if len(caseResult.Failures) > 0 {
+			suite.Failures++
+			testCase.Failure = &junitMessage{
+				Message: caseResult.Failures[0],
+				Body:    strings.Join(caseResult.Failures, "\n"),
+			}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitTestSuites{Suites: []junitTestSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,215 @@
+// Package regression runs automated acceptance tests of an aircraft against the simulator.  A
+// [Suite] of test cases, each consisting of steps to perform and assertions about dataref values
+// after particular steps, is loaded from a JSON file and run against a [xpweb.Client], producing a
+// JUnit-style XML report which continuous integration tools can consume.
+//
+//	{
+//		"name": "c172 startup",
+//		"cases": [
+//			{
+//				"name": "battery on",
+//				"steps": [
+//					{"command": "sim/electrical/battery_1_on"},
+//					{"sleep": 1}
+//				],
+//				"assertions": [
+//					{"after_step": 2, "dataref": "sim/cockpit2/electrical/battery_on", "index": 0, "min": 1, "max": 1}
+//				]
+//			}
+//		]
+//	}
+//
+// Steps are numbered from 1, and an assertion with an after_step of 0 is evaluated before any
+// steps are performed.
+package regression
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/sequence"
+)
+
+// Suite is a named set of test cases.
+type Suite struct {
+	Name  string  `json:"name"`
+	Cases []*Case `json:"cases"`
+}
+
+// Case is a single test case, consisting of steps and assertions.
+type Case struct {
+	Name       string       `json:"name"`
+	Steps      []*Step      `json:"steps"`
+	Assertions []*Assertion `json:"assertions"`
+}
+
+// Step is a single action within a test case.  Exactly one of Command, Set, or Sleep should be
+// specified.
+type Step struct {
+	// The name of a command to activate.
+	Command string `json:"command,omitempty"`
+	// The duration, in seconds, for which Command is activated.
+	Duration float64 `json:"duration,omitempty"`
+	// The name of a dataref to which Value is written.
+	Set string `json:"set,omitempty"`
+	// The value written to the Set dataref.
+	Value any `json:"value,omitempty"`
+	// A number of seconds to wait.
+	Sleep float64 `json:"sleep,omitempty"`
+}
+
+// Assertion is an expectation that a dataref value is within a range after a particular step.
+type Assertion struct {
+	// The number of the step, from 1, after which the assertion is evaluated.
+	AfterStep int `json:"after_step"`
+	// The name of the dataref whose value is checked.
+	Dataref string `json:"dataref"`
+	// The element of an array dataref whose value is checked.
+	Index *int `json:"index,omitempty"`
+	// The minimum acceptable value, inclusive.
+	Min float64 `json:"min"`
+	// The maximum acceptable value, inclusive.
+	Max float64 `json:"max"`
+}
+
+// LoadSuite reads a [Suite] from the specified JSON file.
+func LoadSuite(path string) (*Suite, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	suite := &Suite{}
+	if err := json.Unmarshal(data, suite); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal suite: %w", err)
+	}
+	return suite, nil
+}
+
+// CaseResult is the outcome of running a single test case.
+type CaseResult struct {
+	Case *Case
+	// The failed assertions, if any.
+	Failures []string
+	// The error which prevented the case from completing, if any.
+	Error error
+	// How long the case took to run.
+	Duration time.Duration
+}
+
+// Passed returns whether the case completed with no failed assertions.
+func (r *CaseResult) Passed() bool {
+	return r.Error == nil && len(r.Failures) == 0
+}
+
+// SuiteResult is the outcome of running a [Suite].
+type SuiteResult struct {
+	Suite    *Suite
+	Cases    []*CaseResult
+	Duration time.Duration
+}
+
+// Passed returns whether every case in the suite passed.
+func (r *SuiteResult) Passed() bool {
+	for _, caseResult := range r.Cases {
+		if !caseResult.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Run runs every case in the suite against the specified client.  A case which fails does not
+// prevent the following cases from running.  An error is returned only if the context is done.
+func (s *Suite) Run(ctx context.Context, client *xpweb.Client) (*SuiteResult, error) {
+	result := &SuiteResult{Suite: s}
+	start := time.Now()
+	for _, testCase := range s.Cases {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		result.Cases = append(result.Cases, testCase.Run(ctx, client))
+	}
+	result.Duration = time.Since(start)
+	return result, nil
+}
+
+// Run performs the steps of the case against the specified client, evaluating assertions after
+// the appropriate steps.
+func (c *Case) Run(ctx context.Context, client *xpweb.Client) *CaseResult {
+	result := &CaseResult{Case: c}
+	start := time.Now()
+	defer func() { result.Duration = time.Since(start) }()
+
+	for stepNum := 0; stepNum <= len(c.Steps); stepNum++ {
+		if stepNum > 0 {
+			step, err := c.Steps[stepNum-1].sequenceStep()
+			if err == nil {
+				err = step.Run(ctx, client)
+			}
+			if err != nil {
+				result.Error = fmt.Errorf("step %d: %w", stepNum, err)
+				return result
+			}
+		}
+		for _, assertion := range c.Assertions {
+			if assertion.AfterStep != stepNum {
+				continue
+			}
+			failure, err := assertion.check(ctx, client)
+			if err != nil {
+				result.Error = fmt.Errorf("assertion after step %d: %w", stepNum, err)
+				return result
+			}
+			if failure != "" {
+				result.Failures = append(result.Failures, failure)
+			}
+		}
+	}
+	return result
+}
+
+// sequenceStep returns the [sequence.Step] which performs the step.
+func (s *Step) sequenceStep() (sequence.Step, error) {
+	switch {
+	case s.Command != "":
+		return &sequence.CommandStep{Name: s.Command, Duration: s.Duration}, nil
+	case s.Set != "":
+		return &sequence.SetStep{Name: s.Set, Value: s.Value}, nil
+	case s.Sleep > 0:
+		return &sequence.SleepStep{Duration: time.Duration(s.Sleep * float64(time.Second))}, nil
+	}
+	return nil, errors.New("step has no command, set, or sleep")
+}
+
+// check evaluates the assertion, returning a description of the failure if the value is out of
+// range.
+func (a *Assertion) check(ctx context.Context, client *xpweb.Client) (string, error) {
+	val, err := client.GetValue(ctx, a.Dataref)
+	if err != nil {
+		return "", err
+	}
+
+	name := a.Dataref
+	var value float64
+	if a.Index != nil {
+		name = fmt.Sprintf("%s[%d]", a.Dataref, *a.Index)
+		values := val.GetFloatArrayValue()
+		if *a.Index < 0 || *a.Index >= len(values) {
+			return fmt.Sprintf("after step %d: %s: index out of range", a.AfterStep, name), nil
+		}
+		value = values[*a.Index]
+	} else {
+		value = val.GetFloatValue()
+	}
+
+	if value < a.Min || value > a.Max {
+		return fmt.Sprintf("after step %d: %s = %v, expected between %v and %v", a.AfterStep,
+			name, value, a.Min, a.Max), nil
+	}
+	return "", nil
+}
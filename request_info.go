@@ -0,0 +1,66 @@
+package xpweb
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RequestInfo describes a REST API call which has been performed, for diagnosing which calls are
+// slow or large.
+type RequestInfo struct {
+	// The HTTP method of the request.
+	Method string
+	// The path, including any query string, of the request.
+	Path string
+	// The HTTP status code of the response, or 0 if no response was received.
+	StatusCode int
+	// The number of bytes in the request body.
+	BytesSent int
+	// The number of bytes in the response body.
+	BytesReceived int
+	// The time from sending the request until the response body was read.
+	Latency time.Duration
+	// The processing time reported by the server in a Server-Timing header, or 0 if the server did
+	// not report one.
+	ServerTime time.Duration
+	// The error which the call returned, if any.
+	Err error
+}
+
+// RequestHandler is a function which performs some action for each REST API call, such as
+// recording metrics.  It is called synchronously, so it should return promptly.
+type RequestHandler func(*RequestInfo)
+
+// parseServerTiming returns the duration reported by a Server-Timing header.  A metric named total
+// is preferred; otherwise the durations of all metrics are summed.
+func parseServerTiming(header http.Header) time.Duration {
+	var total, sum float64
+	var hasTotal bool
+	for _, value := range header.Values("Server-Timing") {
+		for metric := range strings.SplitSeq(value, ",") {
+			params := strings.Split(metric, ";")
+			name := strings.TrimSpace(params[0])
+			for _, param := range params[1:] {
+				key, val, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if !strings.EqualFold(key, "dur") {
+					continue
+				}
+				dur, err := strconv.ParseFloat(strings.Trim(val, `"`), 64)
+				if err != nil {
+					continue
+				}
+				if strings.EqualFold(name, "total") {
+					total, hasTotal = dur, true
+				}
+				sum += dur
+			}
+		}
+	}
+	if hasTotal {
+		sum = total
+	}
+	// Server-Timing durations are in milliseconds
+	return time.Duration(sum * float64(time.Millisecond))
+}
@@ -0,0 +1,117 @@
+package xpweb
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy configures automatic retries of failed REST API requests.  The X-Plane web server
+// briefly refuses connections while scenery loads, so retrying saves every caller from wrapping
+// requests itself.
+//
+// Requests which were refused a connection are always safe to retry.  Requests whose connection
+// was reset, or which received a 5xx response, may have been processed, so are retried only if
+// their method is idempotent, so that a command activation is never performed twice.
+type RetryPolicy struct {
+	// The maximum number of attempts, including the first.  Values less than 2 disable retries.
+	MaxAttempts int
+	// The delay before the first retry, doubling for each subsequent retry.  If unspecified, a
+	// default of 100 milliseconds will be used.
+	InitialBackoff time.Duration
+	// The maximum delay between retries.  If unspecified, a default of 2 seconds will be used.
+	MaxBackoff time.Duration
+	// An optional function which decides whether a failed request should be retried, replacing
+	// the default rules described above.
+	ShouldRetry func(method string, err error) bool
+}
+
+// DefaultRetryPolicy is a reasonable [RetryPolicy] for riding out scenery loads.
+var DefaultRetryPolicy = &RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 100 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+}
+
+const (
+	defaultRetryInitialBackoff time.Duration = 100 * time.Millisecond
+	defaultRetryMaxBackoff     time.Duration = 2 * time.Second
+)
+
+// shouldRetry returns whether a request which failed with the specified error should be retried.
+func (p *RetryPolicy) shouldRetry(method string, err error) bool {
+	if p.ShouldRetry != nil {
+		return p.ShouldRetry(method, err)
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) {
+		return isIdempotent(method)
+	}
+	var errorResp *ErrorResponse
+	if errors.As(err, &errorResp) && errorResp.StatusCode >= 500 {
+		return isIdempotent(method)
+	}
+	return false
+}
+
+// backoff returns the delay before the specified retry, counting from 1, with jitter.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := p.InitialBackoff
+	if delay <= 0 {
+		delay = defaultRetryInitialBackoff
+	}
+	maxDelay := p.MaxBackoff
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxBackoff
+	}
+	for range retry - 1 {
+		delay *= 2
+		if delay >= maxDelay {
+			delay = maxDelay
+			break
+		}
+	}
+	// up to 20% jitter, so that several clients do not retry in lockstep
+	return delay - time.Duration(rand.Int64N(int64(delay)/5+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodPatch, http.MethodDelete,
+		http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// withRetry calls the specified function, retrying according to the client's [RetryPolicy].
+func withRetry[T any](
+	ctx context.Context,
+	policy *RetryPolicy,
+	method string,
+	fn func() (T, error),
+) (T, error) {
+	result, err := fn()
+	if policy == nil {
+		return result, err
+	}
+	for attempt := 2; err != nil && attempt <= policy.MaxAttempts; attempt++ {
+		if !policy.shouldRetry(method, err) {
+			break
+		}
+		timer := time.NewTimer(policy.backoff(attempt - 1))
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		}
+		result, err = fn()
+	}
+	return result, err
+}
@@ -0,0 +1,29 @@
+package xpweb
+
+import (
+	"fmt"
+	"net/http"
+	"syscall"
+	"testing"
+)
+
+func TestShouldRetry(t *testing.T) {
+	policy := &RetryPolicy{MaxAttempts: 3}
+	for _, tc := range []struct {
+		method string
+		err    error
+		want   bool
+	}{
+		{http.MethodGet, fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{http.MethodPost, fmt.Errorf("dial: %w", syscall.ECONNREFUSED), true},
+		{http.MethodGet, fmt.Errorf("read: %w", syscall.ECONNRESET), true},
+		{http.MethodPost, fmt.Errorf("read: %w", syscall.ECONNRESET), false},
+		{http.MethodPut, &ErrorResponse{StatusCode: http.StatusBadGateway}, true},
+		{http.MethodPost, &ErrorResponse{StatusCode: http.StatusBadGateway}, false},
+		{http.MethodGet, &ErrorResponse{StatusCode: http.StatusNotFound}, false},
+	} {
+		if got := policy.shouldRetry(tc.method, tc.err); got != tc.want {
+			t.Errorf("%s %v: got %v, expected %v", tc.method, tc.err, got, tc.want)
+		}
+	}
+}
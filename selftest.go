@@ -0,0 +1,81 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/janeprather/xpweb/names/command"
+	"github.com/janeprather/xpweb/names/dataref/dreftest"
+)
+
+const (
+	// SafeCommand is the name of a command which does nothing when activated, and is therefore
+	// safe to use when testing connectivity and permissions.
+	SafeCommand string = command.SimNone_none
+	// SafeDataref is the name of a writable float dataref which the simulator provides for
+	// testing purposes, and which has no effect on the simulation when written.
+	SafeDataref string = dreftest.SimTest_test_float
+)
+
+// SafeDatarefs returns the names of writable datarefs which may be used as scratch values without
+// affecting the simulation.
+func SafeDatarefs() []string {
+	return []string{SafeDataref}
+}
+
+// SelfTest exercises the API using only harmless names, so that a deployment may validate its
+// connectivity and permissions on startup.  The cache must be loaded prior to calling SelfTest.
+// It performs the following checks, returning an error describing the first one which fails.
+//
+//   - reading the value of [SafeDataref] via REST
+//   - writing the value of [SafeDataref] via REST and reading it back
+//   - activating [SafeCommand] via REST
+//   - subscribing to [SafeDataref] via websocket and receiving an update, if the websocket is
+//     connected
+func (c *Client) SelfTest(ctx context.Context) error {
+	origVal, err := c.REST.GetDatarefValue(ctx, SafeDataref)
+	if err != nil {
+		return fmt.Errorf("self-test read: %w", err)
+	}
+	orig := origVal.GetFloatValue()
+
+	// write a distinct value, confirm it, then restore the original
+	testValue := orig + 1
+	if err := c.REST.SetDatarefValue(ctx, SafeDataref, testValue); err != nil {
+		return fmt.Errorf("self-test write: %w", err)
+	}
+	newVal, err := c.REST.GetDatarefValue(ctx, SafeDataref)
+	if err != nil {
+		return fmt.Errorf("self-test read-back: %w", err)
+	}
+	// the dataref is a float, so the written value is narrowed to 32 bits
+	if newVal.GetFloat32Value() != float32(testValue) {
+		return fmt.Errorf("self-test read-back: wrote %v but read %v", testValue,
+			newVal.GetFloatValue())
+	}
+	if err := c.REST.SetDatarefValue(ctx, SafeDataref, orig); err != nil {
+		return fmt.Errorf("self-test restore: %w", err)
+	}
+
+	if err := c.REST.ActivateCommand(ctx, SafeCommand, 0); err != nil {
+		return fmt.Errorf("self-test command: %w", err)
+	}
+
+	if c.WS.currentConn() != nil {
+		if err := c.WS.selfTestSubscription(ctx); err != nil {
+			return fmt.Errorf("self-test subscription: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// selfTestSubscription subscribes to SafeDataref and waits for an update which includes it.
+func (wsc *WSClient) selfTestSubscription(ctx context.Context) error {
+	dref, err := wsc.client.LookupDataref(SafeDataref)
+	if err != nil {
+		return err
+	}
+	_, err = wsc.snapshot(ctx, dref.ID)
+	return err
+}
@@ -0,0 +1,47 @@
+package sequence
+
+import (
+	"context"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+type clockKey struct{}
+
+// WithClock returns a context which causes sequences run with it to time their sleeps and timeouts
+// using the specified clock rather than the wall clock.  With an [xpweb.SimClock], pausing the
+// simulator pauses scripted waits.
+//
+//	clock, err := client.NewSimClock(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	err = seq.Run(sequence.WithClock(ctx, clock), client)
+func WithClock(ctx context.Context, clock xpweb.Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// clockFrom returns the clock associated with the context, or the wall clock.
+func clockFrom(ctx context.Context) xpweb.Clock {
+	if clock, ok := ctx.Value(clockKey{}).(xpweb.Clock); ok {
+		return clock
+	}
+	return xpweb.WallClock
+}
+
+// withClockTimeout returns a context which is cancelled when the specified duration has elapsed
+// according to the context's clock.
+func withClockTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	clock := clockFrom(ctx)
+	if clock == xpweb.WallClock {
+		return context.WithTimeout(ctx, d)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	go func() {
+		if clock.Sleep(ctx, d) == nil {
+			cancel(context.DeadlineExceeded)
+		}
+	}()
+	return ctx, func() { cancel(context.Canceled) }
+}
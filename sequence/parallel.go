@@ -0,0 +1,101 @@
+package sequence
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/janeprather/xpweb"
+)
+
+// Locks is a set of named resource locks, such as "throttle" or "autopilot", which sequences
+// declare with [Sequence.Uses] so that concurrently running sequences do not fight over the same
+// controls.  The zero value is ready for use.
+type Locks struct {
+	locks map[string]chan struct{}
+	lock  sync.Mutex
+}
+
+// Acquire waits until all of the named resources are available and then holds them.  Resources
+// are always acquired in sorted order, so that sequences with overlapping resources cannot
+// deadlock.  If the context is done first, any resources already acquired are released and the
+// context's error is returned.
+func (l *Locks) Acquire(ctx context.Context, resources ...string) error {
+	sorted := slices.Compact(slices.Sorted(slices.Values(resources)))
+	for idx, resource := range sorted {
+		select {
+		case l.get(resource) <- struct{}{}:
+		case <-ctx.Done():
+			l.Release(sorted[:idx]...)
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Release releases the named resources, which must have been acquired with [Locks.Acquire].
+func (l *Locks) Release(resources ...string) {
+	for _, resource := range slices.Compact(slices.Sorted(slices.Values(resources))) {
+		<-l.get(resource)
+	}
+}
+
+// get returns the channel for the named resource, creating it if necessary.
+func (l *Locks) get(resource string) chan struct{} {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.locks == nil {
+		l.locks = make(map[string]chan struct{})
+	}
+	ch, exists := l.locks[resource]
+	if !exists {
+		ch = make(chan struct{}, 1)
+		l.locks[resource] = ch
+	}
+	return ch
+}
+
+// Uses declares the resources which the sequence controls.  When the sequence is run with
+// [RunParallel], it waits until no other sequence holds any of these resources.
+func (s *Sequence) Uses(resources ...string) *Sequence {
+	s.Resources = append(s.Resources, resources...)
+	return s
+}
+
+// RunParallel runs the specified sequences concurrently against the specified client.  Each
+// sequence first acquires the resources it declared with [Sequence.Uses] from the specified locks,
+// so sequences sharing a resource run one after another while others proceed in parallel.  If
+// locks is nil, a new set is used for this call only.  RunParallel waits for every sequence to
+// finish, and returns the errors of those which failed joined together.
+//
+//	locks := &sequence.Locks{}
+//	err := sequence.RunParallel(ctx, client, locks,
+//		groundServices.Uses("doors"),
+//		cockpitPrep.Uses("electrical", "autopilot"),
+//		engineStart.Uses("electrical", "throttle"),
+//	)
+func RunParallel(ctx context.Context, client *xpweb.Client, locks *Locks, seqs ...*Sequence) error {
+	if locks == nil {
+		locks = &Locks{}
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(seqs))
+	for idx, seq := range seqs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := locks.Acquire(ctx, seq.Resources...); err != nil {
+				errs[idx] = err
+				return
+			}
+			defer locks.Release(seq.Resources...)
+			errs[idx] = seq.Run(ctx, client)
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,253 @@
+package sequence
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janeprather/xpweb/internal/yaml"
+)
+
+// Scenario is a declarative description of a [Sequence], which may be written in YAML so that
+// test scenarios can be expressed without writing Go.
+//
+//	name: engine run-up
+//	resources: [throttle]
+//	vars:
+//	  rpm_min: 1650
+//	  rpm_max: 1850
+//	steps:
+//	  - command: sim/engines/throttle_up
+//	    duration: 2
+//	  - expect: sim/cockpit2/engine/indicators/engine_speed_rpm
+//	    index: 0
+//	    min: ${rpm_min}
+//	    max: ${rpm_max}
+//	    timeout: 10
+//	  - repeat: 2
+//	    var: mag
+//	    steps:
+//	      - command: sim/magnetos/magnetos_down_1
+//	      - sleep: 3
+//
+// Variables are referenced as ${name} within any string, and a string which consists of only a
+// variable reference takes on the variable's value and type.  Variables are defined in vars, may
+// be assigned by a let step, and within a repeat, the variable named by var holds the iteration
+// number, counting from 1.  Durations are in seconds.
+type Scenario struct {
+	Name string `json:"name"`
+	// The resources which the scenario controls, for use with [RunParallel].
+	Resources []string        `json:"resources,omitempty"`
+	Vars      map[string]any  `json:"vars,omitempty"`
+	Steps     []*ScenarioStep `json:"steps"`
+}
+
+// ScenarioStep is a single step of a [Scenario].  Exactly one of Command, Set, Sleep, Wait, Expect,
+// Repeat, or Let should be specified.  Numeric fields may be given as numbers or as variable
+// references.
+type ScenarioStep struct {
+	// The name of a command to activate, for Duration seconds.  If Duration is omitted, the
+	// command is activated according to its [xpweb.CommandKind].
+	Command  string `json:"command,omitempty"`
+	Duration any    `json:"duration,omitempty"`
+
+	// The name of a dataref to which Value is written.
+	Set   string `json:"set,omitempty"`
+	Value any    `json:"value,omitempty"`
+
+	// A number of seconds to pause.
+	Sleep any `json:"sleep,omitempty"`
+
+	// The name of a dataref whose value (or Index element) must come within Min and Max before
+	// Timeout seconds elapse.
+	Expect  string `json:"expect,omitempty"`
+	Index   *int   `json:"index,omitempty"`
+	Min     any    `json:"min,omitempty"`
+	Max     any    `json:"max,omitempty"`
+	Timeout any    `json:"timeout,omitempty"`
+
+	// A number of times to perform Steps, with the iteration number stored in the variable Var.
+	Repeat any             `json:"repeat,omitempty"`
+	Var    string          `json:"var,omitempty"`
+	Steps  []*ScenarioStep `json:"steps,omitempty"`
+
+	// Variables to assign for the following steps.
+	Let map[string]any `json:"let,omitempty"`
+}
+
+// LoadScenario reads a [Scenario] from the specified file, which is decoded as JSON if its name
+// ends in .json, or as YAML otherwise.
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		scenario := &Scenario{}
+		if err := json.Unmarshal(data, scenario); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+		}
+		return scenario, nil
+	}
+	return ParseScenario(data)
+}
+
+// ParseScenario decodes a [Scenario] from YAML.
+func ParseScenario(data []byte) (*Scenario, error) {
+	scenario := &Scenario{}
+	if err := yaml.Unmarshal(data, scenario); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scenario: %w", err)
+	}
+	return scenario, nil
+}
+
+// Sequence compiles the scenario into a [Sequence], substituting variables and expanding repeats.
+func (s *Scenario) Sequence() (*Sequence, error) {
+	vars := scenarioVars{}
+	maps.Copy(vars, s.Vars)
+	seq, err := vars.compile(s.Name, s.Steps)
+	if err != nil {
+		return nil, err
+	}
+	return seq.Uses(s.Resources...), nil
+}
+
+// scenarioVars holds the variables in scope while compiling a scenario.
+type scenarioVars map[string]any
+
+func (v scenarioVars) compile(name string, steps []*ScenarioStep) (*Sequence, error) {
+	seq := New(name)
+	for idx, step := range steps {
+		compiled, err := v.compileStep(name, step)
+		if err != nil {
+			return nil, fmt.Errorf("%s: step %d: %w", name, idx+1, err)
+		}
+		if compiled != nil {
+			seq.Then(compiled)
+		}
+	}
+	return seq, nil
+}
+
+func (v scenarioVars) compileStep(name string, step *ScenarioStep) (Step, error) {
+	switch {
+	case step.Command != "" && step.Duration == nil:
+		return &TriggerStep{Name: v.expand(step.Command)}, nil
+
+	case step.Command != "":
+		duration, err := v.number(step.Duration, 0)
+		if err != nil {
+			return nil, fmt.Errorf("duration: %w", err)
+		}
+		return &CommandStep{Name: v.expand(step.Command), Duration: duration}, nil
+
+	case step.Set != "":
+		return &SetStep{Name: v.expand(step.Set), Value: v.resolve(step.Value)}, nil
+
+	case step.Sleep != nil:
+		seconds, err := v.number(step.Sleep, 0)
+		if err != nil {
+			return nil, fmt.Errorf("sleep: %w", err)
+		}
+		return &SleepStep{Duration: secondsToDuration(seconds)}, nil
+
+	case step.Expect != "":
+		expect := &ExpectStep{Name: v.expand(step.Expect), Index: -1}
+		if step.Index != nil {
+			expect.Index = *step.Index
+		}
+		var err error
+		if expect.Min, err = v.number(step.Min, 0); err != nil {
+			return nil, fmt.Errorf("min: %w", err)
+		}
+		if expect.Max, err = v.number(step.Max, expect.Min); err != nil {
+			return nil, fmt.Errorf("max: %w", err)
+		}
+		timeout, err := v.number(step.Timeout, 0)
+		if err != nil {
+			return nil, fmt.Errorf("timeout: %w", err)
+		}
+		expect.Timeout = secondsToDuration(timeout)
+		return expect, nil
+
+	case step.Repeat != nil:
+		count, err := v.number(step.Repeat, 0)
+		if err != nil {
+			return nil, fmt.Errorf("repeat: %w", err)
+		}
+		loop := New(fmt.Sprintf("%s (repeat)", name))
+		for iteration := 1; iteration <= int(count); iteration++ {
+			if step.Var != "" {
+				v[step.Var] = float64(iteration)
+			}
+			body, err := v.compile(fmt.Sprintf("%s (iteration %d)", name, iteration), step.Steps)
+			if err != nil {
+				return nil, err
+			}
+			loop.Then(body)
+		}
+		return loop, nil
+
+	case step.Let != nil:
+		for key, value := range step.Let {
+			v[key] = v.resolve(value)
+		}
+		return nil, nil
+	}
+	return nil, fmt.Errorf("step has no action")
+}
+
+// expand substitutes variable references within a string.
+func (v scenarioVars) expand(text string) string {
+	return os.Expand(text, func(key string) string {
+		if value, ok := v[key]; ok {
+			return fmt.Sprint(value)
+		}
+		return "${" + key + "}"
+	})
+}
+
+// resolve substitutes variable references within a value.  A string which consists of only a
+// variable reference is replaced by the variable's value.
+func (v scenarioVars) resolve(value any) any {
+	switch typed := value.(type) {
+	case string:
+		if strings.HasPrefix(typed, "${") && strings.HasSuffix(typed, "}") &&
+			strings.Count(typed, "${") == 1 {
+			if resolved, ok := v[typed[2:len(typed)-1]]; ok {
+				return resolved
+			}
+		}
+		return v.expand(typed)
+	case []any:
+		resolved := make([]any, len(typed))
+		for idx, elem := range typed {
+			resolved[idx] = v.resolve(elem)
+		}
+		return resolved
+	}
+	return value
+}
+
+// number resolves a value to a number, returning the default if the value is nil.
+func (v scenarioVars) number(value any, def float64) (float64, error) {
+	switch typed := v.resolve(value).(type) {
+	case nil:
+		return def, nil
+	case float64:
+		return typed, nil
+	case string:
+		return strconv.ParseFloat(typed, 64)
+	default:
+		return 0, fmt.Errorf("expected a number, got %v", typed)
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,167 @@
+// Package sequence provides a reusable, abortable engine for scripted procedures, such as
+// starting an engine or configuring an aircraft for a phase of flight.  A [Sequence] is built from
+// steps which activate commands, write datarefs, wait for conditions, or pause, and is run against
+// a [xpweb.Client] with a context which may be cancelled to abort it.
+//
+//	seq := sequence.New("start skyhawk").
+//		ActivateCommand(command.SimElectrical_battery_1_on, 0).
+//		ActivateCommand("sim/engines/mixture_max", 0).
+//		ActivateCommand("sim/engines/engage_starters", 2).
+//		WaitForDataref("sim/flightmodel/engine/ENGN_running", func(val *xpweb.DatarefValue) bool {
+//			return val.GetIntArrayValue()[0] == 1
+//		}, 10*time.Second)
+//
+//	seq.OnProgress(func(p sequence.Progress) { fmt.Println(p) })
+//	err := seq.Run(ctx, client)
+package sequence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// Step is a single action within a [Sequence].
+type Step interface {
+	// Run performs the step against the specified client.
+	Run(ctx context.Context, client *xpweb.Client) error
+	// String returns a short human-readable description of the step.
+	String() string
+}
+
+// Progress describes the state of a running [Sequence], and is passed to a [ProgressHandler]
+// before and after each step.
+type Progress struct {
+	// The name of the sequence.
+	Sequence string
+	// The zero-based index of the step within the sequence.
+	Index int
+	// The number of steps in the sequence.
+	Total int
+	// The step being performed.
+	Step Step
+	// Whether the step has finished.
+	Done bool
+	// The error returned by the step, if it has finished and failed.
+	Err error
+}
+
+// String returns a human-readable description of the progress.
+func (p Progress) String() string {
+	status := "started"
+	if p.Done {
+		status = "done"
+		if p.Err != nil {
+			status = fmt.Sprintf("failed: %s", p.Err)
+		}
+	}
+	return fmt.Sprintf("%s [%d/%d] %s: %s", p.Sequence, p.Index+1, p.Total, p.Step, status)
+}
+
+// ProgressHandler is a function which is called to report the progress of a [Sequence].
+type ProgressHandler func(Progress)
+
+// Sequence is an ordered list of steps.  A Sequence is itself a [Step], so sequences may be
+// nested.
+type Sequence struct {
+	Name  string
+	Steps []Step
+	// The resources which the sequence controls, for use with [RunParallel].
+	Resources []string
+
+	progress []ProgressHandler
+}
+
+// New returns an empty sequence with the specified name.
+func New(name string) *Sequence {
+	return &Sequence{Name: name}
+}
+
+// Then appends the specified steps to the sequence.
+func (s *Sequence) Then(steps ...Step) *Sequence {
+	s.Steps = append(s.Steps, steps...)
+	return s
+}
+
+// ActivateCommand appends a step which activates the named command for the specified duration,
+// in seconds.  The step does not wait for the duration to elapse.
+func (s *Sequence) ActivateCommand(name string, duration float64) *Sequence {
+	return s.Then(&CommandStep{Name: name, Duration: duration})
+}
+
+// TriggerCommand appends a step which activates the named command using the semantics of its
+// [xpweb.CommandKind], as with [xpweb.Client.TriggerCommand].
+func (s *Sequence) TriggerCommand(name string) *Sequence {
+	return s.Then(&TriggerStep{Name: name})
+}
+
+// SetDataref appends a step which writes the specified value to the named dataref.
+func (s *Sequence) SetDataref(name string, value any) *Sequence {
+	return s.Then(&SetStep{Name: name, Value: value})
+}
+
+// WaitForDataref appends a step which waits until the value of the named dataref satisfies the
+// specified predicate.  The step fails if the timeout elapses first, or waits indefinitely if
+// timeout is 0.
+func (s *Sequence) WaitForDataref(
+	name string,
+	predicate func(*xpweb.DatarefValue) bool,
+	timeout time.Duration,
+) *Sequence {
+	return s.Then(&WaitStep{Name: name, Predicate: predicate, Timeout: timeout})
+}
+
+// Expect appends a step which waits until the value of the named dataref is between min and max,
+// inclusive.  The step fails if the timeout elapses first.
+func (s *Sequence) Expect(name string, min float64, max float64, timeout time.Duration) *Sequence {
+	return s.Then(&ExpectStep{Name: name, Index: -1, Min: min, Max: max, Timeout: timeout})
+}
+
+// Repeat appends a step which runs the specified sequence count times.
+func (s *Sequence) Repeat(count int, seq *Sequence) *Sequence {
+	return s.Then(&RepeatStep{Count: count, Sequence: seq})
+}
+
+// Sleep appends a step which pauses for the specified duration.
+func (s *Sequence) Sleep(duration time.Duration) *Sequence {
+	return s.Then(&SleepStep{Duration: duration})
+}
+
+// OnProgress adds a handler which is called before and after each step of the sequence.
+func (s *Sequence) OnProgress(handler ProgressHandler) *Sequence {
+	s.progress = append(s.progress, handler)
+	return s
+}
+
+// Run performs each step of the sequence in order against the specified client, calling any
+// progress handlers before and after each step.  The sequence stops at the first step which fails,
+// or when the context is done.
+func (s *Sequence) Run(ctx context.Context, client *xpweb.Client) error {
+	report := func(p Progress) {
+		for _, handler := range s.progress {
+			handler(p)
+		}
+	}
+
+	for idx, step := range s.Steps {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		p := Progress{Sequence: s.Name, Index: idx, Total: len(s.Steps), Step: step}
+		report(p)
+		err := step.Run(ctx, client)
+		p.Done, p.Err = true, err
+		report(p)
+		if err != nil {
+			return fmt.Errorf("%s: step %d (%s): %w", s.Name, idx+1, step, err)
+		}
+	}
+	return nil
+}
+
+// String returns the name of the sequence.
+func (s *Sequence) String() string {
+	return fmt.Sprintf("sequence %s", s.Name)
+}
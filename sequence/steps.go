@@ -0,0 +1,221 @@
+package sequence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// CommandStep is a [Step] which activates a command.
+type CommandStep struct {
+	Name     string
+	Duration float64
+}
+
+func (s *CommandStep) Run(ctx context.Context, client *xpweb.Client) error {
+	return client.ActivateCommand(ctx, s.Name, s.Duration)
+}
+
+func (s *CommandStep) String() string {
+	if s.Duration > 0 {
+		return fmt.Sprintf("activate %s for %vs", s.Name, s.Duration)
+	}
+	return fmt.Sprintf("activate %s", s.Name)
+}
+
+// TriggerStep is a [Step] which activates a command according to its [xpweb.CommandKind].
+type TriggerStep struct {
+	Name string
+}
+
+func (s *TriggerStep) Run(ctx context.Context, client *xpweb.Client) error {
+	return client.TriggerCommand(ctx, s.Name)
+}
+
+func (s *TriggerStep) String() string {
+	return fmt.Sprintf("trigger %s", s.Name)
+}
+
+// SetStep is a [Step] which writes a dataref value.
+type SetStep struct {
+	Name  string
+	Value any
+}
+
+func (s *SetStep) Run(ctx context.Context, client *xpweb.Client) error {
+	return client.SetValue(ctx, s.Name, s.Value)
+}
+
+func (s *SetStep) String() string {
+	return fmt.Sprintf("set %s to %v", s.Name, s.Value)
+}
+
+// WaitStep is a [Step] which waits for a dataref value to satisfy a predicate.
+type WaitStep struct {
+	Name      string
+	Predicate func(*xpweb.DatarefValue) bool
+	// The maximum time to wait, or 0 to wait until the context is done.
+	Timeout time.Duration
+}
+
+func (s *WaitStep) Run(ctx context.Context, client *xpweb.Client) error {
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = withClockTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	updates, err := client.SubscribeFor(subCtx, s.Name)
+	if err != nil {
+		return err
+	}
+
+	// check the current value, in case the condition is already met and the value never changes
+	val, err := client.GetValue(ctx, s.Name)
+	if err != nil {
+		return err
+	}
+	if s.Predicate(val) {
+		return nil
+	}
+
+	for {
+		select {
+		case msg, ok := <-updates:
+			if !ok {
+				return fmt.Errorf("waiting for %s: %w", s.Name, context.Cause(ctx))
+			}
+			for _, val := range msg.Data {
+				if s.Predicate(val) {
+					return nil
+				}
+			}
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for %s: %w", s.Name, context.Cause(ctx))
+		}
+	}
+}
+
+func (s *WaitStep) String() string {
+	return fmt.Sprintf("wait for %s", s.Name)
+}
+
+// ExpectStep is a [Step] which asserts that a dataref value comes within a range before a timeout.
+type ExpectStep struct {
+	Name string
+	// The element of an array dataref to check, or -1 for a scalar dataref.
+	Index int
+	// The minimum acceptable value, inclusive.
+	Min float64
+	// The maximum acceptable value, inclusive.
+	Max float64
+	// The maximum time to wait, or 0 to wait until the context is done.
+	Timeout time.Duration
+}
+
+func (s *ExpectStep) Run(ctx context.Context, client *xpweb.Client) error {
+	var last any
+	wait := &WaitStep{
+		Name:    s.Name,
+		Timeout: s.Timeout,
+		Predicate: func(val *xpweb.DatarefValue) bool {
+			value, ok := elementValue(val, s.Index)
+			if !ok {
+				return false
+			}
+			last = value
+			return value >= s.Min && value <= s.Max
+		},
+	}
+	if err := wait.Run(ctx, client); err != nil {
+		return fmt.Errorf("expected %s between %v and %v, last value %v: %w",
+			s.element(), s.Min, s.Max, last, err)
+	}
+	return nil
+}
+
+func (s *ExpectStep) String() string {
+	return fmt.Sprintf("expect %s between %v and %v", s.element(), s.Min, s.Max)
+}
+
+func (s *ExpectStep) element() string {
+	if s.Index < 0 {
+		return s.Name
+	}
+	return fmt.Sprintf("%s[%d]", s.Name, s.Index)
+}
+
+// elementValue returns the numeric value of the specified element of an array dataref value, or of
+// a scalar dataref value if index is negative.
+func elementValue(val *xpweb.DatarefValue, index int) (float64, bool) {
+	if index < 0 {
+		num, ok := val.Value.(float64)
+		return num, ok
+	}
+	switch values := val.Value.(type) {
+	case []float64:
+		if index < len(values) {
+			return values[index], true
+		}
+	case []any:
+		if index < len(values) {
+			num, ok := values[index].(float64)
+			return num, ok
+		}
+	}
+	return 0, false
+}
+
+// RepeatStep is a [Step] which runs a sequence a number of times.
+type RepeatStep struct {
+	Count    int
+	Sequence *Sequence
+}
+
+func (s *RepeatStep) Run(ctx context.Context, client *xpweb.Client) error {
+	for iteration := range s.Count {
+		if err := s.Sequence.Run(ctx, client); err != nil {
+			return fmt.Errorf("iteration %d: %w", iteration+1, err)
+		}
+	}
+	return nil
+}
+
+func (s *RepeatStep) String() string {
+	return fmt.Sprintf("repeat %s %d times", s.Sequence.Name, s.Count)
+}
+
+// SleepStep is a [Step] which pauses for a duration.
+type SleepStep struct {
+	Duration time.Duration
+}
+
+func (s *SleepStep) Run(ctx context.Context, client *xpweb.Client) error {
+	return clockFrom(ctx).Sleep(ctx, s.Duration)
+}
+
+func (s *SleepStep) String() string {
+	return fmt.Sprintf("sleep %s", s.Duration)
+}
+
+// StepFunc adapts an ordinary function to a [Step] with the specified description.
+func StepFunc(description string, fn func(ctx context.Context, client *xpweb.Client) error) Step {
+	return &funcStep{description: description, fn: fn}
+}
+
+type funcStep struct {
+	description string
+	fn          func(ctx context.Context, client *xpweb.Client) error
+}
+
+func (s *funcStep) Run(ctx context.Context, client *xpweb.Client) error {
+	return s.fn(ctx, client)
+}
+
+func (s *funcStep) String() string {
+	return s.description
+}
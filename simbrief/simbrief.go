@@ -0,0 +1,315 @@
+// Package simbrief imports operational flight plans (OFPs) from SimBrief, converting the route
+// into an X-Plane flight plan and applying the planned fuel and payload to the loaded aircraft.
+//
+//	ofp, err := simbrief.Fetch(ctx, "123456")
+//	if err != nil {
+//		return err
+//	}
+//	path := filepath.Join(xplaneDir, "Output", "FMS plans", ofp.FileName())
+//	if err := ofp.FlightPlan().Save(path); err != nil {
+//		return err
+//	}
+//	err = ofp.Apply(ctx, client)
+//
+// The latest OFP generated by the pilot is fetched.  The web API does not expose the FMS, so the
+// route is written as an .fms file to be loaded from the FMS; see the [flightplan] package.
+package simbrief
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/airplane"
+	"github.com/janeprather/xpweb/flightplan"
+)
+
+// FetchURL is the SimBrief API endpoint from which OFPs are fetched.
+var FetchURL = "https://www.simbrief.com/api/xml.fetcher.php"
+
+// kgPerLb converts pounds to kilograms.
+const kgPerLb = 0.45359237
+
+// OFP is the subset of a SimBrief operational flight plan which is applied to the simulator.
+// Weights are in kilograms, regardless of the units in which the OFP was planned.
+type OFP struct {
+	// The AIRAC cycle of the navigation data used to plan the flight.
+	AIRAC string
+	// The ICAO airline code and flight number, such as "BAW", "123".
+	Airline      string
+	FlightNumber string
+
+	Origin      Airport
+	Destination Airport
+	// The route, in ICAO flight plan notation.
+	Route string
+	// The initial cruise altitude, in feet.
+	CruiseAltitude float64
+
+	// The planned fuel on board at engine start.
+	RampFuel float64
+	// The planned payload, including passengers, baggage, and cargo.
+	Payload float64
+	// The number of passengers.
+	Passengers int
+
+	Fixes []Fix
+}
+
+// Airport is the origin or destination of the flight.
+type Airport struct {
+	ICAO string
+	// The planned runway, such as "16L".
+	Runway string
+	// The elevation in feet.
+	Elevation float64
+	Latitude  float64
+	Longitude float64
+}
+
+// Fix is a point of the navigation log.
+type Fix struct {
+	Ident string
+	// The fix type, such as "apt", "vor", "ndb", "wpt", or "ltlg".
+	Type string
+	// The airway, SID, or STAR by which the fix is reached, or "DCT".
+	Via string
+	// Whether the fix is part of a SID or STAR.
+	Procedure bool
+	// The planned altitude in feet.
+	Altitude  float64
+	Latitude  float64
+	Longitude float64
+}
+
+// ofpJSON is the JSON representation of the fetched OFP fields, in which all values are strings.
+type ofpJSON struct {
+	Fetch struct {
+		Status string `json:"status"`
+	} `json:"fetch"`
+	Params struct {
+		AIRAC string `json:"airac"`
+		Units string `json:"units"`
+	} `json:"params"`
+	General struct {
+		ICAOAirline     string `json:"icao_airline"`
+		FlightNumber    string `json:"flight_number"`
+		InitialAltitude string `json:"initial_altitude"`
+		Route           string `json:"route"`
+	} `json:"general"`
+	Origin      airportJSON `json:"origin"`
+	Destination airportJSON `json:"destination"`
+	Fuel        struct {
+		PlanRamp string `json:"plan_ramp"`
+	} `json:"fuel"`
+	Weights struct {
+		Payload  string `json:"payload"`
+		PaxCount string `json:"pax_count"`
+	} `json:"weights"`
+	Navlog struct {
+		Fix []struct {
+			Ident     string `json:"ident"`
+			Type      string `json:"type"`
+			ViaAirway string `json:"via_airway"`
+			IsSidStar string `json:"is_sid_star"`
+			Altitude  string `json:"altitude_feet"`
+			PosLat    string `json:"pos_lat"`
+			PosLong   string `json:"pos_long"`
+		} `json:"fix"`
+	} `json:"navlog"`
+}
+
+// airportJSON is the JSON representation of the origin or destination of the OFP.
+type airportJSON struct {
+	ICAOCode  string `json:"icao_code"`
+	Elevation string `json:"elevation"`
+	PosLat    string `json:"pos_lat"`
+	PosLong   string `json:"pos_long"`
+	PlanRwy   string `json:"plan_rwy"`
+}
+
+// decode returns the airport described by the JSON representation.
+func (a *airportJSON) decode() Airport {
+	return Airport{
+		ICAO:      a.ICAOCode,
+		Runway:    a.PlanRwy,
+		Elevation: parseNumber(a.Elevation),
+		Latitude:  parseNumber(a.PosLat),
+		Longitude: parseNumber(a.PosLong),
+	}
+}
+
+// Fetch returns the latest OFP generated by the SimBrief user with the specified pilot ID.
+func Fetch(ctx context.Context, pilotID string) (*OFP, error) {
+	query := url.Values{"userid": {pilotID}, "json": {"1"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, FetchURL+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	ofp, err := Parse(resp.Body)
+	if err != nil && resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching OFP: %s", resp.Status)
+	}
+	return ofp, err
+}
+
+// Parse reads an OFP in the JSON format of the SimBrief API.
+func Parse(r io.Reader) (*OFP, error) {
+	raw := &ofpJSON{}
+	if err := json.NewDecoder(r).Decode(raw); err != nil {
+		return nil, fmt.Errorf("decoding OFP: %w", err)
+	}
+	if status := raw.Fetch.Status; status != "" && status != "Success" {
+		return nil, fmt.Errorf("fetching OFP: %s", status)
+	}
+
+	weightScale := 1.0
+	if strings.EqualFold(raw.Params.Units, "lbs") {
+		weightScale = kgPerLb
+	}
+
+	ofp := &OFP{
+		AIRAC:          raw.Params.AIRAC,
+		Airline:        raw.General.ICAOAirline,
+		FlightNumber:   raw.General.FlightNumber,
+		Origin:         raw.Origin.decode(),
+		Destination:    raw.Destination.decode(),
+		Route:          raw.General.Route,
+		CruiseAltitude: parseNumber(raw.General.InitialAltitude),
+		RampFuel:       parseNumber(raw.Fuel.PlanRamp) * weightScale,
+		Payload:        parseNumber(raw.Weights.Payload) * weightScale,
+		Passengers:     int(parseNumber(raw.Weights.PaxCount)),
+	}
+	for _, fix := range raw.Navlog.Fix {
+		ofp.Fixes = append(ofp.Fixes, Fix{
+			Ident:     fix.Ident,
+			Type:      fix.Type,
+			Via:       fix.ViaAirway,
+			Procedure: fix.IsSidStar == "1",
+			Altitude:  parseNumber(fix.Altitude),
+			Latitude:  parseNumber(fix.PosLat),
+			Longitude: parseNumber(fix.PosLong),
+		})
+	}
+	return ofp, nil
+}
+
+// parseNumber parses a numeric string from the OFP, returning 0 if it is empty or malformed.
+func parseNumber(text string) float64 {
+	value, _ := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	return value
+}
+
+// FileName returns a conventional name for the .fms file of the OFP, such as "KSEAKPDX.fms".
+func (o *OFP) FileName() string {
+	return o.Origin.ICAO + o.Destination.ICAO + ".fms"
+}
+
+// FlightPlan converts the navigation log to a flight plan, from the origin to the destination.
+// Fixes which are part of a SID or STAR are omitted, as the FMS inserts them when the procedures
+// are selected.  The procedures themselves are not included in the OFP, so they must be selected
+// in the FMS.
+func (o *OFP) FlightPlan() *flightplan.FlightPlan {
+	plan := &flightplan.FlightPlan{
+		Cycle:             o.AIRAC,
+		Departure:         o.Origin.ICAO,
+		DepartureRunway:   runway(o.Origin.Runway),
+		Destination:       o.Destination.ICAO,
+		DestinationRunway: runway(o.Destination.Runway),
+	}
+	plan.Waypoints = append(plan.Waypoints, airportWaypoint(o.Origin, flightplan.ViaDeparture))
+	for _, fix := range o.Fixes {
+		if fix.Procedure || fix.Type == "apt" {
+			continue
+		}
+		wpt := flightplan.Waypoint{
+			Type:     waypointType(fix.Type),
+			Ident:    fix.Ident,
+			Via:      fix.Via,
+			Altitude: fix.Altitude,
+			Lat:      fix.Latitude,
+			Lon:      fix.Longitude,
+		}
+		if wpt.Via == "" || wpt.Via == "DCT" {
+			wpt.Via = flightplan.ViaDirect
+		}
+		plan.Waypoints = append(plan.Waypoints, wpt)
+	}
+	if len(plan.Waypoints) > 1 {
+		// the first en route waypoint is reached from the SID or departure
+		plan.Waypoints[1].Via = flightplan.ViaDirect
+	}
+	plan.Waypoints = append(plan.Waypoints,
+		airportWaypoint(o.Destination, flightplan.ViaDestination))
+	return plan
+}
+
+// airportWaypoint returns the flight plan waypoint of the origin or destination.
+func airportWaypoint(airport Airport, via string) flightplan.Waypoint {
+	return flightplan.Waypoint{
+		Type:     flightplan.WaypointAirport,
+		Ident:    airport.ICAO,
+		Via:      via,
+		Altitude: airport.Elevation,
+		Lat:      airport.Latitude,
+		Lon:      airport.Longitude,
+	}
+}
+
+// runway returns a runway designator in the form used by .fms files, such as "RW16L".
+func runway(designator string) string {
+	if designator == "" || strings.HasPrefix(designator, "RW") {
+		return designator
+	}
+	return "RW" + designator
+}
+
+// waypointType returns the flight plan waypoint type of an OFP fix type.
+func waypointType(fixType string) flightplan.WaypointType {
+	switch fixType {
+	case "apt":
+		return flightplan.WaypointAirport
+	case "ndb":
+		return flightplan.WaypointNDB
+	case "vor":
+		return flightplan.WaypointVOR
+	case "ltlg":
+		return flightplan.WaypointLatLon
+	}
+	return flightplan.WaypointFix
+}
+
+// Apply loads the planned ramp fuel and payload into the aircraft.  Fuel is distributed among the
+// tanks in proportion to their capacities.
+func (o *OFP) Apply(ctx context.Context, client *xpweb.Client) error {
+	wb, err := airplane.New(client).WeightBalance(ctx)
+	if err != nil {
+		return err
+	}
+	if wb.MaxFuel <= 0 {
+		return fmt.Errorf("aircraft has no fuel capacity")
+	}
+	if o.RampFuel > wb.MaxFuel {
+		return fmt.Errorf("planned fuel %.0f kg exceeds capacity of %.0f kg", o.RampFuel,
+			wb.MaxFuel)
+	}
+	if err := wb.SetFuelFraction(ctx, o.RampFuel/wb.MaxFuel); err != nil {
+		return fmt.Errorf("setting fuel: %w", err)
+	}
+	if err := wb.SetPayload(ctx, o.Payload); err != nil {
+		return fmt.Errorf("setting payload: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package xpweb
+
+import "sync"
+
+// Store retains the most recently received value of every dataref for which an update has been
+// delivered, whether from a websocket subscription or another [Transport].  The client's Store is
+// accessible via [Client.Store].
+type Store struct {
+	client *Client
+	values map[uint64]*DatarefValue
+	lock   sync.RWMutex
+}
+
+func newStore(client *Client) *Store {
+	return &Store{client: client, values: make(map[uint64]*DatarefValue)}
+}
+
+// update records the values contained in a dataref update message.
+func (s *Store) update(msg *WSMessageDatarefUpdate) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for id, val := range msg.Data {
+		s.values[id] = val
+	}
+}
+
+// GetByID returns the most recently received value of the dataref with the specified ID.  If no
+// value has been received, a value of nil is returned.
+func (s *Store) GetByID(id uint64) *DatarefValue {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.values[id]
+}
+
+// Get returns the most recently received value of the dataref with the specified name.  If no
+// value has been received, a value of nil is returned.
+func (s *Store) Get(name string) *DatarefValue {
+	dref := s.client.GetDatarefByName(name)
+	if dref == nil {
+		return nil
+	}
+	return s.GetByID(dref.ID)
+}
+
+// Snapshot returns the most recently received values of the datarefs with the specified names,
+// keyed by name.  Datarefs for which no value has been received are omitted.
+func (s *Store) Snapshot(names ...string) map[string]*DatarefValue {
+	values := make(map[string]*DatarefValue, len(names))
+	for _, name := range names {
+		if val := s.Get(name); val != nil {
+			values[name] = val
+		}
+	}
+	return values
+}
+
+// Store returns the client's [Store] of most recently received dataref values.
+func (c *Client) Store() *Store {
+	return c.store
+}
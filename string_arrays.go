@@ -0,0 +1,114 @@
+package xpweb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+)
+
+// Some data datarefs hold an array of strings rather than a single string, with each element
+// occupying a fixed number of bytes and padded with NUL bytes.  The element width is not provided
+// by the simulator, so it must be supplied by the caller from the dataref's documentation.
+
+// GetStringArrayValue returns the elements of a data dataref value which holds an array of
+// fixed-width strings, each of the specified width in bytes.  Each element is truncated at its
+// first NUL byte.  A trailing partial element is included.  If the value cannot be decoded or the
+// width is not positive, nil is returned.
+func (v *DatarefValue) GetStringArrayValue(width int) []string {
+	if width <= 0 {
+		return nil
+	}
+	data := v.GetByteArrayValue()
+	if data == nil {
+		return nil
+	}
+	elems := make([]string, 0, (len(data)+width-1)/width)
+	for chunk := range slices.Chunk(data, width) {
+		elems = append(elems, cString(chunk))
+	}
+	return elems
+}
+
+// GetStringElementValue returns the element at the specified index of a data dataref value which
+// holds an array of fixed-width strings, as with GetStringArrayValue.  If the index is out of
+// range, an empty string is returned.
+func (v *DatarefValue) GetStringElementValue(width int, index int) string {
+	elems := v.GetStringArrayValue(width)
+	if index < 0 || index >= len(elems) {
+		return ""
+	}
+	return elems[index]
+}
+
+// SetDatarefStringArrayValue writes the specified strings to a data dataref which holds an array
+// of fixed-width strings, each of the specified width in bytes.  Each string is NUL-padded to the
+// width, so must be shorter than the width to leave room for a terminator.
+func (c *RESTClient) SetDatarefStringArrayValue(
+	ctx context.Context,
+	name string,
+	width int,
+	values []string,
+) error {
+	data, err := packStrings(width, values)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrWriteFailed, name, err)
+	}
+	return c.SetDatarefValue(ctx, name, data)
+}
+
+// SetDatarefStringElementValue writes the specified string to the element at the specified index
+// of a data dataref which holds an array of fixed-width strings.  The current value is read and
+// written back with the element replaced, so concurrent writes to other elements of the same
+// dataref may be lost.  If the current value is too short to contain the element, it is extended
+// with NUL bytes.
+func (c *RESTClient) SetDatarefStringElementValue(
+	ctx context.Context,
+	name string,
+	width int,
+	index int,
+	value string,
+) error {
+	if index < 0 {
+		return fmt.Errorf("%w: %s: invalid index %d", ErrWriteFailed, name, index)
+	}
+	elem, err := packStrings(width, []string{value})
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrWriteFailed, name, err)
+	}
+
+	current, err := c.GetDatarefValue(ctx, name)
+	if err != nil {
+		return err
+	}
+	data := current.GetByteArrayValue()
+	if end := (index + 1) * width; len(data) < end {
+		data = append(data, make([]byte, end-len(data))...)
+	}
+	copy(data[index*width:], elem)
+	return c.SetDatarefValue(ctx, name, data)
+}
+
+// packStrings returns the specified strings as consecutive NUL-padded elements of the specified
+// width.
+func packStrings(width int, values []string) ([]byte, error) {
+	if width <= 0 {
+		return nil, fmt.Errorf("invalid element width %d", width)
+	}
+	data := make([]byte, width*len(values))
+	for idx, value := range values {
+		if len(value) >= width {
+			return nil, fmt.Errorf("element %d is too long for width %d", idx, width)
+		}
+		copy(data[idx*width:], value)
+	}
+	return data, nil
+}
+
+// cString returns the contents of the specified bytes up to the first NUL byte.
+func cString(data []byte) string {
+	if end := bytes.IndexByte(data, 0); end >= 0 {
+		data = data[:end]
+	}
+	return string(data)
+}
@@ -0,0 +1,170 @@
+package xpweb
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// subscribeForBufferSize is the capacity of the channel returned by Client.SubscribeFor.
+const subscribeForBufferSize = 16
+
+// refCounts tracks how many subscribers exist for each of a set of keys, so that overlapping
+// subscriptions result in a single upstream subscription.
+type refCounts[K comparable] struct {
+	counts map[K]int
+	lock   sync.Mutex
+}
+
+// acquire increments the count for each of the specified keys, and returns the keys whose count
+// was previously zero.
+func (r *refCounts[K]) acquire(keys ...K) (added []K) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.counts == nil {
+		r.counts = make(map[K]int)
+	}
+	for _, key := range keys {
+		if r.counts[key] == 0 {
+			added = append(added, key)
+		}
+		r.counts[key]++
+	}
+	return added
+}
+
+// release decrements the count for each of the specified keys, and returns the keys whose count
+// has reached zero.
+func (r *refCounts[K]) release(keys ...K) (removed []K) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, key := range keys {
+		count, exists := r.counts[key]
+		if !exists {
+			continue
+		}
+		if count <= 1 {
+			delete(r.counts, key)
+			removed = append(removed, key)
+		} else {
+			r.counts[key] = count - 1
+		}
+	}
+	return removed
+}
+
+// isHeld returns whether the specified key has any subscribers.
+func (r *refCounts[K]) isHeld(key K) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.counts[key] > 0
+}
+
+// keys returns the keys which have any subscribers.
+func (r *refCounts[K]) keys() []K {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return slices.Collect(maps.Keys(r.counts))
+}
+
+// rekey replaces each key with the key returned for it by the specified function, adding together
+// the counts of keys which are replaced by the same key, and discards the keys for which it
+// returns false.  It returns the resulting keys.
+func (r *refCounts[K]) rekey(replace func(key K) (K, bool)) []K {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	counts := make(map[K]int, len(r.counts))
+	for key, count := range r.counts {
+		if newKey, ok := replace(key); ok {
+			counts[newKey] += count
+		}
+	}
+	r.counts = counts
+	return slices.Collect(maps.Keys(counts))
+}
+
+// reset discards the counts for all keys.
+func (r *refCounts[K]) reset() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	clear(r.counts)
+}
+
+// SubscribeFor subscribes to the datarefs with the specified names using the selected
+// [Transport], and returns a channel which receives updates containing only those datarefs.  When
+// the context is done, the subscriptions are removed and the channel is closed, so that
+// request-scoped features do not leak subscriptions.
+//
+//	updates, err := client.SubscribeFor(r.Context(), "sim/cockpit2/gauges/indicators/airspeed_kts_pilot")
+//	if err != nil {
+//		return err
+//	}
+//	for msg := range updates {
+//		...
+//	}
+//
+// Updates are dropped if the channel's buffer is full, so the caller should receive from it
+// promptly.
+func (c *Client) SubscribeFor(
+	ctx context.Context,
+	names ...string,
+) (<-chan *WSMessageDatarefUpdate, error) {
+	// values are matched by name rather than ID, as IDs may change if the simulator is restarted
+	resolved := make(map[string]bool, len(names))
+	for _, name := range names {
+		dref, err := c.LookupDataref(name)
+		if err != nil {
+			return nil, err
+		}
+		resolved[dref.Name] = true
+	}
+
+	updates := make(chan *WSMessageDatarefUpdate, subscribeForBufferSize)
+	var closeLock sync.RWMutex
+	closed := false
+
+	listenerID := c.WS.addDatarefListener(func(msg *WSMessageDatarefUpdate) {
+		filtered := &WSMessageDatarefUpdate{Type: msg.Type, Data: make(WSDatarefValuesMap)}
+		for id, val := range msg.Data {
+			if val.Dataref != nil && resolved[val.Dataref.Name] {
+				filtered.Data[id] = val
+			}
+		}
+		if len(filtered.Data) == 0 {
+			return
+		}
+
+		closeLock.RLock()
+		defer closeLock.RUnlock()
+		if closed {
+			return
+		}
+		select {
+		case updates <- filtered:
+		default:
+		}
+	})
+
+	transport := c.Transport()
+	if err := transport.SubscribeDatarefs(ctx, names...); err != nil {
+		c.WS.removeDatarefListener(listenerID)
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		c.WS.removeDatarefListener(listenerID)
+		transport.UnsubscribeDatarefs(context.Background(), names...)
+
+		closeLock.Lock()
+		closed = true
+		close(updates)
+		closeLock.Unlock()
+	}()
+
+	return updates, nil
+}
@@ -0,0 +1,240 @@
+// Package telemetry exports dataref values as wide tables, with one row per update and one column
+// per dataref, for import into spreadsheets or time series tools such as Excel or Grafana.  Array
+// datarefs are expanded into one column per element, named with the element index, such as
+// sim/flightmodel/weight/m_fuel[0].
+//
+//	w := telemetry.NewWriter(file, telemetry.CSV,
+//		dataref.SimFlightmodelPosition_elevation,
+//		dataref.SimFlightmodelWeight_m_fuel,
+//	)
+//	if err := w.Attach(ctx, client); err != nil {
+//		return err
+//	}
+//
+// Each row holds the most recent value of every column at the time of the update.  Rows are not
+// written until a value has been received for every configured dataref, so that the columns of
+// array datarefs are known.
+package telemetry
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/janeprather/xpweb"
+)
+
+// Format is the file format in which rows are written.
+type Format int
+
+const (
+	// CSV writes a header row followed by one row of comma-separated values per update.
+	CSV Format = iota
+	// JSONL writes one JSON object per update, keyed by column name.
+	JSONL
+)
+
+// scalarWidth marks a dataref whose value is not an array, and so occupies a single column.
+const scalarWidth = -1
+
+// timeColumn is the name of the column holding the timestamp of each row.
+const timeColumn = "time"
+
+// Writer writes rows of dataref values.  It is safe for concurrent use.
+type Writer struct {
+	format   Format
+	datarefs []string
+	csv      *csv.Writer
+	json     *json.Encoder
+
+	latest  map[string]any
+	columns []string
+	widths  []int
+	err     error
+	lock    sync.Mutex
+}
+
+// NewWriter returns a [Writer] which writes rows in the specified format to w, with columns for
+// the specified datarefs in order.
+func NewWriter(w io.Writer, format Format, datarefs ...string) *Writer {
+	writer := &Writer{
+		format:   format,
+		datarefs: datarefs,
+		latest:   make(map[string]any),
+	}
+	if format == JSONL {
+		writer.json = json.NewEncoder(w)
+	} else {
+		writer.csv = csv.NewWriter(w)
+	}
+	return writer
+}
+
+// Attach subscribes to the configured datarefs and writes a row for each update until the context
+// is done.
+func (w *Writer) Attach(ctx context.Context, client *xpweb.Client) error {
+	updates, err := client.SubscribeFor(ctx, w.datarefs...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for msg := range updates {
+			w.HandleUpdate(msg)
+		}
+	}()
+	return nil
+}
+
+// HandleUpdate records the values in an update message and writes a row.  It has the signature of
+// an [xpweb.DatarefUpdateHandler], so a Writer may be attached directly to a client's update
+// stream.  Values of datarefs which were not configured are ignored.
+func (w *Writer) HandleUpdate(msg *xpweb.WSMessageDatarefUpdate) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	updated := false
+	for _, val := range msg.Data {
+		if val.Dataref == nil {
+			continue
+		}
+		for _, name := range w.datarefs {
+			if name == val.Dataref.Name {
+				w.latest[name] = val.Value
+				updated = true
+			}
+		}
+	}
+	if updated {
+		w.writeRow(time.Now())
+	}
+}
+
+// Err returns the first error encountered while writing, if any.  Once an error has occurred, no
+// further rows are written.
+func (w *Writer) Err() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.err
+}
+
+// writeRow writes a row holding the latest values, first writing the CSV header if the columns
+// have just become known.
+func (w *Writer) writeRow(t time.Time) {
+	if w.err != nil {
+		return
+	}
+	if w.columns == nil {
+		if len(w.latest) < len(w.datarefs) {
+			return
+		}
+		w.columns = w.expandColumns()
+		if w.csv != nil {
+			w.csv.Write(append([]string{timeColumn}, w.columns...))
+		}
+	}
+
+	cells := w.cells()
+	if w.json != nil {
+		row := make(map[string]any, len(w.columns)+1)
+		row[timeColumn] = t.Format(time.RFC3339Nano)
+		for idx, column := range w.columns {
+			row[column] = cells[idx]
+		}
+		w.err = w.json.Encode(row)
+		return
+	}
+
+	record := make([]string, 0, len(cells)+1)
+	record = append(record, t.Format(time.RFC3339Nano))
+	for _, cell := range cells {
+		record = append(record, formatCell(cell))
+	}
+	w.csv.Write(record)
+	w.csv.Flush()
+	w.err = w.csv.Error()
+}
+
+// expandColumns returns the column names, with one column per element of array values, and
+// records the number of elements of each array dataref.
+func (w *Writer) expandColumns() []string {
+	var columns []string
+	w.widths = make([]int, len(w.datarefs))
+	for idx, name := range w.datarefs {
+		length, isArray := arrayLen(w.latest[name])
+		if !isArray {
+			w.widths[idx] = scalarWidth
+			columns = append(columns, name)
+			continue
+		}
+		w.widths[idx] = length
+		for elem := range length {
+			columns = append(columns, fmt.Sprintf("%s[%d]", name, elem))
+		}
+	}
+	return columns
+}
+
+// cells returns the latest values in column order.  Array elements beyond the length first seen
+// are dropped, and missing elements are left empty.
+func (w *Writer) cells() []any {
+	cells := make([]any, 0, len(w.columns))
+	for idx, name := range w.datarefs {
+		value := w.latest[name]
+		if w.widths[idx] == scalarWidth {
+			cells = append(cells, value)
+			continue
+		}
+		for elem := range w.widths[idx] {
+			cells = append(cells, arrayElem(value, elem))
+		}
+	}
+	return cells
+}
+
+// arrayLen returns the number of elements of an array value, and whether it is an array.  Arrays
+// are a []float64 when received in a websocket update, or a []any otherwise.
+func arrayLen(value any) (int, bool) {
+	switch elems := value.(type) {
+	case []float64:
+		return len(elems), true
+	case []any:
+		return len(elems), true
+	}
+	return 0, false
+}
+
+// arrayElem returns the element of an array value at the specified index, or nil if there is no
+// such element.
+func arrayElem(value any, index int) any {
+	switch elems := value.(type) {
+	case []float64:
+		if index < len(elems) {
+			return elems[index]
+		}
+	case []any:
+		if index < len(elems) {
+			return elems[index]
+		}
+	}
+	return nil
+}
+
+// formatCell returns the CSV representation of a value.
+func formatCell(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case string:
+		return typed
+	default:
+		data, _ := json.Marshal(typed)
+		return string(data)
+	}
+}
@@ -0,0 +1,83 @@
+package xpweb
+
+import (
+	"sync"
+	"time"
+)
+
+// Sample is a numeric value observed at a point in time.
+type Sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// TimeSeries is a fixed capacity buffer of the most recent samples of a numeric value.  It is safe
+// for concurrent use.
+type TimeSeries struct {
+	samples []Sample
+	next    int
+	count   int
+	lock    sync.RWMutex
+}
+
+// NewTimeSeries returns a [TimeSeries] which retains up to the specified number of samples.
+func NewTimeSeries(capacity int) *TimeSeries {
+	return &TimeSeries{samples: make([]Sample, max(capacity, 2))}
+}
+
+// Add appends a sample, discarding the oldest sample if the buffer is full.
+func (ts *TimeSeries) Add(t time.Time, value float64) {
+	ts.lock.Lock()
+	defer ts.lock.Unlock()
+
+	ts.samples[ts.next] = Sample{Time: t, Value: value}
+	ts.next = (ts.next + 1) % len(ts.samples)
+	ts.count = min(ts.count+1, len(ts.samples))
+}
+
+// Len returns the number of samples in the buffer.
+func (ts *TimeSeries) Len() int {
+	ts.lock.RLock()
+	defer ts.lock.RUnlock()
+	return ts.count
+}
+
+// Samples returns the samples in the buffer, oldest first.
+func (ts *TimeSeries) Samples() []Sample {
+	ts.lock.RLock()
+	defer ts.lock.RUnlock()
+
+	samples := make([]Sample, 0, ts.count)
+	start := (ts.next - ts.count + len(ts.samples)) % len(ts.samples)
+	for idx := range ts.count {
+		samples = append(samples, ts.samples[(start+idx)%len(ts.samples)])
+	}
+	return samples
+}
+
+// Latest returns the most recent sample, and false if the buffer is empty.
+func (ts *TimeSeries) Latest() (Sample, bool) {
+	ts.lock.RLock()
+	defer ts.lock.RUnlock()
+
+	if ts.count == 0 {
+		return Sample{}, false
+	}
+	return ts.samples[(ts.next-1+len(ts.samples))%len(ts.samples)], true
+}
+
+// Derivative returns the rate of change per second between the oldest and most recent samples in
+// the buffer, and false if there are fewer than two samples or no time has elapsed between them.
+// A larger capacity yields a smoother rate over a longer period.
+func (ts *TimeSeries) Derivative() (float64, bool) {
+	samples := ts.Samples()
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := last.Time.Sub(first.Time).Seconds()
+	if elapsed <= 0 {
+		return 0, false
+	}
+	return (last.Value - first.Value) / elapsed, true
+}
@@ -0,0 +1,83 @@
+package xpweb
+
+import (
+	"context"
+	"strings"
+)
+
+// Tracer creates spans for API operations.  It mirrors the OpenTelemetry trace.Tracer closely
+// enough that an adapter requires only a few lines, without this package depending on
+// OpenTelemetry:
+//
+//	type otelTracer struct{ trace.Tracer }
+//
+//	func (t otelTracer) Start(ctx context.Context, name string, attrs ...xpweb.Attribute) (context.Context, xpweb.Span) {
+//		ctx, span := t.Tracer.Start(ctx, name, trace.WithAttributes(toOTel(attrs)...))
+//		return ctx, otelSpan{span}
+//	}
+//
+//	client, err := xpweb.NewClient(&xpweb.ClientConfig{
+//		Tracer: otelTracer{otel.Tracer("xpweb")},
+//	})
+type Tracer interface {
+	// Start creates a span and returns a context containing it.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a single traced operation created by a [Tracer].
+type Span interface {
+	// SetAttributes sets attributes of the span.
+	SetAttributes(attrs ...Attribute)
+	// RecordError records an error which caused the operation to fail.
+	RecordError(err error)
+	// End completes the span.
+	End()
+}
+
+// Attribute is a key and value describing a traced operation.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// startRESTSpan starts a span for a REST API request, if the client has a Tracer.
+func (c *Client) startRESTSpan(
+	ctx context.Context,
+	method string,
+	path string,
+) (context.Context, Span) {
+	if c.tracer == nil {
+		return ctx, nil
+	}
+	urlPath, _, _ := strings.Cut(path, "?")
+	return c.tracer.Start(ctx, "xpweb "+method+" "+urlPath,
+		Attribute{"http.request.method", method},
+		Attribute{"url.path", urlPath},
+	)
+}
+
+// startWSSpan starts a span for a websocket request, which is ended when its result is received.
+func (c *Client) startWSSpan(req *WSReq) {
+	if c.tracer == nil {
+		return
+	}
+	_, req.span = c.tracer.Start(context.Background(), "xpweb ws "+req.Type,
+		Attribute{"xpweb.ws.req_id", req.ReqID},
+		Attribute{"xpweb.ws.type", req.Type},
+	)
+}
+
+// endWSSpan ends the span of a websocket request with its result, if it has one.
+func endWSSpan(req *WSReq, msg *WSMessageResult) {
+	if req.span == nil {
+		return
+	}
+	if msg != nil {
+		req.span.SetAttributes(Attribute{"xpweb.ws.success", msg.Success})
+		if err := msg.Err(); err != nil {
+			req.span.RecordError(err)
+		}
+	}
+	req.span.End()
+	req.span = nil
+}
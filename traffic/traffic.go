@@ -0,0 +1,201 @@
+// Package traffic reads the simulator's AI and multiplayer traffic from the TCAS target datarefs
+// in sim/cockpit2/tcas/targets, decoded into [Traffic] structs.
+//
+// A single read is performed with [Read]:
+//
+//	targets, err := traffic.Read(ctx, client)
+//
+// A [Tracker] instead subscribes to the target datarefs and keeps the latest traffic available:
+//
+//	tracker := traffic.NewTracker(client)
+//	if err := tracker.Attach(ctx); err != nil {
+//		return err
+//	}
+//	...
+//	for _, target := range tracker.Traffic() {
+//		fmt.Println(target.Callsign, target.Latitude, target.Longitude)
+//	}
+//
+// The client's cache must be loaded, or LazyCache enabled.
+package traffic
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/dataref/drefcockpit2"
+)
+
+// textWidth is the number of bytes per target in the flight ID and ICAO type datarefs.
+const textWidth = 8
+
+// Traffic is the state of an aircraft other than the user's.
+type Traffic struct {
+	// The index of the target in the TCAS arrays, which identifies it for as long as it exists.
+	Index int
+	// The 24 bit Mode S address of the aircraft.
+	ModeS uint32
+	// The flight ID, such as a call sign or registration, which may be empty.
+	Callsign string
+	// The ICAO aircraft type designator, such as "B738", which may be empty.
+	ICAOType string
+	// Latitude in degrees.
+	Latitude float64
+	// Longitude in degrees.
+	Longitude float64
+	// Elevation above mean sea level in meters.
+	Elevation float64
+	// Pitch in degrees, positive nose up.
+	Pitch float64
+	// Roll in degrees, positive right wing down.
+	Roll float64
+	// True heading in degrees.
+	Heading float64
+	// True track over the ground in degrees.
+	Track float64
+	// Ground speed in meters per second.
+	GroundSpeed float64
+	// Vertical speed in feet per minute.
+	VerticalSpeed float64
+	// Whether the aircraft is on the ground.
+	OnGround bool
+}
+
+// Datarefs contains the names of the datarefs from which [Traffic] is built.  These may be
+// subscribed to, and the received values passed to [FromValues].
+var Datarefs = []string{
+	drefcockpit2.SimCockpit2TcasTargets_modeS_id,
+	drefcockpit2.SimCockpit2TcasTargets_flight_id,
+	drefcockpit2.SimCockpit2TcasTargets_icao_type,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_lat,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_lon,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_ele,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_the,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_phi,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_psi,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_hpath,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_V_msc,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_vertical_speed,
+	drefcockpit2.SimCockpit2TcasTargetsPosition_weight_on_wheels,
+}
+
+// FromValues builds the list of traffic from dataref values keyed by name, such as those returned
+// by [xpweb.Store.Snapshot] for [Datarefs].  The first element of each array is the user's
+// aircraft, which is omitted, as are unused targets, which have no Mode S address.  Missing
+// values are left as zero.
+func FromValues(values map[string]*xpweb.DatarefValue) []Traffic {
+	ids := values[drefcockpit2.SimCockpit2TcasTargets_modeS_id].GetIntArrayValue()
+	callsigns := values[drefcockpit2.SimCockpit2TcasTargets_flight_id].GetStringArrayValue(textWidth)
+	types := values[drefcockpit2.SimCockpit2TcasTargets_icao_type].GetStringArrayValue(textWidth)
+	floats := func(name string) func(int) float64 {
+		elems := values[name].GetFloatArrayValue()
+		return func(idx int) float64 {
+			if idx < len(elems) {
+				return elems[idx]
+			}
+			return 0
+		}
+	}
+	lat := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_lat)
+	lon := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_lon)
+	ele := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_ele)
+	pitch := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_the)
+	roll := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_phi)
+	heading := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_psi)
+	track := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_hpath)
+	speed := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_V_msc)
+	vspeed := floats(drefcockpit2.SimCockpit2TcasTargetsPosition_vertical_speed)
+	onGround := values[drefcockpit2.SimCockpit2TcasTargetsPosition_weight_on_wheels].GetIntArrayValue()
+
+	var targets []Traffic
+	for idx := 1; idx < len(ids); idx++ {
+		if ids[idx] == 0 {
+			continue
+		}
+		target := Traffic{
+			Index:         idx,
+			ModeS:         uint32(ids[idx]) & 0xFFFFFF,
+			Latitude:      lat(idx),
+			Longitude:     lon(idx),
+			Elevation:     ele(idx),
+			Pitch:         pitch(idx),
+			Roll:          roll(idx),
+			Heading:       heading(idx),
+			Track:         track(idx),
+			GroundSpeed:   speed(idx),
+			VerticalSpeed: vspeed(idx),
+			OnGround:      idx < len(onGround) && onGround[idx] != 0,
+		}
+		if idx < len(callsigns) {
+			target.Callsign = strings.TrimSpace(callsigns[idx])
+		}
+		if idx < len(types) {
+			target.ICAOType = strings.TrimSpace(types[idx])
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+// Read returns the current traffic, reading each of the target datarefs once.
+func Read(ctx context.Context, client *xpweb.Client) ([]Traffic, error) {
+	values := make(map[string]*xpweb.DatarefValue, len(Datarefs))
+	for _, name := range Datarefs {
+		val, err := client.GetValue(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		values[name] = val
+	}
+	return FromValues(values), nil
+}
+
+// Tracker keeps the latest traffic, refreshed by a subscription to the target datarefs.  It is
+// safe for concurrent use.
+type Tracker struct {
+	client *xpweb.Client
+	lock   sync.Mutex
+	latest map[string]*xpweb.DatarefValue
+}
+
+// NewTracker instantiates and returns a pointer to a new [Tracker] which uses the specified
+// client.
+func NewTracker(client *xpweb.Client) *Tracker {
+	return &Tracker{client: client, latest: make(map[string]*xpweb.DatarefValue)}
+}
+
+// Attach subscribes to the target datarefs and records their values as they are updated until
+// the context is done.
+func (t *Tracker) Attach(ctx context.Context) error {
+	updates, err := t.client.SubscribeFor(ctx, Datarefs...)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for msg := range updates {
+			t.HandleUpdate(msg)
+		}
+	}()
+	return nil
+}
+
+// HandleUpdate records the target values in an update message.  It has the signature of an
+// [xpweb.DatarefUpdateHandler].
+func (t *Tracker) HandleUpdate(msg *xpweb.WSMessageDatarefUpdate) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, val := range msg.Data {
+		if val.Dataref != nil {
+			t.latest[val.Dataref.Name] = val
+		}
+	}
+}
+
+// Traffic returns the latest traffic.  It is empty until the first update has been received.
+func (t *Tracker) Traffic() []Traffic {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return FromValues(t.latest)
+}
@@ -0,0 +1,293 @@
+package xpweb
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaultPollInterval is the interval at which a [RESTTransport] reads subscribed datarefs.
+const defaultPollInterval time.Duration = time.Second
+
+// Transport is a means of reading and writing dataref values, activating commands, and
+// subscribing to dataref updates.  Updates for subscribed datarefs are delivered as
+// [WSMessageDatarefUpdate] messages to the DatarefUpdateHandler specified in the [ClientConfig],
+// regardless of the transport used, so that application code does not depend on it.
+//
+// The transport used by the [Client] agnostic methods may be selected with
+// [Client.SetTransport].
+type Transport interface {
+	// GetDatarefValue returns the current value of the dataref with the specified name.
+	GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error)
+	// SetDatarefValue applies the specified value to the dataref with the specified name.
+	SetDatarefValue(ctx context.Context, name string, value any) error
+	// ActivateCommand runs the command with the specified name for a fixed duration.
+	ActivateCommand(ctx context.Context, name string, duration float64) error
+	// SubscribeDatarefs begins delivering updates for the datarefs with the specified names.
+	// Subscriptions are counted, so that a dataref remains subscribed until UnsubscribeDatarefs
+	// has been called for it as many times as SubscribeDatarefs.
+	SubscribeDatarefs(ctx context.Context, names ...string) error
+	// UnsubscribeDatarefs releases subscriptions made with SubscribeDatarefs.
+	UnsubscribeDatarefs(ctx context.Context, names ...string) error
+}
+
+// SplitTransport is a [Transport] which performs each kind of operation using a separately
+// specified transport, allowing mixed deployments.  For example, values may be read via REST
+// while subscriptions use the websocket service.
+type SplitTransport struct {
+	Values        Transport
+	Commands      Transport
+	Subscriptions Transport
+}
+
+// GetDatarefValue reads a dataref value using the Values transport.
+func (t *SplitTransport) GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error) {
+	return t.Values.GetDatarefValue(ctx, name)
+}
+
+// SetDatarefValue writes a dataref value using the Values transport.
+func (t *SplitTransport) SetDatarefValue(ctx context.Context, name string, value any) error {
+	return t.Values.SetDatarefValue(ctx, name, value)
+}
+
+// ActivateCommand activates a command using the Commands transport.
+func (t *SplitTransport) ActivateCommand(ctx context.Context, name string, duration float64) error {
+	return t.Commands.ActivateCommand(ctx, name, duration)
+}
+
+// SubscribeDatarefs subscribes to datarefs using the Subscriptions transport.
+func (t *SplitTransport) SubscribeDatarefs(ctx context.Context, names ...string) error {
+	return t.Subscriptions.SubscribeDatarefs(ctx, names...)
+}
+
+// UnsubscribeDatarefs unsubscribes from datarefs using the Subscriptions transport.
+func (t *SplitTransport) UnsubscribeDatarefs(ctx context.Context, names ...string) error {
+	return t.Subscriptions.UnsubscribeDatarefs(ctx, names...)
+}
+
+// RESTTransport is a [Transport] which performs all operations via the REST API.  Subscribed
+// datarefs are polled with a [Poller].
+type RESTTransport struct {
+	client   *Client
+	interval time.Duration
+	poller   *Poller
+	refs     refCounts[string]
+	cancel   context.CancelFunc
+	lock     sync.Mutex
+}
+
+// NewRESTTransport returns a [Transport] which uses the REST API, polling subscribed datarefs at
+// the specified interval.  If the interval is zero, a default of one second will be used.
+func (c *Client) NewRESTTransport(interval time.Duration) *RESTTransport {
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	return &RESTTransport{
+		client:   c,
+		interval: interval,
+		poller:   c.NewPoller(),
+	}
+}
+
+// GetDatarefValue reads a dataref value via [RESTClient.GetDatarefValue].
+func (t *RESTTransport) GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error) {
+	return t.client.REST.GetDatarefValue(ctx, name)
+}
+
+// SetDatarefValue writes a dataref value via [RESTClient.SetDatarefValue].
+func (t *RESTTransport) SetDatarefValue(ctx context.Context, name string, value any) error {
+	return t.client.REST.SetDatarefValue(ctx, name, value)
+}
+
+// ActivateCommand activates a command via [RESTClient.ActivateCommand].
+func (t *RESTTransport) ActivateCommand(ctx context.Context, name string, duration float64) error {
+	return t.client.REST.ActivateCommand(ctx, name, duration)
+}
+
+// SubscribeDatarefs adds the specified datarefs to the transport's [Poller], starting it if it is
+// not already running.
+func (t *RESTTransport) SubscribeDatarefs(ctx context.Context, names ...string) error {
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		dref, err := t.client.LookupDataref(name)
+		if err != nil {
+			return err
+		}
+		resolved = append(resolved, dref.Name)
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for _, name := range t.refs.acquire(resolved...) {
+		if err := t.poller.Add(name, t.interval); err != nil {
+			t.refs.release(name)
+			return err
+		}
+	}
+	if t.cancel == nil {
+		var pollCtx context.Context
+		pollCtx, t.cancel = context.WithCancel(context.Background())
+		go t.poller.Run(pollCtx)
+	}
+	return nil
+}
+
+// UnsubscribeDatarefs removes the specified datarefs from the transport's [Poller] once they no
+// longer have any subscribers.
+func (t *RESTTransport) UnsubscribeDatarefs(ctx context.Context, names ...string) error {
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		resolved = append(resolved, t.client.resolveAlias(name))
+	}
+	for _, name := range t.refs.release(resolved...) {
+		t.poller.Remove(name)
+	}
+	return nil
+}
+
+// Close stops the transport's [Poller].
+func (t *RESTTransport) Close() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.cancel != nil {
+		t.cancel()
+		t.cancel = nil
+	}
+}
+
+// ConnectWithFallback attempts to establish a websocket connection, and if that fails, selects a
+// [RESTTransport] polling subscribed datarefs at the specified interval, so that the
+// transport-agnostic methods such as [Client.Watch] work regardless of whether the websocket
+// service is available.  The selected transport is returned.
+func (c *Client) ConnectWithFallback(pollInterval time.Duration) Transport {
+	if err := c.WS.Connect(); err != nil {
+		c.logger.Warn("websocket unavailable, falling back to REST polling", "error", err)
+		transport := c.NewRESTTransport(pollInterval)
+		c.SetTransport(transport)
+		return transport
+	}
+	return c.Transport()
+}
+
+// WSTransport is a [Transport] which performs all operations via the websocket service.  The
+// websocket must be connected with [WSClient.Connect] before it is used.
+type WSTransport struct {
+	client *Client
+}
+
+// NewWSTransport returns a [Transport] which uses the websocket service.
+func (c *Client) NewWSTransport() *WSTransport {
+	return &WSTransport{client: c}
+}
+
+// GetDatarefValue reads a dataref value by subscribing to it and waiting for the first update.
+func (t *WSTransport) GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error) {
+	dref, err := t.client.LookupDataref(name)
+	if err != nil {
+		return nil, err
+	}
+	values, err := t.client.WS.snapshot(ctx, dref.ID)
+	if err != nil {
+		return nil, err
+	}
+	return values[dref.ID], nil
+}
+
+// SetDatarefValue writes a dataref value with a dataref_set_values request.
+func (t *WSTransport) SetDatarefValue(ctx context.Context, name string, value any) error {
+	if err := t.client.checkWriter(); err != nil {
+		return err
+	}
+	dref, err := t.client.LookupDataref(name)
+	if err != nil {
+		return err
+	}
+	if err := validateDatarefValue(dref, value); err != nil {
+		return err
+	}
+	payload := genSetDatarefValuePayload(dref.ValueType, value)
+	return t.client.WS.NewReq().DatarefSet(NewWSDatarefValue(dref.ID, payload.Data)).Send()
+}
+
+// ActivateCommand activates a command with a command_set_is_active request.
+func (t *WSTransport) ActivateCommand(ctx context.Context, name string, duration float64) error {
+	if err := t.client.checkWriter(); err != nil {
+		return err
+	}
+	if err := validateDuration(duration); err != nil {
+		return err
+	}
+	cmd, err := t.client.LookupCommand(name)
+	if err != nil {
+		return err
+	}
+	return t.client.WS.NewReq().CommandSetIsActive(
+		NewWSCommand(cmd.ID, true).WithDuration(duration),
+	).Send()
+}
+
+// SubscribeDatarefs subscribes to datarefs with a dataref_subscribe_values request.
+func (t *WSTransport) SubscribeDatarefs(ctx context.Context, names ...string) error {
+	ids, err := t.client.WS.lookupDatarefIDs(names)
+	if err != nil {
+		return err
+	}
+	return t.client.WS.subscribeDatarefIDs(ids...)
+}
+
+// UnsubscribeDatarefs unsubscribes from datarefs with a dataref_unsubscribe_values request.
+func (t *WSTransport) UnsubscribeDatarefs(ctx context.Context, names ...string) error {
+	ids, err := t.client.WS.lookupDatarefIDs(names)
+	if err != nil {
+		return err
+	}
+	return t.client.WS.unsubscribeDatarefIDs(ids...)
+}
+
+// SetTransport selects the [Transport] used by the client's transport-agnostic methods.  By
+// default, a [SplitTransport] is used which reads and writes values and activates commands via
+// REST, and subscribes to datarefs via the websocket service.
+func (c *Client) SetTransport(transport Transport) {
+	c.dataTransportLock.Lock()
+	defer c.dataTransportLock.Unlock()
+	c.dataTransport = transport
+}
+
+// Transport returns the [Transport] used by the client's transport-agnostic methods.
+func (c *Client) Transport() Transport {
+	c.dataTransportLock.RLock()
+	defer c.dataTransportLock.RUnlock()
+	return c.dataTransport
+}
+
+// GetValue returns the current value of the dataref with the specified name using the selected
+// [Transport].
+func (c *Client) GetValue(ctx context.Context, name string) (*DatarefValue, error) {
+	return c.Transport().GetDatarefValue(ctx, name)
+}
+
+// SetValue applies the specified value to the dataref with the specified name using the selected
+// [Transport].
+func (c *Client) SetValue(ctx context.Context, name string, value any) error {
+	return c.Transport().SetDatarefValue(ctx, name, value)
+}
+
+// ActivateCommand runs the command with the specified name for a fixed duration using the
+// selected [Transport].
+func (c *Client) ActivateCommand(ctx context.Context, name string, duration float64) error {
+	return c.Transport().ActivateCommand(ctx, name, duration)
+}
+
+// Subscribe begins delivering updates for the datarefs with the specified names using the
+// selected [Transport].
+func (c *Client) Subscribe(ctx context.Context, names ...string) error {
+	return c.Transport().SubscribeDatarefs(ctx, names...)
+}
+
+// Unsubscribe stops delivering updates for the datarefs with the specified names using the
+// selected [Transport].
+func (c *Client) Unsubscribe(ctx context.Context, names ...string) error {
+	return c.Transport().UnsubscribeDatarefs(ctx, names...)
+}
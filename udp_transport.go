@@ -0,0 +1,347 @@
+package xpweb
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"reflect"
+	"sync"
+)
+
+// Sizes and defaults of the legacy UDP protocol.
+const (
+	udpRREFNameSize  = 400
+	udpDREFNameSize  = 500
+	udpMaxPacketSize = 1500
+	// DefaultUDPPort is the port on which X-Plane receives legacy UDP protocol datagrams.
+	DefaultUDPPort = 49000
+	// defaultUDPFrequency is the number of updates per second requested for subscriptions, if no
+	// frequency is specified.
+	defaultUDPFrequency = 20
+	// udpSyntheticIDBase is the first ID assigned to datarefs which are not in the cache.  Such
+	// IDs are used to key update messages, and do not collide with IDs assigned by the simulator.
+	udpSyntheticIDBase = uint64(1) << 63
+)
+
+// UDPTransport is a [Transport] which uses X-Plane's legacy UDP protocol: RREF datagrams to
+// subscribe to and read datarefs, DREF datagrams to write them, and CMND datagrams to trigger
+// commands.  It may be used where the web API is unavailable.  Subscription updates are delivered
+// to the same handlers which receive websocket subscription updates.
+//
+// The protocol transfers every value as a 32-bit float, so int values are delivered as float64
+// values like those of the web API, and array elements must be addressed individually by names
+// such as sim/flightmodel/engine/ENGN_N1_[0].  Datarefs which are not found in the cache are
+// delivered with a float value type and an ID assigned by the transport.
+//
+//	udp, err := client.NewUDPTransport("192.168.1.10:49000", 20)
+//	if err != nil {
+//		return err
+//	}
+//	defer udp.Close()
+//	client.SetTransport(udp)
+type UDPTransport struct {
+	client    *Client
+	conn      *net.UDPConn
+	frequency int32
+
+	subscriptions refCounts[string]
+
+	// lock also serializes the RREF requests which start and stop each dataref being sent, with
+	// the changes to subscriptions and readers which decide them
+	lock     sync.Mutex
+	indexes  map[string]int32
+	datarefs map[int32]*Dataref
+	waiters  map[int32][]chan float32
+	// the number of GetDatarefValue calls waiting for each index
+	readers   map[int32]int
+	nextIndex int32
+	nextID    uint64
+}
+
+// NewUDPTransport instantiates and returns a pointer to a new [UDPTransport] which communicates
+// with the simulator at the specified address, such as "localhost:49000".  Subscribed datarefs are
+// requested at the specified number of updates per second, or 20 if it is not positive.  The
+// transport should be closed when no longer needed.
+func (c *Client) NewUDPTransport(addr string, frequency int) (*UDPTransport, error) {
+	raddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid UDP address: %w", err)
+	}
+	conn, err := net.DialUDP("udp", nil, raddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open UDP socket: %w", err)
+	}
+	if frequency <= 0 {
+		frequency = defaultUDPFrequency
+	}
+
+	t := &UDPTransport{
+		client:    c,
+		conn:      conn,
+		frequency: int32(frequency),
+		indexes:   make(map[string]int32),
+		datarefs:  make(map[int32]*Dataref),
+		waiters:   make(map[int32][]chan float32),
+		readers:   make(map[int32]int),
+		nextID:    udpSyntheticIDBase,
+	}
+	go t.readLoop()
+	return t, nil
+}
+
+// Close cancels all subscriptions and closes the UDP socket.
+func (t *UDPTransport) Close() error {
+	t.lock.Lock()
+	for name := range t.indexes {
+		t.sendRREF(name, t.indexes[name], 0)
+	}
+	t.lock.Unlock()
+	return t.conn.Close()
+}
+
+// GetDatarefValue reads a dataref value by requesting it with an RREF datagram and waiting for
+// the first value received.  Concurrent reads of a dataref share a single request, which is
+// stopped once the last of them returns, unless the dataref is subscribed.
+func (t *UDPTransport) GetDatarefValue(ctx context.Context, name string) (*DatarefValue, error) {
+	waiter := make(chan float32, 1)
+	t.lock.Lock()
+	index, dref := t.register(name)
+	t.readers[index]++
+	if t.readers[index] == 1 && !t.subscriptions.isHeld(name) {
+		if err := t.sendRREF(name, index, t.frequency); err != nil {
+			t.releaseReader(name, index)
+			t.lock.Unlock()
+			return nil, err
+		}
+	}
+	t.waiters[index] = append(t.waiters[index], waiter)
+	t.lock.Unlock()
+
+	defer func() {
+		t.lock.Lock()
+		t.releaseReader(name, index)
+		t.lock.Unlock()
+	}()
+
+	select {
+	case value := <-waiter:
+		return &DatarefValue{Dataref: dref, Value: float64(value)}, nil
+	case <-ctx.Done():
+		t.removeWaiter(index, waiter)
+		return nil, fmt.Errorf("reading %s: %w", name, ctx.Err())
+	}
+}
+
+// SetDatarefValue writes a numeric dataref value with a DREF datagram.
+func (t *UDPTransport) SetDatarefValue(ctx context.Context, name string, value any) error {
+	if err := t.client.checkWriter(); err != nil {
+		return err
+	}
+	if dref := t.client.GetDatarefByName(name); dref != nil {
+		if err := validateDatarefValue(dref, value); err != nil {
+			return err
+		}
+	}
+	floatValue, err := udpFloatValue(value)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrWriteFailed, name, err)
+	}
+
+	packet := make([]byte, 0, 5+4+udpDREFNameSize)
+	packet = append(packet, "DREF\x00"...)
+	packet = binary.LittleEndian.AppendUint32(packet, math.Float32bits(floatValue))
+	packet = appendPadded(packet, name, udpDREFNameSize)
+	_, err = t.conn.Write(packet)
+	return err
+}
+
+// ActivateCommand triggers a command with a CMND datagram.  The protocol cannot hold a command, so
+// an error matching errors.ErrUnsupported is returned for a non-zero duration.
+func (t *UDPTransport) ActivateCommand(ctx context.Context, name string, duration float64) error {
+	if err := t.client.checkWriter(); err != nil {
+		return err
+	}
+	if duration != 0 {
+		return fmt.Errorf("%w: UDP commands cannot be held for a duration", errors.ErrUnsupported)
+	}
+	packet := append([]byte("CMND\x00"), name...)
+	packet = append(packet, 0)
+	_, err := t.conn.Write(packet)
+	return err
+}
+
+// SubscribeDatarefs subscribes to datarefs with RREF datagrams.
+func (t *UDPTransport) SubscribeDatarefs(ctx context.Context, names ...string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, name := range t.subscriptions.acquire(names...) {
+		index, _ := t.register(name)
+		if t.readers[index] > 0 {
+			// already requested by a read
+			continue
+		}
+		if err := t.sendRREF(name, index, t.frequency); err != nil {
+			t.subscriptions.release(names...)
+			return err
+		}
+	}
+	return nil
+}
+
+// UnsubscribeDatarefs releases subscriptions made with SubscribeDatarefs, sending RREF datagrams
+// with a frequency of zero for datarefs which are no longer subscribed or being read.
+func (t *UDPTransport) UnsubscribeDatarefs(ctx context.Context, names ...string) error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, name := range t.subscriptions.release(names...) {
+		index := t.indexes[name]
+		if t.readers[index] > 0 {
+			// stopped once the last read returns
+			continue
+		}
+		if err := t.sendRREF(name, index, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseReader records that a GetDatarefValue call has returned, and stops the request for the
+// dataref if it was the last reader and the dataref is not subscribed.  The lock must be held.
+func (t *UDPTransport) releaseReader(name string, index int32) {
+	t.readers[index]--
+	if t.readers[index] > 0 {
+		return
+	}
+	delete(t.readers, index)
+	if !t.subscriptions.isHeld(name) {
+		t.sendRREF(name, index, 0)
+	}
+}
+
+// register returns the RREF index and dataref for the specified name, assigning them if the name
+// has not been seen before.  The lock must be held.
+func (t *UDPTransport) register(name string) (int32, *Dataref) {
+	if index, exists := t.indexes[name]; exists {
+		return index, t.datarefs[index]
+	}
+
+	dref := t.client.GetDatarefByName(name)
+	if dref == nil {
+		dref = &Dataref{ID: t.nextID, Name: name, ValueType: ValueTypeFloat, IsWritable: true}
+		t.nextID++
+	}
+	index := t.nextIndex
+	t.nextIndex++
+	t.indexes[name] = index
+	t.datarefs[index] = dref
+	return index, dref
+}
+
+// removeWaiter removes a channel registered by GetDatarefValue.
+func (t *UDPTransport) removeWaiter(index int32, waiter chan float32) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	waiters := t.waiters[index]
+	for i, w := range waiters {
+		if w == waiter {
+			t.waiters[index] = append(waiters[:i], waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+// sendRREF requests the dataref with the specified name to be sent at the specified frequency,
+// identified by the specified index.  A frequency of zero stops the dataref being sent.
+func (t *UDPTransport) sendRREF(name string, index int32, frequency int32) error {
+	packet := make([]byte, 0, 5+8+udpRREFNameSize)
+	packet = append(packet, "RREF\x00"...)
+	packet = binary.LittleEndian.AppendUint32(packet, uint32(frequency))
+	packet = binary.LittleEndian.AppendUint32(packet, uint32(index))
+	packet = appendPadded(packet, name, udpRREFNameSize)
+	_, err := t.conn.Write(packet)
+	return err
+}
+
+// readLoop receives RREF datagrams until the socket is closed, delivering subscribed values as
+// update messages and passing values to any waiting readers.
+func (t *UDPTransport) readLoop() {
+	buf := make([]byte, udpMaxPacketSize)
+	for {
+		n, err := t.conn.Read(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			t.client.logger.Error("failed to read UDP datagram", "error", err)
+			continue
+		}
+		packet := buf[:n]
+		// the RREF header is followed by a single byte, then pairs of index and value
+		if len(packet) < 5 || !bytes.HasPrefix(packet, []byte("RREF")) {
+			continue
+		}
+		t.handleRREF(packet[5:])
+	}
+}
+
+// handleRREF processes the index and value pairs of an RREF datagram.
+func (t *UDPTransport) handleRREF(data []byte) {
+	msg := &WSMessageDatarefUpdate{
+		Type: MessageTypeDatarefUpdate,
+		Data: make(WSDatarefValuesMap),
+	}
+
+	t.lock.Lock()
+	for ; len(data) >= 8; data = data[8:] {
+		index := int32(binary.LittleEndian.Uint32(data[0:4]))
+		value := math.Float32frombits(binary.LittleEndian.Uint32(data[4:8]))
+		dref, exists := t.datarefs[index]
+		if !exists {
+			continue
+		}
+		for _, waiter := range t.waiters[index] {
+			waiter <- value
+		}
+		delete(t.waiters, index)
+		if t.subscriptions.isHeld(dref.Name) {
+			msg.Data[dref.ID] = &DatarefValue{Dataref: dref, Value: float64(value)}
+		}
+	}
+	t.lock.Unlock()
+
+	if len(msg.Data) > 0 {
+		t.client.WS.deliverDatarefUpdate(msg)
+	}
+}
+
+// appendPadded appends the string to the packet, padded with null bytes to the specified size.
+func appendPadded(packet []byte, value string, size int) []byte {
+	field := make([]byte, size)
+	copy(field[:size-1], value)
+	return append(packet, field...)
+}
+
+// udpFloatValue converts a numeric value to the float32 form used by the UDP protocol.
+func udpFloatValue(value any) (float32, error) {
+	v := reflect.ValueOf(value)
+	switch {
+	case v.CanFloat():
+		return float32(v.Float()), nil
+	case v.CanInt():
+		return float32(v.Int()), nil
+	case v.CanUint():
+		return float32(v.Uint()), nil
+	case v.Kind() == reflect.Bool:
+		if v.Bool() {
+			return 1, nil
+		}
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("UDP writes require a numeric value, not %T", value)
+	}
+}
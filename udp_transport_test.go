@@ -0,0 +1,164 @@
+package xpweb
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"net"
+	"testing"
+	"time"
+)
+
+// rrefRequest is an RREF datagram received by udpSim.
+type rrefRequest struct {
+	frequency int32
+	index     int32
+}
+
+// udpSim is a minimal simulator which receives RREF requests, and sends values on request.
+type udpSim struct {
+	conn     *net.UDPConn
+	requests chan rrefRequest
+	peer     chan *net.UDPAddr
+}
+
+func newUDPSim(t *testing.T) *udpSim {
+	t.Helper()
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	sim := &udpSim{
+		conn:     conn,
+		requests: make(chan rrefRequest, 16),
+		peer:     make(chan *net.UDPAddr, 1),
+	}
+	go func() {
+		buf := make([]byte, udpMaxPacketSize)
+		for {
+			n, addr, err := conn.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			select {
+			case sim.peer <- addr:
+			default:
+			}
+			if n >= 13 && string(buf[:5]) == "RREF\x00" {
+				sim.requests <- rrefRequest{
+					frequency: int32(binary.LittleEndian.Uint32(buf[5:9])),
+					index:     int32(binary.LittleEndian.Uint32(buf[9:13])),
+				}
+			}
+		}
+	}()
+	return sim
+}
+
+// expectRequest waits for the next RREF request, and fails the test unless it has the specified
+// frequency.
+func (s *udpSim) expectRequest(t *testing.T, frequency int32) rrefRequest {
+	t.Helper()
+	select {
+	case req := <-s.requests:
+		if req.frequency != frequency {
+			t.Fatalf("received RREF at frequency %d, expected %d", req.frequency, frequency)
+		}
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no RREF received, expected frequency %d", frequency)
+	}
+	return rrefRequest{}
+}
+
+// expectNoRequest fails the test if an RREF request is received within a short time.
+func (s *udpSim) expectNoRequest(t *testing.T) {
+	t.Helper()
+	select {
+	case req := <-s.requests:
+		t.Fatalf("unexpected RREF at frequency %d", req.frequency)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// send sends a value of the dataref with the specified index to the transport.
+func (s *udpSim) send(t *testing.T, addr *net.UDPAddr, index int32, value float32) {
+	t.Helper()
+	packet := append([]byte("RREF"), ',')
+	packet = binary.LittleEndian.AppendUint32(packet, uint32(index))
+	packet = binary.LittleEndian.AppendUint32(packet, math.Float32bits(value))
+	if _, err := s.conn.WriteToUDP(packet, addr); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUDPConcurrentReadsShareRequest(t *testing.T) {
+	sim := newUDPSim(t)
+	client, err := NewClient(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport, err := client.NewUDPTransport(sim.conn.LocalAddr().String(), 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer transport.Close()
+	const name = "sim/test/value"
+
+	// the first reader starts the request
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstDone := make(chan error, 1)
+	go func() {
+		_, err := transport.GetDatarefValue(firstCtx, name)
+		firstDone <- err
+	}()
+	start := sim.expectRequest(t, 20)
+	addr := <-sim.peer
+
+	// the second reader shares it
+	secondDone := make(chan *DatarefValue, 1)
+	go func() {
+		val, err := transport.GetDatarefValue(context.Background(), name)
+		if err != nil {
+			t.Error(err)
+		}
+		secondDone <- val
+	}()
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(time.Millisecond) {
+		transport.lock.Lock()
+		readers := transport.readers[start.index]
+		transport.lock.Unlock()
+		if readers == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d readers registered, expected 2", readers)
+		}
+	}
+
+	// the first reader leaving does not stop the request for the second
+	cancelFirst()
+	if err := <-firstDone; err == nil {
+		t.Fatal("cancelled read succeeded")
+	}
+	sim.expectNoRequest(t)
+
+	// nor does a subscription made and released while the second is waiting
+	if err := transport.SubscribeDatarefs(context.Background(), name); err != nil {
+		t.Fatal(err)
+	}
+	if err := transport.UnsubscribeDatarefs(context.Background(), name); err != nil {
+		t.Fatal(err)
+	}
+	sim.expectNoRequest(t)
+
+	// the last reader leaving stops it
+	sim.send(t, addr, start.index, 1.5)
+	if val := <-secondDone; val == nil || val.GetFloatValue() != 1.5 {
+		t.Fatalf("unexpected value %v", val)
+	}
+	if stop := sim.expectRequest(t, 0); stop.index != start.index {
+		t.Errorf("stopped index %d, expected %d", stop.index, start.index)
+	}
+}
@@ -0,0 +1,109 @@
+package xpweb
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ValidateValue checks whether the specified Go value may be written to a dataref of the specified
+// type, and returns an error matching [ErrInvalidValue] describing the mismatch if not.
+//
+//   - int values must be an integer type, or a float type without a fractional part
+//   - float and double values must be an integer or float type
+//   - int_array and float_array values must be a slice or array of elements which are valid for
+//     int or float respectively
+//   - data values must be a string or []byte
+//
+// Values of any other type, such as a value decoded from JSON into []any, are checked element by
+// element.  The length of array values is not checked, as it is not included in the dataref
+// cache.  An unrecognized value type accepts any value.
+func ValidateValue(valueType ValueType, value any) error {
+	switch valueType {
+	case ValueTypeInt, ValueTypeFloat, ValueTypeDouble:
+		return validateScalar(valueType, value)
+	case ValueTypeIntArray, ValueTypeFloatArray:
+		rv := reflect.ValueOf(value)
+		if kind := rv.Kind(); kind != reflect.Slice && kind != reflect.Array {
+			return invalidValue(valueType, value, "an array")
+		}
+		if _, isData := value.([]byte); isData {
+			return invalidValue(valueType, value, "a numeric array")
+		}
+		elemType := elementValueType(valueType)
+		for idx := range rv.Len() {
+			if err := validateScalar(elemType, rv.Index(idx).Interface()); err != nil {
+				return fmt.Errorf("element %d: %w", idx, err)
+			}
+		}
+	case ValueTypeData:
+		switch value.(type) {
+		case string, []byte:
+		default:
+			return invalidValue(valueType, value, "a string or []byte")
+		}
+	}
+	return nil
+}
+
+// ValidateElementValue checks whether the specified Go value may be written to a single element of
+// an array dataref of the specified type, as with [ValidateValue].
+func ValidateElementValue(valueType ValueType, value any) error {
+	switch valueType {
+	case ValueTypeIntArray, ValueTypeFloatArray:
+		return validateScalar(elementValueType(valueType), value)
+	case ValueTypeInt, ValueTypeFloat, ValueTypeDouble, ValueTypeData:
+		return fmt.Errorf("%w: %s dataref is not an array", ErrInvalidValue, valueType)
+	}
+	return nil
+}
+
+// validateDatarefValue checks a value to be written to the specified dataref, and returns an error
+// naming the dataref if it is invalid.
+func validateDatarefValue(dref *Dataref, value any) error {
+	if err := ValidateValue(dref.ValueType, value); err != nil {
+		return fmt.Errorf("%s: %w", dref.Name, err)
+	}
+	return nil
+}
+
+// validateDatarefElementValue checks a value to be written to an element of the specified
+// dataref, and returns an error naming the dataref if it is invalid.
+func validateDatarefElementValue(dref *Dataref, index int, value any) error {
+	if index < 0 {
+		return fmt.Errorf("%w: %s: invalid index %d", ErrInvalidValue, dref.Name, index)
+	}
+	if err := ValidateElementValue(dref.ValueType, value); err != nil {
+		return fmt.Errorf("%s: %w", dref.Name, err)
+	}
+	return nil
+}
+
+// validateScalar checks a value to be written to an int, float, or double dataref.
+func validateScalar(valueType ValueType, value any) error {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if x := rv.Float(); valueType == ValueTypeInt && x != math.Trunc(x) {
+			return invalidValue(valueType, value, "an integer")
+		}
+		return nil
+	}
+	return invalidValue(valueType, value, "a number")
+}
+
+// elementValueType returns the value type of the elements of an array value type.
+func elementValueType(valueType ValueType) ValueType {
+	if valueType == ValueTypeIntArray {
+		return ValueTypeInt
+	}
+	return ValueTypeFloat
+}
+
+func invalidValue(valueType ValueType, value any, want string) error {
+	return fmt.Errorf("%w: %s dataref requires %s, got %T %v", ErrInvalidValue, valueType, want,
+		value, value)
+}
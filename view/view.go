@@ -0,0 +1,261 @@
+// Package view controls the simulator's camera, for applications such as external camera
+// controller hardware.  It switches view modes, reads and positions the pilot's head in the 3D
+// cockpit, and runs smooth camera moves over the websocket.
+//
+//	cam := view.New(client)
+//	if err := cam.SetMode(ctx, view.ModeCockpit); err != nil {
+//		return err
+//	}
+//	// look 45 degrees left over two seconds
+//	pose, err := cam.HeadPose(ctx)
+//	if err != nil {
+//		return err
+//	}
+//	pose.Heading -= 45
+//	err = cam.MoveTo(ctx, pose, 2*time.Second)
+//
+// Head positions apply to the 3D cockpit view.  The client's cache must be loaded, or LazyCache
+// enabled.
+package view
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/names/command"
+	"github.com/janeprather/xpweb/names/dataref/drefgraphics"
+)
+
+// DefaultRate is the number of camera updates per second sent by [View.MoveTo].
+const DefaultRate = 30
+
+// View controls the simulator's camera.
+type View struct {
+	client *xpweb.Client
+	// The number of camera updates per second sent by MoveTo.
+	Rate float64
+}
+
+// New instantiates and returns a pointer to a new [View] object which uses the specified client.
+func New(client *xpweb.Client) *View {
+	return &View{client: client, Rate: DefaultRate}
+}
+
+// Mode is a camera view which may be selected with [View.SetMode].
+type Mode int
+
+const (
+	ModeCockpit Mode = iota
+	ModeDefault
+	Mode2DPanel
+	ModeChase
+	ModeCircle
+	ModeTower
+	ModeRunway
+	ModeLinearSpot
+	ModeStillSpot
+	ModeFreeCamera
+)
+
+// modeCommands maps each Mode to the command which selects it.
+var modeCommands = map[Mode]string{
+	ModeCockpit:    command.SimView_3d_cockpit_cmnd_look,
+	ModeDefault:    command.SimView_default_view,
+	Mode2DPanel:    command.SimView_forward_with_2d_panel,
+	ModeChase:      command.SimView_chase,
+	ModeCircle:     command.SimView_circle,
+	ModeTower:      command.SimView_tower,
+	ModeRunway:     command.SimView_runway,
+	ModeLinearSpot: command.SimView_linear_spot,
+	ModeStillSpot:  command.SimView_still_spot,
+	ModeFreeCamera: command.SimView_free_camera,
+}
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	switch m {
+	case ModeCockpit:
+		return "cockpit"
+	case ModeDefault:
+		return "default"
+	case Mode2DPanel:
+		return "2d panel"
+	case ModeChase:
+		return "chase"
+	case ModeCircle:
+		return "circle"
+	case ModeTower:
+		return "tower"
+	case ModeRunway:
+		return "runway"
+	case ModeLinearSpot:
+		return "linear spot"
+	case ModeStillSpot:
+		return "still spot"
+	case ModeFreeCamera:
+		return "free camera"
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// SetMode switches to the specified view mode.
+func (v *View) SetMode(ctx context.Context, mode Mode) error {
+	name, ok := modeCommands[mode]
+	if !ok {
+		return fmt.Errorf("unknown view mode: %s", mode)
+	}
+	return v.client.ActivateCommand(ctx, name, 0)
+}
+
+// ViewType returns the simulator's code for the current view, as found in
+// sim/graphics/view/view_type.
+func (v *View) ViewType(ctx context.Context) (int, error) {
+	val, err := v.client.GetValue(ctx, drefgraphics.SimGraphicsView_view_type)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetIntValue(), nil
+}
+
+// IsExternal returns whether the current view is outside the aircraft.
+func (v *View) IsExternal(ctx context.Context) (bool, error) {
+	val, err := v.client.GetValue(ctx, drefgraphics.SimGraphicsView_view_is_external)
+	if err != nil {
+		return false, err
+	}
+	return val.GetIntValue() != 0, nil
+}
+
+// FieldOfView returns the horizontal field of view, in degrees.
+func (v *View) FieldOfView(ctx context.Context) (float64, error) {
+	val, err := v.client.GetValue(ctx, drefgraphics.SimGraphicsView_field_of_view_deg)
+	if err != nil {
+		return 0, err
+	}
+	return val.GetFloatValue(), nil
+}
+
+// SetFieldOfView sets the horizontal field of view, in degrees.
+func (v *View) SetFieldOfView(ctx context.Context, degrees float64) error {
+	if degrees <= 0 || degrees >= 180 {
+		return fmt.Errorf("invalid field of view %v: must be between 0 and 180 degrees", degrees)
+	}
+	return v.client.SetValue(ctx, drefgraphics.SimGraphicsView_field_of_view_deg, degrees)
+}
+
+// HeadPose is the position and orientation of the pilot's head in the 3D cockpit.  The position is
+// in meters relative to the aircraft's reference point, with X to the right, Y up, and Z aft.  The
+// orientation is in degrees relative to the aircraft.
+type HeadPose struct {
+	X, Y, Z float64
+	Heading float64
+	Pitch   float64
+	Roll    float64
+}
+
+// headPoseDatarefs lists the datarefs holding each field of a HeadPose.
+var headPoseDatarefs = [...]string{
+	drefgraphics.SimGraphicsView_pilots_head_x,
+	drefgraphics.SimGraphicsView_pilots_head_y,
+	drefgraphics.SimGraphicsView_pilots_head_z,
+	drefgraphics.SimGraphicsView_pilots_head_psi,
+	drefgraphics.SimGraphicsView_pilots_head_the,
+	drefgraphics.SimGraphicsView_pilots_head_phi,
+}
+
+// fields returns pointers to the fields of the pose, in the order of headPoseDatarefs.
+func (p *HeadPose) fields() [6]*float64 {
+	return [...]*float64{&p.X, &p.Y, &p.Z, &p.Heading, &p.Pitch, &p.Roll}
+}
+
+// HeadPose returns the current position and orientation of the pilot's head.
+func (v *View) HeadPose(ctx context.Context) (HeadPose, error) {
+	var pose HeadPose
+	for idx, field := range pose.fields() {
+		val, err := v.client.GetValue(ctx, headPoseDatarefs[idx])
+		if err != nil {
+			return HeadPose{}, err
+		}
+		*field = val.GetFloatValue()
+	}
+	return pose, nil
+}
+
+// SetHeadPose moves the pilot's head to the specified position and orientation.  The values are
+// written with a single websocket request, so the websocket must be connected.
+func (v *View) SetHeadPose(ctx context.Context, pose HeadPose) error {
+	values := make(map[string]any, len(headPoseDatarefs))
+	for idx, field := range pose.fields() {
+		values[headPoseDatarefs[idx]] = *field
+	}
+	return v.client.WS.SetDatarefValues(values)
+}
+
+// MoveTo moves the pilot's head smoothly from its current pose to the specified pose over the
+// specified duration, easing in and out.  Heading and roll turn the shorter way around.  Updates
+// are sent over the websocket at the configured Rate until the move completes or the context is
+// done.
+func (v *View) MoveTo(ctx context.Context, target HeadPose, duration time.Duration) error {
+	from, err := v.HeadPose(ctx)
+	if err != nil {
+		return err
+	}
+	return v.Animate(ctx, from, target, duration)
+}
+
+// Animate moves the pilot's head smoothly from one pose to another over the specified duration,
+// as with [View.MoveTo].
+func (v *View) Animate(ctx context.Context, from, to HeadPose, duration time.Duration) error {
+	rate := v.Rate
+	if rate <= 0 {
+		rate = DefaultRate
+	}
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+
+	start := time.Now()
+	for {
+		progress := 1.0
+		if duration > 0 {
+			progress = min(float64(time.Since(start))/float64(duration), 1)
+		}
+		if err := v.SetHeadPose(ctx, Interpolate(from, to, smoothstep(progress))); err != nil {
+			return err
+		}
+		if progress >= 1 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Interpolate returns the pose at the specified fraction, from 0 to 1, of the way from one pose to
+// another.  Heading and roll are interpolated the shorter way around.
+func Interpolate(from, to HeadPose, fraction float64) HeadPose {
+	lerp := func(a, b float64) float64 { return a + (b-a)*fraction }
+	lerpAngle := func(a, b float64) float64 {
+		delta := math.Mod(b-a+540, 360) - 180
+		return a + delta*fraction
+	}
+	return HeadPose{
+		X:       lerp(from.X, to.X),
+		Y:       lerp(from.Y, to.Y),
+		Z:       lerp(from.Z, to.Z),
+		Heading: lerpAngle(from.Heading, to.Heading),
+		Pitch:   lerp(from.Pitch, to.Pitch),
+		Roll:    lerpAngle(from.Roll, to.Roll),
+	}
+}
+
+// smoothstep eases a linear fraction from 0 to 1 so that motion starts and stops gently.
+func smoothstep(fraction float64) float64 {
+	return fraction * fraction * (3 - 2*fraction)
+}
@@ -0,0 +1,398 @@
+package xpweb
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// DatarefValueHandler is a function which performs some action for an updated value of a single
+// dataref.
+type DatarefValueHandler func(*DatarefValue)
+
+// WatchOption is an option which modifies the values delivered by [Client.Watch].
+type WatchOption func(*watchConfig)
+
+type watchConfig struct {
+	stages      []func() watchStage
+	minInterval time.Duration
+}
+
+// watchStage transforms a watched value before delivery, or returns nil to drop it.  Each watch
+// creates its own stages, so they may hold state.
+type watchStage func(*DatarefValue) *DatarefValue
+
+// WithSmoothing applies a [Filter] to the watched values before they are delivered.  The specified
+// function is called to create a separate Filter for each element of an array dataref.
+//
+//	client.Watch(ctx, dataref.SimFlightmodelPosition_vh_ind_fpm, handler,
+//		xpweb.WithSmoothing(func() xpweb.Filter { return xpweb.NewEMAFilter(0.2) }))
+func WithSmoothing(newFilter func() Filter) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.stages = append(cfg.stages, func() watchStage {
+			return (&filterState{newFilter: newFilter}).apply
+		})
+	}
+}
+
+// WithDerivative replaces each watched value with its rate of change per second, computed over the
+// most recent window samples using a [TimeSeries] for each element of the dataref.  Values are not
+// delivered until at least two samples have been received.  Options are applied in order, so
+// WithSmoothing may precede WithDerivative to smooth the input, or follow it to smooth the rate.
+//
+//	// pitch rate in degrees per second
+//	client.Watch(ctx, dataref.SimFlightmodelPosition_theta, handler, xpweb.WithDerivative(5))
+func WithDerivative(window int) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.stages = append(cfg.stages, func() watchStage {
+			return (&derivativeState{window: window}).apply
+		})
+	}
+}
+
+// WithMaxRate limits the rate at which values are delivered to at most the specified number per
+// second.  The web API does not offer a per-subscription update rate, so values which arrive
+// sooner are held, and only the most recent held value is delivered once the interval has
+// elapsed.  The final value of a burst of updates is therefore delayed rather than lost.  The
+// limit applies after any other options.
+//
+//	// vertical acceleration, at most twice per second
+//	client.Watch(ctx, dataref.SimFlightmodelForces_g_nrml, handler, xpweb.WithMaxRate(2))
+func WithMaxRate(hz float64) WatchOption {
+	return func(cfg *watchConfig) {
+		if hz > 0 {
+			cfg.minInterval = time.Duration(float64(time.Second) / hz)
+		}
+	}
+}
+
+// WithDeadband suppresses watched values which differ from the most recently passed value by less
+// than epsilon in every element, cutting handler churn for noisy datarefs such as fuel flow.  An
+// epsilon of zero suppresses only unchanged values.  Non-numeric values are passed whenever they
+// change.  The first value is always passed.
+//
+//	client.Watch(ctx, dataref.SimCockpit2EngineIndicators_fuel_flow_kg_sec, handler,
+//		xpweb.WithDeadband(0.001))
+func WithDeadband(epsilon float64) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.stages = append(cfg.stages, func() watchStage {
+			return (&deadbandState{epsilon: epsilon}).apply
+		})
+	}
+}
+
+// deadbandState drops values which have not changed by at least epsilon.
+type deadbandState struct {
+	epsilon float64
+	last    *DatarefValue
+}
+
+func (s *deadbandState) apply(val *DatarefValue) *DatarefValue {
+	if s.last != nil && !s.changed(s.last.Value, val.Value) {
+		return nil
+	}
+	s.last = val
+	return val
+}
+
+func (s *deadbandState) changed(prev, next any) bool {
+	switch nextRaw := next.(type) {
+	case float64:
+		prevRaw, ok := prev.(float64)
+		return !ok || s.exceeds(prevRaw, nextRaw)
+	case []float64:
+		prevRaw, ok := prev.([]float64)
+		if !ok || len(prevRaw) != len(nextRaw) {
+			return true
+		}
+		for idx := range nextRaw {
+			if s.exceeds(prevRaw[idx], nextRaw[idx]) {
+				return true
+			}
+		}
+		return false
+	case []any:
+		prevRaw, ok := prev.([]any)
+		if !ok || len(prevRaw) != len(nextRaw) {
+			return true
+		}
+		for idx := range nextRaw {
+			if s.changed(prevRaw[idx], nextRaw[idx]) {
+				return true
+			}
+		}
+		return false
+	}
+	return !reflect.DeepEqual(prev, next)
+}
+
+func (s *deadbandState) exceeds(prev, next float64) bool {
+	if s.epsilon <= 0 {
+		return prev != next
+	}
+	return math.Abs(next-prev) >= s.epsilon
+}
+
+// derivativeState computes the rate of change of each element of a dataref value.
+type derivativeState struct {
+	window int
+	series []*TimeSeries
+}
+
+func (s *derivativeState) apply(val *DatarefValue) *DatarefValue {
+	now := time.Now()
+	switch raw := val.Value.(type) {
+	case float64:
+		rate, ok := s.rate(0, now, raw)
+		if !ok {
+			return nil
+		}
+		return &DatarefValue{Dataref: val.Dataref, Value: rate}
+	case []float64:
+		rates := make([]float64, len(raw))
+		for idx, num := range raw {
+			rate, ok := s.rate(idx, now, num)
+			if !ok {
+				return nil
+			}
+			rates[idx] = rate
+		}
+		return &DatarefValue{Dataref: val.Dataref, Value: rates}
+	case []any:
+		rates := make([]any, len(raw))
+		for idx, elem := range raw {
+			num, isNum := elem.(float64)
+			if !isNum {
+				return val
+			}
+			rate, ok := s.rate(idx, now, num)
+			if !ok {
+				return nil
+			}
+			rates[idx] = rate
+		}
+		return &DatarefValue{Dataref: val.Dataref, Value: rates}
+	}
+	return val
+}
+
+func (s *derivativeState) rate(idx int, now time.Time, value float64) (float64, bool) {
+	for len(s.series) <= idx {
+		s.series = append(s.series, NewTimeSeries(s.window))
+	}
+	s.series[idx].Add(now, value)
+	return s.series[idx].Derivative()
+}
+
+// Watch subscribes to the dataref with the specified name using the selected [Transport], and
+// calls the specified handler with each updated value, after applying any options.  The
+// subscription is removed when the context is done.
+func (c *Client) Watch(
+	ctx context.Context,
+	name string,
+	handler DatarefValueHandler,
+	opts ...WatchOption,
+) error {
+	cfg := newWatchConfig(opts)
+	updates, err := c.SubscribeFor(ctx, name)
+	if err != nil {
+		return err
+	}
+	go watchLoop(updates, cfg.newStages(), cfg.minInterval, handler)
+	return nil
+}
+
+// newWatchConfig returns the configuration resulting from the specified options.
+func newWatchConfig(opts []WatchOption) *watchConfig {
+	cfg := &watchConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// newStages creates the stages of a single watch.
+func (cfg *watchConfig) newStages() []watchStage {
+	stages := make([]watchStage, 0, len(cfg.stages))
+	for _, newStage := range cfg.stages {
+		stages = append(stages, newStage())
+	}
+	return stages
+}
+
+// SubscribeDatarefElement subscribes to the element at the specified index of the array dataref
+// with the specified name, and calls the specified handler with each updated value of the element,
+// as with [Client.Watch].  The Value of each delivered [DatarefValue] is the scalar element rather
+// than the whole array, and options are applied to the element value.
+//
+// When subscriptions use the websocket service, only the element is subscribed, so the simulator
+// does not send the rest of the array.  Other transports subscribe to the whole array, and deliver
+// the element from it.
+//
+//	// N1 of the second engine
+//	client.SubscribeDatarefElement(ctx, dataref.SimFlightmodelEngine_ENGN_N1, 1, handler)
+func (c *Client) SubscribeDatarefElement(
+	ctx context.Context,
+	name string,
+	index int,
+	handler DatarefValueHandler,
+	opts ...WatchOption,
+) error {
+	if index < 0 {
+		return fmt.Errorf("invalid index %d for dataref %s", index, name)
+	}
+	wsc := subscriptionsWSClient(c.Transport())
+	if wsc == nil {
+		return c.Watch(ctx, name, handler, append([]WatchOption{withElement(index)}, opts...)...)
+	}
+
+	dref, err := c.LookupDataref(name)
+	if err != nil {
+		return err
+	}
+	cfg := newWatchConfig(opts)
+	updates := make(chan *WSMessageDatarefUpdate, subscribeForBufferSize)
+	var closeLock sync.RWMutex
+	closed := false
+
+	listenerID := wsc.addElementListener(dref.Name, index, func(val *DatarefValue) {
+		closeLock.RLock()
+		defer closeLock.RUnlock()
+		if closed {
+			return
+		}
+		msg := &WSMessageDatarefUpdate{
+			Type: MessageTypeDatarefUpdate,
+			Data: WSDatarefValuesMap{val.Dataref.ID: val},
+		}
+		select {
+		case updates <- msg:
+		default:
+		}
+	})
+	if err := wsc.subscribeDatarefElement(dref.Name, index); err != nil {
+		wsc.removeElementListener(listenerID)
+		return err
+	}
+
+	go func() {
+		<-ctx.Done()
+		wsc.removeElementListener(listenerID)
+		if err := wsc.unsubscribeDatarefElement(dref.Name, index); err != nil {
+			c.logger.Warn("failed to unsubscribe from dataref element", "name", dref.Name,
+				"index", index, "error", err)
+		}
+
+		closeLock.Lock()
+		closed = true
+		close(updates)
+		closeLock.Unlock()
+	}()
+
+	go watchLoop(updates, cfg.newStages(), cfg.minInterval, handler)
+	return nil
+}
+
+// subscriptionsWSClient returns the websocket client used for subscriptions by the specified
+// transport, or nil if subscriptions do not use the websocket service.
+func subscriptionsWSClient(transport Transport) *WSClient {
+	switch t := transport.(type) {
+	case *WSTransport:
+		return t.client.WS
+	case *SplitTransport:
+		return subscriptionsWSClient(t.Subscriptions)
+	}
+	return nil
+}
+
+// withElement replaces each watched array value with the element at the specified index.
+func withElement(index int) WatchOption {
+	return func(cfg *watchConfig) {
+		cfg.stages = append(cfg.stages, func() watchStage {
+			return func(val *DatarefValue) *DatarefValue {
+				elem := &DatarefValue{Dataref: val.Dataref}
+				switch elems := val.Value.(type) {
+				case []float64:
+					if index >= len(elems) {
+						return nil
+					}
+					elem.Value = elems[index]
+				case []any:
+					if index >= len(elems) {
+						return nil
+					}
+					elem.Value = elems[index]
+				default:
+					return nil
+				}
+				switch typed := val.Typed.(type) {
+				case []int:
+					elem.Typed = typed[index]
+				case []float64:
+					elem.Typed = typed[index]
+				}
+				return elem
+			}
+		})
+	}
+}
+
+// watchLoop applies the stages to each value received from the updates channel, and delivers the
+// results to the handler no more often than the minimum interval, until the channel is closed.
+func watchLoop(
+	updates <-chan *WSMessageDatarefUpdate,
+	stages []watchStage,
+	minInterval time.Duration,
+	handler DatarefValueHandler,
+) {
+	var pending *DatarefValue
+	var delivered time.Time
+	var timer *time.Timer
+	var timerC <-chan time.Time
+
+	deliver := func(val *DatarefValue) {
+		handler(val)
+		delivered = time.Now()
+	}
+
+	for {
+		select {
+		case msg, ok := <-updates:
+			if !ok {
+				if timer != nil {
+					timer.Stop()
+				}
+				return
+			}
+			for _, val := range msg.Data {
+				for _, stage := range stages {
+					if val = stage(val); val == nil {
+						break
+					}
+				}
+				if val == nil {
+					continue
+				}
+				wait := minInterval - time.Since(delivered)
+				if wait <= 0 && pending == nil {
+					deliver(val)
+					continue
+				}
+				pending = val
+				if timerC == nil {
+					timer = time.NewTimer(wait)
+					timerC = timer.C
+				}
+			}
+		case <-timerC:
+			timer, timerC = nil, nil
+			if pending != nil {
+				deliver(pending)
+				pending = nil
+			}
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package xpweb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// writerElectionRetry is the interval at which an observing instance attempts to become the
+// writer.
+const writerElectionRetry time.Duration = time.Second
+
+// ErrNotWriter is returned by write operations when writer election is in use and another instance
+// holds the writer lock.
+var ErrNotWriter = errors.New("another instance is the writer for this simulator")
+
+// WriterElection coordinates several applications running against the same simulator so that
+// only one of them, the writer, performs writes while the others observe.  The writer holds a
+// listening socket on a local address, which is released automatically if it exits, allowing an
+// observer to take over.
+type WriterElection struct {
+	client   *Client
+	addr     string
+	onChange func(isWriter bool)
+
+	elected  atomic.Bool
+	listener net.Listener
+	cancel   context.CancelFunc
+	done     chan struct{}
+	lock     sync.Mutex
+}
+
+// ElectWriter begins contending for the writer role among applications using the same lock
+// address.  If addr is empty, a localhost address derived from the simulator URL is used, so that
+// all applications on this machine targeting the same simulator contend with one another.  While
+// the election is active, dataref writes and command activations made through this client return
+// [ErrNotWriter] unless it is the writer.  The optional onChange handler is called whenever the
+// role changes.  Contention continues until the context is done or [WriterElection.Close] is
+// called.
+//
+//	election, err := client.ElectWriter(ctx, "", func(isWriter bool) {
+//		log.Printf("writer: %v", isWriter)
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer election.Close()
+func (c *Client) ElectWriter(
+	ctx context.Context,
+	addr string,
+	onChange func(isWriter bool),
+) (*WriterElection, error) {
+	if addr == "" {
+		addr = defaultWriterLockAddr(c.REST.url.Host)
+	}
+
+	c.electionLock.Lock()
+	defer c.electionLock.Unlock()
+	if c.election != nil {
+		return nil, errors.New("writer election is already active")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	election := &WriterElection{
+		client:   c,
+		addr:     addr,
+		onChange: onChange,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+	c.election = election
+	election.tryAcquire()
+	go election.run(ctx)
+	return election, nil
+}
+
+// defaultWriterLockAddr returns a localhost address with a port in the dynamic range derived from
+// the simulator's host.
+func defaultWriterLockAddr(simHost string) string {
+	hash := fnv.New32a()
+	hash.Write([]byte(simHost))
+	port := 49152 + hash.Sum32()%16384
+	return fmt.Sprintf("127.0.0.1:%d", port)
+}
+
+// IsWriter returns whether this instance currently holds the writer role.
+func (e *WriterElection) IsWriter() bool {
+	return e.elected.Load()
+}
+
+// Close stops contending for the writer role, releasing it if held, and allows this client to
+// write without restriction.
+func (e *WriterElection) Close() {
+	e.cancel()
+	<-e.done
+
+	e.client.electionLock.Lock()
+	defer e.client.electionLock.Unlock()
+	if e.client.election == e {
+		e.client.election = nil
+	}
+}
+
+func (e *WriterElection) run(ctx context.Context) {
+	defer close(e.done)
+	ticker := time.NewTicker(writerElectionRetry)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			e.release()
+			return
+		case <-ticker.C:
+			e.tryAcquire()
+		}
+	}
+}
+
+// tryAcquire attempts to become the writer if not already.
+func (e *WriterElection) tryAcquire() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.listener != nil {
+		return
+	}
+	listener, err := net.Listen("tcp", e.addr)
+	if err != nil {
+		return
+	}
+	e.listener = listener
+	e.setElected(true)
+}
+
+// release gives up the writer role if held.
+func (e *WriterElection) release() {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.listener != nil {
+		e.listener.Close()
+		e.listener = nil
+		e.setElected(false)
+	}
+}
+
+func (e *WriterElection) setElected(elected bool) {
+	if e.elected.Swap(elected) != elected && e.onChange != nil {
+		go e.onChange(elected)
+	}
+}
+
+// checkWriter returns [ErrNotWriter] if writer election is active and this instance is not the
+// writer.
+func (c *Client) checkWriter() error {
+	c.electionLock.Lock()
+	election := c.election
+	c.electionLock.Unlock()
+
+	if election != nil && !election.IsWriter() {
+		return ErrNotWriter
+	}
+	return nil
+}
@@ -1,9 +1,18 @@
 package xpweb
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
 	"errors"
-	"log"
+	"fmt"
+	"io"
+	"log/slog"
+	"maps"
+	"net/http"
 	"net/url"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
@@ -13,6 +22,12 @@ import (
 
 const reconnectFreq time.Duration = 5 * time.Second
 
+const (
+	defaultCloseTimeout  time.Duration = 2 * time.Second
+	closeDrainFreq       time.Duration = 10 * time.Millisecond
+	defaultResultTimeout time.Duration = time.Minute
+)
+
 const (
 	MessageTypeResult             string = "result"
 	MessageTypeDatarefSub         string = "dataref_subscribe_values"
@@ -27,105 +42,730 @@ const (
 
 // XPWebsocketClient provides functions and attributes related to Websocket API operations.
 type WSClient struct {
+	backfill             datarefBackfill
+	backfillDatarefs     bool
 	commandUpdateHandler CommandUpdateHandler
 	datarefUpdateHandler DatarefUpdateHandler
 	client               *Client
+	elements             elementSubscriptions
+	closeTimeout         time.Duration
+	codec                Codec
+	commandListeners     map[uint64]CommandUpdateHandler
+	commandSubscriptions refCounts[uint64]
 	conn                 *websocket.Conn
+	headers              http.Header
+	connLock             sync.RWMutex
+	datarefListeners     map[uint64]DatarefUpdateHandler
+	lifecycle            *wsLifecycle
+	listenerID           atomic.Uint64
+	listenersLock        sync.RWMutex
 	messageID            atomic.Uint64
+	proxy                proxyFunc
+	rawMessageHandler    RawMessageHandler
+	skipValidation       bool
 	reqHistory           *reqHistory
 	resultHandler        ResultHandler
+	state                atomic.Int32
+	stateLock            sync.Mutex
+	stateWatchers        []*stateWatcher
+	subscriptions        refCounts[uint64]
+	tlsConfig            *tls.Config
 	url                  *url.URL
 }
 
+// wsLifecycle tracks the background goroutines started by a call to [WSClient.Connect], so that
+// they can be stopped by [WSClient.Close] and their completion observed via [WSClient.Done].
+type wsLifecycle struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	done   chan struct{}
+}
+
+func newWSLifecycle() *wsLifecycle {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &wsLifecycle{ctx: ctx, cancel: cancel, done: make(chan struct{})}
+}
+
+// closedDone is returned by WSClient.Done when no connection has been established.
+var closedDone = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
 // readLoop continually reads from the websocket while the connection is open.  It should be called
-// in a goroutine after the websocket connects.
-func (wsc *WSClient) readLoop() {
+// in a goroutine after the websocket connects, and returns once the connection is closed or
+// replaced.
+func (wsc *WSClient) readLoop(life *wsLifecycle, conn *websocket.Conn) {
+	defer life.wg.Done()
 	for {
-		var inMsg wsMessageStub
-		err := websocket.JSON.Receive(wsc.conn, &inMsg)
+		var data []byte
+		err := websocket.Message.Receive(conn, &data)
 		if err != nil {
-			if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) {
-				// connection reset or aborted, we should try to reconnect gracefully
-				go wsc.reconnectLoop()
+			if life.ctx.Err() != nil || wsc.currentConn() != conn {
+				return
+			}
+			if errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) ||
+				errors.Is(err, syscall.ECONNABORTED) {
+				// connection closed, reset or aborted, we should try to reconnect gracefully
+				life.wg.Add(1)
+				go wsc.reconnectLoop(life)
 				return
 			}
-			log.Printf("failed to read message: %s\n", err.Error())
+			wsc.client.logger.Error("failed to read websocket message", "error", err)
+			continue
+		}
+		if wsc.client.logger.Enabled(context.Background(), slog.LevelDebug) {
+			wsc.client.logger.Debug("received websocket message", "message", string(data))
+		}
+		inMsg, err := parseMessageStub(wsc.codec, data)
+		if err != nil {
+			wsc.client.logger.Error("failed to unmarshal incoming websocket message", "error", err)
+			continue
+		}
+		msg, err := inMsg.toMessage(wsc.codec)
+		if errors.Is(err, errUnknownMessageType) && wsc.rawMessageHandler != nil {
+			wsc.rawMessageHandler(inMsg.Type, inMsg.json)
 			continue
 		}
-		msg, err := inMsg.toMessage()
 		if err != nil {
-			log.Printf("failed to unmarshal incoming message: %s\n", err.Error())
+			wsc.client.logger.Error("failed to unmarshal incoming websocket message", "error", err)
 			continue
 		}
 
+		// Messages are enriched from the request history and the cache whether or not handlers
+		// are configured, so that listeners and other internal consumers always receive them
+		// complete.
 		switch realMsg := msg.(type) {
 		case *WSMessageResult:
+			wsc.reqHistory.applyToResult(realMsg)
+			if realMsg.Req != nil {
+				endWSSpan(realMsg.Req, realMsg)
+			}
 			if wsc.resultHandler != nil {
-				wsc.reqHistory.applyToResult(realMsg)
 				wsc.resultHandler(realMsg)
 			}
 		case *WSMessageDatarefUpdate:
-			if wsc.datarefUpdateHandler != nil {
-				// The UnmarshalJSON method didn't have access to the client cache, so contains
-				// DatarefValue objects with nil Dataref pointers. Populate those Dataref values
-				// here before passing the message to the handler.
-				realMsg.populateDatarefs(wsc)
-				wsc.datarefUpdateHandler(realMsg)
+			// Decoding didn't have access to the client cache, so the DatarefValue objects have
+			// nil Dataref pointers.  Populate those Dataref values here before passing the
+			// message to the store and handlers.
+			realMsg.populateDatarefs(wsc)
+			wsc.dispatchElements(realMsg)
+			if len(realMsg.Data) > 0 {
+				wsc.deliverOrBackfill(realMsg)
 			}
 		case *WSMessageCommandUpdate:
+			// Decoding didn't have access to the client cache, so the CommandStatus objects have
+			// nil Command pointers.  Populate these Command values here before passing the
+			// message to the handlers.
+			realMsg.populateCommands(wsc)
 			if wsc.commandUpdateHandler != nil {
-				// The UnmarshalJSON method didn't have access to the client cache, so contains
-				// CommandStatus objects with nil Command pointers.  Populate these Command values
-				// here before passing the message to the handler.
-				realMsg.populateCommands(wsc)
 				wsc.commandUpdateHandler(realMsg)
 			}
+			wsc.notifyCommandListeners(realMsg)
+		}
+	}
+}
+
+// deliverDatarefUpdate passes a dataref update message, with its Dataref values populated and their
+// Typed values decoded, to the configured DatarefUpdateHandler and any internal listeners.
+func (wsc *WSClient) deliverDatarefUpdate(msg *WSMessageDatarefUpdate) {
+	for _, val := range msg.Data {
+		val.decodeTyped()
+	}
+	wsc.client.store.update(msg)
+	if wsc.datarefUpdateHandler != nil {
+		wsc.datarefUpdateHandler(msg)
+	}
+	wsc.notifyDatarefListeners(msg)
+}
+
+// addDatarefListener registers an internal handler which receives every dataref update message in
+// addition to the configured DatarefUpdateHandler.  It returns an ID which may be passed to
+// removeDatarefListener.
+func (wsc *WSClient) addDatarefListener(handler DatarefUpdateHandler) uint64 {
+	wsc.listenersLock.Lock()
+	defer wsc.listenersLock.Unlock()
+
+	if wsc.datarefListeners == nil {
+		wsc.datarefListeners = make(map[uint64]DatarefUpdateHandler)
+	}
+	id := wsc.listenerID.Add(1)
+	wsc.datarefListeners[id] = handler
+	return id
+}
+
+// removeDatarefListener unregisters a handler previously registered with addDatarefListener.
+func (wsc *WSClient) removeDatarefListener(id uint64) {
+	wsc.listenersLock.Lock()
+	defer wsc.listenersLock.Unlock()
+	delete(wsc.datarefListeners, id)
+}
+
+func (wsc *WSClient) notifyDatarefListeners(msg *WSMessageDatarefUpdate) {
+	// copy the handlers so that a handler may remove itself without deadlocking
+	wsc.listenersLock.RLock()
+	handlers := slices.Collect(maps.Values(wsc.datarefListeners))
+	wsc.listenersLock.RUnlock()
+
+	for _, handler := range handlers {
+		handler(msg)
+	}
+}
+
+// addCommandListener registers an internal handler which receives every command update message in
+// addition to the configured CommandUpdateHandler.  It returns an ID which may be passed to
+// removeCommandListener.
+func (wsc *WSClient) addCommandListener(handler CommandUpdateHandler) uint64 {
+	wsc.listenersLock.Lock()
+	defer wsc.listenersLock.Unlock()
+
+	if wsc.commandListeners == nil {
+		wsc.commandListeners = make(map[uint64]CommandUpdateHandler)
+	}
+	id := wsc.listenerID.Add(1)
+	wsc.commandListeners[id] = handler
+	return id
+}
+
+// removeCommandListener unregisters a handler previously registered with addCommandListener.
+func (wsc *WSClient) removeCommandListener(id uint64) {
+	wsc.listenersLock.Lock()
+	defer wsc.listenersLock.Unlock()
+	delete(wsc.commandListeners, id)
+}
+
+func (wsc *WSClient) notifyCommandListeners(msg *WSMessageCommandUpdate) {
+	// copy the handlers so that a handler may remove itself without deadlocking
+	wsc.listenersLock.RLock()
+	handlers := slices.Collect(maps.Values(wsc.commandListeners))
+	wsc.listenersLock.RUnlock()
+
+	for _, handler := range handlers {
+		handler(msg)
+	}
+}
+
+// subscribeCommandIDs subscribes to the active status of the commands with the specified IDs,
+// sending a subscription request only for those which are not already subscribed.
+func (wsc *WSClient) subscribeCommandIDs(ids ...uint64) error {
+	added := wsc.commandSubscriptions.acquire(ids...)
+	if len(added) == 0 {
+		return nil
+	}
+	if err := wsc.sendCommandIDs(MessageTypeCommandSub, added); err != nil {
+		wsc.commandSubscriptions.release(ids...)
+		return err
+	}
+	return nil
+}
+
+// unsubscribeCommandIDs releases subscriptions made with subscribeCommandIDs.
+func (wsc *WSClient) unsubscribeCommandIDs(ids ...uint64) error {
+	removed := wsc.commandSubscriptions.release(ids...)
+	if len(removed) == 0 {
+		return nil
+	}
+	return wsc.sendCommandIDs(MessageTypeCommandUnsub, removed)
+}
+
+// sendCommandIDs sends a command subscription request of the specified type for the specified
+// command IDs.
+func (wsc *WSClient) sendCommandIDs(reqType string, ids []uint64) error {
+	cmds := make([]map[string]uint64, 0, len(ids))
+	for _, id := range ids {
+		cmds = append(cmds, map[string]uint64{"id": id})
+	}
+	req := wsc.NewReq()
+	req.Type = reqType
+	req.Params = map[string]any{"commands": cmds}
+	return req.Send()
+}
+
+// lookupDatarefIDs returns the IDs of the datarefs with the specified names.  If any of the names
+// cannot be found, a [NotFoundError] is returned.
+func (wsc *WSClient) lookupDatarefIDs(names []string) ([]uint64, error) {
+	ids := make([]uint64, 0, len(names))
+	for _, name := range names {
+		dref, err := wsc.client.LookupDataref(name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, dref.ID)
+	}
+	return ids, nil
+}
+
+// subscribeDatarefIDs subscribes to the datarefs with the specified IDs, sending a subscription
+// request only for those which are not already subscribed via subscribeDatarefIDs.
+func (wsc *WSClient) subscribeDatarefIDs(ids ...uint64) error {
+	added := wsc.subscriptions.acquire(ids...)
+	if len(added) == 0 {
+		return nil
+	}
+	// elements subscribed individually are taken from the whole values instead
+	wsc.syncElementsByID(added)
+	datarefs := make([]*WSDataref, 0, len(added))
+	for _, id := range added {
+		datarefs = append(datarefs, NewWSDataref(id))
+	}
+	if err := wsc.NewReq().DatarefSubscribe(datarefs...).Send(); err != nil {
+		wsc.subscriptions.release(ids...)
+		wsc.syncElementsByID(added)
+		return err
+	}
+	return nil
+}
+
+// unsubscribeDatarefIDs releases subscriptions made with subscribeDatarefIDs, sending an
+// unsubscribe request for the datarefs which no longer have any subscribers.
+func (wsc *WSClient) unsubscribeDatarefIDs(ids ...uint64) error {
+	removed := wsc.subscriptions.release(ids...)
+	if len(removed) == 0 {
+		return nil
+	}
+	datarefs := make([]*WSDataref, 0, len(removed))
+	for _, id := range removed {
+		datarefs = append(datarefs, NewWSDataref(id))
+	}
+	err := wsc.NewReq().DatarefUnsubscribe(datarefs...).Send()
+	wsc.syncElementsByID(removed)
+	return err
+}
+
+// snapshot subscribes to the datarefs with the specified IDs, waits until a value has been
+// received for each of them, and then unsubscribes.  The values are returned keyed by ID.
+func (wsc *WSClient) snapshot(ctx context.Context, ids ...uint64) (WSDatarefValuesMap, error) {
+	var valuesLock sync.Mutex
+	values := make(WSDatarefValuesMap, len(ids))
+	done := make(chan struct{})
+
+	listenerID := wsc.addDatarefListener(func(msg *WSMessageDatarefUpdate) {
+		valuesLock.Lock()
+		defer valuesLock.Unlock()
+		if len(values) == len(ids) {
+			return
+		}
+		for _, id := range ids {
+			if val, ok := msg.Data[id]; ok {
+				values[id] = val
+			}
+		}
+		if len(values) == len(ids) {
+			close(done)
+		}
+	})
+	defer wsc.removeDatarefListener(listenerID)
+
+	if err := wsc.subscribeDatarefIDs(ids...); err != nil {
+		return nil, err
+	}
+	defer wsc.unsubscribeDatarefIDs(ids...)
+
+	select {
+	case <-done:
+		return values, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// WaitForDataref subscribes to the dataref with the specified name, waits until a value is received
+// which satisfies the specified predicate, and then unsubscribes.  Because X-Plane sends the
+// current value upon subscription, the predicate is evaluated immediately against the present
+// state.  An error is returned if the dataref cannot be found, or the context is done first.
+//
+//	// wait for engine N1 to exceed 20%
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+//	defer cancel()
+//	err := client.WS.WaitForDataref(ctx, dataref.SimFlightmodelEngine_ENGN_N1, func(val *xpweb.DatarefValue) bool {
+//		return val.GetFloatArrayValue()[0] > 20
+//	})
+func (wsc *WSClient) WaitForDataref(
+	ctx context.Context,
+	name string,
+	predicate func(*DatarefValue) bool,
+) error {
+	ids, err := wsc.lookupDatarefIDs([]string{name})
+	if err != nil {
+		return err
+	}
+	id := ids[0]
+
+	var doneOnce sync.Once
+	done := make(chan struct{})
+
+	listenerID := wsc.addDatarefListener(func(msg *WSMessageDatarefUpdate) {
+		if val, ok := msg.Data[id]; ok && predicate(val) {
+			doneOnce.Do(func() { close(done) })
 		}
+	})
+	defer wsc.removeDatarefListener(listenerID)
+
+	if err := wsc.subscribeDatarefIDs(id); err != nil {
+		return err
+	}
+	defer wsc.unsubscribeDatarefIDs(id)
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for %s: %w", name, ctx.Err())
 	}
 }
 
-// reconnectLoop continually attempts to continuously re-establish a websocket connection
-func (xpc *WSClient) reconnectLoop() {
+// SetDatarefValues writes the specified values, keyed by dataref name, with a single
+// dataref_set_values request, so that related values are applied together.  Each value is checked
+// against the cached value type of its dataref, as with [ValidateValue], before anything is sent.
+func (wsc *WSClient) SetDatarefValues(values map[string]any) error {
+	if err := wsc.client.checkWriter(); err != nil {
+		return err
+	}
+	datarefs := make([]*WSDatarefValue, 0, len(values))
+	for name, value := range values {
+		dref, err := wsc.client.LookupDataref(name)
+		if err != nil {
+			return err
+		}
+		if err := validateDatarefValue(dref, value); err != nil {
+			return err
+		}
+		payload := genSetDatarefValuePayload(dref.ValueType, value)
+		datarefs = append(datarefs, NewWSDatarefValue(dref.ID, payload.Data))
+	}
+	return wsc.NewReq().DatarefSet(datarefs...).Send()
+}
+
+// reconnectLoop continually attempts to re-establish a websocket connection, until it succeeds or
+// the client is closed.
+func (xpc *WSClient) reconnectLoop(life *wsLifecycle) {
+	defer life.wg.Done()
+	xpc.setState(ConnReconnecting)
 	for {
-		err := xpc.Connect()
+		err := xpc.dial(life)
 		if err == nil {
-			// established connection
+			// established connection, but the simulator may have been restarted, in which case
+			// subscribed IDs must be resolved again once the cache has been reloaded
+			datarefNames, commandNames := xpc.subscribedNames()
+			xpc.client.reloadCacheNow(life.ctx)
+			xpc.resubscribe(datarefNames, commandNames)
+			xpc.resubscribeElements()
 			return
 		}
-		log.Printf("failed to re-establish websocket connection: %s\n", err.Error())
-		time.Sleep(reconnectFreq)
+		if life.ctx.Err() != nil {
+			return
+		}
+		xpc.client.logger.Warn("failed to re-establish websocket connection", "error", err)
+
+		select {
+		case <-life.ctx.Done():
+			return
+		case <-time.After(reconnectFreq):
+		}
+	}
+}
+
+// subscribedNames returns the names of the subscribed datarefs and commands, keyed by ID.
+func (wsc *WSClient) subscribedNames() (datarefNames, commandNames map[uint64]string) {
+	datarefNames = make(map[uint64]string)
+	for _, id := range wsc.subscriptions.keys() {
+		if dref := wsc.client.GetDatarefByID(id); dref != nil {
+			datarefNames[id] = dref.Name
+		}
+	}
+	commandNames = make(map[uint64]string)
+	for _, id := range wsc.commandSubscriptions.keys() {
+		if cmd := wsc.client.GetCommandByID(id); cmd != nil {
+			commandNames[id] = cmd.Name
+		}
+	}
+	return datarefNames, commandNames
+}
+
+// resubscribe sends subscription requests over a re-established connection for the datarefs and
+// commands which remain subscribed, as the simulator does not retain them across connections.
+// Subscribed IDs are first replaced by the IDs which their names, as returned by subscribedNames
+// before the cache was reloaded, now have in the cache.  Subscriptions whose names are no longer
+// found are discarded.
+func (wsc *WSClient) resubscribe(datarefNames, commandNames map[uint64]string) {
+	datarefIDs := wsc.subscriptions.rekey(func(id uint64) (uint64, bool) {
+		name, known := datarefNames[id]
+		if !known {
+			return id, true
+		}
+		dref := wsc.client.GetDatarefByName(name)
+		if dref == nil {
+			wsc.client.logger.Warn("subscribed dataref no longer found", "name", name)
+			return 0, false
+		}
+		return dref.ID, true
+	})
+	if len(datarefIDs) > 0 {
+		datarefs := make([]*WSDataref, 0, len(datarefIDs))
+		for _, id := range datarefIDs {
+			datarefs = append(datarefs, NewWSDataref(id))
+		}
+		if err := wsc.NewReq().DatarefSubscribe(datarefs...).Send(); err != nil {
+			wsc.client.logger.Warn("failed to resubscribe to datarefs", "error", err)
+		}
 	}
+
+	commandIDs := wsc.commandSubscriptions.rekey(func(id uint64) (uint64, bool) {
+		name, known := commandNames[id]
+		if !known {
+			return id, true
+		}
+		cmd := wsc.client.GetCommandByName(name)
+		if cmd == nil {
+			wsc.client.logger.Warn("subscribed command no longer found", "name", name)
+			return 0, false
+		}
+		return cmd.ID, true
+	})
+	if len(commandIDs) > 0 {
+		if err := wsc.sendCommandIDs(MessageTypeCommandSub, commandIDs); err != nil {
+			wsc.client.logger.Warn("failed to resubscribe to commands", "error", err)
+		}
+	}
+}
+
+// logDebugJSON logs a message with the JSON encoding of the specified value, if debug logging is
+// enabled.
+func (wsc *WSClient) logDebugJSON(msg string, value any) {
+	logger := wsc.client.logger
+	if !logger.Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		logger.Debug(msg, "error", err)
+		return
+	}
+	logger.Debug(msg, "message", string(data))
 }
 
 // SendToWS marshals the specified object into JSON and sends it over the websocket connection.
+// The request is first checked with [WSReq.Validate], unless SkipRequestValidation is set in the
+// [ClientConfig].
 func (c *WSClient) Send(req *WSReq) error {
+	if !c.skipValidation {
+		if err := req.Validate(); err != nil {
+			return err
+		}
+	}
+	conn := c.currentConn()
+	if conn == nil {
+		return ErrNotConnected
+	}
+
+	c.logDebugJSON("sending websocket message", req)
+	c.client.startWSSpan(req)
 	c.reqHistory.add(req)
 
-	if err := websocket.JSON.Send(c.conn, req); err != nil {
+	if err := websocketCodec(c.codec).Send(conn, req); err != nil {
+		c.reqHistory.delete(req.ReqID)
+		if req.span != nil {
+			req.span.RecordError(err)
+		}
+		endWSSpan(req, nil)
 		return err
 	}
 
 	return nil
 }
 
+// currentConn returns the established websocket connection, or nil if there is none.
+func (wsc *WSClient) currentConn() *websocket.Conn {
+	wsc.connLock.RLock()
+	defer wsc.connLock.RUnlock()
+	return wsc.conn
+}
+
 // WSConnect establishes a websocket connection to the web API.  If an application calls this
 // function, it must read from the channel returned by XPClient.Messages() to avoid a deadlock.
-func (xpc *WSClient) Connect() (err error) {
-	if xpc.conn != nil {
-		xpc.Close()
+func (xpc *WSClient) Connect() error {
+	if xpc.currentConn() != nil {
+		xpc.disconnect()
 	}
-	xpc.conn, err = websocket.Dial(xpc.url.String(), "", xpc.client.REST.url.String())
+
+	life := newWSLifecycle()
+	xpc.connLock.Lock()
+	xpc.lifecycle = life
+	xpc.connLock.Unlock()
+
+	xpc.setState(ConnConnecting)
+	if err := xpc.dial(life); err != nil {
+		life.cancel()
+		close(life.done)
+		xpc.setState(ConnDisconnected)
+		return err
+	}
+
+	life.wg.Add(1)
+	go func() {
+		defer life.wg.Done()
+		xpc.reqHistory.sweep(life.ctx)
+	}()
+	go func() {
+		life.wg.Wait()
+		close(life.done)
+	}()
+	return nil
+}
+
+// dial establishes the websocket connection and starts the readLoop.  It returns ErrClosed, and
+// discards the new connection, if the lifecycle was cancelled while the connection was being
+// established.
+func (xpc *WSClient) dial(life *wsLifecycle) error {
+	version, err := xpc.client.ensureAPIVersion(life.ctx)
+	if err != nil {
+		return err
+	}
+	wsURL := *xpc.url
+	wsURL.Path = "/api/" + version
+
+	config, err := xpc.handshakeConfig(wsURL.String())
+	if err != nil {
+		return err
+	}
+	auth, err := xpc.client.authorization(life.ctx)
+	if err != nil {
+		return err
+	}
+	if auth != "" {
+		config.Header.Set("Authorization", auth)
+	}
+	conn, err := xpc.dialConn(life.ctx, config)
 	if err != nil {
+		if life.ctx.Err() != nil {
+			return ErrClosed
+		}
 		return err
 	}
-	go xpc.readLoop()
+
+	// the lifecycle is cancelled while holding connLock, so no goroutine can be added to it once
+	// Close has begun waiting for them
+	xpc.connLock.Lock()
+	if life.ctx.Err() != nil {
+		xpc.connLock.Unlock()
+		conn.Close()
+		return ErrClosed
+	}
+	xpc.conn = conn
+	life.wg.Add(1)
+	xpc.connLock.Unlock()
+
+	xpc.setState(ConnConnected)
+	go xpc.readLoop(life, conn)
 	return nil
 }
 
-// WSClose closes an established websocket connection.
+// handshakeConfig returns the websocket configuration for a connection to the specified URL,
+// including the configured TLS settings and handshake headers.
+func (xpc *WSClient) handshakeConfig(wsURL string) (*websocket.Config, error) {
+	origin := xpc.client.REST.url.String()
+	if value := xpc.headers.Get("Origin"); value != "" {
+		origin = value
+	}
+	config, err := websocket.NewConfig(wsURL, origin)
+	if err != nil {
+		return nil, fmt.Errorf("invalid websocket configuration: %w", err)
+	}
+	config.TlsConfig = xpc.tlsConfig
+
+	// the origin is written by the handshake itself, so must not be repeated as a header
+	for name, values := range xpc.headers {
+		if name != "Origin" {
+			config.Header[name] = slices.Clone(values)
+		}
+	}
+	return config, nil
+}
+
+// Close gracefully closes an established websocket connection.  Subscriptions to all datarefs and
+// commands are removed, and results of in-flight requests are awaited for up to the configured
+// CloseTimeout before the connection is closed.  The read, reconnect and request expiry goroutines
+// are then stopped; the channel returned by Done is closed once they have exited, and the channels
+// returned by StateChanges are closed.  A closed client will not reconnect until Connect is called
+// again.
 func (xpc *WSClient) Close() {
+	xpc.disconnect()
+	xpc.closeStateWatchers()
+}
+
+// disconnect closes the connection as described by Close, without closing the channels returned
+// by StateChanges, so that they continue to receive transitions when Connect replaces an existing
+// connection.
+func (xpc *WSClient) disconnect() {
+	if conn := xpc.currentConn(); conn != nil {
+		xpc.unsubscribeAll()
+		xpc.drain(xpc.closeTimeout)
+	}
+
+	xpc.connLock.Lock()
+	if xpc.lifecycle != nil {
+		xpc.lifecycle.cancel()
+	}
 	if xpc.conn != nil {
 		xpc.conn.Close()
 		xpc.conn = nil
 	}
+	xpc.connLock.Unlock()
+
+	xpc.setState(ConnDisconnected)
+}
+
+// Done returns a channel which is closed once the goroutines started by the most recent call to
+// Connect have exited, following Close or a failed connection attempt.  If Connect has not been
+// called, the returned channel is already closed.
+func (xpc *WSClient) Done() <-chan struct{} {
+	xpc.connLock.RLock()
+	defer xpc.connLock.RUnlock()
+	if xpc.lifecycle == nil {
+		return closedDone
+	}
+	return xpc.lifecycle.done
+}
+
+// unsubscribeAll removes all dataref and command subscriptions, and discards the associated
+// subscription counts.
+func (wsc *WSClient) unsubscribeAll() {
+	if err := wsc.NewReq().DatarefUnsubscribeAll().Send(); err != nil {
+		wsc.client.logger.Warn("failed to unsubscribe from datarefs", "error", err)
+	}
+	if err := wsc.NewReq().CommandUnsubscribeAll().Send(); err != nil {
+		wsc.client.logger.Warn("failed to unsubscribe from commands", "error", err)
+	}
+	wsc.subscriptions.reset()
+	wsc.commandSubscriptions.reset()
+	wsc.resetElements()
+}
+
+// PendingRequests returns the websocket requests which are awaiting results, in the order in which
+// they were sent.  A request which remains pending for long is likely to have been dropped by the
+// simulator.
+func (wsc *WSClient) PendingRequests() []*WSReq {
+	return wsc.reqHistory.pendingRequests()
+}
+
+// RequestStats returns counts of the websocket requests which are awaiting results, have received
+// them, or were discarded without receiving one.  Requests which are discarded were most likely
+// dropped by the simulator.
+func (wsc *WSClient) RequestStats() RequestStats {
+	return wsc.reqHistory.getStats()
+}
+
+// drain waits for results of all in-flight requests to be received, or for the timeout to elapse.
+func (wsc *WSClient) drain(timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+	for wsc.reqHistory.pending() > 0 {
+		if time.Now().After(deadline) {
+			wsc.client.logger.Warn("closing websocket with requests still in flight",
+				"pending", wsc.reqHistory.pending())
+			return
+		}
+		time.Sleep(closeDrainFreq)
+	}
 }
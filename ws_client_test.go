@@ -0,0 +1,174 @@
+package xpweb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/websocket"
+)
+
+// fakeSim is a minimal simulator web API which serves a dataref listing and a websocket service.
+// The first websocket connection is dropped once a dataref subscription is received, as if the
+// simulator had been restarted, and the listing then reports a new ID for the dataref.
+type fakeSim struct {
+	t           *testing.T
+	connections atomic.Int32
+	// the ID of sim/test/value reported by the listing and expected in subscriptions
+	datarefID atomic.Uint64
+}
+
+func (s *fakeSim) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/capabilities", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"api":{"versions":["v2"]},"x-plane":{"version":"12.1.0"}}`))
+	})
+	mux.HandleFunc("/api/v2/datarefs", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"data": []*Dataref{
+			{ID: s.datarefID.Load(), Name: "sim/test/value", ValueType: ValueTypeFloat},
+		}})
+	})
+	mux.HandleFunc("/api/v2/commands", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data":[]}`))
+	})
+	mux.Handle("/api/v2", websocket.Handler(s.serveWS))
+	return mux
+}
+
+func (s *fakeSim) serveWS(conn *websocket.Conn) {
+	connection := s.connections.Add(1)
+	for {
+		req := &struct {
+			ReqID  uint64 `json:"req_id"`
+			Type   string `json:"type"`
+			Params struct {
+				// an array of datarefs, or "all"
+				Datarefs json.RawMessage `json:"datarefs"`
+			} `json:"params"`
+		}{}
+		if err := websocket.JSON.Receive(conn, req); err != nil {
+			return
+		}
+		var datarefs []struct {
+			ID uint64 `json:"id"`
+		}
+		json.Unmarshal(req.Params.Datarefs, &datarefs)
+		websocket.JSON.Send(conn, map[string]any{
+			"type": MessageTypeResult, "req_id": req.ReqID, "success": true,
+		})
+		if req.Type != MessageTypeDatarefSub {
+			continue
+		}
+		if connection == 1 {
+			// the simulator restarts, and the dataref is assigned a new ID
+			s.datarefID.Store(7)
+			conn.Close()
+			return
+		}
+		for _, dref := range datarefs {
+			if dref.ID != s.datarefID.Load() {
+				s.t.Errorf("resubscribed to dataref ID %d, expected %d", dref.ID,
+					s.datarefID.Load())
+			}
+			websocket.Message.Send(conn,
+				fmt.Sprintf(`{"type":"dataref_update_values","data":{"%d":1.5}}`, dref.ID))
+		}
+	}
+}
+
+func TestReconnectResubscribes(t *testing.T) {
+	sim := &fakeSim{t: t}
+	sim.datarefID.Store(1)
+	server := httptest.NewServer(sim.handler())
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{URL: server.URL, AutoReloadCache: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cachePath := filepath.Join(t.TempDir(), "cache.json")
+	cache := `{"datarefs":[{"id":1,"name":"sim/test/value","value_type":"float"}]}`
+	if err := os.WriteFile(cachePath, []byte(cache), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.LoadCacheFromFile(cachePath); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.WS.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	defer client.WS.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	updates, err := client.SubscribeFor(ctx, "sim/test/value")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-updates:
+		val := msg.Data[7]
+		if val == nil || val.GetFloatValue() != 1.5 {
+			t.Fatalf("unexpected update after reconnect: %+v", msg.Data)
+		}
+	case <-ctx.Done():
+		t.Fatal("no update received after reconnect")
+	}
+	if got := sim.connections.Load(); got != 2 {
+		t.Errorf("simulator received %d connections, expected 2", got)
+	}
+}
+
+func TestStateChangesClosed(t *testing.T) {
+	sim := &fakeSim{t: t}
+	sim.datarefID.Store(1)
+	server := httptest.NewServer(sim.handler())
+	defer server.Close()
+
+	client, err := NewClient(&ClientConfig{URL: server.URL, APIVersion: APIVersion2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a watcher whose context is done is removed and closed
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := client.WS.StateChanges(ctx)
+	cancel()
+	select {
+	case _, ok := <-cancelled:
+		if ok {
+			t.Fatal("unexpected state change before connecting")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel not closed once its context was done")
+	}
+
+	// a remaining watcher receives each transition, and is closed by Close
+	changes := client.WS.StateChanges(context.Background())
+	if err := client.WS.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	client.WS.Close()
+	var states []ConnState
+	for state := range changes {
+		states = append(states, state)
+	}
+	want := []ConnState{ConnConnecting, ConnConnected, ConnDisconnected}
+	if fmt.Sprint(states) != fmt.Sprint(want) {
+		t.Errorf("received states %v, expected %v", states, want)
+	}
+	client.WS.stateLock.Lock()
+	defer client.WS.stateLock.Unlock()
+	if len(client.WS.stateWatchers) != 0 {
+		t.Errorf("%d state watchers remain after Close", len(client.WS.stateWatchers))
+	}
+}
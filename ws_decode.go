@@ -0,0 +1,488 @@
+package xpweb
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// This file contains a single pass decoder for the JSON of inbound websocket messages, which is
+// used in place of encoding/json when the default [JSONCodec] is configured.  Dataref updates are
+// received many times per second, and decoding them through map[string]any required a full
+// validation pass, an intermediate map, a string allocation and strconv call for every key, and
+// repeated slice growth for every array.  The decoder instead parses IDs and numbers in place,
+// sizes each map and slice exactly using pooled scratch space, and allocates the DatarefValue
+// structs of an update as a single block.
+//
+// The decoded values themselves are not pooled, as they are retained by the [Store] and may be
+// retained by handlers.  Arrays of numbers, which are the bulk of every large update, are decoded
+// into a []float64 rather than boxing each element into an any.  Other values are decoded to the
+// same types as encoding/json would produce, and anything unexpected, such as an escaped string,
+// is handed to encoding/json.
+
+// errMalformedJSON is returned by the decoder for input which is not valid JSON.
+var errMalformedJSON = errors.New("malformed JSON")
+
+// updateEntry is a dataref ID and value decoded from a dataref update.
+type updateEntry struct {
+	id    uint64
+	value any
+}
+
+// Scratch space reused between decodes, to size maps and slices before allocating them.
+var (
+	entryPool  = sync.Pool{New: func() any { return new([]updateEntry) }}
+	arrayPool  = sync.Pool{New: func() any { return new([]any) }}
+	numberPool = sync.Pool{New: func() any { return new([]float64) }}
+)
+
+// jsonDecoder reads JSON values from a byte slice.
+type jsonDecoder struct {
+	data []byte
+	pos  int
+}
+
+// errorf returns an error matching errMalformedJSON which describes the current position.
+func (d *jsonDecoder) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at offset %d: %s", errMalformedJSON, d.pos, fmt.Sprintf(format, args...))
+}
+
+func (d *jsonDecoder) skipSpace() {
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case ' ', '\t', '\r', '\n':
+			d.pos++
+		default:
+			return
+		}
+	}
+}
+
+// peek returns the next non-space byte without consuming it, or 0 at the end of the input.
+func (d *jsonDecoder) peek() byte {
+	d.skipSpace()
+	if d.pos < len(d.data) {
+		return d.data[d.pos]
+	}
+	return 0
+}
+
+// consume consumes the next non-space byte if it is c, and returns whether it was.
+func (d *jsonDecoder) consume(c byte) bool {
+	if d.peek() == c {
+		d.pos++
+		return true
+	}
+	return false
+}
+
+func (d *jsonDecoder) expect(c byte) error {
+	if !d.consume(c) {
+		return d.errorf("expected %q", c)
+	}
+	return nil
+}
+
+// end returns an error unless only spaces remain in the input.
+func (d *jsonDecoder) end() error {
+	if d.peek() != 0 || d.pos < len(d.data) {
+		return d.errorf("unexpected data after value")
+	}
+	return nil
+}
+
+// rawString consumes a string and returns its contents without the quotes, and whether it
+// contains escapes, in which case the contents are not its decoded value.
+func (d *jsonDecoder) rawString() (raw []byte, escaped bool, err error) {
+	if err := d.expect('"'); err != nil {
+		return nil, false, err
+	}
+	start := d.pos
+	for d.pos < len(d.data) {
+		switch c := d.data[d.pos]; {
+		case c == '"':
+			d.pos++
+			return d.data[start : d.pos-1], escaped, nil
+		case c == '\\':
+			escaped = true
+			d.pos += 2
+		case c < 0x20:
+			return nil, false, d.errorf("control character in string")
+		default:
+			d.pos++
+		}
+	}
+	return nil, false, d.errorf("unterminated string")
+}
+
+// string consumes a string and returns its decoded value.
+func (d *jsonDecoder) string() (string, error) {
+	d.skipSpace()
+	start := d.pos
+	raw, escaped, err := d.rawString()
+	if err != nil || !escaped {
+		return string(raw), err
+	}
+	var text string
+	err = json.Unmarshal(d.data[start:d.pos], &text)
+	return text, err
+}
+
+// number consumes a number and returns its value.
+func (d *jsonDecoder) number() (float64, error) {
+	d.skipSpace()
+	start := d.pos
+scan:
+	for d.pos < len(d.data) {
+		switch d.data[d.pos] {
+		case '-', '+', '.', 'e', 'E', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+			d.pos++
+		default:
+			break scan
+		}
+	}
+	if value, ok := parseSimpleNumber(d.data[start:d.pos]); ok {
+		return value, nil
+	}
+	value, err := strconv.ParseFloat(string(d.data[start:d.pos]), 64)
+	if err != nil {
+		return 0, d.errorf("invalid number %q", d.data[start:d.pos])
+	}
+	return value, nil
+}
+
+// float64Pow10 holds the powers of ten which are exactly representable as a float64.
+var float64Pow10 = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11,
+	1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19, 1e20, 1e21, 1e22,
+}
+
+// parseSimpleNumber parses a number without an exponent and with at most 15 digits, which covers
+// nearly every value sent by the simulator, without the string conversion required by strconv.
+// Both the digits and the power of ten are then exact, so a single division is correctly rounded,
+// as strconv does in the same case.  It returns false for any other number.
+func parseSimpleNumber(text []byte) (float64, bool) {
+	negative := len(text) > 0 && text[0] == '-'
+	if negative {
+		text = text[1:]
+	}
+	var mantissa uint64
+	digits, fraction := 0, -1
+	for idx, c := range text {
+		switch {
+		case c >= '0' && c <= '9':
+			mantissa = mantissa*10 + uint64(c-'0')
+			digits++
+		case c == '.' && fraction < 0 && idx > 0 && idx < len(text)-1:
+			fraction = 0
+			continue
+		default:
+			return 0, false
+		}
+		if fraction >= 0 {
+			fraction++
+		}
+	}
+	if digits == 0 || digits > 15 || text[0] == '0' && len(text) > 1 && text[1] != '.' {
+		return 0, false
+	}
+	value := float64(mantissa)
+	if fraction > 0 {
+		value /= float64Pow10[fraction]
+	}
+	if negative {
+		value = -value
+	}
+	return value, true
+}
+
+// literal consumes the specified literal, such as true.
+func (d *jsonDecoder) literal(text string) error {
+	d.skipSpace()
+	if len(d.data)-d.pos < len(text) || string(d.data[d.pos:d.pos+len(text)]) != text {
+		return d.errorf("invalid literal")
+	}
+	d.pos += len(text)
+	return nil
+}
+
+// value consumes any value, and returns it as encoding/json would unmarshal it into an any.
+func (d *jsonDecoder) value() (any, error) {
+	switch c := d.peek(); {
+	case c == '"':
+		return d.string()
+	case c == '[':
+		if numbers, ok, err := d.numbers(); ok || err != nil {
+			return numbers, err
+		}
+		return d.array()
+	case c == '{':
+		// objects are not sent as dataref values, so are left to encoding/json
+		start := d.pos
+		if err := d.skip(); err != nil {
+			return nil, err
+		}
+		var obj map[string]any
+		err := json.Unmarshal(d.data[start:d.pos], &obj)
+		return obj, err
+	case c == 't':
+		return true, d.literal("true")
+	case c == 'f':
+		return false, d.literal("false")
+	case c == 'n':
+		return nil, d.literal("null")
+	case c == '-' || c >= '0' && c <= '9':
+		return d.number()
+	}
+	return nil, d.errorf("unexpected character")
+}
+
+// array consumes an array and returns its elements.
+func (d *jsonDecoder) array() ([]any, error) {
+	if err := d.expect('['); err != nil {
+		return nil, err
+	}
+	scratch := arrayPool.Get().(*[]any)
+	defer func() {
+		clear(*scratch)
+		*scratch = (*scratch)[:0]
+		arrayPool.Put(scratch)
+	}()
+
+	if !d.consume(']') {
+		for {
+			elem, err := d.value()
+			if err != nil {
+				return nil, err
+			}
+			*scratch = append(*scratch, elem)
+			if d.consume(']') {
+				break
+			}
+			if err := d.expect(','); err != nil {
+				return nil, err
+			}
+		}
+	}
+	elems := make([]any, len(*scratch))
+	copy(elems, *scratch)
+	return elems, nil
+}
+
+// numbers consumes an array whose elements are all numbers, and returns them.  If the array is
+// empty, or holds anything other than a number, nothing is consumed and false is returned.
+func (d *jsonDecoder) numbers() ([]float64, bool, error) {
+	start := d.pos
+	if err := d.expect('['); err != nil {
+		return nil, false, err
+	}
+	scratch := numberPool.Get().(*[]float64)
+	defer func() {
+		*scratch = (*scratch)[:0]
+		numberPool.Put(scratch)
+	}()
+
+	for {
+		if c := d.peek(); c != '-' && (c < '0' || c > '9') {
+			d.pos = start
+			return nil, false, nil
+		}
+		elem, err := d.number()
+		if err != nil {
+			return nil, false, err
+		}
+		*scratch = append(*scratch, elem)
+		if d.consume(']') {
+			break
+		}
+		if err := d.expect(','); err != nil {
+			return nil, false, err
+		}
+	}
+	elems := make([]float64, len(*scratch))
+	copy(elems, *scratch)
+	return elems, true, nil
+}
+
+// skip consumes any value without decoding it.
+func (d *jsonDecoder) skip() error {
+	switch c := d.peek(); {
+	case c == '"':
+		_, _, err := d.rawString()
+		return err
+	case c == '[' || c == '{':
+		d.pos++
+		closing := byte(']')
+		if c == '{' {
+			closing = '}'
+		}
+		if d.consume(closing) {
+			return nil
+		}
+		for {
+			if c == '{' {
+				if _, _, err := d.rawString(); err != nil {
+					return err
+				}
+				if err := d.expect(':'); err != nil {
+					return err
+				}
+			}
+			if err := d.skip(); err != nil {
+				return err
+			}
+			if d.consume(closing) {
+				return nil
+			}
+			if err := d.expect(','); err != nil {
+				return err
+			}
+		}
+	case c == 't':
+		return d.literal("true")
+	case c == 'f':
+		return d.literal("false")
+	case c == 'n':
+		return d.literal("null")
+	}
+	_, err := d.number()
+	return err
+}
+
+// object consumes an object, calling field for each key, which must consume the value.
+func (d *jsonDecoder) object(field func(key []byte) error) error {
+	if err := d.expect('{'); err != nil {
+		return err
+	}
+	if d.consume('}') {
+		return nil
+	}
+	for {
+		key, _, err := d.rawString()
+		if err != nil {
+			return err
+		}
+		if err := d.expect(':'); err != nil {
+			return err
+		}
+		if err := field(key); err != nil {
+			return err
+		}
+		if d.consume('}') {
+			return nil
+		}
+		if err := d.expect(','); err != nil {
+			return err
+		}
+	}
+}
+
+// parseID parses a dataref or command ID object key.
+func parseID(key []byte) (uint64, error) {
+	if len(key) == 0 || len(key) > 20 {
+		return 0, fmt.Errorf("invalid ID %q", key)
+	}
+	var id uint64
+	for _, c := range key {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid ID %q", key)
+		}
+		next := id*10 + uint64(c-'0')
+		if next < id {
+			return 0, fmt.Errorf("invalid ID %q", key)
+		}
+		id = next
+	}
+	return id, nil
+}
+
+// decodeMessageType returns the value of the type field of a message.  The remainder of the
+// message is not scanned once the type is found, as it is decoded according to the type.
+func decodeMessageType(data []byte) (string, error) {
+	d := &jsonDecoder{data: data}
+	if err := d.expect('{'); err != nil {
+		return "", err
+	}
+	for d.peek() == '"' {
+		key, _, err := d.rawString()
+		if err != nil {
+			return "", err
+		}
+		if err := d.expect(':'); err != nil {
+			return "", err
+		}
+		if string(key) == "type" {
+			if d.peek() != '"' {
+				return "", errors.New("JSON type value is not string")
+			}
+			return d.string()
+		}
+		if err := d.skip(); err != nil {
+			return "", err
+		}
+		if !d.consume(',') {
+			break
+		}
+	}
+	return "", errors.New("JSON data does not contain type key")
+}
+
+// decodeDatarefUpdate decodes a dataref update message.
+func decodeDatarefUpdate(data []byte) (*WSMessageDatarefUpdate, error) {
+	d := &jsonDecoder{data: data}
+	msg := &WSMessageDatarefUpdate{}
+	err := d.object(func(key []byte) error {
+		switch string(key) {
+		case "type":
+			var err error
+			msg.Type, err = d.string()
+			return err
+		case "data":
+			return d.datarefValues(&msg.Data)
+		}
+		return d.skip()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msg, d.end()
+}
+
+// datarefValues consumes an object of dataref values keyed by ID.
+func (d *jsonDecoder) datarefValues(m *WSDatarefValuesMap) error {
+	if d.peek() == 'n' {
+		return d.literal("null")
+	}
+	scratch := entryPool.Get().(*[]updateEntry)
+	defer func() {
+		clear(*scratch)
+		*scratch = (*scratch)[:0]
+		entryPool.Put(scratch)
+	}()
+
+	err := d.object(func(key []byte) error {
+		id, err := parseID(key)
+		if err != nil {
+			return err
+		}
+		value, err := d.value()
+		if err != nil {
+			return err
+		}
+		*scratch = append(*scratch, updateEntry{id: id, value: value})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	values := make([]DatarefValue, len(*scratch))
+	*m = make(WSDatarefValuesMap, len(*scratch))
+	for idx, entry := range *scratch {
+		values[idx].Value = entry.value
+		(*m)[entry.id] = &values[idx]
+	}
+	return nil
+}
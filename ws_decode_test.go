@@ -0,0 +1,219 @@
+package xpweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// updatePayload returns the JSON of a dataref update message having values for the specified
+// number of datarefs, each of which is an array of the specified length, or a scalar if zero.
+func updatePayload(datarefs int, length int) []byte {
+	var payload strings.Builder
+	payload.WriteString(`{"type":"dataref_update_values","data":{`)
+	for idx := range datarefs {
+		if idx > 0 {
+			payload.WriteByte(',')
+		}
+		fmt.Fprintf(&payload, `"%d":`, idx+1)
+		if length == 0 {
+			fmt.Fprintf(&payload, "%.4f", float64(idx)*1.37)
+			continue
+		}
+		payload.WriteByte('[')
+		for elem := range length {
+			if elem > 0 {
+				payload.WriteByte(',')
+			}
+			fmt.Fprintf(&payload, "%.4f", float64(idx+elem)*0.25)
+		}
+		payload.WriteByte(']')
+	}
+	payload.WriteString(`}}`)
+	return []byte(payload.String())
+}
+
+// updateSizes are dataref update messages of a handful of instruments, a cockpit's worth of
+// values, and large arrays such as TCAS targets or engine parameters.
+var updateSizes = []struct {
+	name     string
+	datarefs int
+	length   int
+}{
+	{"Small", 10, 0},
+	{"Medium", 200, 8},
+	{"Large", 20, 1000},
+}
+
+func TestDecodeDatarefUpdateMatchesEncodingJSON(t *testing.T) {
+	for _, size := range updateSizes {
+		payload := updatePayload(size.datarefs, size.length)
+		msg, err := decodeDatarefUpdate(payload)
+		if err != nil {
+			t.Fatalf("%s: %v", size.name, err)
+		}
+		want := &struct {
+			Data map[uint64]any `json:"data"`
+		}{}
+		if err := json.Unmarshal(payload, want); err != nil {
+			t.Fatal(err)
+		}
+		if len(msg.Data) != len(want.Data) {
+			t.Fatalf("%s: decoded %d values, expected %d", size.name, len(msg.Data),
+				len(want.Data))
+		}
+		for id, value := range want.Data {
+			if got := msg.Data[id]; got == nil || fmt.Sprint(got.Value) != fmt.Sprint(value) {
+				t.Errorf("%s: value of %d differs from encoding/json", size.name, id)
+			}
+		}
+	}
+}
+
+func TestDecodeDatarefUpdateNestingAndEscapes(t *testing.T) {
+	payload := []byte(`{"type":"dataref_update_values","data":{
+		"1": [[1, 2.5], [], [-3e2]],
+		"2": [1, [2], "three", null, true],
+		"3": {"a\u0062": [1], "c\"": "d\\e"},
+		"4": "caf\u00e9\n",
+		"5": [],
+		"6": [ -0.5 , 1e-3 ,7 ]
+	}}`)
+	msg, err := decodeDatarefUpdate(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &struct {
+		Data map[uint64]any `json:"data"`
+	}{}
+	if err := json.Unmarshal(payload, want); err != nil {
+		t.Fatal(err)
+	}
+	if len(msg.Data) != len(want.Data) {
+		t.Fatalf("decoded %d values, expected %d", len(msg.Data), len(want.Data))
+	}
+	for id, value := range want.Data {
+		// number arrays are decoded to []float64 rather than []any, so compare the encodings
+		got, _ := json.Marshal(msg.Data[id].Value)
+		expected, _ := json.Marshal(value)
+		if string(got) != string(expected) {
+			t.Errorf("value of %d decoded as %s, expected %s", id, got, expected)
+		}
+	}
+	if _, ok := msg.Data[6].Value.([]float64); !ok {
+		t.Errorf("number array decoded as %T, expected []float64", msg.Data[6].Value)
+	}
+}
+
+func TestDecodeDatarefUpdateMalformed(t *testing.T) {
+	for _, data := range []string{
+		`[1, 2}`,
+		`[1, ]`,
+		`[1 2]`,
+		`[1, "a"`,
+		`[-]`,
+		`1.2.3`,
+		`tru`,
+		`"abc`,
+		`"a\"`,
+		"\"a\x01\"",
+		`{"a" 1}`,
+		`[[1], [2]`,
+	} {
+		payload := `{"type":"dataref_update_values","data":{"1":` + data + `}}`
+		if _, err := decodeDatarefUpdate([]byte(payload)); err == nil {
+			t.Errorf("%s: expected an error", data)
+		}
+	}
+	for _, payload := range []string{
+		`{"type":"dataref_update_values","data":{"x":1}}`,
+		`{"type":"dataref_update_values","data":{"1":1}} {}`,
+		`{"type":"dataref_update_values","data":{"1":1}`,
+		`{"type":"dataref_update_values","data":{"1":1,}}`,
+	} {
+		if _, err := decodeDatarefUpdate([]byte(payload)); err == nil {
+			t.Errorf("%s: expected an error", payload)
+		}
+	}
+}
+
+func TestDecodeDatarefUpdateAllocs(t *testing.T) {
+	// each number array is allocated as a whole, rather than boxing each of its elements
+	size := updateSizes[len(updateSizes)-1]
+	payload := updatePayload(size.datarefs, size.length)
+	allocs := testing.AllocsPerRun(10, func() {
+		if _, err := decodeDatarefUpdate(payload); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if limit := float64(3*size.datarefs + 10); allocs > limit {
+		t.Errorf("decoding %d arrays of %d numbers made %.0f allocations, expected at most %.0f",
+			size.datarefs, size.length, allocs, limit)
+	}
+}
+
+func BenchmarkDecodeMessageType(b *testing.B) {
+	// the type is found without scanning the remainder of the message, so bytes are not reported
+	payload := updatePayload(200, 8)
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := decodeMessageType(payload); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeUpdate(b *testing.B) {
+	for _, size := range updateSizes {
+		payload := updatePayload(size.datarefs, size.length)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for b.Loop() {
+				if _, err := decodeDatarefUpdate(payload); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeUpdateTyped(b *testing.B) {
+	for _, size := range updateSizes {
+		payload := updatePayload(size.datarefs, size.length)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for b.Loop() {
+				msg, err := decodeDatarefUpdate(payload)
+				if err != nil {
+					b.Fatal(err)
+				}
+				for _, val := range msg.Data {
+					val.GetFloatArrayValue()
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeUpdateEncodingJSON(b *testing.B) {
+	// the decode as it was performed through map[string]any, for comparison
+	for _, size := range updateSizes {
+		payload := updatePayload(size.datarefs, size.length)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(payload)))
+			for b.Loop() {
+				msg := &struct {
+					Type string         `json:"type"`
+					Data map[string]any `json:"data"`
+				}{}
+				if err := json.Unmarshal(payload, msg); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
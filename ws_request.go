@@ -1,5 +1,10 @@
 package xpweb
 
+import (
+	"context"
+	"fmt"
+)
+
 // WSReq is an object containing the payload of a websocket request.  A WSReq object is easiest to
 // instantiate using the function appropriate for the type of request being made.
 //
@@ -12,6 +17,7 @@ type WSReq struct {
 	Type     string `json:"type"`
 	Params   any    `json:"params"`
 	wsClient *WSClient
+	span     Span
 }
 
 // NewReq instantiates a new websocket request object having the next available request ID.  Type
@@ -118,6 +124,101 @@ func (r *WSReq) Send() error {
 	return r.wsClient.Send(r)
 }
 
+// SendContext submits the WSReq object to the websocket service as with [WSReq.Send], but returns
+// the context's error if the context is done before the request has been written.  A write which
+// has begun cannot be interrupted, so it continues in the background.
+func (r *WSReq) SendContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	sent := make(chan error, 1)
+	go func() {
+		sent <- r.wsClient.Send(r)
+	}()
+	select {
+	case err := <-sent:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Validate checks the request for mistakes which the simulator would reject with an unhelpful
+// result, returning an error matching [ErrInvalidRequest] if one is found.  The type must be set,
+// and for the request types built by the WSReq methods, the list of datarefs or commands must not
+// be empty, nor contain an ID of 0, which is the ID used for a name which was not found in the
+// cache.  The params of other request types are not checked.
+//
+// Requests are validated by [WSClient.Send] unless SkipRequestValidation is set in the
+// [ClientConfig].
+func (r *WSReq) Validate() error {
+	if r.Type == "" {
+		return fmt.Errorf("%w: type is not set", ErrInvalidRequest)
+	}
+	params, ok := r.Params.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	var key string
+	switch r.Type {
+	case MessageTypeDatarefSub, MessageTypeDatarefUnsub, MessageTypeDatarefSet:
+		key = "datarefs"
+	case MessageTypeCommandSub, MessageTypeCommandUnsub, MessageTypeCommandSetIsActive:
+		key = "commands"
+	default:
+		return nil
+	}
+
+	var ids []uint64
+	switch items := params[key].(type) {
+	case string:
+		if items == "all" && r.Type != MessageTypeDatarefSet &&
+			r.Type != MessageTypeCommandSetIsActive {
+			return nil
+		}
+		return fmt.Errorf("%w: %s of %s request must be a list", ErrInvalidRequest, key, r.Type)
+	case []*WSDataref:
+		for _, item := range items {
+			ids = append(ids, validID(item, func(d *WSDataref) uint64 { return d.ID }))
+		}
+	case []*WSDatarefValue:
+		for _, item := range items {
+			ids = append(ids, validID(item, func(d *WSDatarefValue) uint64 { return d.ID }))
+		}
+	case []*WSCommand:
+		for _, item := range items {
+			ids = append(ids, validID(item, func(c *WSCommand) uint64 { return c.ID }))
+		}
+	case []map[string]uint64:
+		for _, item := range items {
+			ids = append(ids, item["id"])
+		}
+	case nil:
+	default:
+		return nil
+	}
+
+	if len(ids) == 0 {
+		return fmt.Errorf("%w: %s request has no %s", ErrInvalidRequest, r.Type, key)
+	}
+	for idx, id := range ids {
+		if id == 0 {
+			return fmt.Errorf("%w: %s request has an unknown ID in %s element %d",
+				ErrInvalidRequest, r.Type, key, idx)
+		}
+	}
+	return nil
+}
+
+// validID returns the ID of a request item, or 0 if the item is nil.
+func validID[T any](item *T, id func(*T) uint64) uint64 {
+	if item == nil {
+		return 0
+	}
+	return id(item)
+}
+
 // WSCommand is a structure which is included in websocket requests to set whether a command is
 // active.  It is easiest to instantiate a WSCommand object using [WithCommand] or
 // [Client.WithCommand].
@@ -159,7 +260,7 @@ func NewWSCommand(id uint64, isActive bool) *WSCommand {
 // NewCommand behaves like [NewWSCommand] except that it takes a command name as an argument and
 // uses the [Client] object's loaded command cache to map the command name to its ID value. If
 // the command does not exist, an ID value of 0 will be used and a websocket request containing the
-// returned value should fail.
+// returned value will fail validation.
 func (wsc *WSClient) NewCommand(name string, isActive bool) *WSCommand {
 	return NewWSCommand(wsc.client.GetCommandID(name), isActive)
 }
@@ -193,7 +294,7 @@ func NewWSDataref(id uint64) *WSDataref {
 // NewDataref behaves like [NewWSDataref] except that it takes a dataref name as the argument and
 // uses the [Client] object's loaded dataref cache to map the dataref name to its ID value.  If
 // the dataref does not exist, an ID value of 0 will be used and a websocket request containing
-// the returned value should fail.
+// the returned value will fail validation.
 func (wsc *WSClient) NewDataref(name string) *WSDataref {
 	return NewWSDataref(wsc.client.GetDatarefID(name))
 }
@@ -221,7 +322,7 @@ func NewWSDatarefValue(id uint64, value any) *WSDatarefValue {
 // NewWSDatarefValue behaves like [NewWSDatarefValue] except that it takes a dataref name as the
 // argument and uses the [Client] object's loaded dataref cache to map the dataref name to its ID
 // value.  If the dataref does not exist, an ID value of 0 will be used and a websocket request
-// containing the returned value should fail.
+// containing the returned value will fail validation.
 func (wsc *WSClient) NewDatarefValue(name string, value any) *WSDatarefValue {
 	return NewWSDatarefValue(wsc.client.GetDatarefID(name), value)
 }
@@ -0,0 +1,44 @@
+package xpweb
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func BenchmarkMarshalRequest(b *testing.B) {
+	client := newBenchClient(b)
+	for _, count := range []int{1, 100} {
+		names := make([]string, count)
+		for idx := range names {
+			names[idx] = benchDatarefName(idx)
+		}
+
+		b.Run(fmt.Sprintf("Subscribe/%d", count), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				drefs := make([]*WSDataref, len(names))
+				for idx, name := range names {
+					drefs[idx] = client.WS.NewDataref(name)
+				}
+				req := client.WS.NewReq().DatarefSubscribe(drefs...)
+				if _, err := json.Marshal(req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("Set/%d", count), func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				values := make([]*WSDatarefValue, len(names))
+				for idx, name := range names {
+					values[idx] = client.WS.NewDatarefValue(name, []float64{1, 2, 3, 4})
+				}
+				req := client.WS.NewReq().DatarefSet(values...)
+				if _, err := json.Marshal(req); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,69 +1,97 @@
 package xpweb
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"maps"
-	"slices"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 )
 
+// errUnknownMessageType is returned by toMessage for message types which are not known to this
+// package, so that they may be passed to the RawMessageHandler.
+var errUnknownMessageType = errors.New("unknown message type")
+
 // maxReqHistory sets a limit on WSReq objects stored in a reqHistory object.
 // Ideally the simulator sends timely results for every request and we never climb up to this value,
 // but this exists to prevent the app from exhausting memory if the simulator decides not to send
 // a result for some requests.
 const maxReqHistory = 1000
 
-// wsMessageStub is a generic struct which receives inbound websocket messages.  It sets ReqID and
-// Type, and remarshals the entire JSON object so that it can be fully unmarshalled into a more
-// specific message struct.
+// wsMessageStub holds an inbound websocket message whose type has been decoded, so that the
+// entire payload can then be unmarshalled into a more specific message struct.
 type wsMessageStub struct {
 	Type string `json:"type"`
 	json []byte
 }
 
-func (m *wsMessageStub) UnmarshalJSON(data []byte) error {
-	genericObj := make(map[string]any)
-	err := json.Unmarshal(data, &genericObj)
-	if err != nil {
-		return err
+// parseMessageStub decodes the type of the message payload with the specified codec.
+func parseMessageStub(codec Codec, data []byte) (wsMessageStub, error) {
+	stub := wsMessageStub{json: data}
+	if _, ok := codec.(JSONCodec); ok {
+		var err error
+		stub.Type, err = decodeMessageType(data)
+		return stub, err
 	}
-	reqType, ok := genericObj["type"]
-	if !ok {
-		return errors.New("JSON data does not contain type key")
+	if err := codec.Unmarshal(data, &stub); err != nil {
+		return stub, err
 	}
-	m.Type, ok = reqType.(string)
-	if !ok {
-		return errors.New("JSON type value is not string")
+	if stub.Type == "" {
+		return stub, errors.New("message does not contain type")
 	}
-	m.json = data
+	return stub, nil
+}
 
-	return nil
+// datarefUpdateWire and commandUpdateWire are the wire formats of update messages, which are
+// decoded by a [Codec] and then converted, so that custom UnmarshalJSON methods are not required.
+type datarefUpdateWire struct {
+	Type string         `json:"type"`
+	Data map[string]any `json:"data"`
 }
 
-// copyTo unmarshals the message stub's JSON onto the target object
-func (m wsMessageStub) copyTo(target any) error {
-	return json.Unmarshal(m.json, &target)
+type commandUpdateWire struct {
+	Type string          `json:"type"`
+	Data map[string]bool `json:"data"`
 }
 
-// toMessage returns the complete message object for this message
-func (m wsMessageStub) toMessage() (msg any, err error) {
+// toMessage returns the complete message object for this message, unmarshalled with the specified
+// codec.
+func (m wsMessageStub) toMessage(codec Codec) (msg any, err error) {
 	switch m.Type {
 	case MessageTypeResult:
-		msg = &WSMessageResult{}
+		result := &WSMessageResult{}
+		if err := codec.Unmarshal(m.json, result); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case MessageTypeDatarefUpdate:
-		msg = &WSMessageDatarefUpdate{}
+		if _, ok := codec.(JSONCodec); ok {
+			return decodeDatarefUpdate(m.json)
+		}
+		var wire datarefUpdateWire
+		if err := codec.Unmarshal(m.json, &wire); err != nil {
+			return nil, err
+		}
+		update := &WSMessageDatarefUpdate{Type: wire.Type}
+		if err := update.Data.fromWire(wire.Data); err != nil {
+			return nil, err
+		}
+		return update, nil
 	case MessageTypeCommandUpdate:
-		msg = &WSMessageCommandUpdate{}
-	default:
-		return nil, fmt.Errorf("unknown message type: %s", m.Type)
-	}
-	if err = m.copyTo(msg); err != nil {
-		return nil, err
+		var wire commandUpdateWire
+		if err := codec.Unmarshal(m.json, &wire); err != nil {
+			return nil, err
+		}
+		update := &WSMessageCommandUpdate{Type: wire.Type}
+		if err := update.Data.fromWire(wire.Data); err != nil {
+			return nil, err
+		}
+		return update, nil
 	}
-	return msg, nil
+	return nil, fmt.Errorf("%w: %s", errUnknownMessageType, m.Type)
 }
 
 type WSMessageResult struct {
@@ -77,16 +105,72 @@ type WSMessageResult struct {
 
 func (m WSMessageResult) GetType() string { return m.Type }
 
+// Values of the error_code field of a [WSMessageResult] for which helper methods are provided.
+// Codes not listed here may still be received, and can be compared with ErrorCode directly.
+const (
+	ResultErrorInvalidRequest   string = "invalid_request"
+	ResultErrorInvalidParams    string = "invalid_params"
+	ResultErrorInvalidDatarefID string = "invalid_dataref_id"
+	ResultErrorInvalidCommandID string = "invalid_command_id"
+	ResultErrorNotWritable      string = "dataref_not_writable"
+	ResultErrorInternal         string = "internal_error"
+)
+
+// IsNotFound returns whether the request failed because it referenced a dataref or command ID
+// which does not exist.
+func (m WSMessageResult) IsNotFound() bool {
+	switch m.ErrorCode {
+	case ResultErrorInvalidDatarefID, ResultErrorInvalidCommandID:
+		return true
+	}
+	return strings.HasSuffix(m.ErrorCode, "_not_found")
+}
+
+// IsInvalidRequest returns whether the request failed because it was malformed.
+func (m WSMessageResult) IsInvalidRequest() bool {
+	return m.ErrorCode == ResultErrorInvalidRequest
+}
+
+// IsInvalidParams returns whether the request failed because of invalid parameters.
+func (m WSMessageResult) IsInvalidParams() bool {
+	return m.ErrorCode == ResultErrorInvalidParams
+}
+
+// IsNotWritable returns whether the request failed because it attempted to write a read-only
+// dataref.
+func (m WSMessageResult) IsNotWritable() bool {
+	return m.ErrorCode == ResultErrorNotWritable
+}
+
+// IsInternal returns whether the request failed because of an error within the simulator.
+func (m WSMessageResult) IsInternal() bool {
+	return m.ErrorCode == ResultErrorInternal
+}
+
+// Err returns nil if the request succeeded, or otherwise an [ErrorResponse] holding the error code
+// and message, so that websocket results can be handled like REST errors.
+func (m WSMessageResult) Err() error {
+	if m.Success {
+		return nil
+	}
+	return &ErrorResponse{ErrorCode: m.ErrorCode, ErrorMessage: m.ErrorMessage}
+}
+
 type WSDatarefValuesMap map[uint64]*DatarefValue
 
 func (m *WSDatarefValuesMap) UnmarshalJSON(data []byte) error {
-	// inbound data has dataref IDs as strings for JSON object keys
-	*m = make(WSDatarefValuesMap)
-	valMap := *m
-	dataMap := make(map[string]any)
-	if err := json.Unmarshal(data, &dataMap); err != nil {
+	d := &jsonDecoder{data: data}
+	if err := d.datarefValues(m); err != nil {
 		return err
 	}
+	return d.end()
+}
+
+// fromWire populates the map from decoded values keyed by dataref ID strings.
+func (m *WSDatarefValuesMap) fromWire(dataMap map[string]any) error {
+	// inbound data has dataref IDs as strings for JSON object keys
+	*m = make(WSDatarefValuesMap, len(dataMap))
+	valMap := *m
 	for idString, val := range dataMap {
 		id, err := strconv.ParseUint(idString, 10, 64)
 		if err != nil {
@@ -125,13 +209,18 @@ type WSCommandStatusMap map[uint64]*CommandStatus
 
 // UnmarshalJSON handles converting data from the JSON data into the desired structure.
 func (m *WSCommandStatusMap) UnmarshalJSON(data []byte) error {
-	// inbound data has command IDs as strings for JSON object keys
-	*m = make(WSCommandStatusMap)
-	valMap := *m
 	dataMap := make(map[string]bool)
 	if err := json.Unmarshal(data, &dataMap); err != nil {
 		return err
 	}
+	return m.fromWire(dataMap)
+}
+
+// fromWire populates the map from active states keyed by command ID strings.
+func (m *WSCommandStatusMap) fromWire(dataMap map[string]bool) error {
+	// inbound data has command IDs as strings for JSON object keys
+	*m = make(WSCommandStatusMap, len(dataMap))
+	valMap := *m
 	for idString, isActive := range dataMap {
 		id, err := strconv.ParseUint(idString, 10, 64)
 		if err != nil {
@@ -172,38 +261,161 @@ type DatarefUpdateHandler func(*WSMessageDatarefUpdate)
 // back from the websocket service.
 type ResultHandler func(*WSMessageResult)
 
+// OrphanedRequestHandler is a function which performs some action for a websocket request which
+// was discarded without receiving a result, most likely because the simulator dropped it.
+type OrphanedRequestHandler func(*WSReq)
+
+// RawMessageHandler is a function which performs some action for any incoming message of a type
+// which is not known to this package, such as one introduced by a newer version of X-Plane.  It
+// receives the message type and the complete JSON payload of the message.
+type RawMessageHandler func(messageType string, payload []byte)
+
+// RequestStats holds counts of websocket requests awaiting or having received results.
+type RequestStats struct {
+	// The number of requests which are awaiting a result.
+	Pending int
+	// The number of requests which have received a result.
+	Completed uint64
+	// The number of requests which received no result within the ResultTimeout of the
+	// [ClientConfig].
+	Expired uint64
+	// The number of requests which were discarded without a result because the maximum number of
+	// requests had since been sent.
+	Evicted uint64
+}
+
+// reqHistoryEntry is a request awaiting a result, and when it was sent.
+type reqHistoryEntry struct {
+	req    *WSReq
+	sentAt time.Time
+}
+
 // reqHistory is a means to store submitted requests so they can be looked up when a result is
-// received.
+// received.  Requests are held in a ring buffer in the order in which they were sent, so that the
+// oldest may be expired or evicted in constant time, and indexed by ID in a map.  An entry remains
+// in the ring after its result is received, until it is overwritten or reaches the head.
 type reqHistory struct {
-	requests map[uint64]*WSReq
-	lock     sync.RWMutex
+	requests map[uint64]*reqHistoryEntry
+	ring     []*reqHistoryEntry
+	head     int
+	count    int
+	ttl      time.Duration
+	stats    RequestStats
+	// requests orphaned while the lock is held, to be passed to onOrphan once it is released
+	orphans  []*WSReq
+	onOrphan OrphanedRequestHandler
+	lock     sync.Mutex
 }
 
-func newReqHistory() *reqHistory {
-	return &reqHistory{requests: make(map[uint64]*WSReq)}
+// newReqHistory returns a reqHistory whose requests expire after the specified time without a
+// result, or never if it is zero.  The optional handler is called for each orphaned request.
+func newReqHistory(ttl time.Duration, onOrphan OrphanedRequestHandler) *reqHistory {
+	return &reqHistory{
+		requests: make(map[uint64]*reqHistoryEntry),
+		ring:     make([]*reqHistoryEntry, maxReqHistory),
+		ttl:      ttl,
+		onOrphan: onOrphan,
+	}
+}
+
+// unlock releases the lock, and then handles any requests which were orphaned while it was held,
+// so that the handler may safely call back into the reqHistory.
+func (rh *reqHistory) unlock() {
+	orphans := rh.orphans
+	rh.orphans = nil
+	rh.lock.Unlock()
+	for _, req := range orphans {
+		// no result is expected for an orphaned request, so end its span now
+		endWSSpan(req, nil)
+		if rh.onOrphan != nil {
+			rh.onOrphan(req)
+		}
+	}
 }
 
 func (rh *reqHistory) add(req *WSReq) {
 	rh.lock.Lock()
-	defer rh.lock.Unlock()
-	rh.requests[req.ReqID] = req
-
-	// trim handlers map down to limit if it has been exceeded
-	requestCount := len(rh.requests)
-	if requestCount > maxReqHistory {
-		numToTrim := requestCount - maxReqHistory
-		reqIDs := slices.Collect(maps.Keys(rh.requests))
-		slices.Sort(reqIDs)
-		for _, removeID := range reqIDs[0:numToTrim] {
-			delete(rh.requests, removeID)
+	defer rh.unlock()
+
+	now := time.Now()
+	rh.expire(now)
+	if rh.count == len(rh.ring) {
+		if entry := rh.pop(); entry != nil {
+			rh.stats.Evicted++
+			rh.orphan(entry)
 		}
 	}
+	entry := &reqHistoryEntry{req: req, sentAt: now}
+	rh.ring[(rh.head+rh.count)%len(rh.ring)] = entry
+	rh.count++
+	rh.requests[req.ReqID] = entry
 }
 
-func (rh *reqHistory) get(reqID uint64) *WSReq {
-	rh.lock.RLock()
-	defer rh.lock.RUnlock()
-	return rh.requests[reqID]
+// pop removes the oldest entry from the ring, and returns it if it is still awaiting a result.
+func (rh *reqHistory) pop() *reqHistoryEntry {
+	entry := rh.ring[rh.head]
+	rh.ring[rh.head] = nil
+	rh.head = (rh.head + 1) % len(rh.ring)
+	rh.count--
+	if rh.requests[entry.req.ReqID] != entry {
+		return nil
+	}
+	delete(rh.requests, entry.req.ReqID)
+	return entry
+}
+
+// expire removes entries from the head of the ring which have received a result, or which were
+// sent longer than the TTL before the specified time.
+func (rh *reqHistory) expire(now time.Time) {
+	for rh.count > 0 {
+		entry := rh.ring[rh.head]
+		pending := rh.requests[entry.req.ReqID] == entry
+		if pending && (rh.ttl <= 0 || now.Sub(entry.sentAt) < rh.ttl) {
+			return
+		}
+		if rh.pop() != nil {
+			rh.stats.Expired++
+			rh.orphan(entry)
+		}
+	}
+}
+
+// sweep expires requests as their TTL elapses, so that they are reported as orphaned promptly
+// even while no further requests are sent.  It returns once the context is cancelled.
+func (rh *reqHistory) sweep(ctx context.Context) {
+	if rh.ttl <= 0 {
+		return
+	}
+	timer := time.NewTimer(rh.ttl)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			timer.Reset(rh.expireNow())
+		}
+	}
+}
+
+// expireNow expires requests, and returns the time remaining until the oldest request still
+// awaiting a result will expire, or the TTL if there is none.  A request sent later cannot expire
+// sooner.
+func (rh *reqHistory) expireNow() time.Duration {
+	rh.lock.Lock()
+	defer rh.unlock()
+	now := time.Now()
+	rh.expire(now)
+	if rh.count == 0 {
+		return rh.ttl
+	}
+	return rh.ring[rh.head].sentAt.Add(rh.ttl).Sub(now)
+}
+
+// orphan records a request which was removed without receiving a result, to be handled once the
+// lock is released.
+func (rh *reqHistory) orphan(entry *reqHistoryEntry) {
+	rh.orphans = append(rh.orphans, entry.req)
 }
 
 func (rh *reqHistory) delete(reqID uint64) {
@@ -212,10 +424,46 @@ func (rh *reqHistory) delete(reqID uint64) {
 	delete(rh.requests, reqID)
 }
 
+// pending returns the number of requests which are awaiting a result.
+func (rh *reqHistory) pending() int {
+	rh.lock.Lock()
+	defer rh.unlock()
+	rh.expire(time.Now())
+	return len(rh.requests)
+}
+
+// pendingRequests returns the requests which are awaiting a result, in the order in which they
+// were sent.
+func (rh *reqHistory) pendingRequests() []*WSReq {
+	rh.lock.Lock()
+	defer rh.unlock()
+	rh.expire(time.Now())
+	reqs := make([]*WSReq, 0, len(rh.requests))
+	for idx := range rh.count {
+		entry := rh.ring[(rh.head+idx)%len(rh.ring)]
+		if rh.requests[entry.req.ReqID] == entry {
+			reqs = append(reqs, entry.req)
+		}
+	}
+	return reqs
+}
+
+// getStats returns the current request counts.
+func (rh *reqHistory) getStats() RequestStats {
+	rh.lock.Lock()
+	defer rh.unlock()
+	rh.expire(time.Now())
+	stats := rh.stats
+	stats.Pending = len(rh.requests)
+	return stats
+}
+
 func (rh *reqHistory) applyToResult(msg *WSMessageResult) {
-	req := rh.get(msg.ReqID)
-	if req != nil {
-		rh.delete(msg.ReqID)
-		msg.Req = req
+	rh.lock.Lock()
+	defer rh.lock.Unlock()
+	if entry := rh.requests[msg.ReqID]; entry != nil {
+		delete(rh.requests, msg.ReqID)
+		rh.stats.Completed++
+		msg.Req = entry.req
 	}
 }
@@ -0,0 +1,34 @@
+package xpweb
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestReqHistorySweepOrphansIdleRequests(t *testing.T) {
+	orphaned := make(chan *WSReq, 1)
+	rh := newReqHistory(50*time.Millisecond, func(req *WSReq) { orphaned <- req })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go rh.sweep(ctx)
+
+	// no further activity follows the request, so only the sweeper can expire it
+	sent := time.Now()
+	rh.add(&WSReq{ReqID: 1})
+	select {
+	case req := <-orphaned:
+		if req.ReqID != 1 {
+			t.Errorf("orphaned request %d, expected 1", req.ReqID)
+		}
+		if elapsed := time.Since(sent); elapsed > 500*time.Millisecond {
+			t.Errorf("request orphaned after %s, expected about 50ms", elapsed)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("idle request was not orphaned")
+	}
+	if stats := rh.getStats(); stats.Expired != 1 || stats.Pending != 0 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
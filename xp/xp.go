@@ -0,0 +1,125 @@
+// Package xp is the redesigned, typed API for the X-Plane web API.  It is built on top of
+// [xpweb.Client] so that existing projects can migrate incrementally: a Sim may wrap a client
+// which is already in use, and the underlying client remains available for anything the typed API
+// does not cover.
+//
+//	sim, err := xp.New(&xpweb.ClientConfig{LazyCache: true})
+//	if err != nil {
+//		return err
+//	}
+//	altitude, err := sim.Float(dataref.SimFlightmodelPosition_elevation).Get(ctx)
+//
+// # Migrating
+//
+// Code using an existing client can adopt the typed API one call at a time by wrapping it:
+//
+//	sim := xp.Wrap(client)
+//
+//	// before
+//	val, err := client.REST.GetDatarefValue(ctx, "sim/flightmodel/weight/m_fuel")
+//	fuel := val.GetFloatArrayValue()
+//
+//	// after
+//	fuel, err := sim.FloatArray("sim/flightmodel/weight/m_fuel").Get(ctx)
+//
+// Methods of xpweb which return a zero value rather than an error when a name is not found are
+// marked as deprecated, so that tools such as staticcheck and gopls report their remaining uses.
+package xp
+
+import (
+	"context"
+
+	"github.com/janeprather/xpweb"
+)
+
+// Sim is a connection to a simulator using the typed API.
+type Sim struct {
+	client *xpweb.Client
+}
+
+// New creates an [xpweb.Client] with the specified configuration and wraps it in a [Sim].
+func New(config *xpweb.ClientConfig) (*Sim, error) {
+	client, err := xpweb.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+	return Wrap(client), nil
+}
+
+// Wrap returns a [Sim] using an existing client.  The client may continue to be used directly.
+func Wrap(client *xpweb.Client) *Sim {
+	return &Sim{client: client}
+}
+
+// Client returns the underlying client, for low-level operations not covered by the typed API.
+func (s *Sim) Client() *xpweb.Client {
+	return s.client
+}
+
+// Command returns a handle to the command with the specified name.
+func (s *Sim) Command(name string) *xpweb.CommandRef {
+	return s.client.Command(name)
+}
+
+// Float returns a handle to a float or double dataref.
+func (s *Sim) Float(name string) *Value[float64] {
+	return newValue(s, name, (*xpweb.DatarefValue).GetFloatValue)
+}
+
+// Int returns a handle to an int dataref.
+func (s *Sim) Int(name string) *Value[int] {
+	return newValue(s, name, (*xpweb.DatarefValue).GetIntValue)
+}
+
+// FloatArray returns a handle to a float array dataref.
+func (s *Sim) FloatArray(name string) *Value[[]float64] {
+	return newValue(s, name, (*xpweb.DatarefValue).GetFloatArrayValue)
+}
+
+// IntArray returns a handle to an int array dataref.
+func (s *Sim) IntArray(name string) *Value[[]int] {
+	return newValue(s, name, (*xpweb.DatarefValue).GetIntArrayValue)
+}
+
+// String returns a handle to a data dataref holding a string.
+func (s *Sim) String(name string) *Value[string] {
+	return newValue(s, name, (*xpweb.DatarefValue).GetStringValue)
+}
+
+// Value is a handle to a dataref whose values are of type T.
+type Value[T any] struct {
+	ref    *xpweb.DatarefRef
+	decode func(*xpweb.DatarefValue) T
+}
+
+func newValue[T any](s *Sim, name string, decode func(*xpweb.DatarefValue) T) *Value[T] {
+	return &Value[T]{ref: s.client.Dataref(name), decode: decode}
+}
+
+// Name returns the name of the dataref.
+func (v *Value[T]) Name() string {
+	return v.ref.Name()
+}
+
+// Get reads the current value of the dataref.
+func (v *Value[T]) Get(ctx context.Context) (T, error) {
+	val, err := v.ref.Get(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.decode(val), nil
+}
+
+// Set writes the value of the dataref.
+func (v *Value[T]) Set(ctx context.Context, value T) error {
+	return v.ref.Set(ctx, value)
+}
+
+// Subscribe calls the specified handler with each updated value of the dataref until the context
+// is done.
+func (v *Value[T]) Subscribe(ctx context.Context, handler func(T), opts ...xpweb.WatchOption) error {
+	return v.ref.Subscribe(ctx, func(val *xpweb.DatarefValue) {
+		handler(v.decode(val))
+	}, opts...)
+}
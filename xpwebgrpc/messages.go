@@ -0,0 +1,291 @@
+package xpwebgrpc
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+// The message types below are encoded by hand according to xpweb.proto, so that the package does
+// not depend on generated code.  Their encoding is checked against descriptors built from
+// xpweb.proto by the package tests, so any change to the proto definition must be reflected here.
+
+// GetDatarefRequest is the request of the GetDataref RPC.
+type GetDatarefRequest struct {
+	Name string
+}
+
+// DatarefValue is the value of a dataref.  Value is a float64 for number values, a []float64 for
+// array values, a []byte for data values, or nil if no value is set.
+type DatarefValue struct {
+	Name  string
+	Value any
+}
+
+// SetDatarefRequest is the request of the SetDataref RPC.
+type SetDatarefRequest struct {
+	Value *DatarefValue
+	// If not nil, the element of an array dataref to which the number is written.
+	Index *int32
+}
+
+// SetDatarefResponse is the response of the SetDataref RPC.
+type SetDatarefResponse struct{}
+
+// SubscribeRequest is the request of the Subscribe RPC.
+type SubscribeRequest struct {
+	Names []string
+}
+
+// ActivateCommandRequest is the request of the ActivateCommand RPC.
+type ActivateCommandRequest struct {
+	Name     string
+	Duration float64
+}
+
+// ActivateCommandResponse is the response of the ActivateCommand RPC.
+type ActivateCommandResponse struct{}
+
+// message is implemented by each message type.
+type message interface {
+	marshal() []byte
+	unmarshal(data []byte) error
+}
+
+// codec encodes the messages of this package, and delegates other messages to the protobuf
+// runtime, so that it may be forced upon a server which also hosts generated services.
+type codec struct{}
+
+func (codec) Marshal(v any) ([]byte, error) {
+	switch msg := v.(type) {
+	case message:
+		return msg.marshal(), nil
+	case proto.Message:
+		return proto.Marshal(msg)
+	}
+	return nil, fmt.Errorf("cannot marshal %T", v)
+}
+
+func (codec) Unmarshal(data []byte, v any) error {
+	switch msg := v.(type) {
+	case message:
+		return msg.unmarshal(data)
+	case proto.Message:
+		return proto.Unmarshal(data, msg)
+	}
+	return fmt.Errorf("cannot unmarshal %T", v)
+}
+
+// Name returns the name of the protobuf codec, as the messages are encoded in the protobuf wire
+// format, and so are compatible with clients generated from xpweb.proto.
+func (codec) Name() string {
+	return "proto"
+}
+
+// field is a decoded field of a message.
+type field struct {
+	num    protowire.Number
+	typ    protowire.Type
+	data   []byte
+	scalar uint64
+}
+
+// consumeFields calls the handler with each field of the encoded message.  Varint and fixed 64
+// bit values are delivered in scalar, and length delimited values in data.
+func consumeFields(data []byte, handler func(field) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		f := field{num: num, typ: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.scalar, n = protowire.ConsumeVarint(data)
+		case protowire.Fixed64Type:
+			f.scalar, n = protowire.ConsumeFixed64(data)
+		case protowire.BytesType:
+			f.data, n = protowire.ConsumeBytes(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		if err := handler(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendString(data []byte, num protowire.Number, value string) []byte {
+	if value == "" {
+		return data
+	}
+	data = protowire.AppendTag(data, num, protowire.BytesType)
+	return protowire.AppendString(data, value)
+}
+
+func appendDouble(data []byte, num protowire.Number, value float64) []byte {
+	data = protowire.AppendTag(data, num, protowire.Fixed64Type)
+	return protowire.AppendFixed64(data, math.Float64bits(value))
+}
+
+func (m *GetDatarefRequest) marshal() []byte {
+	return appendString(nil, 1, m.Name)
+}
+
+func (m *GetDatarefRequest) unmarshal(data []byte) error {
+	*m = GetDatarefRequest{}
+	return consumeFields(data, func(f field) error {
+		if f.num == 1 && f.typ == protowire.BytesType {
+			m.Name = string(f.data)
+		}
+		return nil
+	})
+}
+
+func (m *DatarefValue) marshal() []byte {
+	data := appendString(nil, 1, m.Name)
+	switch value := m.Value.(type) {
+	case float64:
+		data = appendDouble(data, 2, value)
+	case []float64:
+		var packed []byte
+		for _, elem := range value {
+			packed = protowire.AppendFixed64(packed, math.Float64bits(elem))
+		}
+		var array []byte
+		if len(packed) > 0 {
+			array = protowire.AppendTag(array, 1, protowire.BytesType)
+			array = protowire.AppendBytes(array, packed)
+		}
+		data = protowire.AppendTag(data, 3, protowire.BytesType)
+		data = protowire.AppendBytes(data, array)
+	case []byte:
+		data = protowire.AppendTag(data, 4, protowire.BytesType)
+		data = protowire.AppendBytes(data, value)
+	}
+	return data
+}
+
+func (m *DatarefValue) unmarshal(data []byte) error {
+	*m = DatarefValue{}
+	return consumeFields(data, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			m.Name = string(f.data)
+		case f.num == 2 && f.typ == protowire.Fixed64Type:
+			m.Value = math.Float64frombits(f.scalar)
+		case f.num == 3 && f.typ == protowire.BytesType:
+			values := []float64{}
+			err := consumeFields(f.data, func(elem field) error {
+				switch {
+				case elem.num != 1:
+				case elem.typ == protowire.Fixed64Type:
+					values = append(values, math.Float64frombits(elem.scalar))
+				case elem.typ == protowire.BytesType:
+					// packed encoding
+					packed := elem.data
+					for len(packed) > 0 {
+						bits, n := protowire.ConsumeFixed64(packed)
+						if n < 0 {
+							return protowire.ParseError(n)
+						}
+						values = append(values, math.Float64frombits(bits))
+						packed = packed[n:]
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			m.Value = values
+		case f.num == 4 && f.typ == protowire.BytesType:
+			m.Value = append([]byte{}, f.data...)
+		}
+		return nil
+	})
+}
+
+func (m *SetDatarefRequest) marshal() []byte {
+	var data []byte
+	if m.Value != nil {
+		data = protowire.AppendTag(data, 1, protowire.BytesType)
+		data = protowire.AppendBytes(data, m.Value.marshal())
+	}
+	if m.Index != nil {
+		data = protowire.AppendTag(data, 2, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(int64(*m.Index)))
+	}
+	return data
+}
+
+func (m *SetDatarefRequest) unmarshal(data []byte) error {
+	*m = SetDatarefRequest{}
+	return consumeFields(data, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			m.Value = &DatarefValue{}
+			return m.Value.unmarshal(f.data)
+		case f.num == 2 && f.typ == protowire.VarintType:
+			index := int32(f.scalar)
+			m.Index = &index
+		}
+		return nil
+	})
+}
+
+func (m *SetDatarefResponse) marshal() []byte { return nil }
+
+func (m *SetDatarefResponse) unmarshal([]byte) error { return nil }
+
+func (m *SubscribeRequest) marshal() []byte {
+	var data []byte
+	for _, name := range m.Names {
+		data = protowire.AppendTag(data, 1, protowire.BytesType)
+		data = protowire.AppendString(data, name)
+	}
+	return data
+}
+
+func (m *SubscribeRequest) unmarshal(data []byte) error {
+	*m = SubscribeRequest{}
+	return consumeFields(data, func(f field) error {
+		if f.num == 1 && f.typ == protowire.BytesType {
+			m.Names = append(m.Names, string(f.data))
+		}
+		return nil
+	})
+}
+
+func (m *ActivateCommandRequest) marshal() []byte {
+	data := appendString(nil, 1, m.Name)
+	if m.Duration != 0 {
+		data = appendDouble(data, 2, m.Duration)
+	}
+	return data
+}
+
+func (m *ActivateCommandRequest) unmarshal(data []byte) error {
+	*m = ActivateCommandRequest{}
+	return consumeFields(data, func(f field) error {
+		switch {
+		case f.num == 1 && f.typ == protowire.BytesType:
+			m.Name = string(f.data)
+		case f.num == 2 && f.typ == protowire.Fixed64Type:
+			m.Duration = math.Float64frombits(f.scalar)
+		}
+		return nil
+	})
+}
+
+func (m *ActivateCommandResponse) marshal() []byte { return nil }
+
+func (m *ActivateCommandResponse) unmarshal([]byte) error { return nil }
@@ -0,0 +1,205 @@
+package xpwebgrpc
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+var (
+	protoMessageRe = regexp.MustCompile(`^message (\w+) \{(\})?$`)
+	protoOneofRe   = regexp.MustCompile(`^oneof (\w+) \{$`)
+	protoFieldRe   = regexp.MustCompile(`^(optional |repeated )?(\w+) (\w+) = (\d+);$`)
+)
+
+var protoScalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"bytes":  descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"double": descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"int32":  descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"string": descriptorpb.FieldDescriptorProto_TYPE_STRING,
+}
+
+// loadProtoFile builds the descriptor of xpweb.proto, so that the hand written encoding can be
+// checked against the protobuf runtime.  Only the message syntax used by the file is supported.
+func loadProtoFile(t *testing.T) protoreflect.FileDescriptor {
+	t.Helper()
+	src, err := os.ReadFile("xpweb.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("xpweb.proto"),
+		Package: proto.String("xpweb.v1"),
+		Syntax:  proto.String("proto3"),
+	}
+	var msg *descriptorpb.DescriptorProto
+	var oneof *int32
+	for _, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSpace(line)
+		if match := protoMessageRe.FindStringSubmatch(line); match != nil {
+			msg = &descriptorpb.DescriptorProto{Name: proto.String(match[1])}
+			file.MessageType = append(file.MessageType, msg)
+			if match[2] != "" {
+				msg = nil
+			}
+			continue
+		}
+		if msg == nil {
+			continue
+		}
+		if match := protoOneofRe.FindStringSubmatch(line); match != nil {
+			oneof = proto.Int32(int32(len(msg.OneofDecl)))
+			msg.OneofDecl = append(msg.OneofDecl,
+				&descriptorpb.OneofDescriptorProto{Name: proto.String(match[1])})
+			continue
+		}
+		if line == "}" {
+			if oneof != nil {
+				oneof = nil
+			} else {
+				msg = nil
+			}
+			continue
+		}
+		match := protoFieldRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		num, _ := strconv.Atoi(match[4])
+		field := &descriptorpb.FieldDescriptorProto{
+			Name:       proto.String(match[3]),
+			JsonName:   proto.String(match[3]),
+			Number:     proto.Int32(int32(num)),
+			Label:      descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			OneofIndex: oneof,
+		}
+		if typ, ok := protoScalarTypes[match[2]]; ok {
+			field.Type = typ.Enum()
+		} else {
+			field.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			field.TypeName = proto.String(".xpweb.v1." + match[2])
+		}
+		switch match[1] {
+		case "repeated ":
+			field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		case "optional ":
+			// proto3 optional fields are members of a synthetic oneof
+			field.Proto3Optional = proto.Bool(true)
+			field.OneofIndex = proto.Int32(int32(len(msg.OneofDecl)))
+			msg.OneofDecl = append(msg.OneofDecl,
+				&descriptorpb.OneofDescriptorProto{Name: proto.String("_" + match[3])})
+		}
+		msg.Field = append(msg.Field, field)
+	}
+	fd, err := protodesc.NewFile(file, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd
+}
+
+// checkKnownFields fails the test if the message, or any message within it, has unknown fields,
+// which would indicate that a field was encoded with the wrong number or wire type.
+func checkKnownFields(t *testing.T, msg protoreflect.Message) {
+	t.Helper()
+	if len(msg.GetUnknown()) > 0 {
+		t.Errorf("%s has unknown fields %x", msg.Descriptor().FullName(), msg.GetUnknown())
+	}
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+			checkKnownFields(t, value.Message())
+		}
+		return true
+	})
+}
+
+func TestMessagesRoundTrip(t *testing.T) {
+	fd := loadProtoFile(t)
+	index := int32(3)
+	for _, tc := range []struct {
+		name string
+		msg  message
+	}{
+		{"GetDatarefRequest", &GetDatarefRequest{Name: "sim/time/zulu_time_sec"}},
+		{"DatarefValue", &DatarefValue{Name: "sim/a", Value: 1.5}},
+		{"DatarefValue", &DatarefValue{Name: "sim/a", Value: 0.0}},
+		{"DatarefValue", &DatarefValue{Name: "sim/b", Value: []float64{1, -2.5, 3e10}}},
+		{"DatarefValue", &DatarefValue{Name: "sim/b", Value: []float64{}}},
+		{"DatarefValue", &DatarefValue{Name: "sim/c", Value: []byte("N12345\x00")}},
+		{"DatarefValue", &DatarefValue{Name: "sim/d"}},
+		{"SetDatarefRequest", &SetDatarefRequest{
+			Value: &DatarefValue{Name: "sim/b", Value: 2.0},
+			Index: &index,
+		}},
+		{"SetDatarefRequest", &SetDatarefRequest{Value: &DatarefValue{Name: "sim/a", Value: 1.0}}},
+		{"SetDatarefResponse", &SetDatarefResponse{}},
+		{"SubscribeRequest", &SubscribeRequest{Names: []string{"sim/a", "sim/b"}}},
+		{"ActivateCommandRequest", &ActivateCommandRequest{Name: "sim/lights/landing_lights_on"}},
+		{"ActivateCommandRequest", &ActivateCommandRequest{Name: "sim/a", Duration: 0.5}},
+		{"ActivateCommandResponse", &ActivateCommandResponse{}},
+	} {
+		md := fd.Messages().ByName(protoreflect.Name(tc.name))
+		if md == nil {
+			t.Fatalf("%s is not defined by xpweb.proto", tc.name)
+		}
+
+		// the hand written encoding is decoded by the protobuf runtime without unknown fields, and
+		// re-encoded identically
+		data := tc.msg.marshal()
+		dyn := dynamicpb.NewMessage(md)
+		if err := proto.Unmarshal(data, dyn); err != nil {
+			t.Errorf("%s %+v: %v", tc.name, tc.msg, err)
+			continue
+		}
+		checkKnownFields(t, dyn)
+		encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(dyn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(encoded, data) {
+			t.Errorf("%s %+v: encoded as %x, protobuf runtime encodes %x", tc.name, tc.msg, data,
+				encoded)
+		}
+
+		// the protobuf runtime's encoding is decoded to the original message
+		decoded := reflect.New(reflect.TypeOf(tc.msg).Elem()).Interface().(message)
+		if err := decoded.unmarshal(encoded); err != nil {
+			t.Errorf("%s %+v: %v", tc.name, tc.msg, err)
+			continue
+		}
+		if !reflect.DeepEqual(decoded, tc.msg) {
+			t.Errorf("%s: decoded %+v, expected %+v", tc.name, decoded, tc.msg)
+		}
+	}
+}
+
+func TestDatarefValueUnpackedArray(t *testing.T) {
+	// a conforming decoder must accept an unpacked encoding of a repeated scalar field
+	var array []byte
+	for _, value := range []float64{1, 2, 3} {
+		array = protowire.AppendTag(array, 1, protowire.Fixed64Type)
+		array = protowire.AppendFixed64(array, math.Float64bits(value))
+	}
+	data := protowire.AppendTag(nil, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, array)
+
+	msg := &DatarefValue{}
+	if err := msg.unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(msg.Value, []float64{1, 2, 3}) {
+		t.Errorf("decoded %x as %v", data, msg.Value)
+	}
+}
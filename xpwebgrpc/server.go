@@ -0,0 +1,243 @@
+// Package xpwebgrpc serves the xpweb.v1.XPWeb gRPC service, defined in xpweb.proto, backed by an
+// [xpweb.Client].  Applications in any language may generate a client from the proto definition,
+// and read and write datarefs, stream dataref updates, and activate commands over a stable local
+// API, while the server handles caching, reconnection, and the simulator's protocol.
+//
+//	server := xpwebgrpc.NewServer(client)
+//	listener, err := net.Listen("tcp", "localhost:50051")
+//	if err != nil {
+//		return err
+//	}
+//	err = server.Serve(listener)
+//
+// The messages of the service are encoded without generated code, by a codec which the server is
+// configured to use.  To host the service on an existing server, pass [ServerOption] when creating
+// it and call [Register]; messages of other services are still encoded by the protobuf runtime.
+//
+// The package is a separate module, so that applications which do not serve gRPC do not depend on
+// the gRPC and protobuf modules.
+package xpwebgrpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/janeprather/xpweb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceName is the fully qualified name of the gRPC service.
+const ServiceName = "xpweb.v1.XPWeb"
+
+// service is implemented by [Service], and is the handler type of the service description.
+type service interface {
+	GetDataref(ctx context.Context, req *GetDatarefRequest) (*DatarefValue, error)
+	SetDataref(ctx context.Context, req *SetDatarefRequest) (*SetDatarefResponse, error)
+	Subscribe(req *SubscribeRequest, stream grpc.ServerStream) error
+	ActivateCommand(
+		ctx context.Context,
+		req *ActivateCommandRequest,
+	) (*ActivateCommandResponse, error)
+}
+
+// Service implements the XPWeb service through a client.
+type Service struct {
+	client *xpweb.Client
+}
+
+// NewServer returns a new gRPC server configured with [ServerOption] and any additional options,
+// on which the XPWeb service is registered for the specified client.
+func NewServer(client *xpweb.Client, opts ...grpc.ServerOption) *grpc.Server {
+	server := grpc.NewServer(append([]grpc.ServerOption{ServerOption()}, opts...)...)
+	Register(server, client)
+	return server
+}
+
+// ServerOption returns the server option which configures a gRPC server to encode the messages of
+// the XPWeb service.
+func ServerOption() grpc.ServerOption {
+	return grpc.ForceServerCodec(codec{})
+}
+
+// Register registers the XPWeb service for the specified client on a gRPC server, which must have
+// been created with [ServerOption].
+func Register(server *grpc.Server, client *xpweb.Client) {
+	server.RegisterService(&serviceDesc, &Service{client: client})
+}
+
+// GetDataref returns the current value of a dataref.
+func (s *Service) GetDataref(ctx context.Context, req *GetDatarefRequest) (*DatarefValue, error) {
+	val, err := s.client.GetValue(ctx, req.Name)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return toMessage(req.Name, val), nil
+}
+
+// SetDataref writes the value of a dataref, or of one element of an array dataref.
+func (s *Service) SetDataref(
+	ctx context.Context,
+	req *SetDatarefRequest,
+) (*SetDatarefResponse, error) {
+	if req.Value == nil || req.Value.Value == nil {
+		return nil, status.Error(codes.InvalidArgument, "no value specified")
+	}
+	var err error
+	if req.Index != nil {
+		err = s.client.REST.SetDatarefElementValue(ctx, req.Value.Name, int(*req.Index),
+			req.Value.Value)
+	} else {
+		err = s.client.SetValue(ctx, req.Value.Name, req.Value.Value)
+	}
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return &SetDatarefResponse{}, nil
+}
+
+// Subscribe streams the values of the requested datarefs as they change, until the call ends.
+func (s *Service) Subscribe(req *SubscribeRequest, stream grpc.ServerStream) error {
+	if len(req.Names) == 0 {
+		return status.Error(codes.InvalidArgument, "no dataref specified")
+	}
+	updates, err := s.client.SubscribeFor(stream.Context(), req.Names...)
+	if err != nil {
+		return statusError(err)
+	}
+	for msg := range updates {
+		for _, val := range msg.Data {
+			if val.Dataref == nil {
+				continue
+			}
+			if err := stream.SendMsg(toMessage(val.Dataref.Name, val)); err != nil {
+				return err
+			}
+		}
+	}
+	return stream.Context().Err()
+}
+
+// ActivateCommand activates a command for the requested duration.
+func (s *Service) ActivateCommand(
+	ctx context.Context,
+	req *ActivateCommandRequest,
+) (*ActivateCommandResponse, error) {
+	if err := s.client.ActivateCommand(ctx, req.Name, req.Duration); err != nil {
+		return nil, statusError(err)
+	}
+	return &ActivateCommandResponse{}, nil
+}
+
+// toMessage converts a dataref value to its message representation.
+func toMessage(name string, val *xpweb.DatarefValue) *DatarefValue {
+	msg := &DatarefValue{Name: name}
+	switch value := val.Value.(type) {
+	case float64:
+		msg.Value = value
+	case int:
+		msg.Value = float64(value)
+	case []float64:
+		msg.Value = value
+	case []int:
+		array := make([]float64, len(value))
+		for idx, elem := range value {
+			array[idx] = float64(elem)
+		}
+		msg.Value = array
+	case []any:
+		array := val.GetFloatArrayValue()
+		if array == nil {
+			array = []float64{}
+		}
+		msg.Value = array
+	case []byte:
+		msg.Value = value
+	case string:
+		msg.Value = val.GetByteArrayValue()
+	}
+	return msg
+}
+
+// statusError returns a gRPC status error with a code appropriate to the error.
+func statusError(err error) error {
+	code := codes.Unavailable
+	switch {
+	case errors.Is(err, xpweb.ErrDatarefNotFound), errors.Is(err, xpweb.ErrCommandNotFound):
+		code = codes.NotFound
+	case errors.Is(err, xpweb.ErrInvalidValue), errors.Is(err, xpweb.ErrInvalidDuration):
+		code = codes.InvalidArgument
+	case errors.Is(err, xpweb.ErrNotWriter):
+		code = codes.FailedPrecondition
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	}
+	return status.Error(code, err.Error())
+}
+
+// serviceDesc describes the XPWeb service, as generated code would.
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*service)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDataref",
+			Handler:    unaryHandler("GetDataref", service.GetDataref),
+		},
+		{
+			MethodName: "SetDataref",
+			Handler:    unaryHandler("SetDataref", service.SetDataref),
+		},
+		{
+			MethodName: "ActivateCommand",
+			Handler:    unaryHandler("ActivateCommand", service.ActivateCommand),
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			ServerStreams: true,
+			Handler: func(srv any, stream grpc.ServerStream) error {
+				req := &SubscribeRequest{}
+				if err := stream.RecvMsg(req); err != nil {
+					return err
+				}
+				return srv.(service).Subscribe(req, stream)
+			},
+		},
+	},
+	Metadata: "xpweb.proto",
+}
+
+// unaryHandler returns the method handler of a unary RPC, which decodes the request and calls
+// the method through any interceptor.
+func unaryHandler[Req any, PReq interface {
+	*Req
+	message
+}, Resp any](
+	method string,
+	call func(service, context.Context, PReq) (Resp, error),
+) func(any, context.Context, func(any) error, grpc.UnaryServerInterceptor) (any, error) {
+	fullMethod := "/" + ServiceName + "/" + method
+	return func(
+		srv any,
+		ctx context.Context,
+		dec func(any) error,
+		interceptor grpc.UnaryServerInterceptor,
+	) (any, error) {
+		req := PReq(new(Req))
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		if interceptor == nil {
+			return call(srv.(service), ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod}
+		return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			return call(srv.(service), ctx, req.(PReq))
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Package xpwebmqtt bridges datarefs and commands to an MQTT broker, for smart home and IoT
+// cockpit setups.  Dataref values are published to topics as they change, and values and command
+// activations are accepted from topics, according to a [Config] mapping table.
+//
+//	datarefs:
+//	  - topic: cockpit/gear
+//	    dataref: sim/cockpit2/controls/gear_handle_down
+//	    writable: true
+//	  - topic: cockpit/n1/0
+//	    dataref: sim/flightmodel/engine/ENGN_N1_
+//	    index: 0
+//	commands:
+//	  - topic: cockpit/cmd/gear_toggle
+//	    command: sim/flight_controls/landing_gear_toggle
+//
+// Values are published as text: numbers in decimal, arrays as JSON arrays, and data datarefs as
+// the string they hold.  A writable dataref accepts values published to its topic followed by
+// /set, in any form accepted by [xpweb.ParseValue].  A message on a command topic activates the
+// command; an empty payload activates it according to its [xpweb.CommandKind], and a numeric
+// payload holds it for that many seconds.
+//
+//	cfg, err := xpwebmqtt.Load("mqtt.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	conn, err := xpwebmqtt.Dial(ctx, "localhost:1883", xpwebmqtt.DialOptions{ClientID: "xplane"})
+//	if err != nil {
+//		return err
+//	}
+//	defer conn.Close()
+//	err = xpwebmqtt.New(client, conn, cfg).Run(ctx)
+package xpwebmqtt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/janeprather/xpweb"
+	"github.com/janeprather/xpweb/internal/yaml"
+)
+
+// SetSuffix is appended to the topic of a writable dataref to form the topic on which writes are
+// accepted.
+const SetSuffix = "/set"
+
+// requestTimeout is the time allowed for a write or command activation received from the broker.
+const requestTimeout = 10 * time.Second
+
+// Config is the table mapping MQTT topics to datarefs and commands.
+type Config struct {
+	Datarefs []*DatarefMapping `json:"datarefs"`
+	Commands []*CommandMapping `json:"commands"`
+	// Whether published dataref values are retained by the broker, so that new subscribers
+	// receive the latest value immediately.
+	Retain bool `json:"retain,omitempty"`
+}
+
+// DatarefMapping publishes the value of a dataref, or of its Index element, to a topic.
+type DatarefMapping struct {
+	Topic   string `json:"topic"`
+	Dataref string `json:"dataref"`
+	Index   *int   `json:"index,omitempty"`
+	// Whether values published to the topic followed by SetSuffix are written to the dataref.
+	Writable bool `json:"writable,omitempty"`
+}
+
+// CommandMapping activates a command when a message is published to a topic.
+type CommandMapping struct {
+	Topic   string `json:"topic"`
+	Command string `json:"command"`
+}
+
+// Load reads a [Config] from the specified file, which is decoded as JSON if its name ends in
+// .json, or as YAML otherwise.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		cfg := &Config{}
+		if err := json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal MQTT config: %w", err)
+		}
+		return cfg, cfg.Validate()
+	}
+	return Parse(data)
+}
+
+// Parse decodes a [Config] from YAML.
+func Parse(data []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal MQTT config: %w", err)
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks that each mapping has a topic and a dataref or command.
+func (c *Config) Validate() error {
+	for idx, mapping := range c.Datarefs {
+		if mapping.Topic == "" || mapping.Dataref == "" {
+			return fmt.Errorf("dataref mapping %d: topic and dataref are required", idx+1)
+		}
+	}
+	for idx, mapping := range c.Commands {
+		if mapping.Topic == "" || mapping.Command == "" {
+			return fmt.Errorf("command mapping %d: topic and command are required", idx+1)
+		}
+	}
+	return nil
+}
+
+// Bridge publishes dataref values to, and accepts writes and commands from, an MQTT broker.
+type Bridge struct {
+	client *xpweb.Client
+	broker Broker
+	config *Config
+	// An optional logger for errors publishing values or handling messages.  If nil,
+	// slog.Default() is used.
+	Logger *slog.Logger
+}
+
+// New instantiates and returns a pointer to a new [Bridge] which applies the specified
+// configuration between the simulator of the specified client and the broker.
+func New(client *xpweb.Client, broker Broker, config *Config) *Bridge {
+	return &Bridge{client: client, broker: broker, config: config}
+}
+
+func (b *Bridge) logger() *slog.Logger {
+	if b.Logger != nil {
+		return b.Logger
+	}
+	return slog.Default()
+}
+
+// Run subscribes to the mapped datarefs and topics, and bridges them until the context is done.
+// Subscriptions to the broker cannot be removed, so a Bridge should be run once per connection.
+func (b *Bridge) Run(ctx context.Context) error {
+	for _, mapping := range b.config.Datarefs {
+		handler := func(val *xpweb.DatarefValue) { b.publish(mapping, val) }
+		var err error
+		if mapping.Index != nil {
+			err = b.client.SubscribeDatarefElement(ctx, mapping.Dataref, *mapping.Index, handler)
+		} else {
+			err = b.client.Watch(ctx, mapping.Dataref, handler)
+		}
+		if err != nil {
+			return fmt.Errorf("subscribing to %s: %w", mapping.Dataref, err)
+		}
+
+		if mapping.Writable {
+			err := b.broker.Subscribe(mapping.Topic+SetSuffix, func(_ string, payload []byte) {
+				b.handle(ctx, func(reqCtx context.Context) error {
+					return b.write(reqCtx, mapping, string(payload))
+				})
+			})
+			if err != nil {
+				return fmt.Errorf("subscribing to %s: %w", mapping.Topic+SetSuffix, err)
+			}
+		}
+	}
+
+	for _, mapping := range b.config.Commands {
+		err := b.broker.Subscribe(mapping.Topic, func(_ string, payload []byte) {
+			b.handle(ctx, func(reqCtx context.Context) error {
+				return b.activate(reqCtx, mapping, string(payload))
+			})
+		})
+		if err != nil {
+			return fmt.Errorf("subscribing to %s: %w", mapping.Topic, err)
+		}
+	}
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// publish sends a dataref value to the topic of its mapping.
+func (b *Bridge) publish(mapping *DatarefMapping, val *xpweb.DatarefValue) {
+	if err := b.broker.Publish(mapping.Topic, formatValue(val), b.config.Retain); err != nil {
+		b.logger().Error("failed to publish dataref value", "topic", mapping.Topic,
+			"error", err)
+	}
+}
+
+// handle performs a request received from the broker, unless the bridge has stopped, and logs
+// any error.
+func (b *Bridge) handle(ctx context.Context, request func(context.Context) error) {
+	if ctx.Err() != nil {
+		return
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	if err := request(reqCtx); err != nil {
+		b.logger().Error("failed to handle MQTT message", "error", err)
+	}
+}
+
+// write writes a value received from the broker to the dataref of its mapping.
+func (b *Bridge) write(ctx context.Context, mapping *DatarefMapping, payload string) error {
+	if mapping.Index != nil {
+		value, err := xpweb.ParseValue(payload, xpweb.ValueTypeFloat)
+		if err != nil {
+			return err
+		}
+		return b.client.REST.SetDatarefElementValue(ctx, mapping.Dataref, *mapping.Index, value)
+	}
+	value, err := b.client.ParseDatarefValue(mapping.Dataref, payload)
+	if err != nil {
+		return err
+	}
+	return b.client.SetValue(ctx, mapping.Dataref, value)
+}
+
+// activate activates the command of its mapping, for the duration in the payload, if any.
+func (b *Bridge) activate(ctx context.Context, mapping *CommandMapping, payload string) error {
+	payload = strings.TrimSpace(payload)
+	if payload == "" {
+		return b.client.TriggerCommand(ctx, mapping.Command)
+	}
+	duration, err := strconv.ParseFloat(payload, 64)
+	if err != nil {
+		return fmt.Errorf("invalid duration for %s: %w", mapping.Command, err)
+	}
+	return b.client.ActivateCommand(ctx, mapping.Command, duration)
+}
+
+// formatValue returns the text published for a dataref value.
+func formatValue(val *xpweb.DatarefValue) []byte {
+	if val.Dataref != nil && val.Dataref.ValueType == xpweb.ValueTypeData {
+		if _, isScalar := val.Value.(string); isScalar {
+			return []byte(strings.TrimRight(val.GetStringValue(), "\x00"))
+		}
+	}
+	if num, ok := val.Value.(float64); ok {
+		return []byte(strconv.FormatFloat(num, 'f', -1, 64))
+	}
+	data, err := json.Marshal(val.Value)
+	if err != nil {
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,342 @@
+package xpwebmqtt
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// MQTT 3.1.1 control packet types, in the high nibble of the first header byte.
+const (
+	packetConnect     = 0x10
+	packetConnack     = 0x20
+	packetPublish     = 0x30
+	packetSubscribe   = 0x82
+	packetSuback      = 0x90
+	packetPingreq     = 0xC0
+	packetPingresp    = 0xD0
+	packetDisconnect  = 0xE0
+	packetTypeMask    = 0xF0
+	publishRetainFlag = 0x01
+	publishQoSMask    = 0x06
+)
+
+// DefaultKeepAlive is the keep alive interval of a [Conn] if none is specified.
+const DefaultKeepAlive = 30 * time.Second
+
+// Broker publishes messages to, and receives messages from, an MQTT broker.  [Conn] is a minimal
+// implementation; an adapter for a full-featured client library may be used instead.
+type Broker interface {
+	// Publish sends a message to the specified topic.
+	Publish(topic string, payload []byte, retain bool) error
+	// Subscribe calls the handler with each message received on the specified topic.
+	Subscribe(topic string, handler func(topic string, payload []byte)) error
+}
+
+// DialOptions configures the connection made by [Dial].
+type DialOptions struct {
+	// The client identifier presented to the broker.  If empty, the broker assigns one.
+	ClientID string
+	// Optional credentials.
+	Username string
+	Password string
+	// The interval at which the connection is kept alive.  If zero, DefaultKeepAlive is used.
+	KeepAlive time.Duration
+}
+
+// Conn is a minimal MQTT 3.1.1 client connection, which publishes and subscribes at QoS 0 with a
+// clean session.  Subscriptions match topics exactly; wildcards are not supported.  It is safe
+// for concurrent use.
+type Conn struct {
+	conn net.Conn
+
+	writeLock sync.Mutex
+	lock      sync.Mutex
+	handlers  map[string]func(topic string, payload []byte)
+	packetID  uint16
+	subacks   map[uint16]chan byte
+	done      chan struct{}
+	err       error
+}
+
+// Dial connects to the MQTT broker at the specified TCP address, such as "localhost:1883".
+func Dial(ctx context.Context, addr string, opts DialOptions) (*Conn, error) {
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	keepAlive := opts.KeepAlive
+	if keepAlive <= 0 {
+		keepAlive = DefaultKeepAlive
+	}
+
+	// protocol name and level, connect flags (clean session), and keep alive
+	flags := byte(0x02)
+	if opts.Username != "" {
+		flags |= 0x80
+	}
+	if opts.Password != "" {
+		flags |= 0x40
+	}
+	body := appendString(nil, "MQTT")
+	body = append(body, 4, flags)
+	body = binary.BigEndian.AppendUint16(body, uint16(keepAlive/time.Second))
+	body = appendString(body, opts.ClientID)
+	if opts.Username != "" {
+		body = appendString(body, opts.Username)
+	}
+	if opts.Password != "" {
+		body = appendString(body, opts.Password)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		netConn.SetDeadline(deadline)
+	}
+	reader := bufio.NewReader(netConn)
+	if _, err := netConn.Write(appendPacket(nil, packetConnect, body)); err != nil {
+		netConn.Close()
+		return nil, err
+	}
+	header, ack, err := readPacket(reader)
+	if err != nil {
+		netConn.Close()
+		return nil, fmt.Errorf("reading CONNACK: %w", err)
+	}
+	if header&packetTypeMask != packetConnack || len(ack) != 2 {
+		netConn.Close()
+		return nil, fmt.Errorf("unexpected MQTT packet 0x%02X awaiting CONNACK", header)
+	}
+	if ack[1] != 0 {
+		netConn.Close()
+		return nil, fmt.Errorf("MQTT connection refused: return code %d", ack[1])
+	}
+	netConn.SetDeadline(time.Time{})
+
+	c := &Conn{
+		conn:     netConn,
+		handlers: make(map[string]func(string, []byte)),
+		subacks:  make(map[uint16]chan byte),
+		done:     make(chan struct{}),
+	}
+	go c.readLoop(reader)
+	go c.keepAlive(keepAlive)
+	return c, nil
+}
+
+// Publish allows Conn to implement the [Broker] interface.
+func (c *Conn) Publish(topic string, payload []byte, retain bool) error {
+	header := byte(packetPublish)
+	if retain {
+		header |= publishRetainFlag
+	}
+	body := append(appendString(nil, topic), payload...)
+	return c.write(appendPacket(nil, header, body))
+}
+
+// Subscribe allows Conn to implement the [Broker] interface.  It waits for the broker to
+// acknowledge the subscription.  Handlers are called from the goroutine which reads from the
+// broker, so a slow handler delays the delivery of other messages.
+func (c *Conn) Subscribe(topic string, handler func(topic string, payload []byte)) error {
+	c.lock.Lock()
+	c.handlers[topic] = handler
+	c.packetID++
+	if c.packetID == 0 {
+		c.packetID++
+	}
+	id := c.packetID
+	ack := make(chan byte, 1)
+	c.subacks[id] = ack
+	c.lock.Unlock()
+
+	body := binary.BigEndian.AppendUint16(nil, id)
+	body = appendString(body, topic)
+	body = append(body, 0)
+	if err := c.write(appendPacket(nil, packetSubscribe, body)); err != nil {
+		c.lock.Lock()
+		delete(c.subacks, id)
+		delete(c.handlers, topic)
+		c.lock.Unlock()
+		return err
+	}
+	select {
+	case code := <-ack:
+		if code == 0x80 {
+			c.lock.Lock()
+			delete(c.handlers, topic)
+			c.lock.Unlock()
+			return fmt.Errorf("MQTT subscription to %s refused", topic)
+		}
+		return nil
+	case <-c.done:
+		return c.Err()
+	}
+}
+
+// Close disconnects from the broker.
+func (c *Conn) Close() error {
+	c.write(appendPacket(nil, packetDisconnect, nil))
+	return c.conn.Close()
+}
+
+// Done returns a channel which is closed when the connection is lost or closed.
+func (c *Conn) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the error which ended the connection, if it has ended.
+func (c *Conn) Err() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.err
+}
+
+func (c *Conn) write(packet []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	_, err := c.conn.Write(packet)
+	return err
+}
+
+// readLoop dispatches packets received from the broker until the connection fails.
+func (c *Conn) readLoop(reader *bufio.Reader) {
+	var err error
+	defer func() {
+		c.lock.Lock()
+		c.err = err
+		c.lock.Unlock()
+		close(c.done)
+		c.conn.Close()
+	}()
+
+	for {
+		var header byte
+		var body []byte
+		header, body, err = readPacket(reader)
+		if err != nil {
+			return
+		}
+		switch header & packetTypeMask {
+		case packetPublish:
+			c.handlePublish(header, body)
+		case packetSuback:
+			if len(body) < 3 {
+				continue
+			}
+			id := binary.BigEndian.Uint16(body)
+			c.lock.Lock()
+			ack := c.subacks[id]
+			delete(c.subacks, id)
+			c.lock.Unlock()
+			if ack != nil {
+				ack <- body[2]
+			}
+		}
+	}
+}
+
+// handlePublish delivers a received message to the handler of its topic.
+func (c *Conn) handlePublish(header byte, body []byte) {
+	topic, rest, ok := readString(body)
+	if !ok {
+		return
+	}
+	if header&publishQoSMask != 0 {
+		// skip the packet identifier; subscriptions are QoS 0, so none should arrive
+		if len(rest) < 2 {
+			return
+		}
+		rest = rest[2:]
+	}
+	c.lock.Lock()
+	handler := c.handlers[topic]
+	c.lock.Unlock()
+	if handler != nil {
+		handler(topic, rest)
+	}
+}
+
+// keepAlive sends a ping whenever the keep alive interval elapses, until the connection ends.
+func (c *Conn) keepAlive(interval time.Duration) {
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.write(appendPacket(nil, packetPingreq, nil)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// appendPacket appends a control packet with the specified header byte and body.
+func appendPacket(dest []byte, header byte, body []byte) []byte {
+	dest = append(dest, header)
+	length := len(body)
+	for {
+		digit := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			digit |= 0x80
+		}
+		dest = append(dest, digit)
+		if length == 0 {
+			break
+		}
+	}
+	return append(dest, body...)
+}
+
+// appendString appends a length-prefixed UTF-8 string.
+func appendString(dest []byte, s string) []byte {
+	dest = binary.BigEndian.AppendUint16(dest, uint16(len(s)))
+	return append(dest, s...)
+}
+
+// readString reads a length-prefixed string, and returns it with the remaining data.
+func readString(data []byte) (string, []byte, bool) {
+	if len(data) < 2 {
+		return "", nil, false
+	}
+	length := int(binary.BigEndian.Uint16(data))
+	if len(data) < 2+length {
+		return "", nil, false
+	}
+	return string(data[2 : 2+length]), data[2+length:], true
+}
+
+// readPacket reads a control packet, returning its header byte and body.
+func readPacket(reader *bufio.Reader) (byte, []byte, error) {
+	header, err := reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	length := 0
+	for shift := 0; ; shift += 7 {
+		if shift > 21 {
+			return 0, nil, errors.New("malformed MQTT remaining length")
+		}
+		digit, err := reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length |= int(digit&0x7F) << shift
+		if digit&0x80 == 0 {
+			break
+		}
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return 0, nil, err
+	}
+	return header, body, nil
+}